@@ -0,0 +1,169 @@
+package api
+
+import (
+	"errors"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+)
+
+// KeyringBackend is the backend where the keys of the accounts are stored.
+type KeyringBackend string
+
+const (
+	// KeyringTest stores the keys unencrypted in the home of the keyring.
+	KeyringTest = KeyringBackend(cosmosaccount.KeyringTest)
+
+	// KeyringOS stores the keys in the keyring of the operating system.
+	KeyringOS = KeyringBackend(cosmosaccount.KeyringOS)
+
+	// KeyringMemory stores the keys in memory.
+	KeyringMemory = KeyringBackend(cosmosaccount.KeyringMemory)
+)
+
+var (
+	// ErrAccountExists is returned when an account is created or imported
+	// with the name of an existing account.
+	ErrAccountExists = cosmosaccount.ErrAccountExists
+
+	// ErrAccountNotFound is returned when an account doesn't exist.
+	ErrAccountNotFound = errors.New("account not found")
+)
+
+// Account is an account of a keyring.
+type Account struct {
+	// Name is the name of the account.
+	Name string
+
+	account cosmosaccount.Account
+}
+
+// Address returns the address of the account with the bech32 prefix, or with
+// the cosmos prefix when prefix is empty.
+func (a Account) Address(prefix string) (string, error) {
+	return a.account.Address(prefix)
+}
+
+// PubKey returns the public key of the account.
+func (a Account) PubKey() (string, error) {
+	return a.account.PubKey()
+}
+
+// Keyring stores the keys of accounts.
+type Keyring struct {
+	registry cosmosaccount.Registry
+}
+
+// KeyringOption configures a keyring.
+type KeyringOption func(*[]cosmosaccount.Option)
+
+// WithKeyringHome sets the home directory of the keyring.
+func WithKeyringHome(path string) KeyringOption {
+	return func(o *[]cosmosaccount.Option) {
+		*o = append(*o, cosmosaccount.WithHome(path))
+	}
+}
+
+// WithKeyringBackend sets the backend of the keyring.
+func WithKeyringBackend(backend KeyringBackend) KeyringOption {
+	return func(o *[]cosmosaccount.Option) {
+		*o = append(*o, cosmosaccount.WithKeyringBackend(cosmosaccount.KeyringBackend(backend)))
+	}
+}
+
+// OpenKeyring opens the keyring of the accounts of Ignite CLI, the accounts
+// managed with the "ignite account" commands. Use the options to open another
+// keyring, the test backend is used by default.
+func OpenKeyring(options ...KeyringOption) (Keyring, error) {
+	var registryOptions []cosmosaccount.Option
+	for _, apply := range options {
+		apply(&registryOptions)
+	}
+
+	registry, err := cosmosaccount.NewStandalone(registryOptions...)
+	if err != nil {
+		return Keyring{}, err
+	}
+
+	return Keyring{registry}, nil
+}
+
+// CreateAccount creates an account with a new mnemonic and returns the
+// account with its mnemonic.
+func (k Keyring) CreateAccount(name string) (account Account, mnemonic string, err error) {
+	acc, mnemonic, err := k.registry.Create(name)
+	if err != nil {
+		return Account{}, "", err
+	}
+
+	return newAccount(acc), mnemonic, nil
+}
+
+// ImportAccount imports an account from a mnemonic, or from a private key
+// exported with the passphrase.
+func (k Keyring) ImportAccount(name, secret, passphrase string) (Account, error) {
+	acc, err := k.registry.Import(name, secret, passphrase)
+	if err != nil {
+		return Account{}, err
+	}
+
+	return newAccount(acc), nil
+}
+
+// ExportAccount exports the private key of an account encrypted with the passphrase.
+func (k Keyring) ExportAccount(name, passphrase string) (string, error) {
+	if _, err := k.Account(name); err != nil {
+		return "", err
+	}
+
+	return k.registry.Export(name, passphrase)
+}
+
+// Account returns the account with the name.
+func (k Keyring) Account(name string) (Account, error) {
+	acc, err := k.registry.GetByName(name)
+	if err != nil {
+		return Account{}, accountError(err)
+	}
+
+	return newAccount(acc), nil
+}
+
+// Accounts returns the accounts of the keyring.
+func (k Keyring) Accounts() ([]Account, error) {
+	accs, err := k.registry.List()
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]Account, len(accs))
+	for i, acc := range accs {
+		accounts[i] = newAccount(acc)
+	}
+
+	return accounts, nil
+}
+
+// DeleteAccount deletes the account with the name.
+func (k Keyring) DeleteAccount(name string) error {
+	if _, err := k.Account(name); err != nil {
+		return err
+	}
+
+	return k.registry.DeleteByName(name)
+}
+
+func newAccount(acc cosmosaccount.Account) Account {
+	return Account{
+		Name:    acc.Name,
+		account: acc,
+	}
+}
+
+func accountError(err error) error {
+	var notFound *cosmosaccount.AccountDoesNotExistError
+	if errors.As(err, &notFound) {
+		return ErrAccountNotFound
+	}
+
+	return err
+}
@@ -0,0 +1,30 @@
+// Package api is the stable Go API of Ignite CLI for the tools built on top of
+// it. It gives access to the lifecycle of the chains, to their config, to the
+// accounts of the keyring and to the code generation from their proto files.
+//
+// The API follows semantic versioning: the exported identifiers of this package
+// are not removed and their behavior is not changed in an incompatible way
+// within a major version of Ignite CLI. The other packages of the module,
+// including the ones in ignite/pkg and ignite/services, are the implementation
+// of Ignite CLI and can change in any release, the tools should not import them.
+package api
+
+import (
+	"path/filepath"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/cache"
+)
+
+// cacheFileName is the name of the cache file shared with the commands of
+// Ignite CLI, so the builds and the code generation are cached for both.
+const cacheFileName = "ignite_cache.db"
+
+func newCacheStorage() (cache.Storage, error) {
+	dir, err := chainconfig.ConfigDirPath()
+	if err != nil {
+		return cache.Storage{}, err
+	}
+
+	return cache.NewStorage(filepath.Join(dir, cacheFileName))
+}
@@ -0,0 +1,77 @@
+package api_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/api"
+)
+
+func TestParseConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := api.LocateConfig(dir)
+	require.ErrorIs(t, err, api.ErrConfigNotFound)
+
+	path := filepath.Join(dir, "config.yml")
+	config := "version: 1\naccounts:\n  - name: alice\n    coins: [\"100stake\"]\nvalidators:\n  - name: alice\n    bonded: 100stake\n"
+	require.NoError(t, os.WriteFile(path, []byte(config), 0o644))
+
+	located, err := api.LocateConfig(dir)
+	require.NoError(t, err)
+	require.Equal(t, path, located)
+
+	conf, err := api.ParseConfig(path)
+	require.NoError(t, err)
+	require.Len(t, conf.Accounts, 1)
+	require.Equal(t, "alice", conf.Accounts[0].Name)
+}
+
+func TestKeyring(t *testing.T) {
+	keyring, err := api.OpenKeyring(
+		api.WithKeyringHome(t.TempDir()),
+		api.WithKeyringBackend(api.KeyringMemory),
+	)
+	require.NoError(t, err)
+
+	alice, mnemonic, err := keyring.CreateAccount("alice")
+	require.NoError(t, err)
+	require.Equal(t, "alice", alice.Name)
+	require.NotEmpty(t, mnemonic)
+
+	_, _, err = keyring.CreateAccount("alice")
+	require.ErrorIs(t, err, api.ErrAccountExists)
+
+	other, err := api.OpenKeyring(
+		api.WithKeyringHome(t.TempDir()),
+		api.WithKeyringBackend(api.KeyringMemory),
+	)
+	require.NoError(t, err)
+	imported, err := other.ImportAccount("alice", mnemonic, "")
+	require.NoError(t, err)
+
+	aliceAddress, err := alice.Address("mars")
+	require.NoError(t, err)
+	importedAddress, err := imported.Address("mars")
+	require.NoError(t, err)
+	require.Equal(t, aliceAddress, importedAddress, "accounts with the same mnemonic")
+
+	_, _, err = keyring.CreateAccount("bob")
+	require.NoError(t, err)
+
+	account, err := keyring.Account("alice")
+	require.NoError(t, err)
+	require.Equal(t, "alice", account.Name)
+
+	accounts, err := keyring.Accounts()
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+
+	require.NoError(t, keyring.DeleteAccount("alice"))
+	_, err = keyring.Account("alice")
+	require.ErrorIs(t, err, api.ErrAccountNotFound)
+	require.ErrorIs(t, keyring.DeleteAccount("alice"), api.ErrAccountNotFound)
+}
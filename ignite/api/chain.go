@@ -0,0 +1,211 @@
+package api
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/chaincmd"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+// Chain is a chain scaffolded with Ignite CLI.
+type Chain struct {
+	chain        *chain.Chain
+	cacheStorage cache.Storage
+}
+
+// ChainOption configures a chain.
+type ChainOption func(*[]chain.Option)
+
+// WithHome sets the home directory of the chain.
+func WithHome(path string) ChainOption {
+	return func(o *[]chain.Option) {
+		*o = append(*o, chain.HomePath(path))
+	}
+}
+
+// WithConfigFile sets the config file of the chain.
+func WithConfigFile(path string) ChainOption {
+	return func(o *[]chain.Option) {
+		*o = append(*o, chain.ConfigFile(path))
+	}
+}
+
+// WithChainID sets the ID of the chain.
+func WithChainID(id string) ChainOption {
+	return func(o *[]chain.Option) {
+		*o = append(*o, chain.ID(id))
+	}
+}
+
+// WithChainKeyringBackend sets the keyring backend of the binary of the chain
+// when the config doesn't set it.
+func WithChainKeyringBackend(backend KeyringBackend) ChainOption {
+	return func(o *[]chain.Option) {
+		*o = append(*o, chain.KeyringBackend(chaincmd.KeyringBackend(backend)))
+	}
+}
+
+// OpenChain opens the chain with its source code in path.
+func OpenChain(path string, options ...ChainOption) (*Chain, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var chainOptions []chain.Option
+	for _, apply := range options {
+		apply(&chainOptions)
+	}
+
+	c, err := chain.New(path, chainOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheStorage, err := newCacheStorage()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Chain{
+		chain:        c,
+		cacheStorage: cacheStorage,
+	}, nil
+}
+
+// ID returns the ID of the chain.
+func (c *Chain) ID() (string, error) {
+	return c.chain.ID()
+}
+
+// Name returns the name of the chain.
+func (c *Chain) Name() string {
+	return c.chain.Name()
+}
+
+// Home returns the home directory of the chain.
+func (c *Chain) Home() (string, error) {
+	return c.chain.Home()
+}
+
+// Binary returns the name of the binary of the chain.
+func (c *Chain) Binary() (string, error) {
+	return c.chain.Binary()
+}
+
+// Config returns the config of the chain.
+func (c *Chain) Config() (*Config, error) {
+	return c.chain.Config()
+}
+
+// Build builds the binary of the chain and installs it in output, or in the
+// Go bin directory when output is empty. The name of the binary is returned.
+func (c *Chain) Build(ctx context.Context, output string) (binary string, err error) {
+	return c.chain.Build(ctx, c.cacheStorage, output, false)
+}
+
+// Init initializes the home of the chain from its config, with its accounts.
+// The binary of the chain must be built.
+func (c *Chain) Init(ctx context.Context) error {
+	return c.chain.Init(ctx, true)
+}
+
+// ServeOption configures the serve of a chain.
+type ServeOption struct {
+	option chain.ServeOption
+}
+
+// ServeForceReset resets the state of the chain each time it's served.
+func ServeForceReset() ServeOption {
+	return ServeOption{chain.ServeForceReset()}
+}
+
+// ServeResetOnce resets the state of the chain the first time it's served.
+func ServeResetOnce() ServeOption {
+	return ServeOption{chain.ServeResetOnce()}
+}
+
+// ServeSkipProto skips the code generation from the proto files when the
+// chain is built.
+func ServeSkipProto() ServeOption {
+	return ServeOption{chain.ServeSkipProto()}
+}
+
+// ServeQuitOnFail stops serving the chain when it fails to build or start.
+func ServeQuitOnFail() ServeOption {
+	return ServeOption{chain.QuitOnFail()}
+}
+
+// Serve builds, initializes and starts the chain, the chain is rebuilt and
+// restarted when its source code changes. Serve returns when ctx is canceled.
+func (c *Chain) Serve(ctx context.Context, options ...ServeOption) error {
+	serveOptions := make([]chain.ServeOption, len(options))
+	for i, o := range options {
+		serveOptions[i] = o.option
+	}
+
+	return c.chain.Serve(ctx, c.cacheStorage, serveOptions...)
+}
+
+// GenerateTarget is a target of the code generation from the proto files.
+type GenerateTarget struct {
+	target chain.GenerateTarget
+}
+
+// GenerateGo generates the Go code of the chain.
+func GenerateGo() GenerateTarget {
+	return GenerateTarget{chain.GenerateGo()}
+}
+
+// GenerateTSClient generates the Typescript client in path, or in the path of
+// the config when path is empty.
+func GenerateTSClient(path string) GenerateTarget {
+	return GenerateTarget{chain.GenerateTSClient(path)}
+}
+
+// GenerateComposables generates the Vue 3 composables in path, or in the path
+// of the config when path is empty.
+func GenerateComposables(path string) GenerateTarget {
+	return GenerateTarget{chain.GenerateComposables(path)}
+}
+
+// GenerateHooks generates the React hooks in path, or in the path of the config
+// when path is empty.
+func GenerateHooks(path string) GenerateTarget {
+	return GenerateTarget{chain.GenerateHooks(path)}
+}
+
+// GenerateOpenAPI generates the OpenAPI spec in the path of the config.
+func GenerateOpenAPI() GenerateTarget {
+	return GenerateTarget{chain.GenerateOpenAPI()}
+}
+
+// GeneratePythonClient generates the Python client in path, or in the path of
+// the config when path is empty.
+func GeneratePythonClient(path string) GenerateTarget {
+	return GenerateTarget{chain.GeneratePythonClient(path)}
+}
+
+// GenerateRustClient generates the Rust client in path, or in the path of the
+// config when path is empty.
+func GenerateRustClient(path string) GenerateTarget {
+	return GenerateTarget{chain.GenerateRustClient(path)}
+}
+
+// GenerateDartClient generates the Dart client in path, or in the path of the
+// config when path is empty.
+func GenerateDartClient(path string) GenerateTarget {
+	return GenerateTarget{chain.GenerateDartClient(path)}
+}
+
+// Generate generates the code of the targets from the proto files of the chain.
+func (c *Chain) Generate(ctx context.Context, target GenerateTarget, additionalTargets ...GenerateTarget) error {
+	targets := make([]chain.GenerateTarget, len(additionalTargets))
+	for i, t := range additionalTargets {
+		targets[i] = t.target
+	}
+
+	return c.chain.Generate(ctx, c.cacheStorage, target.target, targets...)
+}
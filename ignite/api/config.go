@@ -0,0 +1,26 @@
+package api
+
+import "github.com/ignite/cli/ignite/chainconfig"
+
+// ErrConfigNotFound is returned when a chain has no config file.
+var ErrConfigNotFound = chainconfig.ErrConfigNotFound
+
+// Config is the config of a chain, in the latest version of the config file.
+type Config = chainconfig.Config
+
+// DefaultConfig returns the config of a chain with the default values.
+func DefaultConfig() *Config {
+	return chainconfig.DefaultConfig()
+}
+
+// ParseConfig parses and validates the config file in path, the config is
+// migrated to the latest version when the file has an older version.
+func ParseConfig(path string) (*Config, error) {
+	return chainconfig.ParseFile(path)
+}
+
+// LocateConfig returns the path of the config file of the chain in dir.
+// ErrConfigNotFound is returned when the chain has no config file.
+func LocateConfig(dir string) (string, error) {
+	return chainconfig.LocateDefault(dir)
+}
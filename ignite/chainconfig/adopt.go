@@ -0,0 +1,84 @@
+package chainconfig
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/ignite/cli/ignite/chainconfig/config"
+	v1 "github.com/ignite/cli/ignite/chainconfig/v1"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil/genesis"
+)
+
+const (
+	adoptValidatorAccount = "validator"
+	adoptFaucetAccount    = "faucet"
+)
+
+// Adopt returns a config to serve a development network for an existing chain.
+// The chain ID, the consensus params and the module params are read from the chain
+// genesis, and the validator and faucet accounts are funded with its staking denom.
+func Adopt(g *genesis.Genesis, binary string) (*Config, error) {
+	chainID, err := g.ChainID()
+	if err != nil {
+		return nil, fmt.Errorf("cannot read the chain ID from the genesis: %w", err)
+	}
+
+	denom, err := g.StakeDenom()
+	if err != nil {
+		return nil, fmt.Errorf("cannot read the staking denom from the genesis: %w", err)
+	}
+
+	moduleParams, err := g.ModuleParams()
+	if err != nil {
+		return nil, fmt.Errorf("cannot read the module params from the genesis: %w", err)
+	}
+
+	appState := make(map[string]interface{}, len(moduleParams))
+	for module, params := range moduleParams {
+		appState[module] = map[string]interface{}{"params": params}
+	}
+
+	genesisOverrides := map[string]interface{}{
+		"chain_id":  chainID,
+		"app_state": appState,
+	}
+
+	// Consensus params are optional in the genesis
+	if params, err := g.ConsensusParams(); err == nil {
+		genesisOverrides["consensus_params"] = params
+	}
+
+	faucetName := adoptFaucetAccount
+	c := &Config{
+		BaseConfig: config.BaseConfig{
+			Version: LatestVersion,
+			Build: config.Build{
+				Binary: filepath.Base(binary),
+				Proto:  config.DefaultBaseConfig().Build.Proto,
+			},
+			Accounts: []config.Account{
+				{
+					Name:  adoptValidatorAccount,
+					Coins: []string{fmt.Sprintf("200000000%s", denom)},
+				},
+				{
+					Name:  adoptFaucetAccount,
+					Coins: []string{fmt.Sprintf("100000000%s", denom)},
+				},
+			},
+			Faucet: config.Faucet{
+				Name:  &faucetName,
+				Coins: []string{fmt.Sprintf("100000%s", denom)},
+			},
+			Genesis: genesisOverrides,
+		},
+		Validators: []v1.Validator{
+			{
+				Name:   adoptValidatorAccount,
+				Bonded: fmt.Sprintf("100000000%s", denom),
+			},
+		},
+	}
+
+	return c, nil
+}
@@ -0,0 +1,60 @@
+package chainconfig_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil/genesis"
+)
+
+const adoptGenesis = `{
+  "chain_id": "mars-1",
+  "consensus_params": {
+    "block": {"max_bytes": "22020096", "max_gas": "-1", "time_iota_ms": "1000"}
+  },
+  "app_state": {
+    "auth": {"params": {"max_memo_characters": "256"}, "accounts": []},
+    "crisis": {"constant_fee": {"denom": "umars", "amount": "1000"}},
+    "staking": {"params": {"bond_denom": "umars", "max_validators": 100}},
+    "upgrade": {}
+  }
+}`
+
+func TestAdopt(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "genesis.json")
+	require.NoError(t, os.WriteFile(path, []byte(adoptGenesis), 0o644))
+
+	g, err := genesis.FromPath(path)
+	require.NoError(t, err)
+	defer g.Close()
+
+	// Act
+	cfg, err := chainconfig.Adopt(g, "./build/marsd")
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, "marsd", cfg.Build.Binary)
+	require.Equal(t, "100000000umars", cfg.Validators[0].Bonded)
+	require.Equal(t, []string{"100000umars"}, cfg.Faucet.Coins)
+	require.Equal(t, "mars-1", cfg.Genesis["chain_id"])
+	require.Equal(t, map[string]interface{}{
+		"auth":    map[string]interface{}{"params": map[string]interface{}{"max_memo_characters": "256"}},
+		"staking": map[string]interface{}{"params": map[string]interface{}{"bond_denom": "umars", "max_validators": int64(100)}},
+	}, cfg.Genesis["app_state"])
+	require.Contains(t, cfg.Genesis, "consensus_params")
+
+	// The adopted config must be a valid config file
+	var buf bytes.Buffer
+	require.NoError(t, yaml.NewEncoder(&buf).Encode(cfg))
+
+	parsed, err := chainconfig.Parse(&buf)
+	require.NoError(t, err)
+	require.Equal(t, cfg.Accounts, parsed.Accounts)
+}
@@ -24,6 +24,29 @@ var (
 	// The path is relative to the app's directory.
 	DefaultTSClientPath = "ts-client"
 
+	// DefaultComposablesPath defines the default relative path to use when generating the Vue composables.
+	// The path is relative to the app's directory.
+	DefaultComposablesPath = "vue/src/composables"
+
+	// DefaultHooksPath defines the default relative path to use when generating the React hooks.
+	// The path is relative to the app's directory.
+	DefaultHooksPath = "react/src/hooks"
+
+	// DefaultPythonClientPath defines the default relative path to use when generating the Python client.
+	// The path is relative to the app's directory.
+	DefaultPythonClientPath = "python-client"
+
+	// DefaultRustClientPath defines the default relative path to use when generating the Rust client.
+	// The path is relative to the app's directory.
+	DefaultRustClientPath = "rust-client"
+
+	// DefaultDartClientPath defines the default relative path to use when generating the Dart client.
+	// The path is relative to the app's directory.
+	DefaultDartClientPath = "dart-client"
+
+	// DefaultOpenAPIAddress defines the default address of the OpenAPI console served during chain serve.
+	DefaultOpenAPIAddress = "0.0.0.0:4501"
+
 	// LatestVersion defines the latest version of the config.
 	LatestVersion config.Version = 1
 
@@ -67,6 +90,65 @@ func TSClientPath(conf *Config) string {
 	return DefaultTSClientPath
 }
 
+// ComposablesPath returns the relative path to the Vue composables directory.
+// Path is relative to the app's directory.
+func ComposablesPath(conf *Config) string {
+	if path := strings.TrimSpace(conf.Client.Composables.Path); path != "" {
+		return filepath.Clean(path)
+	}
+
+	return DefaultComposablesPath
+}
+
+// HooksPath returns the relative path to the React hooks directory.
+// Path is relative to the app's directory.
+func HooksPath(conf *Config) string {
+	if path := strings.TrimSpace(conf.Client.Hooks.Path); path != "" {
+		return filepath.Clean(path)
+	}
+
+	return DefaultHooksPath
+}
+
+// PythonClientPath returns the relative path to the Python client directory.
+// Path is relative to the app's directory.
+func PythonClientPath(conf *Config) string {
+	if path := strings.TrimSpace(conf.Client.Python.Path); path != "" {
+		return filepath.Clean(path)
+	}
+
+	return DefaultPythonClientPath
+}
+
+// RustClientPath returns the relative path to the Rust client directory.
+// Path is relative to the app's directory.
+func RustClientPath(conf *Config) string {
+	if path := strings.TrimSpace(conf.Client.Rust.Path); path != "" {
+		return filepath.Clean(path)
+	}
+
+	return DefaultRustClientPath
+}
+
+// DartClientPath returns the relative path to the Dart client directory.
+// Path is relative to the app's directory.
+func DartClientPath(conf *Config) string {
+	if path := strings.TrimSpace(conf.Client.Dart.Path); path != "" {
+		return filepath.Clean(path)
+	}
+
+	return DefaultDartClientPath
+}
+
+// OpenAPIAddress returns the address of the OpenAPI console served during chain serve.
+func OpenAPIAddress(conf *Config) string {
+	if addr := strings.TrimSpace(conf.Client.OpenAPI.Address); addr != "" {
+		return addr
+	}
+
+	return DefaultOpenAPIAddress
+}
+
 // CreateConfigDir creates config directory if it is not created yet.
 func CreateConfigDir() error {
 	path, err := ConfigDirPath()
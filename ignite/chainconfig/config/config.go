@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/imdario/mergo"
 
@@ -42,16 +43,44 @@ type Account struct {
 	Address  string   `yaml:"address,omitempty"`
 	CoinType string   `yaml:"cointype,omitempty"`
 
+	// EthKey is the hex encoded private key of an Ethereum account imported
+	// as an eth_secp256k1 key, for EVM-enabled chains.
+	EthKey string `yaml:"eth_key,omitempty"`
+
 	// The RPCAddress off the chain that account is issued at.
 	RPCAddress string `yaml:"rpc_address,omitempty"`
 }
 
 // Build holds build configs.
 type Build struct {
-	Main    string   `yaml:"main,omitempty"`
-	Binary  string   `yaml:"binary,omitempty"`
+	Main   string `yaml:"main,omitempty"`
+	Binary string `yaml:"binary,omitempty"`
+
+	// LDFlags are the linker flags passed to the Go compiler.
+	// Each flag is a template that can reference the chain build values,
+	// for example: "-X main.Version={{.Version}}".
+	LDFlags []string `yaml:"ldflags,omitempty"`
+
+	// Tags are the Go build tags used to build the chain binary,
+	// for example: netgo, ledger or rocksdb.
+	Tags []string `yaml:"tags,omitempty"`
+
+	// CGO configures the cgo settings used to build the chain binary.
+	CGO CGO `yaml:"cgo,omitempty"`
+
+	Proto Proto `yaml:"proto"`
+}
+
+// CGO holds cgo build configs.
+type CGO struct {
+	// Enabled enables or disables cgo. When not set the Go default is used.
+	Enabled *bool `yaml:"enabled,omitempty"`
+
+	// CFlags are the flags passed to the C compiler.
+	CFlags []string `yaml:"cflags,omitempty"`
+
+	// LDFlags are the flags passed to the C linker.
 	LDFlags []string `yaml:"ldflags,omitempty"`
-	Proto   Proto    `yaml:"proto"`
 }
 
 // Proto holds proto build configs.
@@ -62,6 +91,29 @@ type Proto struct {
 	// ThirdPartyPath is the relative path of where the third party proto files are
 	// located that used by the app.
 	ThirdPartyPaths []string `yaml:"third_party_paths"`
+
+	// Modules configures the code generation of the proto packages of modules
+	// using custom proto options.
+	Modules []ProtoModule `yaml:"modules,omitempty"`
+}
+
+// ProtoModule holds the code generation configs of a module proto package.
+type ProtoModule struct {
+	// Package is the proto package of the module, for example "mars.mars".
+	Package string `yaml:"package"`
+
+	// IncludePaths are the relative paths of the proto files that define the
+	// custom options used by the module.
+	IncludePaths []string `yaml:"include_paths,omitempty"`
+
+	// GoOptions are the parameters of the Go code generator plugin.
+	GoOptions []string `yaml:"go_options,omitempty"`
+
+	// OpenAPIOptions are the parameters of the OpenAPI generator plugin.
+	OpenAPIOptions []string `yaml:"openapi_options,omitempty"`
+
+	// TSOptions are the options of the Typescript code generator plugin.
+	TSOptions []string `yaml:"ts_options,omitempty"`
 }
 
 // Client configures code generation for clients.
@@ -72,8 +124,23 @@ type Client struct {
 	// Vuex configures code generation for Vuex stores.
 	Vuex Typescript `yaml:"vuex,omitempty"`
 
+	// Composables configures code generation for Vue 3 composables.
+	Composables Typescript `yaml:"composables,omitempty"`
+
+	// Hooks configures code generation for React hooks.
+	Hooks Typescript `yaml:"hooks,omitempty"`
+
 	// OpenAPI configures OpenAPI spec generation for API.
 	OpenAPI OpenAPI `yaml:"openapi,omitempty"`
+
+	// Python configures code generation for Python Client.
+	Python Python `yaml:"python,omitempty"`
+
+	// Rust configures code generation for Rust Client.
+	Rust Rust `yaml:"rust,omitempty"`
+
+	// Dart configures code generation for Dart Client.
+	Dart Dart `yaml:"dart,omitempty"`
 }
 
 // TSClient configures code generation for Typescript Client.
@@ -91,6 +158,28 @@ type Vuex struct {
 // OpenAPI configures OpenAPI spec generation for API.
 type OpenAPI struct {
 	Path string `yaml:"path"`
+
+	// Address is the address of the OpenAPI console served with the
+	// generated spec during chain serve.
+	Address string `yaml:"address,omitempty"`
+}
+
+// Python configures code generation for Python Client.
+type Python struct {
+	// Path configures out location for generated Python Client code.
+	Path string `yaml:"path"`
+}
+
+// Rust configures code generation for Rust Client.
+type Rust struct {
+	// Path configures out location for generated Rust Client code.
+	Path string `yaml:"path"`
+}
+
+// Dart configures code generation for Dart Client.
+type Dart struct {
+	// Path configures out location for generated Dart Client code.
+	Path string `yaml:"path"`
 }
 
 // Faucet configuration.
@@ -112,8 +201,137 @@ type Faucet struct {
 
 	// Port number for faucet server to listen at.
 	Port int `yaml:"port,omitempty"`
+
+	// GRPCAddress is the host and port of the gRPC service of the faucet, the
+	// service is not served when empty.
+	GRPCAddress string `yaml:"grpc_address,omitempty"`
+
+	// Limits configures the limits of the faucet, they are persisted so they
+	// survive the restarts of the faucet.
+	Limits FaucetLimits `yaml:"limits,omitempty"`
+
+	// Challenge configures the CAPTCHA or the proof-of-work that the clients
+	// must solve before the faucet sends them coins.
+	Challenge FaucetChallenge `yaml:"challenge,omitempty"`
+
+	// LogFile is the file where the structured logs of the requests are
+	// appended as JSON lines, relative to the app path.
+	LogFile string `yaml:"log_file,omitempty"`
+
+	// Allowlist is the file of the addresses and of the client IPs or networks
+	// allowed to request coins, relative to the app path.
+	Allowlist string `yaml:"allowlist,omitempty"`
+
+	// Denylist is the file of the addresses and of the client IPs or networks
+	// denied by the faucet, relative to the app path.
+	Denylist string `yaml:"denylist,omitempty"`
+
+	// Batch configures the batches of transfers sent in single multi-send txs.
+	Batch FaucetBatch `yaml:"batch,omitempty"`
+
+	// Alerts configures the webhooks notified when the faucet account runs low
+	// on funds or when too many requests fail.
+	Alerts FaucetAlerts `yaml:"alerts,omitempty"`
+
+	// UI serves the web page of the faucet to request coins from a browser,
+	// it is enabled by default.
+	UI *bool `yaml:"ui,omitempty"`
+}
+
+// IsUIEnabled returns true when the web page of the faucet is served.
+func (f Faucet) IsUIEnabled() bool {
+	return isEnabled(f.UI)
+}
+
+// FaucetLimits configures the limits of the faucet.
+type FaucetLimits struct {
+	// IPRequests is the number of requests accepted from a client IP in IPWindow.
+	IPRequests int `yaml:"ip_requests,omitempty"`
+
+	// IPWindow is the window of IPRequests, for example "1h", one hour by default.
+	IPWindow string `yaml:"ip_window,omitempty"`
+
+	// AddressDailyCap holds the max amounts of coins sent to an address in 24 hours.
+	AddressDailyCap []string `yaml:"address_daily_cap,omitempty"`
+
+	// DailyCap holds the max amounts of coins sent by the faucet in 24 hours.
+	DailyCap []string `yaml:"daily_cap,omitempty"`
 }
 
+// FaucetBatch configures the batches of transfers of the faucet.
+type FaucetBatch struct {
+	// Interval is the time between the batches, for example "5s". The
+	// transfers are sent one by one when empty.
+	Interval string `yaml:"interval,omitempty"`
+
+	// MaxSize is the max number of transfers of a batch, 50 by default.
+	MaxSize int `yaml:"max_size,omitempty"`
+}
+
+// FaucetAlerts configures the alerts of the faucet.
+type FaucetAlerts struct {
+	// Interval is the time between the checks of the alerts, "1m" by default.
+	Interval string `yaml:"interval,omitempty"`
+
+	// MinBalances are the balances of the faucet account under which an
+	// alert fires, like "1000000token".
+	MinBalances []string `yaml:"min_balances,omitempty"`
+
+	// MaxErrorRate is the ratio of the requests that fail to be sent between
+	// two checks above which an alert fires, between 0 and 1.
+	MaxErrorRate float64 `yaml:"max_error_rate,omitempty"`
+
+	// Webhooks are notified when an alert fires and when it is resolved.
+	Webhooks []FaucetWebhook `yaml:"webhooks,omitempty"`
+}
+
+// FaucetWebhook is a webhook notified of the alerts of the faucet.
+type FaucetWebhook struct {
+	// URL of the webhook, it can reference environment variables.
+	URL string `yaml:"url"`
+
+	// Type is the payload format of the webhook, "slack", "discord" or
+	// "generic" by default.
+	Type string `yaml:"type,omitempty"`
+}
+
+// FaucetChallenge configures the challenges of the faucet.
+type FaucetChallenge struct {
+	// Captcha is the CAPTCHA service that verifies the clients, "hcaptcha" or "turnstile".
+	Captcha string `yaml:"captcha,omitempty"`
+
+	// CaptchaSiteKey is the public site key of the CAPTCHA widget.
+	CaptchaSiteKey string `yaml:"captcha_site_key,omitempty"`
+
+	// CaptchaSecret is the secret key that verifies the CAPTCHA tokens, it can
+	// reference environment variables.
+	CaptchaSecret string `yaml:"captcha_secret,omitempty"`
+
+	// PoWDifficulty is the number of leading zero bits of the proof-of-work.
+	PoWDifficulty int `yaml:"pow_difficulty,omitempty"`
+}
+
+const (
+	// CaptchaHCaptcha verifies the clients of the faucet with hCaptcha.
+	CaptchaHCaptcha = "hcaptcha"
+
+	// CaptchaTurnstile verifies the clients of the faucet with Cloudflare Turnstile.
+	CaptchaTurnstile = "turnstile"
+
+	// MaxPoWDifficulty is the max difficulty of the proof-of-work of the faucet,
+	// higher difficulties can't be solved by the browsers in a reasonable time.
+	MaxPoWDifficulty = 32
+
+	// WebhookGeneric receives the alerts of the faucet as JSON objects.
+	WebhookGeneric = "generic"
+
+	// WebhookSlack is a Slack incoming webhook.
+	WebhookSlack = "slack"
+
+	// WebhookDiscord is a Discord webhook.
+	WebhookDiscord = "discord"
+)
+
 // Init overwrites sdk configurations with given values.
 type Init struct {
 	// App overwrites appd's config/app.toml configs.
@@ -142,12 +360,234 @@ type Host struct {
 	API     string `yaml:"api"`
 }
 
+// Proxy configures the development proxy that exposes the API,
+// gRPC and gRPC-Web servers of the chain under a single address.
+type Proxy struct {
+	// Address is the address the proxy listens on. The proxy is disabled when empty.
+	Address string `yaml:"address,omitempty"`
+
+	// Auth holds the credentials required to access the proxied servers.
+	Auth ProxyAuth `yaml:"auth,omitempty"`
+
+	// RateLimit limits the requests accepted from each client IP.
+	RateLimit ProxyRateLimit `yaml:"rate_limit,omitempty"`
+
+	// TLS serves the proxy with a certificate issued by the project certificate authority.
+	TLS ProxyTLS `yaml:"tls,omitempty"`
+}
+
+// ProxyAuth holds the credentials accepted by the development proxy.
+// Values can reference environment variables, for example "${DEVNET_TOKEN}",
+// to keep secrets out of the config file.
+type ProxyAuth struct {
+	// Tokens are the accepted bearer tokens.
+	Tokens []string `yaml:"tokens,omitempty"`
+
+	// Users are the accepted basic authentication credentials.
+	Users []ProxyUser `yaml:"users,omitempty"`
+}
+
+// ProxyUser holds basic authentication credentials.
+type ProxyUser struct {
+	Name     string `yaml:"name"`
+	Password string `yaml:"password"`
+}
+
+// IsEnabled returns true when the proxy requires authentication.
+func (a ProxyAuth) IsEnabled() bool {
+	return len(a.Tokens) > 0 || len(a.Users) > 0
+}
+
+// ProxyRateLimit configures the requests accepted by the development proxy from each client IP.
+type ProxyRateLimit struct {
+	// RPS is the number of requests per second accepted from a client IP.
+	RPS float64 `yaml:"rps,omitempty"`
+
+	// Burst is the maximum number of requests accepted at once from a client IP.
+	// It defaults to RPS rounded up.
+	Burst int `yaml:"burst,omitempty"`
+
+	// Exempt are the URL path prefixes of the requests that are not rate limited.
+	Exempt []string `yaml:"exempt,omitempty"`
+}
+
+// IsEnabled returns true when the proxy limits the rate of the requests.
+func (l ProxyRateLimit) IsEnabled() bool {
+	return l.RPS > 0
+}
+
+// ProxyTLS configures the TLS of the development proxy. The certificates are
+// issued by a certificate authority created for the project.
+type ProxyTLS struct {
+	// Enabled serves the proxy with TLS.
+	Enabled bool `yaml:"enabled,omitempty"`
+
+	// Hosts are the host names and IPs of the node certificate in addition to
+	// localhost and the loopback IPs.
+	Hosts []string `yaml:"hosts,omitempty"`
+
+	// ClientAuth requires the clients to authenticate with a certificate issued
+	// by the project certificate authority.
+	ClientAuth bool `yaml:"client_auth,omitempty"`
+}
+
+const (
+	// WatchModeAuto detects source code changes with content hashes when the app is
+	// in a network or virtual file system and with modification times otherwise.
+	WatchModeAuto = "auto"
+
+	// WatchModeModTime detects source code changes with the file modification times.
+	WatchModeModTime = "modtime"
+
+	// WatchModeHash detects source code changes with the file content hashes.
+	WatchModeHash = "hash"
+)
+
+// Watch configures how the source code changes are detected when serving the chain.
+type Watch struct {
+	// Mode is the change detection mode, "auto" by default.
+	Mode string `yaml:"mode,omitempty"`
+
+	// Interval is the polling interval, for example "500ms" or "2s".
+	Interval string `yaml:"interval,omitempty"`
+}
+
+// IntervalDuration returns the polling interval or zero when it's not defined.
+func (w Watch) IntervalDuration() (time.Duration, error) {
+	if w.Interval == "" {
+		return 0, nil
+	}
+	return time.ParseDuration(w.Interval)
+}
+
+// Services enables or disables the services run with the chain when it's
+// served, all the services are enabled by default. The config files selected
+// with the --config flag act as profiles with their own services.
+type Services struct {
+	// Faucet enables the token faucet when a faucet account is configured.
+	Faucet *bool `yaml:"faucet,omitempty"`
+
+	// API enables the API server of the chain.
+	API *bool `yaml:"api,omitempty"`
+
+	// TSClientWatch enables the generation of the Typescript client and of
+	// the frontend code that uses it each time the source code changes.
+	TSClientWatch *bool `yaml:"ts_client_watch,omitempty"`
+}
+
+// IsFaucetEnabled returns true when the faucet is enabled.
+func (s Services) IsFaucetEnabled() bool {
+	return isEnabled(s.Faucet)
+}
+
+// IsAPIEnabled returns true when the API server is enabled.
+func (s Services) IsAPIEnabled() bool {
+	return isEnabled(s.API)
+}
+
+// IsTSClientWatchEnabled returns true when the Typescript client is generated
+// each time the source code changes.
+func (s Services) IsTSClientWatchEnabled() bool {
+	return isEnabled(s.TSClientWatch)
+}
+
+// isEnabled returns true when a service is enabled or not configured.
+func isEnabled(enabled *bool) bool {
+	return enabled == nil || *enabled
+}
+
+// Upgrade is a named on-chain upgrade of the blockchain.
+type Upgrade struct {
+	// Name is the upgrade name used in the software upgrade proposals.
+	Name string `yaml:"name"`
+
+	// Binaries are the download URLs of the upgraded app binaries indexed by
+	// platform, e.g. "linux/amd64". They follow the cosmovisor upgrade info
+	// format to allow the automatic download of the binaries.
+	Binaries map[string]string `yaml:"binaries,omitempty"`
+}
+
+const (
+	// OrderingOrdered relays the packets of a channel in order.
+	OrderingOrdered = "ordered"
+
+	// OrderingUnordered relays the packets of a channel in any order.
+	OrderingUnordered = "unordered"
+)
+
+// Relayer configures the IBC paths between the chain and counterparty chains
+// that are linked and relayed when the chain is served.
+type Relayer struct {
+	// Account is the name of the account of the Ignite CLI keyring that signs
+	// the relayer transactions on both chains, "default" by default.
+	Account string `yaml:"account,omitempty"`
+
+	// Paths are the IBC paths relayed when both chains are reachable.
+	Paths []RelayerPath `yaml:"paths,omitempty"`
+}
+
+// RelayerPath is an IBC path between the chain and a counterparty chain.
+type RelayerPath struct {
+	// ID is the ID of the path in the relayer config, the chain IDs of both
+	// chains joined with a dash by default.
+	ID string `yaml:"id,omitempty"`
+
+	// Port is the IBC port of the channel on the chain, "transfer" by default.
+	Port string `yaml:"port,omitempty"`
+
+	// Version is the version of the channel, "ics20-1" by default.
+	Version string `yaml:"version,omitempty"`
+
+	// Ordering is the ordering of the channel, "ordered" or "unordered" by default.
+	Ordering string `yaml:"ordering,omitempty"`
+
+	// GasPrice is the gas price of the relayer transactions on the chain, the
+	// gas price of the node by default.
+	GasPrice string `yaml:"gas_price,omitempty"`
+
+	// AddressPrefix is the address prefix of the chain, "cosmos" by default.
+	AddressPrefix string `yaml:"address_prefix,omitempty"`
+
+	// Counterparty is the chain at the other end of the path.
+	Counterparty RelayerCounterparty `yaml:"counterparty"`
+}
+
+// RelayerCounterparty is the counterparty chain of an IBC path.
+type RelayerCounterparty struct {
+	// RPC is the RPC address of the chain.
+	RPC string `yaml:"rpc"`
+
+	// GRPC is the gRPC address of the chain.
+	GRPC string `yaml:"grpc,omitempty"`
+
+	// Faucet is the address of a faucet that funds the relayer account.
+	Faucet string `yaml:"faucet,omitempty"`
+
+	// Port is the IBC port of the channel on the chain, the port of the path by default.
+	Port string `yaml:"port,omitempty"`
+
+	// Version is the version of the channel on the chain, the version of the path by default.
+	Version string `yaml:"version,omitempty"`
+
+	// GasPrice is the gas price of the relayer transactions on the chain, the
+	// gas price of the node by default.
+	GasPrice string `yaml:"gas_price,omitempty"`
+
+	// AddressPrefix is the address prefix of the chain, "cosmos" by default.
+	AddressPrefix string `yaml:"address_prefix,omitempty"`
+}
+
 // BaseConfig defines a struct with the fields that are common to all config versions.
 type BaseConfig struct {
 	Version  Version   `yaml:"version"`
 	Build    Build     `yaml:"build"`
 	Accounts []Account `yaml:"accounts"`
 	Faucet   Faucet    `yaml:"faucet,omitempty"`
+	Proxy    Proxy     `yaml:"proxy,omitempty"`
+	Watch    Watch     `yaml:"watch,omitempty"`
+	Services Services  `yaml:"services,omitempty"`
+	Upgrades []Upgrade `yaml:"upgrades,omitempty"`
+	Relayer  Relayer   `yaml:"relayer,omitempty"`
 	Client   Client    `yaml:"client,omitempty"`
 	Genesis  xyaml.Map `yaml:"genesis,omitempty"`
 }
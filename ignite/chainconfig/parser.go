@@ -2,12 +2,16 @@ package chainconfig
 
 import (
 	"bytes"
+	"fmt"
 	"io"
+	"net"
 	"os"
+	"strings"
 
 	"gopkg.in/yaml.v2"
 
 	"github.com/ignite/cli/ignite/chainconfig/config"
+	v1 "github.com/ignite/cli/ignite/chainconfig/v1"
 )
 
 // Parse reads a config file.
@@ -102,9 +106,191 @@ func validateConfig(c *Config) error {
 		if validator.Bonded == "" {
 			return &ValidationError{"validator 'bonded' is required"}
 		}
+
+		if err := validateSigner(validator.Signer); err != nil {
+			return err
+		}
+
+		if err := validateStateSync(validator); err != nil {
+			return err
+		}
+	}
+
+	if c.Proxy.Auth.IsEnabled() && c.Proxy.Address == "" {
+		return &ValidationError{"proxy 'address' is required when proxy 'auth' is set"}
+	}
+
+	for _, user := range c.Proxy.Auth.Users {
+		if user.Name == "" || user.Password == "" {
+			return &ValidationError{"proxy users 'name' and 'password' are required"}
+		}
+	}
+
+	if rl := c.Proxy.RateLimit; rl.RPS < 0 || rl.Burst < 0 {
+		return &ValidationError{"proxy rate limit 'rps' and 'burst' can't be negative"}
+	}
+
+	if c.Proxy.RateLimit.IsEnabled() && c.Proxy.Address == "" {
+		return &ValidationError{"proxy 'address' is required when proxy 'rate_limit' is set"}
+	}
+
+	if tls := c.Proxy.TLS; (tls.Enabled || tls.ClientAuth || len(tls.Hosts) > 0) && c.Proxy.Address == "" {
+		return &ValidationError{"proxy 'address' is required when proxy 'tls' is set"}
+	}
+
+	if tls := c.Proxy.TLS; !tls.Enabled && (tls.ClientAuth || len(tls.Hosts) > 0) {
+		return &ValidationError{"proxy tls 'enabled' is required when proxy tls 'hosts' or 'client_auth' are set"}
+	}
+
+	if l := c.Faucet.Limits; l.IPRequests < 0 {
+		return &ValidationError{"faucet limits 'ip_requests' can't be negative"}
+	}
+
+	switch c.Faucet.Challenge.Captcha {
+	case "", config.CaptchaHCaptcha, config.CaptchaTurnstile:
+	default:
+		return &ValidationError{fmt.Sprintf(
+			"faucet challenge 'captcha' must be %s or %s",
+			config.CaptchaHCaptcha,
+			config.CaptchaTurnstile,
+		)}
+	}
+
+	if ch := c.Faucet.Challenge; ch.Captcha != "" && ch.CaptchaSecret == "" {
+		return &ValidationError{"faucet challenge 'captcha_secret' is required when 'captcha' is set"}
+	}
+
+	if d := c.Faucet.Challenge.PoWDifficulty; d < 0 || d > config.MaxPoWDifficulty {
+		return &ValidationError{fmt.Sprintf("faucet challenge 'pow_difficulty' must be between 0 and %d", config.MaxPoWDifficulty)}
+	}
+
+	if r := c.Faucet.Alerts.MaxErrorRate; r < 0 || r > 1 {
+		return &ValidationError{"faucet alerts 'max_error_rate' must be between 0 and 1"}
+	}
+
+	for _, w := range c.Faucet.Alerts.Webhooks {
+		if w.URL == "" {
+			return &ValidationError{"faucet alerts webhook 'url' is required"}
+		}
+
+		switch w.Type {
+		case "", config.WebhookGeneric, config.WebhookSlack, config.WebhookDiscord:
+		default:
+			return &ValidationError{fmt.Sprintf(
+				"faucet alerts webhook 'type' must be %s, %s or %s",
+				config.WebhookGeneric,
+				config.WebhookSlack,
+				config.WebhookDiscord,
+			)}
+		}
+	}
+
+	packages := make(map[string]struct{})
+	for _, m := range c.Build.Proto.Modules {
+		if m.Package == "" {
+			return &ValidationError{"proto module 'package' is required"}
+		}
+		if _, ok := packages[m.Package]; ok {
+			return &ValidationError{fmt.Sprintf("proto module package %s is duplicated", m.Package)}
+		}
+		packages[m.Package] = struct{}{}
+	}
+
+	switch c.Watch.Mode {
+	case "", config.WatchModeAuto, config.WatchModeModTime, config.WatchModeHash:
+	default:
+		return &ValidationError{fmt.Sprintf(
+			"watch 'mode' must be one of %s, %s or %s",
+			config.WatchModeAuto,
+			config.WatchModeModTime,
+			config.WatchModeHash,
+		)}
+	}
+
+	if d, err := c.Watch.IntervalDuration(); err != nil || d < 0 {
+		return &ValidationError{"watch 'interval' must be a positive duration, for example 500ms"}
+	}
+
+	upgrades := make(map[string]struct{})
+	for _, upgrade := range c.Upgrades {
+		if upgrade.Name == "" {
+			return &ValidationError{"upgrade 'name' is required"}
+		}
+		if _, ok := upgrades[upgrade.Name]; ok {
+			return &ValidationError{fmt.Sprintf("upgrade '%s' is defined more than once", upgrade.Name)}
+		}
+		upgrades[upgrade.Name] = struct{}{}
+	}
+
+	paths := make(map[string]struct{})
+	for _, path := range c.Relayer.Paths {
+		if path.Counterparty.RPC == "" {
+			return &ValidationError{"relayer path counterparty 'rpc' is required"}
+		}
+
+		switch path.Ordering {
+		case "", config.OrderingOrdered, config.OrderingUnordered:
+		default:
+			return &ValidationError{fmt.Sprintf(
+				"relayer path 'ordering' must be %s or %s",
+				config.OrderingOrdered,
+				config.OrderingUnordered,
+			)}
+		}
+
+		if path.ID == "" {
+			continue
+		}
+		if _, ok := paths[path.ID]; ok {
+			return &ValidationError{fmt.Sprintf("relayer path '%s' is defined more than once", path.ID)}
+		}
+		paths[path.ID] = struct{}{}
 	}
 
 	// TODO: We should validate all of the required config fields
 
 	return nil
 }
+
+func validateStateSync(v v1.Validator) error {
+	if v.StateSync == nil {
+		return nil
+	}
+
+	if v.StateSync.SnapshotInterval == 0 {
+		return &ValidationError{"validator state_sync 'snapshot_interval' is required"}
+	}
+
+	// The snapshots are taken from the state of the node, the "everything"
+	// strategy prunes it before the snapshots are taken.
+	if pruning, _ := v.App["pruning"].(string); pruning == "everything" {
+		return &ValidationError{"validator state_sync requires a 'pruning' strategy other than 'everything'"}
+	}
+
+	return nil
+}
+
+func validateSigner(s *v1.Signer) error {
+	if s == nil {
+		return nil
+	}
+
+	switch s.Type {
+	case v1.SignerTypeTMKMS, v1.SignerTypeHorcrux:
+	default:
+		return &ValidationError{fmt.Sprintf("validator signer 'type' must be one of %s", strings.Join(v1.SignerTypes, ", "))}
+	}
+
+	addr := s.GetAddress()
+	switch {
+	case strings.HasPrefix(addr, "unix://") && len(addr) > len("unix://"):
+	case strings.HasPrefix(addr, "tcp://"):
+		if _, _, err := net.SplitHostPort(strings.TrimPrefix(addr, "tcp://")); err != nil {
+			return &ValidationError{fmt.Sprintf("invalid validator signer 'address' %s: %s", addr, err)}
+		}
+	default:
+		return &ValidationError{fmt.Sprintf("validator signer 'address' %s must start with tcp:// or unix://", addr)}
+	}
+
+	return nil
+}
@@ -70,3 +70,268 @@ func TestParseWithUnknownVersion(t *testing.T) {
 	require.NotNil(t, want)
 	require.Equal(t, want.Version, version)
 }
+
+func TestParseWithInvalidWatch(t *testing.T) {
+	cases := []struct {
+		name  string
+		watch string
+	}{
+		{"unknown mode", "watch:\n  mode: events\n"},
+		{"invalid interval", "watch:\n  interval: fast\n"},
+		{"negative interval", "watch:\n  interval: -1s\n"},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			r := strings.NewReader(fmt.Sprintf(
+				"version: 1\naccounts:\n  - name: alice\nvalidators:\n  - name: alice\n    bonded: 100stake\n%s",
+				tt.watch,
+			))
+
+			var want *chainconfig.ValidationError
+
+			// Act
+			_, err := chainconfig.Parse(r)
+
+			// Assert
+			require.ErrorAs(t, err, &want)
+		})
+	}
+}
+
+func TestParseWithInvalidProtoModules(t *testing.T) {
+	cases := []struct {
+		name  string
+		build string
+	}{
+		{"missing package", "build:\n  proto:\n    modules:\n      - go_options: [paths=source_relative]\n"},
+		{"duplicated package", "build:\n  proto:\n    modules:\n      - package: mars.mars\n      - package: mars.mars\n"},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			r := strings.NewReader(fmt.Sprintf(
+				"version: 1\naccounts:\n  - name: alice\nvalidators:\n  - name: alice\n    bonded: 100stake\n%s",
+				tt.build,
+			))
+
+			var want *chainconfig.ValidationError
+
+			// Act
+			_, err := chainconfig.Parse(r)
+
+			// Assert
+			require.ErrorAs(t, err, &want)
+		})
+	}
+}
+
+func TestParseWithInvalidProxyTLS(t *testing.T) {
+	cases := []struct {
+		name  string
+		proxy string
+	}{
+		{"missing address", "proxy:\n  tls:\n    enabled: true\n"},
+		{"client auth without tls", "proxy:\n  address: :8080\n  tls:\n    client_auth: true\n"},
+		{"hosts without tls", "proxy:\n  address: :8080\n  tls:\n    hosts: [devnet.example.com]\n"},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			r := strings.NewReader(fmt.Sprintf(
+				"version: 1\naccounts:\n  - name: alice\nvalidators:\n  - name: alice\n    bonded: 100stake\n%s",
+				tt.proxy,
+			))
+
+			var want *chainconfig.ValidationError
+
+			// Act
+			_, err := chainconfig.Parse(r)
+
+			// Assert
+			require.ErrorAs(t, err, &want)
+		})
+	}
+}
+
+func TestParseWithInvalidFaucetLimits(t *testing.T) {
+	// Arrange
+	r := strings.NewReader(
+		"version: 1\naccounts:\n  - name: alice\nvalidators:\n  - name: alice\n    bonded: 100stake\n" +
+			"faucet:\n  name: alice\n  limits:\n    ip_requests: -1\n",
+	)
+
+	var want *chainconfig.ValidationError
+
+	// Act
+	_, err := chainconfig.Parse(r)
+
+	// Assert
+	require.ErrorAs(t, err, &want)
+}
+
+func TestParseWithInvalidFaucetChallenge(t *testing.T) {
+	cases := []struct {
+		name      string
+		challenge string
+	}{
+		{"unknown captcha", "captcha: recaptcha\n    captcha_secret: secret\n"},
+		{"missing captcha secret", "captcha: turnstile\n"},
+		{"negative difficulty", "pow_difficulty: -1\n"},
+		{"too high difficulty", "pow_difficulty: 64\n"},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			r := strings.NewReader(
+				"version: 1\naccounts:\n  - name: alice\nvalidators:\n  - name: alice\n    bonded: 100stake\n" +
+					"faucet:\n  name: alice\n  challenge:\n    " + tt.challenge,
+			)
+
+			var want *chainconfig.ValidationError
+			_, err := chainconfig.Parse(r)
+			require.ErrorAs(t, err, &want)
+		})
+	}
+}
+
+func TestParseWithInvalidFaucetAlerts(t *testing.T) {
+	cases := []struct {
+		name   string
+		alerts string
+	}{
+		{"too high error rate", "max_error_rate: 1.5\n"},
+		{"missing webhook url", "webhooks:\n      - type: slack\n"},
+		{"unknown webhook type", "webhooks:\n      - url: https://example.com\n        type: teams\n"},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			r := strings.NewReader(
+				"version: 1\naccounts:\n  - name: alice\nvalidators:\n  - name: alice\n    bonded: 100stake\n" +
+					"faucet:\n  name: alice\n  alerts:\n    " + tt.alerts,
+			)
+
+			var want *chainconfig.ValidationError
+			_, err := chainconfig.Parse(r)
+			require.ErrorAs(t, err, &want)
+		})
+	}
+}
+
+func TestParseWithInvalidUpgrades(t *testing.T) {
+	cases := []struct {
+		name     string
+		upgrades string
+	}{
+		{"missing name", "upgrades:\n  - binaries:\n      linux/amd64: https://example.com\n"},
+		{"duplicated name", "upgrades:\n  - name: v2\n  - name: v2\n"},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			r := strings.NewReader(fmt.Sprintf(
+				"version: 1\naccounts:\n  - name: alice\nvalidators:\n  - name: alice\n    bonded: 100stake\n%s",
+				tt.upgrades,
+			))
+
+			var want *chainconfig.ValidationError
+
+			// Act
+			_, err := chainconfig.Parse(r)
+
+			// Assert
+			require.ErrorAs(t, err, &want)
+		})
+	}
+}
+
+func TestParseWithInvalidRelayerPaths(t *testing.T) {
+	cases := []struct {
+		name  string
+		paths string
+	}{
+		{"missing counterparty rpc", "  - port: transfer\n"},
+		{"unknown ordering", "  - ordering: random\n    counterparty:\n      rpc: http://localhost:26659\n"},
+		{
+			"duplicated id",
+			"  - id: mars-venus\n    counterparty:\n      rpc: http://localhost:26659\n" +
+				"  - id: mars-venus\n    counterparty:\n      rpc: http://localhost:26669\n",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			r := strings.NewReader(
+				"version: 1\naccounts:\n  - name: alice\nvalidators:\n  - name: alice\n    bonded: 100stake\n" +
+					"relayer:\n  paths:\n" + tt.paths,
+			)
+
+			var want *chainconfig.ValidationError
+			_, err := chainconfig.Parse(r)
+			require.ErrorAs(t, err, &want)
+		})
+	}
+}
+
+func TestParseWithInvalidSigner(t *testing.T) {
+	cases := []struct {
+		name   string
+		signer string
+	}{
+		{"missing type", "    signer:\n      address: tcp://127.0.0.1:26659\n"},
+		{"unknown type", "    signer:\n      type: vault\n"},
+		{"invalid scheme", "    signer:\n      type: tmkms\n      address: 127.0.0.1:26659\n"},
+		{"missing port", "    signer:\n      type: horcrux\n      address: tcp://127.0.0.1\n"},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			r := strings.NewReader(fmt.Sprintf(
+				"version: 1\naccounts:\n  - name: alice\nvalidators:\n  - name: alice\n    bonded: 100stake\n%s",
+				tt.signer,
+			))
+
+			var want *chainconfig.ValidationError
+
+			// Act
+			_, err := chainconfig.Parse(r)
+
+			// Assert
+			require.ErrorAs(t, err, &want)
+		})
+	}
+}
+
+func TestParseWithInvalidStateSync(t *testing.T) {
+	cases := []struct {
+		name      string
+		validator string
+	}{
+		{"missing snapshot interval", "    state_sync:\n      snapshot_keep_recent: 2\n"},
+		{"pruning everything", "    app:\n      pruning: everything\n    state_sync:\n      snapshot_interval: 100\n"},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			r := strings.NewReader(fmt.Sprintf(
+				"version: 1\naccounts:\n  - name: alice\nvalidators:\n  - name: alice\n    bonded: 100stake\n%s",
+				tt.validator,
+			))
+
+			var want *chainconfig.ValidationError
+
+			// Act
+			_, err := chainconfig.Parse(r)
+
+			// Assert
+			require.ErrorAs(t, err, &want)
+		})
+	}
+}
@@ -46,6 +46,11 @@ type Plugin struct {
 	Path string `yaml:"path"`
 	// With holds arguments passed to the plugin interface
 	With map[string]string `yaml:"with"`
+	// Generate holds the code generation targets of the plugin generated with
+	// the targets of the client config when the chain is built or served. The
+	// keys are the names of the targets, the values their output path, the
+	// default output path of a target is used when its value is empty.
+	Generate map[string]string `yaml:"generate,omitempty"`
 }
 
 func (c *Config) SetDefaults() error {
@@ -51,6 +51,10 @@ func TestConfigDecode(t *testing.T) {
 				Host:  "0.0.0.0:4600",
 				Port:  4600,
 			},
+			Services: config.Services{
+				Faucet:        &[]bool{false}[0],
+				TSClientWatch: &[]bool{false}[0],
+			},
 			Genesis: map[string]any{
 				"app_state": map[string]any{
 					"crisis": map[string]any{
@@ -84,6 +88,9 @@ func TestConfigDecode(t *testing.T) {
 		},
 	}
 	assert.Equal(expected, cfg)
+	assert.False(cfg.Services.IsFaucetEnabled())
+	assert.True(cfg.Services.IsAPIEnabled())
+	assert.False(cfg.Services.IsTSClientWatchEnabled())
 }
 
 func TestConfigValidatorDefaultServers(t *testing.T) {
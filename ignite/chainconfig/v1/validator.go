@@ -16,6 +16,8 @@ type Validator struct {
 	App xyaml.Map `yaml:"app,omitempty"`
 
 	// Config overwrites appd's config/config.toml configs.
+	// Keys are validated against the Tendermint or CometBFT version of the app,
+	// values that should not be validated can be defined inside an "extra" key.
 	Config xyaml.Map `yaml:"config,omitempty"`
 
 	// Client overwrites appd's config/client.toml configs.
@@ -29,6 +31,14 @@ type Validator struct {
 
 	// Gentx overwrites appd's config/gentx.toml configs.
 	Gentx *Gentx `yaml:"gentx,omitempty"`
+
+	// Signer configures a remote signer that signs the blocks of the validator
+	// instead of its local key.
+	Signer *Signer `yaml:"signer,omitempty"`
+
+	// StateSync configures the state snapshots served by the node to the nodes
+	// that join the network with state sync.
+	StateSync *StateSync `yaml:"state_sync,omitempty"`
 }
 
 // Gentx holds info related to Gentx settings.
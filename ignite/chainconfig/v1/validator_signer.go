@@ -0,0 +1,34 @@
+package v1
+
+const (
+	// SignerTypeTMKMS is the type of the Tendermint KMS remote signers.
+	SignerTypeTMKMS = "tmkms"
+
+	// SignerTypeHorcrux is the type of the Horcrux remote signers.
+	SignerTypeHorcrux = "horcrux"
+)
+
+// DefaultSignerAddress is the default address the node listens on for the
+// connection of the remote signer.
+var DefaultSignerAddress = "tcp://127.0.0.1:26659"
+
+// SignerTypes are the supported types of remote signers.
+var SignerTypes = []string{SignerTypeTMKMS, SignerTypeHorcrux}
+
+// Signer holds info related to the remote signer of a validator.
+type Signer struct {
+	// Type is the type of the remote signer, "tmkms" or "horcrux".
+	Type string `yaml:"type"`
+
+	// Address is the address the node listens on for the connection of the
+	// remote signer, it is written to the "priv_validator_laddr" of config.toml.
+	Address string `yaml:"address,omitempty"`
+}
+
+// GetAddress returns the address the node listens on for the remote signer.
+func (s Signer) GetAddress() string {
+	if s.Address == "" {
+		return DefaultSignerAddress
+	}
+	return s.Address
+}
@@ -0,0 +1,25 @@
+package v1
+
+// DefaultSnapshotKeepRecent is the default number of state snapshots kept by
+// the node.
+var DefaultSnapshotKeepRecent uint32 = 2
+
+// StateSync configures the state snapshots served by a validator node to the
+// nodes that join the network with state sync.
+type StateSync struct {
+	// SnapshotInterval is the number of blocks between the snapshots of the
+	// state, it is written to the "state-sync.snapshot-interval" of app.toml.
+	SnapshotInterval uint64 `yaml:"snapshot_interval"`
+
+	// SnapshotKeepRecent is the number of recent snapshots kept by the node,
+	// it is written to the "state-sync.snapshot-keep-recent" of app.toml.
+	SnapshotKeepRecent uint32 `yaml:"snapshot_keep_recent,omitempty"`
+}
+
+// GetSnapshotKeepRecent returns the number of recent snapshots kept by the node.
+func (s StateSync) GetSnapshotKeepRecent() uint32 {
+	if s.SnapshotKeepRecent == 0 {
+		return DefaultSnapshotKeepRecent
+	}
+	return s.SnapshotKeepRecent
+}
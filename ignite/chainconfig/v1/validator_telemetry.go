@@ -0,0 +1,63 @@
+package v1
+
+import (
+	"fmt"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+var (
+	// DefaultPrometheusAddress is the default address of the Prometheus metrics
+	// server of the node.
+	DefaultPrometheusAddress = ":26660"
+
+	// DefaultMetricsNamespace is the default namespace of the Tendermint metrics.
+	DefaultMetricsNamespace = "tendermint"
+)
+
+// Telemetry describes the metrics served by a validator node.
+type Telemetry struct {
+	// Instrumentation configures the Tendermint metrics served by the node.
+	Instrumentation Instrumentation `mapstructure:"instrumentation"`
+
+	// App configures the Cosmos SDK telemetry served by the API of the app.
+	App AppTelemetry `mapstructure:"telemetry"`
+}
+
+// Instrumentation configures the Tendermint metrics of a node.
+type Instrumentation struct {
+	// Prometheus is true when the node serves the metrics.
+	Prometheus bool `mapstructure:"prometheus"`
+
+	// PrometheusAddress is the address of the Prometheus metrics server.
+	PrometheusAddress string `mapstructure:"prometheus_listen_addr"`
+
+	// Namespace is the prefix of the names of the metrics.
+	Namespace string `mapstructure:"namespace"`
+}
+
+// AppTelemetry configures the Cosmos SDK telemetry of an app.
+type AppTelemetry struct {
+	// Enabled is true when the app serves the telemetry.
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// GetTelemetry returns the telemetry of the validator node from its config.
+func (v Validator) GetTelemetry() (Telemetry, error) {
+	t := Telemetry{
+		Instrumentation: Instrumentation{
+			PrometheusAddress: DefaultPrometheusAddress,
+			Namespace:         DefaultMetricsNamespace,
+		},
+	}
+
+	if err := mapstructure.WeakDecode(v.Config, &t); err != nil {
+		return Telemetry{}, fmt.Errorf("error reading validator config instrumentation: %w", err)
+	}
+
+	if err := mapstructure.WeakDecode(v.App, &t); err != nil {
+		return Telemetry{}, fmt.Errorf("error reading validator app telemetry: %w", err)
+	}
+
+	return t, nil
+}
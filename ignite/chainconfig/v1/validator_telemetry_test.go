@@ -0,0 +1,36 @@
+package v1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/ignite/cli/ignite/chainconfig/v1"
+)
+
+func TestValidatorGetTelemetry(t *testing.T) {
+	v := v1.Validator{
+		App: map[string]interface{}{
+			"telemetry": map[string]interface{}{"enabled": true},
+		},
+		Config: map[string]interface{}{
+			"instrumentation": map[string]interface{}{
+				"prometheus":             "true",
+				"prometheus_listen_addr": ":26661",
+			},
+		},
+	}
+
+	telemetry, err := v.GetTelemetry()
+	require.NoError(t, err)
+	require.True(t, telemetry.Instrumentation.Prometheus)
+	require.Equal(t, ":26661", telemetry.Instrumentation.PrometheusAddress)
+	require.Equal(t, v1.DefaultMetricsNamespace, telemetry.Instrumentation.Namespace)
+	require.True(t, telemetry.App.Enabled)
+
+	telemetry, err = v1.Validator{}.GetTelemetry()
+	require.NoError(t, err)
+	require.False(t, telemetry.Instrumentation.Prometheus)
+	require.Equal(t, v1.DefaultPrometheusAddress, telemetry.Instrumentation.PrometheusAddress)
+	require.False(t, telemetry.App.Enabled)
+}
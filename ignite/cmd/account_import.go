@@ -12,18 +12,32 @@ import (
 	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
 )
 
-const flagSecret = "secret"
+const (
+	flagSecret = "secret"
+	flagEthKey = "eth-key"
+)
 
 func NewAccountImport() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "import [name]",
 		Short: "Import an account by using a mnemonic or a private key",
-		Args:  cobra.ExactArgs(1),
-		RunE:  accountImportHandler,
+		Long: `Import an account by using a mnemonic or a private key.
+
+Use --eth-key to import the hex encoded private key of an Ethereum account,
+like the keys exported by Metamask, as an eth_secp256k1 key. These keys are
+used by the EVM-enabled chains, the address of the account is derived from the
+key like on Ethereum:
+
+  ignite account import alice --eth-key 0x4c08...2318 --address-prefix evmos
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: accountImportHandler,
 	}
 
 	c.Flags().String(flagSecret, "", "Your mnemonic or path to your private key (use interactive mode instead to securely pass your mnemonic)")
+	c.Flags().String(flagEthKey, "", "Hex encoded private key of an Ethereum account to import as an eth_secp256k1 key")
 	c.Flags().AddFlagSet(flagSetAccountImport())
+	c.Flags().AddFlagSet(flagSetAccountPrefixes())
 
 	return c
 }
@@ -32,8 +46,13 @@ func accountImportHandler(cmd *cobra.Command, args []string) error {
 	var (
 		name      = args[0]
 		secret, _ = cmd.Flags().GetString(flagSecret)
+		ethKey, _ = cmd.Flags().GetString(flagEthKey)
 	)
 
+	if ethKey != "" {
+		return accountImportEthKey(cmd, name, ethKey)
+	}
+
 	if secret == "" {
 		if err := cliquiz.Ask(
 			cliquiz.NewQuestion("Your mnemonic or path to your private key", &secret, cliquiz.Required())); err != nil {
@@ -74,3 +93,31 @@ func accountImportHandler(cmd *cobra.Command, args []string) error {
 	fmt.Printf("Account %q imported.\n", name)
 	return nil
 }
+
+func accountImportEthKey(cmd *cobra.Command, name, key string) error {
+	ca, err := cosmosaccount.New(
+		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
+		cosmosaccount.WithHome(getKeyringDir(cmd)),
+	)
+	if err != nil {
+		return err
+	}
+
+	acc, err := ca.ImportEthKey(name, key)
+	if err != nil {
+		return err
+	}
+
+	addr, err := acc.Address(getAddressPrefix(cmd))
+	if err != nil {
+		return err
+	}
+
+	ethAddr, err := acc.EthAddress()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Account %q imported with the address %s (%s).\n", name, addr, ethAddr)
+	return nil
+}
@@ -74,6 +74,19 @@ to send token from any other account that exists on chain.
 
 The "simulate" command helps you start a simulation testing process for your
 chain.
+
+The "deps" command helps you upgrade your chain's dependencies to a new Cosmos
+SDK version.
+
+The "certs" command manages the TLS certificates of the development proxy,
+issued by a certificate authority created for the project.
+
+The "genesis validate" command validates the state of each module of the
+genesis with the binary of the chain.
+
+The "registry publish" command writes the chain.json and assetlist.json files of
+the chain in a clone of the Cosmos chain registry and can open their pull
+request.
 `,
 		Aliases:           []string{"c"},
 		Args:              cobra.ExactArgs(1),
@@ -89,6 +102,18 @@ chain.
 	c.AddCommand(NewChainInit())
 	c.AddCommand(NewChainFaucet())
 	c.AddCommand(NewChainSimulate())
+	c.AddCommand(NewChainDeps())
+	c.AddCommand(NewChainTunnel())
+	c.AddCommand(NewChainAdopt())
+	c.AddCommand(NewChainCerts())
+	c.AddCommand(NewChainFeature())
+	c.AddCommand(NewChainCompatCheck())
+	c.AddCommand(NewChainAPIParity())
+	c.AddCommand(NewChainSign())
+	c.AddCommand(NewChainFixture())
+	c.AddCommand(NewChainSigner())
+	c.AddCommand(NewChainGenesis())
+	c.AddCommand(NewChainRegistry())
 
 	return c
 }
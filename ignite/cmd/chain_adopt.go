@@ -0,0 +1,113 @@
+package ignitecmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil/genesis"
+)
+
+const flagBinary = "binary"
+
+// NewChainAdopt returns a new command to create a config file for an existing blockchain.
+func NewChainAdopt() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "adopt",
+		Short: "Create a config file for an existing blockchain",
+		Long: `Create a config file for a blockchain that was not scaffolded with Ignite, so
+teams with existing blockchains can adopt the Ignite tooling incrementally.
+
+The command must be run in the source code directory of the blockchain. The
+chain ID, the consensus params and the params of every module are read from the
+genesis file of the blockchain and saved as genesis overrides in the config:
+
+  ignite chain adopt --genesis ./genesis.json --binary marsd
+
+A validator account and a faucet account funded with the staking denom of the
+blockchain are added to the config, so "ignite chain serve" starts a
+development network that uses the parameters of the existing blockchain, with
+the faucet and the accounts managed by Ignite.
+
+The binary is the name of the blockchain binary built from the source code.
+The generated config can be edited afterwards to add accounts or to change the
+overridden genesis values.`,
+		Args: cobra.NoArgs,
+		// The config file doesn't exist yet so it can't be migrated
+		PersistentPreRunE: func(*cobra.Command, []string) error { return nil },
+		RunE:              chainAdoptHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().String(flagGenesis, "", "Path to the genesis file of the blockchain")
+	c.Flags().String(flagBinary, "", "Name of the blockchain binary")
+
+	return c
+}
+
+func chainAdoptHandler(cmd *cobra.Command, _ []string) error {
+	var (
+		appPath        = flagGetPath(cmd)
+		configPath     = getConfig(cmd)
+		genesisPath, _ = cmd.Flags().GetString(flagGenesis)
+		binary, _      = cmd.Flags().GetString(flagBinary)
+	)
+
+	if genesisPath == "" {
+		return errors.New("the genesis file is required, use the --genesis flag")
+	}
+	if binary == "" {
+		return errors.New("the blockchain binary name is required, use the --binary flag")
+	}
+
+	session := cliui.New()
+	defer session.End()
+
+	if configPath == "" {
+		path, err := chainconfig.LocateDefault(appPath)
+		if err == nil {
+			return fmt.Errorf("the blockchain already has a config file: %s", path)
+		}
+		if !errors.Is(err, chainconfig.ErrConfigNotFound) {
+			return err
+		}
+
+		configPath = filepath.Join(appPath, chainconfig.ConfigFileNames[0])
+	} else if _, err := os.Stat(configPath); err == nil {
+		return fmt.Errorf("the config file already exists: %s", configPath)
+	}
+
+	g, err := genesis.FromPath(genesisPath)
+	if err != nil {
+		return err
+	}
+	defer g.Close()
+
+	cfg, err := chainconfig.Adopt(g, binary)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(configPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if err := yaml.NewEncoder(file).Encode(cfg); err != nil {
+		return err
+	}
+
+	session.Printf("%s Config file created: %s\n", icons.OK, colors.Info(configPath))
+	session.Println("\nStart a development network for the blockchain with:\n\n  ignite chain serve")
+
+	return nil
+}
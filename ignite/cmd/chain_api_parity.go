@@ -0,0 +1,137 @@
+package ignitecmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/apiparity"
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+)
+
+const (
+	flagParityGRPC = "grpc"
+	flagParityRPC  = "rpc"
+	flagParityAPI  = "api"
+)
+
+// NewChainAPIParity returns a command to check that the queries of a running
+// chain respond the same through gRPC, the Tendermint RPC and the REST API.
+func NewChainAPIParity() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "api-parity",
+		Short: "Check that the queries of a running chain are consistent across gRPC, RPC and REST",
+		Long: `Query the modules of the app through the gRPC server, the Tendermint RPC and the
+REST API of a running chain, and report the inconsistencies between the three
+transports before they reach the clients of the chain.
+
+Serve the chain with "ignite chain serve" and run in another terminal:
+
+  ignite chain api-parity
+
+The queries of the Query services of the modules of the app that don't need
+params, like the params and list queries, are sent through the three transports
+at the latest height of the chain. The list queries request a page of one item
+with the total of the items. The command reports:
+
+  - availability: a query that fails on some transports only
+  - payload: responses that differ between the transports
+  - pagination: paginations that differ between the transports
+  - field casing: JSON fields of the REST API not named after their proto field
+  - height: responses at a different height than the one requested
+
+The command fails when an inconsistency is found. The addresses of the servers
+are read from the config of the chain, use the "--grpc", "--rpc" and "--api"
+flags to check a chain served at other addresses.
+`,
+		Args: cobra.NoArgs,
+		RunE: chainAPIParityHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().String(flagParityGRPC, "", "address of the gRPC server (e.g. localhost:9090)")
+	c.Flags().String(flagParityRPC, "", "address of the Tendermint RPC (e.g. http://localhost:26657)")
+	c.Flags().String(flagParityAPI, "", "address of the REST API (e.g. http://localhost:1317)")
+
+	return c
+}
+
+func chainAPIParityHandler(cmd *cobra.Command, _ []string) error {
+	var (
+		grpcAddress, _ = cmd.Flags().GetString(flagParityGRPC)
+		rpcAddress, _  = cmd.Flags().GetString(flagParityRPC)
+		apiAddress, _  = cmd.Flags().GetString(flagParityAPI)
+		session        = cliui.New(cliui.StartSpinner())
+		ctx            = cmd.Context()
+		nInconsistent  int
+	)
+	defer session.End()
+
+	c, err := NewChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	set, err := c.ParitySet(ctx, cacheStorage)
+	if err != nil {
+		return err
+	}
+	if len(set.Queries) == 0 {
+		return errors.New("no queries to check in the modules of the app")
+	}
+
+	endpoints, err := c.ParityEndpoints()
+	if err != nil {
+		return err
+	}
+	if grpcAddress != "" {
+		endpoints.GRPC = grpcAddress
+	}
+	if rpcAddress != "" {
+		endpoints.RPC = rpcAddress
+	}
+	if apiAddress != "" {
+		endpoints.API = apiAddress
+	}
+
+	results, err := apiparity.New(endpoints).Check(ctx, set)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+	for _, r := range results {
+		if r.IsConsistent() {
+			session.Printf("%s %s\n", icons.OK, r.Query)
+			continue
+		}
+
+		nInconsistent++
+		session.Printf("%s %s\n", icons.NotOK, r.Query)
+		if r.Err != nil {
+			session.Printf("    %s\n", r.Err)
+		}
+		for _, m := range r.Mismatches {
+			session.Printf("    %s\n", m)
+		}
+	}
+	for _, s := range set.Skipped {
+		session.Printf("%s %s skipped: %s\n", icons.Info, s.Name, s.Reason)
+	}
+
+	if nInconsistent > 0 {
+		return fmt.Errorf("%d of %d queries are inconsistent across the transports", nInconsistent, len(results))
+	}
+	session.Println("\nThe queries are consistent across gRPC, RPC and REST")
+
+	return nil
+}
@@ -18,6 +18,7 @@ const (
 	flagRelease           = "release"
 	flagReleasePrefix     = "release.prefix"
 	flagReleaseTargets    = "release.targets"
+	flagReleaseSign       = "release.sign"
 )
 
 // NewChainBuild returns a new build command to build a blockchain app.
@@ -76,6 +77,10 @@ tags. If the optional --release.targets is not specified, a binary is created
 for your current environment.
 
   ignite chain build --release -t linux:amd64 -t darwin:amd64 -t darwin:arm64
+
+Use the --release.sign flag to sign the tarballs and the checksum file with the
+project key, the signatures are written next to them and can be verified with
+"ignite verify artifact".
 `,
 		Args: cobra.NoArgs,
 		RunE: chainBuildHandler,
@@ -89,6 +94,7 @@ for your current environment.
 	c.Flags().Bool(flagRelease, false, "build for a release")
 	c.Flags().StringSliceP(flagReleaseTargets, "t", []string{}, "release targets. Available only with --release flag")
 	c.Flags().String(flagReleasePrefix, "", "tarball prefix for each release target. Available only with --release flag")
+	c.Flags().Bool(flagReleaseSign, false, "sign the tarballs and the checksum file with the project key. Available only with --release flag")
 	c.Flags().StringP(flagOutput, "o", "", "binary output path")
 	c.Flags().BoolP("verbose", "v", false, "verbose output")
 
@@ -100,6 +106,7 @@ func chainBuildHandler(cmd *cobra.Command, _ []string) error {
 		isRelease, _      = cmd.Flags().GetBool(flagRelease)
 		releaseTargets, _ = cmd.Flags().GetStringSlice(flagReleaseTargets)
 		releasePrefix, _  = cmd.Flags().GetString(flagReleasePrefix)
+		releaseSign, _    = cmd.Flags().GetBool(flagReleaseSign)
 		output, _         = cmd.Flags().GetString(flagOutput)
 		session           = cliui.New(
 			cliui.WithVerbosity(getVerbosity(cmd)),
@@ -123,6 +130,13 @@ func chainBuildHandler(cmd *cobra.Command, _ []string) error {
 		chainOption = append(chainOption, chain.CheckDependencies())
 	}
 
+	// generate the code of the targets of the plugins with the chain
+	pluginTargets, err := pluginGenerateTargets()
+	if err != nil {
+		return err
+	}
+	chainOption = append(chainOption, chain.WithGenerateTargets(pluginTargets...))
+
 	c, err := NewChainWithHomeFlags(cmd, chainOption...)
 	if err != nil {
 		return err
@@ -139,6 +153,16 @@ func chainBuildHandler(cmd *cobra.Command, _ []string) error {
 			return err
 		}
 
+		if releaseSign {
+			artifacts, err := chain.ReleaseArtifacts(releasePath)
+			if err != nil {
+				return err
+			}
+			if _, err := c.SignArtifacts(artifacts...); err != nil {
+				return err
+			}
+		}
+
 		return session.Printf("🗃  Release created: %s\n", colors.Info(releasePath))
 	}
 
@@ -0,0 +1,40 @@
+package ignitecmd
+
+import "github.com/spf13/cobra"
+
+// NewChainCerts returns a command that groups sub commands related to
+// managing the TLS certificates of the chain.
+func NewChainCerts() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "certs [command]",
+		Short: "Manage the TLS certificates of the development proxy",
+		Long: `Ignite creates a certificate authority for each project and issues the
+certificates of the development proxy with it when the proxy is served with TLS:
+
+  proxy:
+    address: 0.0.0.0:8080
+    tls:
+      enabled: true
+      hosts:
+        - devnet.example.com
+      client_auth: true
+
+The proxy exposes the API, gRPC and gRPC-Web servers of the chain with the node
+certificate. The certificate is valid for localhost, the loopback IPs, the host
+of the proxy address and the hosts of the config. With "client_auth", the
+clients must authenticate with a certificate issued by the project certificate
+authority, like the client certificate issued along with the node certificate.
+
+The certificates are kept in Ignite's config directory, in "certs/{app}", so
+they aren't removed when the chain is reset. The clients trust the proxy by
+trusting the "ca.pem" certificate authority.
+
+The peer-to-peer connections of the nodes don't use these certificates, they
+are authenticated and encrypted with the node keys of Tendermint.`,
+		Args: cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(NewChainCertsRotate())
+
+	return c
+}
@@ -0,0 +1,66 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+const flagCA = "ca"
+
+// NewChainCertsRotate returns a new command to issue new TLS certificates for the chain.
+func NewChainCertsRotate() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "rotate",
+		Short: "Issue new node and client certificates",
+		Long: `Issue new node and client certificates with the project certificate authority.
+The certificate authority is created when it doesn't exist yet.
+
+The certificates are issued again automatically when the chain is served and
+the node certificate expires soon or doesn't match the hosts of the config.
+
+Use the "--ca" flag to create a new certificate authority too, for example when
+its key leaked. The clients must then trust the new certificate authority.
+
+Restart "ignite chain serve" to serve the new certificates.`,
+		Args: cobra.NoArgs,
+		RunE: chainCertsRotateHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().Bool(flagCA, false, "Create a new certificate authority too")
+
+	return c
+}
+
+func chainCertsRotateHandler(cmd *cobra.Command, _ []string) error {
+	rotateCA, _ := cmd.Flags().GetBool(flagCA)
+
+	session := cliui.New()
+	defer session.End()
+
+	var chainOption []chain.Option
+	if config := getConfig(cmd); config != "" {
+		chainOption = append(chainOption, chain.ConfigFile(config))
+	}
+
+	c, err := NewChainWithHomeFlags(cmd, chainOption...)
+	if err != nil {
+		return err
+	}
+
+	paths, err := c.RotateCerts(rotateCA)
+	if err != nil {
+		return err
+	}
+
+	if rotateCA {
+		session.Printf("%s New certificate authority: %s\n", icons.OK, paths.CA)
+	}
+	session.Printf("%s Node certificate: %s\n", icons.OK, paths.Node)
+	session.Printf("%s Client certificate: %s\n", icons.OK, paths.Client)
+
+	return nil
+}
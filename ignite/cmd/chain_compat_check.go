@@ -0,0 +1,148 @@
+package ignitecmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/apicompat"
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+)
+
+const (
+	flagRecord    = "record"
+	flagCompatDir = "dir"
+	flagQueryPath = "query"
+)
+
+// NewChainCompatCheck returns a command to check the backward compatibility of
+// the API of a running chain with golden files.
+func NewChainCompatCheck() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "compat-check",
+		Short: "Check the API of a running chain against golden files to catch breaking changes",
+		Long: `Lock in the JSON responses of the queries of the modules of the app in golden
+files, and replay the queries against a new build of the app to catch the
+changes that break the clients of the API between two releases.
+
+Serve the released version of the app with "ignite chain serve" and record the
+golden files with the "--record" flag:
+
+  ignite chain compat-check --record
+
+The golden files are written in the "testdata/compat" directory of the app, one
+file per query. Commit them with the source code. The queries of the modules of
+the app that don't have params in the path of their HTTP endpoint, like the
+params and list queries, are recorded. Use the "--query" flag to record the
+queries that have params:
+
+  ignite chain compat-check --record --query /mars/mars/post/0
+
+Once the app is changed, serve the new build and replay the queries:
+
+  ignite chain compat-check
+
+The command fails when a query fails, or when a field of a golden response is
+removed from the response or has a different JSON type. The values of the fields
+are not compared and new fields are backward compatible.
+`,
+		Args: cobra.NoArgs,
+		RunE: chainCompatCheckHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().Bool(flagRecord, false, "record the golden files from the responses of the running chain")
+	c.Flags().String(flagCompatDir, "", "directory of the golden files (default: testdata/compat)")
+	c.Flags().StringSlice(flagQueryPath, []string{}, "paths of additional queries to record (e.g. --query /mars/mars/post/0)")
+
+	return c
+}
+
+func chainCompatCheckHandler(cmd *cobra.Command, _ []string) error {
+	var (
+		record, _  = cmd.Flags().GetBool(flagRecord)
+		dir, _     = cmd.Flags().GetString(flagCompatDir)
+		paths, _   = cmd.Flags().GetStringSlice(flagQueryPath)
+		session    = cliui.New(cliui.StartSpinner())
+		ctx        = cmd.Context()
+		nBreakings int
+	)
+	defer session.End()
+
+	c, err := NewChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.APIClient()
+	if err != nil {
+		return err
+	}
+	dir = c.CompatDir(dir)
+
+	if record {
+		queries, err := c.CompatQueries(ctx)
+		if err != nil {
+			return err
+		}
+		for _, p := range paths {
+			queries = append(queries, apicompat.Query{
+				Module: "custom",
+				Name:   strings.ReplaceAll(strings.Trim(p, "/"), "/", "_"),
+				Path:   p,
+			})
+		}
+
+		results, err := client.Record(ctx, dir, queries)
+		if err != nil {
+			return err
+		}
+
+		session.StopSpinner()
+		for _, r := range results {
+			if r.Err != nil {
+				session.Printf("%s %s not recorded: %s\n", icons.NotOK, r.Query, r.Err)
+				continue
+			}
+			session.Printf("%s %s\n", icons.OK, r.Query)
+		}
+		session.Printf("\nThe golden files are written in %s\n", dir)
+		return nil
+	}
+
+	results, err := client.Check(ctx, dir)
+	if err != nil {
+		return err
+	}
+	if len(results) == 0 {
+		return fmt.Errorf("no golden files in %s, record them with the --record flag", dir)
+	}
+
+	session.StopSpinner()
+	for _, r := range results {
+		if !r.IsBreaking() {
+			session.Printf("%s %s\n", icons.OK, r.Query)
+			continue
+		}
+
+		nBreakings++
+		session.Printf("%s %s\n", icons.NotOK, r.Query)
+		if r.Err != nil {
+			session.Printf("    %s\n", r.Err)
+		}
+		for _, change := range r.Changes {
+			session.Printf("    %s\n", change)
+		}
+	}
+
+	if nBreakings > 0 {
+		return errors.New("the API has breaking changes")
+	}
+	session.Println("\nThe API is backward compatible")
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package ignitecmd
+
+import "github.com/spf13/cobra"
+
+// NewChainDeps returns a command that groups sub commands related to
+// managing the chain's dependencies.
+func NewChainDeps() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "deps [command]",
+		Short: "Manage the blockchain dependencies",
+		Args:  cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(NewChainDepsUpgrade())
+
+	return c
+}
@@ -0,0 +1,101 @@
+package ignitecmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/cosmosdeps"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+const flagSDK = "sdk"
+
+// NewChainDepsUpgrade returns a new command to upgrade the chain dependencies.
+func NewChainDepsUpgrade() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Upgrade the blockchain dependencies to a new Cosmos SDK version",
+		Long: fmt.Sprintf(`Upgrade the blockchain dependencies to a new Cosmos SDK version.
+
+The "go.mod" file is updated with the Cosmos SDK, ibc-go and Tendermint/CometBFT
+versions that are compatible with the new Cosmos SDK version, and the known
+source code modifications, like the import path changes, are applied for each
+Cosmos SDK version in between.
+
+Not every change can be done automatically, the steps that must be completed
+manually are reported at the end of the upgrade.
+
+  ignite chain deps upgrade --sdk v0.47.x
+
+The Cosmos SDK version can be a release line like "v0.47.x" or an exact
+version like "v0.47.1". Supported release lines: %s.
+`, strings.Join(cosmosdeps.Lines(), ", ")),
+		Args: cobra.NoArgs,
+		RunE: chainDepsUpgradeHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().String(flagSDK, "", "Cosmos SDK version to upgrade to (e.g. v0.47.x)")
+
+	return c
+}
+
+func chainDepsUpgradeHandler(cmd *cobra.Command, _ []string) error {
+	sdkVersion, _ := cmd.Flags().GetString(flagSDK)
+	if sdkVersion == "" {
+		return errors.New("the Cosmos SDK version is required, use the --sdk flag")
+	}
+
+	session := cliui.New(cliui.StartSpinner())
+	defer session.End()
+
+	if !getYes(cmd) {
+		if err := confirmWhenUncommittedChanges(session, flagGetPath(cmd)); err != nil {
+			return err
+		}
+	}
+
+	c, err := NewChainWithHomeFlags(cmd, chain.CollectEvents(session.EventBus()))
+	if err != nil {
+		return err
+	}
+
+	u, err := c.UpgradeDependencies(cmd.Context(), sdkVersion)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+	session.Printf("%s Cosmos SDK upgraded from %s to %s\n\n", icons.OK, u.From, colors.Info(u.To))
+
+	for _, change := range u.GoModChanges {
+		session.Printf("%sgo.mod: %s\n", modifyPrefix, change)
+	}
+
+	for _, file := range u.ModifiedFiles {
+		path, err := relativePath(file)
+		if err != nil {
+			return err
+		}
+
+		session.Printf("%s%s\n", modifyPrefix, path)
+	}
+
+	if len(u.ManualSteps) == 0 {
+		return nil
+	}
+
+	session.Printf("\n%s The following steps must be completed manually:\n\n", icons.Info)
+	for _, step := range u.ManualSteps {
+		session.Printf("%s %s\n", icons.Bullet, step)
+	}
+
+	return nil
+}
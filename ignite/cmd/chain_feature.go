@@ -0,0 +1,90 @@
+package ignitecmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/chaincmd"
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/chain"
+	"github.com/ignite/cli/ignite/services/scaffolder"
+)
+
+const flagDeposit = "deposit"
+
+// NewChainFeature returns a command that groups sub commands related to the
+// feature flags of the modules of a running chain.
+func NewChainFeature() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "feature [command]",
+		Short: "Enable or disable the feature flags of a running development chain",
+		Long: `The feature flags scaffolded with "ignite scaffold feature" are params of the
+modules, they are changed with param change proposals.
+
+The commands submit the proposal from the validator account of the chain served
+with "ignite chain serve" and vote yes on it. The feature is enabled or disabled
+when the voting period of the proposal ends. Shorten the voting period of the
+development chain in config.yml to change the feature flags quickly:
+
+  genesis:
+    app_state:
+      gov:
+        voting_params:
+          voting_period: 10s`,
+		Args: cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(NewChainFeatureEnable())
+	c.AddCommand(NewChainFeatureDisable())
+
+	return c
+}
+
+func flagSetChainFeature(c *cobra.Command) {
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().String(flagDeposit, "10000000stake", "Deposit of the param change proposal")
+}
+
+func chainFeatureHandler(cmd *cobra.Command, args []string, enabled bool) error {
+	var (
+		moduleName  = args[0]
+		featureName = args[1]
+		deposit, _  = cmd.Flags().GetString(flagDeposit)
+	)
+
+	session := cliui.New(cliui.StartSpinner())
+	defer session.End()
+
+	key, err := scaffolder.FeatureParamName(featureName)
+	if err != nil {
+		return err
+	}
+
+	c, err := NewChainWithHomeFlags(cmd, chain.KeyringBackend(chaincmd.KeyringBackendTest))
+	if err != nil {
+		return err
+	}
+
+	action := "Disable"
+	if enabled {
+		action = "Enable"
+	}
+	title := fmt.Sprintf("%s the %s feature of the %s module", action, featureName, moduleName)
+
+	id, err := c.ChangeParams(cmd.Context(), title, deposit, chain.ParamChange{
+		Subspace: moduleName,
+		Key:      key,
+		Value:    enabled,
+	})
+	if err != nil {
+		return err
+	}
+
+	session.Printf("%s Proposal %d submitted and voted: %s\n", icons.OK, id, title)
+	session.Println("The param changes when the voting period of the proposal ends.")
+
+	return nil
+}
@@ -0,0 +1,19 @@
+package ignitecmd
+
+import "github.com/spf13/cobra"
+
+// NewChainFeatureDisable returns a command to disable a feature flag of a module.
+func NewChainFeatureDisable() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "disable [module] [feature]",
+		Short: "Disable a feature of a module with a param change proposal",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return chainFeatureHandler(cmd, args, false)
+		},
+	}
+
+	flagSetChainFeature(c)
+
+	return c
+}
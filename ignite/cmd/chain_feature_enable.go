@@ -0,0 +1,19 @@
+package ignitecmd
+
+import "github.com/spf13/cobra"
+
+// NewChainFeatureEnable returns a command to enable a feature flag of a module.
+func NewChainFeatureEnable() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "enable [module] [feature]",
+		Short: "Enable a feature of a module with a param change proposal",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return chainFeatureHandler(cmd, args, true)
+		},
+	}
+
+	flagSetChainFeature(c)
+
+	return c
+}
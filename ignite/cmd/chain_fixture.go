@@ -0,0 +1,35 @@
+package ignitecmd
+
+import "github.com/spf13/cobra"
+
+// NewChainFixture returns a command that groups sub commands related to the
+// fixtures that reproduce the state of a development chain.
+func NewChainFixture() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "fixture [command]",
+		Short: "Export and run fixtures that reproduce a development chain anywhere",
+		Long: `A fixture is a self-contained archive of a development chain: the genesis, the
+keys and the config of the home of the chain, the config file, the hash of the
+binary of the chain and a scenario script.
+
+Export the fixture of a chain initialized with "ignite chain init" or "ignite
+chain serve":
+
+  ignite chain fixture export --scenario repro.sh
+
+Run the fixture on another machine or in CI with the same binary. The node of
+the chain starts from the genesis of the fixture and the scenario is run once
+the node is ready:
+
+  ignite chain fixture run mars-fixture.tar.gz
+
+The archive is deterministic, exporting the same chain twice produces the same
+bytes, so fixtures can be committed and compared.`,
+		Args: cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(NewChainFixtureExport())
+	c.AddCommand(NewChainFixtureRun())
+
+	return c
+}
@@ -0,0 +1,106 @@
+package ignitecmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+const (
+	flagFixtureOut      = "out"
+	flagFixtureScenario = "scenario"
+	flagFixtureSign     = "sign"
+)
+
+// NewChainFixtureExport returns a new command to export the fixture of a chain.
+func NewChainFixtureExport() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "export",
+		Short: "Export the genesis, keys, config and binary hash of the chain in an archive",
+		Long: `Export the fixture of the chain in a gzipped tar archive. The chain must be
+initialized and its keys must use the "test" keyring backend.
+
+The scenario of the fixture is the shell script of the "--scenario" flag. The
+script is run once the node of the fixture is ready, with the following
+environment variables:
+
+* FIXTURE_BINARY: path of the binary of the chain
+* FIXTURE_HOME: home of the chain
+* FIXTURE_CHAIN_ID: ID of the chain
+* FIXTURE_NODE: RPC address of the node
+
+  #!/bin/sh
+  set -e
+  "$FIXTURE_BINARY" tx bank send alice $BOB 10token --home "$FIXTURE_HOME" \
+    --chain-id "$FIXTURE_CHAIN_ID" --node "$FIXTURE_NODE" --keyring-backend test -y
+
+The state of the blocks of the chain is not exported, the fixture always starts
+the chain from its genesis and the scenario produces the state to reproduce.`,
+		Args: cobra.NoArgs,
+		RunE: chainFixtureExportHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().String(flagFixtureOut, "", "path of the archive (default: {chain-id}-fixture.tar.gz)")
+	c.Flags().String(flagFixtureScenario, "", "path of the scenario script run against the node of the fixture")
+	c.Flags().Bool(flagFixtureSign, false, "sign the archive with the project key")
+
+	return c
+}
+
+func chainFixtureExportHandler(cmd *cobra.Command, _ []string) error {
+	var (
+		out, _      = cmd.Flags().GetString(flagFixtureOut)
+		scenario, _ = cmd.Flags().GetString(flagFixtureScenario)
+		sign, _     = cmd.Flags().GetBool(flagFixtureSign)
+	)
+
+	session := cliui.New()
+	defer session.End()
+
+	var chainOption []chain.Option
+	if config := getConfig(cmd); config != "" {
+		chainOption = append(chainOption, chain.ConfigFile(config))
+	}
+
+	c, err := NewChainWithHomeFlags(cmd, chainOption...)
+	if err != nil {
+		return err
+	}
+
+	if out == "" {
+		id, err := c.ID()
+		if err != nil {
+			return err
+		}
+		out = fmt.Sprintf("%s-fixture.tar.gz", id)
+	}
+
+	// The archive is only written once the fixture is complete
+	var buf bytes.Buffer
+	if err := c.ExportFixture(&buf, scenario); err != nil {
+		return err
+	}
+	if err := os.WriteFile(out, buf.Bytes(), 0o600); err != nil {
+		return err
+	}
+
+	session.Printf("%s Fixture exported: %s\n", icons.OK, out)
+
+	if sign {
+		sigPaths, err := c.SignArtifacts(out)
+		if err != nil {
+			return err
+		}
+		session.Printf("%s Fixture signed: %s\n", icons.OK, sigPaths[0])
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package ignitecmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	uilog "github.com/ignite/cli/ignite/pkg/cliui/log"
+	"github.com/ignite/cli/ignite/pkg/fixture"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+const (
+	flagFixtureBinary        = "binary"
+	flagFixtureDir           = "dir"
+	flagFixtureSkipHashCheck = "skip-hash-check"
+	flagFixtureKeepRunning   = "keep-running"
+)
+
+// NewChainFixtureRun returns a new command to run a fixture.
+func NewChainFixtureRun() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "run [archive]",
+		Short: "Start the chain of a fixture and run its scenario",
+		Long: `Extract a fixture, start the node of its chain and run its scenario once the node
+is ready. The node is stopped when the scenario ends, the command fails when the
+scenario fails.
+
+The binary of the fixture is searched in PATH, use the "--binary" flag to run
+another build of the chain. The hash of the binary must match the hash of the
+binary of the fixture, unless the "--skip-hash-check" flag is used to check if
+another build reproduces the same state.
+
+The fixture is extracted in a temporary directory that is removed once the
+fixture is run. Use the "--dir" flag to keep the home of the chain.`,
+		Args: cobra.ExactArgs(1),
+		RunE: chainFixtureRunHandler,
+	}
+
+	c.Flags().String(flagFixtureBinary, "", "path of the binary of the chain (default: binary of the fixture in PATH)")
+	c.Flags().String(flagFixtureDir, "", "directory where the fixture is extracted")
+	c.Flags().Bool(flagFixtureSkipHashCheck, false, "run the fixture with a binary of a different hash")
+	c.Flags().Bool(flagFixtureKeepRunning, false, "keep the node running once the scenario is run")
+	c.Flags().BoolP("verbose", "v", false, "Verbose output")
+
+	return c
+}
+
+func chainFixtureRunHandler(cmd *cobra.Command, args []string) error {
+	var (
+		binary, _        = cmd.Flags().GetString(flagFixtureBinary)
+		dir, _           = cmd.Flags().GetString(flagFixtureDir)
+		skipHashCheck, _ = cmd.Flags().GetBool(flagFixtureSkipHashCheck)
+		keepRunning, _   = cmd.Flags().GetBool(flagFixtureKeepRunning)
+	)
+
+	session := cliui.New(cliui.WithVerbosity(getVerbosity(cmd)))
+	defer session.End()
+
+	if dir == "" {
+		tmp, err := os.MkdirTemp("", "fixture")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(tmp)
+		dir = tmp
+	}
+
+	f, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	m, err := fixture.Extract(f, dir)
+	if err != nil {
+		return err
+	}
+
+	scenario := session.NewOutput("scenario", 96)
+	options := []chain.FixtureRunOption{
+		chain.FixtureWithScenarioOutput(scenario.Stdout(), scenario.Stderr()),
+	}
+	// The output of the node is only printed in verbose mode
+	if session.Verbosity() == uilog.VerbosityVerbose {
+		node := session.NewOutput(m.Binary, 93)
+		options = append(options, chain.FixtureWithNodeOutput(node.Stdout(), node.Stderr()))
+	}
+	if binary != "" {
+		options = append(options, chain.FixtureWithBinary(binary))
+	}
+	if skipHashCheck {
+		options = append(options, chain.FixtureSkipHashCheck())
+	}
+	if keepRunning {
+		options = append(options, chain.FixtureKeepRunning())
+	}
+
+	session.Printf("%s Running the fixture of %s in %s\n", icons.Info, m.ChainID, dir)
+	if err := chain.RunFixture(cmd.Context(), dir, options...); err != nil {
+		return err
+	}
+
+	session.Printf("%s Scenario run\n", icons.OK)
+	return nil
+}
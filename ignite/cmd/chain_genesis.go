@@ -0,0 +1,17 @@
+package ignitecmd
+
+import "github.com/spf13/cobra"
+
+// NewChainGenesis returns a command that groups sub commands related to the
+// genesis of a development chain.
+func NewChainGenesis() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "genesis [command]",
+		Short: "Check the genesis of the chain against the modules of its binary",
+		Args:  cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(NewChainGenesisValidate())
+
+	return c
+}
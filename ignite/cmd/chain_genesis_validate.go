@@ -0,0 +1,84 @@
+package ignitecmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+// NewChainGenesisValidate returns a new command to validate the genesis of a chain.
+func NewChainGenesisValidate() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "validate [genesis-file]",
+		Short: "Validate the state of each module of the genesis with the built binary",
+		Long: `Validate the genesis of the chain with the ValidateGenesis of the modules of the
+binary of the chain, like the node does when it starts from the genesis. The
+genesis of the home of the chain is validated by default:
+
+  ignite chain genesis validate
+
+Validate another genesis file, like a genesis edited by hand or the genesis of
+a testnet, with the modules of the chain:
+
+  ignite chain genesis validate ./genesis.json
+
+The binary of the chain must be built with "ignite chain build" or "ignite chain
+serve". When the genesis is invalid, the section of each module is validated
+on its own with the default state of the other modules, and the modules with an
+invalid section are reported with the top level fields of the section that are
+invalid:
+
+  ✘ bank.balances: 1stake: invalid coins
+
+The modules can also be valid on their own but not together, for example when
+the gentxs are signed by accounts that have no balance. The error of the
+validation of the whole genesis is then reported.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: chainGenesisValidateHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+
+	return c
+}
+
+func chainGenesisValidateHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New(cliui.StartSpinnerWithText("Validating the genesis..."))
+	defer session.End()
+
+	var chainOption []chain.Option
+	if config := getConfig(cmd); config != "" {
+		chainOption = append(chainOption, chain.ConfigFile(config))
+	}
+
+	c, err := NewChainWithHomeFlags(cmd, chainOption...)
+	if err != nil {
+		return err
+	}
+
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	}
+
+	genesisErrs, err := c.ValidateGenesis(cmd.Context(), path)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+	if len(genesisErrs) == 0 {
+		return session.Println(icons.OK, "The genesis is valid")
+	}
+
+	for _, genesisErr := range genesisErrs {
+		session.Println(icons.NotOK, genesisErr.Error())
+	}
+
+	return errors.New("the genesis is invalid")
+}
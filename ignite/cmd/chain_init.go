@@ -2,6 +2,7 @@ package ignitecmd
 
 import (
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
 	"github.com/ignite/cli/ignite/pkg/chaincmd"
 	"github.com/ignite/cli/ignite/pkg/cliui"
@@ -9,6 +10,8 @@ import (
 	"github.com/ignite/cli/ignite/services/chain"
 )
 
+const flagReuseKeys = "reuse-keys"
+
 func NewChainInit() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "init",
@@ -54,6 +57,13 @@ Refer to config.yml guide to see which values you can set.
 One of these accounts is a validator account and the amount of self-delegated
 tokens can be set in the top-level "validator" property.
 
+The addresses generated for the accounts are saved in an address book, together
+with their mnemonics, so Ignite can warn you when a reset generates different
+addresses that your frontends or tests might still reference. To keep the same
+addresses, re-derive the account keys from the saved mnemonics:
+
+  ignite chain init --reuse-keys
+
 One of the most important components of an initialized chain is the genesis
 file, the 0th block of the chain. The genesis file is stored in the data
 directory "config" subdirectory and contains the initial state of the chain,
@@ -83,6 +93,7 @@ commands manually to ensure a production-level node initialization.
 	c.Flags().AddFlagSet(flagSetHome())
 	c.Flags().AddFlagSet(flagSetCheckDependencies())
 	c.Flags().AddFlagSet(flagSetSkipProto())
+	c.Flags().AddFlagSet(flagSetReuseKeys())
 
 	return c
 }
@@ -105,6 +116,10 @@ func chainInitHandler(cmd *cobra.Command, _ []string) error {
 		chainOption = append(chainOption, chain.CheckDependencies())
 	}
 
+	if flagGetReuseKeys(cmd) {
+		chainOption = append(chainOption, chain.ReuseAccountKeys())
+	}
+
 	c, err := NewChainWithHomeFlags(cmd, chainOption...)
 	if err != nil {
 		return err
@@ -130,3 +145,15 @@ func chainInitHandler(cmd *cobra.Command, _ []string) error {
 
 	return session.Printf("🗃  Initialized. Checkout your chain's home (data) directory: %s\n", colors.Info(home))
 }
+
+func flagSetReuseKeys() *flag.FlagSet {
+	usage := "re-derive the account keys from the mnemonics saved in the address book to keep the same addresses"
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Bool(flagReuseKeys, false, usage)
+	return fs
+}
+
+func flagGetReuseKeys(cmd *cobra.Command) (reuse bool) {
+	reuse, _ = cmd.Flags().GetBool(flagReuseKeys)
+	return
+}
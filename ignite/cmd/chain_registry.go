@@ -0,0 +1,147 @@
+package ignitecmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/chainregistry"
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+const (
+	flagRegistryDir         = "registry"
+	flagRegistryNetworkType = "network-type"
+	flagRegistryStatus      = "status"
+	flagRegistryPrettyName  = "pretty-name"
+	flagRegistryVersion     = "version"
+	flagRegistryGenesisURL  = "genesis-url"
+	flagRegistryRPC         = "rpc"
+	flagRegistryREST        = "rest"
+	flagRegistryGRPC        = "grpc"
+	flagRegistryPullRequest = "pull-request"
+)
+
+// NewChainRegistry returns a command that groups sub commands related to the
+// Cosmos chain registry.
+func NewChainRegistry() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "registry [command]",
+		Short: "Publish your chain to the Cosmos chain registry",
+		Args:  cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(NewChainRegistryPublish())
+
+	return c
+}
+
+// NewChainRegistryPublish returns a new command to write the files of a chain
+// in the Cosmos chain registry.
+func NewChainRegistryPublish() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "publish",
+		Short: "Write the chain.json and assetlist.json of your chain in the chain registry",
+		Long: `The publish command writes the chain.json and assetlist.json files of the chain
+in a clone of the Cosmos chain registry (https://github.com/cosmos/chain-registry),
+used by the wallets, the explorers and the relayers to find the chain.
+
+The chain ID, the bech32 prefix, the staking denom and the assets are read from
+the genesis of the chain, and the fee tokens from the minimum gas prices of its
+app.toml, the chain must be initialized with "ignite chain init". The public
+endpoints of the chain are set with flags:
+
+  ignite chain registry publish --registry ../chain-registry --rpc https://rpc.mars.example.com --rest https://api.mars.example.com
+
+The files of the testnets and the devnets are written in the testnets directory
+of the registry. With --pull-request, the files are committed in a new branch
+that is pushed to the origin remote of the registry and the pull request of the
+branch is opened with the GitHub CLI, the registry must be a clone of your fork
+of the chain registry.
+`,
+		Args: cobra.NoArgs,
+		RunE: chainRegistryPublishHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().String(flagRegistryDir, "", "directory of the clone of the chain registry (required)")
+	c.Flags().String(flagRegistryNetworkType, chainregistry.NetworkTypeTestnet, "network type of the chain, mainnet, testnet or devnet")
+	c.Flags().String(flagRegistryStatus, chainregistry.StatusLive, "status of the chain, live or upcoming")
+	c.Flags().String(flagRegistryPrettyName, "", "name of the chain displayed by the wallets and the explorers")
+	c.Flags().String(flagRegistryVersion, "", "recommended version of the binary of the chain")
+	c.Flags().String(flagRegistryGenesisURL, "", "URL of the genesis of the chain")
+	c.Flags().StringSlice(flagRegistryRPC, nil, "addresses of the public RPC endpoints of the chain")
+	c.Flags().StringSlice(flagRegistryREST, nil, "addresses of the public REST endpoints of the chain")
+	c.Flags().StringSlice(flagRegistryGRPC, nil, "addresses of the public gRPC endpoints of the chain")
+	c.Flags().Bool(flagRegistryPullRequest, false, "open the pull request of the files with the GitHub CLI")
+
+	return c
+}
+
+func chainRegistryPublishHandler(cmd *cobra.Command, _ []string) error {
+	var (
+		registry, _    = cmd.Flags().GetString(flagRegistryDir)
+		networkType, _ = cmd.Flags().GetString(flagRegistryNetworkType)
+		status, _      = cmd.Flags().GetString(flagRegistryStatus)
+		prettyName, _  = cmd.Flags().GetString(flagRegistryPrettyName)
+		version, _     = cmd.Flags().GetString(flagRegistryVersion)
+		genesisURL, _  = cmd.Flags().GetString(flagRegistryGenesisURL)
+		rpc, _         = cmd.Flags().GetStringSlice(flagRegistryRPC)
+		rest, _        = cmd.Flags().GetStringSlice(flagRegistryREST)
+		grpc, _        = cmd.Flags().GetStringSlice(flagRegistryGRPC)
+		pullRequest, _ = cmd.Flags().GetBool(flagRegistryPullRequest)
+	)
+	if registry == "" {
+		return errors.New("the directory of the chain registry is required, set --registry")
+	}
+
+	session := cliui.New(cliui.StartSpinnerWithText("Writing the files of the chain..."))
+	defer session.End()
+
+	var chainOption []chain.Option
+	if config := getConfig(cmd); config != "" {
+		chainOption = append(chainOption, chain.ConfigFile(config))
+	}
+
+	c, err := NewChainWithHomeFlags(cmd, chainOption...)
+	if err != nil {
+		return err
+	}
+
+	registryChain, assets, err := c.Registry(chain.RegistryOptions{
+		NetworkType: networkType,
+		Status:      status,
+		PrettyName:  prettyName,
+		Version:     version,
+		GenesisURL:  genesisURL,
+		RPC:         rpc,
+		REST:        rest,
+		GRPC:        grpc,
+	})
+	if err != nil {
+		return err
+	}
+
+	dir, err := chainregistry.Write(registry, registryChain, assets)
+	if err != nil {
+		return err
+	}
+
+	if !pullRequest {
+		session.StopSpinner()
+		return session.Printf("%s Files of %s written in %s\n", icons.OK, colors.Info(registryChain.ChainName), colors.Info(dir))
+	}
+
+	session.StartSpinner("Opening the pull request...")
+	branch, err := chainregistry.OpenPullRequest(cmd.Context(), registry, registryChain)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+	return session.Printf("%s Pull request of %s opened from branch %s\n", icons.OK, colors.Info(registryChain.ChainName), colors.Info(branch))
+}
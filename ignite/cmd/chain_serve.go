@@ -62,6 +62,7 @@ production, you may want to run "appd start" manually.
 	c.Flags().AddFlagSet(flagSetProto3rdParty(""))
 	c.Flags().AddFlagSet(flagSetCheckDependencies())
 	c.Flags().AddFlagSet(flagSetSkipProto())
+	c.Flags().AddFlagSet(flagSetReuseKeys())
 	c.Flags().BoolP("verbose", "v", false, "Verbose output")
 	c.Flags().BoolP(flagForceReset, "f", false, "Force reset of the app state on start and every source change")
 	c.Flags().BoolP(flagResetOnce, "r", false, "Reset of the app state on first start")
@@ -90,6 +91,10 @@ func chainServeHandler(cmd *cobra.Command, args []string) error {
 		chainOption = append(chainOption, chain.CheckDependencies())
 	}
 
+	if flagGetReuseKeys(cmd) {
+		chainOption = append(chainOption, chain.ReuseAccountKeys())
+	}
+
 	// check if custom config is defined
 	config, err := cmd.Flags().GetString(flagConfig)
 	if err != nil {
@@ -99,6 +104,13 @@ func chainServeHandler(cmd *cobra.Command, args []string) error {
 		chainOption = append(chainOption, chain.ConfigFile(config))
 	}
 
+	// generate the code of the targets of the plugins with the chain
+	pluginTargets, err := pluginGenerateTargets()
+	if err != nil {
+		return err
+	}
+	chainOption = append(chainOption, chain.WithGenerateTargets(pluginTargets...))
+
 	// create the chain
 	c, err := NewChainWithHomeFlags(cmd, chainOption...)
 	if err != nil {
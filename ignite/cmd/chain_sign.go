@@ -0,0 +1,78 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+)
+
+// NewChainSign returns a new command to sign the artifacts of the chain with
+// the project key.
+func NewChainSign() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "sign [artifact]...",
+		Short: "Sign the genesis, upgrade plans or other artifacts of the chain",
+		Long: `Hash and sign artifacts with the project key to keep an audit trail of the
+artifacts of the chain, like its exported genesis files, its upgrade plans and
+its release binaries. The genesis of the chain home is signed when no artifact
+is given:
+
+  ignite chain sign
+  ignite chain sign upgrades/v2.json
+
+The signature of an artifact is written next to it with the ".sig" extension.
+It holds the SHA-256 hash of the artifact, the time of the signature and the
+public key of the signer.
+
+The project key is created on first use in Ignite's config directory, in
+"notary/{app}", so it isn't removed when the chain is reset. Share the public
+key "key.pub.pem" with the auditors to verify the artifacts:
+
+  ignite verify artifact genesis.json --key key.pub.pem
+
+The release binaries are signed by "ignite chain build --release --release.sign"
+and the fixtures by "ignite chain fixture export --sign".`,
+		RunE: chainSignHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+
+	return c
+}
+
+func chainSignHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New()
+	defer session.End()
+
+	c, err := NewChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	artifacts := args
+	if len(artifacts) == 0 {
+		genesisPath, err := c.GenesisPath()
+		if err != nil {
+			return err
+		}
+		artifacts = []string{genesisPath}
+	}
+
+	sigPaths, err := c.SignArtifacts(artifacts...)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range sigPaths {
+		session.Printf("%s Signature: %s\n", icons.OK, p)
+	}
+
+	paths, err := c.NotaryKeyPaths()
+	if err != nil {
+		return err
+	}
+
+	return session.Printf("\nVerify the signatures with the public key: %s\n", paths.PublicKey)
+}
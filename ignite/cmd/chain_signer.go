@@ -0,0 +1,44 @@
+package ignitecmd
+
+import "github.com/spf13/cobra"
+
+// NewChainSigner returns a command that groups sub commands related to the
+// remote signers of the validator of a development chain.
+func NewChainSigner() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "signer [command]",
+		Short: "Test remote signers like tmkms and Horcrux with the validator of the chain",
+		Long: `A remote signer signs the blocks of a validator instead of the node, with a key
+that is kept outside of the node. Configure the remote signer of the validator
+in "config.yml":
+
+  validators:
+    - name: alice
+      bonded: 100000000stake
+      signer:
+        type: tmkms
+        address: tcp://127.0.0.1:26659
+
+The "type" of the signer is "tmkms" or "horcrux". The node listens on the
+"address" for the connection of the signer, it is written to the
+"priv_validator_laddr" of "config.toml" when the chain is initialized. The
+default address is tcp://127.0.0.1:26659.
+
+Once the chain is initialized, write the config of the signer from the key of
+the validator and start the signer before the node:
+
+  ignite chain signer config --out ./tmkms
+  tmkms start -c ./tmkms/tmkms.toml
+
+To sign with a threshold of Horcrux cosigners, run a local cluster with
+"ignite chain signer cluster".`,
+		Args: cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(
+		NewChainSignerConfig(),
+		NewChainSignerCluster(),
+	)
+
+	return c
+}
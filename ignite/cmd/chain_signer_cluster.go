@@ -0,0 +1,50 @@
+package ignitecmd
+
+import "github.com/spf13/cobra"
+
+const (
+	flagClusterDir = "dir"
+
+	defaultClusterDir = "horcrux-cluster"
+)
+
+// NewChainSignerCluster returns a command that groups sub commands to run a
+// local cluster of Horcrux cosigners that sign the blocks of the validator.
+func NewChainSignerCluster() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "cluster [command]",
+		Short: "Rehearse threshold signing with a local cluster of Horcrux cosigners",
+		Long: `Run a local cluster of Horcrux cosigners in threshold mode that sign the blocks
+of the validator of the chain. The key of the validator is split in one shard
+for each cosigner and a threshold of cosigners is needed to sign, 2 of 3 by
+default.
+
+The signer of the validator must be "horcrux" in "config.yml". Write the homes
+of the cosigners, start them and serve the chain:
+
+  ignite chain signer cluster init
+  ignite chain signer cluster start
+  ignite chain serve
+
+To rehearse the failover of a cosigner, start each cosigner in its own terminal
+and stop one of them, the others keep signing:
+
+  ignite chain signer cluster start --cosigners 1
+
+To rehearse the resharing of the key, stop the cluster and deal new shards,
+e.g. 3 of 5, the sign states of the cosigners are kept:
+
+  ignite chain signer cluster init --threshold 3 --shards 5
+
+The horcrux binary must be installed, see
+https://github.com/strangelove-ventures/horcrux.`,
+		Args: cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(
+		NewChainSignerClusterInit(),
+		NewChainSignerClusterStart(),
+	)
+
+	return c
+}
@@ -0,0 +1,80 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/remotesigner"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+const (
+	flagClusterThreshold = "threshold"
+	flagClusterShards    = "shards"
+	flagClusterPort      = "port"
+)
+
+// NewChainSignerClusterInit returns a new command to write the homes of the
+// cosigners of a Horcrux cluster.
+func NewChainSignerClusterInit() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "init",
+		Short: "Split the key of the validator in shards and write the homes of the cosigners",
+		Long: `Split the key of the validator of the chain in one shard for each cosigner and
+write the homes of the cosigners, "cosigner_1", "cosigner_2" and so on. The
+chain must be initialized.
+
+The cosigners listen on consecutive ports from the port of the first cosigner
+and connect to the "priv_validator_laddr" of the node.
+
+Run the command again to deal new shards of the key, the sign states of the
+cosigners are kept and the cosigners that are not part of the cluster anymore
+are removed.`,
+		Args: cobra.NoArgs,
+		RunE: chainSignerClusterInitHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().String(flagClusterDir, defaultClusterDir, "directory of the homes of the cosigners")
+	c.Flags().Int(flagClusterThreshold, remotesigner.DefaultClusterThreshold, "number of cosigners needed to sign")
+	c.Flags().Int(flagClusterShards, remotesigner.DefaultClusterShards, "number of cosigners")
+	c.Flags().Int(flagClusterPort, remotesigner.DefaultCosignerPort, "P2P port of the first cosigner")
+
+	return c
+}
+
+func chainSignerClusterInitHandler(cmd *cobra.Command, _ []string) error {
+	var (
+		dir, _       = cmd.Flags().GetString(flagClusterDir)
+		threshold, _ = cmd.Flags().GetInt(flagClusterThreshold)
+		shards, _    = cmd.Flags().GetInt(flagClusterShards)
+		port, _      = cmd.Flags().GetInt(flagClusterPort)
+	)
+
+	session := cliui.New(cliui.StartSpinnerWithText("Splitting the key of the validator..."))
+	defer session.End()
+
+	var chainOption []chain.Option
+	if config := getConfig(cmd); config != "" {
+		chainOption = append(chainOption, chain.ConfigFile(config))
+	}
+
+	c, err := NewChainWithHomeFlags(cmd, chainOption...)
+	if err != nil {
+		return err
+	}
+
+	err = c.WriteSignerCluster(cmd.Context(), dir, remotesigner.ClusterOptions{
+		Threshold: threshold,
+		Shards:    shards,
+		Port:      port,
+	})
+	if err != nil {
+		return err
+	}
+
+	session.Printf("%s %d of %d Horcrux cluster written: %s\n", icons.OK, threshold, shards, dir)
+	return nil
+}
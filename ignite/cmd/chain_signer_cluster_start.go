@@ -0,0 +1,39 @@
+package ignitecmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/remotesigner"
+)
+
+const flagClusterCosigners = "cosigners"
+
+// NewChainSignerClusterStart returns a new command to start the cosigners of
+// a Horcrux cluster.
+func NewChainSignerClusterStart() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "start",
+		Short: "Start the cosigners of the Horcrux cluster",
+		Long: `Start the cosigners of the Horcrux cluster until the command is stopped, all of
+them or the cosigners with the shard IDs of the --cosigners flag. The logs of
+the cosigners are prefixed with their shard ID.`,
+		Args: cobra.NoArgs,
+		RunE: chainSignerClusterStartHandler,
+	}
+
+	c.Flags().String(flagClusterDir, defaultClusterDir, "directory of the homes of the cosigners")
+	c.Flags().IntSlice(flagClusterCosigners, nil, "shard IDs of the cosigners to start (default: all)")
+
+	return c
+}
+
+func chainSignerClusterStartHandler(cmd *cobra.Command, _ []string) error {
+	var (
+		dir, _       = cmd.Flags().GetString(flagClusterDir)
+		cosigners, _ = cmd.Flags().GetIntSlice(flagClusterCosigners)
+	)
+
+	return remotesigner.StartHorcruxCluster(cmd.Context(), dir, cosigners, os.Stdout, os.Stderr)
+}
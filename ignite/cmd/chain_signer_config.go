@@ -0,0 +1,86 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+const (
+	flagSignerType = "type"
+	flagSignerOut  = "out"
+)
+
+// NewChainSignerConfig returns a new command to write the config of the remote
+// signer of the validator of a chain.
+func NewChainSignerConfig() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "config",
+		Short: "Write the config of a tmkms or Horcrux signer from the key of the validator",
+		Long: `Write the config of the remote signer of the validator of the chain. The chain
+must be initialized and the validator must have a "signer" in "config.yml".
+
+For tmkms, the directory contains "tmkms.toml", the key of the validator for
+the softsign provider and a new identity key of the signer:
+
+  tmkms start -c ./tmkms/tmkms.toml
+
+For Horcrux, the directory is the home of a signer in single sign mode with the
+key of the validator:
+
+  horcrux start --home ./horcrux
+
+The key of the validator is written unencrypted, these configs are meant for
+development chains only.`,
+		Args: cobra.NoArgs,
+		RunE: chainSignerConfigHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().String(flagSignerType, "", "type of the signer, tmkms or horcrux (default: the signer type of the validator)")
+	c.Flags().String(flagSignerOut, "", "directory of the signer config (default: ./{type})")
+
+	return c
+}
+
+func chainSignerConfigHandler(cmd *cobra.Command, _ []string) error {
+	var (
+		signerType, _ = cmd.Flags().GetString(flagSignerType)
+		out, _        = cmd.Flags().GetString(flagSignerOut)
+	)
+
+	session := cliui.New()
+	defer session.End()
+
+	var chainOption []chain.Option
+	if config := getConfig(cmd); config != "" {
+		chainOption = append(chainOption, chain.ConfigFile(config))
+	}
+
+	c, err := NewChainWithHomeFlags(cmd, chainOption...)
+	if err != nil {
+		return err
+	}
+
+	if out == "" {
+		conf, err := c.Config()
+		if err != nil {
+			return err
+		}
+		out = signerType
+		if out == "" && conf.Validators[0].Signer != nil {
+			out = conf.Validators[0].Signer.Type
+		}
+	}
+
+	signerType, err = c.WriteSignerConfig(out, signerType)
+	if err != nil {
+		return err
+	}
+
+	session.Printf("%s %s config written: %s\n", icons.OK, signerType, out)
+	return nil
+}
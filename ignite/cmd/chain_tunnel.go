@@ -0,0 +1,102 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/sshtunnel"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+const (
+	flagSSHPort    = "ssh-port"
+	flagBind       = "bind"
+	flagFromRemote = "from-remote"
+)
+
+// NewChainTunnel returns a new command to share the blockchain servers through SSH tunnels.
+func NewChainTunnel() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "tunnel [user@host]",
+		Short: "Share the blockchain servers through SSH tunnels",
+		Long: `Share the Tendermint RPC, the API and the faucet of a blockchain started with
+"ignite chain serve" through SSH tunnels, to demo a local blockchain to
+teammates without deploying it.
+
+The ports are forwarded using the "ssh" command of the system, so the SSH
+configuration, keys and agent of the user are used to connect to the host:
+
+  ignite chain tunnel alice@example.com
+
+By default the local ports are exposed on the remote host using reverse
+tunnels. Binding the forwarded ports to an address other than localhost
+requires the "GatewayPorts" option to be enabled in the SSH server of the
+remote host.
+
+When the blockchain runs on the remote host, use the "--from-remote" flag to
+forward the remote ports to the local machine instead:
+
+  ignite chain tunnel alice@example.com --from-remote
+
+The ports are read from the blockchain config, the tunnels stay open until the
+command is stopped.`,
+		Args: cobra.ExactArgs(1),
+		RunE: chainTunnelHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().Int(flagSSHPort, 0, "SSH port of the remote host")
+	c.Flags().String(flagBind, sshtunnel.DefaultBindAddress, "Address where the forwarded ports listen")
+	c.Flags().Bool(flagFromRemote, false, "Forward the ports of the remote host to the local machine")
+
+	return c
+}
+
+func chainTunnelHandler(cmd *cobra.Command, args []string) error {
+	var (
+		sshPort, _    = cmd.Flags().GetInt(flagSSHPort)
+		bind, _       = cmd.Flags().GetString(flagBind)
+		fromRemote, _ = cmd.Flags().GetBool(flagFromRemote)
+	)
+
+	session := cliui.New(cliui.StartSpinner())
+	defer session.End()
+
+	var chainOption []chain.Option
+	if config := getConfig(cmd); config != "" {
+		chainOption = append(chainOption, chain.ConfigFile(config))
+	}
+
+	c, err := NewChainWithHomeFlags(cmd, chainOption...)
+	if err != nil {
+		return err
+	}
+
+	forwards, err := c.TunnelForwards()
+	if err != nil {
+		return err
+	}
+
+	tunnel := sshtunnel.Tunnel{
+		Target:      args[0],
+		Port:        sshPort,
+		BindAddress: bind,
+		FromRemote:  fromRemote,
+		Forwards:    forwards,
+	}
+	if err := tunnel.Validate(); err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+	session.Printf("%s Opening tunnels to %s\n\n", icons.OK, colors.Info(tunnel.Target))
+	for _, f := range tunnel.Forwards {
+		session.Printf("%s %s: %s\n", icons.Bullet, f.Name, tunnel.URL(f))
+	}
+	session.Println("\nPress Ctrl+C to close the tunnels")
+
+	return tunnel.Run(cmd.Context())
+}
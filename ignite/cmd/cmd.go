@@ -2,6 +2,7 @@ package ignitecmd
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -24,6 +25,7 @@ import (
 	"github.com/ignite/cli/ignite/pkg/xgenny"
 	"github.com/ignite/cli/ignite/services/chain"
 	"github.com/ignite/cli/ignite/services/scaffolder"
+	"github.com/ignite/cli/ignite/services/templatepack"
 	"github.com/ignite/cli/ignite/version"
 )
 
@@ -34,6 +36,10 @@ const (
 	flagYes           = "yes"
 	flagClearCache    = "clear-cache"
 	flagSkipProto     = "skip-proto"
+	flagTemplate      = "template"
+	flagDryRun        = "dry-run"
+	flagPlan          = "plan"
+	flagAppWiring     = "app-wiring"
 
 	checkVersionTimeout = time.Millisecond * 600
 	cacheFileName       = "ignite_cache.db"
@@ -72,14 +78,19 @@ ignite scaffold chain github.com/username/mars`,
 	c.AddCommand(NewScaffold())
 	c.AddCommand(NewChain())
 	c.AddCommand(NewGenerate())
+	c.AddCommand(NewWorkspace())
 	c.AddCommand(NewNetwork())
 	c.AddCommand(NewNode())
+	c.AddCommand(NewTestnet())
 	c.AddCommand(NewAccount())
 	c.AddCommand(NewRelayer())
 	c.AddCommand(NewTools())
+	c.AddCommand(NewDoctor())
 	c.AddCommand(NewDocs())
 	c.AddCommand(NewVersion())
 	c.AddCommand(NewPlugin())
+	c.AddCommand(NewVerify())
+	c.AddCommand(NewFaucet())
 	c.AddCommand(deprecated()...)
 
 	return c
@@ -178,6 +189,90 @@ func flagGetClearCache(cmd *cobra.Command) bool {
 	return clearCache
 }
 
+func flagSetTemplatePack(cmd *cobra.Command) {
+	cmd.Flags().String(flagTemplate, "", "template pack overriding the built-in templates, by registered name or directory path")
+}
+
+// flagGetTemplatePack returns the directory of the template pack selected with the template flag.
+func flagGetTemplatePack(cmd *cobra.Command) (string, error) {
+	name, _ := cmd.Flags().GetString(flagTemplate)
+	if name == "" {
+		return "", nil
+	}
+	return templatepack.Resolve(name)
+}
+
+func flagSetAppWiring(cmd *cobra.Command, usage string) {
+	cmd.Flags().String(flagAppWiring, string(scaffolder.AppWiringAuto), usage)
+}
+
+func flagGetAppWiring(cmd *cobra.Command) (scaffolder.AppWiring, error) {
+	name, _ := cmd.Flags().GetString(flagAppWiring)
+	return scaffolder.ParseAppWiring(name)
+}
+
+func flagSetDryRun(cmd *cobra.Command) {
+	cmd.Flags().Bool(flagDryRun, false, "print the diff of the source code changes without applying them")
+	cmd.Flags().Bool(flagPlan, false, "print a JSON plan of the source code changes without applying them")
+}
+
+func flagGetDryRun(cmd *cobra.Command) bool {
+	dryRun, _ := cmd.Flags().GetBool(flagDryRun)
+	return dryRun || flagGetPlan(cmd)
+}
+
+func flagGetPlan(cmd *cobra.Command) bool {
+	plan, _ := cmd.Flags().GetBool(flagPlan)
+	return plan
+}
+
+// flagGetPreview returns the preview of the source code changes when the dry run
+// or the plan flag is set, nil otherwise.
+func flagGetPreview(cmd *cobra.Command) *xgenny.Preview {
+	if !flagGetDryRun(cmd) {
+		return nil
+	}
+	return xgenny.NewPreview()
+}
+
+// printPreview prints the diff of the source code changes of a dry run, or their
+// JSON plan when the plan flag is set, and returns the error of the scaffold operation,
+// so missing placeholders make the command fail after the changes are printed.
+func printPreview(cmd *cobra.Command, session *cliui.Session, preview *xgenny.Preview, scaffoldErr error) error {
+	wd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	if flagGetPlan(cmd) {
+		plan, err := preview.Plan(wd)
+		if err != nil {
+			return err
+		}
+		bz, err := json.MarshalIndent(plan, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		session.StopSpinner()
+		session.Println(string(bz))
+		return scaffoldErr
+	}
+
+	diff, err := preview.Diff(wd)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+	if diff == "" {
+		session.Println("No source code changes")
+	} else {
+		session.Print(diff)
+	}
+	return scaffoldErr
+}
+
 func NewChainWithHomeFlags(cmd *cobra.Command, chainOption ...chain.Option) (*chain.Chain, error) {
 	// Check if custom home is provided
 	if home := getHome(cmd); home != "" {
@@ -290,8 +385,8 @@ func checkNewVersion(ctx context.Context) {
 }
 
 // newApp create a new scaffold app
-func newApp(appPath string) (scaffolder.Scaffolder, error) {
-	sc, err := scaffolder.App(appPath)
+func newApp(appPath string, options ...scaffolder.Option) (scaffolder.Scaffolder, error) {
+	sc, err := scaffolder.App(appPath, options...)
 	if err != nil {
 		return sc, err
 	}
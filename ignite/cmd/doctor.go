@@ -0,0 +1,98 @@
+package ignitecmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/chainconfig/config"
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/doctor"
+)
+
+// NewDoctor returns a new command to diagnose the development environment of a blockchain app.
+func NewDoctor() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "doctor",
+		Short: "Diagnose the development environment of a blockchain app",
+		Long: `Diagnose the development environment of a blockchain app and recommend
+settings to fix the detected issues.
+
+The source code changes of the app might not be detected by "ignite chain serve"
+when the app is stored in a network or virtual file system, like NFS, SMB, the
+Windows drives mounted in WSL2 or the volumes of Docker Desktop. In these file
+systems the changes are detected by polling the content hashes of the source
+files, which can be configured in the "watch" section of config.yml:
+
+  watch:
+    mode: hash
+    interval: 1s`,
+		Args: cobra.NoArgs,
+		RunE: doctorHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetConfig())
+
+	return c
+}
+
+func doctorHandler(cmd *cobra.Command, _ []string) error {
+	session := cliui.New()
+	defer session.End()
+
+	appPath := flagGetPath(cmd)
+	configPath := getConfig(cmd)
+	if configPath == "" {
+		path, err := chainconfig.LocateDefault(appPath)
+		if err != nil && !errors.Is(err, chainconfig.ErrConfigNotFound) {
+			return err
+		}
+		configPath = path
+	}
+
+	var watch config.Watch
+	if configPath != "" {
+		conf, err := chainconfig.ParseFile(configPath)
+		if err != nil {
+			return err
+		}
+		watch = conf.Watch
+	}
+
+	report, err := doctor.CheckFileSystem(appPath, watch)
+	if err != nil {
+		return err
+	}
+
+	session.Printf("%s File system: %s\n", icons.Bullet, colors.Info(report.FileSystem.Type))
+	if report.WSL {
+		session.Printf("%s Running in WSL\n", icons.Bullet)
+	}
+	if report.Docker {
+		session.Printf("%s Running in a Docker container\n", icons.Bullet)
+	}
+
+	recommendations := report.Recommendations()
+	if len(recommendations) == 0 {
+		return session.Printf("\n%s No issues found\n", icons.OK)
+	}
+
+	session.Printf(
+		"\n%s Source code changes might not be detected reliably in %s file systems:\n",
+		icons.NotOK,
+		report.FileSystem.Type,
+	)
+	for _, r := range recommendations {
+		session.Printf("  %s %s\n", icons.Bullet, r)
+	}
+	return session.Println(`
+Recommended config.yml settings:
+
+  watch:
+    mode: hash
+    interval: 1s`)
+}
@@ -0,0 +1,17 @@
+package ignitecmd
+
+import "github.com/spf13/cobra"
+
+// NewFaucet returns a command that groups sub commands to run the token faucet
+// of a chain.
+func NewFaucet() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "faucet [command]",
+		Short: "Run a token faucet for a chain",
+		Args:  cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(NewFaucetServe())
+
+	return c
+}
@@ -0,0 +1,324 @@
+package ignitecmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/chainconfig/config"
+	"github.com/ignite/cli/ignite/pkg/chaincmd"
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/ignite/pkg/cosmosfaucet"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+	"github.com/ignite/cli/ignite/pkg/xexec"
+	"github.com/ignite/cli/ignite/pkg/xurl"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+const (
+	flagFaucetBinary        = "binary"
+	flagFaucetNode          = "node"
+	flagFaucetChainID       = "chain-id"
+	flagFaucetConfig        = "config"
+	flagFaucetAccount       = "account"
+	flagFaucetMnemonicEnv   = "mnemonic-env"
+	flagFaucetMnemonicFile  = "mnemonic-file"
+	flagFaucetCoinType      = "coin-type"
+	flagFaucetCoins         = "coins"
+	flagFaucetCoinsMax      = "coins-max"
+	flagFaucetHost          = "host"
+	flagFaucetGRPCAddress   = "grpc-address"
+	flagFaucetAPIAddress    = "api-address"
+	flagFaucetGasAdjustment = "gas-adjustment"
+	flagFaucetAddressPrefix = "address-prefix"
+
+	defaultFaucetHost = ":4500"
+	faucetDirName     = "faucet"
+)
+
+// NewFaucetServe returns a command to serve a token faucet for a chain running
+// anywhere, like a public testnet.
+func NewFaucetServe() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve a token faucet for a remote chain",
+		Long: `Serve the token faucet as a standalone service for a chain reachable through its
+Tendermint RPC, like a public testnet, instead of the chain served by "ignite
+chain serve".
+
+The faucet sends the transactions with the binary of the chain, which must be
+installed, from an account of the keyring of the binary:
+
+  ignite faucet serve --binary gaiad --node https://rpc.testnet.example.com:443 --coins 10000000uatom
+
+The chain ID is read from the node unless the "--chain-id" flag is set. The
+account of the faucet is imported in the keyring when its mnemonic is read from
+an environment variable with "--mnemonic-env" or from a file with
+"--mnemonic-file". The fees of the transactions are set with "--fees" or
+"--gas-prices", and the estimated gas is multiplied by "--gas-adjustment". Set
+"--gas-prices auto" to use the minimum gas price of the node, raised to the base
+fee of the x/feemarket module when the chain has one.
+
+The "--config" flag reads the limits, challenges, batches and other settings of
+the faucet from a YAML file with the keys of the "faucet" section of config.yml,
+the flags take precedence over the file.
+`,
+		Args: cobra.NoArgs,
+		RunE: faucetServeHandler,
+	}
+
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().String(flagFaucetBinary, "", "binary of the chain used to send the transactions (e.g. gaiad)")
+	c.Flags().String(flagFaucetNode, "tcp://localhost:26657", "<host>:<port> of the Tendermint RPC of the chain")
+	c.Flags().String(flagFaucetChainID, "", "chain ID, read from the node when empty")
+	c.Flags().String(flagFaucetConfig, "", "YAML file with the keys of the faucet section of config.yml")
+	c.Flags().String(flagFaucetAccount, "", fmt.Sprintf("name of the faucet account in the keyring (default %q)", cosmosfaucet.DefaultAccountName))
+	c.Flags().String(flagFaucetMnemonicEnv, "", "environment variable holding the mnemonic of the faucet account")
+	c.Flags().String(flagFaucetMnemonicFile, "", "file holding the mnemonic of the faucet account")
+	c.Flags().String(flagFaucetCoinType, "", "coin type of the faucet account imported from its mnemonic")
+	c.Flags().StringSlice(flagFaucetCoins, nil, "coins sent per request (e.g. 10000000uatom)")
+	c.Flags().StringSlice(flagFaucetCoinsMax, nil, "max amounts of coins sent to an address")
+	c.Flags().String(flagFaucetHost, "", fmt.Sprintf("host and port the faucet listens on (default %q)", defaultFaucetHost))
+	c.Flags().String(flagFaucetGRPCAddress, "", "host and port of the gRPC service of the faucet, not served when empty")
+	c.Flags().String(flagFaucetAPIAddress, "", "address of the API of the chain shown in the OpenAPI console")
+	c.Flags().String(flagFaucetAddressPrefix, "", "bech32 prefix of the addresses of the chain, read from the faucet account when empty")
+	c.Flags().String(flagFees, "", "fees paid by the transactions (e.g. 500uatom)")
+	c.Flags().String(flagGasPrices, "", fmt.Sprintf("gas prices that determine the fees of the transactions (e.g. 0.025uatom); set to %q to use the gas price of the node", cosmosclient.GasPricesAuto))
+	c.Flags().String(flagGas, gasFlagAuto, fmt.Sprintf("gas limit of the transactions; set to %q to estimate the gas", gasFlagAuto))
+	c.Flags().Float64(flagFaucetGasAdjustment, 0, "factor applied to the estimated gas of the transactions")
+
+	return c
+}
+
+func faucetServeHandler(cmd *cobra.Command, _ []string) error {
+	var (
+		binary, _        = cmd.Flags().GetString(flagFaucetBinary)
+		node, _          = cmd.Flags().GetString(flagFaucetNode)
+		chainID, _       = cmd.Flags().GetString(flagFaucetChainID)
+		configPath, _    = cmd.Flags().GetString(flagFaucetConfig)
+		account, _       = cmd.Flags().GetString(flagFaucetAccount)
+		mnemonicEnv, _   = cmd.Flags().GetString(flagFaucetMnemonicEnv)
+		mnemonicFile, _  = cmd.Flags().GetString(flagFaucetMnemonicFile)
+		coinType, _      = cmd.Flags().GetString(flagFaucetCoinType)
+		coins, _         = cmd.Flags().GetStringSlice(flagFaucetCoins)
+		coinsMax, _      = cmd.Flags().GetStringSlice(flagFaucetCoinsMax)
+		host, _          = cmd.Flags().GetString(flagFaucetHost)
+		grpcAddress, _   = cmd.Flags().GetString(flagFaucetGRPCAddress)
+		apiAddress, _    = cmd.Flags().GetString(flagFaucetAPIAddress)
+		gasAdjustment, _ = cmd.Flags().GetFloat64(flagFaucetGasAdjustment)
+		addressPrefix, _ = cmd.Flags().GetString(flagFaucetAddressPrefix)
+		session          = cliui.New(cliui.StartSpinner())
+		ctx              = cmd.Context()
+	)
+	defer session.End()
+
+	if binary == "" {
+		return fmt.Errorf("the binary of the chain is required, set it with --%s", flagFaucetBinary)
+	}
+	if mnemonicEnv != "" && mnemonicFile != "" {
+		return fmt.Errorf("--%s and --%s can't be used together", flagFaucetMnemonicEnv, flagFaucetMnemonicFile)
+	}
+
+	conf, err := parseFaucetConfig(configPath)
+	if err != nil {
+		return err
+	}
+	if account != "" {
+		conf.Name = &account
+	}
+	if conf.Name == nil {
+		name := cosmosfaucet.DefaultAccountName
+		conf.Name = &name
+	}
+	if len(coins) > 0 {
+		conf.Coins = coins
+	}
+	if len(coinsMax) > 0 {
+		conf.CoinsMax = coinsMax
+	}
+	if host != "" {
+		conf.Host, conf.Port = host, 0
+	}
+	if grpcAddress != "" {
+		conf.GRPCAddress = grpcAddress
+	}
+
+	mnemonic, err := faucetMnemonic(mnemonicEnv, mnemonicFile)
+	if err != nil {
+		return err
+	}
+
+	nodeAddress, err := xurl.TCP(node)
+	if err != nil {
+		return err
+	}
+
+	gasPrices := getGasPrices(cmd)
+	if gasPrices == cosmosclient.GasPricesAuto {
+		client, err := cosmosclient.New(ctx, cosmosclient.WithNodeAddress(nodeAddress))
+		if err != nil {
+			return err
+		}
+		gasPrice, err := client.GasPrice(ctx)
+		if err != nil {
+			return fmt.Errorf("cannot read the gas price from the node %s: %w", node, err)
+		}
+		gasPrices = gasPrice.String()
+	}
+
+	keyringBackend, err := chaincmd.KeyringBackendFromString(string(getKeyringBackend(cmd)))
+	if err != nil {
+		return err
+	}
+
+	chainCmdOptions := []chaincmd.Option{
+		chaincmd.WithNodeAddress(nodeAddress),
+		chaincmd.WithKeyringBackend(keyringBackend),
+		chaincmd.WithFees(getFees(cmd)),
+		chaincmd.WithGas(getGas(cmd)),
+		chaincmd.WithGasPrices(gasPrices),
+		chaincmd.WithGasAdjustment(gasAdjustment),
+	}
+	if home := getHome(cmd); home != "" {
+		chainCmdOptions = append(chainCmdOptions, chaincmd.WithHome(home))
+	}
+
+	cc := chaincmd.New(xexec.TryResolveAbsPath(binary), chainCmdOptions...)
+
+	// read the chain ID from the node when it is not set.
+	if chainID == "" {
+		runner, err := chaincmdrunner.New(ctx, cc)
+		if err != nil {
+			return err
+		}
+		status, err := runner.Status(ctx)
+		if err != nil {
+			return fmt.Errorf("cannot read the chain ID from the node %s: %w", node, err)
+		}
+		chainID = status.ChainID
+	}
+
+	runner, err := chaincmdrunner.New(ctx, cc.Copy(chaincmd.WithChainID(chainID)))
+	if err != nil {
+		return err
+	}
+
+	configDir, err := chainconfig.ConfigDirPath()
+	if err != nil {
+		return err
+	}
+	limitsPath := filepath.Join(configDir, faucetDirName, chainID, "faucet-limits.yml")
+
+	faucetOptions := []cosmosfaucet.Option{
+		cosmosfaucet.Account(*conf.Name, mnemonic, coinType),
+		cosmosfaucet.ChainID(chainID),
+	}
+	if apiAddress != "" {
+		if apiAddress, err = xurl.HTTP(apiAddress); err != nil {
+			return err
+		}
+		faucetOptions = append(faucetOptions, cosmosfaucet.OpenAPI(apiAddress))
+	}
+
+	workDir, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	configOptions, err := chain.FaucetOptions(conf, workDir, limitsPath)
+	if err != nil {
+		return err
+	}
+	faucetOptions = append(faucetOptions, configOptions...)
+
+	faucet, err := cosmosfaucet.New(ctx, runner, faucetOptions...)
+	if err != nil {
+		return err
+	}
+
+	// the addresses of the requests must have the prefix of the faucet account,
+	// which is known once the account is imported.
+	if addressPrefix == "" {
+		account, err := runner.ShowAccount(ctx, *conf.Name)
+		if err != nil {
+			return err
+		}
+		if addressPrefix, err = cosmosutil.GetAddressPrefix(account.Address); err != nil {
+			return err
+		}
+	}
+	cosmosfaucet.AddressPrefix(addressPrefix)(&faucet)
+
+	// keep supporting the port of the config like the faucet of "ignite chain serve".
+	host = conf.Host
+	if conf.Port != 0 {
+		host = fmt.Sprintf(":%d", conf.Port)
+	}
+	if host == "" {
+		host = defaultFaucetHost
+	}
+	faucetAddress, err := xurl.HTTP(host)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+	session.Printf("%s Faucet of chain %s served at %s\n", icons.OK, chainID, faucetAddress)
+	if conf.GRPCAddress != "" {
+		session.Printf("%s Faucet gRPC service served at %s\n", icons.OK, conf.GRPCAddress)
+	}
+
+	return chain.ServeFaucet(ctx, faucet, conf, host, workDir)
+}
+
+// parseFaucetConfig reads the faucet config from a YAML file with the keys of
+// the faucet section of config.yml, the config is empty without file.
+func parseFaucetConfig(path string) (conf config.Faucet, err error) {
+	if path == "" {
+		return conf, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return conf, err
+	}
+	if err := yaml.UnmarshalStrict(data, &conf); err != nil {
+		return conf, fmt.Errorf("faucet config %s: %w", path, err)
+	}
+
+	return conf, nil
+}
+
+// faucetMnemonic reads the mnemonic of the faucet account from an environment
+// variable or a file, the mnemonic is empty when none is set.
+func faucetMnemonic(env, path string) (string, error) {
+	switch {
+	case env != "":
+		mnemonic, ok := os.LookupEnv(env)
+		if !ok || strings.TrimSpace(mnemonic) == "" {
+			return "", fmt.Errorf("environment variable %s holding the mnemonic is not set", env)
+		}
+		return strings.TrimSpace(mnemonic), nil
+	case path != "":
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", err
+		}
+		mnemonic := strings.TrimSpace(string(data))
+		if mnemonic == "" {
+			return "", errors.New("the mnemonic file is empty")
+		}
+		return mnemonic, nil
+	}
+	return "", nil
+}
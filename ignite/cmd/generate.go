@@ -1,6 +1,11 @@
 package ignitecmd
 
-import "github.com/spf13/cobra"
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/cosmosgen"
+)
 
 // NewGenerate returns a command that groups code generation related sub commands.
 func NewGenerate() *cobra.Command {
@@ -11,17 +16,47 @@ func NewGenerate() *cobra.Command {
 
 Such as compiling protocol buffer files into Go or implement particular functionality, for example, generating an OpenAPI spec.
 
-Produced source code can be regenerated by running a command again and is not meant to be edited by hand.`,
+Produced source code can be regenerated by running a command again and is not meant to be edited by hand.
+
+The code generated for a proto package is cached with the checksum of its proto files and of the proto files they
+import, only the packages that changed are generated again. Use the "--force" flag to generate the code of all the
+packages.`,
 		Aliases: []string{"g"},
 		Args:    cobra.ExactArgs(1),
 	}
 
 	flagSetPath(c)
 	flagSetClearCache(c)
+	c.PersistentFlags().Bool(flagForce, false, "generate the code of all the proto packages ignoring the generation cache")
 	c.AddCommand(NewGenerateGo())
+	c.AddCommand(NewGenerateProto())
 	c.AddCommand(NewGenerateTSClient())
 	c.AddCommand(NewGenerateVuex())
+	c.AddCommand(NewGenerateComposables())
+	c.AddCommand(NewGenerateHooks())
 	c.AddCommand(NewGenerateOpenAPI())
+	c.AddCommand(NewGeneratePythonClient())
+	c.AddCommand(NewGenerateRustClient())
+	c.AddCommand(NewGenerateDartClient())
+	c.AddCommand(NewGenerateDashboards())
+	c.AddCommand(NewGenerateDescriptors())
 
 	return c
 }
+
+// newGenerateCache returns the cache storage of the generate commands, the
+// cached generated code is deleted when the generation is forced.
+func newGenerateCache(cmd *cobra.Command) (cache.Storage, error) {
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return cache.Storage{}, err
+	}
+
+	if force, _ := cmd.Flags().GetBool(flagForce); force {
+		if err := cosmosgen.ClearCache(cacheStorage); err != nil {
+			return cache.Storage{}, err
+		}
+	}
+
+	return cacheStorage, nil
+}
@@ -0,0 +1,61 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+func NewGenerateComposables() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "composables",
+		Short: "Generate Typescript client and Vue 3 composables for your chain's frontend",
+		Long: `Generate a Typescript client and Vue 3 composables for the queries and the
+messages of the modules of your chain.
+
+The composables use Vue Query on top of the Typescript client. The queries are
+cached and the queries of a module are refetched after a message of the module
+is broadcasted. The Typescript client and Vue Query are added to the
+dependencies of the Vue app when the composables are generated inside of it.`,
+		PreRunE: gitChangesConfirmPreRunHandler,
+		RunE:    generateComposablesHandler,
+	}
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().StringP(flagOutput, "o", "", "Vue composables output path")
+
+	return c
+}
+
+func generateComposablesHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New(cliui.StartSpinnerWithText(statusGenerating))
+	defer session.End()
+
+	c, err := NewChainWithHomeFlags(
+		cmd,
+		chain.WithOutputer(session),
+		chain.CollectEvents(session.EventBus()),
+		chain.PrintGeneratedPaths(),
+	)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newGenerateCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	output, err := cmd.Flags().GetString(flagOutput)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Generate(cmd.Context(), cacheStorage, chain.GenerateComposables(output)); err != nil {
+		return err
+	}
+
+	return session.Println(icons.OK, "Generated Typescript Client and Vue composables")
+}
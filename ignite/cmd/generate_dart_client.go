@@ -0,0 +1,67 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+func NewGenerateDartClient() *cobra.Command {
+	c := &cobra.Command{
+		Use:     "dart-client",
+		Aliases: []string{"dart"},
+		Short:   "Generate Dart client for your chain's custom modules",
+		Long: `Generate a null-safe Dart package for the custom modules of your chain, for
+Flutter and Dart apps.
+
+The package contains the message types and the gRPC clients of the modules, a
+wallet that signs the transactions of an account derived from a mnemonic, and a
+client that broadcasts them to a node with gRPC-web in the browser and with gRPC
+on the other platforms.
+
+The code is generated with the Dart protoc plugin, install it with:
+
+  dart pub global activate protoc_plugin`,
+		PreRunE: gitChangesConfirmPreRunHandler,
+		RunE:    generateDartClientHandler,
+	}
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().StringP(flagOutput, "o", "", "dart client output path")
+
+	return c
+}
+
+func generateDartClientHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New(cliui.StartSpinnerWithText(statusGenerating))
+	defer session.End()
+
+	c, err := NewChainWithHomeFlags(
+		cmd,
+		chain.WithOutputer(session),
+		chain.CollectEvents(session.EventBus()),
+		chain.PrintGeneratedPaths(),
+	)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newGenerateCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	output, err := cmd.Flags().GetString(flagOutput)
+	if err != nil {
+		return err
+	}
+
+	err = c.Generate(cmd.Context(), cacheStorage, chain.GenerateDartClient(output))
+	if err != nil {
+		return err
+	}
+
+	return session.Println(icons.OK, "Generated Dart Client")
+}
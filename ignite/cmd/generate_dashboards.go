@@ -0,0 +1,86 @@
+package ignitecmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/cosmosmetrics"
+)
+
+const defaultDashboardsDir = "dashboards"
+
+const telemetryConfigTip = `Add to the validator in config.yml so the node serves its metrics:
+
+  config:
+    instrumentation:
+      prometheus: true
+  app:
+    telemetry:
+      enabled: true
+      prometheus-retention-time: 60
+`
+
+func NewGenerateDashboards() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "dashboards",
+		Short: "Generate Grafana dashboards and a Prometheus and Grafana stack for your chain",
+		Long: `Generate Grafana dashboards of the consensus and mempool metrics of your chain,
+and of the metrics of its custom modules when they are instrumented with the
+telemetry package of the Cosmos SDK.
+
+A Docker Compose file of a Prometheus server that scrapes the metrics of the
+first validator and of a Grafana server with the dashboards is also generated.
+Start the stack with:
+
+  docker compose up -d`,
+		Args: cobra.NoArgs,
+		RunE: generateDashboardsHandler,
+	}
+
+	c.Flags().StringP(flagOutput, "o", defaultDashboardsDir, "dashboards output path")
+
+	return c
+}
+
+func generateDashboardsHandler(cmd *cobra.Command, _ []string) error {
+	session := cliui.New(cliui.StartSpinnerWithText(statusGenerating))
+	defer session.End()
+
+	c, err := NewChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	output, err := cmd.Flags().GetString(flagOutput)
+	if err != nil {
+		return err
+	}
+
+	info, err := c.WriteDashboards(output)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	if len(info.Modules) > 0 {
+		session.Printf("%s Dashboard of the metrics of the modules: %s\n", icons.Info, strings.Join(info.Modules, ", "))
+	}
+	if info.InstrumentationDisabled || info.TelemetryDisabled {
+		session.Printf("%s The node does not serve all its metrics. %s\n", icons.Info, telemetryConfigTip)
+	}
+
+	return session.Println(
+		icons.OK,
+		fmt.Sprintf(
+			"Generated dashboards in %s, Grafana serves them on http://localhost:%d",
+			colors.Info(output),
+			cosmosmetrics.GrafanaPort,
+		),
+	)
+}
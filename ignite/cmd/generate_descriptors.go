@@ -0,0 +1,63 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+const defaultDescriptorsOut = "chain.protoset"
+
+func NewGenerateDescriptors() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "descriptors",
+		Short: "Generate the descriptor set of the proto files of your chain",
+		Long: `Generate the FileDescriptorSet of the proto files of your chain, of its custom
+modules and of the modules of its dependencies like the Cosmos SDK modules,
+with the files they import and their source info.
+
+The descriptor set describes the API of the chain without access to its source
+code, it can be used by grpcurl, Buf Studio or code generation pipelines:
+
+  grpcurl -protoset chain.protoset localhost:9090 list`,
+		Args: cobra.NoArgs,
+		RunE: generateDescriptorsHandler,
+	}
+
+	c.Flags().String(flagOut, defaultDescriptorsOut, "descriptor set output path")
+
+	return c
+}
+
+func generateDescriptorsHandler(cmd *cobra.Command, _ []string) error {
+	session := cliui.New(cliui.StartSpinnerWithText(statusGenerating))
+	defer session.End()
+
+	c, err := NewChainWithHomeFlags(
+		cmd,
+		chain.WithOutputer(session),
+		chain.CollectEvents(session.EventBus()),
+		chain.PrintGeneratedPaths(),
+	)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newGenerateCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	out, err := cmd.Flags().GetString(flagOut)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Generate(cmd.Context(), cacheStorage, chain.GenerateDescriptors(out)); err != nil {
+		return err
+	}
+
+	return session.Println(icons.OK, "Generated descriptor set")
+}
@@ -34,7 +34,7 @@ func generateGoHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	cacheStorage, err := newCache(cmd)
+	cacheStorage, err := newGenerateCache(cmd)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,61 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+func NewGenerateHooks() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "hooks",
+		Short: "Generate Typescript client and React hooks for your chain's frontend",
+		Long: `Generate a Typescript client and React hooks for the queries and the
+messages of the modules of your chain.
+
+The hooks use React Query on top of the Typescript client. The queries are
+cached and the queries of a module are refetched after a message of the module
+is broadcasted. The Typescript client and React Query are added to the
+dependencies of the React app when the hooks are generated inside of it.`,
+		PreRunE: gitChangesConfirmPreRunHandler,
+		RunE:    generateHooksHandler,
+	}
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().StringP(flagOutput, "o", "", "React hooks output path")
+
+	return c
+}
+
+func generateHooksHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New(cliui.StartSpinnerWithText(statusGenerating))
+	defer session.End()
+
+	c, err := NewChainWithHomeFlags(
+		cmd,
+		chain.WithOutputer(session),
+		chain.CollectEvents(session.EventBus()),
+		chain.PrintGeneratedPaths(),
+	)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newGenerateCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	output, err := cmd.Flags().GetString(flagOutput)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Generate(cmd.Context(), cacheStorage, chain.GenerateHooks(output)); err != nil {
+		return err
+	}
+
+	return session.Println(icons.OK, "Generated Typescript Client and React hooks")
+}
@@ -35,7 +35,7 @@ func generateOpenAPIHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	cacheStorage, err := newCache(cmd)
+	cacheStorage, err := newGenerateCache(cmd)
 	if err != nil {
 		return err
 	}
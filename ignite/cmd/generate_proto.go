@@ -0,0 +1,115 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/buf"
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+const (
+	flagLint     = "lint"
+	flagBreaking = "breaking"
+	flagAgainst  = "against"
+)
+
+// NewGenerateProto returns a command to check the proto files of the app with
+// buf and generate their Go code.
+func NewGenerateProto() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "proto",
+		Short: "Lint the proto files, check their breaking changes with buf and generate their Go code",
+		Long: `The proto files of the chains scaffolded with Ignite are a buf module, the
+"buf.yaml" file in the proto directory lists their dependencies like the Cosmos
+SDK and configures the linters and the breaking change detection of buf.
+
+The dependencies are pinned in "buf.lock" the first time the command is run
+when buf is installed. The proto files of the pinned dependencies are then
+resolved from the Buf Schema Registry by all the generate commands, the other
+proto files are resolved from the Go module cache.
+
+Run the buf linters before generating the code:
+
+  ignite generate proto --lint
+
+Check that the proto files have no breaking changes compared to the proto
+files of the main branch of the git repository of the app:
+
+  ignite generate proto --breaking
+
+Use the "--against" flag to compare them with another buf input, like a tag:
+
+  ignite generate proto --breaking --against ".git#tag=v1.0.0,subdir=proto"
+
+The code is not generated when the proto files have lint errors or breaking
+changes. The buf CLI must be installed to lint the proto files and to check
+their breaking changes, see https://docs.buf.build/installation.`,
+		PreRunE: gitChangesConfirmPreRunHandler,
+		RunE:    generateProtoHandler,
+	}
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().Bool(flagLint, false, "lint the proto files with buf")
+	c.Flags().Bool(flagBreaking, false, "check the breaking changes of the proto files with buf")
+	c.Flags().String(flagAgainst, "", "buf input to check the breaking changes against (default: the proto files of the main branch)")
+
+	return c
+}
+
+func generateProtoHandler(cmd *cobra.Command, _ []string) error {
+	var (
+		lint, _     = cmd.Flags().GetBool(flagLint)
+		breaking, _ = cmd.Flags().GetBool(flagBreaking)
+		against, _  = cmd.Flags().GetString(flagAgainst)
+		ctx         = cmd.Context()
+	)
+
+	session := cliui.New(cliui.StartSpinnerWithText(statusGenerating))
+	defer session.End()
+
+	c, err := NewChainWithHomeFlags(
+		cmd,
+		chain.WithOutputer(session),
+		chain.CollectEvents(session.EventBus()),
+	)
+	if err != nil {
+		return err
+	}
+
+	if lint {
+		session.StartSpinner("Linting the proto files...")
+		if err := c.LintProto(ctx); err != nil {
+			return err
+		}
+		session.Println(icons.OK, "The proto files have no lint errors")
+	}
+
+	if breaking {
+		session.StartSpinner("Checking the breaking changes of the proto files...")
+		if err := c.CheckProtoBreaking(ctx, against); err != nil {
+			return err
+		}
+		session.Println(icons.OK, "The proto files have no breaking changes")
+	}
+
+	if buf.IsInstalled() {
+		session.StartSpinner("Resolving the proto dependencies...")
+		if err := c.UpdateProtoDependencies(ctx); err != nil {
+			return err
+		}
+	}
+
+	cacheStorage, err := newGenerateCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	session.StartSpinner(statusGenerating)
+	if err := c.Generate(ctx, cacheStorage, chain.GenerateGo()); err != nil {
+		return err
+	}
+
+	return session.Println(icons.OK, "Generated Go code")
+}
@@ -0,0 +1,61 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+func NewGeneratePythonClient() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "python-client",
+		Short: "Generate Python client for your chain's custom modules",
+		Long: `Generate a Python client for the custom modules of your chain.
+
+The message types and the gRPC clients of the modules are generated with betterproto,
+install its protoc plugin with:
+
+  pip install "betterproto[compiler]"`,
+		PreRunE: gitChangesConfirmPreRunHandler,
+		RunE:    generatePythonClientHandler,
+	}
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().StringP(flagOutput, "o", "", "python client output path")
+
+	return c
+}
+
+func generatePythonClientHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New(cliui.StartSpinnerWithText(statusGenerating))
+	defer session.End()
+
+	c, err := NewChainWithHomeFlags(
+		cmd,
+		chain.WithOutputer(session),
+		chain.CollectEvents(session.EventBus()),
+		chain.PrintGeneratedPaths(),
+	)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newGenerateCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	output, err := cmd.Flags().GetString(flagOutput)
+	if err != nil {
+		return err
+	}
+
+	err = c.Generate(cmd.Context(), cacheStorage, chain.GeneratePythonClient(output))
+	if err != nil {
+		return err
+	}
+
+	return session.Println(icons.OK, "Generated Python Client")
+}
@@ -0,0 +1,61 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+func NewGenerateRustClient() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "rust-client",
+		Short: "Generate Rust client for your chain's custom modules",
+		Long: `Generate a Rust client crate for the custom modules of your chain.
+
+The message types are generated with prost and the gRPC clients with tonic,
+install their protoc plugins with:
+
+  cargo install protoc-gen-prost protoc-gen-tonic`,
+		PreRunE: gitChangesConfirmPreRunHandler,
+		RunE:    generateRustClientHandler,
+	}
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().StringP(flagOutput, "o", "", "rust client output path")
+
+	return c
+}
+
+func generateRustClientHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New(cliui.StartSpinnerWithText(statusGenerating))
+	defer session.End()
+
+	c, err := NewChainWithHomeFlags(
+		cmd,
+		chain.WithOutputer(session),
+		chain.CollectEvents(session.EventBus()),
+		chain.PrintGeneratedPaths(),
+	)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newGenerateCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	output, err := cmd.Flags().GetString(flagOutput)
+	if err != nil {
+		return err
+	}
+
+	err = c.Generate(cmd.Context(), cacheStorage, chain.GenerateRustClient(output))
+	if err != nil {
+		return err
+	}
+
+	return session.Println(icons.OK, "Generated Rust Client")
+}
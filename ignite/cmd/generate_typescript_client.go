@@ -37,7 +37,7 @@ func generateTSClientHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	cacheStorage, err := newCache(cmd)
+	cacheStorage, err := newGenerateCache(cmd)
 	if err != nil {
 		return err
 	}
@@ -108,6 +108,7 @@ validators launch their nodes, a blockchain will be live.
 		NewNetworkValidator(),
 		NewNetworkProfile(),
 		NewNetworkCoordinator(),
+		NewNetworkGit(),
 	)
 
 	return c
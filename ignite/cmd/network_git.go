@@ -0,0 +1,71 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+// NewNetworkGit returns a command that groups sub commands to coordinate the
+// launch of a chain with a git repository.
+func NewNetworkGit() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "git [command]",
+		Short: "Coordinate the launch of a chain with a git repository instead of a coordination chain",
+		Long: `Coordinate the launch of a chain with a git repository, the gentxs of the
+validators are collected with pull requests and the coordinator finalizes the
+genesis from the merged gentxs. No coordination chain is needed.
+
+The commands are run in the directory of the source code of the chain with its
+binary built, with the path of the local clone of the repository of the network.
+
+As a coordinator, publish the initial genesis of the chain in a new repository
+and push it to GitHub:
+
+  ignite network git publish ../mars-network --validator-balance 100000000stake
+
+The repository has a workflow that verifies the pull requests of the validators
+with "ignite network git verify" and merges them.
+
+As a validator, clone the repository of the network and create the gentx of the
+node, the gentx is pushed in a new branch and its pull request is opened with
+the GitHub CLI:
+
+  ignite network git join ../mars-network --name alice --amount 95000000stake
+
+Once the pull requests are merged, the coordinator finalizes the genesis with the
+gentxs and pushes it:
+
+  ignite network git finalize ../mars-network
+
+The validators pull the finalized genesis and prepare their nodes:
+
+  ignite network git prepare ../mars-network`,
+	}
+
+	c.AddCommand(
+		NewNetworkGitPublish(),
+		NewNetworkGitJoin(),
+		NewNetworkGitVerify(),
+		NewNetworkGitFinalize(),
+		NewNetworkGitPrepare(),
+	)
+
+	return c
+}
+
+// networkGitChain returns the chain of the app and the commands of its binary.
+func networkGitChain(cmd *cobra.Command, options ...chain.Option) (*chain.Chain, chaincmdrunner.Runner, error) {
+	c, err := NewChainWithHomeFlags(cmd, options...)
+	if err != nil {
+		return nil, chaincmdrunner.Runner{}, err
+	}
+
+	commands, err := c.Commands(cmd.Context())
+	if err != nil {
+		return nil, chaincmdrunner.Runner{}, err
+	}
+
+	return c, commands, nil
+}
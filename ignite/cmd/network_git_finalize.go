@@ -0,0 +1,60 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/network/networkgit"
+)
+
+// NewNetworkGitFinalize returns a new command to finalize the genesis of the
+// git repository of a network.
+func NewNetworkGitFinalize() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "finalize [repository-path]",
+		Short: "Finalize the genesis of the chain with the gentxs of the git repository of a network",
+		Long: `Build the genesis of the chain from the initial genesis and the gentxs of the
+repository with the binary of the chain, and commit it with the peer addresses
+of the validators. The repository doesn't accept gentxs anymore once the genesis
+is finalized.
+
+Push the commit to share the finalized genesis with the validators.`,
+		Args: cobra.ExactArgs(1),
+		RunE: networkGitFinalizeHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+
+	return c
+}
+
+func networkGitFinalizeHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New(cliui.StartSpinnerWithText("Finalizing the genesis..."))
+	defer session.End()
+
+	repo, err := networkgit.Open(args[0])
+	if err != nil {
+		return err
+	}
+
+	_, commands, err := networkGitChain(cmd)
+	if err != nil {
+		return err
+	}
+
+	genesis, peers, err := repo.BuildGenesis(cmd.Context(), commands)
+	if err != nil {
+		return err
+	}
+	if err := repo.Finalize(cmd.Context(), genesis, peers); err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+	session.Printf("%s Genesis of %s finalized with %d validators\n", icons.OK, repo.Config().ChainID, len(peers))
+	session.Println("\nPush the commit and ask the validators to prepare their nodes")
+
+	return nil
+}
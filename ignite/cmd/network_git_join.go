@@ -0,0 +1,116 @@
+package ignitecmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/network/networkgit"
+)
+
+const (
+	flagValidatorName = "name"
+	flagNoPush        = "no-push"
+)
+
+// NewNetworkGitJoin returns a new command to add the gentx of a validator to
+// the git repository of a network.
+func NewNetworkGitJoin() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "join [repository-path]",
+		Short: "Open the pull request of the gentx of the validator in the git repository of a network",
+		Long: `Create the gentx of the validator of the node of the chain from the initial
+genesis of the network and commit it in a new branch of the repository. The
+account of the validator is created in the keyring of the node when it doesn't
+exist.
+
+The branch is pushed to the origin remote of the repository and its pull request
+is opened with the GitHub CLI, use the "--no-push" flag to only commit the gentx.
+
+The peer address of the node is the address detected by the binary of the
+chain, use the "--peer-address" flag to set the public address of the node.`,
+		Args: cobra.ExactArgs(1),
+		RunE: networkGitJoinHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().String(flagValidatorName, "validator", "name of the validator and of its account")
+	c.Flags().String(flagAmount, "", "self-delegation of the validator")
+	c.Flags().String(flagPeerAddress, "", "public address of the node (e.g. 1.2.3.4:26656)")
+	c.Flags().Bool(flagNoPush, false, "commit the gentx without pushing it")
+	c.MarkFlagRequired(flagAmount)
+
+	return c
+}
+
+func networkGitJoinHandler(cmd *cobra.Command, args []string) error {
+	var (
+		name, _        = cmd.Flags().GetString(flagValidatorName)
+		amount, _      = cmd.Flags().GetString(flagAmount)
+		peerAddress, _ = cmd.Flags().GetString(flagPeerAddress)
+		noPush, _      = cmd.Flags().GetBool(flagNoPush)
+		ctx            = cmd.Context()
+	)
+
+	session := cliui.New(cliui.StartSpinnerWithText("Creating the gentx..."))
+	defer session.End()
+
+	repo, err := networkgit.Open(args[0])
+	if err != nil {
+		return err
+	}
+
+	c, commands, err := networkGitChain(cmd)
+	if err != nil {
+		return err
+	}
+	home, err := c.Home()
+	if err != nil {
+		return err
+	}
+
+	account, gentx, err := repo.CreateGentx(ctx, commands, home, networkgit.Validator{
+		Name:           name,
+		SelfDelegation: amount,
+		PeerAddress:    peerAddress,
+	})
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+	if account.Mnemonic != "" {
+		session.Printf("%s Account %s created, keep its mnemonic safe:\n\n%s\n\n", icons.Info, account.Address, account.Mnemonic)
+	}
+
+	branch, err := repo.AddGentx(ctx, name, gentx)
+	if err != nil {
+		return err
+	}
+	session.Printf("%s Gentx of %s committed in the %s branch\n", icons.OK, name, branch)
+
+	if noPush {
+		return nil
+	}
+
+	session.StartSpinner("Opening the pull request...")
+	if err := repo.Push(ctx, branch); err != nil {
+		return err
+	}
+
+	err = repo.OpenPullRequest(ctx, branch, "Add the gentx of "+name)
+	session.StopSpinner()
+	if errors.Is(err, networkgit.ErrGitHubCLINotInstalled) {
+		session.Printf("%s Branch %s pushed, open its pull request in the repository of the network\n", icons.OK, branch)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	session.Printf("%s Pull request of the %s branch opened\n", icons.OK, branch)
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/network/networkgit"
+)
+
+// NewNetworkGitPrepare returns a new command to prepare the node of a
+// validator with the finalized genesis of the git repository of a network.
+func NewNetworkGitPrepare() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "prepare [repository-path]",
+		Short: "Prepare the node of the validator to start with the finalized genesis of a network",
+		Long: `Replace the genesis of the node of the chain with the finalized genesis of the
+repository, set the other validators as the persistent peers of the node and
+reset the state of the node. Pull the repository of the network first.`,
+		Args: cobra.ExactArgs(1),
+		RunE: networkGitPrepareHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+
+	return c
+}
+
+func networkGitPrepareHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New(cliui.StartSpinnerWithText("Preparing the node..."))
+	defer session.End()
+
+	repo, err := networkgit.Open(args[0])
+	if err != nil {
+		return err
+	}
+
+	c, commands, err := networkGitChain(cmd)
+	if err != nil {
+		return err
+	}
+	home, err := c.Home()
+	if err != nil {
+		return err
+	}
+	binary, err := c.Binary()
+	if err != nil {
+		return err
+	}
+
+	if err := repo.Prepare(cmd.Context(), commands, home); err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+	session.Printf("%s Node of %s prepared, start it with:\n\n", icons.OK, repo.Config().ChainID)
+	session.Printf("\t%s start --home %s\n", binary, home)
+
+	return nil
+}
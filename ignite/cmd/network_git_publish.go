@@ -0,0 +1,103 @@
+package ignitecmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/repoversion"
+	"github.com/ignite/cli/ignite/services/chain"
+	"github.com/ignite/cli/ignite/services/network/networkgit"
+)
+
+const flagValidatorBalance = "validator-balance"
+
+// NewNetworkGitPublish returns a new command to publish the network of a chain
+// in a git repository.
+func NewNetworkGitPublish() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "publish [repository-path]",
+		Short: "Publish the initial genesis of the chain in the git repository of a new network",
+		Long: `Create the repository of a new network in the directory of the repository path
+and commit the config of the network, the initial genesis of the chain and the
+workflow that verifies and merges the pull requests of the validators.
+
+The initial genesis is the default genesis of the binary of the chain, use the
+"--genesis" flag to publish another genesis. The account of each validator is
+added to the genesis with the balance of the "--validator-balance" flag.
+
+The workflow builds the chain from its GitHub repository at the current commit
+of the source code of the chain. Push the repository to GitHub once published.`,
+		Args: cobra.ExactArgs(1),
+		RunE: networkGitPublishHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().String(flagChainID, "", "chain ID of the network (default: the chain ID of the chain)")
+	c.Flags().String(flagGenesis, "", "path of the initial genesis of the chain")
+	c.Flags().String(flagValidatorBalance, "100000000stake", "balance of the account of each validator")
+
+	return c
+}
+
+func networkGitPublishHandler(cmd *cobra.Command, args []string) error {
+	var (
+		chainID, _          = cmd.Flags().GetString(flagChainID)
+		genesisPath, _      = cmd.Flags().GetString(flagGenesis)
+		validatorBalance, _ = cmd.Flags().GetString(flagValidatorBalance)
+		ctx                 = cmd.Context()
+	)
+
+	session := cliui.New(cliui.StartSpinnerWithText("Publishing the network..."))
+	defer session.End()
+
+	c, commands, err := networkGitChain(cmd)
+	if err != nil {
+		return err
+	}
+
+	if chainID == "" {
+		if chainID, err = c.ID(); err != nil {
+			return err
+		}
+	}
+
+	appPath := flagGetPath(cmd)
+	app, err := chain.NewAppAt(appPath)
+	if err != nil {
+		return err
+	}
+
+	config := networkgit.Config{
+		ChainID:          chainID,
+		Source:           app.ImportPath,
+		ValidatorBalance: validatorBalance,
+	}
+	if v, err := repoversion.Determine(appPath); err == nil {
+		config.Hash = v.Hash
+	}
+
+	var genesis []byte
+	if genesisPath != "" {
+		genesis, err = os.ReadFile(genesisPath)
+	} else {
+		genesis, err = networkgit.DefaultGenesis(ctx, commands, chainID)
+	}
+	if err != nil {
+		return err
+	}
+
+	repo, err := networkgit.Create(ctx, args[0], config, genesis)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+	session.Printf("%s Network of %s published in %s\n", icons.OK, chainID, repo.Path())
+	session.Println("\nPush the repository to GitHub and share it with the validators")
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/network/networkgit"
+)
+
+// NewNetworkGitVerify returns a new command to verify the gentxs of the git
+// repository of a network.
+func NewNetworkGitVerify() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "verify [repository-path]",
+		Short: "Verify the gentxs of the git repository of a network with the binary of the chain",
+		Long: `Build the genesis of the chain from the initial genesis and the gentxs of the
+repository, and validate it with the binary of the chain. The self-delegation of
+each gentx must not be greater than the validator balance of the network.
+
+The workflow of the repository of the network runs the command to verify the
+pull requests of the validators before merging them.`,
+		Args: cobra.ExactArgs(1),
+		RunE: networkGitVerifyHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+
+	return c
+}
+
+func networkGitVerifyHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New(cliui.StartSpinnerWithText("Verifying the gentxs..."))
+	defer session.End()
+
+	repo, err := networkgit.Open(args[0])
+	if err != nil {
+		return err
+	}
+	if repo.IsFinalized() {
+		return networkgit.ErrFinalized
+	}
+
+	_, commands, err := networkGitChain(cmd)
+	if err != nil {
+		return err
+	}
+
+	if _, _, err := repo.BuildGenesis(cmd.Context(), commands); err != nil {
+		return err
+	}
+
+	gentxs, err := repo.Gentxs()
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+	for _, gentx := range gentxs {
+		session.Printf("%s %s: %s\n", icons.OK, gentx.Name, gentx.Info.SelfDelegation)
+	}
+
+	return nil
+}
@@ -134,6 +134,7 @@ func networkRewardRelease(cmd *cobra.Command, args []string) (err error) {
 		spnGasLimit,
 		networktypes.SPN,
 		spn.ClientID,
+		"",
 	)
 	if err != nil {
 		return err
@@ -152,6 +153,7 @@ func networkRewardRelease(cmd *cobra.Command, args []string) (err error) {
 		testnetGasLimit,
 		testnetAddressPrefix,
 		chain.ClientID,
+		"",
 	)
 	if err != nil {
 		return err
@@ -30,6 +30,7 @@ func NewNodeTx() *cobra.Command {
 	c.PersistentFlags().String(flagFees, "", "Fees to pay along with transaction; eg: 10uatom")
 
 	c.AddCommand(NewNodeTxBank())
+	c.AddCommand(NewNodeTxLoad())
 
 	return c
 }
@@ -0,0 +1,283 @@
+package ignitecmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/ignite/pkg/cosmosload"
+)
+
+const (
+	gasPriceFixed   = "fixed"
+	gasPriceUniform = "uniform"
+	gasPriceNormal  = "normal"
+)
+
+// loadConfig is the YAML file of the lanes of a load.
+type loadConfig struct {
+	Duration time.Duration    `yaml:"duration"`
+	Seed     int64            `yaml:"seed"`
+	Lanes    []loadLaneConfig `yaml:"lanes"`
+}
+
+type loadLaneConfig struct {
+	Name      string               `yaml:"name"`
+	Accounts  []string             `yaml:"accounts"`
+	Rate      float64              `yaml:"rate"`
+	GasPrice  loadGasPriceConfig   `yaml:"gas_price"`
+	Workloads []loadWorkloadConfig `yaml:"workloads"`
+}
+
+type loadGasPriceConfig struct {
+	Distribution string `yaml:"distribution"`
+	Price        string `yaml:"price"`
+	Min          string `yaml:"min"`
+	Max          string `yaml:"max"`
+	Mean         string `yaml:"mean"`
+	StdDev       string `yaml:"std_dev"`
+}
+
+type loadWorkloadConfig struct {
+	Name     string `yaml:"name"`
+	Weight   int    `yaml:"weight"`
+	BankSend *struct {
+		To     string `yaml:"to"`
+		Amount string `yaml:"amount"`
+	} `yaml:"bank_send"`
+}
+
+// NewNodeTxLoad returns a command to send a load of txs to a node.
+func NewNodeTxLoad() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "load [config-file]",
+		Short: "Send a load of transactions in lanes of different priorities",
+		Long: `Send transactions to the node at fixed rates in lanes described by a YAML file,
+then report the latency of the transactions of each lane from their send to
+their inclusion in a block.
+
+Each lane sends its transactions in turn from its own accounts of the keyring,
+with gas prices drawn from a fixed, uniform or normal distribution. The mempool
+orders the transactions by their gas price, so the gas prices of the lanes set
+their priority. The transactions of a lane are a mix of workloads picked
+according to their weights:
+
+  duration: 1m
+  seed: 42
+  lanes:
+    - name: priority
+      accounts: [alice]
+      rate: 5
+      gas_price:
+        distribution: uniform
+        min: 0.5stake
+        max: 1stake
+      workloads:
+        - name: transfer
+          weight: 3
+          bank_send:
+            to: bob
+            amount: 1stake
+    - name: bulk
+      accounts: [bob, carol]
+      rate: 20
+      gas_price:
+        distribution: normal
+        mean: 0.1stake
+        std_dev: 0.05
+      workloads:
+        - name: transfer
+          weight: 1
+          bank_send:
+            to: alice
+            amount: 1stake
+
+The gas of the transactions can't be simulated while the previous transactions
+of an account are in the mempool, set a fixed gas with "--gas". The workloads
+of other messages, like the messages of the modules of a chain, are sent with
+the cosmosload Go package.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: nodeTxLoadHandler,
+	}
+
+	return c
+}
+
+func nodeTxLoadHandler(cmd *cobra.Command, args []string) error {
+	if gas := getGas(cmd); gas == "" || gas == gasFlagAuto {
+		return errors.New(`set a fixed gas for the transactions with "--gas"`)
+	}
+
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+	var conf loadConfig
+	if err := yaml.UnmarshalStrict(data, &conf); err != nil {
+		return fmt.Errorf("invalid load file %s: %w", args[0], err)
+	}
+
+	client, err := newNodeCosmosClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	lanes, err := loadLanes(client, conf.Lanes)
+	if err != nil {
+		return err
+	}
+
+	var options []cosmosload.Option
+	if conf.Seed != 0 {
+		options = append(options, cosmosload.WithSeed(conf.Seed))
+	}
+	if conf.Duration > 0 {
+		options = append(options, cosmosload.WithDuration(conf.Duration))
+	}
+
+	session := cliui.New(cliui.StartSpinnerWithText("Sending transactions..."))
+	defer session.End()
+
+	report, err := cosmosload.Run(cmd.Context(), lanes, options...)
+	if err != nil {
+		return err
+	}
+	session.StopSpinner()
+
+	var laneEntries, workloadEntries [][]string
+	for _, l := range report.Lanes {
+		laneEntries = append(laneEntries, []string{
+			l.Name,
+			fmt.Sprint(l.Sent),
+			fmt.Sprint(l.Failed),
+			fmt.Sprintf("%.2f", float64(l.Sent-l.Failed)/report.Duration.Seconds()),
+			l.Latency.P50.Round(time.Millisecond).String(),
+			l.Latency.P90.Round(time.Millisecond).String(),
+			l.Latency.P99.Round(time.Millisecond).String(),
+			l.Latency.Max.Round(time.Millisecond).String(),
+		})
+		for _, w := range l.Workloads {
+			workloadEntries = append(workloadEntries, []string{l.Name, w.Name, fmt.Sprint(w.Sent), fmt.Sprint(w.Failed)})
+		}
+	}
+	if err := session.PrintTable([]string{"Lane", "Sent", "Failed", "Tx/s", "P50", "P90", "P99", "Max"}, laneEntries...); err != nil {
+		return err
+	}
+	session.Println()
+	if err := session.PrintTable([]string{"Lane", "Workload", "Sent", "Failed"}, workloadEntries...); err != nil {
+		return err
+	}
+
+	for _, l := range report.Lanes {
+		if l.Err != nil {
+			session.Printf("\n%s Lane %s: %d transactions failed, first error: %s\n", icons.NotOK, l.Name, l.Failed, l.Err)
+		}
+	}
+	return nil
+}
+
+// loadLanes returns the lanes of the load file with the accounts of the keyring
+// of the client.
+func loadLanes(client cosmosclient.Client, confs []loadLaneConfig) ([]cosmosload.Lane, error) {
+	var lanes []cosmosload.Lane
+	for _, conf := range confs {
+		lane := cosmosload.Lane{
+			Name: conf.Name,
+			Rate: conf.Rate,
+		}
+
+		for _, name := range conf.Accounts {
+			account, err := client.Account(name)
+			if err != nil {
+				return nil, err
+			}
+			a, err := cosmosload.NewAccount(client, account)
+			if err != nil {
+				return nil, err
+			}
+			lane.Accounts = append(lane.Accounts, a)
+		}
+
+		gasPrice, err := loadGasPrice(conf.GasPrice)
+		if err != nil {
+			return nil, fmt.Errorf("gas price of the lane %q: %w", conf.Name, err)
+		}
+		lane.GasPrice = gasPrice
+
+		for _, w := range conf.Workloads {
+			if w.BankSend == nil {
+				return nil, fmt.Errorf("no msgs in the workload %q of the lane %q", w.Name, conf.Name)
+			}
+
+			// the recipient can be an account of the keyring or a raw address
+			to, err := client.Address(w.BankSend.To)
+			if err != nil {
+				to = w.BankSend.To
+			}
+			amount, err := sdk.ParseCoinsNormalized(w.BankSend.Amount)
+			if err != nil {
+				return nil, err
+			}
+
+			lane.Workloads = append(lane.Workloads, cosmosload.Workload{
+				Name:   w.Name,
+				Weight: w.Weight,
+				Msgs:   cosmosload.BankSend(to, amount),
+			})
+		}
+
+		lanes = append(lanes, lane)
+	}
+	return lanes, nil
+}
+
+func loadGasPrice(conf loadGasPriceConfig) (cosmosload.GasPriceDistribution, error) {
+	switch conf.Distribution {
+	case "", gasPriceFixed:
+		price, err := sdk.ParseDecCoin(conf.Price)
+		if err != nil {
+			return nil, err
+		}
+		return cosmosload.FixedGasPrice(price), nil
+
+	case gasPriceUniform:
+		min, err := sdk.ParseDecCoin(conf.Min)
+		if err != nil {
+			return nil, err
+		}
+		max, err := sdk.ParseDecCoin(conf.Max)
+		if err != nil {
+			return nil, err
+		}
+		return cosmosload.UniformGasPrice(min, max)
+
+	case gasPriceNormal:
+		mean, err := sdk.ParseDecCoin(conf.Mean)
+		if err != nil {
+			return nil, err
+		}
+		stdDev, err := sdk.NewDecFromStr(conf.StdDev)
+		if err != nil {
+			return nil, err
+		}
+		return cosmosload.NormalGasPrice(mean, stdDev)
+
+	default:
+		return nil, fmt.Errorf(
+			"unknown distribution %q, use %q, %q or %q",
+			conf.Distribution,
+			gasPriceFixed,
+			gasPriceUniform,
+			gasPriceNormal,
+		)
+	}
+}
@@ -10,8 +10,11 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
+	"github.com/ignite/cli/ignite/pkg/cliui"
 	"github.com/ignite/cli/ignite/pkg/cliui/entrywriter"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
 	"github.com/ignite/cli/ignite/pkg/xgit"
+	"github.com/ignite/cli/ignite/services/chain"
 	"github.com/ignite/cli/ignite/services/plugin"
 )
 
@@ -69,6 +72,90 @@ func linkPluginCmds(rootCmd *cobra.Command, p *plugin.Plugin) {
 			return
 		}
 	}
+	linkPluginGenerateCmds(rootCmd, p)
+}
+
+// linkPluginGenerateCmds adds the code generation targets of the plugin as
+// sub commands of `ignite generate`.
+func linkPluginGenerateCmds(rootCmd *cobra.Command, p *plugin.Plugin) {
+	g, ok := p.Interface.(plugin.Generator)
+	if !ok {
+		return
+	}
+	generateCmd := findCommandByPath(rootCmd, "ignite generate")
+	if generateCmd == nil {
+		return
+	}
+	for _, target := range g.GenerateTargets() {
+		for _, cmd := range generateCmd.Commands() {
+			if cmd.Name() == target.Name {
+				p.Error = errors.Errorf("plugin code generation target %q already exists in ignite's commands", target.Name)
+				return
+			}
+		}
+		generateCmd.AddCommand(newPluginGenerateCmd(p, target))
+	}
+}
+
+func newPluginGenerateCmd(p *plugin.Plugin, target plugin.GenerateTarget) *cobra.Command {
+	c := &cobra.Command{
+		Use:     target.Name,
+		Short:   target.Short,
+		Long:    target.Long,
+		Args:    cobra.NoArgs,
+		PreRunE: gitChangesConfirmPreRunHandler,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			session := cliui.New(cliui.StartSpinnerWithText(statusGenerating))
+			defer session.End()
+
+			c, err := NewChainWithHomeFlags(
+				cmd,
+				chain.WithOutputer(session),
+				chain.CollectEvents(session.EventBus()),
+			)
+			if err != nil {
+				return err
+			}
+
+			cacheStorage, err := newGenerateCache(cmd)
+			if err != nil {
+				return err
+			}
+
+			output, err := cmd.Flags().GetString(flagOutput)
+			if err != nil {
+				return err
+			}
+
+			if err := c.Generate(cmd.Context(), cacheStorage, p.ChainGenerateTarget(target, output)); err != nil {
+				return err
+			}
+
+			return session.Printf("%s Generated %s with plugin %s\n", icons.OK, target.Name, p.Path)
+		},
+	}
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().StringP(flagOutput, "o", "", "output path")
+
+	return c
+}
+
+// pluginGenerateTargets returns the code generation targets of the plugins
+// configured to be generated with the targets of the chain config.
+func pluginGenerateTargets() ([]chain.GenerateTarget, error) {
+	var targets []chain.GenerateTarget
+	for _, p := range plugins {
+		if p.Error != nil {
+			continue
+		}
+		t, err := p.ConfigGenerateTargets()
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, t...)
+	}
+	return targets, nil
 }
 
 func linkPluginCmd(rootCmd *cobra.Command, p *plugin.Plugin, pluginCmd plugin.Command) {
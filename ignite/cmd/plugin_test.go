@@ -27,6 +27,21 @@ func (pluginInterface) Execute(plugin.Command, []string) error {
 	return nil
 }
 
+// pluginGenerator implements plugin.Interface and plugin.Generator for testing
+// purpose.
+type pluginGenerator struct {
+	pluginInterface
+	targets []plugin.GenerateTarget
+}
+
+func (p pluginGenerator) GenerateTargets() []plugin.GenerateTarget {
+	return p.targets
+}
+
+func (pluginGenerator) Generate(plugin.GenerateTarget, plugin.GenerateRequest) error {
+	return nil
+}
+
 func TestLinkPluginCmds(t *testing.T) {
 	buildRootCmd := func() *cobra.Command {
 		var (
@@ -242,6 +257,63 @@ ignite
 	}
 }
 
+func TestLinkPluginGenerateCmds(t *testing.T) {
+	buildRootCmd := func() *cobra.Command {
+		rootCmd := &cobra.Command{Use: "ignite"}
+		generateCmd := &cobra.Command{Use: "generate"}
+		generateCmd.AddCommand(&cobra.Command{
+			Use: "go",
+			Run: func(*cobra.Command, []string) {},
+		})
+		rootCmd.AddCommand(generateCmd)
+		return rootCmd
+	}
+	tests := []struct {
+		name            string
+		targets         []plugin.GenerateTarget
+		expectedDumpCmd string
+		expectedError   string
+	}{
+		{
+			name:    "ok: link targets under generate",
+			targets: []plugin.GenerateTarget{{Name: "kotlin"}, {Name: "graphql"}},
+			expectedDumpCmd: `
+ignite
+  generate
+    go*
+    graphql*
+    kotlin*
+`,
+		},
+		{
+			name:          "fail: target already exists",
+			targets:       []plugin.GenerateTarget{{Name: "go"}},
+			expectedError: `plugin code generation target "go" already exists in ignite's commands`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &plugin.Plugin{
+				Plugin:    chainconfig.Plugin{Path: "foo"},
+				Interface: pluginGenerator{targets: tt.targets},
+			}
+			rootCmd := buildRootCmd()
+
+			linkPluginCmds(rootCmd, p)
+
+			if tt.expectedError != "" {
+				require.EqualError(t, p.Error, tt.expectedError)
+				return
+			}
+			require.NoError(t, p.Error)
+			var s strings.Builder
+			s.WriteString("\n")
+			dumpCmd(rootCmd, &s, 0)
+			assert.Equal(t, tt.expectedDumpCmd, s.String())
+		})
+	}
+}
+
 // dumpCmd helps in comparing cobra.Command by writing their Use and Commands.
 // Runnable commands are marked with a *.
 func dumpCmd(c *cobra.Command, w io.Writer, ntabs int) {
@@ -1,10 +1,22 @@
 package ignitecmd
 
 import (
+	"fmt"
+
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
 	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/pkg/relayer"
+	"github.com/ignite/cli/ignite/pkg/relayer/hermes"
+)
+
+const (
+	flagBackend          = "backend"
+	flagHermesBinary     = "hermes-binary"
+	relayerBackendTS     = "ts"
+	relayerBackendHermes = "hermes"
 )
 
 // NewRelayer returns a new relayer command.
@@ -18,6 +30,14 @@ func NewRelayer() *cobra.Command {
 	c.AddCommand(
 		NewRelayerConfigure(),
 		NewRelayerConnect(),
+		NewRelayerClose(),
+		NewRelayerUpgrade(),
+		NewRelayerRegisterPayee(),
+		NewRelayerPackets(),
+		NewRelayerClients(),
+		NewRelayerTransfer(),
+		NewRelayerPolicy(),
+		NewRelayerStatus(),
 	)
 
 	return c
@@ -29,5 +49,56 @@ func handleRelayerAccountErr(err error) error {
 		return err
 	}
 
-	return errors.Wrap(accountErr, `make sure to create or import your account through "ignite account" commands`)
+	return errors.Wrap(
+		accountErr,
+		`make sure to create or import your account through "ignite account" commands, `+
+			`e.g. "ignite account import <name> --secret <mnemonic>"`,
+	)
+}
+
+func flagSetRelayerBackend() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(flagBackend, relayerBackendTS, "Relayer backend used to link and relay the paths (ts|hermes)")
+	fs.String(flagHermesBinary, hermes.DefaultBinary, "Binary of Hermes used by the hermes backend")
+	return fs
+}
+
+// relayerBackend returns the relayer backend selected with the backend flag,
+// nil is returned for the TypeScript relayer which is the default backend
+// and the backend of the commands without the flag. The backends sign the
+// transactions with the keys of the relayer accounts of ca.
+func relayerBackend(cmd *cobra.Command, ca cosmosaccount.Registry) (relayer.Backend, error) {
+	name, _ := cmd.Flags().GetString(flagBackend)
+	switch name {
+	case "", relayerBackendTS:
+		return nil, nil
+	case relayerBackendHermes:
+		binary, _ := cmd.Flags().GetString(flagHermesBinary)
+		return hermes.New(
+			hermes.WithBinary(binary),
+			hermes.WithAccountRegistry(ca),
+			hermes.WithLogs(cmd.OutOrStdout()),
+		), nil
+	default:
+		return nil, fmt.Errorf("unknown relayer backend %q, use %s or %s", name, relayerBackendTS, relayerBackendHermes)
+	}
+}
+
+// newRelayer returns the relayer with the accounts of the keyring and the
+// backend selected with the flags of the command.
+func newRelayer(cmd *cobra.Command) (relayer.Relayer, error) {
+	ca, err := cosmosaccount.New(
+		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
+		cosmosaccount.WithHome(getKeyringDir(cmd)),
+	)
+	if err != nil {
+		return relayer.Relayer{}, err
+	}
+
+	backend, err := relayerBackend(cmd, ca)
+	if err != nil {
+		return relayer.Relayer{}, err
+	}
+
+	return relayer.New(ca, relayer.WithBackend(backend)), nil
 }
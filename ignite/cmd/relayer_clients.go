@@ -0,0 +1,225 @@
+package ignitecmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/entrywriter"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/relayer"
+)
+
+const (
+	flagClientsUpdate     = "update"
+	flagClientsWarnBefore = "warn-before"
+	flagAutoUpdateClients = "auto-update-clients"
+)
+
+var relayerClientsHeader = []string{"Path", "Chain", "Client", "Tracks", "Status", "Last update", "Expires in"}
+
+// NewRelayerClients returns a new relayer clients command to check the light clients of the paths.
+func NewRelayerClients() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "clients [<path>,...]",
+		Short: "Check the expiry of the light clients of the linked paths",
+		Long: `Check the light clients hosted on both chains of the linked paths, all the linked paths are
+checked when no path is specified.
+
+A client expires when it's not updated during its trusting period, and it's frozen when a
+misbehaviour of the chain it tracks is submitted. The packets of the channels of the expired and
+frozen clients can't be relayed, these clients can only be recovered by a governance proposal.
+
+The clients that expire within a third of their trusting period, or within the duration of
+--warn-before, are reported and they are updated with --update. The clients are updated by Hermes,
+select it with "--backend hermes".
+`,
+		RunE: relayerClientsHandler,
+	}
+
+	c.Flags().Bool(flagClientsUpdate, false, "Update the clients that expire soon")
+	c.Flags().AddFlagSet(flagSetClientsWarnBefore())
+	c.Flags().AddFlagSet(flagSetRelayerBackend())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetKeyringDir())
+
+	return c
+}
+
+func flagSetClientsWarnBefore() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Duration(
+		flagClientsWarnBefore,
+		0,
+		"Report the clients that expire within the duration, a third of their trusting period by default",
+	)
+	return fs
+}
+
+func relayerClientsHandler(cmd *cobra.Command, args []string) (err error) {
+	defer func() {
+		err = handleRelayerAccountErr(err)
+	}()
+
+	var (
+		update, _     = cmd.Flags().GetBool(flagClientsUpdate)
+		warnBefore, _ = cmd.Flags().GetDuration(flagClientsWarnBefore)
+	)
+
+	session := cliui.New()
+	defer session.End()
+
+	r, err := newRelayer(cmd)
+	if err != nil {
+		return err
+	}
+
+	ids := args
+	if len(ids) == 0 {
+		paths, err := r.ListPaths(cmd.Context())
+		if err != nil {
+			return err
+		}
+		for _, path := range paths {
+			if path.Src.ChannelID != "" {
+				ids = append(ids, path.ID)
+			}
+		}
+	}
+
+	if len(ids) == 0 {
+		return session.Println("No linked paths found.")
+	}
+
+	session.StartSpinner("Querying the clients...")
+
+	var (
+		now     = time.Now()
+		entries [][]string
+		expire  []relayer.Client
+	)
+	for _, id := range ids {
+		clients, err := r.PathClients(cmd.Context(), id)
+		if err != nil {
+			return err
+		}
+		for _, c := range clients {
+			if c.ExpiresSoon(now, warnBefore) {
+				expire = append(expire, c)
+			}
+			entries = append(entries, []string{
+				c.PathID,
+				c.ChainID,
+				c.ClientID,
+				c.CounterpartyChainID,
+				c.Status,
+				c.LastUpdate.Format(time.RFC3339),
+				clientExpiry(c, now),
+			})
+		}
+	}
+
+	session.StopSpinner()
+
+	if err := session.PrintTable(relayerClientsHeader, entries...); err != nil {
+		return err
+	}
+
+	for _, c := range expire {
+		if !update {
+			if err := session.Printf(
+				"%s Client %s on chain %s expires in %s, update it with --update\n",
+				icons.NotOK,
+				c.ClientID,
+				c.ChainID,
+				clientExpiry(c, now),
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		session.StartSpinner(fmt.Sprintf("Updating client %s on chain %s...", c.ClientID, c.ChainID))
+
+		if err := r.UpdateClient(cmd.Context(), c.PathID, c.ChainID); err != nil {
+			return err
+		}
+
+		session.StopSpinner()
+
+		if err := session.Printf("%s Updated client %s on chain %s\n", icons.OK, c.ClientID, c.ChainID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// monitorClients checks the clients of the paths until ctx is canceled, the
+// clients that expire soon, that are expired or frozen are reported and the
+// clients that expire soon are updated when the auto-update-clients flag is set.
+func monitorClients(
+	ctx context.Context,
+	cmd *cobra.Command,
+	session *cliui.Session,
+	r relayer.Relayer,
+	pathIDs []string,
+) error {
+	var (
+		autoUpdate, _ = cmd.Flags().GetBool(flagAutoUpdateClients)
+		warnBefore, _ = cmd.Flags().GetDuration(flagClientsWarnBefore)
+	)
+
+	return r.MonitorClients(ctx, relayer.ClientsMonitor{
+		Warn:       warnBefore,
+		AutoUpdate: autoUpdate,
+		OnClient: func(c relayer.Client, updateErr error) {
+			switch {
+			case c.Status != relayer.ClientActive:
+				session.Printf(
+					"%s Client %s on chain %s of path %s is %s, the path can't be relayed\n",
+					icons.NotOK,
+					c.ClientID,
+					c.ChainID,
+					c.PathID,
+					c.Status,
+				)
+			case !autoUpdate:
+				session.Printf(
+					"%s Client %s on chain %s of path %s expires in %s\n",
+					icons.NotOK,
+					c.ClientID,
+					c.ChainID,
+					c.PathID,
+					clientExpiry(c, time.Now()),
+				)
+			case updateErr != nil:
+				session.Printf(
+					"%s Client %s on chain %s of path %s can't be updated: %s\n",
+					icons.NotOK,
+					c.ClientID,
+					c.ChainID,
+					c.PathID,
+					updateErr,
+				)
+			default:
+				session.Printf("%s Updated client %s on chain %s of path %s\n", icons.OK, c.ClientID, c.ChainID, c.PathID)
+			}
+		},
+		OnError: func(pathID string, err error) {
+			session.Printf("%s Clients of path %s can't be checked: %s\n", icons.NotOK, pathID, err)
+		},
+	}, pathIDs...)
+}
+
+// clientExpiry returns the time until the expiry of the client.
+func clientExpiry(c relayer.Client, now time.Time) string {
+	if c.Status != relayer.ClientActive {
+		return entrywriter.None
+	}
+	return c.ExpiresAt.Sub(now).Truncate(time.Second).String()
+}
@@ -0,0 +1,53 @@
+package ignitecmd
+
+import (
+	"github.com/gookit/color"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+)
+
+// NewRelayerClose returns a new relayer close command to close the channel of a path.
+func NewRelayerClose() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "close [path]",
+		Short: "Close the IBC channel of a linked path",
+		Long: `Close the IBC channel of a linked path on both chains.
+
+The path is unlinked once its channel is closed, so "ignite relayer connect" links it again
+with a new channel. The channels are closed by Hermes, select it with "--backend hermes".
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: relayerCloseHandler,
+	}
+
+	c.Flags().AddFlagSet(flagSetRelayerBackend())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetKeyringDir())
+
+	return c
+}
+
+func relayerCloseHandler(cmd *cobra.Command, args []string) (err error) {
+	defer func() {
+		err = handleRelayerAccountErr(err)
+	}()
+
+	session := cliui.New()
+	defer session.End()
+
+	r, err := newRelayer(cmd)
+	if err != nil {
+		return err
+	}
+
+	session.StartSpinner("Closing the channel...")
+
+	if err := r.ClosePath(cmd.Context(), args[0]); err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	return session.Printf("⛓  Closed the channel of path %s\n", color.Green.Sprint(args[0]))
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/gookit/color"
+	"github.com/manifoldco/promptui"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/ignite/cli/ignite/pkg/cliui/cliquiz"
 	"github.com/ignite/cli/ignite/pkg/cliui/entrywriter"
 	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
 	"github.com/ignite/cli/ignite/pkg/relayer"
 	relayerconfig "github.com/ignite/cli/ignite/pkg/relayer/config"
 )
@@ -37,6 +39,10 @@ const (
 	flagReset               = "reset"
 	flagSourceClientID      = "source-client-id"
 	flagTargetClientID      = "target-client-id"
+	flagSourceGRPC          = "source-grpc"
+	flagTargetGRPC          = "target-grpc"
+	flagSourceConnection    = "source-connection"
+	flagNewConnection       = "new-connection"
 
 	relayerSource = "source"
 	relayerTarget = "target"
@@ -44,8 +50,8 @@ const (
 	defaultSourceRPCAddress = "http://localhost:26657"
 	defaultTargetRPCAddress = "https://rpc.cosmos.network:443"
 
-	defautSourceGasPrice      = "0.00025stake"
-	defautTargetGasPrice      = "0.025uatom"
+	defautSourceGasPrice      = cosmosclient.GasPricesAuto
+	defautTargetGasPrice      = cosmosclient.GasPricesAuto
 	defautSourceGasLimit      = 300000
 	defautTargetGasLimit      = 300000
 	defautSourceAddressPrefix = "cosmos"
@@ -84,6 +90,10 @@ func NewRelayerConfigure() *cobra.Command {
 	c.Flags().BoolP(flagReset, "r", false, "Reset the relayer config")
 	c.Flags().String(flagSourceClientID, "", "use a custom client id for source")
 	c.Flags().String(flagTargetClientID, "", "use a custom client id for target")
+	c.Flags().String(flagSourceGRPC, "", "gRPC address of the source chain, used by the Hermes backend")
+	c.Flags().String(flagTargetGRPC, "", "gRPC address of the target chain, used by the Hermes backend")
+	c.Flags().String(flagSourceConnection, "", "Existing connection of the source chain to the target chain reused by the channel")
+	c.Flags().Bool(flagNewConnection, false, "Create new clients and a new connection instead of reusing an existing connection")
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
 	c.Flags().AddFlagSet(flagSetKeyringDir())
 
@@ -310,6 +320,8 @@ func relayerConfigureHandler(cmd *cobra.Command, _ []string) (err error) {
 	var (
 		sourceClientID, _ = cmd.Flags().GetString(flagSourceClientID)
 		targetClientID, _ = cmd.Flags().GetString(flagTargetClientID)
+		sourceGRPC, _     = cmd.Flags().GetString(flagSourceGRPC)
+		targetGRPC, _     = cmd.Flags().GetString(flagTargetGRPC)
 		reset, _          = cmd.Flags().GetBool(flagReset)
 
 		questions []cliquiz.Question
@@ -399,6 +411,7 @@ func relayerConfigureHandler(cmd *cobra.Command, _ []string) (err error) {
 		sourceGasLimit,
 		sourceAddressPrefix,
 		sourceClientID,
+		sourceGRPC,
 	)
 	if err != nil {
 		return err
@@ -420,6 +433,7 @@ func relayerConfigureHandler(cmd *cobra.Command, _ []string) (err error) {
 		targetGasLimit,
 		targetAddressPrefix,
 		targetClientID,
+		targetGRPC,
 	)
 	if err != nil {
 		return err
@@ -429,10 +443,21 @@ func relayerConfigureHandler(cmd *cobra.Command, _ []string) (err error) {
 		return err
 	}
 
+	// reuse an existing connection between the chains
+	var channelOptions []relayer.ChannelOption
+	if sourceClientID == "" && targetClientID == "" {
+		connection, err := selectConnection(cmd, session, r, sourceChain.ID, targetChain.ID)
+		if err != nil {
+			return err
+		}
+		if connection != nil {
+			channelOptions = append(channelOptions, relayer.ReuseConnection(*connection))
+		}
+	}
+
 	session.StartSpinner("Configuring...")
 
 	// sets advanced channel options
-	var channelOptions []relayer.ChannelOption
 	if advanced {
 		channelOptions = append(channelOptions,
 			relayer.SourcePort(sourcePort),
@@ -455,6 +480,74 @@ func relayerConfigureHandler(cmd *cobra.Command, _ []string) (err error) {
 	return session.Printf("⛓  Configured chains: %s\n\n", color.Green.Sprint(id))
 }
 
+// selectConnection returns the existing connection between the chains reused
+// by the channel of the path, or nil to create new clients and a new
+// connection. The connection is selected with the source connection flag,
+// otherwise the user is asked to reuse the first open connection.
+func selectConnection(
+	cmd *cobra.Command,
+	session *cliui.Session,
+	r relayer.Relayer,
+	sourceChainID,
+	targetChainID string,
+) (*relayer.Connection, error) {
+	var (
+		sourceConnection, _ = cmd.Flags().GetString(flagSourceConnection)
+		newConnection, _    = cmd.Flags().GetBool(flagNewConnection)
+	)
+	if newConnection {
+		if sourceConnection != "" {
+			return nil, fmt.Errorf("--%s and --%s can't be used together", flagSourceConnection, flagNewConnection)
+		}
+		return nil, nil
+	}
+
+	session.StartSpinner("Looking for existing connections...")
+	connections, err := r.Connections(cmd.Context(), sourceChainID, targetChainID)
+	session.StopSpinner()
+	if err != nil {
+		if sourceConnection != "" {
+			return nil, err
+		}
+		session.Println(color.Yellow.Sprintf("cannot list the connections, creating a new connection: %s", err))
+		return nil, nil
+	}
+
+	if sourceConnection != "" {
+		for _, c := range connections {
+			if c.Src.ConnectionID == sourceConnection {
+				return &c, nil
+			}
+		}
+		return nil, fmt.Errorf(
+			"connection %s is not an open connection of chain %s to chain %s with active clients",
+			sourceConnection,
+			sourceChainID,
+			targetChainID,
+		)
+	}
+
+	for _, c := range connections {
+		question := fmt.Sprintf(
+			"Reuse the connection %s (%s) of %s and %s (%s) of %s",
+			c.Src.ConnectionID,
+			c.SrcClientID,
+			sourceChainID,
+			c.Dst.ConnectionID,
+			c.DstClientID,
+			targetChainID,
+		)
+		if err := session.AskConfirm(question); err != nil {
+			if errors.Is(err, promptui.ErrAbort) {
+				continue
+			}
+			return nil, err
+		}
+		return &c, nil
+	}
+	return nil, nil
+}
+
 // initChain initializes chain information for the relayer connection
 func initChain(
 	cmd *cobra.Command,
@@ -467,7 +560,8 @@ func initChain(
 	gasPrice string,
 	gasLimit int64,
 	addressPrefix,
-	clientID string,
+	clientID,
+	grpcAddr string,
 ) (*relayer.Chain, error) {
 	defer session.StopSpinner()
 	session.StartSpinner(fmt.Sprintf("Initializing chain %s...", name))
@@ -480,6 +574,7 @@ func initChain(
 		relayer.WithGasLimit(gasLimit),
 		relayer.WithAddressPrefix(addressPrefix),
 		relayer.WithClientID(clientID),
+		relayer.WithGRPCAddress(grpcAddr),
 	)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot resolve %s", name)
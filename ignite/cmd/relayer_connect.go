@@ -6,22 +6,36 @@ import (
 	"text/tabwriter"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/ignite/cli/ignite/pkg/cliui"
 	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
 	"github.com/ignite/cli/ignite/pkg/relayer"
+	relayerconf "github.com/ignite/cli/ignite/pkg/relayer/config"
 )
 
+const flagPort = "port"
+
 // NewRelayerConnect returns a new relayer connect command to link all or some relayer paths and start
 // relaying txs in between.
-// if not paths are specified, all paths are linked.
+// if not paths are specified, all paths are linked, the paths can be filtered by port.
 func NewRelayerConnect() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "connect [<path>,...]",
 		Short: "Link chains associated with paths and start relaying tx packets in between",
-		RunE:  relayerConnectHandler,
+		Long: `Link chains associated with paths and start relaying tx packets in between.
+
+The light clients of the paths are checked while the packets are relayed, the clients that expire
+soon, that are expired or frozen are reported. The clients that expire soon are updated with
+--auto-update-clients, they are updated by Hermes, select it with "--backend hermes".
+`,
+		RunE: relayerConnectHandler,
 	}
 
+	c.Flags().StringSlice(flagPort, nil, "Link and relay the paths with a channel on one of the IBC ports only")
+	c.Flags().Bool(flagAutoUpdateClients, false, "Update the light clients of the paths that expire soon")
+	c.Flags().AddFlagSet(flagSetClientsWarnBefore())
+	c.Flags().AddFlagSet(flagSetRelayerBackend())
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
 	c.Flags().AddFlagSet(flagSetKeyringDir())
 
@@ -48,10 +62,15 @@ func relayerConnectHandler(cmd *cobra.Command, args []string) (err error) {
 		return err
 	}
 
+	backend, err := relayerBackend(cmd, ca)
+	if err != nil {
+		return err
+	}
+
 	var (
 		use []string
 		ids = args
-		r   = relayer.New(ca)
+		r   = relayer.New(ca, relayer.WithBackend(backend))
 	)
 
 	all, err := r.ListPaths(cmd.Context())
@@ -76,6 +95,19 @@ func relayerConnectHandler(cmd *cobra.Command, args []string) (err error) {
 		}
 	}
 
+	ports, _ := cmd.Flags().GetStringSlice(flagPort)
+	if len(ports) > 0 {
+		var filtered []string
+		for _, id := range use {
+			for _, path := range all {
+				if path.ID == id && hasPathPort(path, ports) {
+					filtered = append(filtered, id)
+				}
+			}
+		}
+		use = filtered
+	}
+
 	if len(use) == 0 {
 		return session.Println("No chains found to connect.")
 	}
@@ -116,5 +148,23 @@ func relayerConnectHandler(cmd *cobra.Command, args []string) (err error) {
 		return err
 	}
 
-	return r.StartPaths(cmd.Context(), use...)
+	g, ctx := errgroup.WithContext(cmd.Context())
+
+	g.Go(func() error {
+		return r.StartPaths(ctx, use...)
+	})
+	g.Go(func() error {
+		return monitorClients(ctx, cmd, session, r, use)
+	})
+	return g.Wait()
+}
+
+// hasPathPort returns true when one of the ends of the path has one of the ports.
+func hasPathPort(path relayerconf.Path, ports []string) bool {
+	for _, port := range ports {
+		if path.Src.PortID == port || path.Dst.PortID == port {
+			return true
+		}
+	}
+	return false
 }
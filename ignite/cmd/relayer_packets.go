@@ -0,0 +1,122 @@
+package ignitecmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/entrywriter"
+	"github.com/ignite/cli/ignite/pkg/relayer"
+)
+
+const (
+	flagPacketsLimit  = "limit"
+	flagPacketsStatus = "status"
+)
+
+var packetsHeader = []string{"Sequence", "Status", "Ack", "Ack error"}
+
+// NewRelayerPackets returns a new relayer packets command to inspect the packets of the channel of a path.
+func NewRelayerPackets() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "packets [path]",
+		Short: "List the pending, relayed and timed-out packets of the IBC channel of a linked path",
+		Long: `List the packets sent in both directions on the IBC channel of a linked path, with their
+sequence, their status and the status of their acknowledgement.
+
+The packets are:
+
+- pending: sent and not received yet by the counterparty chain.
+- timed-out: not received before their timeout, they must be timed out on their source chain
+  to refund their senders.
+- relayed: received by the counterparty chain, their acknowledgement is pending until it's
+  relayed back to the source chain.
+
+All the packets that are not acknowledged are listed, and the latest acknowledged packets.
+The timeouts of the packets and the errors of the acknowledgements are read from the
+transactions, they are unknown when the nodes don't index the transactions.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: relayerPacketsHandler,
+	}
+
+	c.Flags().Uint64(flagPacketsLimit, relayer.DefaultPacketsLimit, "Max number of acknowledged packets listed for each direction")
+	c.Flags().String(flagPacketsStatus, "", "List the packets with the status only (pending|timed-out|relayed)")
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetKeyringDir())
+
+	return c
+}
+
+func relayerPacketsHandler(cmd *cobra.Command, args []string) error {
+	var (
+		limit, _  = cmd.Flags().GetUint64(flagPacketsLimit)
+		status, _ = cmd.Flags().GetString(flagPacketsStatus)
+	)
+
+	switch relayer.PacketStatus(status) {
+	case "", relayer.PacketPending, relayer.PacketTimedOut, relayer.PacketRelayed:
+	default:
+		return fmt.Errorf("invalid status %q, use pending, timed-out or relayed", status)
+	}
+
+	session := cliui.New()
+	defer session.End()
+
+	r, err := newRelayer(cmd)
+	if err != nil {
+		return err
+	}
+
+	session.StartSpinner("Querying the packets...")
+
+	channels, err := r.ListPackets(cmd.Context(), args[0], limit)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	for _, c := range channels {
+		if err := printSection(session, fmt.Sprintf(
+			"%s %s/%s > %s %s/%s",
+			c.Src.ChainID, c.Src.PortID, c.Src.ChannelID,
+			c.Dst.ChainID, c.Dst.PortID, c.Dst.ChannelID,
+		)); err != nil {
+			return err
+		}
+
+		var entries [][]string
+		for _, p := range c.Packets {
+			if status != "" && string(p.Status) != status {
+				continue
+			}
+
+			var (
+				ack    = string(p.AckStatus)
+				ackErr = p.AckError
+			)
+			if ack == "" {
+				ack = entrywriter.None
+			}
+			if ackErr == "" {
+				ackErr = entrywriter.None
+			}
+			entries = append(entries, []string{strconv.FormatUint(p.Sequence, 10), string(p.Status), ack, ackErr})
+		}
+
+		if len(entries) == 0 {
+			if err := session.Println("No packets found."); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := session.PrintTable(packetsHeader, entries...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
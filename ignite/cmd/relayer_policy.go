@@ -0,0 +1,71 @@
+package ignitecmd
+
+import (
+	"github.com/gookit/color"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	relayerconf "github.com/ignite/cli/ignite/pkg/relayer/config"
+)
+
+const (
+	flagPolicyInterval = "interval"
+	flagPolicyMaxGas   = "max-gas"
+	flagPolicyMemo     = "memo"
+)
+
+// NewRelayerPolicy returns a new relayer policy command to set the relay policy of a path.
+func NewRelayerPolicy() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "policy [path]",
+		Short: "Set the relay policy of a path",
+		Long: `Set the relay policy of a path, the policy is applied the next time the path is relayed.
+
+The paths are relayed concurrently and the packets of each path are relayed as soon as they are
+sent. The TypeScript relayer is notified of the packets by the WebSocket events of the chains, and
+relays the packets at the interval of the policy while the events are not available.
+
+The relayer account must be able to pay the max gas of the path to relay it with the TypeScript
+relayer. Hermes uses the max gas and the memo of the transactions of the paths, the chains of
+several paths use the highest max gas and the first memo of their paths.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: relayerPolicyHandler,
+	}
+
+	c.Flags().String(flagPolicyInterval, "", "Interval between the relays of the packets of the path (e.g. 30s), 5s by default")
+	c.Flags().Int64(flagPolicyMaxGas, 0, "Max gas of the relayer transactions of the path")
+	c.Flags().String(flagPolicyMemo, "", "Memo of the relayer transactions of the path")
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetKeyringDir())
+
+	return c
+}
+
+func relayerPolicyHandler(cmd *cobra.Command, args []string) error {
+	var (
+		interval, _ = cmd.Flags().GetString(flagPolicyInterval)
+		maxGas, _   = cmd.Flags().GetInt64(flagPolicyMaxGas)
+		memo, _     = cmd.Flags().GetString(flagPolicyMemo)
+	)
+
+	session := cliui.New()
+	defer session.End()
+
+	r, err := newRelayer(cmd)
+	if err != nil {
+		return err
+	}
+
+	policy := relayerconf.Policy{
+		Interval: interval,
+		MaxGas:   maxGas,
+		Memo:     memo,
+	}
+	if err := r.SetPolicy(cmd.Context(), args[0], policy); err != nil {
+		return err
+	}
+
+	return session.Printf("%s Updated the relay policy of path %s\n", icons.OK, color.Green.Sprint(args[0]))
+}
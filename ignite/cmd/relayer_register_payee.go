@@ -0,0 +1,67 @@
+package ignitecmd
+
+import (
+	"github.com/gookit/color"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+)
+
+const (
+	flagSourcePayee = "source-payee"
+	flagTargetPayee = "target-payee"
+)
+
+// NewRelayerRegisterPayee returns a new relayer register-payee command to register the counterparty
+// payees of the relayer for the ICS-29 fee middleware.
+func NewRelayerRegisterPayee() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "register-payee [path]",
+		Short: "Register the counterparty payees of the relayer for the ICS-29 fee middleware",
+		Long: `Register the counterparty payees of the relayer account on both ends of the IBC channel
+of a linked path, the channel must be wrapped by the ICS-29 fee middleware.
+
+The relayer is paid on the source chain for the packets it relays to the target chain, and on
+the target chain for the packets it relays to the source chain. The relayer accounts are paid
+by default.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: relayerRegisterPayeeHandler,
+	}
+
+	c.Flags().String(flagSourcePayee, "", "Address on the source chain paid for the packets relayed to the target chain")
+	c.Flags().String(flagTargetPayee, "", "Address on the target chain paid for the packets relayed to the source chain")
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetKeyringDir())
+
+	return c
+}
+
+func relayerRegisterPayeeHandler(cmd *cobra.Command, args []string) (err error) {
+	defer func() {
+		err = handleRelayerAccountErr(err)
+	}()
+
+	var (
+		sourcePayee, _ = cmd.Flags().GetString(flagSourcePayee)
+		targetPayee, _ = cmd.Flags().GetString(flagTargetPayee)
+	)
+
+	session := cliui.New()
+	defer session.End()
+
+	r, err := newRelayer(cmd)
+	if err != nil {
+		return err
+	}
+
+	session.StartSpinner("Registering the counterparty payees...")
+
+	if err := r.RegisterPayees(cmd.Context(), args[0], sourcePayee, targetPayee); err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	return session.Printf("⛓  Registered the counterparty payees of path %s\n", color.Green.Sprint(args[0]))
+}
@@ -0,0 +1,90 @@
+package ignitecmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/entrywriter"
+)
+
+const flagStatusJSON = "json"
+
+var relayerStatusHeader = []string{"Path", "Source", "Target", "State", "Last relay", "Error"}
+
+// NewRelayerStatus returns a new relayer status command to show the status of the paths.
+func NewRelayerStatus() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "status",
+		Short: "Show the status of the relayer paths",
+		Long: `Show the status of the relayer paths, with their channels, their relay policy and
+the state of their relay.
+
+The paths are idle until they are relayed by "ignite relayer connect" or "ignite chain serve",
+they are stopped when the relayer stops and failed when an error stops their relay.
+`,
+		Args: cobra.NoArgs,
+		RunE: relayerStatusHandler,
+	}
+
+	c.Flags().Bool(flagStatusJSON, false, "Print the status of the paths in JSON")
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetKeyringDir())
+
+	return c
+}
+
+func relayerStatusHandler(cmd *cobra.Command, _ []string) error {
+	session := cliui.New()
+	defer session.End()
+
+	r, err := newRelayer(cmd)
+	if err != nil {
+		return err
+	}
+
+	statuses, err := r.Status(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	if asJSON, _ := cmd.Flags().GetBool(flagStatusJSON); asJSON {
+		bz, err := json.MarshalIndent(statuses, "", "  ")
+		if err != nil {
+			return err
+		}
+		return session.Println(string(bz))
+	}
+
+	if len(statuses) == 0 {
+		return session.Println("No paths found.")
+	}
+
+	entries := make([][]string, 0, len(statuses))
+	for _, s := range statuses {
+		lastRelay := entrywriter.None
+		if s.LastRelay != nil {
+			lastRelay = s.LastRelay.Format(time.RFC3339)
+		}
+		entries = append(entries, []string{
+			s.ID,
+			fmt.Sprintf("%s %s/%s", s.Src.ChainID, s.Src.PortID, valueOrNone(s.Src.ChannelID)),
+			fmt.Sprintf("%s %s/%s", s.Dst.ChainID, s.Dst.PortID, valueOrNone(s.Dst.ChannelID)),
+			string(s.State),
+			lastRelay,
+			valueOrNone(s.Error),
+		})
+	}
+	return session.PrintTable(relayerStatusHeader, entries...)
+}
+
+// valueOrNone returns value or entrywriter.None when value is empty.
+func valueOrNone(value string) string {
+	if value == "" {
+		return entrywriter.None
+	}
+	return value
+}
@@ -0,0 +1,156 @@
+package ignitecmd
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/gookit/color"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/relayer"
+)
+
+const (
+	flagTransferReceiver      = "receiver"
+	flagTransferMemo          = "memo"
+	flagTransferTimeout       = "timeout"
+	flagTransferTimeoutHeight = "timeout-height"
+	flagTransferReverse       = "reverse"
+	flagTransferWait          = "wait"
+)
+
+// NewRelayerTransfer returns a new relayer transfer command to send an ICS-20 transfer on the channel of a path.
+func NewRelayerTransfer() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "transfer [path] [amount]",
+		Short: "Send an ICS-20 transfer on the IBC channel of a linked path",
+		Long: `Send an ICS-20 transfer from the relayer account of the source chain of a linked path to its
+target chain, or from the target chain to the source chain with --reverse. The relayer account
+of the destination chain receives the tokens by default.
+
+The memo of the transfer is read by middlewares like the packet forward middleware and the
+contract callbacks, the chains must run ibc-go v5.1 or later to accept it.
+
+The acknowledgement of the transfer is decoded when the packet is relayed within the duration
+of --wait, the path must be relayed by "ignite relayer connect" or "ignite chain serve".
+`,
+		Example: `  ignite relayer transfer mars-venus 10stake
+  ignite relayer transfer mars-venus 10stake --memo '{"forward":{"receiver":"cosmos1...","port":"transfer","channel":"channel-1"}}'`,
+		Args: cobra.ExactArgs(2),
+		RunE: relayerTransferHandler,
+	}
+
+	c.Flags().String(flagTransferReceiver, "", "Address of the receiver, the relayer account of the destination chain by default")
+	c.Flags().String(flagTransferMemo, "", "Memo of the transfer")
+	c.Flags().Duration(flagTransferTimeout, relayer.DefaultTransferTimeout, "Timeout of the transfer after the block time of the destination chain, 0 to disable it")
+	c.Flags().Uint64(flagTransferTimeoutHeight, 0, "Timeout of the transfer in blocks of the destination chain")
+	c.Flags().Bool(flagTransferReverse, false, "Send the transfer from the target chain of the path to its source chain")
+	c.Flags().Duration(flagTransferWait, time.Minute, "Duration to wait for the acknowledgement of the transfer, 0 to not wait")
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetKeyringDir())
+
+	return c
+}
+
+func relayerTransferHandler(cmd *cobra.Command, args []string) (err error) {
+	defer func() {
+		err = handleRelayerAccountErr(err)
+	}()
+
+	var (
+		receiver, _      = cmd.Flags().GetString(flagTransferReceiver)
+		memo, _          = cmd.Flags().GetString(flagTransferMemo)
+		timeout, _       = cmd.Flags().GetDuration(flagTransferTimeout)
+		timeoutHeight, _ = cmd.Flags().GetUint64(flagTransferTimeoutHeight)
+		reverse, _       = cmd.Flags().GetBool(flagTransferReverse)
+		wait, _          = cmd.Flags().GetDuration(flagTransferWait)
+	)
+
+	session := cliui.New()
+	defer session.End()
+
+	r, err := newRelayer(cmd)
+	if err != nil {
+		return err
+	}
+
+	session.StartSpinner("Sending the transfer...")
+
+	sent, err := r.Transfer(cmd.Context(), relayer.Transfer{
+		PathID:        args[0],
+		Reverse:       reverse,
+		Amount:        args[1],
+		Receiver:      receiver,
+		Memo:          memo,
+		TimeoutHeight: timeoutHeight,
+		Timeout:       timeout,
+	})
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	if err := session.Printf(
+		"⛓  Sent %s from %s to %s on chain %s (sequence %d, tx %s)\n",
+		color.Green.Sprint(args[1]),
+		sent.Sender,
+		sent.Receiver,
+		sent.Dst.ChainID,
+		sent.Sequence,
+		sent.TxHash,
+	); err != nil {
+		return err
+	}
+
+	if wait <= 0 {
+		return nil
+	}
+
+	session.StartSpinner("Waiting for the acknowledgement...")
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), wait)
+	defer cancel()
+
+	ack, err := r.WaitAcknowledgement(ctx, sent)
+	if errors.Is(err, context.DeadlineExceeded) {
+		session.StopSpinner()
+		return session.Printf(
+			"%s The transfer is not acknowledged yet, check its packet with \"ignite relayer packets %s\"\n",
+			icons.NotOK,
+			args[0],
+		)
+	}
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	switch {
+	case ack.TimedOut:
+		return session.Printf(
+			"%s The transfer timed out, its packet must be timed out on chain %s to refund the sender\n",
+			icons.NotOK,
+			sent.Src.ChainID,
+		)
+	case ack.Error != "":
+		return session.Printf("%s The transfer failed: %s\n", icons.NotOK, ack.Error)
+	default:
+		return session.Printf("%s The transfer is acknowledged with the result %s\n", icons.OK, ackResult(ack.Result))
+	}
+}
+
+// ackResult returns the JSON result of an acknowledgement, or its base64
+// encoding when it's not JSON like the results of the ICS-20 transfers.
+func ackResult(result []byte) string {
+	if json.Valid(result) {
+		return string(result)
+	}
+	return base64.StdEncoding.EncodeToString(result)
+}
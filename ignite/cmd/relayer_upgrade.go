@@ -0,0 +1,91 @@
+package ignitecmd
+
+import (
+	"fmt"
+
+	"github.com/gookit/color"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/relayer"
+)
+
+const (
+	flagChannelVersion    = "version"
+	flagChannelOrdering   = "ordering"
+	flagChannelConnection = "connection"
+)
+
+// NewRelayerUpgrade returns a new relayer upgrade command to initiate the upgrade of the channel of a path.
+func NewRelayerUpgrade() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "upgrade [path]",
+		Short: "Initiate the upgrade of the IBC channel of a linked path",
+		Long: `Initiate the upgrade of the IBC channel of a linked path on its source chain.
+
+The upgrade handshake is completed by the relayer while it relays the packets of the path
+with "ignite relayer connect". The channels are upgraded by Hermes, select it with
+"--backend hermes". The chains must support channel upgrades and allow the relayer account
+to initiate them.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: relayerUpgradeHandler,
+	}
+
+	c.Flags().String(flagChannelVersion, "", "Version of the channel after the upgrade")
+	c.Flags().String(flagChannelOrdering, "", "Ordering of the channel after the upgrade (ordered|unordered)")
+	c.Flags().String(flagChannelConnection, "", "Connection of the channel on the source chain after the upgrade")
+	c.Flags().AddFlagSet(flagSetRelayerBackend())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetKeyringDir())
+
+	return c
+}
+
+func relayerUpgradeHandler(cmd *cobra.Command, args []string) (err error) {
+	defer func() {
+		err = handleRelayerAccountErr(err)
+	}()
+
+	var (
+		version, _    = cmd.Flags().GetString(flagChannelVersion)
+		ordering, _   = cmd.Flags().GetString(flagChannelOrdering)
+		connection, _ = cmd.Flags().GetString(flagChannelConnection)
+		upgrade       = relayer.ChannelUpgrade{Version: version, ConnectionID: connection}
+	)
+
+	switch ordering {
+	case "":
+	case "ordered":
+		upgrade.Ordering = relayer.OrderingOrdered
+	case "unordered":
+		upgrade.Ordering = relayer.OrderingUnordered
+	default:
+		return fmt.Errorf("invalid ordering %q, use ordered or unordered", ordering)
+	}
+
+	if upgrade == (relayer.ChannelUpgrade{}) {
+		return fmt.Errorf("set the --%s, --%s or --%s flags to upgrade the channel", flagChannelVersion, flagChannelOrdering, flagChannelConnection)
+	}
+
+	session := cliui.New()
+	defer session.End()
+
+	r, err := newRelayer(cmd)
+	if err != nil {
+		return err
+	}
+
+	session.StartSpinner("Initiating the channel upgrade...")
+
+	if err := r.UpgradePath(cmd.Context(), args[0], upgrade); err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	return session.Printf(
+		"⛓  Initiated the channel upgrade of path %s, relay the path to complete the upgrade handshake\n",
+		color.Green.Sprint(args[0]),
+	)
+}
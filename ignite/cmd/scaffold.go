@@ -9,6 +9,7 @@ import (
 
 	"github.com/ignite/cli/ignite/pkg/cliui"
 	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
 	"github.com/ignite/cli/ignite/pkg/xgit"
 	"github.com/ignite/cli/ignite/services/scaffolder"
 )
@@ -18,9 +19,12 @@ const (
 	flagModule       = "module"
 	flagNoMessage    = "no-message"
 	flagNoSimulation = "no-simulation"
+	flagNoEvents     = "no-events"
 	flagResponse     = "response"
 	flagDescription  = "desc"
 
+	flagSecondaryIndex = "secondary-index"
+
 	statusScaffolding = "Scaffolding..."
 )
 
@@ -39,7 +43,25 @@ corresponding command with a "--help" flag, for example, "ignite scaffold chain
 The Ignite team strongly recommends committing the code to a version control
 system before running scaffolding commands. This will make it easier to see the
 changes to the source code as well as undo the command if you've decided to roll
-back the changes.
+back the changes. The last scaffolding command can also be reverted with the
+"ignite scaffold undo" command.
+
+To review the changes before they are applied, run a scaffolding command with
+the "--dry-run" flag. The unified diff of the files the command would create or
+modify, including the code added to "app/app.go", is printed and no file is
+written. The command fails when placeholders are missing in the source code,
+which makes it usable in code reviews and CI:
+
+  ignite scaffold list post title body --dry-run
+
+The code generated from the proto files is not part of the diff.
+
+The "--plan" flag prints the same changes as a JSON document for review tools
+and documentation generators. Each created or modified file is listed with a
+summary of its hunks: the unified diff header, the number of lines added and
+removed, and the placeholders the hunk inserts code at:
+
+  ignite scaffold list post title body --plan
 
 This blockchain you create with the chain scaffolding command uses the modular
 Cosmos SDK framework and imports many standard modules for functionality like
@@ -81,7 +103,20 @@ in the "Msg" service.
 If you're building an application with custom IBC logic, you might need to
 scaffold IBC packets. An IBC packet represents the data sent from one blockchain
 to another. You can only scaffold IBC packets in IBC-enabled modules scaffolded
-with an "--ibc" flag. Note that the default module is not IBC-enabled.
+with an "--ibc" flag. Note that the default module is not IBC-enabled. IBC
+middlewares, which wrap the token transfer IBC application, can be scaffolded
+in any module.
+
+Once the blockchain is live, new versions of the app are deployed with on-chain
+upgrades. The upgrade scaffolding command generates the upgrade handler and the
+migrations of the modules changed by the upgrade. Features can be shipped
+dormant behind feature flags, module params enabled later by governance.
+
+Some modules come with their logic, like the price oracle module scaffolded
+with "ignite scaffold oracle" and its reference feeder daemon.
+
+The scaffolded code can be customized with template packs that override or
+extend the built-in templates, see "ignite scaffold template --help".
 `,
 		Aliases: []string{"s"},
 		Args:    cobra.ExactArgs(1),
@@ -93,12 +128,24 @@ with an "--ibc" flag. Note that the default module is not IBC-enabled.
 	c.AddCommand(NewScaffoldMap())
 	c.AddCommand(NewScaffoldSingle())
 	c.AddCommand(NewScaffoldType())
+	c.AddCommand(NewScaffoldField())
 	c.AddCommand(NewScaffoldMessage())
 	c.AddCommand(NewScaffoldQuery())
+	c.AddCommand(NewScaffoldImportProto())
 	c.AddCommand(NewScaffoldPacket())
+	c.AddCommand(NewScaffoldIBCMiddleware())
 	c.AddCommand(NewScaffoldBandchain())
 	c.AddCommand(NewScaffoldVue())
-	// c.AddCommand(NewScaffoldWasm())
+	c.AddCommand(NewScaffoldWasm())
+	c.AddCommand(NewScaffoldSDKModule())
+	c.AddCommand(NewScaffoldUpgrade())
+	c.AddCommand(NewScaffoldFeature())
+	c.AddCommand(NewScaffoldOracle())
+	c.AddCommand(NewScaffoldProposalHandlers())
+	c.AddCommand(NewScaffoldICA())
+	c.AddCommand(NewScaffoldNFT())
+	c.AddCommand(NewScaffoldTemplate())
+	c.AddCommand(NewScaffoldUndo())
 
 	return c
 }
@@ -114,8 +161,10 @@ func scaffoldType(
 		moduleName        = flagGetModule(cmd)
 		withoutMessage    = flagGetNoMessage(cmd)
 		withoutSimulation = flagGetNoSimulation(cmd)
+		withoutEvents     = flagGetNoEvents(cmd)
 		signer            = flagGetSigner(cmd)
 		appPath           = flagGetPath(cmd)
+		secondaryIndexes  = flagGetSecondaryIndexes(cmd)
 	)
 
 	var options []scaffolder.AddTypeOption
@@ -126,6 +175,9 @@ func scaffoldType(
 	if moduleName != "" {
 		options = append(options, scaffolder.TypeWithModule(moduleName))
 	}
+	if len(secondaryIndexes) > 0 {
+		options = append(options, scaffolder.TypeWithSecondaryIndexes(secondaryIndexes...))
+	}
 	if withoutMessage {
 		options = append(options, scaffolder.TypeWithoutMessage())
 	} else {
@@ -135,12 +187,21 @@ func scaffoldType(
 		if withoutSimulation {
 			options = append(options, scaffolder.TypeWithoutSimulation())
 		}
+		if withoutEvents {
+			options = append(options, scaffolder.TypeWithoutEvents())
+		}
 	}
 
 	session := cliui.New(cliui.StartSpinnerWithText(statusScaffolding))
 	defer session.End()
 
-	sc, err := newApp(appPath)
+	templatePack, err := flagGetTemplatePack(cmd)
+	if err != nil {
+		return err
+	}
+
+	preview := flagGetPreview(cmd)
+	sc, err := newApp(appPath, scaffolder.WithTemplatePack(templatePack), scaffolder.WithPreview(preview))
 	if err != nil {
 		return err
 	}
@@ -150,7 +211,14 @@ func scaffoldType(
 		return err
 	}
 
-	sm, err := sc.AddType(cmd.Context(), cacheStorage, typeName, placeholder.New(), kind, options...)
+	var sm xgenny.SourceModification
+	err = sc.Record(scaffoldOperationName(cmd, args), func() (err error) {
+		sm, err = sc.AddType(cmd.Context(), cacheStorage, typeName, placeholder.New(), kind, options...)
+		return err
+	})
+	if preview != nil {
+		return printPreview(cmd, session, preview, err)
+	}
 	if err != nil {
 		return err
 	}
@@ -167,8 +235,8 @@ func scaffoldType(
 }
 
 func gitChangesConfirmPreRunHandler(cmd *cobra.Command, args []string) error {
-	// Don't confirm when the "--yes" flag is present
-	if getYes(cmd) {
+	// Don't confirm when the "--yes" flag is present or when the changes are not applied
+	if getYes(cmd) || flagGetDryRun(cmd) {
 		return nil
 	}
 
@@ -219,6 +287,17 @@ func flagGetNoSimulation(cmd *cobra.Command) bool {
 	return noMessage
 }
 
+func flagSetNoEvents() *flag.FlagSet {
+	f := flag.NewFlagSet("", flag.ContinueOnError)
+	f.Bool(flagNoEvents, false, "Disable typed events scaffolding")
+	return f
+}
+
+func flagGetNoEvents(cmd *cobra.Command) bool {
+	noEvents, _ := cmd.Flags().GetBool(flagNoEvents)
+	return noEvents
+}
+
 func flagGetNoMessage(cmd *cobra.Command) bool {
 	noMessage, _ := cmd.Flags().GetBool(flagNoMessage)
 	return noMessage
@@ -228,3 +307,14 @@ func flagGetSigner(cmd *cobra.Command) string {
 	signer, _ := cmd.Flags().GetString(flagSigner)
 	return signer
 }
+
+func flagSetSecondaryIndexes() *flag.FlagSet {
+	f := flag.NewFlagSet("", flag.ContinueOnError)
+	f.StringSlice(flagSecondaryIndex, []string{}, "fields that index the values in addition to the primary key, each one with a paginated query")
+	return f
+}
+
+func flagGetSecondaryIndexes(cmd *cobra.Command) []string {
+	indexes, _ := cmd.Flags().GetStringSlice(flagSecondaryIndex)
+	return indexes
+}
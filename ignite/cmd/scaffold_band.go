@@ -7,6 +7,7 @@ import (
 
 	"github.com/ignite/cli/ignite/pkg/cliui"
 	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
 	"github.com/ignite/cli/ignite/services/scaffolder"
 )
 
@@ -32,6 +33,7 @@ func NewScaffoldBandchain() *cobra.Command {
 
 	flagSetPath(c)
 	flagSetClearCache(c)
+	flagSetDryRun(c)
 
 	c.Flags().AddFlagSet(flagSetYes())
 	c.Flags().String(flagModule, "", "IBC Module to add the packet into")
@@ -68,13 +70,21 @@ func createBandchainHandler(cmd *cobra.Command, args []string) error {
 		options = append(options, scaffolder.OracleWithSigner(signer)) // nolint: staticcheck
 	}
 
-	sc, err := newApp(appPath)
+	preview := flagGetPreview(cmd)
+	sc, err := newApp(appPath, scaffolder.WithPreview(preview))
 	if err != nil {
 		return err
 	}
 
 	// nolint: staticcheck
-	sm, err := sc.AddOracle(cmd.Context(), cacheStorage, placeholder.New(), module, oracle, options...)
+	var sm xgenny.SourceModification
+	err = sc.Record(scaffoldOperationName(cmd, args), func() (err error) {
+		sm, err = sc.AddOracle(cmd.Context(), cacheStorage, placeholder.New(), module, oracle, options...)
+		return err
+	})
+	if preview != nil {
+		return printPreview(cmd, session, preview, err)
+	}
 	if err != nil {
 		return err
 	}
@@ -65,6 +65,13 @@ speed up the build process. To clear the cache when building a blockchain use
 the "--clear-cache" flag. It is very unlikely you will ever need to use this
 flag.
 
+By default the blockchain wires its modules manually in "app/app.go". To create
+a blockchain based on Cosmos SDK v0.47 with modules wired by dependency
+injection in "app/app_config.go" and commands registered with AutoCLI use the
+"--app-wiring" flag:
+
+  ignite scaffold chain foo --app-wiring modern
+
 The blockchain is using the Cosmos SDK modular blockchain framework. Learn more
 about Cosmos SDK on https://docs.cosmos.network
 `,
@@ -73,6 +80,9 @@ about Cosmos SDK on https://docs.cosmos.network
 	}
 
 	flagSetClearCache(c)
+	flagSetTemplatePack(c)
+	flagSetDryRun(c)
+	flagSetAppWiring(c, "wiring of the app modules, modern uses dependency injection and AutoCLI [auto|legacy|modern]")
 	c.Flags().AddFlagSet(flagSetAccountPrefixes())
 	c.Flags().StringP(flagPath, "p", ".", "Create a project in a specific path")
 	c.Flags().Bool(flagNoDefaultModule, false, "Create a project without a default module")
@@ -91,6 +101,27 @@ func scaffoldChainHandler(cmd *cobra.Command, args []string) error {
 		noDefaultModule, _ = cmd.Flags().GetBool(flagNoDefaultModule)
 	)
 
+	var options []scaffolder.InitOption
+
+	templatePack, err := flagGetTemplatePack(cmd)
+	if err != nil {
+		return err
+	}
+	if templatePack != "" {
+		options = append(options, scaffolder.InitWithTemplatePack(templatePack))
+	}
+
+	preview := flagGetPreview(cmd)
+	if preview != nil {
+		options = append(options, scaffolder.InitWithPreview(preview))
+	}
+
+	appWiring, err := flagGetAppWiring(cmd)
+	if err != nil {
+		return err
+	}
+	options = append(options, scaffolder.InitWithAppWiring(appWiring))
+
 	cacheStorage, err := newCache(cmd)
 	if err != nil {
 		return err
@@ -98,8 +129,11 @@ func scaffoldChainHandler(cmd *cobra.Command, args []string) error {
 
 	appdir, err := scaffolder.Init(
 		cmd.Context(), cacheStorage, placeholder.New(), appPath, name,
-		addressPrefix, noDefaultModule,
+		addressPrefix, noDefaultModule, options...,
 	)
+	if preview != nil {
+		return printPreview(cmd, session, preview, err)
+	}
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,106 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/services/scaffolder"
+)
+
+const flagGateMessage = "gate-message"
+
+// NewScaffoldFeature returns a command to scaffold a feature flag in a module.
+func NewScaffoldFeature() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "feature [name]",
+		Short: "Feature flag backed by a module param to ship dormant features",
+		Long: `Scaffold a feature flag in a module: a boolean param that gates the code of a
+feature, so the feature can be shipped with a release of the chain and enabled
+later with a param change proposal.
+
+  ignite scaffold feature payouts --module mars
+
+The "PayoutsEnabled" param is added to the params of the module. The param is
+false by default, including on the chains that upgrade to the version that adds
+the feature. The keeper of the module has the "PayoutsEnabled" method to check
+the flag and the "CheckPayoutsEnabled" method that returns an error when the
+feature is disabled.
+
+The handlers of the messages of the feature can reject the messages while the
+feature is disabled:
+
+  ignite scaffold feature payouts --module mars --gate-message create-payout
+
+On a running development chain, enable or disable the feature with:
+
+  ignite chain feature enable mars payouts
+`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: gitChangesConfirmPreRunHandler,
+		RunE:    scaffoldFeatureHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+	flagSetDryRun(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().String(flagModule, "", "Module to add the feature into. Default: app's main module")
+	c.Flags().StringSlice(flagGateMessage, []string{}, "messages rejected while the feature is disabled")
+
+	return c
+}
+
+func scaffoldFeatureHandler(cmd *cobra.Command, args []string) error {
+	var (
+		name        = args[0]
+		appPath     = flagGetPath(cmd)
+		module, _   = cmd.Flags().GetString(flagModule)
+		messages, _ = cmd.Flags().GetStringSlice(flagGateMessage)
+	)
+
+	session := cliui.New(cliui.StartSpinnerWithText(statusScaffolding))
+	defer session.End()
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	preview := flagGetPreview(cmd)
+	sc, err := newApp(appPath, scaffolder.WithPreview(preview))
+	if err != nil {
+		return err
+	}
+
+	var sm xgenny.SourceModification
+	err = sc.Record(scaffoldOperationName(cmd, args), func() (err error) {
+		sm, err = sc.AddFeature(
+			cmd.Context(),
+			cacheStorage,
+			placeholder.New(),
+			module,
+			name,
+			scaffolder.WithGatedMessages(messages...),
+		)
+		return err
+	})
+	if preview != nil {
+		return printPreview(cmd, session, preview, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	session.Println(modificationsStr)
+	session.Printf("\n🎉 Feature `%[1]v` created.\n\n", name)
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/services/scaffolder"
+)
+
+const flagNoMigration = "no-migration"
+
+// NewScaffoldField returns a command to add fields to an existing type.
+func NewScaffoldField() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "field [type] [field]...",
+		Short: "Add fields to an existing type and migrate the module state",
+		Long: `Add new fields to a type scaffolded with "ignite scaffold list", "map", "single"
+or "type".
+
+  ignite scaffold field post body views:uint
+
+The fields are added at the end of the proto message of the type with new field
+numbers, so the values stored before the change are still decoded. When the
+type has messages, the fields are also added to its create and update messages,
+to the events emitted by the msg server and to the values created and updated
+by the msg server. The create and update CLI commands get an optional flag for
+each field, their args are not changed:
+
+  marsd tx mars create-post "Hello" --body "World" --views 3
+
+The update command replaces the value with the values of its args and flags, the
+fields without a flag are set to their default value.
+
+Changing the schema of a deployed chain requires a migration of its state. The
+consensus version of the module is bumped and a migration stub is registered
+in "x/<module>/keeper/migrations.go". The existing values have the default value
+of the new fields, implement the migration when these values are not valid. The
+migration is run by the next upgrade of the chain, the migration being already
+registered the upgrade is scaffolded without the "--migrate-module" flag:
+
+  ignite scaffold upgrade v2
+
+Use the "--no-migration" flag when the chain is not deployed yet.
+`,
+		Args:    cobra.MinimumNArgs(2),
+		PreRunE: gitChangesConfirmPreRunHandler,
+		RunE:    scaffoldFieldHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+	flagSetDryRun(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().String(flagModule, "", "module of the type (default: app's main module)")
+	c.Flags().Bool(flagNoMigration, false, "don't bump the consensus version of the module and don't register a migration")
+
+	return c
+}
+
+func scaffoldFieldHandler(cmd *cobra.Command, args []string) error {
+	var (
+		typeName       = args[0]
+		fields         = args[1:]
+		appPath        = flagGetPath(cmd)
+		moduleName     = flagGetModule(cmd)
+		noMigration, _ = cmd.Flags().GetBool(flagNoMigration)
+	)
+
+	session := cliui.New(cliui.StartSpinnerWithText(statusScaffolding))
+	defer session.End()
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	var options []scaffolder.AddFieldOption
+	if moduleName != "" {
+		options = append(options, scaffolder.FieldWithModule(moduleName))
+	}
+	if noMigration {
+		options = append(options, scaffolder.FieldWithoutMigration())
+	}
+
+	preview := flagGetPreview(cmd)
+	sc, err := newApp(appPath, scaffolder.WithPreview(preview))
+	if err != nil {
+		return err
+	}
+
+	var sm xgenny.SourceModification
+	err = sc.Record(scaffoldOperationName(cmd, args), func() (err error) {
+		sm, err = sc.AddField(cmd.Context(), cacheStorage, placeholder.New(), typeName, fields, options...)
+		return err
+	})
+	if preview != nil {
+		return printPreview(cmd, session, preview, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	session.Println(modificationsStr)
+	session.Printf("\n🎉 Fields added to `%[1]v`.\n\n", typeName)
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package ignitecmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/services/scaffolder"
+)
+
+// NewScaffoldIBCMiddleware creates a new IBC middleware in the module
+func NewScaffoldIBCMiddleware() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "ibc-middleware [name] --module [moduleName]",
+		Short: "IBC middleware wrapping the transfer stack",
+		Long: `Scaffold an IBC middleware (ICS-4 wrapper) in a specific Cosmos SDK module.
+
+The middleware implements the "porttypes.Middleware" interface: every IBC
+application callback and ICS-4 call is forwarded to the wrapped application.
+Acknowledgement and timeout callbacks emit an event before being forwarded,
+the places where custom logic belongs are marked with a "TODO" comment.
+
+The middleware is added to the transfer stack in "app/app.go". Middlewares
+are applied in the order they are scaffolded, so the last scaffolded
+middleware is the first one to be called by core IBC:
+
+  ignite scaffold ibc-middleware fee --module blog
+
+The module keeper is passed to the middleware, so the middleware can read and
+write the module state.`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: gitChangesConfirmPreRunHandler,
+		RunE:    createIBCMiddlewareHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+	flagSetDryRun(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().String(flagModule, "", "Module to add the middleware into")
+
+	return c
+}
+
+func createIBCMiddlewareHandler(cmd *cobra.Command, args []string) error {
+	var (
+		name    = args[0]
+		appPath = flagGetPath(cmd)
+	)
+
+	session := cliui.New(cliui.StartSpinnerWithText(statusScaffolding))
+	defer session.End()
+
+	module, err := cmd.Flags().GetString(flagModule)
+	if err != nil {
+		return err
+	}
+	if module == "" {
+		return errors.New("please specify a module to create the middleware into: --module <module_name>")
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	preview := flagGetPreview(cmd)
+	sc, err := newApp(appPath, scaffolder.WithPreview(preview))
+	if err != nil {
+		return err
+	}
+
+	var sm xgenny.SourceModification
+	err = sc.Record(scaffoldOperationName(cmd, args), func() (err error) {
+		sm, err = sc.AddIBCMiddleware(cmd.Context(), cacheStorage, placeholder.New(), module, name)
+		return err
+	})
+	if preview != nil {
+		return printPreview(cmd, session, preview, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	session.Println(modificationsStr)
+	session.Printf("\n🎉 Created an IBC middleware `%[1]v`.\n\n", name)
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package ignitecmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/services/scaffolder"
+	"github.com/ignite/cli/ignite/templates/ica"
+)
+
+const (
+	flagController    = "controller"
+	flagHost          = "host"
+	flagAllowMessages = "allow-messages"
+)
+
+// NewScaffoldICA returns a command to wire the interchain accounts in the app.
+func NewScaffoldICA() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "ica [module]",
+		Short: "Interchain accounts (ICS-27) controller and host",
+		Long: `Wire the controller and the host submodules of the ICS-27 interchain accounts in
+the app. Both submodules are wired unless one of the "--controller" or "--host"
+flags is used.
+
+The controller submodule registers interchain accounts on other chains, the host
+chains, and sends txs to the accounts. A module that demonstrates how to use the
+controller is created, the controller middleware of ibc-go wraps the module in
+"app/app.go":
+
+  ignite scaffold ica intertx --controller
+
+The module has the following messages and queries:
+
+* "MsgRegisterAccount": registers an interchain account of the signer on the
+  host chain of a connection
+* "MsgSubmitTx": executes a message with the interchain account of the signer
+* "InterchainAccount": queries the address of an interchain account
+
+The host submodule lets the accounts of other chains execute messages on the
+chain. The interchain accounts are allowed to execute the messages of the
+"--allow-messages" flag in the genesis of "config.yml":
+
+  ignite scaffold ica --host --allow-messages /cosmos.bank.v1beta1.MsgSend
+
+The "ica" directory contains the config files to test the interchain accounts
+locally: the config of a host chain served next to the chain of "config.yml" and
+the config of the Hermes relayer that completes the handshakes of the channels of
+the accounts:
+
+  ignite chain serve
+  ignite chain serve -c ica/host.yml
+  hermes --config ica/hermes.toml create connection --a-chain mars --b-chain mars-host
+  hermes --config ica/hermes.toml start
+  marsd tx intertx register-account connection-0 --from alice
+`,
+		Args:    cobra.MaximumNArgs(1),
+		PreRunE: gitChangesConfirmPreRunHandler,
+		RunE:    scaffoldICAHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+	flagSetTemplatePack(c)
+	flagSetDryRun(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().Bool(flagController, false, "scaffold a module that registers interchain accounts with the controller submodule")
+	c.Flags().Bool(flagHost, false, "allow the interchain accounts of other chains to execute messages with the host submodule")
+	c.Flags().StringSlice(flagAllowMessages, ica.DefaultAllowMessages, "messages the interchain accounts of the host chains are allowed to execute")
+
+	return c
+}
+
+func scaffoldICAHandler(cmd *cobra.Command, args []string) error {
+	var (
+		appPath          = flagGetPath(cmd)
+		controller, _    = cmd.Flags().GetBool(flagController)
+		host, _          = cmd.Flags().GetBool(flagHost)
+		allowMessages, _ = cmd.Flags().GetStringSlice(flagAllowMessages)
+	)
+
+	// Both submodules are wired by default
+	if !controller && !host {
+		controller, host = true, true
+	}
+
+	var options []scaffolder.ICAOption
+	if controller {
+		if len(args) == 0 {
+			return errors.New("please specify the name of the module that uses the controller submodule")
+		}
+		options = append(options, scaffolder.ICAWithController(args[0]))
+	} else if len(args) > 0 {
+		return errors.New("the module is only created with the controller submodule")
+	}
+	if host {
+		options = append(options, scaffolder.ICAWithHost())
+	}
+	options = append(options, scaffolder.ICAWithAllowMessages(allowMessages...))
+
+	session := cliui.New(cliui.StartSpinnerWithText(statusScaffolding))
+	defer session.End()
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	templatePack, err := flagGetTemplatePack(cmd)
+	if err != nil {
+		return err
+	}
+
+	preview := flagGetPreview(cmd)
+	sc, err := newApp(
+		appPath,
+		scaffolder.WithTemplatePack(templatePack),
+		scaffolder.WithPreview(preview),
+	)
+	if err != nil {
+		return err
+	}
+
+	var sm xgenny.SourceModification
+	err = sc.Record(scaffoldOperationName(cmd, args), func() (err error) {
+		sm, err = sc.AddICA(cmd.Context(), cacheStorage, placeholder.New(), options...)
+		return err
+	})
+	if preview != nil {
+		return printPreview(cmd, session, preview, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	session.Println(modificationsStr)
+	session.Printf("\n🎉 Interchain accounts wired in the app.\n\n")
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/services/scaffolder"
+)
+
+// NewScaffoldImportProto scaffolds the messages and queries defined in existing proto files
+func NewScaffoldImportProto() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "import-proto [path]",
+		Short: "Messages and queries from existing proto definitions",
+		Long: `Scaffold the messages and queries defined in existing proto files.
+
+The path is a proto file or a directory containing proto files, for example
+the proto files of a module defined by another blockchain:
+
+  ignite scaffold import-proto ./proto/foo --module blog
+
+Every RPC of a "Msg" service is scaffolded as a message (like "ignite scaffold
+message") and every RPC of a "Query" service is scaffolded as a query (like
+"ignite scaffold query"). This generates the proto definitions, the keeper
+handlers and the CLI commands in the module.
+
+A request field named "creator", "sender", "signer" or "authority" is used
+as the message signer. Queries with a "cosmos.base.query.v1beta1.PageRequest"
+request field are scaffolded as paginated queries.
+
+Supported field types are the scalar types "string", "bool", signed and
+unsigned integers, "cosmos.base.v1beta1.Coin" and their repeated versions.
+Non repeated fields can also use a message defined in the module as a custom
+type. Services other than "Msg" and "Query" are not supported.
+
+All the RPCs are checked before the app is modified, nothing is scaffolded
+when one of them can't be imported.`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: gitChangesConfirmPreRunHandler,
+		RunE:    scaffoldImportProtoHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+	flagSetDryRun(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().String(flagModule, "", "Module to add the messages and queries into. Default: app's main module")
+
+	return c
+}
+
+func scaffoldImportProtoHandler(cmd *cobra.Command, args []string) error {
+	var (
+		protoPath = args[0]
+		appPath   = flagGetPath(cmd)
+	)
+
+	session := cliui.New(cliui.StartSpinnerWithText(statusScaffolding))
+	defer session.End()
+
+	module, err := cmd.Flags().GetString(flagModule)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	preview := flagGetPreview(cmd)
+	sc, err := newApp(appPath, scaffolder.WithPreview(preview))
+	if err != nil {
+		return err
+	}
+
+	var sm xgenny.SourceModification
+	err = sc.Record(scaffoldOperationName(cmd, args), func() (err error) {
+		sm, err = sc.ImportProto(cmd.Context(), cacheStorage, placeholder.New(), module, protoPath)
+		return err
+	})
+	if preview != nil {
+		return printPreview(cmd, session, preview, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	session.Println(modificationsStr)
+	session.Printf("\n🎉 Imported proto definitions from `%[1]v`.\n\n", protoPath)
+
+	return nil
+}
@@ -85,6 +85,24 @@ like to scaffold messages manually. Use a flag to skip message scaffolding:
 
 The "creator" field is not generated if a list is scaffolded with the
 "--no-message" flag.
+
+Values are listed with a paginated query. To query the values by the value of
+one of their fields, index them with the "--secondary-index" flag. A paginated
+query is scaffolded for each index, and the keeper keeps the indexes up to date
+when the values are created, updated and deleted:
+
+  ignite scaffold list post title body author --secondary-index author
+
+Only the fields of type string, bool, int and uint can be indexed.
+
+The messages emit typed events when a value is created, updated or deleted:
+EventCreatePost, EventUpdatePost and EventDeletePost. The events are defined in
+"proto/{app}/{module}/events.proto" with the signer, the ID and the fields of
+the value, so the transactions can be queried by their attributes:
+
+  blogd q txs --events 'blog.blog.EventCreatePost.creator="cosmos1..."'
+
+Use the "--no-events" flag to skip the events scaffolding.
 `,
 		Args:    cobra.MinimumNArgs(1),
 		PreRunE: gitChangesConfirmPreRunHandler,
@@ -93,9 +111,13 @@ The "creator" field is not generated if a list is scaffolded with the
 
 	flagSetPath(c)
 	flagSetClearCache(c)
+	flagSetTemplatePack(c)
+	flagSetDryRun(c)
 
 	c.Flags().AddFlagSet(flagSetYes())
 	c.Flags().AddFlagSet(flagSetScaffoldType())
+	c.Flags().AddFlagSet(flagSetSecondaryIndexes())
+	c.Flags().AddFlagSet(flagSetNoEvents())
 
 	return c
 }
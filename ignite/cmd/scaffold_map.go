@@ -51,6 +51,12 @@ With this command, you would get a "Product" value indexed by both a category
 and a GUID (globally unique ID). This will let you programmatically fetch
 product values that have the same category but are using different GUIDs.
 
+Values can also be queried by the value of one of their fields with a secondary
+index. A paginated query is scaffolded for each field of the "--secondary-index"
+flag:
+
+  ignite scaffold map product price desc vendor --index guid --secondary-index vendor
+
 Since the behavior of "list" and "map" scaffolding is very similar, you can use
 the "--no-message", "--module", "--signer" flags as well as the colon syntax for
 custom types.
@@ -62,10 +68,13 @@ custom types.
 
 	flagSetPath(c)
 	flagSetClearCache(c)
+	flagSetTemplatePack(c)
+	flagSetDryRun(c)
 
 	c.Flags().AddFlagSet(flagSetYes())
 	c.Flags().AddFlagSet(flagSetScaffoldType())
 	c.Flags().StringSlice(FlagIndexes, []string{"index"}, "fields that index the value")
+	c.Flags().AddFlagSet(flagSetSecondaryIndexes())
 
 	return c
 }
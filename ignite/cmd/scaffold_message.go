@@ -5,6 +5,7 @@ import (
 
 	"github.com/ignite/cli/ignite/pkg/cliui"
 	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
 	"github.com/ignite/cli/ignite/services/scaffolder"
 )
 
@@ -55,6 +56,11 @@ specify response fields and their types. For example
 The command above will scaffold MsgCreatePost which returns both an ID (an
 integer) and a title (a string).
 
+The handler emits a typed event, EventCreatePost for the message above, with the
+signer and the fields of the message. The event is defined in
+"proto/{app}/{module}/events.proto". Use the "--no-events" flag to skip the event
+scaffolding.
+
 Message scaffolding follows the rules as "ignite scaffold list/map/single" and
 supports fields with standard and custom types. See "ignite scaffold list —help"
 for details.
@@ -66,11 +72,14 @@ for details.
 
 	flagSetPath(c)
 	flagSetClearCache(c)
+	flagSetTemplatePack(c)
+	flagSetDryRun(c)
 
 	c.Flags().AddFlagSet(flagSetYes())
 	c.Flags().String(flagModule, "", "Module to add the message into. Default: app's main module")
 	c.Flags().StringSliceP(flagResponse, "r", []string{}, "Response fields")
 	c.Flags().Bool(flagNoSimulation, false, "Disable CRUD simulation scaffolding")
+	c.Flags().AddFlagSet(flagSetNoEvents())
 	c.Flags().StringP(flagDescription, "d", "", "Description of the command")
 	c.Flags().String(flagSigner, "", "Label for the message signer (default: creator)")
 
@@ -85,6 +94,7 @@ func messageHandler(cmd *cobra.Command, args []string) error {
 		signer            = flagGetSigner(cmd)
 		appPath           = flagGetPath(cmd)
 		withoutSimulation = flagGetNoSimulation(cmd)
+		withoutEvents     = flagGetNoEvents(cmd)
 	)
 
 	session := cliui.New(cliui.StartSpinnerWithText(statusScaffolding))
@@ -112,12 +122,30 @@ func messageHandler(cmd *cobra.Command, args []string) error {
 		options = append(options, scaffolder.WithoutSimulation())
 	}
 
-	sc, err := newApp(appPath)
+	// Skip scaffold typed events
+	if withoutEvents {
+		options = append(options, scaffolder.WithoutEvents())
+	}
+
+	templatePack, err := flagGetTemplatePack(cmd)
 	if err != nil {
 		return err
 	}
 
-	sm, err := sc.AddMessage(cmd.Context(), cacheStorage, placeholder.New(), module, args[0], args[1:], resFields, options...)
+	preview := flagGetPreview(cmd)
+	sc, err := newApp(appPath, scaffolder.WithTemplatePack(templatePack), scaffolder.WithPreview(preview))
+	if err != nil {
+		return err
+	}
+
+	var sm xgenny.SourceModification
+	err = sc.Record(scaffoldOperationName(cmd, args), func() (err error) {
+		sm, err = sc.AddMessage(cmd.Context(), cacheStorage, placeholder.New(), module, args[0], args[1:], resFields, options...)
+		return err
+	})
+	if preview != nil {
+		return printPreview(cmd, session, preview, err)
+	}
 	if err != nil {
 		return err
 	}
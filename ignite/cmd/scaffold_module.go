@@ -11,6 +11,7 @@ import (
 	"github.com/ignite/cli/ignite/pkg/cliui"
 	"github.com/ignite/cli/ignite/pkg/placeholder"
 	"github.com/ignite/cli/ignite/pkg/validation"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
 	"github.com/ignite/cli/ignite/services/scaffolder"
 	modulecreate "github.com/ignite/cli/ignite/templates/module/create"
 )
@@ -19,6 +20,8 @@ const (
 	flagDep                 = "dep"
 	flagIBC                 = "ibc"
 	flagParams              = "params"
+	flagHooks               = "hooks"
+	flagMetrics             = "metrics"
 	flagIBCOrdering         = "ordering"
 	flagRequireRegistration = "require-registration"
 
@@ -92,8 +95,27 @@ you can specify a type for each param. For example:
 
   ignite scaffold module foo --params baz:uint,bar:bool
 
-Refer to Cosmos SDK documentation to learn more about modules, dependencies and
-params.
+A module can expose hooks so that other modules are notified about its events,
+like the "staking" module does with its "StakingHooks". To scaffold a module
+with a hooks interface and a keeper "SetHooks" method use the "--hooks" flag:
+
+  ignite scaffold module foo --hooks
+
+To scaffold a module that emits metrics with the telemetry of the Cosmos SDK use
+the "--metrics" flag. The message handlers and the keeper methods of the types
+scaffolded afterwards in the module count their calls and measure their
+duration. The metrics are served by the API when the telemetry is enabled:
+
+  ignite scaffold module foo --metrics
+
+Apps using Cosmos SDK v0.47 or newer wire their modules with dependency
+injection. In these apps the module is added to the module orders in
+"app/app_config.go" and its commands are registered with AutoCLI. The wiring is
+detected from the Cosmos SDK version of the app, use the "--app-wiring" flag to
+select it explicitly. IBC modules are not supported by apps with modern wiring.
+
+Refer to Cosmos SDK documentation to learn more about modules, dependencies,
+params and hooks.
 `,
 		Args:    cobra.ExactArgs(1),
 		PreRunE: gitChangesConfirmPreRunHandler,
@@ -102,6 +124,9 @@ params.
 
 	flagSetPath(c)
 	flagSetClearCache(c)
+	flagSetTemplatePack(c)
+	flagSetDryRun(c)
+	flagSetAppWiring(c, "wiring of the app modules, detected from the Cosmos SDK version by default [auto|legacy|modern]")
 
 	c.Flags().AddFlagSet(flagSetYes())
 	c.Flags().StringSlice(flagDep, []string{}, "module dependencies (e.g. --dep account,bank)")
@@ -109,6 +134,8 @@ params.
 	c.Flags().String(flagIBCOrdering, "none", "channel ordering of the IBC module [none|ordered|unordered]")
 	c.Flags().Bool(flagRequireRegistration, false, "if true command will fail if module can't be registered")
 	c.Flags().StringSlice(flagParams, []string{}, "scaffold module params")
+	c.Flags().Bool(flagHooks, false, "scaffold module hooks interface")
+	c.Flags().Bool(flagMetrics, false, "scaffold module with telemetry metrics of its messages and keeper methods")
 
 	return c
 }
@@ -141,6 +168,16 @@ func scaffoldModuleHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	withHooks, err := cmd.Flags().GetBool(flagHooks)
+	if err != nil {
+		return err
+	}
+
+	withMetrics, err := cmd.Flags().GetBool(flagMetrics)
+	if err != nil {
+		return err
+	}
+
 	cacheStorage, err := newCache(cmd)
 	if err != nil {
 		return err
@@ -150,6 +187,14 @@ func scaffoldModuleHandler(cmd *cobra.Command, args []string) error {
 		scaffolder.WithParams(params),
 	}
 
+	if withHooks {
+		options = append(options, scaffolder.WithHooks())
+	}
+
+	if withMetrics {
+		options = append(options, scaffolder.WithMetrics())
+	}
+
 	// Check if the module must be an IBC module
 	if ibcModule {
 		options = append(options, scaffolder.WithIBCChannelOrdering(ibcOrdering), scaffolder.WithIBC())
@@ -184,12 +229,35 @@ func scaffoldModuleHandler(cmd *cobra.Command, args []string) error {
 	var msg bytes.Buffer
 	fmt.Fprintf(&msg, "\n🎉 Module created %s.\n\n", name)
 
-	sc, err := newApp(appPath)
+	templatePack, err := flagGetTemplatePack(cmd)
+	if err != nil {
+		return err
+	}
+
+	appWiring, err := flagGetAppWiring(cmd)
 	if err != nil {
 		return err
 	}
 
-	sm, err := sc.CreateModule(cmd.Context(), cacheStorage, placeholder.New(), name, options...)
+	preview := flagGetPreview(cmd)
+	sc, err := newApp(
+		appPath,
+		scaffolder.WithTemplatePack(templatePack),
+		scaffolder.WithPreview(preview),
+		scaffolder.WithAppWiring(appWiring),
+	)
+	if err != nil {
+		return err
+	}
+
+	var sm xgenny.SourceModification
+	err = sc.Record(scaffoldOperationName(cmd, args), func() (err error) {
+		sm, err = sc.CreateModule(cmd.Context(), cacheStorage, placeholder.New(), name, options...)
+		return err
+	})
+	if preview != nil {
+		return printPreview(cmd, session, preview, err)
+	}
 	if err != nil {
 		var validationErr validation.Error
 		if !requireRegistration && errors.As(err, &validationErr) {
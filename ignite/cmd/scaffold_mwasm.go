@@ -5,18 +5,28 @@ import (
 
 	"github.com/ignite/cli/ignite/pkg/cliui"
 	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/services/scaffolder"
 )
 
 func NewScaffoldWasm() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "wasm",
 		Short: "Import the wasm module to your app",
-		Long:  "Add support for WebAssembly smart contracts to your blockchain",
-		Args:  cobra.NoArgs,
-		RunE:  scaffoldWasmHandler,
+		Long: `Add support for WebAssembly smart contracts to your blockchain.
+
+The x/wasm module is wired into "app/app.go", including its store key, keepers,
+IBC route and governance proposal handlers. The default wasm node options are
+added to the validators in "config.yml".
+
+The supported wasmd release is built on Cosmos SDK v0.45 and ibc-go v4, the
+module can't be imported in blockchains built on Cosmos SDK v0.46 or newer.`,
+		Args: cobra.NoArgs,
+		RunE: scaffoldWasmHandler,
 	}
 
 	flagSetPath(c)
+	flagSetDryRun(c)
 
 	return c
 }
@@ -32,12 +42,20 @@ func scaffoldWasmHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	sc, err := newApp(appPath)
+	preview := flagGetPreview(cmd)
+	sc, err := newApp(appPath, scaffolder.WithPreview(preview))
 	if err != nil {
 		return err
 	}
 
-	sm, err := sc.ImportModule(cmd.Context(), cacheStorage, placeholder.New(), "wasm")
+	var sm xgenny.SourceModification
+	err = sc.Record(scaffoldOperationName(cmd, args), func() (err error) {
+		sm, err = sc.ImportModule(cmd.Context(), cacheStorage, placeholder.New(), "wasm")
+		return err
+	})
+	if preview != nil {
+		return printPreview(cmd, session, preview, err)
+	}
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,109 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/services/scaffolder"
+)
+
+// NewScaffoldNFT returns a command to scaffold an NFT module based on the nft
+// module of the Cosmos SDK.
+func NewScaffoldNFT() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "nft [name]",
+		Short: "NFT module with classes, minting and transfers based on x/nft",
+		Long: `Scaffold a module that creates classes of NFTs, mints and transfers NFTs with the
+nft module of the Cosmos SDK. The nft module is enabled in the app when it's not
+already, which requires Cosmos SDK v0.46 or newer.
+
+  ignite scaffold nft collectibles
+
+The module has the following messages and queries:
+
+* "MsgCreateClass": creates a class of NFTs with the schema of the metadata of
+  its NFTs
+* "MsgMint": mints an NFT of a class created by the signer, the metadata of the
+  NFT must follow the schema of the class
+* "MsgTransfer": transfers an NFT owned by the signer
+* "Class": queries a class with its schema and its supply
+* "NFT": queries an NFT with its metadata and its owner
+* "NFTsOfOwner": queries the NFTs of a class owned by an address
+
+The schema of a class is a JSON object of the types of the metadata fields, by
+field name. The supported types are "string", "number" and "bool":
+
+  marsd tx collectibles create-class swords '{"name":"string","level":"number"}' --from alice
+  marsd tx collectibles mint swords excalibur '{"name":"Excalibur","level":99}' --from alice
+
+The TypeScript client of the new messages and queries is generated with the
+module when "client.typescript.path" is set in "config.yml".
+`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: gitChangesConfirmPreRunHandler,
+		RunE:    scaffoldNFTHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+	flagSetTemplatePack(c)
+	flagSetDryRun(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+
+	return c
+}
+
+func scaffoldNFTHandler(cmd *cobra.Command, args []string) error {
+	var (
+		name    = args[0]
+		appPath = flagGetPath(cmd)
+	)
+
+	session := cliui.New(cliui.StartSpinnerWithText(statusScaffolding))
+	defer session.End()
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	templatePack, err := flagGetTemplatePack(cmd)
+	if err != nil {
+		return err
+	}
+
+	preview := flagGetPreview(cmd)
+	sc, err := newApp(
+		appPath,
+		scaffolder.WithTemplatePack(templatePack),
+		scaffolder.WithPreview(preview),
+	)
+	if err != nil {
+		return err
+	}
+
+	var sm xgenny.SourceModification
+	err = sc.Record(scaffoldOperationName(cmd, args), func() (err error) {
+		sm, err = sc.CreateNFTModule(cmd.Context(), cacheStorage, placeholder.New(), name)
+		return err
+	})
+	if preview != nil {
+		return printPreview(cmd, session, preview, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	session.Println(modificationsStr)
+	session.Printf("\n🎉 NFT module `%[1]v` created.\n\n", name)
+
+	return nil
+}
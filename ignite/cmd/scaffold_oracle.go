@@ -0,0 +1,119 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/services/scaffolder"
+)
+
+const flagVoteExtensions = "vote-extensions"
+
+// NewScaffoldOracle returns a command to scaffold a price oracle module.
+func NewScaffoldOracle() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "oracle [name]",
+		Short: "Price oracle module with a reference feeder daemon",
+		Long: `Scaffold a module that aggregates the prices of symbols submitted by a set of
+feeders.
+
+  ignite scaffold oracle prices
+
+The feeders submit the prices with the "MsgSubmitPrice" message. At the end of
+each aggregation window, the price of a symbol is set to the median of the
+prices submitted during the window, when the symbol has enough submissions.
+The aggregated prices are queried with the "Price" and "PriceAll" queries.
+
+The module has the following params:
+
+* "window": number of blocks of an aggregation window
+* "minSubmissions": minimum number of submissions to aggregate a price
+* "feeders": addresses of the feeders, any account can submit prices when the
+  list is empty
+
+A reference feeder daemon is created in "tools/<name>feeder". The daemon fetches
+the prices from an HTTP source and submits them periodically:
+
+  go run ./tools/pricesfeeder --from alice --symbols ATOM-USD \
+    --url "https://prices.example.com/{symbol}"
+
+The prices are submitted with txs. Validators can submit the prices with the
+vote extensions of ABCI++ instead, which require Cosmos SDK v0.50 or newer. The
+"--vote-extensions" flag selects this mode and fails when the Cosmos SDK version
+of the app doesn't support vote extensions.
+`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: gitChangesConfirmPreRunHandler,
+		RunE:    scaffoldOracleHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+	flagSetTemplatePack(c)
+	flagSetDryRun(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().Bool(flagVoteExtensions, false, "submit the prices with the vote extensions of the validators")
+
+	return c
+}
+
+func scaffoldOracleHandler(cmd *cobra.Command, args []string) error {
+	var (
+		name              = args[0]
+		appPath           = flagGetPath(cmd)
+		voteExtensions, _ = cmd.Flags().GetBool(flagVoteExtensions)
+	)
+
+	session := cliui.New(cliui.StartSpinnerWithText(statusScaffolding))
+	defer session.End()
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	templatePack, err := flagGetTemplatePack(cmd)
+	if err != nil {
+		return err
+	}
+
+	var options []scaffolder.OracleModuleOption
+	if voteExtensions {
+		options = append(options, scaffolder.WithVoteExtensions())
+	}
+
+	preview := flagGetPreview(cmd)
+	sc, err := newApp(
+		appPath,
+		scaffolder.WithTemplatePack(templatePack),
+		scaffolder.WithPreview(preview),
+	)
+	if err != nil {
+		return err
+	}
+
+	var sm xgenny.SourceModification
+	err = sc.Record(scaffoldOperationName(cmd, args), func() (err error) {
+		sm, err = sc.CreateOracleModule(cmd.Context(), cacheStorage, placeholder.New(), name, options...)
+		return err
+	})
+	if preview != nil {
+		return printPreview(cmd, session, preview, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	session.Println(modificationsStr)
+	session.Printf("\n🎉 Oracle module `%[1]v` created.\n\n", name)
+
+	return nil
+}
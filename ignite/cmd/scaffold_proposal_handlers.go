@@ -0,0 +1,116 @@
+package ignitecmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/services/scaffolder"
+	"github.com/ignite/cli/ignite/templates/abci"
+)
+
+const flagMempool = "mempool"
+
+// NewScaffoldProposalHandlers returns a command to scaffold an app-side mempool
+// and the ABCI++ proposal handlers.
+func NewScaffoldProposalHandlers() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "proposal-handlers",
+		Short: "App-side mempool and ABCI++ proposal handlers to customize the order of the txs",
+		Long: fmt.Sprintf(`Scaffold an app-side mempool and the skeletons of the PrepareProposal and
+ProcessProposal handlers of ABCI++, to experiment with the selection and the
+order of the txs of the blocks.
+
+  ignite scaffold proposal-handlers --mempool priority
+
+The proposal handlers are defined in "app/proposal_handlers.go" and are set in
+the base app with the mempool. The proposer of a block selects its txs with the
+PrepareProposal handler, the validators verify the proposed block with the
+ProcessProposal handler before voting for it. The scaffolded handlers behave
+like the default handlers of the Cosmos SDK.
+
+The mempool is created in "app/mempool.go" from the "mempool" section of the
+app.toml config file of the node. The mempool types are:
+
+* %[1]s: no app-side mempool, the txs are ordered by CometBFT in FIFO order
+* %[2]s: the txs of a sender are ordered by nonce, the senders are selected randomly
+* %[3]s: the txs are ordered by priority, set from their fees by the ante handler
+
+The "--mempool" flag sets the type used when app.toml doesn't set one. Switch
+the type on a development chain in the config of the validators of config.yml:
+
+  validators:
+    - name: alice
+      bonded: 100000000stake
+      app:
+        mempool:
+          type: %[2]s
+          max-txs: 5000
+
+The proposal handlers require Cosmos SDK v0.47 or newer.
+`, abci.MempoolNoOp, abci.MempoolSenderNonce, abci.MempoolPriority),
+		Args:    cobra.NoArgs,
+		PreRunE: gitChangesConfirmPreRunHandler,
+		RunE:    scaffoldProposalHandlersHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+	flagSetDryRun(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().String(
+		flagMempool,
+		abci.MempoolNoOp,
+		fmt.Sprintf("default type of the mempool (%s)", strings.Join(abci.MempoolTypes, ", ")),
+	)
+
+	return c
+}
+
+func scaffoldProposalHandlersHandler(cmd *cobra.Command, args []string) error {
+	var (
+		appPath        = flagGetPath(cmd)
+		mempoolType, _ = cmd.Flags().GetString(flagMempool)
+	)
+
+	session := cliui.New(cliui.StartSpinnerWithText(statusScaffolding))
+	defer session.End()
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	preview := flagGetPreview(cmd)
+	sc, err := newApp(appPath, scaffolder.WithPreview(preview))
+	if err != nil {
+		return err
+	}
+
+	var sm xgenny.SourceModification
+	err = sc.Record(scaffoldOperationName(cmd, args), func() (err error) {
+		sm, err = sc.AddProposalHandlers(cmd.Context(), cacheStorage, placeholder.New(), mempoolType)
+		return err
+	})
+	if preview != nil {
+		return printPreview(cmd, session, preview, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	session.Println(modificationsStr)
+	session.Printf("\n🎉 Proposal handlers created with the %s mempool.\n\n", mempoolType)
+
+	return nil
+}
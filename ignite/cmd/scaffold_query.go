@@ -7,17 +7,47 @@ import (
 
 	"github.com/ignite/cli/ignite/pkg/cliui"
 	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/services/scaffolder"
 )
 
 const (
 	flagPaginated = "paginated"
+	flagRoute     = "route"
 )
 
 // NewScaffoldQuery command creates a new type command to scaffold queries
 func NewScaffoldQuery() *cobra.Command {
 	c := &cobra.Command{
-		Use:     "query [name] [request_field1] [request_field2] ...",
-		Short:   "Query to get data from the blockchain",
+		Use:   "query [name] [request_field1] [request_field2] ...",
+		Short: "Query to get data from the blockchain",
+		Long: `Query scaffolding adds a gRPC query to the "Query" service of a module, with
+a CLI command and a REST endpoint served by the API server.
+
+The request fields are passed as arguments and the response fields with the
+"--response" flag. Both accept any number of fields of the types supported by
+"ignite scaffold list", including repeated fields like "array.string":
+
+  ignite scaffold query posts-by-tag tags:array.string --response posts:array.string
+
+The query request and response messages are defined in
+"proto/{app}/{module}/query.proto". The handler is scaffolded in the "keeper"
+package, where you implement the query logic.
+
+The query is annotated with a "google.api.http" route, so it can be queried with
+the API server without additional code. By default the route is
+"/{app}/{module}/{query_name}" followed by the request fields that can be path
+parameters, the other fields like the repeated ones are query parameters. Use
+the "--route" flag to customize the route, its path parameters must be request
+fields:
+
+  ignite scaffold query post-by-title title --response id:uint --route /blog/posts/{title}
+
+Use the "--paginated" flag to add the pagination request and response fields to
+the query, the CLI command then accepts the pagination flags:
+
+  ignite scaffold query posts --response titles:array.string --paginated
+`,
 		Args:    cobra.MinimumNArgs(1),
 		PreRunE: gitChangesConfirmPreRunHandler,
 		RunE:    queryHandler,
@@ -25,12 +55,15 @@ func NewScaffoldQuery() *cobra.Command {
 
 	flagSetPath(c)
 	flagSetClearCache(c)
+	flagSetTemplatePack(c)
+	flagSetDryRun(c)
 
 	c.Flags().AddFlagSet(flagSetYes())
 	c.Flags().String(flagModule, "", "Module to add the query into. Default: app's main module")
 	c.Flags().StringSliceP(flagResponse, "r", []string{}, "Response fields")
 	c.Flags().StringP(flagDescription, "d", "", "Description of the command")
 	c.Flags().Bool(flagPaginated, false, "Define if the request can be paginated")
+	c.Flags().String(flagRoute, "", "Custom REST route of the query, e.g. /blog/posts/{id}")
 
 	return c
 }
@@ -68,17 +101,39 @@ func queryHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	var options []scaffolder.QueryOption
+	route, err := cmd.Flags().GetString(flagRoute)
+	if err != nil {
+		return err
+	}
+	if route != "" {
+		options = append(options, scaffolder.QueryWithRoute(route))
+	}
+
 	cacheStorage, err := newCache(cmd)
 	if err != nil {
 		return err
 	}
 
-	sc, err := newApp(appPath)
+	templatePack, err := flagGetTemplatePack(cmd)
 	if err != nil {
 		return err
 	}
 
-	sm, err := sc.AddQuery(cmd.Context(), cacheStorage, placeholder.New(), module, args[0], desc, args[1:], resFields, paginated)
+	preview := flagGetPreview(cmd)
+	sc, err := newApp(appPath, scaffolder.WithTemplatePack(templatePack), scaffolder.WithPreview(preview))
+	if err != nil {
+		return err
+	}
+
+	var sm xgenny.SourceModification
+	err = sc.Record(scaffoldOperationName(cmd, args), func() (err error) {
+		sm, err = sc.AddQuery(cmd.Context(), cacheStorage, placeholder.New(), module, args[0], desc, args[1:], resFields, paginated, options...)
+		return err
+	})
+	if preview != nil {
+		return printPreview(cmd, session, preview, err)
+	}
 	if err != nil {
 		return err
 	}
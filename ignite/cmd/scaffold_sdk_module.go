@@ -0,0 +1,96 @@
+package ignitecmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/services/scaffolder"
+)
+
+// NewScaffoldSDKModule returns a command to enable optional Cosmos SDK modules in the app.
+func NewScaffoldSDKModule() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "sdk-module [name]...",
+		Short: "Enable optional Cosmos SDK modules in your app",
+		Long: fmt.Sprintf(`Enable optional Cosmos SDK modules in a blockchain that doesn't use them yet.
+
+The modules are wired into "app/app.go": the store key, the keeper created after
+the keepers it depends on, the module basic, the app module registered in the
+module and simulation managers, and the module name added to the begin
+blockers, end blockers and init genesis order. The fee grant keeper is also
+added to the ante handler options and the nft module account to the module
+account permissions.
+
+  ignite scaffold sdk-module authz feegrant group
+
+Supported modules: %s. The group and nft modules require Cosmos SDK v0.46 or
+newer.
+
+Blockchains created with "ignite scaffold chain" already use the authz, feegrant
+and group modules.
+Enabling a module in a live blockchain also requires an upgrade that adds the
+module store.`, strings.Join(scaffolder.SDKModules(), ", ")),
+		Args:    cobra.MinimumNArgs(1),
+		PreRunE: gitChangesConfirmPreRunHandler,
+		RunE:    scaffoldSDKModuleHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+	flagSetDryRun(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+
+	return c
+}
+
+func scaffoldSDKModuleHandler(cmd *cobra.Command, args []string) error {
+	appPath := flagGetPath(cmd)
+
+	session := cliui.New(cliui.StartSpinnerWithText(statusScaffolding))
+	defer session.End()
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	preview := flagGetPreview(cmd)
+	sc, err := newApp(appPath, scaffolder.WithPreview(preview))
+	if err != nil {
+		return err
+	}
+
+	sm := xgenny.NewSourceModification()
+	err = sc.Record(scaffoldOperationName(cmd, args), func() error {
+		for _, name := range args {
+			moduleSm, err := sc.EnableSDKModule(cmd.Context(), cacheStorage, placeholder.New(), name)
+			if err != nil {
+				return err
+			}
+			sm.Merge(moduleSm)
+		}
+		return nil
+	})
+	if preview != nil {
+		return printPreview(cmd, session, preview, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	session.Println(modificationsStr)
+	session.Printf("\n🎉 Cosmos SDK modules enabled: %s.\n\n", strings.Join(args, ", "))
+
+	return nil
+}
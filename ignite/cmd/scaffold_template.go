@@ -0,0 +1,48 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewScaffoldTemplate returns a command to manage the template packs used to customize the scaffolding.
+func NewScaffoldTemplate() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "template [command]",
+		Short: "Manage the template packs that customize the scaffolded code",
+		Long: `Manage the template packs that override or extend the built-in scaffolding
+templates.
+
+A template pack is a local directory or a git repository that contains a folder
+for each scaffolding command it customizes: chain, module, message, query,
+packet, list, map, single and type. The files of these folders follow the
+layout of the files generated in the blockchain and are rendered with the same
+variables as the built-in templates:
+
+  module/
+    x/{{moduleName}}/keeper/keeper.go.plush
+  message/
+    x/{{moduleName}}/keeper/msg_server_{{msgName}}.go.plush
+
+A file with the same path as a built-in template replaces it, other files are
+generated in addition to the built-in ones.
+
+Register a pack from a git repository, optionally at a tag or branch:
+
+  ignite scaffold template add mycompany/standard github.com/mycompany/templates@v1.0.0
+
+Then select the pack with the "--template" flag of the scaffolding commands:
+
+  ignite scaffold module blog --template mycompany/standard
+
+The "--template" flag also accepts the path of a local pack directory that is
+not registered.
+`,
+		Args: cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(NewScaffoldTemplateAdd())
+	c.AddCommand(NewScaffoldTemplateList())
+	c.AddCommand(NewScaffoldTemplateRemove())
+
+	return c
+}
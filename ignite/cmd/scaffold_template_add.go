@@ -0,0 +1,34 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/services/templatepack"
+)
+
+// NewScaffoldTemplateAdd returns a command to register a template pack.
+func NewScaffoldTemplateAdd() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "add [name] [source]",
+		Short: "Register a template pack from a local directory or a git repository",
+		Args:  cobra.ExactArgs(2),
+		RunE:  scaffoldTemplateAddHandler,
+	}
+
+	return c
+}
+
+func scaffoldTemplateAddHandler(cmd *cobra.Command, args []string) error {
+	name, source := args[0], args[1]
+
+	session := cliui.New(cliui.StartSpinnerWithText("Adding template pack..."))
+	defer session.End()
+
+	if _, err := templatepack.Add(name, source); err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+	return session.Printf("🎉 Template pack `%[1]v` added.\n", name)
+}
@@ -0,0 +1,41 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/services/templatepack"
+)
+
+var templatePackHeader = []string{"name", "source"}
+
+// NewScaffoldTemplateList returns a command to list the registered template packs.
+func NewScaffoldTemplateList() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "list",
+		Short: "List the registered template packs",
+		Args:  cobra.NoArgs,
+		RunE:  scaffoldTemplateListHandler,
+	}
+
+	return c
+}
+
+func scaffoldTemplateListHandler(cmd *cobra.Command, _ []string) error {
+	session := cliui.New()
+	defer session.End()
+
+	packs, err := templatepack.List()
+	if err != nil {
+		return err
+	}
+	if len(packs) == 0 {
+		return session.Println("No template packs registered")
+	}
+
+	var entries [][]string
+	for _, p := range packs {
+		entries = append(entries, []string{p.Name, p.Source})
+	}
+	return session.PrintTable(templatePackHeader, entries...)
+}
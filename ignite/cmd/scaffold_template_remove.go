@@ -0,0 +1,31 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/services/templatepack"
+)
+
+// NewScaffoldTemplateRemove returns a command to unregister a template pack.
+func NewScaffoldTemplateRemove() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "remove [name]",
+		Short: "Unregister a template pack",
+		Args:  cobra.ExactArgs(1),
+		RunE:  scaffoldTemplateRemoveHandler,
+	}
+
+	return c
+}
+
+func scaffoldTemplateRemoveHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New()
+	defer session.End()
+
+	if err := templatepack.Remove(args[0]); err != nil {
+		return err
+	}
+
+	return session.Printf("Template pack `%[1]v` removed.\n", args[0])
+}
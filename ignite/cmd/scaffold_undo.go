@@ -0,0 +1,103 @@
+package ignitecmd
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/ignite/pkg/journal"
+)
+
+// NewScaffoldUndo returns a command that reverts the last scaffold operation.
+func NewScaffoldUndo() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "undo",
+		Short: "Revert the last scaffold operation",
+		Long: fmt.Sprintf(`Revert the changes made to the source code by the last scaffold operation.
+
+Every scaffold operation that modifies an existing blockchain records the files
+it creates, modifies and deletes, including the generated code, in a journal
+saved in the "%s" directory of the blockchain. The journal is used to revert
+the last operation even when the changes were not committed to a version
+control system:
+
+  ignite scaffold list post title body
+  ignite scaffold undo
+
+Undo can be used many times in a row to revert the previous operations.
+
+When the files changed by the operation were modified afterwards, the undo
+is aborted to avoid losing those modifications, use the "--force" flag to
+revert the operation anyway.
+`, journal.DirName),
+		Args: cobra.NoArgs,
+		RunE: scaffoldUndoHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().BoolP(flagForce, "f", false, "Revert the operation even if the changed files were modified afterwards")
+
+	return c
+}
+
+func scaffoldUndoHandler(cmd *cobra.Command, _ []string) error {
+	var (
+		appPath  = flagGetPath(cmd)
+		force, _ = cmd.Flags().GetBool(flagForce)
+	)
+
+	session := cliui.New(cliui.StartSpinner())
+	defer session.End()
+
+	sc, err := newApp(appPath)
+	if err != nil {
+		return err
+	}
+
+	entry, err := sc.Undo(force)
+	if errors.Is(err, journal.ErrEmpty) {
+		return errors.New("there is no scaffold operation to undo")
+	}
+
+	var conflictErr journal.ConflictError
+	if errors.As(err, &conflictErr) {
+		return fmt.Errorf(
+			"files changed by the last scaffold operation were modified afterwards: %s. Use --force to revert the operation anyway",
+			strings.Join(conflictErr.Files, ", "),
+		)
+	}
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	for _, f := range entry.Created {
+		session.Printf("%s%s\n", colors.Error("delete "), filepath.FromSlash(f.Path))
+	}
+	for _, f := range entry.Modified {
+		session.Printf("%s%s\n", modifyPrefix, filepath.FromSlash(f.Path))
+	}
+	for _, f := range entry.Deleted {
+		session.Printf("%s%s\n", createPrefix, filepath.FromSlash(f.Path))
+	}
+	session.Printf("\n↩️  Reverted `%s`.\n\n", entry.Name)
+
+	return nil
+}
+
+// scaffoldOperationName returns the name of a scaffold operation recorded in the
+// app journal, it contains the command, its arguments and the flags that were set.
+func scaffoldOperationName(cmd *cobra.Command, args []string) string {
+	name := append([]string{cmd.CommandPath()}, args...)
+	cmd.Flags().Visit(func(f *flag.Flag) {
+		name = append(name, fmt.Sprintf("--%s=%s", f.Name, f.Value))
+	})
+	return strings.Join(name, " ")
+}
@@ -0,0 +1,118 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/services/scaffolder"
+)
+
+const (
+	flagAddStore      = "add-store"
+	flagMigrateModule = "migrate-module"
+)
+
+// NewScaffoldUpgrade returns a command to scaffold an on-chain upgrade of the blockchain.
+func NewScaffoldUpgrade() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "upgrade [name]",
+		Short: "Scaffold an on-chain upgrade of the blockchain",
+		Long: `Scaffold the code required to upgrade a live blockchain to a new version of
+the app with a software upgrade proposal.
+
+  ignite scaffold upgrade v2
+
+The upgrade is defined in the "app/upgrades/v2" package with the upgrade handler
+that runs the migrations of the modules. The first scaffolded upgrade also
+registers the upgrade handlers and the store loaders in "app/app.go".
+
+When the upgrade adds new modules to the app, their stores must be added by the
+store loader at the upgrade height:
+
+  ignite scaffold upgrade v2 --add-store mars
+
+To change the state of a module during the upgrade, bump its consensus version
+and register a migration of its state, implemented in the module keeper:
+
+  ignite scaffold upgrade v2 --migrate-module mars
+
+A test that applies the upgrade to an app with the module versions of the
+previous version of the blockchain is created in "app/". The upgrade is also
+added to the "upgrades" of config.yml, where the download URLs of the upgraded
+binaries can be set for the automatic downloads of cosmovisor:
+
+  upgrades:
+    - name: v2
+      binaries:
+        linux/amd64: https://example.com/marsd-v2-linux-amd64
+
+The upgrade name is the name used in the software upgrade proposal.`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: gitChangesConfirmPreRunHandler,
+		RunE:    scaffoldUpgradeHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+	flagSetDryRun(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().StringSlice(flagAddStore, []string{}, "stores of the modules added by the upgrade")
+	c.Flags().StringSlice(flagMigrateModule, []string{}, "modules whose state is migrated by the upgrade")
+
+	return c
+}
+
+func scaffoldUpgradeHandler(cmd *cobra.Command, args []string) error {
+	var (
+		name               = args[0]
+		appPath            = flagGetPath(cmd)
+		addedStores, _     = cmd.Flags().GetStringSlice(flagAddStore)
+		migratedModules, _ = cmd.Flags().GetStringSlice(flagMigrateModule)
+	)
+
+	session := cliui.New(cliui.StartSpinnerWithText(statusScaffolding))
+	defer session.End()
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	preview := flagGetPreview(cmd)
+	sc, err := newApp(appPath, scaffolder.WithPreview(preview))
+	if err != nil {
+		return err
+	}
+
+	var sm xgenny.SourceModification
+	err = sc.Record(scaffoldOperationName(cmd, args), func() (err error) {
+		sm, err = sc.AddUpgrade(
+			cmd.Context(),
+			cacheStorage,
+			placeholder.New(),
+			name,
+			scaffolder.WithAddedStores(addedStores...),
+			scaffolder.WithMigratedModules(migratedModules...),
+		)
+		return err
+	})
+	if preview != nil {
+		return printPreview(cmd, session, preview, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	session.Println(modificationsStr)
+	session.Printf("\n🎉 Upgrade `%[1]v` created.\n\n", name)
+
+	return nil
+}
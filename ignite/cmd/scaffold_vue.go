@@ -19,6 +19,7 @@ func NewScaffoldVue() *cobra.Command {
 
 	c.Flags().AddFlagSet(flagSetYes())
 	c.Flags().StringP(flagPath, "p", "./vue", "path to scaffold content of the Vue.js app")
+	flagSetDryRun(c)
 
 	return c
 }
@@ -28,7 +29,12 @@ func scaffoldVueHandler(cmd *cobra.Command, args []string) error {
 	defer session.End()
 
 	path := flagGetPath(cmd)
-	if err := scaffolder.Vue(path); err != nil {
+	preview := flagGetPreview(cmd)
+	err := scaffolder.Vue(path, scaffolder.WithPreview(preview))
+	if preview != nil {
+		return printPreview(cmd, session, preview, err)
+	}
+	if err != nil {
 		return err
 	}
 
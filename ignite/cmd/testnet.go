@@ -0,0 +1,28 @@
+package ignitecmd
+
+import "github.com/spf13/cobra"
+
+// NewTestnet returns a command that groups sub commands to run local networks
+// of the nodes of a chain and to coordinate the launch of testnets.
+func NewTestnet() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "testnet [command]",
+		Short: "Run local networks and coordinate testnets of your chain",
+		Args:  cobra.ExactArgs(1),
+	}
+
+	c.PersistentFlags().AddFlagSet(flagSetConfig())
+
+	c.AddCommand(NewTestnetMultiNode())
+	c.AddCommand(NewTestnetFork())
+	c.AddCommand(NewTestnetDeploy())
+	c.AddCommand(NewTestnetPeers())
+	c.AddCommand(NewTestnetStateSyncConfig())
+	c.AddCommand(NewTestnetUpgradeRehearsal())
+	c.AddCommand(NewTestnetCoordinate())
+	c.AddCommand(NewTestnetJoin())
+	c.AddCommand(NewTestnetLaunch())
+	c.AddCommand(NewTestnetVerifyGenesis())
+
+	return c
+}
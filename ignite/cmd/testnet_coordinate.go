@@ -0,0 +1,241 @@
+package ignitecmd
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+
+	"github.com/ignite/cli/ignite/pkg/chaincmd"
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/launchstore"
+	"github.com/ignite/cli/ignite/pkg/xhttp"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+const (
+	flagLaunchStore      = "store"
+	flagLaunchStoreToken = "store-token"
+	flagLaunchTime       = "launch-time"
+	flagLaunchDir        = "dir"
+	flagLaunchAddress    = "address"
+	flagLaunchToken      = "token"
+
+	defaultLaunchAddress = ":4600"
+)
+
+// NewTestnetCoordinate returns a command that groups sub commands to
+// coordinate the launch of a testnet.
+func NewTestnetCoordinate() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "coordinate [command]",
+		Short: "Coordinate the launch of a testnet with its validators",
+		Long: `The coordinator of a testnet publishes its launch in a store shared with the
+validators: a directory, a git repository or an HTTP service served by
+"ignite testnet coordinate serve". The launch has the genesis of the accounts
+of config.yml as genesis template, the hash of the binary of the chain and the
+launch time.
+
+The validators generate their gentx from the genesis template and submit it
+with "ignite testnet join", then the coordinator collects the gentxs in the
+final genesis with "ignite testnet launch" and the participants verify it with
+"ignite testnet verify-genesis":
+
+  ignite testnet coordinate publish --store git@github.com:mars/launches.git --launch-time 2022-11-02T10:00:00Z
+  ignite testnet join --store git@github.com:mars/launches.git --peer-address 203.0.113.1:26656
+  ignite testnet launch --store git@github.com:mars/launches.git
+  ignite testnet verify-genesis --store git@github.com:mars/launches.git
+  ignite testnet join --store git@github.com:mars/launches.git
+`,
+		Args: cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(NewTestnetCoordinatePublish())
+	c.AddCommand(NewTestnetCoordinateServe())
+
+	return c
+}
+
+// NewTestnetCoordinatePublish returns a new command to publish the launch of a
+// testnet.
+func NewTestnetCoordinatePublish() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "publish",
+		Short: "Publish the launch of a testnet of your chain",
+		Long: `The publish command compiles and installs the binary (like "ignite chain build")
+and publishes the launch of the testnet in the store. The genesis template has
+the accounts and the genesis of config.yml, the validators of config.yml are
+not part of the testnet unless they join it.
+`,
+		Args: cobra.NoArgs,
+		RunE: testnetCoordinatePublishHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().AddFlagSet(flagSetCheckDependencies())
+	c.Flags().AddFlagSet(flagSetSkipProto())
+	c.Flags().AddFlagSet(flagSetLaunchStore())
+	c.Flags().String(flagLaunchTime, "", "launch time of the testnet in RFC3339 format, in a day by default")
+
+	return c
+}
+
+// NewTestnetCoordinateServe returns a new command to serve a store of the
+// launches of testnets over HTTP.
+func NewTestnetCoordinateServe() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve a store of the launches of testnets over HTTP",
+		Long: `The serve command serves the launches stored in a directory over HTTP, the
+validators read the launches and submit their gentx with the URL of the
+service as store. The writes are authenticated with the bearer tokens of
+--token, set the token with --store-token:
+
+  ignite testnet coordinate serve --dir launches --token $TOKEN
+`,
+		Args: cobra.NoArgs,
+		RunE: testnetCoordinateServeHandler,
+	}
+
+	c.Flags().String(flagLaunchDir, "", "directory of the launches (required)")
+	c.Flags().String(flagLaunchAddress, defaultLaunchAddress, "<host>:<port> of the service")
+	c.Flags().StringSlice(flagLaunchToken, nil, "bearer tokens of the writes (required)")
+
+	return c
+}
+
+func flagSetLaunchStore() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(flagLaunchStore, "", "directory, git repository or URL of the store of the launch (required)")
+	fs.String(flagLaunchStoreToken, "", "token of the writes to the store")
+	fs.String(flagChainID, "", "chain ID of the testnet, the chain ID of config.yml by default")
+	return fs
+}
+
+// openLaunchStore opens the store of the flags of the command.
+func openLaunchStore(cmd *cobra.Command) (launchstore.Store, error) {
+	var (
+		location, _ = cmd.Flags().GetString(flagLaunchStore)
+		token, _    = cmd.Flags().GetString(flagLaunchStoreToken)
+	)
+	if location == "" {
+		return nil, errors.New("the store of the launch is required, set --store")
+	}
+	return launchstore.Open(cmd.Context(), location, launchstore.WithToken(token))
+}
+
+// newLaunchChain returns the chain of the launch and builds its binary.
+func newLaunchChain(cmd *cobra.Command, session *cliui.Session) (*chain.Chain, error) {
+	chainID, _ := cmd.Flags().GetString(flagChainID)
+
+	chainOption := []chain.Option{
+		chain.KeyringBackend(chaincmd.KeyringBackendTest),
+		chain.WithOutputer(session),
+		chain.CollectEvents(session.EventBus()),
+	}
+	if flagGetCheckDependencies(cmd) {
+		chainOption = append(chainOption, chain.CheckDependencies())
+	}
+	if config := getConfig(cmd); config != "" {
+		chainOption = append(chainOption, chain.ConfigFile(config))
+	}
+	if chainID != "" {
+		chainOption = append(chainOption, chain.ID(chainID))
+	}
+
+	c, err := NewChainWithHomeFlags(cmd, chainOption...)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := c.Build(cmd.Context(), cacheStorage, "", flagGetSkipProto(cmd)); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func testnetCoordinatePublishHandler(cmd *cobra.Command, _ []string) error {
+	launchTime := time.Now().Add(24 * time.Hour).Truncate(time.Minute)
+	if value, _ := cmd.Flags().GetString(flagLaunchTime); value != "" {
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return fmt.Errorf("invalid launch time: %w", err)
+		}
+		launchTime = t
+	}
+
+	session := cliui.New(
+		cliui.WithVerbosity(getVerbosity(cmd)),
+		cliui.StartSpinner(),
+	)
+	defer session.End()
+
+	s, err := openLaunchStore(cmd)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	c, err := newLaunchChain(cmd, session)
+	if err != nil {
+		return err
+	}
+
+	l, err := c.PublishTestnetLaunch(cmd.Context(), s, launchTime)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	return session.Printf(
+		"%s Launch of %s published, launch time: %s\nGenesis template hash: %s\nBinary hash: %s\n",
+		icons.OK,
+		colors.Info(l.ChainID),
+		colors.Info(l.LaunchTime.Format(time.RFC3339)),
+		l.GenesisTemplateHash,
+		l.BinaryHash,
+	)
+}
+
+func testnetCoordinateServeHandler(cmd *cobra.Command, _ []string) error {
+	var (
+		dir, _     = cmd.Flags().GetString(flagLaunchDir)
+		address, _ = cmd.Flags().GetString(flagLaunchAddress)
+		tokens, _  = cmd.Flags().GetStringSlice(flagLaunchToken)
+	)
+	if dir == "" {
+		return errors.New("the directory of the launches is required, set --dir")
+	}
+	if len(tokens) == 0 {
+		return errors.New("a token of the writes is required, set --token")
+	}
+
+	session := cliui.New(cliui.WithVerbosity(getVerbosity(cmd)))
+	defer session.End()
+
+	s, err := launchstore.Open(cmd.Context(), dir)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	if err := session.Printf("%s Serving the launches of %s on %s\n", icons.OK, colors.Info(dir), colors.Info(address)); err != nil {
+		return err
+	}
+	return xhttp.Serve(cmd.Context(), &http.Server{
+		Addr:    address,
+		Handler: launchstore.Handler(s, tokens...),
+	})
+}
@@ -0,0 +1,152 @@
+package ignitecmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/chaincmd"
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+const (
+	flagDeployHosts   = "hosts"
+	flagDeployDir     = "dir"
+	flagDeployUpgrade = "upgrade"
+)
+
+// NewTestnetDeploy returns a new command to deploy the nodes of a local
+// network to remote hosts.
+func NewTestnetDeploy() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "deploy",
+		Short: "Deploy the nodes of a multi-node network to remote hosts over SSH",
+		Long: `The deploy command compiles and installs the binary (like "ignite chain build")
+and deploys the nodes of a network written by "ignite testnet multi-node" to
+remote hosts, for the testnets of small validator teams.
+
+The hosts file lists the nodes of the network deployed to each host:
+
+  dir: mars                               # directory of the nodes in the home of the user, the chain ID by default
+  cosmovisor: /usr/local/bin/cosmovisor   # run the nodes with cosmovisor when set
+  nodes:
+    - name: validator-1
+      host: alice@203.0.113.1
+    - name: validator-2
+      host: alice@203.0.113.2
+      port: 2222                          # SSH port of the host
+      address: validator-2.mars.network   # public address of the node, the host by default
+    - name: seed-1
+      host: alice@203.0.113.3
+      seed: true                          # seed node of the other nodes
+
+The binary and the home of each node are copied to its host with the "ssh" and
+"scp" commands of the system, so the SSH configuration, keys and agent of the
+user are used to connect to the hosts. The nodes are persistent peers of each
+other at their public address, or seeds of the other nodes when they are seed
+nodes, and run as systemd services, the user of the hosts must run sudo
+without password. The binary runs on the hosts, build it for their platform.
+
+The homes already deployed are kept when the nodes are deployed again. Roll out
+a new binary to the nodes with --upgrade, with cosmovisor the binary is the
+binary of the upgrade with the name, otherwise it replaces the binary of the
+nodes that restart one by one:
+
+  ignite testnet deploy --hosts hosts.yml --upgrade v2
+`,
+		Args: cobra.NoArgs,
+		RunE: testnetDeployHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().AddFlagSet(flagSetCheckDependencies())
+	c.Flags().AddFlagSet(flagSetSkipProto())
+	c.Flags().String(flagDeployHosts, "", "path of the hosts file of the nodes (required)")
+	c.Flags().String(flagDeployDir, "", "directory of the homes of the nodes, the home of the chain suffixed by \"-testnet\" by default")
+	c.Flags().String(flagDeployUpgrade, "", "name of the upgrade of the binary rolled out to the deployed nodes")
+
+	return c
+}
+
+func testnetDeployHandler(cmd *cobra.Command, _ []string) error {
+	var (
+		hostsPath, _ = cmd.Flags().GetString(flagDeployHosts)
+		dir, _       = cmd.Flags().GetString(flagDeployDir)
+		upgrade, _   = cmd.Flags().GetString(flagDeployUpgrade)
+	)
+	if hostsPath == "" {
+		return errors.New("the hosts file is required, set --hosts")
+	}
+	hosts, err := chain.ParseTestnetHosts(hostsPath)
+	if err != nil {
+		return err
+	}
+
+	session := cliui.New(
+		cliui.WithVerbosity(getVerbosity(cmd)),
+		cliui.StartSpinner(),
+	)
+	defer session.End()
+
+	chainOption := []chain.Option{
+		chain.KeyringBackend(chaincmd.KeyringBackendTest),
+		chain.WithOutputer(session),
+		chain.CollectEvents(session.EventBus()),
+	}
+	if flagGetCheckDependencies(cmd) {
+		chainOption = append(chainOption, chain.CheckDependencies())
+	}
+	if config := getConfig(cmd); config != "" {
+		chainOption = append(chainOption, chain.ConfigFile(config))
+	}
+
+	c, err := NewChainWithHomeFlags(cmd, chainOption...)
+	if err != nil {
+		return err
+	}
+
+	if dir == "" {
+		home, err := c.Home()
+		if err != nil {
+			return err
+		}
+		dir = home + "-testnet"
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.Build(cmd.Context(), cacheStorage, "", flagGetSkipProto(cmd)); err != nil {
+		return err
+	}
+
+	if err := c.DeployTestnet(cmd.Context(), chain.TestnetDeployOptions{
+		Dir:     dir,
+		Hosts:   hosts,
+		Upgrade: upgrade,
+	}); err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	var entries [][]string
+	for _, n := range hosts.Nodes {
+		entries = append(entries, []string{n.Name, n.Host})
+	}
+	if err := session.PrintTable([]string{"Node", "Host"}, entries...); err != nil {
+		return err
+	}
+
+	if upgrade != "" {
+		return session.Printf("\n%s Upgrade %s rolled out to %d nodes\n", icons.OK, colors.Info(upgrade), len(hosts.Nodes))
+	}
+	return session.Printf("\n%s Network of %d nodes deployed from %s\n", icons.OK, len(hosts.Nodes), colors.Info(dir))
+}
@@ -0,0 +1,179 @@
+package ignitecmd
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/chaincmd"
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+const (
+	flagForkRPC           = "rpc"
+	flagForkState         = "state"
+	flagForkNodeHome      = "node-home"
+	flagForkPeers         = "peers"
+	flagForkOperatorCoins = "operator-coins"
+	flagForkVotingPeriod  = "voting-period"
+	flagForkSkipStart     = "skip-start"
+
+	defaultForkVotingPeriod = time.Minute
+)
+
+// NewTestnetFork returns a new command to fork a live network from its state.
+func NewTestnetFork() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "fork",
+		Short: "Fork a live network from its latest state and start a local node",
+		Long: `The fork command forks a live network in place from its latest state, so
+protocol changes are tested against a realistic state with a single local
+node.
+
+The command compiles and installs the binary (like "ignite chain build"), the
+binary must run the version of the app of the network or a version that
+migrates its state. The state of the network is:
+
+- read from the exported state at the path or the URL of --state,
+- or exported from the synced node in --node-home,
+- or exported from a new node synced with the snapshots of the network, the
+  node syncs from the node of --rpc or the peers of --peers.
+
+The validator of the network with the most voting power is taken over by the
+validator key of the local node, the consensus params accept its key and a new
+"operator" key delegates it more than 2/3 of the voting power. The other
+validators keep their power but don't sign the blocks, they are jailed for
+downtime over time. The operator key is stored in the keyring of the home of
+the fork, it passes the governance proposals alone:
+
+  ignite testnet fork --rpc https://rpc.mars.network --operator-coins 100000000umars
+
+The state of the fork is written in the output directory and the node starts
+unless --skip-start is set, start it again with the start command of the
+binary.
+`,
+		Args: cobra.NoArgs,
+		RunE: testnetForkHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().AddFlagSet(flagSetCheckDependencies())
+	c.Flags().AddFlagSet(flagSetSkipProto())
+	c.Flags().String(flagForkRPC, "", "RPC address of a node of the network (required)")
+	c.Flags().String(flagForkState, "", "path or URL of the exported state of the network")
+	c.Flags().String(flagForkNodeHome, "", "home of a synced node of the network to export the state from")
+	c.Flags().String(flagForkPeers, "", "persistent peers of the network to sync the state from, the node of the RPC by default")
+	c.Flags().String(flagChainID, "", "chain ID of the fork, the chain ID of the network by default")
+	c.Flags().String(flagForkOperatorCoins, "", "coins added to the balance of the operator, e.g. 100000000umars")
+	c.Flags().Duration(flagForkVotingPeriod, defaultForkVotingPeriod, "voting period of the governance proposals of the fork")
+	c.Flags().Bool(flagForkSkipStart, false, "write the state of the fork without starting the node")
+	c.Flags().StringP(flagOutput, "o", "", "home of the node of the fork, the home of the chain suffixed by \"-fork\" by default")
+
+	return c
+}
+
+func testnetForkHandler(cmd *cobra.Command, _ []string) error {
+	var (
+		rpcAddress, _    = cmd.Flags().GetString(flagForkRPC)
+		state, _         = cmd.Flags().GetString(flagForkState)
+		nodeHome, _      = cmd.Flags().GetString(flagForkNodeHome)
+		peers, _         = cmd.Flags().GetString(flagForkPeers)
+		chainID, _       = cmd.Flags().GetString(flagChainID)
+		operatorCoins, _ = cmd.Flags().GetString(flagForkOperatorCoins)
+		votingPeriod, _  = cmd.Flags().GetDuration(flagForkVotingPeriod)
+		skipStart, _     = cmd.Flags().GetBool(flagForkSkipStart)
+		output, _        = cmd.Flags().GetString(flagOutput)
+	)
+	if rpcAddress == "" {
+		return errors.New("the RPC address of the network is required, set --rpc")
+	}
+	coins, err := sdk.ParseCoinsNormalized(operatorCoins)
+	if err != nil {
+		return err
+	}
+
+	session := cliui.New(
+		cliui.WithVerbosity(getVerbosity(cmd)),
+		cliui.StartSpinner(),
+	)
+	defer session.End()
+
+	chainOption := []chain.Option{
+		chain.KeyringBackend(chaincmd.KeyringBackendTest),
+		chain.WithOutputer(session),
+		chain.CollectEvents(session.EventBus()),
+	}
+	if flagGetCheckDependencies(cmd) {
+		chainOption = append(chainOption, chain.CheckDependencies())
+	}
+	if config := getConfig(cmd); config != "" {
+		chainOption = append(chainOption, chain.ConfigFile(config))
+	}
+
+	c, err := NewChainWithHomeFlags(cmd, chainOption...)
+	if err != nil {
+		return err
+	}
+
+	if output == "" {
+		home, err := c.Home()
+		if err != nil {
+			return err
+		}
+		output = home + "-fork"
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.Build(cmd.Context(), cacheStorage, "", flagGetSkipProto(cmd)); err != nil {
+		return err
+	}
+
+	fork, err := c.WriteTestnetFork(cmd.Context(), output, chain.TestnetForkOptions{
+		RPCAddress:    rpcAddress,
+		State:         state,
+		NodeHome:      nodeHome,
+		Peers:         peers,
+		ChainID:       chainID,
+		OperatorCoins: coins,
+		VotingPeriod:  votingPeriod,
+	})
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	if err := session.Printf(
+		"%s Fork %s written: %s\n\nValidator %s (%s) taken over with a voting power of %d\nOperator %s: %s\n\nMnemonic of the operator:\n\n%s\n\n",
+		icons.OK,
+		colors.Info(fork.ChainID),
+		colors.Info(fork.Home),
+		fork.Validator.Moniker,
+		fork.Validator.OperatorAddress,
+		fork.Validator.Power,
+		fork.Operator.Name,
+		colors.Info(fork.Operator.Address),
+		fork.Operator.Mnemonic,
+	); err != nil {
+		return err
+	}
+	if skipStart {
+		return nil
+	}
+
+	if err := session.Println("Starting the node of the fork..."); err != nil {
+		return err
+	}
+	return c.StartTestnetFork(cmd.Context(), fork.Home)
+}
@@ -0,0 +1,118 @@
+package ignitecmd
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+const (
+	flagJoinPeerAddress = "peer-address"
+	flagJoinCoins       = "coins"
+)
+
+// NewTestnetJoin returns a new command to join the launch of a testnet.
+func NewTestnetJoin() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "join",
+		Short: "Join the launch of a testnet as a validator",
+		Long: `The join command compiles and installs the binary (like "ignite chain build")
+and joins the launch of the testnet published in the store.
+
+Before the launch, the first validator of config.yml generates its gentx from
+the genesis template in the home of the chain and submits it with its account,
+the account is created in the keyring when it doesn't exist. Set the public
+address of the P2P server of the node with --peer-address to be a persistent
+peer of the other validators:
+
+  ignite testnet join --store https://launches.mars.network --store-token $TOKEN --peer-address 203.0.113.1:26656
+
+Once the testnet is launched, join it again to write the final genesis and the
+peers of the validators in the home, then start the node with the start
+command of the binary.
+`,
+		Args: cobra.NoArgs,
+		RunE: testnetJoinHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().AddFlagSet(flagSetCheckDependencies())
+	c.Flags().AddFlagSet(flagSetSkipProto())
+	c.Flags().AddFlagSet(flagSetLaunchStore())
+	c.Flags().String(flagJoinPeerAddress, "", "<host>:<port> of the P2P server of the node of the validator")
+	c.Flags().String(flagJoinCoins, "", "coins of the account of the validator in the genesis, the self-delegation by default")
+
+	return c
+}
+
+func testnetJoinHandler(cmd *cobra.Command, _ []string) error {
+	var (
+		peerAddress, _ = cmd.Flags().GetString(flagJoinPeerAddress)
+		coins, _       = cmd.Flags().GetString(flagJoinCoins)
+	)
+
+	session := cliui.New(
+		cliui.WithVerbosity(getVerbosity(cmd)),
+		cliui.StartSpinner(),
+	)
+	defer session.End()
+
+	s, err := openLaunchStore(cmd)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	c, err := newLaunchChain(cmd, session)
+	if err != nil {
+		return err
+	}
+
+	join, err := c.JoinTestnetLaunch(cmd.Context(), s, chain.TestnetJoinOptions{
+		PeerAddress: peerAddress,
+		Coins:       coins,
+	})
+	if err != nil {
+		return err
+	}
+
+	home, err := c.Home()
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	if join.Launch.Launched {
+		return session.Printf(
+			"%s %s is launched, genesis written in %s\nPersistent peers: %s\n",
+			icons.OK,
+			colors.Info(join.Launch.ChainID),
+			colors.Info(home),
+			strings.Join(join.Launch.Peers, ","),
+		)
+	}
+
+	if err := session.Printf(
+		"%s Request %s submitted to join %s with %s\n",
+		icons.OK,
+		colors.Info(join.Request.Name),
+		colors.Info(join.Launch.ChainID),
+		colors.Info(join.Account.Address),
+	); err != nil {
+		return err
+	}
+	if join.Account.Mnemonic != "" {
+		if err := session.Printf("\nMnemonic of the validator:\n\n%s\n\n", join.Account.Mnemonic); err != nil {
+			return err
+		}
+	}
+	return session.Println("Join again once the testnet is launched to write its genesis")
+}
@@ -0,0 +1,108 @@
+package ignitecmd
+
+import (
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+)
+
+const flagLaunchBundle = "bundle"
+
+// NewTestnetLaunch returns a new command to finalize the genesis of the launch
+// of a testnet.
+func NewTestnetLaunch() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "launch",
+		Short: "Finalize the genesis of the launch of a testnet",
+		Long: `The launch command compiles and installs the binary (like "ignite chain build")
+and finalizes the genesis of the launch of the testnet published in the store.
+
+The gentxs of the requests of the validators are verified and collected in the
+genesis template with the accounts of the validators, and the genesis time is
+the launch time. The final genesis is published in the store with the peers of
+the validators, the launch doesn't accept requests anymore:
+
+  ignite testnet launch --store git@github.com:mars/launches.git
+
+Set --bundle to write a bundle to share the testnet: the Dockerfile of the
+images, the Docker Compose definition and the Kubernetes manifests of a public
+RPC node, the faucet of config.yml and a block explorer configured for the
+chain. The binary of the chain is copied in the images, build it for linux:
+
+  ignite testnet launch --store git@github.com:mars/launches.git --bundle mars-bundle
+  docker compose -f mars-bundle/docker-compose.yml up -d --build
+`,
+		Args: cobra.NoArgs,
+		RunE: testnetLaunchHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().AddFlagSet(flagSetCheckDependencies())
+	c.Flags().AddFlagSet(flagSetSkipProto())
+	c.Flags().AddFlagSet(flagSetLaunchStore())
+	c.Flags().String(flagLaunchBundle, "", "directory of the bundle of a public RPC node, a faucet and an explorer")
+
+	return c
+}
+
+func testnetLaunchHandler(cmd *cobra.Command, _ []string) error {
+	bundle, _ := cmd.Flags().GetString(flagLaunchBundle)
+
+	session := cliui.New(
+		cliui.WithVerbosity(getVerbosity(cmd)),
+		cliui.StartSpinner(),
+	)
+	defer session.End()
+
+	s, err := openLaunchStore(cmd)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	c, err := newLaunchChain(cmd, session)
+	if err != nil {
+		return err
+	}
+
+	l, err := c.LaunchTestnet(cmd.Context(), s)
+	if err != nil {
+		return err
+	}
+	if bundle != "" {
+		if err := c.WriteTestnetBundle(cmd.Context(), s, l, bundle); err != nil {
+			return err
+		}
+	}
+
+	session.StopSpinner()
+
+	if err := session.Printf(
+		"%s %s launched at %s\nGenesis hash: %s\nPersistent peers: %s\n",
+		icons.OK,
+		colors.Info(l.ChainID),
+		colors.Info(l.LaunchTime.Format(time.RFC3339)),
+		l.GenesisHash,
+		strings.Join(l.Peers, ","),
+	); err != nil {
+		return err
+	}
+	if bundle == "" {
+		return nil
+	}
+	return session.Printf(
+		"\n%s Bundle written: %s\n\nStart it with:\n\n  docker compose -f %s up -d --build\n  kubectl apply -k %s\n",
+		icons.OK,
+		colors.Info(bundle),
+		filepath.Join(bundle, "docker-compose.yml"),
+		bundle,
+	)
+}
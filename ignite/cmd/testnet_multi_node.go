@@ -0,0 +1,154 @@
+package ignitecmd
+
+import (
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/chaincmd"
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/ignite/pkg/localnet"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+const (
+	flagValidators = "validators"
+	flagFullNodes  = "full-nodes"
+	flagCosmovisor = "cosmovisor"
+
+	defaultTestnetValidators = 4
+)
+
+// NewTestnetMultiNode returns a new command to write the homes of the nodes
+// of a local multi-node network.
+func NewTestnetMultiNode() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "multi-node",
+		Short: "Write the homes of the nodes of a local multi-node network",
+		Long: `The multi-node command compiles and installs the binary (like "ignite chain
+build") and uses that binary to initialize the homes of the nodes of a local
+network, "validator-1", "validator-2" and so on, and "full-node-1",
+"full-node-2" and so on.
+
+The nodes share a genesis with the accounts of config.yml. The first validator
+is the validator of config.yml, the other validators self-delegate the same
+amount with new keys stored in the keyring of their home.
+
+The nodes run on the same host: each node listens on the ports of the validator
+of config.yml increased by 10 times its index, and the nodes are persistent
+peers of each other. The command writes a Docker Compose definition and a
+Procfile for process supervisors like foreman, honcho or overmind in the
+output directory, start the network with one of them:
+
+  docker compose -f ~/.mars-testnet/docker-compose.yml up
+  foreman start -f ~/.mars-testnet/Procfile
+
+The containers of the Docker Compose definition share the network of the host
+and the binary is mounted in the containers, the binary must run on Linux.
+
+With --cosmovisor, the nodes run with cosmovisor and the binary is the genesis
+binary of cosmovisor in the home of each node, so the upgrades of the chain can
+be rehearsed with "ignite testnet upgrade-rehearsal".
+
+The nodes are initialized again each time the command runs.
+`,
+		Args: cobra.NoArgs,
+		RunE: testnetMultiNodeHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().AddFlagSet(flagSetCheckDependencies())
+	c.Flags().AddFlagSet(flagSetSkipProto())
+	c.Flags().Int(flagValidators, defaultTestnetValidators, "number of validator nodes")
+	c.Flags().Int(flagFullNodes, 0, "number of full nodes")
+	c.Flags().String(flagCosmovisor, "", "path of the cosmovisor binary running the nodes")
+	c.Flags().StringP(flagOutput, "o", "", "directory of the homes of the nodes, the home of the chain suffixed by \"-testnet\" by default")
+
+	return c
+}
+
+func testnetMultiNodeHandler(cmd *cobra.Command, _ []string) error {
+	var (
+		validators, _ = cmd.Flags().GetInt(flagValidators)
+		fullNodes, _  = cmd.Flags().GetInt(flagFullNodes)
+		output, _     = cmd.Flags().GetString(flagOutput)
+		cosmovisor, _ = cmd.Flags().GetString(flagCosmovisor)
+	)
+
+	session := cliui.New(
+		cliui.WithVerbosity(getVerbosity(cmd)),
+		cliui.StartSpinner(),
+	)
+	defer session.End()
+
+	chainOption := []chain.Option{
+		chain.KeyringBackend(chaincmd.KeyringBackendTest),
+		chain.WithOutputer(session),
+		chain.CollectEvents(session.EventBus()),
+	}
+	if flagGetCheckDependencies(cmd) {
+		chainOption = append(chainOption, chain.CheckDependencies())
+	}
+	if config := getConfig(cmd); config != "" {
+		chainOption = append(chainOption, chain.ConfigFile(config))
+	}
+
+	c, err := NewChainWithHomeFlags(cmd, chainOption...)
+	if err != nil {
+		return err
+	}
+
+	if output == "" {
+		home, err := c.Home()
+		if err != nil {
+			return err
+		}
+		output = home + "-testnet"
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.Build(cmd.Context(), cacheStorage, "", flagGetSkipProto(cmd)); err != nil {
+		return err
+	}
+
+	nodes, err := c.WriteTestnet(cmd.Context(), output, chain.TestnetOptions{
+		Validators: validators,
+		FullNodes:  fullNodes,
+		Cosmovisor: cosmovisor,
+	})
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	var entries [][]string
+	for _, n := range nodes {
+		entries = append(entries, []string{
+			n.Name,
+			strconv.FormatBool(n.Validator),
+			n.ID,
+			n.RPCAddress,
+			n.P2PAddress,
+		})
+	}
+	if err := session.PrintTable([]string{"Node", "Validator", "Node ID", "RPC", "P2P"}, entries...); err != nil {
+		return err
+	}
+
+	return session.Printf(
+		"\n🗃  Network of %d nodes written: %s\n\nStart it with:\n\n  docker compose -f %s up\n  foreman start -f %s\n",
+		len(nodes),
+		colors.Info(output),
+		filepath.Join(output, localnet.ComposeFile),
+		filepath.Join(output, localnet.Procfile),
+	)
+}
@@ -0,0 +1,192 @@
+package ignitecmd
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+const flagPeersRemote = "remote"
+
+// NewTestnetPeers returns a command that groups sub commands to manage the
+// peers of the nodes of a multi-node network.
+func NewTestnetPeers() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "peers [command]",
+		Short: "Compute and set the peers of the nodes of a multi-node network",
+		Long: `The peers commands read the node IDs of the nodes of a network written by
+"ignite testnet multi-node" and assemble their persistent_peers and seeds with
+the public addresses of the hosts file of "ignite testnet deploy". The nodes
+with "seed: true" are the seeds of the other nodes, the other nodes are their
+persistent peers:
+
+  ignite testnet peers show --hosts hosts.yml
+  ignite testnet peers set --hosts hosts.yml --remote
+`,
+		Args: cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(NewTestnetPeersShow())
+	c.AddCommand(NewTestnetPeersSet())
+
+	return c
+}
+
+// NewTestnetPeersShow returns a new command to show the peers of the nodes of
+// a multi-node network.
+func NewTestnetPeersShow() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "show",
+		Short: "Show the node IDs and the persistent_peers and seeds of the network",
+		Long: `The show command prints the node ID and the peer address of each node of the
+network, and the persistent_peers and seeds of a node joining the network.
+`,
+		Args: cobra.NoArgs,
+		RunE: testnetPeersShowHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().AddFlagSet(flagSetTestnetPeers())
+
+	return c
+}
+
+// NewTestnetPeersSet returns a new command to set the peers of the nodes of a
+// multi-node network.
+func NewTestnetPeersSet() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "set",
+		Short: "Set the persistent_peers and seeds of the config.toml of each node",
+		Long: `The set command sets the persistent_peers, seeds, seed_mode and
+external_address of the config.toml of the home of each node in the directory
+of the network.
+
+With --remote, the config.toml of the nodes deployed by "ignite testnet deploy"
+is also set over SSH and the nodes are restarted, for example after a node is
+added to the hosts file:
+
+  ignite testnet peers set --hosts hosts.yml --remote
+`,
+		Args: cobra.NoArgs,
+		RunE: testnetPeersSetHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().AddFlagSet(flagSetTestnetPeers())
+	c.Flags().Bool(flagPeersRemote, false, "set the peers of the nodes deployed to the hosts and restart them")
+
+	return c
+}
+
+func flagSetTestnetPeers() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(flagDeployHosts, "", "path of the hosts file of the nodes (required)")
+	fs.String(flagDeployDir, "", "directory of the homes of the nodes, the home of the chain suffixed by \"-testnet\" by default")
+	return fs
+}
+
+// loadTestnetPeers returns the chain, the directory of the network and the
+// hosts and the peers of its nodes.
+func loadTestnetPeers(cmd *cobra.Command, chainOption ...chain.Option) (*chain.Chain, string, chain.TestnetHosts, []chain.TestnetPeer, error) {
+	var (
+		hostsPath, _ = cmd.Flags().GetString(flagDeployHosts)
+		dir, _       = cmd.Flags().GetString(flagDeployDir)
+	)
+	if hostsPath == "" {
+		return nil, "", chain.TestnetHosts{}, nil, errors.New("the hosts file is required, set --hosts")
+	}
+	hosts, err := chain.ParseTestnetHosts(hostsPath)
+	if err != nil {
+		return nil, "", chain.TestnetHosts{}, nil, err
+	}
+
+	if config := getConfig(cmd); config != "" {
+		chainOption = append(chainOption, chain.ConfigFile(config))
+	}
+	c, err := NewChainWithHomeFlags(cmd, chainOption...)
+	if err != nil {
+		return nil, "", chain.TestnetHosts{}, nil, err
+	}
+
+	if dir == "" {
+		home, err := c.Home()
+		if err != nil {
+			return nil, "", chain.TestnetHosts{}, nil, err
+		}
+		dir = home + "-testnet"
+	}
+
+	peers, err := chain.TestnetPeers(dir, hosts)
+	if err != nil {
+		return nil, "", chain.TestnetHosts{}, nil, err
+	}
+	return c, dir, hosts, peers, nil
+}
+
+func testnetPeersShowHandler(cmd *cobra.Command, _ []string) error {
+	session := cliui.New()
+	defer session.End()
+
+	_, _, _, peers, err := loadTestnetPeers(cmd)
+	if err != nil {
+		return err
+	}
+
+	var entries [][]string
+	for _, p := range peers {
+		entries = append(entries, []string{p.Name, p.ID, p.String(), strconv.FormatBool(p.Seed)})
+	}
+	if err := session.PrintTable([]string{"Node", "Node ID", "Peer address", "Seed"}, entries...); err != nil {
+		return err
+	}
+
+	return session.Printf(
+		"\npersistent_peers = %q\nseeds = %q\n",
+		chain.PersistentPeers(peers, ""),
+		chain.Seeds(peers, ""),
+	)
+}
+
+func testnetPeersSetHandler(cmd *cobra.Command, _ []string) error {
+	remote, _ := cmd.Flags().GetBool(flagPeersRemote)
+
+	session := cliui.New(
+		cliui.WithVerbosity(getVerbosity(cmd)),
+		cliui.StartSpinner(),
+	)
+	defer session.End()
+
+	c, dir, hosts, peers, err := loadTestnetPeers(
+		cmd,
+		chain.WithOutputer(session),
+		chain.CollectEvents(session.EventBus()),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := chain.SetTestnetPeers(dir, peers); err != nil {
+		return err
+	}
+	if remote {
+		if err := c.SetDeployedTestnetPeers(cmd.Context(), dir, hosts); err != nil {
+			return err
+		}
+	}
+
+	session.StopSpinner()
+
+	if remote {
+		return session.Printf("%s Peers of %d nodes set in %s and on their hosts\n", icons.OK, len(peers), colors.Info(dir))
+	}
+	return session.Printf("%s Peers of %d nodes set in %s\n", icons.OK, len(peers), colors.Info(dir))
+}
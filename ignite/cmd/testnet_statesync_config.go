@@ -0,0 +1,97 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+const (
+	flagStateSyncRPC         = "rpc"
+	flagStateSyncTrustOffset = "trust-offset"
+	flagStateSyncTrustPeriod = "trust-period"
+	flagStateSyncConfigTOML  = "config-toml"
+
+	defaultStateSyncTrustOffset = 2000
+)
+
+// NewTestnetStateSyncConfig returns a new command to generate the state sync
+// config of the nodes joining a network.
+func NewTestnetStateSyncConfig() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "statesync-config",
+		Short: "Generate the state sync config of a node joining the network",
+		Long: `The statesync-config command generates the statesync section of the
+config.toml of a node that joins the network with state sync instead of
+replaying the blocks from the genesis. The trusted block is read from the RPC
+servers of the network, the RPC server of config.yml by default:
+
+  ignite testnet statesync-config --rpc https://rpc-1.mars.network,https://rpc-2.mars.network
+
+The nodes of the network serve the snapshots of their state when the
+state_sync section of their validator is set in config.yml:
+
+  validators:
+    - name: alice
+      bonded: 100000000stake
+      state_sync:
+        snapshot_interval: 1000
+        snapshot_keep_recent: 2
+
+The trusted block is --trust-offset blocks below the latest block, it must be
+below the height of the snapshots. Set the section in the config.toml of the
+node with --config-toml.
+`,
+		Args: cobra.NoArgs,
+		RunE: testnetStateSyncConfigHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().StringSlice(flagStateSyncRPC, nil, "RPC servers of the network, the RPC server of config.yml by default")
+	c.Flags().Int64(flagStateSyncTrustOffset, defaultStateSyncTrustOffset, "number of blocks between the trusted block and the latest block")
+	c.Flags().Duration(flagStateSyncTrustPeriod, chain.DefaultStateSyncTrustPeriod, "trust period of the validators of the trusted block")
+	c.Flags().String(flagStateSyncConfigTOML, "", "path of the config.toml of the node to set the section in")
+
+	return c
+}
+
+func testnetStateSyncConfigHandler(cmd *cobra.Command, _ []string) error {
+	var (
+		rpcServers, _  = cmd.Flags().GetStringSlice(flagStateSyncRPC)
+		trustOffset, _ = cmd.Flags().GetInt64(flagStateSyncTrustOffset)
+		trustPeriod, _ = cmd.Flags().GetDuration(flagStateSyncTrustPeriod)
+		configTOML, _  = cmd.Flags().GetString(flagStateSyncConfigTOML)
+	)
+
+	session := cliui.New(cliui.StartSpinnerWithText("Reading the trusted block..."))
+	defer session.End()
+
+	var chainOption []chain.Option
+	if config := getConfig(cmd); config != "" {
+		chainOption = append(chainOption, chain.ConfigFile(config))
+	}
+
+	c, err := NewChainWithHomeFlags(cmd, chainOption...)
+	if err != nil {
+		return err
+	}
+
+	s, err := c.StateSyncConfig(cmd.Context(), rpcServers, trustOffset, trustPeriod)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	if configTOML != "" {
+		if err := s.Write(configTOML); err != nil {
+			return err
+		}
+		return session.Printf("%s State sync from block %d set in %s\n", icons.OK, s.TrustHeight, colors.Info(configTOML))
+	}
+	return session.Print(s.TOML())
+}
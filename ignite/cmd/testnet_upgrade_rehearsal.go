@@ -0,0 +1,144 @@
+package ignitecmd
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/chaincmd"
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+const (
+	flagUpgradeName    = "name"
+	flagUpgradeHeight  = "height"
+	flagUpgradeBinary  = "binary"
+	flagUpgradeDir     = "dir"
+	flagUpgradeTimeout = "timeout"
+)
+
+// NewTestnetUpgradeRehearsal returns a new command to rehearse an upgrade on a
+// local multi-node network.
+func NewTestnetUpgradeRehearsal() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "upgrade-rehearsal",
+		Short: "Rehearse a software upgrade on a local multi-node network",
+		Long: `The upgrade-rehearsal command rehearses a software upgrade of the chain on a
+running network written by "ignite testnet multi-node --cosmovisor", before the
+upgrade of a public network.
+
+The binary of the upgrade, built from the version of the chain with the upgrade
+handler, is staged for cosmovisor in the home of each node. Then the software
+upgrade proposal is submitted and all the validators vote yes on it. The nodes
+halt at the upgrade height, cosmovisor restarts them with the binary of the
+upgrade and the rehearsal succeeds once every node commits blocks above the
+upgrade height:
+
+  ignite testnet multi-node --cosmovisor $(which cosmovisor)
+  foreman start -f ~/.mars-testnet/Procfile
+  ignite testnet upgrade-rehearsal --name v2 --height 100 --binary ./marsd-v2
+
+The voting period of the proposal must end before the upgrade height, set a
+short voting period in the genesis of config.yml:
+
+  genesis:
+    app_state:
+      gov:
+        voting_params:
+          voting_period: 20s
+
+The network is halted when the nodes don't commit a new block for --timeout.
+`,
+		Args: cobra.NoArgs,
+		RunE: testnetUpgradeRehearsalHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().String(flagUpgradeName, "", "name of the upgrade (required)")
+	c.Flags().Int64(flagUpgradeHeight, 0, "height of the upgrade (required)")
+	c.Flags().String(flagUpgradeBinary, "", "path of the binary of the upgrade (required)")
+	c.Flags().String(flagUpgradeDir, "", "directory of the homes of the nodes, the home of the chain suffixed by \"-testnet\" by default")
+	c.Flags().Duration(flagUpgradeTimeout, chain.DefaultTestnetUpgradeTimeout, "time without a new block after which the network is halted")
+
+	return c
+}
+
+func testnetUpgradeRehearsalHandler(cmd *cobra.Command, _ []string) error {
+	var (
+		name, _    = cmd.Flags().GetString(flagUpgradeName)
+		height, _  = cmd.Flags().GetInt64(flagUpgradeHeight)
+		binary, _  = cmd.Flags().GetString(flagUpgradeBinary)
+		dir, _     = cmd.Flags().GetString(flagUpgradeDir)
+		timeout, _ = cmd.Flags().GetDuration(flagUpgradeTimeout)
+	)
+	switch {
+	case name == "":
+		return errors.New("the name of the upgrade is required, set --name")
+	case height <= 0:
+		return errors.New("the height of the upgrade is required, set --height")
+	case binary == "":
+		return errors.New("the binary of the upgrade is required, set --binary")
+	}
+
+	session := cliui.New(
+		cliui.WithVerbosity(getVerbosity(cmd)),
+		cliui.StartSpinner(),
+	)
+	defer session.End()
+
+	chainOption := []chain.Option{
+		chain.KeyringBackend(chaincmd.KeyringBackendTest),
+		chain.WithOutputer(session),
+		chain.CollectEvents(session.EventBus()),
+	}
+	if config := getConfig(cmd); config != "" {
+		chainOption = append(chainOption, chain.ConfigFile(config))
+	}
+
+	c, err := NewChainWithHomeFlags(cmd, chainOption...)
+	if err != nil {
+		return err
+	}
+
+	if dir == "" {
+		home, err := c.Home()
+		if err != nil {
+			return err
+		}
+		dir = home + "-testnet"
+	}
+
+	u, err := c.RehearseTestnetUpgrade(cmd.Context(), chain.TestnetUpgradeOptions{
+		Dir:     dir,
+		Name:    name,
+		Height:  height,
+		Binary:  binary,
+		Timeout: timeout,
+	})
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	var entries [][]string
+	for _, n := range u.Nodes {
+		entries = append(entries, []string{n.Name, strconv.FormatInt(n.Height, 10)})
+	}
+	if err := session.PrintTable([]string{"Node", "Height"}, entries...); err != nil {
+		return err
+	}
+
+	return session.Printf(
+		"\n%s Upgrade %s of proposal %d applied at height %d, the network resumed\n",
+		icons.OK,
+		colors.Info(name),
+		u.ProposalID,
+		height,
+	)
+}
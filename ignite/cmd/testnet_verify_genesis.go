@@ -0,0 +1,139 @@
+package ignitecmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+)
+
+const (
+	flagVerifyGenesis = "genesis"
+
+	defaultVerifyGenesisReport = "genesis-report.json"
+)
+
+// NewTestnetVerifyGenesis returns a new command to verify the final genesis of
+// the launch of a testnet.
+func NewTestnetVerifyGenesis() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "verify-genesis",
+		Short: "Verify the final genesis of the launch of a testnet",
+		Long: `The verify-genesis command compiles and installs the binary (like "ignite chain
+build") and verifies the final genesis of the launch of the testnet published
+in the store, or the genesis of --genesis:
+
+- the hashes of the genesis, of the genesis template and of the binary are the
+  hashes of the launch,
+- the chain ID and the genesis time are the chain ID and the launch time,
+- the gentxs are the gentxs of the requests of the validators,
+- the balances are the balances of the genesis template and the coins of the
+  accounts of the requests,
+- the binary validates the genesis with its validate-genesis command.
+
+The report of the verification is written in the output file and signed with
+the project key of the chain (like "ignite chain sign"), the participants of
+the launch compare their reports and verify their signatures with "ignite
+verify artifact":
+
+  ignite testnet verify-genesis --store git@github.com:mars/launches.git
+`,
+		Args: cobra.NoArgs,
+		RunE: testnetVerifyGenesisHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().AddFlagSet(flagSetCheckDependencies())
+	c.Flags().AddFlagSet(flagSetSkipProto())
+	c.Flags().AddFlagSet(flagSetLaunchStore())
+	c.Flags().String(flagVerifyGenesis, "", "path of the genesis to verify, the final genesis of the store by default")
+	c.Flags().StringP(flagOutput, "o", defaultVerifyGenesisReport, "path of the report of the verification")
+
+	return c
+}
+
+func testnetVerifyGenesisHandler(cmd *cobra.Command, _ []string) error {
+	var (
+		genesisPath, _ = cmd.Flags().GetString(flagVerifyGenesis)
+		output, _      = cmd.Flags().GetString(flagOutput)
+	)
+
+	session := cliui.New(
+		cliui.WithVerbosity(getVerbosity(cmd)),
+		cliui.StartSpinner(),
+	)
+	defer session.End()
+
+	s, err := openLaunchStore(cmd)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	c, err := newLaunchChain(cmd, session)
+	if err != nil {
+		return err
+	}
+
+	r, err := c.VerifyTestnetGenesis(cmd.Context(), s, genesisPath)
+	if err != nil {
+		return err
+	}
+
+	content, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(output, append(content, '\n'), 0o644); err != nil {
+		return err
+	}
+	sigPaths, err := c.SignArtifacts(output)
+	if err != nil {
+		return err
+	}
+	keyPaths, err := c.NotaryKeyPaths()
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	var entries [][]string
+	for _, check := range r.Checks {
+		status := icons.OK
+		if check.Error != "" {
+			status = fmt.Sprintf("%s %s", icons.NotOK, check.Error)
+		}
+		entries = append(entries, []string{check.Name, status})
+	}
+	if err := session.PrintTable([]string{"Check", "Result"}, entries...); err != nil {
+		return err
+	}
+
+	if err := session.Printf(
+		"\nChain ID: %s\nGenesis hash: %s\nGenesis template hash: %s\nBinary hash: %s\nValidators: %d\nAccounts: %d\n\nReport: %s\nSignature: %s\nPublic key: %s\n",
+		colors.Info(r.ChainID),
+		colors.Info(r.GenesisHash),
+		r.GenesisTemplateHash,
+		r.BinaryHash,
+		len(r.Validators),
+		r.Accounts,
+		output,
+		sigPaths[0],
+		keyPaths.PublicKey,
+	); err != nil {
+		return err
+	}
+
+	if failed := r.Failed(); len(failed) > 0 {
+		return fmt.Errorf("the genesis of %s failed %d checks", r.ChainID, len(failed))
+	}
+	return nil
+}
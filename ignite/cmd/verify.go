@@ -0,0 +1,17 @@
+package ignitecmd
+
+import "github.com/spf13/cobra"
+
+// NewVerify returns a command that groups sub commands to verify the artifacts
+// of a chain.
+func NewVerify() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "verify [command]",
+		Short: "Verify the signatures of the artifacts of a chain",
+		Args:  cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(NewVerifyArtifact())
+
+	return c
+}
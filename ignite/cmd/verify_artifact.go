@@ -0,0 +1,86 @@
+package ignitecmd
+
+import (
+	"crypto/ed25519"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/notary"
+)
+
+const (
+	flagVerifyKey       = "key"
+	flagVerifySignature = "signature"
+)
+
+// NewVerifyArtifact returns a new command to verify the signature of an artifact.
+func NewVerifyArtifact() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "artifact [artifact]",
+		Short: "Verify that an artifact is signed by the project key and wasn't changed",
+		Long: `Verify the signature of an artifact signed with "ignite chain sign", like a
+genesis file, an upgrade plan or a release tarball. The signature is read from
+the ".sig" file next to the artifact by default.
+
+The artifact must be signed by the trusted public key of the "--key" flag:
+
+  ignite verify artifact release/mars_linux_amd64.tar.gz --key key.pub.pem
+
+Without the flag, the public key of the project key of the chain at "--path" is
+trusted. The command fails when the artifact was changed since it was signed or
+when it is signed by another key.`,
+		Args: cobra.ExactArgs(1),
+		RunE: verifyArtifactHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().String(flagVerifyKey, "", "path of the PEM encoded public key trusted to sign the artifact")
+	c.Flags().String(flagVerifySignature, "", "path of the signature file (default: {artifact}.sig)")
+
+	return c
+}
+
+func verifyArtifactHandler(cmd *cobra.Command, args []string) error {
+	var (
+		artifact   = args[0]
+		keyPath, _ = cmd.Flags().GetString(flagVerifyKey)
+		sigPath, _ = cmd.Flags().GetString(flagVerifySignature)
+		trusted    ed25519.PublicKey
+		err        error
+	)
+
+	session := cliui.New()
+	defer session.End()
+
+	if keyPath != "" {
+		trusted, err = notary.LoadPublicKey(keyPath)
+	} else {
+		c, cerr := NewChainWithHomeFlags(cmd)
+		if cerr != nil {
+			return cerr
+		}
+		trusted, err = c.NotaryPublicKey()
+	}
+	if err != nil {
+		return err
+	}
+
+	if sigPath == "" {
+		sigPath = notary.SignaturePath(artifact)
+	}
+
+	s, err := notary.Verify(artifact, sigPath, trusted)
+	if err != nil {
+		return err
+	}
+
+	return session.Printf(
+		"%s %s is signed by the trusted key\n  sha256: %s\n  signed at: %s\n",
+		icons.OK,
+		artifact,
+		s.SHA256,
+		s.SignedAt,
+	)
+}
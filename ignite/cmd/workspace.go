@@ -0,0 +1,51 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+)
+
+const flagWorkers = "workers"
+
+// NewWorkspace returns a command that groups sub commands to build and
+// generate the code of the chains of a workspace.
+func NewWorkspace() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "workspace [command]",
+		Short: "Build and generate the code of the chains of a workspace concurrently",
+		Long: `Build and generate the code of the chains of a workspace, a directory with
+several chains like a monorepo, concurrently.
+
+The chains of a workspace are the modules of its Go workspace file (go.work) when
+it has one, or its sub directories with a chain config file otherwise.
+
+A chain that requires the Go module of another chain of the workspace, or that
+imports its proto files with the third party proto paths of its config, is
+processed once the chain it depends on is. The other chains are processed
+concurrently and share the build cache.`,
+		Aliases: []string{"w"},
+	}
+
+	c.AddCommand(
+		NewWorkspaceList(),
+		NewWorkspaceBuild(),
+		NewWorkspaceGenerate(),
+	)
+
+	return c
+}
+
+func flagSetWorkspace(cmd *cobra.Command) {
+	cmd.Flags().StringP(flagPath, "p", ".", "path of the workspace")
+}
+
+func flagSetWorkers() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Int(flagWorkers, 0, "maximum number of chains processed concurrently (default: the number of CPUs)")
+	return fs
+}
+
+func flagGetWorkers(cmd *cobra.Command) int {
+	workers, _ := cmd.Flags().GetInt(flagWorkers)
+	return workers
+}
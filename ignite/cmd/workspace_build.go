@@ -0,0 +1,96 @@
+package ignitecmd
+
+import (
+	"context"
+	"sync"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/chaincmd"
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/chain"
+	"github.com/ignite/cli/ignite/services/workspace"
+)
+
+// NewWorkspaceBuild returns a new command to build the chains of a workspace.
+func NewWorkspaceBuild() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "build",
+		Short: "Build the node binaries of the chains of a workspace",
+		Long: `Build the node binaries of the chains of a workspace concurrently, the code of
+each chain is generated from its proto files first like with "ignite chain build".
+
+The binaries are installed in the $(go env GOPATH)/bin directory, or in the
+directory of the --output flag:
+
+  ignite workspace build --output dist`,
+		Args: cobra.NoArgs,
+		RunE: workspaceBuildHandler,
+	}
+
+	flagSetWorkspace(c)
+	flagSetClearCache(c)
+	c.Flags().AddFlagSet(flagSetWorkers())
+	c.Flags().AddFlagSet(flagSetSkipProto())
+	c.Flags().StringP(flagOutput, "o", "", "binary output path")
+
+	return c
+}
+
+func workspaceBuildHandler(cmd *cobra.Command, _ []string) error {
+	var (
+		output, _ = cmd.Flags().GetString(flagOutput)
+		skipProto = flagGetSkipProto(cmd)
+	)
+
+	session := cliui.New(cliui.StartSpinnerWithText("Discovering the chains..."))
+	defer session.End()
+
+	w, err := workspace.Discover(flagGetPath(cmd))
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	var (
+		mu       sync.Mutex
+		binaries = make(map[string]string)
+	)
+	err = w.Run(cmd.Context(), func(ctx context.Context, m workspace.Member, ev events.Bus) error {
+		c, err := chain.New(
+			m.Path,
+			chain.KeyringBackend(chaincmd.KeyringBackendTest),
+			chain.CollectEvents(ev),
+		)
+		if err != nil {
+			return err
+		}
+
+		binary, err := c.Build(ctx, cacheStorage, output, skipProto)
+		if err != nil {
+			return err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		binaries[m.Name] = binary
+
+		return nil
+	}, workspace.Workers(flagGetWorkers(cmd)), workspace.CollectEvents(session.EventBus()))
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+	for _, m := range w.Members() {
+		session.Printf("%s %s: %s\n", icons.OK, m.Name, binaries[m.Name])
+	}
+
+	return nil
+}
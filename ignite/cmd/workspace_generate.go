@@ -0,0 +1,69 @@
+package ignitecmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/chain"
+	"github.com/ignite/cli/ignite/services/workspace"
+)
+
+// NewWorkspaceGenerate returns a new command to generate the code of the
+// chains of a workspace.
+func NewWorkspaceGenerate() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "generate",
+		Short: "Generate the code of the chains of a workspace from their proto files",
+		Long: `Generate the code of the chains of a workspace from their proto files
+concurrently: the Go code of each chain and the clients set in its config.`,
+		PreRunE: gitChangesConfirmPreRunHandler,
+		Args:    cobra.NoArgs,
+		RunE:    workspaceGenerateHandler,
+	}
+
+	flagSetWorkspace(c)
+	flagSetClearCache(c)
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().AddFlagSet(flagSetWorkers())
+	c.Flags().Bool(flagForce, false, "generate the code of all the proto packages ignoring the generation cache")
+
+	return c
+}
+
+func workspaceGenerateHandler(cmd *cobra.Command, _ []string) error {
+	session := cliui.New(cliui.StartSpinnerWithText(statusGenerating))
+	defer session.End()
+
+	w, err := workspace.Discover(flagGetPath(cmd))
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newGenerateCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	err = w.Run(cmd.Context(), func(ctx context.Context, m workspace.Member, ev events.Bus) error {
+		c, err := chain.New(m.Path, chain.CollectEvents(ev))
+		if err != nil {
+			return err
+		}
+
+		return c.GenerateFromConfig(ctx, cacheStorage)
+	}, workspace.Workers(flagGetWorkers(cmd)), workspace.CollectEvents(session.EventBus()))
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+	for _, m := range w.Members() {
+		session.Printf("%s Generated the code of %s\n", icons.OK, m.Name)
+	}
+
+	return nil
+}
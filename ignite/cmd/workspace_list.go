@@ -0,0 +1,54 @@
+package ignitecmd
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/services/workspace"
+)
+
+var workspaceListHeader = []string{"Chain", "Module", "Depends on"}
+
+// NewWorkspaceList returns a new command to list the chains of a workspace.
+func NewWorkspaceList() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "list",
+		Short: "List the chains of a workspace and their dependencies",
+		Args:  cobra.NoArgs,
+		RunE:  workspaceListHandler,
+	}
+
+	flagSetWorkspace(c)
+
+	return c
+}
+
+func workspaceListHandler(cmd *cobra.Command, _ []string) error {
+	session := cliui.New()
+	defer session.End()
+
+	w, err := workspace.Discover(flagGetPath(cmd))
+	if err != nil {
+		return err
+	}
+
+	levels, err := w.Levels()
+	if err != nil {
+		return err
+	}
+
+	var rows [][]string
+	for _, level := range levels {
+		for _, m := range level {
+			deps := strings.Join(m.Deps, ", ")
+			if deps == "" {
+				deps = "-"
+			}
+			rows = append(rows, []string{m.Name, m.ModulePath, deps})
+		}
+	}
+
+	return session.PrintTable(workspaceListHeader, rows...)
+}
@@ -0,0 +1,217 @@
+// Package apicompat locks in the responses of the API of a chain in golden files
+// and replays the queries of the golden files against a new build of the chain
+// to find the changes that break the clients of the API.
+package apicompat
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// goldenExt is the extension of the golden files.
+const goldenExt = ".json"
+
+// Query is a query of the API of a chain.
+type Query struct {
+	// Module is the name of the module that defines the query.
+	Module string `json:"module"`
+
+	// Name is the name of the query.
+	Name string `json:"name"`
+
+	// Path is the path of the HTTP endpoint of the query.
+	Path string `json:"path"`
+}
+
+// String returns the name of the query prefixed by its module.
+func (q Query) String() string {
+	return q.Module + "/" + q.Name
+}
+
+// Golden is the content of a golden file: a query and its response.
+type Golden struct {
+	Query
+
+	// Response is the JSON response of the query.
+	Response json.RawMessage `json:"response"`
+}
+
+// Result is the result of a query recorded in or replayed from a golden file.
+type Result struct {
+	Query Query
+
+	// Changes are the breaking changes of the response compared to the golden file.
+	Changes []Change
+
+	// Err is the error of the query when it fails.
+	Err error
+}
+
+// IsBreaking returns true when the query fails or its response has breaking changes.
+func (r Result) IsBreaking() bool {
+	return r.Err != nil || len(r.Changes) > 0
+}
+
+// Client records and replays the queries of the API of a chain.
+type Client struct {
+	apiAddress string
+	httpClient *http.Client
+}
+
+// Option configures the client.
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client used to query the API.
+func WithHTTPClient(c *http.Client) Option {
+	return func(client *Client) {
+		client.httpClient = c
+	}
+}
+
+// New returns a client for the API served at the address, e.g. http://localhost:1317.
+func New(apiAddress string, options ...Option) Client {
+	c := Client{
+		apiAddress: strings.TrimSuffix(apiAddress, "/"),
+		httpClient: http.DefaultClient,
+	}
+	for _, apply := range options {
+		apply(&c)
+	}
+	return c
+}
+
+// Record queries the API and writes the responses in golden files under dir,
+// one file per query in the directory of its module. The queries that fail are
+// not recorded, their result holds the error.
+func (c Client) Record(ctx context.Context, dir string, queries []Query) ([]Result, error) {
+	var results []Result
+	for _, q := range queries {
+		res, err := c.query(ctx, q.Path)
+		if err != nil {
+			results = append(results, Result{Query: q, Err: err})
+			continue
+		}
+
+		response, err := normalize(res)
+		if err != nil {
+			results = append(results, Result{Query: q, Err: err})
+			continue
+		}
+
+		content, err := json.MarshalIndent(Golden{Query: q, Response: response}, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+
+		path := goldenPath(dir, q)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(path, append(content, '\n'), 0o644); err != nil {
+			return nil, err
+		}
+		results = append(results, Result{Query: q})
+	}
+
+	return results, nil
+}
+
+// Check replays the queries of the golden files under dir and compares the
+// responses with the golden ones.
+func (c Client) Check(ctx context.Context, dir string) ([]Result, error) {
+	goldens, err := ReadGoldens(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []Result
+	for _, g := range goldens {
+		res, err := c.query(ctx, g.Path)
+		if err != nil {
+			results = append(results, Result{Query: g.Query, Err: err})
+			continue
+		}
+
+		changes, err := Compare(g.Response, res)
+		results = append(results, Result{Query: g.Query, Changes: changes, Err: err})
+	}
+
+	return results, nil
+}
+
+// ReadGoldens returns the golden files under dir sorted by query.
+func ReadGoldens(dir string) ([]Golden, error) {
+	var goldens []Golden
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || filepath.Ext(path) != goldenExt {
+			return nil
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		var g Golden
+		if err := json.Unmarshal(content, &g); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		goldens = append(goldens, g)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(goldens, func(i, j int) bool {
+		return goldens[i].Query.String() < goldens[j].Query.String()
+	})
+	return goldens, nil
+}
+
+func (c Client) query(ctx context.Context, path string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.apiAddress+path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s", path, res.Status)
+	}
+	return body, nil
+}
+
+// normalize compacts a JSON response, the golden files are indented as a whole.
+func normalize(data []byte) (json.RawMessage, error) {
+	var b bytes.Buffer
+	if err := json.Compact(&b, data); err != nil {
+		return nil, fmt.Errorf("invalid JSON response: %w", err)
+	}
+	return b.Bytes(), nil
+}
+
+func goldenPath(dir string, q Query) string {
+	return filepath.Join(dir, q.Module, q.Name+goldenExt)
+}
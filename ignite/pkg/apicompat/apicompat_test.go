@@ -0,0 +1,61 @@
+package apicompat_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/apicompat"
+)
+
+func TestRecordAndCheck(t *testing.T) {
+	responses := map[string]string{
+		"/foo/bar/params": `{"params":{"window":"10"}}`,
+		"/foo/bar/price":  `{"price":[],"pagination":{"next_key":null,"total":"0"}}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		res, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(res))
+	}))
+	defer server.Close()
+
+	var (
+		ctx    = context.Background()
+		dir    = t.TempDir()
+		client = apicompat.New(server.URL)
+	)
+
+	results, err := client.Record(ctx, dir, []apicompat.Query{
+		{Module: "bar", Name: "Params", Path: "/foo/bar/params"},
+		{Module: "bar", Name: "PriceAll", Path: "/foo/bar/price"},
+		{Module: "bar", Name: "Missing", Path: "/foo/bar/missing"},
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	require.False(t, results[0].IsBreaking())
+	require.False(t, results[1].IsBreaking())
+	require.Error(t, results[2].Err)
+
+	goldens, err := apicompat.ReadGoldens(dir)
+	require.NoError(t, err)
+	require.Len(t, goldens, 2)
+	require.Equal(t, "bar/Params", goldens[0].Query.String())
+
+	// The new build renames a param and removes the price query
+	responses["/foo/bar/params"] = `{"params":{"window_blocks":"10"}}`
+	delete(responses, "/foo/bar/price")
+
+	results, err = client.Check(ctx, dir)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Equal(t, []apicompat.Change{{Field: ".params.window", Kind: apicompat.FieldRemoved}}, results[0].Changes)
+	require.True(t, results[1].IsBreaking())
+	require.Error(t, results[1].Err)
+}
@@ -0,0 +1,131 @@
+package apicompat
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// ChangeKind is the kind of a breaking change of a response.
+type ChangeKind string
+
+const (
+	// FieldRemoved is a field of the golden response missing from the response.
+	FieldRemoved ChangeKind = "removed"
+
+	// TypeChanged is a field of the response with a JSON type different from the golden one.
+	TypeChanged ChangeKind = "type changed"
+)
+
+// Change is a breaking change of a response compared to its golden response.
+type Change struct {
+	// Field is the path of the changed field, e.g. ".params.max_validators".
+	Field string
+
+	Kind ChangeKind
+
+	// Golden and Actual are the JSON types of the field in the golden response
+	// and the response.
+	Golden, Actual string
+}
+
+func (c Change) String() string {
+	if c.Kind == TypeChanged {
+		return fmt.Sprintf("field %s: type changed from %s to %s", c.Field, c.Golden, c.Actual)
+	}
+	return fmt.Sprintf("field %s: removed", c.Field)
+}
+
+// Compare compares the structure of a response with its golden response and
+// returns the changes that break the clients of the golden response: removed
+// fields and fields whose JSON type changed. The values of the fields are not
+// compared because they depend on the state of the chain, and new fields are
+// backward compatible. Null values are compatible with any type.
+func Compare(golden, actual []byte) ([]Change, error) {
+	g, err := decode(golden)
+	if err != nil {
+		return nil, fmt.Errorf("golden response: %w", err)
+	}
+	a, err := decode(actual)
+	if err != nil {
+		return nil, fmt.Errorf("response: %w", err)
+	}
+
+	var changes []Change
+	compare("", g, a, &changes)
+	return changes, nil
+}
+
+func decode(data []byte) (interface{}, error) {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func compare(field string, golden, actual interface{}, changes *[]Change) {
+	if golden == nil || actual == nil {
+		return
+	}
+
+	if g, a := jsonType(golden), jsonType(actual); g != a {
+		*changes = append(*changes, Change{Field: fieldOrRoot(field), Kind: TypeChanged, Golden: g, Actual: a})
+		return
+	}
+
+	switch g := golden.(type) {
+	case map[string]interface{}:
+		a := actual.(map[string]interface{})
+
+		keys := make([]string, 0, len(g))
+		for k := range g {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			v, ok := a[k]
+			if !ok {
+				*changes = append(*changes, Change{Field: field + "." + k, Kind: FieldRemoved})
+				continue
+			}
+			compare(field+"."+k, g[k], v, changes)
+		}
+
+	case []interface{}:
+		// The elements of a list share the same structure, compare the first ones
+		a := actual.([]interface{})
+		if len(g) > 0 && len(a) > 0 {
+			compare(field+"[]", g[0], a[0], changes)
+		}
+	}
+}
+
+func jsonType(v interface{}) string {
+	switch v.(type) {
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case json.Number:
+		return "number"
+	case bool:
+		return "boolean"
+	default:
+		return "null"
+	}
+}
+
+func fieldOrRoot(field string) string {
+	if field == "" {
+		return "."
+	}
+	return field
+}
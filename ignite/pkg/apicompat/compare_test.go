@@ -0,0 +1,65 @@
+package apicompat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompare(t *testing.T) {
+	golden := `{
+  "params": {"window": "10", "feeders": ["cosmos1"], "enabled": true},
+  "price": [{"symbol": "ATOM", "price": "10.5"}],
+  "pagination": null
+}`
+
+	tests := []struct {
+		name    string
+		actual  string
+		changes []Change
+	}{
+		{
+			name:   "same structure with different values",
+			actual: `{"params": {"window": "5", "feeders": [], "enabled": false}, "price": [], "pagination": null}`,
+		},
+		{
+			name:   "added fields",
+			actual: `{"params": {"window": "5", "feeders": [], "enabled": false, "new": 1}, "price": [], "pagination": {"total": "0"}}`,
+		},
+		{
+			name:   "removed fields",
+			actual: `{"params": {"window": "5", "feeders": []}, "price": [{"symbol": "ATOM"}]}`,
+			changes: []Change{
+				{Field: ".pagination", Kind: FieldRemoved},
+				{Field: ".params.enabled", Kind: FieldRemoved},
+				{Field: ".price[].price", Kind: FieldRemoved},
+			},
+		},
+		{
+			name:   "changed types",
+			actual: `{"params": {"window": 5, "feeders": "cosmos1", "enabled": true}, "price": {}, "pagination": null}`,
+			changes: []Change{
+				{Field: ".params.feeders", Kind: TypeChanged, Golden: "array", Actual: "string"},
+				{Field: ".params.window", Kind: TypeChanged, Golden: "string", Actual: "number"},
+				{Field: ".price", Kind: TypeChanged, Golden: "array", Actual: "object"},
+			},
+		},
+		{
+			name:    "changed root",
+			actual:  `[]`,
+			changes: []Change{{Field: ".", Kind: TypeChanged, Golden: "object", Actual: "array"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes, err := Compare([]byte(golden), []byte(tt.actual))
+			require.NoError(t, err)
+			require.Equal(t, tt.changes, changes)
+		})
+	}
+}
+
+func TestCompareInvalidResponse(t *testing.T) {
+	_, err := Compare([]byte(`{}`), []byte(`{`))
+	require.Error(t, err)
+}
@@ -0,0 +1,510 @@
+// Package apiparity queries the same methods of the Query services of a chain
+// through its gRPC server, its Tendermint RPC and its REST API, and reports the
+// inconsistencies between the responses of the three transports: a query that
+// only fails on some of them, different payloads or pagination, JSON fields that
+// don't use the proto field names and responses at a different height.
+package apiparity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosgen"
+	"github.com/ignite/cli/ignite/pkg/tendermintrpc"
+)
+
+const (
+	// queryService is the name of the services of the queries.
+	queryService = "Query"
+
+	// paginationField is the name of the pagination field of the requests and
+	// the responses of the list queries.
+	paginationField = "pagination"
+
+	// pageLimit is the number of items requested by the list queries, a page of
+	// one item is enough to compare the pagination of the transports.
+	pageLimit = 1
+
+	// heightHeader is the gRPC header of the height of the state queried.
+	heightHeader = "x-cosmos-block-height"
+
+	// gatewayHeightHeader is the HTTP header of the gateway that forwards the
+	// gRPC height header.
+	gatewayHeightHeader = "Grpc-Metadata-X-Cosmos-Block-Height"
+)
+
+// The names of the transports.
+const (
+	TransportGRPC = "gRPC"
+	TransportRPC  = "RPC"
+	TransportREST = "REST"
+)
+
+// Kind is the kind of an inconsistency.
+type Kind string
+
+const (
+	// KindAvailability is a query that fails on some of the transports only.
+	KindAvailability Kind = "availability"
+
+	// KindPayload is a response that differs between transports.
+	KindPayload Kind = "payload"
+
+	// KindPagination is a pagination that differs between transports.
+	KindPagination Kind = "pagination"
+
+	// KindFieldCasing is a JSON field of the REST response that is not named
+	// after its proto field.
+	KindFieldCasing Kind = "field casing"
+
+	// KindHeight is a response at a different height than the one requested.
+	KindHeight Kind = "height"
+)
+
+// Mismatch is an inconsistency between the transports of a query.
+type Mismatch struct {
+	Kind   Kind
+	Detail string
+}
+
+// String returns the kind and the detail of the mismatch.
+func (m Mismatch) String() string {
+	return fmt.Sprintf("%s: %s", m.Kind, m.Detail)
+}
+
+// Query is a method of a Query service that can be called with an empty
+// request, or with a pagination only.
+type Query struct {
+	// Method is the descriptor of the method.
+	Method protoreflect.MethodDescriptor
+
+	// Route is the route of the REST endpoint of the method.
+	Route string
+}
+
+// String returns the full name of the method.
+func (q Query) String() string {
+	return string(q.Method.FullName())
+}
+
+// path returns the gRPC path of the method, e.g. "/mars.blog.Query/Params".
+func (q Query) path() string {
+	return fmt.Sprintf("/%s/%s", q.Method.Parent().FullName(), q.Method.Name())
+}
+
+// isPaginated returns true when the request of the method has a pagination.
+func (q Query) isPaginated() bool {
+	return q.Method.Input().Fields().ByName(paginationField) != nil
+}
+
+// Skipped is a method of a Query service that can't be checked.
+type Skipped struct {
+	Name   string
+	Reason string
+}
+
+// Set are the queries of the proto files of a descriptor set.
+type Set struct {
+	// Queries are the queries that can be checked.
+	Queries []Query
+
+	// Skipped are the methods of the Query services that can't be checked
+	// because their requests need values.
+	Skipped []Skipped
+
+	types *protoregistry.Types
+}
+
+// NewSet returns the queries of the Query services of the files of the
+// serialized FileDescriptorSet content. The set must include the files they
+// import.
+func NewSet(content []byte, files []string) (Set, error) {
+	var fds descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(content, &fds); err != nil {
+		return Set{}, err
+	}
+
+	registry, err := protodesc.NewFiles(&fds)
+	if err != nil {
+		return Set{}, err
+	}
+
+	set := Set{types: new(protoregistry.Types)}
+	registry.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		err = registerTypes(set.types, fd.Messages())
+		return err == nil
+	})
+	if err != nil {
+		return Set{}, err
+	}
+
+	for _, name := range files {
+		fd, err := registry.FindFileByPath(name)
+		if err != nil {
+			return Set{}, err
+		}
+
+		services := fd.Services()
+		for i := 0; i < services.Len(); i++ {
+			if services.Get(i).Name() != queryService {
+				continue
+			}
+
+			methods := services.Get(i).Methods()
+			for j := 0; j < methods.Len(); j++ {
+				q, reason := newQuery(methods.Get(j))
+				if reason != "" {
+					set.Skipped = append(set.Skipped, Skipped{
+						Name:   string(methods.Get(j).FullName()),
+						Reason: reason,
+					})
+					continue
+				}
+				set.Queries = append(set.Queries, q)
+			}
+		}
+	}
+
+	sort.Slice(set.Queries, func(i, j int) bool {
+		return set.Queries[i].String() < set.Queries[j].String()
+	})
+
+	return set, nil
+}
+
+// newQuery returns the query of the method, or the reason why the method
+// can't be checked.
+func newQuery(md protoreflect.MethodDescriptor) (Query, string) {
+	if md.IsStreamingClient() || md.IsStreamingServer() {
+		return Query{}, "streaming method"
+	}
+
+	fields := md.Input().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		if f := fields.Get(i); f.Name() != paginationField || f.Message() == nil {
+			return Query{}, fmt.Sprintf("the request requires the %s field", f.Name())
+		}
+	}
+
+	// The gateway binds the methods without HTTP rules to default routes
+	route := cosmosgen.GatewayRoute(string(md.Parent().ParentFile().Package()), string(md.Name()))
+	if opts, ok := md.Options().(*descriptorpb.MethodOptions); ok && proto.HasExtension(opts, annotations.E_Http) {
+		rule, _ := proto.GetExtension(opts, annotations.E_Http).(*annotations.HttpRule)
+		if rule.GetGet() == "" {
+			return Query{}, "no GET endpoint"
+		}
+		route = rule.GetGet()
+	}
+	if strings.Contains(route, "{") {
+		return Query{}, fmt.Sprintf("the endpoint %s has params", route)
+	}
+
+	return Query{Method: md, Route: route}, ""
+}
+
+// registerTypes registers the messages and their nested messages in types.
+func registerTypes(types *protoregistry.Types, messages protoreflect.MessageDescriptors) error {
+	for i := 0; i < messages.Len(); i++ {
+		md := messages.Get(i)
+		if md.IsMapEntry() {
+			continue
+		}
+		if err := types.RegisterMessage(dynamicpb.NewMessageType(md)); err != nil {
+			return err
+		}
+		if err := registerTypes(types, md.Messages()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Endpoints are the addresses of the servers of a chain.
+type Endpoints struct {
+	// GRPC is the address of the gRPC server, e.g. localhost:9090.
+	GRPC string
+
+	// RPC is the address of the Tendermint RPC, e.g. http://localhost:26657.
+	RPC string
+
+	// API is the address of the REST API, e.g. http://localhost:1317.
+	API string
+}
+
+// Result is the result of the check of a query.
+type Result struct {
+	Query Query
+
+	// Height is the height of the state queried.
+	Height int64
+
+	// Mismatches are the inconsistencies between the transports.
+	Mismatches []Mismatch
+
+	// Err is the error of the query when it fails on all the transports.
+	Err error
+}
+
+// IsConsistent returns true when the query succeeds on all the transports with
+// the same responses.
+func (r Result) IsConsistent() bool {
+	return r.Err == nil && len(r.Mismatches) == 0
+}
+
+// Client checks the queries of a chain.
+type Client struct {
+	endpoints  Endpoints
+	httpClient *http.Client
+}
+
+// Option configures the client.
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client used to query the REST API.
+func WithHTTPClient(c *http.Client) Option {
+	return func(client *Client) {
+		client.httpClient = c
+	}
+}
+
+// New returns a client for the chain served at the endpoints.
+func New(endpoints Endpoints, options ...Option) Client {
+	endpoints.RPC = strings.TrimSuffix(endpoints.RPC, "/")
+	endpoints.API = strings.TrimSuffix(endpoints.API, "/")
+
+	c := Client{
+		endpoints:  endpoints,
+		httpClient: http.DefaultClient,
+	}
+	for _, apply := range options {
+		apply(&c)
+	}
+	return c
+}
+
+// Check queries the queries of the set through the three transports at the
+// latest height of the chain and compares the responses.
+func (c Client) Check(ctx context.Context, set Set) ([]Result, error) {
+	rpc := tendermintrpc.New(c.endpoints.RPC)
+
+	// All the transports query the same state
+	height, err := rpc.LatestHeight(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("latest height: %w", err)
+	}
+
+	conn, err := grpc.DialContext(
+		ctx,
+		c.endpoints.GRPC,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(codec{})),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	var results []Result
+	for _, q := range set.Queries {
+		var (
+			req       = request(q)
+			responses = make(map[string]response)
+			errs      = make(map[string]error)
+		)
+		collect := func(transport string, res response, err error) {
+			if err != nil {
+				errs[transport] = err
+				return
+			}
+			responses[transport] = res
+		}
+
+		res, err := c.queryGRPC(ctx, conn, q, req, height)
+		collect(TransportGRPC, res, err)
+		res, err = c.queryRPC(ctx, rpc, q, req, height)
+		collect(TransportRPC, res, err)
+		res, err = c.queryREST(ctx, set, q, height)
+		collect(TransportREST, res, err)
+
+		results = append(results, compare(q, height, responses, errs))
+	}
+
+	return results, nil
+}
+
+// response is the response of a query through a transport.
+type response struct {
+	// message is the decoded response.
+	message *dynamicpb.Message
+
+	// height is the height of the state queried, zero when the transport
+	// doesn't return it.
+	height int64
+
+	// json is the JSON response of the REST API.
+	json []byte
+}
+
+// request returns the request of the query, the list queries request a page
+// with the total of the items.
+func request(q Query) *dynamicpb.Message {
+	req := dynamicpb.NewMessage(q.Method.Input())
+	if !q.isPaginated() {
+		return req
+	}
+
+	fd := q.Method.Input().Fields().ByName(paginationField)
+	page := dynamicpb.NewMessage(fd.Message())
+	fields := fd.Message().Fields()
+	if limit := fields.ByName("limit"); limit != nil {
+		page.Set(limit, protoreflect.ValueOfUint64(pageLimit))
+	}
+	if countTotal := fields.ByName("count_total"); countTotal != nil {
+		page.Set(countTotal, protoreflect.ValueOfBool(true))
+	}
+	req.Set(fd, protoreflect.ValueOfMessage(page))
+
+	return req
+}
+
+func (c Client) queryGRPC(
+	ctx context.Context,
+	conn *grpc.ClientConn,
+	q Query,
+	req *dynamicpb.Message,
+	height int64,
+) (response, error) {
+	ctx = metadata.AppendToOutgoingContext(ctx, heightHeader, strconv.FormatInt(height, 10))
+
+	var (
+		res    = dynamicpb.NewMessage(q.Method.Output())
+		header metadata.MD
+	)
+	if err := conn.Invoke(ctx, q.path(), req, res, grpc.Header(&header)); err != nil {
+		return response{}, err
+	}
+
+	r := response{message: res}
+	if values := header.Get(heightHeader); len(values) > 0 {
+		h, err := strconv.ParseInt(values[0], 10, 64)
+		if err != nil {
+			return response{}, fmt.Errorf("invalid height header: %w", err)
+		}
+		r.height = h
+	}
+
+	return r, nil
+}
+
+func (c Client) queryRPC(
+	ctx context.Context,
+	rpc tendermintrpc.Client,
+	q Query,
+	req *dynamicpb.Message,
+	height int64,
+) (response, error) {
+	data, err := proto.Marshal(req)
+	if err != nil {
+		return response{}, err
+	}
+
+	out, err := rpc.ABCIQuery(ctx, q.path(), data, height)
+	if err != nil {
+		return response{}, err
+	}
+	if out.Code != 0 {
+		return response{}, fmt.Errorf("code %d: %s", out.Code, out.Log)
+	}
+
+	res := dynamicpb.NewMessage(q.Method.Output())
+	if err := proto.Unmarshal(out.Value, res); err != nil {
+		return response{}, err
+	}
+
+	return response{message: res, height: out.Height}, nil
+}
+
+func (c Client) queryREST(ctx context.Context, set Set, q Query, height int64) (response, error) {
+	url := c.endpoints.API + q.Route
+	if q.isPaginated() {
+		url += fmt.Sprintf("?pagination.limit=%d&pagination.count_total=true", pageLimit)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return response{}, err
+	}
+	req.Header.Set(gatewayHeightHeader, strconv.FormatInt(height, 10))
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return response{}, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return response{}, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return response{}, fmt.Errorf("GET %s: %s", q.Route, res.Status)
+	}
+
+	message := dynamicpb.NewMessage(q.Method.Output())
+	err = protojson.UnmarshalOptions{DiscardUnknown: true, Resolver: set.types}.Unmarshal(body, message)
+	if err != nil {
+		return response{}, fmt.Errorf("invalid JSON response: %w", err)
+	}
+
+	r := response{message: message, json: body}
+	if value := res.Header.Get(gatewayHeightHeader); value != "" {
+		h, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return response{}, fmt.Errorf("invalid height header: %w", err)
+		}
+		r.height = h
+	}
+
+	return r, nil
+}
+
+// codec encodes the dynamic messages of the gRPC calls.
+type codec struct{}
+
+func (codec) Marshal(v interface{}) ([]byte, error) {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return nil, errors.New("not a proto message")
+	}
+	return proto.Marshal(m)
+}
+
+func (codec) Unmarshal(data []byte, v interface{}) error {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return errors.New("not a proto message")
+	}
+	return proto.Unmarshal(data, m)
+}
+
+func (codec) Name() string {
+	return "proto"
+}
@@ -0,0 +1,297 @@
+package apiparity_test
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/ignite/cli/ignite/pkg/apiparity"
+)
+
+const height = 5
+
+func TestCheck(t *testing.T) {
+	content, responses := fixture(t)
+
+	set, err := apiparity.NewSet(content, []string{"mars/blog/query.proto"})
+	require.NoError(t, err)
+	require.Len(t, set.Queries, 2)
+	require.Equal(t, "mars.blog.Query.Params", set.Queries[0].String())
+	require.Equal(t, "/mars/blog/params", set.Queries[0].Route)
+	require.Equal(t, "mars.blog.Query.PostAll", set.Queries[1].String())
+	require.Equal(t, "/mars/blog/post_all", set.Queries[1].Route)
+	require.Equal(t, []apiparity.Skipped{{
+		Name:   "mars.blog.Query.Post",
+		Reason: "the request requires the id field",
+	}}, set.Skipped)
+
+	grpcAddress := serveGRPC(t, responses)
+
+	rpc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/status":
+			fmt.Fprintf(w, `{"result":{"sync_info":{"latest_block_height":"%d"}}}`, height)
+		case "/abci_query":
+			path, err := strconv.Unquote(r.URL.Query().Get("path"))
+			require.NoError(t, err)
+			require.Equal(t, strconv.Itoa(height), r.URL.Query().Get("height"))
+			value := base64.StdEncoding.EncodeToString(responses[path])
+			fmt.Fprintf(w, `{"result":{"response":{"code":0,"value":"%s","height":"%d"}}}`, value, height)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer rpc.Close()
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/mars/blog/params":
+			w.Write([]byte(`{"params":{"maxTitles":"10"}}`))
+		case "/mars/blog/post_all":
+			require.Equal(t, "1", r.URL.Query().Get("pagination.limit"))
+			w.Header().Set("Grpc-Metadata-X-Cosmos-Block-Height", "4")
+			w.Write([]byte(`{"post":[{"id":"0","title":"a"}],"pagination":{"next_key":null,"total":"2"}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer api.Close()
+
+	client := apiparity.New(apiparity.Endpoints{
+		GRPC: grpcAddress,
+		RPC:  rpc.URL,
+		API:  api.URL,
+	})
+
+	results, err := client.Check(context.Background(), set)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	params := results[0]
+	require.NoError(t, params.Err)
+	require.EqualValues(t, height, params.Height)
+	require.Equal(t, []apiparity.Mismatch{{
+		Kind:   apiparity.KindFieldCasing,
+		Detail: "params.maxTitles should be params.max_titles",
+	}}, params.Mismatches)
+
+	posts := results[1]
+	require.NoError(t, posts.Err)
+	require.Len(t, posts.Mismatches, 2)
+	require.Equal(t, apiparity.Mismatch{
+		Kind:   apiparity.KindHeight,
+		Detail: "REST responds at height 4 instead of 5",
+	}, posts.Mismatches[0])
+	require.Equal(t, apiparity.KindPagination, posts.Mismatches[1].Kind)
+}
+
+// fixture returns the descriptor set of a blog module and the serialized
+// responses of its queries by gRPC path.
+func fixture(t *testing.T) ([]byte, map[string][]byte) {
+	pagination := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("cosmos/base/query/v1beta1/pagination.proto"),
+		Package: proto.String("cosmos.base.query.v1beta1"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			message("PageRequest",
+				field("key", 1, descriptorpb.FieldDescriptorProto_TYPE_BYTES, ""),
+				field("offset", 2, descriptorpb.FieldDescriptorProto_TYPE_UINT64, ""),
+				field("limit", 3, descriptorpb.FieldDescriptorProto_TYPE_UINT64, ""),
+				field("count_total", 4, descriptorpb.FieldDescriptorProto_TYPE_BOOL, ""),
+			),
+			message("PageResponse",
+				field("next_key", 1, descriptorpb.FieldDescriptorProto_TYPE_BYTES, ""),
+				field("total", 2, descriptorpb.FieldDescriptorProto_TYPE_UINT64, ""),
+			),
+		},
+	}
+
+	posts := field("post", 1, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".mars.blog.Post")
+	posts.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+
+	query := &descriptorpb.FileDescriptorProto{
+		Name:       proto.String("mars/blog/query.proto"),
+		Package:    proto.String("mars.blog"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{pagination.GetName()},
+		MessageType: []*descriptorpb.DescriptorProto{
+			message("Params", field("max_titles", 1, descriptorpb.FieldDescriptorProto_TYPE_UINT64, "")),
+			message("Post",
+				field("id", 1, descriptorpb.FieldDescriptorProto_TYPE_UINT64, ""),
+				field("title", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING, ""),
+			),
+			message("QueryParamsRequest"),
+			message("QueryParamsResponse",
+				field("params", 1, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".mars.blog.Params"),
+			),
+			message("QueryAllPostRequest",
+				field("pagination", 1, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".cosmos.base.query.v1beta1.PageRequest"),
+			),
+			message("QueryAllPostResponse",
+				posts,
+				field("pagination", 2, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".cosmos.base.query.v1beta1.PageResponse"),
+			),
+			message("QueryPostRequest", field("id", 1, descriptorpb.FieldDescriptorProto_TYPE_UINT64, "")),
+			message("QueryPostResponse",
+				field("post", 1, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".mars.blog.Post"),
+			),
+		},
+		Service: []*descriptorpb.ServiceDescriptorProto{{
+			Name: proto.String("Query"),
+			Method: []*descriptorpb.MethodDescriptorProto{
+				method("Params", "QueryParamsRequest", "QueryParamsResponse"),
+				method("PostAll", "QueryAllPostRequest", "QueryAllPostResponse"),
+				method("Post", "QueryPostRequest", "QueryPostResponse"),
+			},
+		}},
+	}
+
+	set := &descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{pagination, query}}
+	content, err := proto.Marshal(set)
+	require.NoError(t, err)
+
+	files, err := protodesc.NewFiles(set)
+	require.NoError(t, err)
+
+	// newMessage returns a message of the blog module with the fields set
+	newMessage := func(name string, fields map[string]protoreflect.Value) *dynamicpb.Message {
+		d, err := files.FindDescriptorByName(protoreflect.FullName(name))
+		require.NoError(t, err)
+		md := d.(protoreflect.MessageDescriptor)
+		m := dynamicpb.NewMessage(md)
+		for k, v := range fields {
+			m.Set(md.Fields().ByName(protoreflect.Name(k)), v)
+		}
+		return m
+	}
+
+	params := newMessage("mars.blog.QueryParamsResponse", map[string]protoreflect.Value{
+		"params": protoreflect.ValueOfMessage(newMessage("mars.blog.Params", map[string]protoreflect.Value{
+			"max_titles": protoreflect.ValueOfUint64(10),
+		})),
+	})
+
+	allPosts := newMessage("mars.blog.QueryAllPostResponse", map[string]protoreflect.Value{
+		"pagination": protoreflect.ValueOfMessage(newMessage("cosmos.base.query.v1beta1.PageResponse", map[string]protoreflect.Value{
+			"next_key": protoreflect.ValueOfBytes([]byte{1}),
+			"total":    protoreflect.ValueOfUint64(2),
+		})),
+	})
+	list := allPosts.Mutable(allPosts.Descriptor().Fields().ByName("post")).List()
+	list.Append(protoreflect.ValueOfMessage(newMessage("mars.blog.Post", map[string]protoreflect.Value{
+		"title": protoreflect.ValueOfString("a"),
+	})))
+
+	responses := make(map[string][]byte)
+	for path, m := range map[string]proto.Message{
+		"/mars.blog.Query/Params":  params,
+		"/mars.blog.Query/PostAll": allPosts,
+	} {
+		responses[path], err = proto.Marshal(m)
+		require.NoError(t, err)
+	}
+
+	return content, responses
+}
+
+// serveGRPC serves the responses by gRPC path and returns the address of the server.
+func serveGRPC(t *testing.T, responses map[string][]byte) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer(
+		grpc.ForceServerCodec(rawCodec{}),
+		grpc.UnknownServiceHandler(func(_ interface{}, stream grpc.ServerStream) error {
+			path, _ := grpc.MethodFromServerStream(stream)
+
+			var req []byte
+			if err := stream.RecvMsg(&req); err != nil {
+				return err
+			}
+
+			md, _ := metadata.FromIncomingContext(stream.Context())
+			if err := stream.SetHeader(metadata.Pairs("x-cosmos-block-height", md.Get("x-cosmos-block-height")[0])); err != nil {
+				return err
+			}
+			res := responses[path]
+			return stream.SendMsg(&res)
+		}),
+	)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	return listener.Addr().String()
+}
+
+// rawCodec passes the serialized messages as they are.
+type rawCodec struct{}
+
+func (rawCodec) Marshal(v interface{}) ([]byte, error) { return *v.(*[]byte), nil }
+
+func (rawCodec) Unmarshal(data []byte, v interface{}) error {
+	*v.(*[]byte) = data
+	return nil
+}
+
+func (rawCodec) Name() string { return "proto" }
+
+func message(name string, fields ...*descriptorpb.FieldDescriptorProto) *descriptorpb.DescriptorProto {
+	return &descriptorpb.DescriptorProto{Name: proto.String(name), Field: fields}
+}
+
+func field(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type, typeName string) *descriptorpb.FieldDescriptorProto {
+	f := &descriptorpb.FieldDescriptorProto{
+		Name:     proto.String(name),
+		JsonName: proto.String(jsonName(name)),
+		Number:   proto.Int32(number),
+		Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Type:     typ.Enum(),
+	}
+	if typeName != "" {
+		f.TypeName = proto.String(typeName)
+	}
+	return f
+}
+
+func method(name, input, output string) *descriptorpb.MethodDescriptorProto {
+	return &descriptorpb.MethodDescriptorProto{
+		Name:       proto.String(name),
+		InputType:  proto.String(".mars.blog." + input),
+		OutputType: proto.String(".mars.blog." + output),
+	}
+}
+
+// jsonName returns the lower camel case JSON name of a field like protoc.
+func jsonName(name string) string {
+	var (
+		out   []byte
+		upper bool
+	)
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if c == '_' {
+			upper = true
+			continue
+		}
+		if upper && c >= 'a' && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		upper = false
+		out = append(out, c)
+	}
+	return string(out)
+}
@@ -0,0 +1,207 @@
+package apiparity
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// transports are the transports in the order of the comparisons, the
+// responses are compared with the gRPC one.
+var transports = []string{TransportGRPC, TransportRPC, TransportREST}
+
+// compare returns the result of the query from the responses and the errors of
+// the transports.
+func compare(q Query, height int64, responses map[string]response, errs map[string]error) Result {
+	r := Result{Query: q, Height: height}
+
+	if len(responses) == 0 {
+		var messages []string
+		for _, t := range transports {
+			messages = append(messages, fmt.Sprintf("%s: %s", t, errs[t]))
+		}
+		r.Err = fmt.Errorf("the query fails on all the transports: %s", strings.Join(messages, ", "))
+		return r
+	}
+
+	var succeeded []string
+	for _, t := range transports {
+		if _, ok := responses[t]; ok {
+			succeeded = append(succeeded, t)
+		}
+	}
+	for _, t := range transports {
+		if err, ok := errs[t]; ok {
+			r.Mismatches = append(r.Mismatches, Mismatch{
+				Kind:   KindAvailability,
+				Detail: fmt.Sprintf("fails on %s but succeeds on %s: %s", t, strings.Join(succeeded, ", "), err),
+			})
+		}
+	}
+
+	for _, t := range succeeded {
+		if h := responses[t].height; h != 0 && h != height {
+			r.Mismatches = append(r.Mismatches, Mismatch{
+				Kind:   KindHeight,
+				Detail: fmt.Sprintf("%s responds at height %d instead of %d", t, h, height),
+			})
+		}
+	}
+
+	if res, ok := responses[TransportREST]; ok {
+		var v interface{}
+		if err := json.Unmarshal(res.json, &v); err == nil {
+			for _, field := range casingMismatches(q.Method.Output(), v, "") {
+				r.Mismatches = append(r.Mismatches, Mismatch{Kind: KindFieldCasing, Detail: field})
+			}
+		}
+	}
+
+	base := succeeded[0]
+	for _, t := range succeeded[1:] {
+		r.Mismatches = append(r.Mismatches, diff(base, t, responses[base].message, responses[t].message)...)
+	}
+
+	return r
+}
+
+// diff returns the differences between the responses of two transports, the
+// differences of the pagination are reported apart from the payload.
+func diff(baseTransport, transport string, base, other *dynamicpb.Message) []Mismatch {
+	var (
+		mismatches []Mismatch
+		fields     []string
+		md         = base.Descriptor()
+	)
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		if equalField(fd, base, other) {
+			continue
+		}
+
+		if fd.Name() == paginationField {
+			mismatches = append(mismatches, Mismatch{
+				Kind: KindPagination,
+				Detail: fmt.Sprintf(
+					"%s returns %s but %s returns %s",
+					baseTransport,
+					formatField(fd, base),
+					transport,
+					formatField(fd, other),
+				),
+			})
+			continue
+		}
+		fields = append(fields, string(fd.Name()))
+	}
+
+	if len(fields) > 0 {
+		mismatches = append(mismatches, Mismatch{
+			Kind: KindPayload,
+			Detail: fmt.Sprintf(
+				"the %s fields of %s and %s differ",
+				strings.Join(fields, ", "),
+				baseTransport,
+				transport,
+			),
+		})
+	}
+
+	return mismatches
+}
+
+// equalField returns true when the field has the same value in both messages.
+func equalField(fd protoreflect.FieldDescriptor, a, b *dynamicpb.Message) bool {
+	return proto.Equal(onlyField(fd, a), onlyField(fd, b))
+}
+
+// onlyField returns a message with the field of m only.
+func onlyField(fd protoreflect.FieldDescriptor, m *dynamicpb.Message) *dynamicpb.Message {
+	out := dynamicpb.NewMessage(m.Descriptor())
+	if m.Has(fd) {
+		out.Set(fd, m.Get(fd))
+	}
+	return out
+}
+
+// formatField returns the text format of the field of m.
+func formatField(fd protoreflect.FieldDescriptor, m *dynamicpb.Message) string {
+	s := strings.TrimSpace(fmt.Sprint(onlyField(fd, m)))
+	if s == "" {
+		return "no " + string(fd.Name())
+	}
+	return "{" + s + "}"
+}
+
+// casingMismatches returns the keys of the JSON value v of a message that are
+// the JSON names of the fields instead of their proto names, the clients of the
+// REST API of the Cosmos SDK expect the proto names.
+func casingMismatches(md protoreflect.MessageDescriptor, v interface{}, prefix string) []string {
+	// The well-known types have their own JSON format
+	if strings.HasPrefix(string(md.FullName()), "google.protobuf.") {
+		return nil
+	}
+
+	obj, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var mismatches []string
+	for _, k := range keys {
+		fd := md.Fields().ByName(protoreflect.Name(k))
+		if fd == nil {
+			if fd = md.Fields().ByJSONName(k); fd == nil {
+				continue
+			}
+			mismatches = append(mismatches, fmt.Sprintf("%s%s should be %s%s", prefix, k, prefix, fd.Name()))
+		}
+
+		path := prefix + string(fd.Name()) + "."
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Message() == nil {
+				continue
+			}
+			values, _ := obj[k].(map[string]interface{})
+			for _, value := range values {
+				mismatches = append(mismatches, casingMismatches(fd.MapValue().Message(), value, path)...)
+			}
+		case fd.Message() == nil:
+		case fd.IsList():
+			items, _ := obj[k].([]interface{})
+			for _, item := range items {
+				mismatches = append(mismatches, casingMismatches(fd.Message(), item, path)...)
+			}
+		default:
+			mismatches = append(mismatches, casingMismatches(fd.Message(), obj[k], path)...)
+		}
+	}
+
+	return dedupe(mismatches)
+}
+
+// dedupe removes the duplicate mismatches of the items of lists and maps.
+func dedupe(mismatches []string) []string {
+	seen := make(map[string]bool)
+	var out []string
+	for _, m := range mismatches {
+		if seen[m] {
+			continue
+		}
+		seen[m] = true
+		out = append(out, m)
+	}
+	return out
+}
@@ -0,0 +1,120 @@
+// Package buf runs the buf CLI to manage the proto files of an app: lint them,
+// detect their breaking changes and resolve their dependencies from the Buf
+// Schema Registry.
+package buf
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/exec"
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/step"
+	"github.com/ignite/cli/ignite/pkg/xexec"
+)
+
+const (
+	// ConfigFile is the name of the config file of a buf module.
+	ConfigFile = "buf.yaml"
+
+	// GenConfigFile is the name of the code generation config file of a buf module.
+	GenConfigFile = "buf.gen.yaml"
+
+	// LockFile is the name of the file with the pinned dependencies of a buf module.
+	LockFile = "buf.lock"
+
+	binaryName = "buf"
+)
+
+// ErrNotInstalled is returned when the buf CLI is not found in PATH.
+var ErrNotInstalled = errors.New(`the "buf" command is not installed, see https://docs.buf.build/installation`)
+
+// Dependency is a dependency of a buf module pinned to a commit of the registry.
+type Dependency struct {
+	Remote     string `yaml:"remote"`
+	Owner      string `yaml:"owner"`
+	Repository string `yaml:"repository"`
+	Commit     string `yaml:"commit"`
+}
+
+// Ref returns the reference of the pinned dependency in the registry.
+func (d Dependency) Ref() string {
+	return fmt.Sprintf("%s/%s/%s:%s", d.Remote, d.Owner, d.Repository, d.Commit)
+}
+
+// Path returns the relative path of the pinned dependency.
+func (d Dependency) Path() string {
+	return filepath.Join(d.Remote, d.Owner, d.Repository, d.Commit)
+}
+
+// IsInstalled checks if the buf CLI is available.
+func IsInstalled() bool {
+	return xexec.IsCommandAvailable(binaryName)
+}
+
+// HasConfig checks if dir is the root of a buf module.
+func HasConfig(dir string) bool {
+	_, err := os.Stat(filepath.Join(dir, ConfigFile))
+	return err == nil
+}
+
+// Dependencies returns the pinned dependencies of the buf module in dir.
+// The dependencies are empty when the module has no lock file.
+func Dependencies(dir string) ([]Dependency, error) {
+	content, err := os.ReadFile(filepath.Join(dir, LockFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var lock struct {
+		Deps []Dependency `yaml:"deps"`
+	}
+	if err := yaml.Unmarshal(content, &lock); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", LockFile, err)
+	}
+
+	return lock.Deps, nil
+}
+
+// Lint runs the linters of the buf module in dir.
+func Lint(ctx context.Context, dir string) error {
+	return run(ctx, dir, "lint")
+}
+
+// Breaking checks the breaking changes of the buf module in dir against the
+// input of the against ref, for example ".git#branch=main,subdir=proto".
+func Breaking(ctx context.Context, dir, against string) error {
+	return run(ctx, dir, "breaking", "--against", against)
+}
+
+// ModUpdate pins the latest versions of the dependencies of the buf module in
+// dir in its lock file.
+func ModUpdate(ctx context.Context, dir string) error {
+	return run(ctx, dir, "mod", "update")
+}
+
+// Export exports the proto files of the pinned dependency and of its own
+// dependencies in out.
+func Export(ctx context.Context, dep Dependency, out string) error {
+	return run(ctx, "", "export", dep.Ref(), "--output", out)
+}
+
+func run(ctx context.Context, dir string, args ...string) error {
+	if !IsInstalled() {
+		return ErrNotInstalled
+	}
+
+	options := []exec.Option{exec.IncludeStdLogsToError()}
+	if dir != "" {
+		options = append(options, exec.StepOption(step.Workdir(dir)))
+	}
+
+	return exec.Exec(ctx, append([]string{binaryName}, args...), options...)
+}
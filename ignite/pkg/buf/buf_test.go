@@ -0,0 +1,48 @@
+package buf_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/buf"
+)
+
+func TestDependencies(t *testing.T) {
+	dir := t.TempDir()
+
+	// Modules without lock file have no pinned dependencies
+	deps, err := buf.Dependencies(dir)
+	require.NoError(t, err)
+	require.Empty(t, deps)
+
+	lock := `# Generated by buf. DO NOT EDIT.
+version: v1
+deps:
+  - remote: buf.build
+    owner: cosmos
+    repository: cosmos-sdk
+    commit: 508e19f5f37549e3a471a2a59b903c00
+  - remote: buf.build
+    owner: googleapis
+    repository: googleapis
+    commit: 8d7204855ec14631a499bd7393ce1970
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, buf.LockFile), []byte(lock), 0o644))
+
+	deps, err = buf.Dependencies(dir)
+	require.NoError(t, err)
+	require.Len(t, deps, 2)
+	require.Equal(t, "buf.build/cosmos/cosmos-sdk:508e19f5f37549e3a471a2a59b903c00", deps[0].Ref())
+	require.Equal(t, filepath.Join("buf.build", "googleapis", "googleapis", "8d7204855ec14631a499bd7393ce1970"), deps[1].Path())
+}
+
+func TestHasConfig(t *testing.T) {
+	dir := t.TempDir()
+	require.False(t, buf.HasConfig(dir))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, buf.ConfigFile), []byte("version: v1\n"), 0o644))
+	require.True(t, buf.HasConfig(dir))
+}
@@ -144,6 +144,23 @@ func (c Cache[T]) Delete(key string) error {
 	})
 }
 
+// Clear deletes all the cached values of the namespace
+func (c Cache[T]) Clear() error {
+	db, err := openDB(c.storage.storagePath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	return db.Update(func(tx *bolt.Tx) error {
+		if tx.Bucket([]byte(c.namespace)) == nil {
+			return nil
+		}
+
+		return tx.DeleteBucket([]byte(c.namespace))
+	})
+}
+
 func openDB(path string) (*bolt.DB, error) {
 	return bolt.Open(path, 0o640, &bolt.Options{Timeout: 1 * time.Minute})
 }
@@ -161,6 +161,34 @@ func TestClearStorage(t *testing.T) {
 	require.Equal(t, cache.ErrorNotFound, err)
 }
 
+func TestClearNamespace(t *testing.T) {
+	tmpDir := t.TempDir()
+	cacheStorage, err := cache.NewStorage(filepath.Join(tmpDir, "testdbfile.db"))
+	require.NoError(t, err)
+
+	strNamespace := cache.New[string](cacheStorage, "myNameSpace")
+	otherNamespace := cache.New[string](cacheStorage, "otherNameSpace")
+
+	err = strNamespace.Put("myKey", "myValue")
+	require.NoError(t, err)
+	err = otherNamespace.Put("myKey", "otherValue")
+	require.NoError(t, err)
+
+	err = strNamespace.Clear()
+	require.NoError(t, err)
+
+	_, err = strNamespace.Get("myKey")
+	require.Equal(t, cache.ErrorNotFound, err)
+
+	val, err := otherNamespace.Get("myKey")
+	require.NoError(t, err)
+	require.Equal(t, "otherValue", val)
+
+	// Clearing an empty namespace is a no-op
+	err = strNamespace.Clear()
+	require.NoError(t, err)
+}
+
 func TestKey(t *testing.T) {
 	singleKey := cache.Key("test1")
 	require.Equal(t, "test1", singleKey)
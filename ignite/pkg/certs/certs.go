@@ -0,0 +1,205 @@
+// Package certs issues the TLS certificates of the development networks from a
+// project certificate authority.
+package certs
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	pemTypeCertificate = "CERTIFICATE"
+	pemTypePrivateKey  = "EC PRIVATE KEY"
+)
+
+// KeyPair is a PEM encoded certificate and its private key.
+type KeyPair struct {
+	Cert []byte
+	Key  []byte
+}
+
+// NewCA creates a self-signed certificate authority valid for the validity duration.
+func NewCA(commonName string, validity time.Duration) (KeyPair, error) {
+	template, err := newTemplate(commonName, validity)
+	if err != nil {
+		return KeyPair{}, err
+	}
+	template.IsCA = true
+	template.BasicConstraintsValid = true
+	template.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return KeyPair{}, err
+	}
+	return newKeyPair(template, template, key, key)
+}
+
+// Issue issues a certificate signed by the ca for the hosts, valid for the validity
+// duration. The certificate can authenticate both servers and clients.
+// The hosts can be host names or IP addresses.
+func Issue(ca KeyPair, commonName string, hosts []string, validity time.Duration) (KeyPair, error) {
+	caCert, caKey, err := ca.parse()
+	if err != nil {
+		return KeyPair{}, err
+	}
+	if !caCert.IsCA {
+		return KeyPair{}, errors.New("the certificate authority can't issue certificates")
+	}
+
+	template, err := newTemplate(commonName, validity)
+	if err != nil {
+		return KeyPair{}, err
+	}
+	template.KeyUsage = x509.KeyUsageDigitalSignature
+	template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}
+	for _, h := range hosts {
+		if ip := net.ParseIP(h); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, h)
+		}
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return KeyPair{}, err
+	}
+	return newKeyPair(template, caCert, key, caKey)
+}
+
+// Load reads a key pair from the PEM encoded certificate and key files.
+func Load(certPath, keyPath string) (KeyPair, error) {
+	cert, err := os.ReadFile(certPath)
+	if err != nil {
+		return KeyPair{}, err
+	}
+	key, err := os.ReadFile(keyPath)
+	if err != nil {
+		return KeyPair{}, err
+	}
+	p := KeyPair{Cert: cert, Key: key}
+	if _, _, err := p.parse(); err != nil {
+		return KeyPair{}, fmt.Errorf("%s: %w", certPath, err)
+	}
+	return p, nil
+}
+
+// Save writes the certificate and the key to PEM encoded files, the key file
+// is only readable by the user.
+func (p KeyPair) Save(certPath, keyPath string) error {
+	for _, path := range []string{certPath, keyPath} {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+	}
+	if err := os.WriteFile(certPath, p.Cert, 0o644); err != nil {
+		return err
+	}
+	return os.WriteFile(keyPath, p.Key, 0o600)
+}
+
+// TLSCertificate returns the key pair as a TLS certificate.
+func (p KeyPair) TLSCertificate() (tls.Certificate, error) {
+	return tls.X509KeyPair(p.Cert, p.Key)
+}
+
+// CertPool returns a pool with the certificate of the key pair, it's used to
+// verify the certificates issued by a certificate authority.
+func (p KeyPair) CertPool() (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(p.Cert) {
+		return nil, errors.New("invalid PEM certificate")
+	}
+	return pool, nil
+}
+
+// NotAfter returns the expiration time of the certificate.
+func (p KeyPair) NotAfter() (time.Time, error) {
+	cert, _, err := p.parse()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return cert.NotAfter, nil
+}
+
+// Hosts returns the host names and IP addresses of the certificate.
+func (p KeyPair) Hosts() ([]string, error) {
+	cert, _, err := p.parse()
+	if err != nil {
+		return nil, err
+	}
+	hosts := append([]string{}, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		hosts = append(hosts, ip.String())
+	}
+	return hosts, nil
+}
+
+// parse decodes the certificate and the key of the key pair.
+func (p KeyPair) parse() (*x509.Certificate, *ecdsa.PrivateKey, error) {
+	certBlock, _ := pem.Decode(p.Cert)
+	if certBlock == nil || certBlock.Type != pemTypeCertificate {
+		return nil, nil, errors.New("invalid PEM certificate")
+	}
+	cert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyBlock, _ := pem.Decode(p.Key)
+	if keyBlock == nil || keyBlock.Type != pemTypePrivateKey {
+		return nil, nil, errors.New("invalid PEM private key")
+	}
+	key, err := x509.ParseECPrivateKey(keyBlock.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cert, key, nil
+}
+
+// newTemplate returns a certificate template with a random serial number.
+func newTemplate(commonName string, validity time.Duration) (*x509.Certificate, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: commonName},
+		// Tolerate the clock skew between the machines of a devnet
+		NotBefore: now.Add(-time.Hour),
+		NotAfter:  now.Add(validity),
+	}, nil
+}
+
+// newKeyPair creates the certificate from the template signed by the parent
+// certificate and key.
+func newKeyPair(template, parent *x509.Certificate, key, parentKey *ecdsa.PrivateKey) (KeyPair, error) {
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		return KeyPair{}, err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return KeyPair{}, err
+	}
+	return KeyPair{
+		Cert: pem.EncodeToMemory(&pem.Block{Type: pemTypeCertificate, Bytes: der}),
+		Key:  pem.EncodeToMemory(&pem.Block{Type: pemTypePrivateKey, Bytes: keyDER}),
+	}, nil
+}
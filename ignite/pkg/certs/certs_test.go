@@ -0,0 +1,61 @@
+package certs
+
+import (
+	"crypto/x509"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssue(t *testing.T) {
+	ca, err := NewCA("mars CA", time.Hour)
+	require.NoError(t, err)
+
+	node, err := Issue(ca, "mars node", []string{"localhost", "127.0.0.1", "node.mars"}, time.Hour)
+	require.NoError(t, err)
+
+	pool, err := ca.CertPool()
+	require.NoError(t, err)
+	cert, _, err := node.parse()
+	require.NoError(t, err)
+
+	for _, host := range []string{"localhost", "127.0.0.1", "node.mars"} {
+		_, err = cert.Verify(x509.VerifyOptions{
+			DNSName:   host,
+			Roots:     pool,
+			KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		})
+		require.NoError(t, err, host)
+	}
+	_, err = cert.Verify(x509.VerifyOptions{DNSName: "other.mars", Roots: pool})
+	require.Error(t, err)
+
+	hosts, err := node.Hosts()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"localhost", "node.mars", "127.0.0.1"}, hosts)
+
+	_, err = node.TLSCertificate()
+	require.NoError(t, err)
+
+	// Only a certificate authority can issue certificates
+	_, err = Issue(node, "other", nil, time.Hour)
+	require.Error(t, err)
+}
+
+func TestSaveLoad(t *testing.T) {
+	ca, err := NewCA("mars CA", time.Hour)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	certPath, keyPath := filepath.Join(dir, "ca.pem"), filepath.Join(dir, "keys", "ca-key.pem")
+	require.NoError(t, ca.Save(certPath, keyPath))
+
+	loaded, err := Load(certPath, keyPath)
+	require.NoError(t, err)
+	require.Equal(t, ca, loaded)
+
+	_, err = Load(keyPath, certPath)
+	require.Error(t, err)
+}
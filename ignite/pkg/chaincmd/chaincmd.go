@@ -2,6 +2,7 @@ package chaincmd
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 
@@ -43,12 +44,18 @@ const (
 	optionValidatorIdentity                = "--identity"
 	optionValidatorWebsite                 = "--website"
 	optionValidatorSecurityContact         = "--security-contact"
+	optionNote                             = "--note"
 	optionYes                              = "--yes"
 	optionHomeClient                       = "--home-client"
 	optionCoinType                         = "--coin-type"
 	optionVestingAmount                    = "--vesting-amount"
 	optionVestingEndTime                   = "--vesting-end-time"
 	optionBroadcastMode                    = "--broadcast-mode"
+	optionFrom                             = "--from"
+	optionFees                             = "--fees"
+	optionGas                              = "--gas"
+	optionGasPrices                        = "--gas-prices"
+	optionGasAdjustment                    = "--gas-adjustment"
 
 	constTendermint = "tendermint"
 	constJSON       = "json"
@@ -75,6 +82,10 @@ type ChainCmd struct {
 	cliHome         string
 	nodeAddress     string
 	legacySend      bool
+	fees            string
+	gas             string
+	gasPrices       string
+	gasAdjustment   float64
 
 	isAutoChainIDDetectionEnabled bool
 
@@ -160,6 +171,37 @@ func WithNodeAddress(addr string) Option {
 	}
 }
 
+// WithFees sets the fees paid by the txs sent by the commands, for example "10uatom".
+func WithFees(fees string) Option {
+	return func(c *ChainCmd) {
+		c.fees = fees
+	}
+}
+
+// WithGas sets the gas limit of the txs sent by the commands, "auto" simulates
+// the txs to estimate their gas.
+func WithGas(gas string) Option {
+	return func(c *ChainCmd) {
+		c.gas = gas
+	}
+}
+
+// WithGasPrices sets the gas prices that determine the fees of the txs sent
+// by the commands, for example "0.025uatom".
+func WithGasPrices(gasPrices string) Option {
+	return func(c *ChainCmd) {
+		c.gasPrices = gasPrices
+	}
+}
+
+// WithGasAdjustment sets the factor applied to the estimated gas of the txs
+// sent by the commands.
+func WithGasAdjustment(gasAdjustment float64) Option {
+	return func(c *ChainCmd) {
+		c.gasAdjustment = gasAdjustment
+	}
+}
+
 // WithLaunchpadCLI provides the CLI application name for the blockchain
 // this is necessary for Launchpad applications since it has two different binaries but
 // not needed by Stargate applications
@@ -403,6 +445,17 @@ func GentxWithSecurityContact(securityContact string) GentxOption {
 	}
 }
 
+// GentxWithMemo provides the memo option for the gentx command, the memo of a
+// gentx is the peer address of the node of the validator
+func GentxWithMemo(memo string) GentxOption {
+	return func(command []string) []string {
+		if len(memo) > 0 {
+			return append(command, optionNote, memo)
+		}
+		return command
+	}
+}
+
 func (c ChainCmd) IsAutoChainIDDetectionEnabled() bool {
 	return c.isAutoChainIDDetectionEnabled
 }
@@ -478,6 +531,15 @@ func (c ChainCmd) ValidateGenesisCommand() step.Option {
 	return c.daemonCommand(command)
 }
 
+// ValidateGenesisFileCommand returns the command to check the validity of the genesis file at path
+func (c ChainCmd) ValidateGenesisFileCommand(path string) step.Option {
+	command := []string{
+		commandValidateGenesis,
+		path,
+	}
+	return c.daemonCommand(command)
+}
+
 // ShowNodeIDCommand returns the command to print the node ID of the node for the chain
 func (c ChainCmd) ShowNodeIDCommand() step.Option {
 	command := []string{
@@ -530,6 +592,7 @@ func (c ChainCmd) BankSendCommand(fromAddress, toAddress, amount string) step.Op
 	)
 
 	command = c.attachChainID(command)
+	command = c.attachFees(command)
 	command = c.attachKeyringBackend(command)
 	command = c.attachNode(command)
 
@@ -540,6 +603,116 @@ func (c ChainCmd) BankSendCommand(fromAddress, toAddress, amount string) step.Op
 	return c.cliCommand(command)
 }
 
+// SignTxCommand returns the command to sign the unsigned tx of the tx file
+// from an account, the signed tx is written to the output.
+func (c ChainCmd) SignTxCommand(fromAccount, txFile string) step.Option {
+	command := []string{
+		commandTx,
+		"sign",
+		txFile,
+		optionFrom, fromAccount,
+	}
+
+	command = c.attachChainID(command)
+	command = c.attachKeyringBackend(command)
+	command = c.attachNode(command)
+	return c.cliCommand(command)
+}
+
+// BroadcastTxCommand returns the command to broadcast the signed tx of the tx file.
+func (c ChainCmd) BroadcastTxCommand(txFile string) step.Option {
+	command := []string{
+		commandTx,
+		"broadcast",
+		txFile,
+		optionBroadcastMode, flags.BroadcastSync,
+	}
+
+	command = c.attachNode(command)
+	return c.cliCommand(command)
+}
+
+// SubmitParamChangeProposalCommand returns the command to submit the param change
+// proposal of the proposal file from an account.
+func (c ChainCmd) SubmitParamChangeProposalCommand(fromAccount, proposalFile string) step.Option {
+	command := []string{
+		commandTx,
+		"gov",
+	}
+
+	// Param changes are legacy proposals from Cosmos SDK v0.46.0
+	if c.sdkVersion.GTE(cosmosver.StargateFortySixVersion) {
+		command = append(command, "submit-legacy-proposal")
+	} else {
+		command = append(command, "submit-proposal")
+	}
+
+	command = append(command,
+		"param-change",
+		proposalFile,
+		optionFrom, fromAccount,
+		optionBroadcastMode, flags.BroadcastSync,
+		optionYes,
+	)
+
+	command = c.attachChainID(command)
+	command = c.attachKeyringBackend(command)
+	command = c.attachNode(command)
+	return c.cliCommand(command)
+}
+
+// SubmitSoftwareUpgradeProposalCommand returns the command to submit the
+// proposal of the software upgrade with the name at the height.
+func (c ChainCmd) SubmitSoftwareUpgradeProposalCommand(fromAccount, name string, height int64, deposit string) step.Option {
+	command := []string{
+		commandTx,
+		"gov",
+	}
+
+	// Software upgrades are legacy proposals from Cosmos SDK v0.46.0
+	if c.sdkVersion.GTE(cosmosver.StargateFortySixVersion) {
+		command = append(command, "submit-legacy-proposal")
+	} else {
+		command = append(command, "submit-proposal")
+	}
+
+	command = append(command,
+		"software-upgrade",
+		name,
+		"--upgrade-height", strconv.FormatInt(height, 10),
+		"--title", fmt.Sprintf("Upgrade %s", name),
+		"--description", fmt.Sprintf("Upgrade to %s at height %d", name, height),
+		"--deposit", deposit,
+		optionFrom, fromAccount,
+		optionBroadcastMode, flags.BroadcastSync,
+		optionYes,
+	)
+
+	command = c.attachChainID(command)
+	command = c.attachKeyringBackend(command)
+	command = c.attachNode(command)
+	return c.cliCommand(command)
+}
+
+// GovVoteCommand returns the command to vote on a governance proposal from an account.
+func (c ChainCmd) GovVoteCommand(fromAccount string, proposalID uint64, option string) step.Option {
+	command := []string{
+		commandTx,
+		"gov",
+		"vote",
+		fmt.Sprintf("%d", proposalID),
+		option,
+		optionFrom, fromAccount,
+		optionBroadcastMode, flags.BroadcastSync,
+		optionYes,
+	}
+
+	command = c.attachChainID(command)
+	command = c.attachKeyringBackend(command)
+	command = c.attachNode(command)
+	return c.cliCommand(command)
+}
+
 // QueryTxCommand returns the command to query tx
 func (c ChainCmd) QueryTxCommand(txHash string) step.Option {
 	command := []string{
@@ -552,6 +725,21 @@ func (c ChainCmd) QueryTxCommand(txHash string) step.Option {
 	return c.cliCommand(command)
 }
 
+// QueryBankBalancesCommand returns the command to query the bank balances of an address.
+func (c ChainCmd) QueryBankBalancesCommand(address string) step.Option {
+	command := []string{
+		commandQuery,
+		"bank",
+		"balances",
+		address,
+		optionOutput,
+		constJSON,
+	}
+
+	command = c.attachNode(command)
+	return c.cliCommand(command)
+}
+
 // QueryTxEventsCommand returns the command to query events.
 func (c ChainCmd) QueryTxEventsCommand(query string) step.Option {
 	command := []string{
@@ -611,6 +799,21 @@ func (c ChainCmd) KeyringPassword() string {
 	return c.keyringPassword
 }
 
+// Fees returns the fees paid by the txs.
+func (c ChainCmd) Fees() string {
+	return c.fees
+}
+
+// GasPrices returns the gas prices that determine the fees of the txs.
+func (c ChainCmd) GasPrices() string {
+	return c.gasPrices
+}
+
+// GasAdjustment returns the factor applied to the estimated gas of the txs.
+func (c ChainCmd) GasAdjustment() float64 {
+	return c.gasAdjustment
+}
+
 // attachChainID appends the chain ID flag to the provided command
 func (c ChainCmd) attachChainID(command []string) []string {
 	if c.chainID != "" {
@@ -627,6 +830,23 @@ func (c ChainCmd) attachKeyringBackend(command []string) []string {
 	return command
 }
 
+// attachFees appends the fees and gas flags to the provided command
+func (c ChainCmd) attachFees(command []string) []string {
+	if c.fees != "" {
+		command = append(command, []string{optionFees, c.fees}...)
+	}
+	if c.gas != "" {
+		command = append(command, []string{optionGas, c.gas}...)
+	}
+	if c.gasPrices != "" {
+		command = append(command, []string{optionGasPrices, c.gasPrices}...)
+	}
+	if c.gasAdjustment != 0 {
+		command = append(command, []string{optionGasAdjustment, strconv.FormatFloat(c.gasAdjustment, 'f', -1, 64)}...)
+	}
+	return command
+}
+
 // attachHome appends the home flag to the provided command
 func (c ChainCmd) attachHome(command []string) []string {
 	if c.homeDir != "" {
@@ -0,0 +1,173 @@
+package chaincmdrunner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/step"
+)
+
+const (
+	// multiSendGasBase and multiSendGasPerOutput estimate the gas limit of a
+	// multi-send tx from the number of its outputs.
+	multiSendGasBase      = 100000
+	multiSendGasPerOutput = 30000
+
+	msgMultiSendTypeURL = "/cosmos.bank.v1beta1.MsgMultiSend"
+)
+
+// BankOutput is an output of a multi-send, the coins sent to an address.
+type BankOutput struct {
+	Address string
+	Coins   sdk.Coins
+}
+
+// BankMultiSend sends the coins of the outputs from an account in a single
+// multi-send tx and returns the hash of the tx.
+func (r Runner) BankMultiSend(ctx context.Context, from Account, outputs []BankOutput) (string, error) {
+	if len(outputs) == 0 {
+		return "", errors.New("no outputs to send")
+	}
+
+	dir, err := os.MkdirTemp("", "multisend")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(dir)
+
+	gasLimit := multiSendGasBase + multiSendGasPerOutput*len(outputs)
+	if adjustment := r.chainCmd.GasAdjustment(); adjustment > 0 {
+		gasLimit = int(math.Ceil(float64(gasLimit) * adjustment))
+	}
+
+	fees, err := r.txFees(gasLimit)
+	if err != nil {
+		return "", err
+	}
+
+	unsigned, err := multiSendTx(from.Address, outputs, gasLimit, fees)
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		unsignedPath = filepath.Join(dir, "unsigned.json")
+		signedPath   = filepath.Join(dir, "signed.json")
+	)
+	if err := os.WriteFile(unsignedPath, unsigned, 0o644); err != nil {
+		return "", err
+	}
+
+	// sign the tx with the keyring of the account.
+	signed := newBuffer()
+	opt := []step.Option{r.chainCmd.SignTxCommand(from.Name, unsignedPath)}
+	if r.chainCmd.KeyringPassword() != "" {
+		input := &bytes.Buffer{}
+		fmt.Fprintln(input, r.chainCmd.KeyringPassword())
+		opt = append(opt, step.Write(input.Bytes()))
+	}
+	if err := r.run(ctx, runOptions{stdout: signed}, opt...); err != nil {
+		return "", fmt.Errorf("cannot sign the multi-send tx: %w", err)
+	}
+	if err := os.WriteFile(signedPath, signed.Bytes(), 0o644); err != nil {
+		return "", err
+	}
+
+	b := newBuffer()
+	if err := r.run(ctx, runOptions{stdout: b}, r.chainCmd.BroadcastTxCommand(signedPath)); err != nil {
+		return "", err
+	}
+
+	txResult, err := decodeTxResult(b)
+	if err != nil {
+		return "", err
+	}
+	if txResult.Code > 0 {
+		return "", fmt.Errorf("cannot send tokens (SDK code %d): %s", txResult.Code, txResult.RawLog)
+	}
+
+	return txResult.TxHash, nil
+}
+
+// txFees returns the fees of a tx with the gas limit, from the fees or the gas
+// prices of the chain commands.
+func (r Runner) txFees(gasLimit int) (sdk.Coins, error) {
+	if fees := r.chainCmd.Fees(); fees != "" {
+		return sdk.ParseCoinsNormalized(fees)
+	}
+
+	gasPrices := r.chainCmd.GasPrices()
+	if gasPrices == "" {
+		return sdk.NewCoins(), nil
+	}
+
+	prices, err := sdk.ParseDecCoins(gasPrices)
+	if err != nil {
+		return nil, err
+	}
+
+	fees := sdk.NewCoins()
+	limit := sdk.NewDec(int64(gasLimit))
+	for _, p := range prices {
+		fees = fees.Add(sdk.NewCoin(p.Denom, p.Amount.Mul(limit).Ceil().RoundInt()))
+	}
+	return fees, nil
+}
+
+// multiSendTx returns the JSON encoded unsigned tx that sends the coins of
+// the outputs from the address.
+func multiSendTx(fromAddress string, outputs []BankOutput, gasLimit int, fees sdk.Coins) ([]byte, error) {
+	type balance struct {
+		Address string    `json:"address"`
+		Coins   sdk.Coins `json:"coins"`
+	}
+
+	var (
+		total = sdk.NewCoins()
+		outs  = make([]balance, 0, len(outputs))
+	)
+	for _, o := range outputs {
+		if !o.Coins.IsValid() || o.Coins.IsZero() {
+			return nil, fmt.Errorf("invalid coins %q sent to %s", o.Coins, o.Address)
+		}
+		total = total.Add(o.Coins...)
+		outs = append(outs, balance{Address: o.Address, Coins: o.Coins})
+	}
+
+	tx := map[string]interface{}{
+		"body": map[string]interface{}{
+			"messages": []interface{}{
+				map[string]interface{}{
+					"@type":   msgMultiSendTypeURL,
+					"inputs":  []balance{{Address: fromAddress, Coins: total}},
+					"outputs": outs,
+				},
+			},
+			"memo":                           "",
+			"timeout_height":                 "0",
+			"extension_options":              []interface{}{},
+			"non_critical_extension_options": []interface{}{},
+		},
+		"auth_info": map[string]interface{}{
+			"signer_infos": []interface{}{},
+			"fee": map[string]interface{}{
+				"amount":    fees,
+				"gas_limit": strconv.Itoa(gasLimit),
+				"payer":     "",
+				"granter":   "",
+			},
+		},
+		"signatures": []interface{}{},
+	}
+
+	return json.Marshal(tx)
+}
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/cenkalti/backoff"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pkg/errors"
 
 	"github.com/ignite/cli/ignite/pkg/chaincmd"
@@ -99,6 +100,11 @@ func (r Runner) ValidateGenesis(ctx context.Context) error {
 	return r.run(ctx, runOptions{}, r.chainCmd.ValidateGenesisCommand())
 }
 
+// ValidateGenesisFile validates the genesis file at path.
+func (r Runner) ValidateGenesisFile(ctx context.Context, path string) error {
+	return r.run(ctx, runOptions{}, r.chainCmd.ValidateGenesisFileCommand(path))
+}
+
 // UnsafeReset resets the blockchain database.
 func (r Runner) UnsafeReset(ctx context.Context) error {
 	return r.run(ctx, runOptions{}, r.chainCmd.UnsafeResetCommand())
@@ -165,6 +171,28 @@ func (r Runner) Status(ctx context.Context) (NodeStatus, error) {
 	}, nil
 }
 
+// BankBalances returns the bank balances of an address.
+func (r Runner) BankBalances(ctx context.Context, address string) (sdk.Coins, error) {
+	b := newBuffer()
+	if err := r.run(ctx, runOptions{stdout: b}, r.chainCmd.QueryBankBalancesCommand(address)); err != nil {
+		return nil, err
+	}
+
+	data, err := b.JSONEnsuredBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	var out struct {
+		Balances sdk.Coins `json:"balances"`
+	}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+
+	return out.Balances, nil
+}
+
 // BankSend sends amount from fromAccount to toAccount.
 func (r Runner) BankSend(ctx context.Context, fromAccount, toAccount, amount string) (string, error) {
 	b := newBuffer()
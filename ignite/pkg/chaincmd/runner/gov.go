@@ -0,0 +1,95 @@
+package chaincmdrunner
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/step"
+)
+
+const (
+	// VoteYes is the yes option of a governance vote.
+	VoteYes = "yes"
+
+	// VoteNo is the no option of a governance vote.
+	VoteNo = "no"
+)
+
+// SubmitParamChangeProposal submits the param change proposal of the proposal file
+// from an account and returns the ID of the proposal.
+func (r Runner) SubmitParamChangeProposal(ctx context.Context, fromAccount, proposalFile string) (uint64, error) {
+	res, err := r.broadcastTx(ctx, r.chainCmd.SubmitParamChangeProposalCommand(fromAccount, proposalFile))
+	if err != nil {
+		return 0, fmt.Errorf("cannot submit the proposal: %w", err)
+	}
+
+	id, ok := res.attribute("submit_proposal", "proposal_id")
+	if !ok {
+		return 0, fmt.Errorf("proposal ID not found in the events of tx %s", res.TxHash)
+	}
+	return strconv.ParseUint(id, 10, 64)
+}
+
+// SubmitSoftwareUpgradeProposal submits the proposal of the software upgrade
+// with the name at the height from an account and returns the ID of the
+// proposal.
+func (r Runner) SubmitSoftwareUpgradeProposal(ctx context.Context, fromAccount, name string, height int64, deposit string) (uint64, error) {
+	res, err := r.broadcastTx(ctx, r.chainCmd.SubmitSoftwareUpgradeProposalCommand(fromAccount, name, height, deposit))
+	if err != nil {
+		return 0, fmt.Errorf("cannot submit the proposal: %w", err)
+	}
+
+	id, ok := res.attribute("submit_proposal", "proposal_id")
+	if !ok {
+		return 0, fmt.Errorf("proposal ID not found in the events of tx %s", res.TxHash)
+	}
+	return strconv.ParseUint(id, 10, 64)
+}
+
+// GovVote votes on a governance proposal from an account.
+func (r Runner) GovVote(ctx context.Context, fromAccount string, proposalID uint64, option string) error {
+	if _, err := r.broadcastTx(ctx, r.chainCmd.GovVoteCommand(fromAccount, proposalID, option)); err != nil {
+		return fmt.Errorf("cannot vote on proposal %d: %w", proposalID, err)
+	}
+	return nil
+}
+
+// broadcastTx runs the command that broadcasts a tx, waits until the tx is added
+// to a block and returns its result with the events emitted by the tx.
+func (r Runner) broadcastTx(ctx context.Context, command step.Option) (txResult, error) {
+	b := newBuffer()
+	opt := []step.Option{command}
+
+	if r.chainCmd.KeyringPassword() != "" {
+		input := &bytes.Buffer{}
+		fmt.Fprintln(input, r.chainCmd.KeyringPassword())
+		fmt.Fprintln(input, r.chainCmd.KeyringPassword())
+		fmt.Fprintln(input, r.chainCmd.KeyringPassword())
+		opt = append(opt, step.Write(input.Bytes()))
+	}
+
+	if err := r.run(ctx, runOptions{stdout: b}, opt...); err != nil {
+		return txResult{}, err
+	}
+
+	res, err := decodeTxResult(b)
+	if err != nil {
+		return txResult{}, err
+	}
+	if res.Code > 0 {
+		return txResult{}, fmt.Errorf("SDK code %d: %s", res.Code, res.RawLog)
+	}
+
+	if err := r.WaitTx(ctx, res.TxHash, time.Second, 30); err != nil {
+		return txResult{}, err
+	}
+
+	b = newBuffer()
+	if err := r.run(ctx, runOptions{stdout: b}, r.chainCmd.QueryTxCommand(res.TxHash)); err != nil {
+		return txResult{}, err
+	}
+	return decodeTxResult(b)
+}
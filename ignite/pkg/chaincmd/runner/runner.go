@@ -158,6 +158,33 @@ type txResult struct {
 	Code   int    `json:"code"`
 	RawLog string `json:"raw_log"`
 	TxHash string `json:"txhash"`
+	Logs   []struct {
+		Events []struct {
+			Type  string `json:"type"`
+			Attrs []struct {
+				Key   string `json:"key"`
+				Value string `json:"value"`
+			} `json:"attributes"`
+		} `json:"events"`
+	} `json:"logs"`
+}
+
+// attribute returns the value of the first attribute with the key of the events
+// of a type emitted by the tx.
+func (r txResult) attribute(eventType, key string) (string, bool) {
+	for _, log := range r.Logs {
+		for _, e := range log.Events {
+			if e.Type != eventType {
+				continue
+			}
+			for _, attr := range e.Attrs {
+				if attr.Key == key {
+					return attr.Value, true
+				}
+			}
+		}
+	}
+	return "", false
 }
 
 func decodeTxResult(b *buffer) (txResult, error) {
@@ -0,0 +1,208 @@
+// Package chainregistry writes the chain.json and assetlist.json files of a
+// chain in a clone of the Cosmos chain registry
+// (https://github.com/cosmos/chain-registry) and opens their pull request.
+package chainregistry
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+const (
+	// ChainFile is the name of the file of the chain in the registry.
+	ChainFile = "chain.json"
+
+	// AssetListFile is the name of the file of the assets of the chain.
+	AssetListFile = "assetlist.json"
+
+	// TestnetsDir is the directory of the testnets in the registry.
+	TestnetsDir = "testnets"
+
+	// NetworkTypeMainnet is the network type of the mainnets.
+	NetworkTypeMainnet = "mainnet"
+
+	// NetworkTypeTestnet is the network type of the testnets.
+	NetworkTypeTestnet = "testnet"
+
+	// NetworkTypeDevnet is the network type of the devnets.
+	NetworkTypeDevnet = "devnet"
+
+	// StatusLive is the status of a running chain.
+	StatusLive = "live"
+
+	// StatusUpcoming is the status of a chain that is not launched yet.
+	StatusUpcoming = "upcoming"
+
+	// TypeAssetSDKCoin is the type of the native coins of the chain.
+	TypeAssetSDKCoin = "sdk.coin"
+)
+
+var chainNameRe = regexp.MustCompile(`^[a-z0-9]+$`)
+
+// Chain is the chain.json file of a chain in the registry.
+type Chain struct {
+	Schema       string   `json:"$schema"`
+	ChainName    string   `json:"chain_name"`
+	Status       string   `json:"status"`
+	NetworkType  string   `json:"network_type"`
+	PrettyName   string   `json:"pretty_name,omitempty"`
+	ChainID      string   `json:"chain_id"`
+	Bech32Prefix string   `json:"bech32_prefix"`
+	DaemonName   string   `json:"daemon_name,omitempty"`
+	NodeHome     string   `json:"node_home,omitempty"`
+	KeyAlgos     []string `json:"key_algos,omitempty"`
+	Slip44       uint32   `json:"slip44"`
+	Fees         Fees     `json:"fees"`
+	Staking      Staking  `json:"staking"`
+	Codebase     Codebase `json:"codebase"`
+	APIs         APIs     `json:"apis"`
+}
+
+// Fees are the tokens accepted as fees by the chain.
+type Fees struct {
+	FeeTokens []FeeToken `json:"fee_tokens"`
+}
+
+// FeeToken is a token accepted as fees with its gas prices.
+type FeeToken struct {
+	Denom            string  `json:"denom"`
+	FixedMinGasPrice float64 `json:"fixed_min_gas_price"`
+	LowGasPrice      float64 `json:"low_gas_price,omitempty"`
+	AverageGasPrice  float64 `json:"average_gas_price,omitempty"`
+	HighGasPrice     float64 `json:"high_gas_price,omitempty"`
+}
+
+// Staking are the tokens staked on the chain.
+type Staking struct {
+	StakingTokens []StakingToken `json:"staking_tokens"`
+}
+
+// StakingToken is a token staked on the chain.
+type StakingToken struct {
+	Denom string `json:"denom"`
+}
+
+// Codebase is the source code of the binary of the chain.
+type Codebase struct {
+	GitRepo            string   `json:"git_repo,omitempty"`
+	RecommendedVersion string   `json:"recommended_version,omitempty"`
+	CompatibleVersions []string `json:"compatible_versions,omitempty"`
+	Genesis            *Genesis `json:"genesis,omitempty"`
+}
+
+// Genesis is the genesis of the chain.
+type Genesis struct {
+	GenesisURL string `json:"genesis_url"`
+}
+
+// APIs are the public endpoints of the chain.
+type APIs struct {
+	RPC  []Endpoint `json:"rpc,omitempty"`
+	REST []Endpoint `json:"rest,omitempty"`
+	GRPC []Endpoint `json:"grpc,omitempty"`
+}
+
+// Endpoint is a public endpoint of the chain.
+type Endpoint struct {
+	Address  string `json:"address"`
+	Provider string `json:"provider,omitempty"`
+}
+
+// AssetList is the assetlist.json file of a chain in the registry.
+type AssetList struct {
+	Schema    string  `json:"$schema"`
+	ChainName string  `json:"chain_name"`
+	Assets    []Asset `json:"assets"`
+}
+
+// Asset is a coin of the chain.
+type Asset struct {
+	Description string      `json:"description,omitempty"`
+	DenomUnits  []DenomUnit `json:"denom_units"`
+	Base        string      `json:"base"`
+	Name        string      `json:"name"`
+	Display     string      `json:"display"`
+	Symbol      string      `json:"symbol"`
+	TypeAsset   string      `json:"type_asset,omitempty"`
+}
+
+// DenomUnit is a unit of the denom of an asset.
+type DenomUnit struct {
+	Denom    string   `json:"denom"`
+	Exponent uint32   `json:"exponent"`
+	Aliases  []string `json:"aliases,omitempty"`
+}
+
+// Validate checks that the chain has the fields required by the registry.
+func (c Chain) Validate() error {
+	if !chainNameRe.MatchString(c.ChainName) {
+		return fmt.Errorf("invalid chain name %q, use lower case letters and digits", c.ChainName)
+	}
+	switch c.NetworkType {
+	case NetworkTypeMainnet, NetworkTypeTestnet, NetworkTypeDevnet:
+	default:
+		return fmt.Errorf("invalid network type %q, use %s, %s or %s", c.NetworkType, NetworkTypeMainnet, NetworkTypeTestnet, NetworkTypeDevnet)
+	}
+	if c.ChainID == "" {
+		return errors.New("the chain ID is required")
+	}
+	if c.Bech32Prefix == "" {
+		return errors.New("the bech32 prefix is required")
+	}
+	if len(c.Fees.FeeTokens) == 0 {
+		return errors.New("a fee token is required")
+	}
+	if len(c.Staking.StakingTokens) == 0 {
+		return errors.New("a staking token is required")
+	}
+	return nil
+}
+
+// Dir returns the directory of the chain in the registry at root, the
+// testnets and the devnets are in the testnets directory.
+func Dir(root string, c Chain) string {
+	if c.NetworkType == NetworkTypeMainnet {
+		return filepath.Join(root, c.ChainName)
+	}
+	return filepath.Join(root, TestnetsDir, c.ChainName)
+}
+
+// Write writes the chain and its assets in their directory of the registry at
+// root and returns the directory.
+func Write(root string, c Chain, a AssetList) (string, error) {
+	if err := c.Validate(); err != nil {
+		return "", err
+	}
+
+	dir := Dir(root, c)
+	rel, err := filepath.Rel(dir, root)
+	if err != nil {
+		return "", err
+	}
+	c.Schema = filepath.ToSlash(filepath.Join(rel, "chain.schema.json"))
+	a.Schema = filepath.ToSlash(filepath.Join(rel, "assetlist.schema.json"))
+	a.ChainName = c.ChainName
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	if err := writeJSON(filepath.Join(dir, ChainFile), c); err != nil {
+		return "", err
+	}
+	if err := writeJSON(filepath.Join(dir, AssetListFile), a); err != nil {
+		return "", err
+	}
+	return dir, nil
+}
+
+func writeJSON(path string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
@@ -0,0 +1,86 @@
+package chainregistry_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/chainregistry"
+)
+
+func newChain(networkType string) chainregistry.Chain {
+	return chainregistry.Chain{
+		ChainName:    "marstestnet",
+		Status:       chainregistry.StatusLive,
+		NetworkType:  networkType,
+		ChainID:      "mars-1",
+		Bech32Prefix: "mars",
+		Slip44:       118,
+		Fees:         chainregistry.Fees{FeeTokens: []chainregistry.FeeToken{{Denom: "umars"}}},
+		Staking:      chainregistry.Staking{StakingTokens: []chainregistry.StakingToken{{Denom: "umars"}}},
+	}
+}
+
+func TestWrite(t *testing.T) {
+	tests := []struct {
+		name        string
+		networkType string
+		dir         string
+		schema      string
+	}{
+		{
+			name:        "mainnet",
+			networkType: chainregistry.NetworkTypeMainnet,
+			dir:         "marstestnet",
+			schema:      "../chain.schema.json",
+		},
+		{
+			name:        "testnet",
+			networkType: chainregistry.NetworkTypeTestnet,
+			dir:         "testnets/marstestnet",
+			schema:      "../../chain.schema.json",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			assets := chainregistry.AssetList{Assets: []chainregistry.Asset{{Base: "umars"}}}
+
+			dir, err := chainregistry.Write(root, newChain(tt.networkType), assets)
+			require.NoError(t, err)
+			require.Equal(t, filepath.Join(root, tt.dir), dir)
+
+			var c chainregistry.Chain
+			data, err := os.ReadFile(filepath.Join(dir, chainregistry.ChainFile))
+			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal(data, &c))
+			require.Equal(t, tt.schema, c.Schema)
+			require.Equal(t, "mars-1", c.ChainID)
+
+			var a chainregistry.AssetList
+			data, err = os.ReadFile(filepath.Join(dir, chainregistry.AssetListFile))
+			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal(data, &a))
+			require.Equal(t, "marstestnet", a.ChainName)
+			require.Equal(t, "umars", a.Assets[0].Base)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	c := newChain(chainregistry.NetworkTypeTestnet)
+	require.NoError(t, c.Validate())
+
+	c.ChainName = "Mars-Testnet"
+	require.EqualError(t, c.Validate(), `invalid chain name "Mars-Testnet", use lower case letters and digits`)
+
+	c = newChain("localnet")
+	require.EqualError(t, c.Validate(), `invalid network type "localnet", use mainnet, testnet or devnet`)
+
+	c = newChain(chainregistry.NetworkTypeTestnet)
+	c.Fees.FeeTokens = nil
+	require.EqualError(t, c.Validate(), "a fee token is required")
+}
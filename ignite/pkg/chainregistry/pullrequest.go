@@ -0,0 +1,53 @@
+package chainregistry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/exec"
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/step"
+	"github.com/ignite/cli/ignite/pkg/xexec"
+)
+
+// branchPrefix is the prefix of the branches of the pull requests.
+const branchPrefix = "ignite/"
+
+// ErrGitHubCLINotInstalled is returned when the pull request can't be opened
+// because the GitHub CLI is not installed.
+var ErrGitHubCLINotInstalled = errors.New(`the "gh" command is not installed, see https://cli.github.com`)
+
+// OpenPullRequest commits the files of the chain written in the registry at
+// root in a new branch, pushes the branch to the origin remote and opens its
+// pull request with the GitHub CLI. The registry must be a clone of a fork of
+// the chain registry. It returns the name of the branch.
+func OpenPullRequest(ctx context.Context, root string, c Chain) (branch string, err error) {
+	if !xexec.IsCommandAvailable("gh") {
+		return "", ErrGitHubCLINotInstalled
+	}
+
+	dir, err := filepath.Rel(root, Dir(root, c))
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		title = fmt.Sprintf("Add %s", c.ChainName)
+		body  = fmt.Sprintf("Add the %s %s (chain ID %s), generated with `ignite chain registry publish`.", c.NetworkType, c.ChainName, c.ChainID)
+	)
+	branch = branchPrefix + c.ChainName
+	for _, args := range [][]string{
+		{"git", "checkout", "-b", branch},
+		{"git", "add", "--", dir},
+		{"git", "commit", "--message", title},
+		{"git", "push", "--set-upstream", "origin", branch},
+		{"gh", "pr", "create", "--head", branch, "--title", title, "--body", body},
+	} {
+		if err := exec.Exec(ctx, args, exec.StepOption(step.Workdir(root))); err != nil {
+			return "", err
+		}
+	}
+
+	return branch, nil
+}
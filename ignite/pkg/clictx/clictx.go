@@ -6,7 +6,12 @@ import (
 	"os/signal"
 )
 
+// exitCodeForced is the exit code used when a second exit signal is received.
+const exitCodeForced = 130
+
 // From creates a new context from ctx that is canceled when an exit signal received.
+// A second exit signal terminates the process immediately without waiting for
+// the running operations to stop.
 func From(ctx context.Context) context.Context {
 	var (
 		ctxend, cancel = context.WithCancel(ctx)
@@ -16,6 +21,9 @@ func From(ctx context.Context) context.Context {
 	go func() {
 		<-quit
 		cancel()
+
+		<-quit
+		os.Exit(exitCodeForced)
 	}()
 	return ctxend
 }
@@ -7,6 +7,7 @@ import (
 	"os"
 	"os/exec"
 	"strings"
+	"time"
 
 	"golang.org/x/sync/errgroup"
 
@@ -14,6 +15,10 @@ import (
 	"github.com/ignite/cli/ignite/pkg/goenv"
 )
 
+// endSignalTimeout is the time given to the processes to exit after the end
+// signal before they are killed.
+const endSignalTimeout = 10 * time.Second
+
 // Runner is an object to run commands
 type Runner struct {
 	endSignal   os.Signal
@@ -140,10 +145,27 @@ func (r *Runner) Run(ctx context.Context, steps ...*step.Step) error {
 			}
 			continue
 		}
+		done := make(chan struct{})
 		go func() {
-			<-ctx.Done()
+			select {
+			case <-ctx.Done():
+			case <-done:
+				return
+			}
 			command.Signal(r.endSignal)
+
+			// kill the process when it doesn't exit in time after the end signal
+			// so an interrupted command never keeps running in the background.
+			select {
+			case <-time.After(endSignalTimeout):
+				command.Signal(os.Kill)
+			case <-done:
+			}
 		}()
+		wait := func() error {
+			defer close(done)
+			return command.Wait()
+		}
 		if err := step.InExec(); err != nil {
 			return err
 		}
@@ -154,9 +176,9 @@ func (r *Runner) Run(ctx context.Context, steps ...*step.Step) error {
 		}
 		if r.runParallel {
 			g.Go(func() error {
-				return runPostExecs(command.Wait())
+				return runPostExecs(wait())
 			})
-		} else if err := runPostExecs(command.Wait()); err != nil {
+		} else if err := runPostExecs(wait()); err != nil {
 			return err
 		}
 	}
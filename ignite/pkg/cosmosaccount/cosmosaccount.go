@@ -12,12 +12,16 @@ import (
 	dkeyring "github.com/99designs/keyring"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/cosmos/go-bip39"
+
+	"github.com/ignite/cli/ignite/pkg/ethsecp256k1"
+	"github.com/ignite/cli/ignite/pkg/randstr"
 )
 
 const (
@@ -99,6 +103,7 @@ func New(options ...Option) (Registry, error) {
 	inBuf := bufio.NewReader(os.Stdin)
 	interfaceRegistry := types.NewInterfaceRegistry()
 	cryptocodec.RegisterInterfaces(interfaceRegistry)
+	ethsecp256k1.RegisterInterfaces(interfaceRegistry)
 	cdc := codec.NewProtoCodec(interfaceRegistry)
 	r.Keyring, err = keyring.New(r.keyringServiceName, string(r.keyringBackend), r.homePath, inBuf, cdc)
 	if err != nil {
@@ -148,6 +153,22 @@ func (a Account) Address(accPrefix string) (string, error) {
 	return toBech32(accPrefix, pk.Address())
 }
 
+// EthAddress returns the hex encoded Ethereum address of the account when it
+// has an eth_secp256k1 key, or an empty string otherwise.
+func (a Account) EthAddress() (string, error) {
+	pk, err := a.Record.GetPubKey()
+	if err != nil {
+		return "", err
+	}
+
+	ethPK, ok := pk.(*ethsecp256k1.PubKey)
+	if !ok {
+		return "", nil
+	}
+
+	return ethPK.EthAddress(), nil
+}
+
 // PubKey returns a public key for account.
 func (a Account) PubKey() (string, error) {
 	pk, err := a.Record.GetPubKey()
@@ -242,6 +263,35 @@ func (r Registry) Import(name, secret, passphrase string) (Account, error) {
 	return r.GetByName(name)
 }
 
+// ImportEthKey imports an account with name from the hex encoded private key
+// of an Ethereum account, the key is imported as an eth_secp256k1 key used by
+// the EVM-enabled chains.
+func (r Registry) ImportEthKey(name, key string) (Account, error) {
+	_, err := r.GetByName(name)
+	if err == nil {
+		return Account{}, ErrAccountExists
+	}
+	var accErr *AccountDoesNotExistError
+	if !errors.As(err, &accErr) {
+		return Account{}, err
+	}
+
+	privKey, err := ethsecp256k1.PrivKeyFromHex(key)
+	if err != nil {
+		return Account{}, err
+	}
+
+	// The keyring only imports armored keys, the passphrase only protects
+	// the key until it is imported
+	passphrase := randstr.Runes(16)
+	armor := crypto.EncryptArmorPrivKey(privKey, passphrase, ethsecp256k1.KeyType)
+	if err := r.Keyring.ImportPrivKey(name, armor, passphrase); err != nil {
+		return Account{}, err
+	}
+
+	return r.GetByName(name)
+}
+
 // Export exports an account as a private key.
 func (r Registry) Export(name, passphrase string) (key string, err error) {
 	if _, err = r.GetByName(name); err != nil {
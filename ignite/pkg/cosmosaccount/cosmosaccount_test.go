@@ -70,3 +70,27 @@ func TestRegistry(t *testing.T) {
 	_, err = registry.GetByAddress(addr)
 	require.ErrorAs(t, err, &expectedErr)
 }
+
+func TestRegistryImportEthKey(t *testing.T) {
+	registry, err := cosmosaccount.New(cosmosaccount.WithHome(t.TempDir()))
+	require.NoError(t, err)
+
+	// The key and the address of the web3.js documentation
+	account, err := registry.ImportEthKey(testAccountName, "0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+	require.NoError(t, err)
+
+	ethAddr, err := account.EthAddress()
+	require.NoError(t, err)
+	require.Equal(t, "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23", ethAddr)
+
+	addr, err := account.Address("evmos")
+	require.NoError(t, err)
+	require.Equal(t, "evmos1936ndcmqtkwpdfar67ccnrjjjwt2vhpr884frm", addr)
+
+	getAccount, err := registry.GetByName(testAccountName)
+	require.NoError(t, err)
+	require.Equal(t, account.Record.PubKey, getAccount.Record.PubKey)
+
+	_, err = registry.ImportEthKey(testAccountName, "0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+	require.ErrorIs(t, err, cosmosaccount.ErrAccountExists)
+}
@@ -17,6 +17,7 @@ import (
 const (
 	cosmosModulePath     = "github.com/cosmos/cosmos-sdk"
 	tendermintModulePath = "github.com/tendermint/tendermint"
+	cometbftModulePath   = "github.com/cometbft/cometbft"
 	appFileName          = "app.go"
 	defaultAppFilePath   = "app/" + appFileName
 )
@@ -163,6 +164,7 @@ func checkImplementation(r implementation) bool {
 }
 
 // ValidateGoMod check if the cosmos-sdk and the tendermint packages are imported.
+// Tendermint is replaced by CometBFT since Cosmos SDK v0.47.
 func ValidateGoMod(module *modfile.File) error {
 	moduleCheck := map[string]bool{
 		cosmosModulePath:     true,
@@ -170,6 +172,9 @@ func ValidateGoMod(module *modfile.File) error {
 	}
 	for _, r := range module.Require {
 		delete(moduleCheck, r.Mod.Path)
+		if r.Mod.Path == cometbftModulePath {
+			delete(moduleCheck, tendermintModulePath)
+		}
 	}
 	for m := range moduleCheck {
 		return fmt.Errorf("invalid go module, missing %s package dependency", m)
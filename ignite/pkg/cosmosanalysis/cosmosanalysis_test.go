@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"golang.org/x/mod/modfile"
 
 	"github.com/ignite/cli/ignite/pkg/cosmosanalysis"
 )
@@ -202,3 +203,44 @@ func TestFindAppFilePath(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, filepath.Join(appFolder, "app.go"), pathFound)
 }
+
+func TestValidateGoMod(t *testing.T) {
+	cases := []struct {
+		name    string
+		content string
+		err     bool
+	}{
+		{
+			name:    "with tendermint",
+			content: "module foo\n\nrequire (\n\tgithub.com/cosmos/cosmos-sdk v0.46.4\n\tgithub.com/tendermint/tendermint v0.34.21\n)\n",
+		},
+		{
+			name:    "with cometbft",
+			content: "module foo\n\nrequire (\n\tgithub.com/cometbft/cometbft v0.37.1\n\tgithub.com/cosmos/cosmos-sdk v0.47.2\n)\n",
+		},
+		{
+			name:    "without tendermint",
+			content: "module foo\n\nrequire github.com/cosmos/cosmos-sdk v0.46.4\n",
+			err:     true,
+		},
+		{
+			name:    "without cosmos sdk",
+			content: "module foo\n\nrequire github.com/cometbft/cometbft v0.37.1\n",
+			err:     true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			module, err := modfile.Parse("go.mod", []byte(tt.content), nil)
+			require.NoError(t, err)
+
+			err = cosmosanalysis.ValidateGoMod(module)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package module
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+)
+
+// aminoRegistrations are the funcs that register the legacy Amino name of a
+// message, with the position of the message and of the name in their arguments.
+var aminoRegistrations = map[string][2]int{
+	"RegisterConcrete": {0, 1},
+	"RegisterAminoMsg": {1, 2},
+}
+
+// findAminoNames returns the legacy Amino names of the messages registered in
+// the Go package at pkgPath, mapped by message name.
+func findAminoNames(pkgPath string) (map[string]string, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, pkgPath, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]string)
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Files {
+			ast.Inspect(f, func(n ast.Node) bool {
+				call, ok := n.(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+
+				var funcName string
+				switch fun := call.Fun.(type) {
+				case *ast.SelectorExpr:
+					funcName = fun.Sel.Name
+				case *ast.Ident:
+					funcName = fun.Name
+				}
+
+				args, ok := aminoRegistrations[funcName]
+				if !ok || len(call.Args) <= args[1] {
+					return true
+				}
+
+				msgName := compositeLitName(call.Args[args[0]])
+				lit, ok := call.Args[args[1]].(*ast.BasicLit)
+				if msgName == "" || !ok || lit.Kind != token.STRING {
+					return true
+				}
+
+				if name, err := strconv.Unquote(lit.Value); err == nil {
+					names[msgName] = name
+				}
+
+				return true
+			})
+		}
+	}
+
+	return names, nil
+}
+
+// compositeLitName returns the type name of a message literal like &MsgFoo{}.
+func compositeLitName(expr ast.Expr) string {
+	if unary, ok := expr.(*ast.UnaryExpr); ok {
+		expr = unary.X
+	}
+
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return ""
+	}
+
+	switch t := lit.Type.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.SelectorExpr:
+		return t.Sel.Name
+	}
+
+	return ""
+}
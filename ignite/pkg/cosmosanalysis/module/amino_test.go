@@ -0,0 +1,34 @@
+package module
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindAminoNames(t *testing.T) {
+	dir := t.TempDir()
+	codec := `package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+)
+
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgCreatePost{}, "blog/CreatePost", nil)
+	legacy.RegisterAminoMsg(cdc, &MsgSend{}, "cosmos-sdk/MsgSend")
+	cdc.RegisterInterface((*Content)(nil), nil)
+}
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "codec.go"), []byte(codec), 0o644))
+
+	names, err := findAminoNames(dir)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"MsgCreatePost": "blog/CreatePost",
+		"MsgSend":       "cosmos-sdk/MsgSend",
+	}, names)
+}
@@ -49,6 +49,13 @@ type Msg struct {
 
 	// FilePath is the path of the .proto file where message is defined at.
 	FilePath string
+
+	// AminoName is the legacy Amino name the message is registered with.
+	// It is empty when the message is not registered with Amino.
+	AminoName string
+
+	// Fields contains the names and the types of the message fields.
+	Fields map[string]string
 }
 
 // HTTPQuery is an sdk Query.
@@ -228,6 +235,11 @@ func (d *moduleDiscoverer) discover(pkg protoanalysis.Package) (Module, error) {
 		return Module{}, nil
 	}
 
+	aminoNames, err := findAminoNames(pkgPath)
+	if err != nil {
+		return Module{}, err
+	}
+
 	namesplit := strings.Split(pkg.Name, ".")
 	m := Module{
 		Name:         namesplit[len(namesplit)-1],
@@ -246,9 +258,11 @@ func (d *moduleDiscoverer) discover(pkg protoanalysis.Package) (Module, error) {
 		}
 
 		m.Msgs = append(m.Msgs, Msg{
-			Name:     msg,
-			URI:      fmt.Sprintf("%s.%s", pkg.Name, msg),
-			FilePath: pkgmsg.Path,
+			Name:      msg,
+			URI:       fmt.Sprintf("%s.%s", pkg.Name, msg),
+			FilePath:  pkgmsg.Path,
+			AminoName: aminoNames[msg],
+			Fields:    pkgmsg.Fields,
 		})
 	}
 
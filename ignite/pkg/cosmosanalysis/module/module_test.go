@@ -67,6 +67,7 @@ func newModule(relChainPath, goImportPath string) module.Module {
 							ReturnsType: "QueryMyQueryResponse",
 							HTTPRules: []protoanalysis.HTTPRule{
 								{
+									Endpoint: "/tendermint/mars/withoutmsg/my_query/{mytypefield}",
 									Params:   []string{"mytypefield"},
 									HasQuery: true,
 									HasBody:  false,
@@ -80,6 +81,7 @@ func newModule(relChainPath, goImportPath string) module.Module {
 							ReturnsType: "QueryFooResponse",
 							HTTPRules: []protoanalysis.HTTPRule{
 								{
+									Endpoint: "/tendermint/mars/withoutmsg/foo/",
 									HasQuery: false,
 									HasBody:  false,
 								},
@@ -96,6 +98,7 @@ func newModule(relChainPath, goImportPath string) module.Module {
 				FullName: "QueryMyQuery",
 				Rules: []protoanalysis.HTTPRule{
 					{
+						Endpoint: "/tendermint/mars/withoutmsg/my_query/{mytypefield}",
 						Params:   []string{"mytypefield"},
 						HasQuery: true,
 						HasBody:  false,
@@ -108,6 +111,7 @@ func newModule(relChainPath, goImportPath string) module.Module {
 				FullName: "QueryFoo",
 				Rules: []protoanalysis.HTTPRule{
 					{
+						Endpoint: "/tendermint/mars/withoutmsg/foo/",
 						HasQuery: false,
 						HasBody:  false,
 					},
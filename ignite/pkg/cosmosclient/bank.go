@@ -18,13 +18,30 @@ func (c Client) BankBalances(ctx context.Context, address string, pagination *qu
 		Pagination: pagination,
 	}
 
-	resp, err := c.bankQueryClient.AllBalances(ctx, req)
+	resp, err := c.bankQueryClient.AllBalances(c.QueryContext(ctx), req)
 	if err != nil {
 		return nil, rpcError(c.nodeAddress, err)
 	}
 	return resp.Balances, nil
 }
 
+// AllBankBalances returns all the balances of the address, the pages of the
+// balances are fetched until the last one.
+func (c Client) AllBankBalances(ctx context.Context, address string) (sdk.Coins, error) {
+	defer c.lockBech32Prefix()()
+
+	return Paginate(ctx, func(ctx context.Context, page *query.PageRequest) ([]sdk.Coin, *query.PageResponse, error) {
+		resp, err := c.bankQueryClient.AllBalances(c.QueryContext(ctx), &banktypes.QueryAllBalancesRequest{
+			Address:    address,
+			Pagination: page,
+		})
+		if err != nil {
+			return nil, nil, rpcError(c.nodeAddress, err)
+		}
+		return resp.Balances, resp.Pagination, nil
+	})
+}
+
 func (c Client) BankSendTx(ctx context.Context, fromAccount cosmosaccount.Account, toAddress string, amount sdk.Coins) (TxService, error) {
 	addr, err := fromAccount.Address(c.addressPrefix)
 	if err != nil {
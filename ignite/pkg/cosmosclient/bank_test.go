@@ -6,10 +6,13 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
 )
 
 func TestClientBankBalances(t *testing.T) {
@@ -39,3 +42,60 @@ func TestClientBankBalances(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, expectedBalances, balances)
 }
+
+func TestClientAllBankBalances(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		address = "address"
+	)
+	c := newClient(t, func(s suite) {
+		s.bankQueryClient.EXPECT().AllBalances(ctx, &banktypes.QueryAllBalancesRequest{
+			Address:    address,
+			Pagination: &query.PageRequest{Limit: query.DefaultLimit},
+		}).Return(&banktypes.QueryAllBalancesResponse{
+			Balances:   sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(2000))),
+			Pagination: &query.PageResponse{NextKey: []byte("next")},
+		}, nil)
+		s.bankQueryClient.EXPECT().AllBalances(ctx, &banktypes.QueryAllBalancesRequest{
+			Address:    address,
+			Pagination: &query.PageRequest{Key: []byte("next"), Limit: query.DefaultLimit},
+		}).Return(&banktypes.QueryAllBalancesResponse{
+			Balances:   sdk.NewCoins(sdk.NewCoin("token", math.NewInt(1000))),
+			Pagination: &query.PageResponse{},
+		}, nil)
+	})
+
+	balances, err := c.AllBankBalances(ctx, address)
+
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewCoins(
+		sdk.NewCoin("stake", math.NewInt(2000)),
+		sdk.NewCoin("token", math.NewInt(1000)),
+	), balances)
+}
+
+func TestClientBankBalancesWithHeight(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		address = "address"
+	)
+	c := newClient(t, func(s suite) {
+		// the height is sent in the metadata of the query.
+		atHeight := mock.MatchedBy(func(ctx context.Context) bool {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			heights := md.Get(grpctypes.GRPCBlockHeightHeader)
+			return len(heights) == 1 && heights[0] == "42"
+		})
+		s.bankQueryClient.EXPECT().AllBalances(atHeight, &banktypes.QueryAllBalancesRequest{
+			Address: address,
+		}).Return(&banktypes.QueryAllBalancesResponse{
+			Balances: sdk.NewCoins(sdk.NewCoin("token", math.NewInt(1000))),
+		}, nil)
+	})
+
+	balances, err := c.WithHeight(42).BankBalances(ctx, address, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewCoins(sdk.NewCoin("token", math.NewInt(1000))), balances)
+	assert.EqualValues(t, 42, c.WithHeight(42).Context().Height)
+}
@@ -0,0 +1,70 @@
+package cosmosclient
+
+import (
+	"context"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+)
+
+// BroadcastTxBatch broadcasts the msgs in txs of at most the batch size of the
+// client, see WithBatchSize, and returns the responses of the txs in order.
+// The txs are broadcasted one after the other with the sequences of the account
+// tracked locally, then the responses are returned once all the txs are
+// included in a block. When the gas is simulated, each tx is broadcasted once
+// the previous one is included in a block instead.
+//
+// The responses of the txs broadcasted before an error are returned with the
+// error.
+func (c Client) BroadcastTxBatch(ctx context.Context, account cosmosaccount.Account, msgs ...sdktypes.Msg) ([]Response, error) {
+	type broadcastedTx struct {
+		txService TxService
+		resp      *sdktypes.TxResponse
+	}
+
+	var (
+		p         = c.NewTxPipeline(account)
+		responses []Response
+		pending   []broadcastedTx
+		err       error
+	)
+	for start := 0; start < len(msgs); start += c.batchSize {
+		end := start + c.batchSize
+		if end > len(msgs) {
+			end = len(msgs)
+		}
+
+		var tx broadcastedTx
+		if tx.txService, tx.resp, err = p.broadcast(ctx, c, msgs[start:end]); err != nil {
+			break
+		}
+
+		if !c.simulatesGas() {
+			pending = append(pending, tx)
+			continue
+		}
+
+		// the gas of the next tx is simulated once this tx is included.
+		res, err := tx.txService.response(ctx, tx.resp)
+		if err != nil {
+			return responses, err
+		}
+		responses = append(responses, res)
+	}
+
+	for _, tx := range pending {
+		res, err := tx.txService.response(ctx, tx.resp)
+		if err != nil {
+			return responses, err
+		}
+		responses = append(responses, res)
+	}
+
+	return responses, err
+}
+
+// simulatesGas returns true when the gas of the txs is simulated.
+func (c Client) simulatesGas() bool {
+	return c.gas == "" || c.gas == "auto"
+}
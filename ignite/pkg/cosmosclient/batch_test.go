@@ -0,0 +1,103 @@
+package cosmosclient_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+)
+
+func TestClientBroadcastTxBatch(t *testing.T) {
+	const (
+		accountName = "bob"
+		passphrase  = "passphrase"
+	)
+	r, err := cosmosaccount.NewInMemory()
+	require.NoError(t, err)
+	a, _, err := r.Create(accountName)
+	require.NoError(t, err)
+	key, err := r.Export(accountName, passphrase)
+	require.NoError(t, err)
+	sdkaddress, err := a.Record.GetAddress()
+	require.NoError(t, err)
+
+	var msgs []sdktypes.Msg
+	for i := 0; i < 5; i++ {
+		msgs = append(msgs, &banktypes.MsgSend{
+			FromAddress: sdkaddress.String(),
+			ToAddress:   "cosmos1k8e50d2d8xkdfw9c4et3m45llh69e7xzw6uzga",
+			Amount:      sdktypes.NewCoins(sdktypes.NewInt64Coin("token", 1)),
+		})
+	}
+
+	tests := []struct {
+		name string
+		opts []cosmosclient.Option
+	}{
+		{
+			name: "ok: fixed gas",
+		},
+		{
+			name: "ok: simulated gas",
+			opts: []cosmosclient.Option{cosmosclient.WithGas("auto")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				sequences []uint64
+				msgCounts []int
+			)
+			opts := append([]cosmosclient.Option{cosmosclient.WithBatchSize(2)}, tt.opts...)
+			c := newClient(t, func(s suite) {
+				s.accountRetriever.EXPECT().
+					EnsureExists(mock.Anything, sdkaddress).
+					Return(nil)
+				s.accountRetriever.EXPECT().
+					GetAccountNumberSequence(mock.Anything, sdkaddress).
+					Return(1, 2, nil).
+					Once()
+				// the batches have 2 msgs except the last one.
+				s.gasometer.EXPECT().
+					CalculateGas(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, 42, nil).
+					Maybe()
+				s.gasometer.EXPECT().
+					CalculateGas(mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, 42, nil).
+					Maybe()
+				s.signer.EXPECT().
+					Sign(mock.Anything, accountName, mock.Anything, true).
+					Run(func(txf tx.Factory, _ string, txBuilder client.TxBuilder, _ bool) {
+						sequences = append(sequences, txf.Sequence())
+						msgCounts = append(msgCounts, len(txBuilder.GetTx().GetMsgs()))
+					}).
+					Return(nil)
+				s.rpcClient.EXPECT().
+					BroadcastTxSync(mock.Anything, mock.Anything).
+					Return(&ctypes.ResultBroadcastTx{Hash: []byte{1}}, nil)
+				s.rpcClient.EXPECT().
+					Tx(mock.Anything, []byte{1}, false).
+					Return(&ctypes.ResultTx{Hash: []byte{1}}, nil)
+			}, opts...)
+			account, err := c.AccountRegistry.Import(accountName, key, passphrase)
+			require.NoError(t, err)
+
+			responses, err := c.BroadcastTxBatch(context.Background(), account, msgs...)
+
+			require.NoError(t, err)
+			require.Len(t, responses, 3)
+			require.Equal(t, []uint64{2, 3, 4}, sequences)
+			require.Equal(t, []int{2, 2, 1}, msgCounts)
+		})
+	}
+}
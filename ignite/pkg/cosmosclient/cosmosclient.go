@@ -3,6 +3,7 @@ package cosmosclient
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/hex"
 	"fmt"
 	"io"
@@ -32,9 +33,10 @@ import (
 	"github.com/gogo/protobuf/proto"
 	prototypes "github.com/gogo/protobuf/types"
 	"github.com/pkg/errors"
+	"github.com/tendermint/tendermint/light"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
-	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"google.golang.org/grpc"
 
 	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
 	"github.com/ignite/cli/ignite/pkg/cosmosfaucet"
@@ -62,6 +64,8 @@ const (
 
 	defaultTXsPerPage = 30
 
+	defaultBatchSize = 10
+
 	searchHeight = "tx.height"
 
 	orderAsc = "asc"
@@ -114,6 +118,17 @@ type Client struct {
 	out         io.Writer
 	chainID     string
 
+	grpcAddress     string
+	grpcTLSConfig   *tls.Config
+	grpcDialOptions []grpc.DialOption
+	grpcConn        *grpc.ClientConn
+	headers         map[string]string
+
+	height         int64
+	lightTrust     *light.TrustOptions
+	lightWitnesses []string
+	lightClient    *light.Client
+
 	useFaucet       bool
 	faucetAddress   string
 	faucetDenom     string
@@ -124,10 +139,17 @@ type Client struct {
 	keyringBackend     cosmosaccount.KeyringBackend
 	keyringDir         string
 
-	gas          string
-	gasPrices    string
-	fees         string
-	generateOnly bool
+	gas           string
+	gasAdjustment float64
+	gasPrices     string
+	feeDenom      string
+	fees          string
+	feeGranter    string
+	feePayer      string
+	generateOnly  bool
+
+	broadcastMode string
+	batchSize     int
 }
 
 // Option configures your client.
@@ -172,6 +194,53 @@ func WithNodeAddress(addr string) Option {
 	}
 }
 
+// WithGRPCAddress sets the address of the gRPC server of the node, e.g.
+// localhost:9090. When it is set, the queries of the client are sent to the
+// gRPC server instead of the ABCI queries of the Tendermint RPC, the txs are
+// still broadcasted through the Tendermint RPC.
+func WithGRPCAddress(addr string) Option {
+	return func(c *Client) {
+		c.grpcAddress = addr
+	}
+}
+
+// WithGRPCTLS enables TLS for the connection to the gRPC server with the
+// config, the connection is insecure by default.
+func WithGRPCTLS(config *tls.Config) Option {
+	return func(c *Client) {
+		c.grpcTLSConfig = config
+	}
+}
+
+// WithGRPCDialOptions adds dial options to the connection to the gRPC server.
+func WithGRPCDialOptions(opts ...grpc.DialOption) Option {
+	return func(c *Client) {
+		c.grpcDialOptions = append(c.grpcDialOptions, opts...)
+	}
+}
+
+// WithHeaders sets headers sent with each request to the node, like the API
+// key of a hosted node. They are sent as metadata of the gRPC requests and as
+// HTTP headers of the Tendermint RPC requests, unless the RPC client is set
+// with WithRPCClient.
+func WithHeaders(headers map[string]string) Option {
+	return func(c *Client) {
+		c.headers = headers
+	}
+}
+
+// WithLightClient verifies the headers of the node with a light client from
+// the trusted header of the options, for the verification of the proofs of
+// the queries by VerifiedQueryStore. The witnesses are the RPC addresses of
+// other nodes of the chain that cross-check the headers of the node, at least
+// one witness other than the node is required.
+func WithLightClient(trust light.TrustOptions, witnesses ...string) Option {
+	return func(c *Client) {
+		c.lightTrust = &trust
+		c.lightWitnesses = witnesses
+	}
+}
+
 func WithAddressPrefix(prefix string) Option {
 	return func(c *Client) {
 		c.addressPrefix = prefix
@@ -199,13 +268,30 @@ func WithGas(gas string) Option {
 	}
 }
 
+// WithGasAdjustment sets the factor applied to the simulated gas of the txs
+// when the gas is calculated automatically, 1 by default.
+func WithGasAdjustment(adjustment float64) Option {
+	return func(c *Client) {
+		c.gasAdjustment = adjustment
+	}
+}
+
 // WithGasPrices sets the price per gas (e.g. 0.1uatom)
+// Set to "auto" to use the gas price of the node, see GasPrice.
 func WithGasPrices(gasPrices string) Option {
 	return func(c *Client) {
 		c.gasPrices = gasPrices
 	}
 }
 
+// WithFeeDenom sets the denom of the fees when the gas price is discovered from
+// the node, see GasPrice.
+func WithFeeDenom(denom string) Option {
+	return func(c *Client) {
+		c.feeDenom = denom
+	}
+}
+
 // WithFees sets the fees (e.g. 10uatom)
 func WithFees(fees string) Option {
 	return func(c *Client) {
@@ -213,6 +299,40 @@ func WithFees(fees string) Option {
 	}
 }
 
+// WithFeeGranter sets the address of the account that grants the fees of the
+// txs, it must have granted a fee allowance to the account of the txs.
+func WithFeeGranter(address string) Option {
+	return func(c *Client) {
+		c.feeGranter = address
+	}
+}
+
+// WithFeePayer sets the address of the account that pays the fees of the txs
+// instead of the first signer, it must be a signer of the txs.
+func WithFeePayer(address string) Option {
+	return func(c *Client) {
+		c.feePayer = address
+	}
+}
+
+// WithBroadcastMode sets the broadcast mode of the txs: "sync" (the default)
+// returns once the tx is included in a block by polling its result, "async"
+// returns once the tx is sent to the node without checking it and "block"
+// relies on the node to wait for the inclusion of the tx. The "block" mode is
+// deprecated by the Cosmos SDK.
+func WithBroadcastMode(mode string) Option {
+	return func(c *Client) {
+		c.broadcastMode = mode
+	}
+}
+
+// WithBatchSize sets the maximum number of msgs per tx of BroadcastTxBatch.
+func WithBatchSize(size int) Option {
+	return func(c *Client) {
+		c.batchSize = size
+	}
+}
+
 // WithGenerateOnly tells if txs will be generated only.
 func WithGenerateOnly(generateOnly bool) Option {
 	return func(c *Client) {
@@ -260,6 +380,14 @@ func WithGasometer(gasometer Gasometer) Option {
 	}
 }
 
+// WithAccountRegistry sets the registry of the accounts that sign the
+// transactions, the keyring options are ignored when it's set.
+func WithAccountRegistry(ar cosmosaccount.Registry) Option {
+	return func(c *Client) {
+		c.AccountRegistry = ar
+	}
+}
+
 // WithSigner sets the signer.
 // Already set by default.
 func WithSigner(signer Signer) Option {
@@ -279,6 +407,8 @@ func New(ctx context.Context, options ...Option) (Client, error) {
 		faucetMinAmount: defaultFaucetMinAmount,
 		out:             io.Discard,
 		gas:             strconv.Itoa(defaultGasLimit),
+		broadcastMode:   flags.BroadcastSync,
+		batchSize:       defaultBatchSize,
 	}
 
 	var err error
@@ -287,8 +417,17 @@ func New(ctx context.Context, options ...Option) (Client, error) {
 		apply(&c)
 	}
 
+	switch c.broadcastMode {
+	case flags.BroadcastSync, flags.BroadcastAsync, flags.BroadcastBlock:
+	default:
+		return Client{}, errors.Errorf("invalid broadcast mode %q", c.broadcastMode)
+	}
+	if c.batchSize <= 0 {
+		return Client{}, errors.Errorf("invalid batch size %d", c.batchSize)
+	}
+
 	if c.RPC == nil {
-		if c.RPC, err = rpchttp.New(c.nodeAddress, "/websocket"); err != nil {
+		if c.RPC, err = newRPCClient(c.nodeAddress, c.headers); err != nil {
 			return Client{}, err
 		}
 	}
@@ -305,6 +444,12 @@ func New(ctx context.Context, options ...Option) (Client, error) {
 
 	c.chainID = statusResp.NodeInfo.Network
 
+	if c.lightTrust != nil {
+		if c.lightClient, err = c.newLightClient(ctx); err != nil {
+			return Client{}, err
+		}
+	}
+
 	if c.homePath == "" {
 		home, err := os.UserHomeDir()
 		if err != nil {
@@ -317,17 +462,28 @@ func New(ctx context.Context, options ...Option) (Client, error) {
 		c.keyringDir = c.homePath
 	}
 
-	c.AccountRegistry, err = cosmosaccount.New(
-		cosmosaccount.WithKeyringServiceName(c.keyringServiceName),
-		cosmosaccount.WithKeyringBackend(c.keyringBackend),
-		cosmosaccount.WithHome(c.keyringDir),
-	)
-	if err != nil {
-		return Client{}, err
+	if c.AccountRegistry.Keyring == nil {
+		c.AccountRegistry, err = cosmosaccount.New(
+			cosmosaccount.WithKeyringServiceName(c.keyringServiceName),
+			cosmosaccount.WithKeyringBackend(c.keyringBackend),
+			cosmosaccount.WithHome(c.keyringDir),
+		)
+		if err != nil {
+			return Client{}, err
+		}
 	}
 
 	c.context = c.newContext()
+	if c.grpcAddress != "" {
+		if c.grpcConn, err = c.dialGRPC(ctx); err != nil {
+			return Client{}, err
+		}
+		c.context = c.context.WithGRPCClient(c.grpcConn)
+	}
 	c.TxFactory = newFactory(c.context)
+	if c.gasAdjustment != 0 {
+		c.TxFactory = c.TxFactory.WithGasAdjustment(c.gasAdjustment)
+	}
 
 	if c.accountRetriever == nil {
 		c.accountRetriever = authtypes.AccountRetriever{}
@@ -554,13 +710,21 @@ func (c Client) CreateTx(goCtx context.Context, account cosmosaccount.Account, m
 		WithFromName(account.Name).
 		WithFromAddress(sdkaddr)
 
+	if c.feeGranter != "" {
+		granter, err := sdktypes.AccAddressFromBech32(c.feeGranter)
+		if err != nil {
+			return TxService{}, errors.Wrap(err, "invalid fee granter")
+		}
+		ctx = ctx.WithFeeGranterAddress(granter)
+	}
+
 	txf, err := c.prepareFactory(ctx)
 	if err != nil {
 		return TxService{}, err
 	}
 
 	var gas uint64
-	if c.gas != "" && c.gas != "auto" {
+	if !c.simulatesGas() {
 		gas, err = strconv.ParseUint(c.gas, 10, 64)
 		if err != nil {
 			return TxService{}, errors.WithStack(err)
@@ -577,7 +741,15 @@ func (c Client) CreateTx(goCtx context.Context, account cosmosaccount.Account, m
 	txf = txf.WithGas(gas)
 	txf = txf.WithFees(c.fees)
 
-	if c.gasPrices != "" {
+	switch c.gasPrices {
+	case "":
+	case GasPricesAuto:
+		gasPrice, err := c.GasPrice(goCtx)
+		if err != nil {
+			return TxService{}, err
+		}
+		txf = txf.WithGasPrices(gasPrice.String())
+	default:
 		txf = txf.WithGasPrices(c.gasPrices)
 	}
 
@@ -587,6 +759,13 @@ func (c Client) CreateTx(goCtx context.Context, account cosmosaccount.Account, m
 	}
 
 	txUnsigned.SetFeeGranter(ctx.GetFeeGranterAddress())
+	if c.feePayer != "" {
+		payer, err := sdktypes.AccAddressFromBech32(c.feePayer)
+		if err != nil {
+			return TxService{}, errors.Wrap(err, "invalid fee payer")
+		}
+		txUnsigned.SetFeePayer(payer)
+	}
 
 	return TxService{
 		client:        c,
@@ -792,7 +971,7 @@ func (c Client) newContext() client.Context {
 		WithInput(os.Stdin).
 		WithOutput(c.out).
 		WithAccountRetriever(c.accountRetriever).
-		WithBroadcastMode(flags.BroadcastSync).
+		WithBroadcastMode(c.broadcastMode).
 		WithHomeDir(c.homePath).
 		WithClient(c.RPC).
 		WithSkipConfirmation(true).
@@ -109,6 +109,20 @@ func TestNew(t *testing.T) {
 	assert.NotNil(txf.AccountRetriever())
 }
 
+func TestNewWithInvalidOptions(t *testing.T) {
+	_, err := cosmosclient.New(context.Background(),
+		cosmosclient.WithRPCClient(mocks.NewRPCClient(t)),
+		cosmosclient.WithBroadcastMode("fast"),
+	)
+	require.EqualError(t, err, `invalid broadcast mode "fast"`)
+
+	_, err = cosmosclient.New(context.Background(),
+		cosmosclient.WithRPCClient(mocks.NewRPCClient(t)),
+		cosmosclient.WithBatchSize(0),
+	)
+	require.EqualError(t, err, "invalid batch size 0")
+}
+
 func TestClientWaitForBlockHeight(t *testing.T) {
 	targetBlockHeight := int64(42)
 	tests := []struct {
@@ -562,6 +576,31 @@ func TestClientCreateTx(t *testing.T) {
 					Return(nil, 42, nil)
 			},
 		},
+		{
+			name: "ok: with fee granter and fee payer",
+			opts: []cosmosclient.Option{
+				cosmosclient.WithFeeGranter("cosmos1k8e50d2d8xkdfw9c4et3m45llh69e7xzw6uzga"),
+				cosmosclient.WithFeePayer(sdkaddress.String()),
+			},
+			msg: &banktypes.MsgSend{
+				FromAddress: "from",
+				ToAddress:   "to",
+				Amount: sdktypes.NewCoins(
+					sdktypes.NewCoin("token", sdktypes.NewIntFromUint64((1))),
+				),
+			},
+			expectedJSONTx: `{"body":{"messages":[{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"from","to_address":"to","amount":[{"denom":"token","amount":"1"}]}],"memo":"","timeout_height":"0","extension_options":[],"non_critical_extension_options":[]},"auth_info":{"signer_infos":[],"fee":{"amount":[],"gas_limit":"300000","payer":"` + sdkaddress.String() + `","granter":"cosmos1k8e50d2d8xkdfw9c4et3m45llh69e7xzw6uzga"},"tip":null},"signatures":[]}`,
+			setup: func(s suite) {
+				s.expectPrepareFactory(sdkaddress)
+			},
+		},
+		{
+			name: "fail: invalid fee granter",
+			opts: []cosmosclient.Option{
+				cosmosclient.WithFeeGranter("granter"),
+			},
+			expectedError: "invalid fee granter: decoding bech32 failed: invalid bech32 string length 7",
+		},
 		{
 			name: "ok: without auto gas limit",
 			opts: []cosmosclient.Option{
@@ -0,0 +1,162 @@
+package cosmosclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	"github.com/pkg/errors"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	jsonrpcclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
+)
+
+const (
+	// EventQueryNewBlock is the query of the events of the new blocks.
+	EventQueryNewBlock = "tm.event='NewBlock'"
+
+	// EventQueryTx is the query of the events of the transactions, it can be
+	// narrowed with the attributes of the events of the transactions, e.g.
+	// "tm.event='Tx' AND message.sender='cosmos1...'".
+	EventQueryTx = "tm.event='Tx'"
+)
+
+const (
+	// wsPingPeriod is the period of the pings of the WebSocket connections, the
+	// connections are closed when the node doesn't answer within wsReadWait.
+	wsPingPeriod = 10 * time.Second
+	wsReadWait   = 30 * time.Second
+
+	// eventsMaxRetryInterval is the maximum interval between the subscriptions
+	// to the events after a disconnection.
+	eventsMaxRetryInterval = 30 * time.Second
+)
+
+var errEventsDisconnected = errors.New("disconnected from the events of the node")
+
+// SubscribeEvents subscribes to the events of the chain matching the query,
+// like EventQueryNewBlock or EventQueryTx, through the WebSocket endpoint of
+// the node. The events are decoded and sent to the returned channel until ctx
+// is canceled, then the channel is closed.
+//
+// The client reconnects and subscribes again when the node disconnects, the
+// events emitted while the client is disconnected are not sent.
+func (c Client) SubscribeEvents(ctx context.Context, query string) (<-chan ctypes.ResultEvent, error) {
+	ws, err := c.subscribeEvents(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan ctypes.ResultEvent)
+	go func() {
+		defer close(events)
+
+		b := backoff.NewExponentialBackOff()
+		b.MaxInterval = eventsMaxRetryInterval
+		b.MaxElapsedTime = 0
+
+		for {
+			if err := readEvents(ctx, ws, events); err == nil {
+				return
+			}
+
+			// subscribe again once the node is back.
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(b.NextBackOff()):
+				}
+				if ws, err = c.subscribeEvents(ctx, query); err == nil {
+					b.Reset()
+					break
+				}
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// subscribeEvents connects to the WebSocket endpoint of the node and subscribes
+// to the events of the query, it returns once the node acknowledged the
+// subscription. The WebSocket client redials the node once after a
+// disconnection and subscribes again, it stops when the redial fails.
+func (c Client) subscribeEvents(ctx context.Context, query string) (*jsonrpcclient.WSClient, error) {
+	var ws *jsonrpcclient.WSClient
+	resubscribe := func() {
+		if err := ws.Subscribe(ctx, query); err != nil {
+			ws.Stop() //nolint:errcheck
+		}
+	}
+
+	ws, err := jsonrpcclient.NewWS(
+		c.nodeAddress,
+		"/websocket",
+		jsonrpcclient.MaxReconnectAttempts(0),
+		jsonrpcclient.PingPeriod(wsPingPeriod),
+		jsonrpcclient.ReadWait(wsReadWait),
+		jsonrpcclient.OnReconnect(resubscribe),
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if err := ws.Start(); err != nil {
+		return nil, rpcError(c.nodeAddress, err)
+	}
+
+	if err := ws.Subscribe(ctx, query); err != nil {
+		ws.Stop() //nolint:errcheck
+		return nil, rpcError(c.nodeAddress, err)
+	}
+
+	select {
+	case <-ctx.Done():
+		ws.Stop() //nolint:errcheck
+		return nil, ctx.Err()
+	case res, ok := <-ws.ResponsesCh:
+		if !ok {
+			return nil, rpcError(c.nodeAddress, errEventsDisconnected)
+		}
+		if res.Error != nil {
+			ws.Stop() //nolint:errcheck
+			return nil, fmt.Errorf("subscribe to the events of %q: %w", query, res.Error)
+		}
+	}
+
+	return ws, nil
+}
+
+// readEvents sends the events received by the WebSocket client to the channel
+// until ctx is canceled or the node disconnects, the client is stopped then.
+// A nil error is returned when ctx is canceled.
+func readEvents(ctx context.Context, ws *jsonrpcclient.WSClient, events chan<- ctypes.ResultEvent) error {
+	defer ws.Stop() //nolint:errcheck
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case res, ok := <-ws.ResponsesCh:
+			if !ok {
+				return errEventsDisconnected
+			}
+			// the node cancels the subscriptions when it stops.
+			if res.Error != nil {
+				return res.Error
+			}
+
+			var event ctypes.ResultEvent
+			if err := tmjson.Unmarshal(res.Result, &event); err != nil || event.Query == "" {
+				continue
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case events <- event:
+			}
+		}
+	}
+}
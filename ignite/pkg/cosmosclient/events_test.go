@@ -0,0 +1,93 @@
+package cosmosclient_test
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpcserver "github.com/tendermint/tendermint/rpc/jsonrpc/server"
+	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+)
+
+// hijackRecorder records the WebSocket connections of the node to close them.
+type hijackRecorder struct {
+	http.ResponseWriter
+	conns chan net.Conn
+}
+
+func (h hijackRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	conn, rw, err := h.ResponseWriter.(http.Hijacker).Hijack()
+	if err == nil {
+		h.conns <- conn
+	}
+	return conn, rw, err
+}
+
+func TestClientSubscribeEvents(t *testing.T) {
+	var height int64
+	subscribe := func(ctx *rpctypes.Context, query string) (*ctypes.ResultSubscribe, error) {
+		if query != cosmosclient.EventQueryNewBlock {
+			return nil, fmt.Errorf("invalid query %s", query)
+		}
+		// the node sends an event of a new block after each subscription.
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			event := ctypes.ResultEvent{
+				Query: query,
+				Data: tmtypes.EventDataNewBlockHeader{
+					Header: tmtypes.Header{Height: atomic.AddInt64(&height, 1)},
+				},
+			}
+			// the events have the ID of the subscription request.
+			resp := rpctypes.NewRPCSuccessResponse(ctx.JSONReq.ID, event)
+			ctx.WSConn.WriteRPCResponse(ctx.Context(), resp) //nolint:errcheck
+		}()
+		return &ctypes.ResultSubscribe{}, nil
+	}
+	wm := rpcserver.NewWebsocketManager(map[string]*rpcserver.RPCFunc{
+		"subscribe": rpcserver.NewWSRPCFunc(subscribe, "query"),
+	})
+
+	conns := make(chan net.Conn, 2)
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		wm.WebsocketHandler(hijackRecorder{ResponseWriter: w, conns: conns}, r)
+	}))
+	defer node.Close()
+
+	c := newClient(t, nil, cosmosclient.WithNodeAddress(node.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := c.SubscribeEvents(ctx, "invalid")
+	require.ErrorContains(t, err, "invalid query invalid")
+
+	events, err := c.SubscribeEvents(ctx, cosmosclient.EventQueryNewBlock)
+	require.NoError(t, err)
+
+	// the first connection is the one of the invalid query.
+	<-conns
+	event := <-events
+	require.Equal(t, cosmosclient.EventQueryNewBlock, event.Query)
+	require.EqualValues(t, 1, event.Data.(tmtypes.EventDataNewBlockHeader).Header.Height)
+
+	// the client subscribes again once the node disconnects.
+	require.NoError(t, (<-conns).Close())
+	event = <-events
+	require.EqualValues(t, 2, event.Data.(tmtypes.EventDataNewBlockHeader).Header.Height)
+
+	cancel()
+	_, ok := <-events
+	require.False(t, ok)
+}
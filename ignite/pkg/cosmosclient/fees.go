@@ -0,0 +1,186 @@
+package cosmosclient
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/pkg/errors"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// GasPricesAuto is the gas prices option of the client that uses the gas price
+// discovered from the node, see GasPrice.
+const GasPricesAuto = "auto"
+
+const (
+	queryPathNodeConfig    = "/cosmos.base.node.v1beta1.Service/Config"
+	queryPathStakingParams = "/cosmos.staking.v1beta1.Query/Params"
+
+	// queryPathFeeMarketBaseFee is the query of the base fee of the EIP-1559
+	// fee market module of the Ethermint based chains.
+	queryPathFeeMarketBaseFee = "/ethermint.feemarket.v1.Query/BaseFee"
+)
+
+// GasPrice returns the gas price of the txs sent to the node, in the denom of
+// the fees of the client, see WithFeeDenom. The denom of the fees is the first
+// denom of the minimum gas prices of the node in alphabetical order by default,
+// or the bond denom of the chain when the node has no minimum gas prices.
+//
+// The gas price is the minimum gas price of the node in the denom, raised to
+// the base fee of the x/feemarket module when the chain has one. The price is
+// zero when the node accepts the txs without fees. The gas price is always read
+// from the latest state of the chain, even when the client queries a past
+// height, see WithHeight.
+func (c Client) GasPrice(ctx context.Context) (sdktypes.DecCoin, error) {
+	minGasPrices, err := c.MinGasPrices(ctx)
+	if err != nil {
+		return sdktypes.DecCoin{}, err
+	}
+
+	denom := c.feeDenom
+	if denom == "" && len(minGasPrices) > 0 {
+		denom = minGasPrices[0].Denom
+	}
+	if denom == "" {
+		if denom, err = c.bondDenom(ctx); err != nil {
+			return sdktypes.DecCoin{}, err
+		}
+	}
+
+	price := sdktypes.NewDecCoinFromDec(denom, minGasPrices.AmountOf(denom))
+
+	baseFee, ok, err := c.BaseFee(ctx)
+	if err != nil {
+		return sdktypes.DecCoin{}, err
+	}
+	if ok && price.Amount.LT(sdktypes.NewDecFromInt(baseFee)) {
+		price.Amount = sdktypes.NewDecFromInt(baseFee)
+	}
+
+	return price, nil
+}
+
+// MinGasPrices returns the minimum gas prices of the node, the node accepts the
+// txs with fees above the gas of the txs times one of the prices. The prices
+// are empty when the node doesn't have minimum gas prices, or when the chain
+// doesn't expose the config of the node.
+func (c Client) MinGasPrices(ctx context.Context) (sdktypes.DecCoins, error) {
+	var res nodeservice.ConfigResponse
+	ok, err := c.queryABCI(ctx, queryPathNodeConfig, &nodeservice.ConfigRequest{}, &res)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	prices, err := sdktypes.ParseDecCoins(res.MinimumGasPrice)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid minimum gas prices %q", res.MinimumGasPrice)
+	}
+	return prices, nil
+}
+
+// BaseFee returns the base fee per gas of the EIP-1559 x/feemarket module of
+// the chain, it returns false when the chain has no fee market or when the base
+// fee is disabled.
+func (c Client) BaseFee(ctx context.Context) (sdkmath.Int, bool, error) {
+	res, err := c.RPC.ABCIQueryWithOptions(ctx, queryPathFeeMarketBaseFee, nil, rpcclient.ABCIQueryOptions{})
+	if err != nil {
+		return sdkmath.Int{}, false, err
+	}
+	if isUnknownQuery(res.Response.Code, res.Response.Codespace) {
+		return sdkmath.Int{}, false, nil
+	}
+	if !res.Response.IsOK() {
+		return sdkmath.Int{}, false, errors.Errorf("query base fee: %s", res.Response.Log)
+	}
+
+	baseFee, err := decodeBaseFee(res.Response.Value)
+	if err != nil || baseFee == "" {
+		return sdkmath.Int{}, false, err
+	}
+	fee, ok := sdkmath.NewIntFromString(baseFee)
+	if !ok {
+		return sdkmath.Int{}, false, errors.Errorf("invalid base fee %q", baseFee)
+	}
+	return fee, true, nil
+}
+
+// bondDenom returns the bond denom of the staking module of the chain.
+func (c Client) bondDenom(ctx context.Context) (string, error) {
+	var res stakingtypes.QueryParamsResponse
+	ok, err := c.queryABCI(ctx, queryPathStakingParams, &stakingtypes.QueryParamsRequest{}, &res)
+	if err != nil {
+		return "", err
+	}
+	if !ok || res.Params.BondDenom == "" {
+		return "", errors.New("cannot find the denom of the fees, set it with WithFeeDenom")
+	}
+	return res.Params.BondDenom, nil
+}
+
+type abciQueryMessage interface {
+	Marshal() ([]byte, error)
+	Unmarshal([]byte) error
+}
+
+// queryABCI queries the gRPC service method of the path through the ABCI
+// queries of the node, it returns false when the chain doesn't register the
+// service. The fees depend on the latest state of the chain, the latest state
+// is queried regardless of the height of the client.
+func (c Client) queryABCI(ctx context.Context, path string, req, res abciQueryMessage) (bool, error) {
+	data, err := req.Marshal()
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	r, err := c.RPC.ABCIQueryWithOptions(ctx, path, data, rpcclient.ABCIQueryOptions{})
+	if err != nil {
+		return false, err
+	}
+	if isUnknownQuery(r.Response.Code, r.Response.Codespace) {
+		return false, nil
+	}
+	if !r.Response.IsOK() {
+		return false, errors.Errorf("query %s: %s", path, r.Response.Log)
+	}
+
+	return true, errors.WithStack(res.Unmarshal(r.Response.Value))
+}
+
+// isUnknownQuery returns true when the query is rejected because the path is
+// not registered by the chain.
+func isUnknownQuery(code uint32, codespace string) bool {
+	return codespace == sdkerrors.ErrUnknownRequest.Codespace() &&
+		code == sdkerrors.ErrUnknownRequest.ABCICode()
+}
+
+// decodeBaseFee decodes the base fee of a QueryBaseFeeResponse of the fee
+// market module, the fee is empty when the base fee is disabled.
+func decodeBaseFee(b []byte) (string, error) {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return "", errors.WithStack(protowire.ParseError(n))
+		}
+		b = b[n:]
+
+		if num == 1 && typ == protowire.BytesType {
+			v, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return "", errors.WithStack(protowire.ParseError(n))
+			}
+			return string(v), nil
+		}
+
+		n = protowire.ConsumeFieldValue(num, typ, b)
+		if n < 0 {
+			return "", errors.WithStack(protowire.ParseError(n))
+		}
+		b = b[n:]
+	}
+	return "", nil
+}
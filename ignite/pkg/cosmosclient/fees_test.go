@@ -0,0 +1,190 @@
+package cosmosclient_test
+
+import (
+	"context"
+	"testing"
+
+	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+)
+
+const (
+	queryPathNodeConfig       = "/cosmos.base.node.v1beta1.Service/Config"
+	queryPathStakingParams    = "/cosmos.staking.v1beta1.Query/Params"
+	queryPathFeeMarketBaseFee = "/ethermint.feemarket.v1.Query/BaseFee"
+)
+
+// expectABCIQuery expects an ABCI query of the path at the latest height
+// answered with the value, the path is unknown to the chain when value is nil.
+func (s suite) expectABCIQuery(path string, value []byte) {
+	res := abci.ResponseQuery{Value: value}
+	if value == nil {
+		res.Codespace = sdkerrors.ErrUnknownRequest.Codespace()
+		res.Code = sdkerrors.ErrUnknownRequest.ABCICode()
+		res.Log = "unknown query path"
+	}
+	s.rpcClient.EXPECT().
+		ABCIQueryWithOptions(mock.Anything, path, mock.Anything, rpcclient.ABCIQueryOptions{}).
+		Return(&ctypes.ResultABCIQuery{Response: res}, nil).
+		Once()
+}
+
+func marshalConfig(t *testing.T, minGasPrice string) []byte {
+	b, err := (&nodeservice.ConfigResponse{MinimumGasPrice: minGasPrice}).Marshal()
+	require.NoError(t, err)
+	return b
+}
+
+func marshalBaseFee(baseFee string) []byte {
+	b := protowire.AppendTag(nil, 1, protowire.BytesType)
+	return protowire.AppendString(b, baseFee)
+}
+
+func TestClientGasPrice(t *testing.T) {
+	stakingParams, err := (&stakingtypes.QueryParamsResponse{
+		Params: stakingtypes.Params{BondDenom: "stake"},
+	}).Marshal()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		opts             []cosmosclient.Option
+		setup            func(s suite)
+		expectedGasPrice string
+		expectedError    string
+	}{
+		{
+			name: "ok: min gas price",
+			setup: func(s suite) {
+				s.expectABCIQuery(queryPathNodeConfig, marshalConfig(t, "0.025uatom,0.1stake"))
+				s.expectABCIQuery(queryPathFeeMarketBaseFee, nil)
+			},
+			expectedGasPrice: "0.100000000000000000stake",
+		},
+		{
+			name: "ok: min gas price of the fee denom",
+			opts: []cosmosclient.Option{
+				cosmosclient.WithFeeDenom("uatom"),
+			},
+			setup: func(s suite) {
+				s.expectABCIQuery(queryPathNodeConfig, marshalConfig(t, "0.025uatom,0.1stake"))
+				s.expectABCIQuery(queryPathFeeMarketBaseFee, nil)
+			},
+			expectedGasPrice: "0.025000000000000000uatom",
+		},
+		{
+			name: "ok: base fee above the min gas price",
+			setup: func(s suite) {
+				s.expectABCIQuery(queryPathNodeConfig, marshalConfig(t, "10aevmos"))
+				s.expectABCIQuery(queryPathFeeMarketBaseFee, marshalBaseFee("875000000"))
+			},
+			expectedGasPrice: "875000000.000000000000000000aevmos",
+		},
+		{
+			name: "ok: base fee disabled",
+			setup: func(s suite) {
+				s.expectABCIQuery(queryPathNodeConfig, marshalConfig(t, "10aevmos"))
+				s.expectABCIQuery(queryPathFeeMarketBaseFee, []byte{})
+			},
+			expectedGasPrice: "10.000000000000000000aevmos",
+		},
+		{
+			name: "ok: no min gas prices",
+			setup: func(s suite) {
+				s.expectABCIQuery(queryPathNodeConfig, marshalConfig(t, ""))
+				s.expectABCIQuery(queryPathStakingParams, stakingParams)
+				s.expectABCIQuery(queryPathFeeMarketBaseFee, nil)
+			},
+			expectedGasPrice: "0.000000000000000000stake",
+		},
+		{
+			name: "ok: node config not exposed",
+			setup: func(s suite) {
+				s.expectABCIQuery(queryPathNodeConfig, nil)
+				s.expectABCIQuery(queryPathStakingParams, stakingParams)
+				s.expectABCIQuery(queryPathFeeMarketBaseFee, nil)
+			},
+			expectedGasPrice: "0.000000000000000000stake",
+		},
+		{
+			name: "fail: no fee denom",
+			setup: func(s suite) {
+				s.expectABCIQuery(queryPathNodeConfig, nil)
+				s.expectABCIQuery(queryPathStakingParams, nil)
+			},
+			expectedError: "cannot find the denom of the fees, set it with WithFeeDenom",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newClient(t, tt.setup, tt.opts...)
+
+			gasPrice, err := c.GasPrice(context.Background())
+
+			if tt.expectedError != "" {
+				require.EqualError(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedGasPrice, gasPrice.String())
+		})
+	}
+}
+
+func TestClientGasPriceWithHeight(t *testing.T) {
+	// the queries of the fees are made at the latest height, see
+	// expectABCIQuery.
+	c := newClient(t, func(s suite) {
+		s.expectABCIQuery(queryPathNodeConfig, marshalConfig(t, "0.025uatom"))
+		s.expectABCIQuery(queryPathFeeMarketBaseFee, marshalBaseFee("50000"))
+	}).WithHeight(42)
+
+	gasPrice, err := c.GasPrice(context.Background())
+
+	require.NoError(t, err)
+	require.Equal(t, "50000.000000000000000000uatom", gasPrice.String())
+}
+
+func TestClientCreateTxWithAutoGasPrices(t *testing.T) {
+	const accountName = "bob"
+	r, err := cosmosaccount.NewInMemory()
+	require.NoError(t, err)
+	a, _, err := r.Create(accountName)
+	require.NoError(t, err)
+	key, err := r.Export(accountName, "passphrase")
+	require.NoError(t, err)
+	sdkaddress, err := a.Record.GetAddress()
+	require.NoError(t, err)
+
+	c := newClient(t, func(s suite) {
+		s.expectPrepareFactory(sdkaddress)
+		s.expectABCIQuery(queryPathNodeConfig, marshalConfig(t, "0.025uatom"))
+		s.expectABCIQuery(queryPathFeeMarketBaseFee, nil)
+	}, cosmosclient.WithGasPrices(cosmosclient.GasPricesAuto))
+	account, err := c.AccountRegistry.Import(accountName, key, "passphrase")
+	require.NoError(t, err)
+
+	txs, err := c.CreateTx(context.Background(), account, &banktypes.MsgSend{
+		FromAddress: "from",
+		ToAddress:   "to",
+		Amount:      sdktypes.NewCoins(sdktypes.NewInt64Coin("token", 1)),
+	})
+
+	require.NoError(t, err)
+	data, err := txs.EncodeJSON()
+	require.NoError(t, err)
+	// the fees are the default gas limit times the min gas price of the node.
+	require.Contains(t, string(data), `"fee":{"amount":[{"denom":"uatom","amount":"7500"}]`)
+}
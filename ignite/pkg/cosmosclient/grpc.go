@@ -0,0 +1,93 @@
+package cosmosclient
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/pkg/errors"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	jsonrpcclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+// Close closes the connection to the gRPC server of the node, if any.
+func (c Client) Close() error {
+	if c.grpcConn == nil {
+		return nil
+	}
+	return c.grpcConn.Close()
+}
+
+// dialGRPC connects to the gRPC server of the node.
+func (c Client) dialGRPC(ctx context.Context) (*grpc.ClientConn, error) {
+	creds := insecure.NewCredentials()
+	if c.grpcTLSConfig != nil {
+		creds = credentials.NewTLS(c.grpcTLSConfig)
+	}
+
+	// the queries of the Cosmos SDK are encoded with gogoproto.
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(codec.NewProtoCodec(c.context.InterfaceRegistry).GRPCCodec())),
+	}
+	if len(c.headers) > 0 {
+		opts = append(opts, grpc.WithChainUnaryInterceptor(headersInterceptor(c.headers)))
+	}
+	opts = append(opts, c.grpcDialOptions...)
+
+	conn, err := grpc.DialContext(ctx, c.grpcAddress, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot connect to the gRPC server '%s'", c.grpcAddress)
+	}
+	return conn, nil
+}
+
+// headersInterceptor adds the headers to the metadata of the gRPC requests.
+func headersInterceptor(headers map[string]string) grpc.UnaryClientInterceptor {
+	var kv []string
+	for k, v := range headers {
+		kv = append(kv, k, v)
+	}
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx = metadata.AppendToOutgoingContext(ctx, kv...)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// newRPCClient returns a Tendermint RPC client that sends the headers with
+// each HTTP request.
+func newRPCClient(nodeAddress string, headers map[string]string) (rpcclient.Client, error) {
+	if len(headers) == 0 {
+		return rpchttp.New(nodeAddress, "/websocket")
+	}
+
+	httpClient, err := jsonrpcclient.DefaultHTTPClient(nodeAddress)
+	if err != nil {
+		return nil, err
+	}
+	httpClient.Transport = headersTransport{
+		base:    httpClient.Transport,
+		headers: headers,
+	}
+	return rpchttp.NewWithClient(nodeAddress, "/websocket", httpClient)
+}
+
+// headersTransport adds headers to the HTTP requests.
+type headersTransport struct {
+	base    http.RoundTripper
+	headers map[string]string
+}
+
+func (t headersTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// a round tripper must not modify the request.
+	req = req.Clone(req.Context())
+	for k, v := range t.headers {
+		req.Header.Set(k, v)
+	}
+	return t.base.RoundTrip(req)
+}
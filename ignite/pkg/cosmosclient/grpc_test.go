@@ -0,0 +1,118 @@
+package cosmosclient_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+)
+
+const apiKey = "secret"
+
+// bankQueryServer serves the balances to the requests with the API key.
+type bankQueryServer struct {
+	*banktypes.UnimplementedQueryServer
+}
+
+func (bankQueryServer) AllBalances(ctx context.Context, req *banktypes.QueryAllBalancesRequest) (*banktypes.QueryAllBalancesResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if keys := md.Get("x-api-key"); len(keys) == 0 || keys[0] != apiKey {
+		return nil, status.Error(codes.Unauthenticated, "invalid API key")
+	}
+	return &banktypes.QueryAllBalancesResponse{
+		Balances: sdk.NewCoins(sdk.NewCoin("token", math.NewInt(1000))),
+	}, nil
+}
+
+func TestClientGRPC(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer(grpc.ForceServerCodec(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()).GRPCCodec()))
+	banktypes.RegisterQueryServer(server, bankQueryServer{})
+	go server.Serve(lis) //nolint:errcheck
+	defer server.Stop()
+
+	tests := []struct {
+		name          string
+		headers       map[string]string
+		expectedError string
+	}{
+		{
+			name:    "ok: with API key",
+			headers: map[string]string{"x-api-key": apiKey},
+		},
+		{
+			name:          "fail: without API key",
+			expectedError: "rpc error: code = Unauthenticated desc = invalid API key",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newClient(t, nil,
+				cosmosclient.WithGRPCAddress(lis.Addr().String()),
+				cosmosclient.WithHeaders(tt.headers),
+			)
+			defer c.Close()
+
+			// the queries of the client context are sent to the gRPC server.
+			res, err := banktypes.NewQueryClient(c.Context()).AllBalances(context.Background(), &banktypes.QueryAllBalancesRequest{
+				Address: "cosmos1k8e50d2d8xkdfw9c4et3m45llh69e7xzw6uzga",
+			})
+			if tt.expectedError != "" {
+				require.EqualError(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, sdk.NewCoins(sdk.NewCoin("token", math.NewInt(1000))), res.Balances)
+		})
+	}
+}
+
+func TestClientRPCHeaders(t *testing.T) {
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("x-api-key") != apiKey {
+			http.Error(w, "invalid API key", http.StatusUnauthorized)
+			return
+		}
+		var req struct {
+			ID json.RawMessage `json:"id"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":{"node_info":{"network":"mychain"}}}`, req.ID)
+	}))
+	defer node.Close()
+
+	c, err := cosmosclient.New(context.Background(),
+		cosmosclient.WithNodeAddress(node.URL),
+		cosmosclient.WithHeaders(map[string]string{"x-api-key": apiKey}),
+		cosmosclient.WithKeyringBackend(cosmosaccount.KeyringMemory),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "mychain", c.Context().ChainID)
+
+	_, err = cosmosclient.New(context.Background(),
+		cosmosclient.WithNodeAddress(node.URL),
+		cosmosclient.WithKeyringBackend(cosmosaccount.KeyringMemory),
+	)
+	require.Error(t, err)
+}
@@ -0,0 +1,31 @@
+package cosmosclient
+
+import (
+	"context"
+	"strconv"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// WithHeight returns a copy of the client that queries the state of the chain
+// at the height, the latest state is queried by default. The historical states
+// are only available on the nodes that don't prune them.
+//
+// The queries of the query clients created with the context of the client are
+// made at the height when their context is returned by QueryContext.
+func (c Client) WithHeight(height int64) Client {
+	c.height = height
+	c.context = c.context.WithHeight(height)
+	return c
+}
+
+// QueryContext returns a copy of ctx that queries the state of the chain at the
+// height of the client, see WithHeight. The height is sent in the metadata of
+// the queries, both to the gRPC server and to the ABCI queries of the node.
+func (c Client) QueryContext(ctx context.Context) context.Context {
+	if c.height == 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(c.height, 10))
+}
@@ -0,0 +1,58 @@
+package cosmosclient
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// PageFetcher fetches the items of a page of a paginated query, e.g. for a
+// custom module:
+//
+//	fetch := func(ctx context.Context, page *query.PageRequest) ([]types.Post, *query.PageResponse, error) {
+//		res, err := types.NewQueryClient(client.Context()).PostAll(ctx, &types.QueryAllPostRequest{
+//			Pagination: page,
+//		})
+//		if err != nil {
+//			return nil, nil, err
+//		}
+//		return res.Post, res.Pagination, nil
+//	}
+type PageFetcher[T any] func(ctx context.Context, page *query.PageRequest) ([]T, *query.PageResponse, error)
+
+// Paginate fetches the pages of a paginated query until the last one and
+// returns their items.
+func Paginate[T any](ctx context.Context, fetch PageFetcher[T]) ([]T, error) {
+	var items []T
+	err := PaginateEach(ctx, fetch, func(item T) error {
+		items = append(items, item)
+		return nil
+	})
+	return items, err
+}
+
+// PaginateEach fetches the pages of a paginated query until the last one and
+// calls fn with each of their items. It stops at the first error of fn.
+func PaginateEach[T any](ctx context.Context, fetch PageFetcher[T], fn func(T) error) error {
+	page := &query.PageRequest{Limit: query.DefaultLimit}
+	for {
+		items, res, err := fetch(ctx, page)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			if err := fn(item); err != nil {
+				return err
+			}
+		}
+
+		if res == nil || len(res.NextKey) == 0 {
+			return nil
+		}
+		page = &query.PageRequest{
+			Key:   res.NextKey,
+			Limit: query.DefaultLimit,
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package cosmosclient_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+)
+
+func TestPaginate(t *testing.T) {
+	pages := map[string]struct {
+		items   []int
+		nextKey string
+	}{
+		"":   {items: []int{1, 2}, nextKey: "k1"},
+		"k1": {items: []int{3, 4}, nextKey: "k2"},
+		"k2": {items: []int{5}},
+	}
+	fetch := func(_ context.Context, page *query.PageRequest) ([]int, *query.PageResponse, error) {
+		require.EqualValues(t, query.DefaultLimit, page.Limit)
+		p, ok := pages[string(page.Key)]
+		if !ok {
+			return nil, nil, errors.New("unknown page")
+		}
+		return p.items, &query.PageResponse{NextKey: []byte(p.nextKey)}, nil
+	}
+
+	items, err := cosmosclient.Paginate(context.Background(), fetch)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3, 4, 5}, items)
+
+	var visited []int
+	err = cosmosclient.PaginateEach(context.Background(), fetch, func(item int) error {
+		if item == 3 {
+			return errors.New("stop")
+		}
+		visited = append(visited, item)
+		return nil
+	})
+	require.EqualError(t, err, "stop")
+	require.Equal(t, []int{1, 2}, visited)
+
+	_, err = cosmosclient.Paginate(context.Background(), func(context.Context, *query.PageRequest) ([]int, *query.PageResponse, error) {
+		return nil, nil, errors.New("oups")
+	})
+	require.EqualError(t, err, "oups")
+}
@@ -0,0 +1,170 @@
+package cosmosclient
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+)
+
+// maxSequenceRetries is the number of times a tx is signed and broadcasted
+// again after an account sequence mismatch.
+const maxSequenceRetries = 3
+
+// reExpectedSequence matches the sequence expected by the node in the log of an
+// account sequence mismatch.
+var reExpectedSequence = regexp.MustCompile(`account sequence mismatch, expected (\d+)`)
+
+// TxPipeline broadcasts the txs of an account. It tracks the sequence of the
+// account locally, so the txs are broadcasted one after the other without
+// waiting for the previous ones to be included in a block, and signs the tx
+// again with the sequence expected by the node after an account sequence
+// mismatch.
+//
+// A TxPipeline is safe for concurrent use, the txs of the concurrent senders
+// are queued and signed one at a time.
+//
+// When the gas of the client is simulated, the simulation of a tx fails while
+// previous txs of the account are not included in a block yet, use a fixed gas
+// to broadcast several txs per block.
+type TxPipeline struct {
+	client  Client
+	account cosmosaccount.Account
+
+	// queue holds the turn of the sender that signs and broadcasts a tx.
+	queue chan struct{}
+
+	// accountNumber and sequence are the account number and the sequence of
+	// the next tx of the account, they are fetched when synced is false.
+	accountNumber uint64
+	sequence      uint64
+	synced        bool
+}
+
+// NewTxPipeline returns a new tx pipeline to broadcast the txs of the account.
+func (c Client) NewTxPipeline(account cosmosaccount.Account) *TxPipeline {
+	return &TxPipeline{
+		client:  c,
+		account: account,
+		queue:   make(chan struct{}, 1),
+	}
+}
+
+// BroadcastTx signs and broadcasts a tx with the msgs, then waits for its
+// inclusion in a block in sync broadcast mode. The turn of the next sender
+// comes once the tx is accepted in the mempool of the node.
+func (p *TxPipeline) BroadcastTx(ctx context.Context, msgs ...sdktypes.Msg) (Response, error) {
+	return p.broadcastTx(ctx, p.client, msgs)
+}
+
+// BroadcastTxWithGasPrice broadcasts a tx with the msgs like BroadcastTx, the
+// fees of the tx are its gas times the gas price instead of the gas prices of
+// the client. The priority of the tx in the mempool of the node depends on its
+// gas price.
+func (p *TxPipeline) BroadcastTxWithGasPrice(ctx context.Context, gasPrice sdktypes.DecCoin, msgs ...sdktypes.Msg) (Response, error) {
+	c := p.client
+	c.gasPrices = gasPrice.String()
+	c.fees = ""
+	return p.broadcastTx(ctx, c, msgs)
+}
+
+func (p *TxPipeline) broadcastTx(ctx context.Context, c Client, msgs []sdktypes.Msg) (Response, error) {
+	select {
+	case <-ctx.Done():
+		return Response{}, ctx.Err()
+	case p.queue <- struct{}{}:
+	}
+
+	txService, resp, err := p.broadcast(ctx, c, msgs)
+	<-p.queue
+	if err != nil {
+		return Response{}, err
+	}
+
+	return txService.response(ctx, resp)
+}
+
+// broadcast signs and broadcasts a tx of the client with the next sequence of
+// the account.
+func (p *TxPipeline) broadcast(ctx context.Context, client Client, msgs []sdktypes.Msg) (TxService, *sdktypes.TxResponse, error) {
+	for retries := 0; ; retries++ {
+		if !p.synced {
+			if err := p.sync(); err != nil {
+				return TxService{}, nil, err
+			}
+		}
+
+		c := client
+		c.TxFactory = c.TxFactory.
+			WithAccountNumber(p.accountNumber).
+			WithSequence(p.sequence)
+
+		txService, err := c.CreateTx(ctx, p.account, msgs...)
+		if err != nil {
+			return TxService{}, nil, err
+		}
+
+		resp, err := txService.broadcast()
+		if err != nil {
+			// the tx may have been accepted by the node.
+			p.synced = false
+			return TxService{}, nil, handleBroadcastResult(resp, err)
+		}
+
+		if isSequenceMismatch(resp) && retries < maxSequenceRetries {
+			if sequence, ok := expectedSequence(resp.RawLog); ok {
+				p.sequence = sequence
+			} else {
+				p.synced = false
+			}
+			continue
+		}
+
+		// the sequence is used once the tx is accepted in the mempool.
+		if err := handleBroadcastResult(resp, nil); err != nil {
+			return TxService{}, nil, err
+		}
+		p.sequence++
+
+		return txService, resp, nil
+	}
+}
+
+// sync fetches the account number and the sequence of the account.
+func (p *TxPipeline) sync() error {
+	addr, err := p.account.Record.GetAddress()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	num, seq, err := p.client.accountRetriever.GetAccountNumberSequence(p.client.context, addr)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	p.accountNumber, p.sequence, p.synced = num, seq, true
+	return nil
+}
+
+// isSequenceMismatch returns true when the tx is rejected because of its
+// account sequence.
+func isSequenceMismatch(resp *sdktypes.TxResponse) bool {
+	return resp.Codespace == sdkerrors.ErrWrongSequence.Codespace() &&
+		resp.Code == sdkerrors.ErrWrongSequence.ABCICode()
+}
+
+// expectedSequence returns the sequence expected by the node from the log of
+// an account sequence mismatch.
+func expectedSequence(log string) (uint64, bool) {
+	m := reExpectedSequence.FindStringSubmatch(log)
+	if m == nil {
+		return 0, false
+	}
+	sequence, err := strconv.ParseUint(m[1], 10, 64)
+	return sequence, err == nil
+}
@@ -0,0 +1,196 @@
+package cosmosclient_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+)
+
+func TestTxPipelineBroadcastTx(t *testing.T) {
+	const (
+		accountName = "bob"
+		passphrase  = "passphrase"
+	)
+	r, err := cosmosaccount.NewInMemory()
+	require.NoError(t, err)
+	a, _, err := r.Create(accountName)
+	require.NoError(t, err)
+	key, err := r.Export(accountName, passphrase)
+	require.NoError(t, err)
+	sdkaddress, err := a.Record.GetAddress()
+	require.NoError(t, err)
+	msg := &banktypes.MsgSend{
+		FromAddress: sdkaddress.String(),
+		ToAddress:   "cosmos1k8e50d2d8xkdfw9c4et3m45llh69e7xzw6uzga",
+		Amount:      sdktypes.NewCoins(sdktypes.NewInt64Coin("token", 1)),
+	}
+
+	tests := []struct {
+		name              string
+		txs               int
+		broadcastResults  []*ctypes.ResultBroadcastTx
+		expectedSequences []uint64
+		expectedError     string
+	}{
+		{
+			name:              "ok: concurrent txs",
+			txs:               3,
+			expectedSequences: []uint64{2, 3, 4},
+		},
+		{
+			name: "ok: sequence mismatch",
+			txs:  2,
+			broadcastResults: []*ctypes.ResultBroadcastTx{
+				{
+					Code:      32,
+					Codespace: "sdk",
+					Log:       "account sequence mismatch, expected 7, got 2: incorrect account sequence",
+				},
+			},
+			expectedSequences: []uint64{2, 7, 8},
+		},
+		{
+			name: "fail: tx rejected",
+			txs:  1,
+			broadcastResults: []*ctypes.ResultBroadcastTx{
+				{Code: 42, Log: "oups"},
+			},
+			expectedSequences: []uint64{2},
+			expectedError:     "error code: '42' msg: 'oups'",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mu        sync.Mutex
+				sequences []uint64
+			)
+			c := newClient(t, func(s suite) {
+				s.accountRetriever.EXPECT().
+					EnsureExists(mock.Anything, sdkaddress).
+					Return(nil)
+				s.accountRetriever.EXPECT().
+					GetAccountNumberSequence(mock.Anything, sdkaddress).
+					Return(1, 2, nil).
+					Once()
+				s.signer.EXPECT().
+					Sign(mock.Anything, accountName, mock.Anything, true).
+					Run(func(txf tx.Factory, _ string, _ client.TxBuilder, _ bool) {
+						mu.Lock()
+						defer mu.Unlock()
+						sequences = append(sequences, txf.Sequence())
+					}).
+					Return(nil)
+				for _, res := range tt.broadcastResults {
+					s.rpcClient.EXPECT().
+						BroadcastTxSync(mock.Anything, mock.Anything).
+						Return(res, nil).
+						Once()
+				}
+				s.rpcClient.EXPECT().
+					BroadcastTxSync(mock.Anything, mock.Anything).
+					Return(&ctypes.ResultBroadcastTx{Hash: []byte{1}}, nil).
+					Maybe()
+				s.rpcClient.EXPECT().
+					Tx(mock.Anything, []byte{1}, false).
+					Return(&ctypes.ResultTx{Hash: []byte{1}}, nil).
+					Maybe()
+			})
+			account, err := c.AccountRegistry.Import(accountName, key, passphrase)
+			require.NoError(t, err)
+			p := c.NewTxPipeline(account)
+
+			var (
+				wg   sync.WaitGroup
+				errs = make(chan error, tt.txs)
+			)
+			for i := 0; i < tt.txs; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					_, err := p.BroadcastTx(context.Background(), msg)
+					errs <- err
+				}()
+			}
+			wg.Wait()
+			close(errs)
+
+			for err := range errs {
+				if tt.expectedError != "" {
+					require.EqualError(t, err, tt.expectedError)
+					continue
+				}
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.expectedSequences, sequences)
+		})
+	}
+}
+
+func TestTxPipelineBroadcastTxWithGasPrice(t *testing.T) {
+	const (
+		accountName = "bob"
+		passphrase  = "passphrase"
+	)
+	r, err := cosmosaccount.NewInMemory()
+	require.NoError(t, err)
+	a, _, err := r.Create(accountName)
+	require.NoError(t, err)
+	key, err := r.Export(accountName, passphrase)
+	require.NoError(t, err)
+	sdkaddress, err := a.Record.GetAddress()
+	require.NoError(t, err)
+	msg := &banktypes.MsgSend{
+		FromAddress: sdkaddress.String(),
+		ToAddress:   "cosmos1k8e50d2d8xkdfw9c4et3m45llh69e7xzw6uzga",
+		Amount:      sdktypes.NewCoins(sdktypes.NewInt64Coin("token", 1)),
+	}
+
+	var fees []string
+	c := newClient(t, func(s suite) {
+		s.accountRetriever.EXPECT().
+			EnsureExists(mock.Anything, sdkaddress).
+			Return(nil)
+		s.accountRetriever.EXPECT().
+			GetAccountNumberSequence(mock.Anything, sdkaddress).
+			Return(1, 2, nil).
+			Once()
+		s.signer.EXPECT().
+			Sign(mock.Anything, accountName, mock.Anything, true).
+			Run(func(_ tx.Factory, _ string, txb client.TxBuilder, _ bool) {
+				fees = append(fees, txb.GetTx().GetFee().String())
+			}).
+			Return(nil)
+		s.rpcClient.EXPECT().
+			BroadcastTxSync(mock.Anything, mock.Anything).
+			Return(&ctypes.ResultBroadcastTx{Hash: []byte{1}}, nil)
+		s.rpcClient.EXPECT().
+			Tx(mock.Anything, []byte{1}, false).
+			Return(&ctypes.ResultTx{Hash: []byte{1}}, nil)
+	},
+		cosmosclient.WithGas("100000"),
+		cosmosclient.WithGasPrices("1stake"),
+	)
+	account, err := c.AccountRegistry.Import(accountName, key, passphrase)
+	require.NoError(t, err)
+	p := c.NewTxPipeline(account)
+
+	_, err = p.BroadcastTxWithGasPrice(context.Background(), sdktypes.NewDecCoinFromDec("uatom", sdktypes.MustNewDecFromStr("0.5")), msg)
+	require.NoError(t, err)
+	_, err = p.BroadcastTx(context.Background(), msg)
+	require.NoError(t, err)
+
+	// the gas price of the tx doesn't change the gas prices of the client.
+	require.Equal(t, []string{"50000uatom", "100000stake"}, fees)
+}
@@ -0,0 +1,162 @@
+package cosmosclient
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	commitmenttypes "github.com/cosmos/ibc-go/v5/modules/core/23-commitment/types"
+	"github.com/pkg/errors"
+	"github.com/tendermint/tendermint/light"
+	"github.com/tendermint/tendermint/light/provider"
+	lighthttp "github.com/tendermint/tendermint/light/provider/http"
+	lightdb "github.com/tendermint/tendermint/light/store/db"
+	"github.com/tendermint/tendermint/proto/tendermint/crypto"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// ErrNoLightClient is returned when the proofs of the queries are verified by a
+// client without light client.
+var ErrNoLightClient = errors.New("the client has no light client, see WithLightClient")
+
+// ErrNoLightWitness is returned when the light client has no witness other than
+// the node to cross-check its headers.
+var ErrNoLightWitness = errors.New("the light client needs a witness other than the node, see WithLightClient")
+
+// StoreValue is the value of a key of a store of the chain with its Merkle
+// proof.
+type StoreValue struct {
+	// StoreKey is the key of the store of the module, e.g. "bank".
+	StoreKey string
+
+	// Key is the key of the value in the store.
+	Key []byte
+
+	// Value is the value of the key, it is empty when the key doesn't exist.
+	Value []byte
+
+	// Height is the height of the state of the value, the app hash of the
+	// state is in the header of the next block.
+	Height int64
+
+	// Proof is the Merkle proof of the value, or of the absence of the key,
+	// in the state.
+	Proof *crypto.ProofOps
+}
+
+// QueryStore queries the value of the key in the store of the module with its
+// Merkle proof, at the height of the client, see WithHeight. The proof is not
+// verified, see VerifyStoreValue and VerifiedQueryStore.
+func (c Client) QueryStore(ctx context.Context, storeKey string, key []byte) (StoreValue, error) {
+	res, err := c.RPC.ABCIQueryWithOptions(ctx, fmt.Sprintf("/store/%s/key", storeKey), key, rpcclient.ABCIQueryOptions{
+		Height: c.height,
+		Prove:  true,
+	})
+	if err != nil {
+		return StoreValue{}, err
+	}
+	if !res.Response.IsOK() {
+		return StoreValue{}, errors.Errorf("query of the store %s failed: %s", storeKey, res.Response.Log)
+	}
+
+	return StoreValue{
+		StoreKey: storeKey,
+		Key:      key,
+		Value:    res.Response.Value,
+		Height:   res.Response.Height,
+		Proof:    res.Response.ProofOps,
+	}, nil
+}
+
+// VerifyStoreValue verifies the Merkle proof of the value against the app hash
+// of a trusted header, the header of the block following the height of the
+// value.
+func VerifyStoreValue(v StoreValue, appHash []byte) error {
+	if v.Proof == nil {
+		return errors.New("the value has no proof")
+	}
+	proof, err := commitmenttypes.ConvertProofs(v.Proof)
+	if err != nil {
+		return errors.Wrap(err, "invalid proof")
+	}
+
+	var (
+		specs = commitmenttypes.GetSDKSpecs()
+		root  = commitmenttypes.NewMerkleRoot(appHash)
+		path  = commitmenttypes.NewMerklePath(v.StoreKey, string(v.Key))
+	)
+	if len(v.Value) == 0 {
+		err = proof.VerifyNonMembership(specs, root, path)
+	} else {
+		err = proof.VerifyMembership(specs, root, path, v.Value)
+	}
+	return errors.Wrapf(err, "invalid proof of the key %X of the store %s", v.Key, v.StoreKey)
+}
+
+// VerifiedQueryStore queries the value of the key in the store of the module
+// like QueryStore, then verifies its Merkle proof against the header of the
+// next block verified by the light client, see WithLightClient. It waits for
+// the next block when the latest state is queried.
+func (c Client) VerifiedQueryStore(ctx context.Context, storeKey string, key []byte) (StoreValue, error) {
+	if c.lightClient == nil {
+		return StoreValue{}, ErrNoLightClient
+	}
+
+	v, err := c.QueryStore(ctx, storeKey, key)
+	if err != nil {
+		return StoreValue{}, err
+	}
+
+	// the app hash of the state is in the header of the next block.
+	if err := c.WaitForBlockHeight(ctx, v.Height+1); err != nil {
+		return StoreValue{}, err
+	}
+	block, err := c.lightClient.VerifyLightBlockAtHeight(ctx, v.Height+1, time.Now())
+	if err != nil {
+		return StoreValue{}, errors.Wrapf(err, "cannot verify the header at height %d", v.Height+1)
+	}
+
+	if err := VerifyStoreValue(v, block.AppHash); err != nil {
+		return StoreValue{}, err
+	}
+	return v, nil
+}
+
+// newLightClient returns a light client that verifies the headers of the node
+// from the trusted header of the options of the client.
+func (c Client) newLightClient(ctx context.Context) (*light.Client, error) {
+	primary, err := lighthttp.New(c.chainID, c.nodeAddress)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// the headers of the node are only verified independently when they are
+	// cross-checked with other nodes.
+	var witnesses []provider.Provider
+	for _, addr := range c.lightWitnesses {
+		if sameAddress(addr, c.nodeAddress) {
+			continue
+		}
+		witness, err := lighthttp.New(c.chainID, addr)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		witnesses = append(witnesses, witness)
+	}
+	if len(witnesses) == 0 {
+		return nil, ErrNoLightWitness
+	}
+
+	lc, err := light.NewClient(ctx, c.chainID, *c.lightTrust, primary, witnesses, lightdb.New(dbm.NewMemDB(), c.chainID))
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create the light client")
+	}
+	return lc, nil
+}
+
+// sameAddress returns true when the RPC addresses are the same.
+func sameAddress(a, b string) bool {
+	return strings.TrimSuffix(a, "/") == strings.TrimSuffix(b, "/")
+}
@@ -0,0 +1,114 @@
+package cosmosclient_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/light"
+	"github.com/tendermint/tendermint/p2p"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/ignite/pkg/cosmosclient/mocks"
+)
+
+func TestClientQueryStore(t *testing.T) {
+	// commit a state with a key in the bank store.
+	var (
+		storeKey = storetypes.NewKVStoreKey("bank")
+		key      = []byte("balances/alice")
+		value    = []byte("1000token")
+		store    = rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	)
+	store.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, store.LoadLatestVersion())
+	store.GetKVStore(storeKey).Set(key, value)
+	commitID := store.Commit()
+
+	query := func(key []byte) *ctypes.ResultABCIQuery {
+		return &ctypes.ResultABCIQuery{Response: store.Query(abci.RequestQuery{
+			Path:   "/bank/key",
+			Data:   key,
+			Height: commitID.Version,
+			Prove:  true,
+		})}
+	}
+	missingKey := []byte("balances/bob")
+	c := newClient(t, func(s suite) {
+		opts := rpcclient.ABCIQueryOptions{Height: commitID.Version, Prove: true}
+		s.rpcClient.EXPECT().
+			ABCIQueryWithOptions(context.Background(), "/store/bank/key", tmbytes.HexBytes(key), opts).
+			Return(query(key), nil)
+		s.rpcClient.EXPECT().
+			ABCIQueryWithOptions(context.Background(), "/store/bank/key", tmbytes.HexBytes(missingKey), opts).
+			Return(query(missingKey), nil)
+	}).WithHeight(commitID.Version)
+
+	v, err := c.QueryStore(context.Background(), "bank", key)
+	require.NoError(t, err)
+	require.Equal(t, value, v.Value)
+	require.Equal(t, commitID.Version, v.Height)
+	require.NoError(t, cosmosclient.VerifyStoreValue(v, commitID.Hash))
+
+	// the proof doesn't match a modified value or another app hash.
+	tampered := v
+	tampered.Value = []byte("2000token")
+	require.Error(t, cosmosclient.VerifyStoreValue(tampered, commitID.Hash))
+	require.Error(t, cosmosclient.VerifyStoreValue(v, []byte("apphash")))
+
+	// the absence of a key is proven too.
+	v, err = c.QueryStore(context.Background(), "bank", missingKey)
+	require.NoError(t, err)
+	require.Empty(t, v.Value)
+	require.NoError(t, cosmosclient.VerifyStoreValue(v, commitID.Hash))
+
+	_, err = c.VerifiedQueryStore(context.Background(), "bank", key)
+	require.ErrorIs(t, err, cosmosclient.ErrNoLightClient)
+}
+
+func TestNewWithLightClientWithoutWitness(t *testing.T) {
+	const nodeAddress = "http://localhost:26657"
+	trust := light.TrustOptions{Period: time.Hour, Height: 1, Hash: make([]byte, 32)}
+
+	tests := []struct {
+		name      string
+		witnesses []string
+	}{
+		{
+			name: "no witnesses",
+		},
+		{
+			name:      "node as witness",
+			witnesses: []string{nodeAddress + "/"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rpc := mocks.NewRPCClient(t)
+			rpc.EXPECT().Status(mock.Anything).Return(&ctypes.ResultStatus{
+				NodeInfo: p2p.DefaultNodeInfo{Network: "mychain"},
+			}, nil)
+
+			_, err := cosmosclient.New(
+				context.Background(),
+				cosmosclient.WithNodeAddress(nodeAddress),
+				cosmosclient.WithRPCClient(rpc),
+				cosmosclient.WithKeyringBackend(cosmosaccount.KeyringMemory),
+				cosmosclient.WithLightClient(trust, tt.witnesses...),
+			)
+
+			require.ErrorIs(t, err, cosmosclient.ErrNoLightWitness)
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package cosmosclient
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+)
+
+// SignerData is the data of the account that signs a tx offline, it is known
+// by the signer instead of being queried from the node.
+type SignerData struct {
+	AccountNumber uint64
+	Sequence      uint64
+}
+
+// SignDoc returns the sign doc of the tx encoded in JSON for the signer, it is
+// the document signed by the accounts in legacy amino JSON sign mode and can
+// be reviewed before signing. The tx in JSON is created by a TxService of a
+// client with WithGenerateOnly.
+func (c Client) SignDoc(txJSON []byte, signer SignerData) ([]byte, error) {
+	defer c.lockBech32Prefix()()
+
+	txBuilder, err := c.decodeTxJSON(txJSON)
+	if err != nil {
+		return nil, err
+	}
+	signers := txBuilder.GetTx().GetSigners()
+	if len(signers) == 0 {
+		return nil, errors.New("the tx has no signers")
+	}
+
+	// the sign doc is the same for all the signers of the tx.
+	return c.context.TxConfig.SignModeHandler().GetSignBytes(
+		signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		authsigning.SignerData{
+			Address:       signers[0].String(),
+			ChainID:       c.chainID,
+			AccountNumber: signer.AccountNumber,
+			Sequence:      signer.Sequence,
+		},
+		txBuilder.GetTx(),
+	)
+}
+
+// SignOffline signs the tx encoded in JSON with the account, without querying
+// the node for the account number and the sequence of the account. It returns
+// the signed tx encoded in JSON, the signatures of the other signers of the tx
+// are kept.
+func (c Client) SignOffline(account cosmosaccount.Account, txJSON []byte, signer SignerData) ([]byte, error) {
+	defer c.lockBech32Prefix()()
+
+	txBuilder, err := c.decodeTxJSON(txJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	txf := c.TxFactory.
+		WithAccountNumber(signer.AccountNumber).
+		WithSequence(signer.Sequence)
+	if err := c.signer.Sign(txf, account.Name, txBuilder, false); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return c.context.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+}
+
+// SignWithMultisig signs the tx encoded in JSON with the account, a member of
+// the multisig account that signs the tx. It returns the signature of the
+// account encoded in JSON, the signatures of the members are aggregated with
+// AggregateMultisig.
+func (c Client) SignWithMultisig(account cosmosaccount.Account, txJSON []byte, multisigSigner SignerData) ([]byte, error) {
+	defer c.lockBech32Prefix()()
+
+	txBuilder, err := c.decodeTxJSON(txJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	// multisig accounts only support the legacy amino JSON sign mode.
+	txf := c.TxFactory.
+		WithAccountNumber(multisigSigner.AccountNumber).
+		WithSequence(multisigSigner.Sequence).
+		WithSignMode(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	if err := c.signer.Sign(txf, account.Name, txBuilder, true); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return c.context.TxConfig.MarshalSignatureJSON(sigs)
+}
+
+// AggregateMultisig verifies the signatures of the members of the multisig
+// account, encoded in JSON by SignWithMultisig, and aggregates them in the
+// signature of the multisig account. It returns the tx encoded in JSON signed
+// by the multisig account.
+func (c Client) AggregateMultisig(
+	multisigPubKey cryptotypes.PubKey,
+	txJSON []byte,
+	multisigSigner SignerData,
+	signaturesJSON ...[]byte,
+) ([]byte, error) {
+	defer c.lockBech32Prefix()()
+
+	pubKey, ok := multisigPubKey.(multisig.PubKey)
+	if !ok {
+		return nil, errors.Errorf("%s is not a multisig public key", multisigPubKey)
+	}
+
+	txBuilder, err := c.decodeTxJSON(txJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	multisigSig := multisig.NewMultisig(len(pubKey.GetPubKeys()))
+	for _, sigJSON := range signaturesJSON {
+		sigs, err := c.context.TxConfig.UnmarshalSignatureJSON(sigJSON)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		for _, sig := range sigs {
+			signerData := authsigning.SignerData{
+				Address:       sdktypes.AccAddress(sig.PubKey.Address()).String(),
+				ChainID:       c.chainID,
+				AccountNumber: multisigSigner.AccountNumber,
+				Sequence:      multisigSigner.Sequence,
+				PubKey:        sig.PubKey,
+			}
+			err := authsigning.VerifySignature(sig.PubKey, signerData, sig.Data, c.context.TxConfig.SignModeHandler(), txBuilder.GetTx())
+			if err != nil {
+				addr := sdktypes.AccAddress(sig.PubKey.Address())
+				return nil, errors.Wrapf(err, "invalid signature of %s", addr)
+			}
+
+			if err := multisig.AddSignatureV2(multisigSig, sig, pubKey.GetPubKeys()); err != nil {
+				return nil, errors.WithStack(err)
+			}
+		}
+	}
+
+	err = txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   pubKey,
+		Data:     multisigSig,
+		Sequence: multisigSigner.Sequence,
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return c.context.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+}
+
+// EncodeTx encodes the tx encoded in JSON to the bytes broadcasted to the
+// node.
+func (c Client) EncodeTx(txJSON []byte) ([]byte, error) {
+	txBuilder, err := c.decodeTxJSON(txJSON)
+	if err != nil {
+		return nil, err
+	}
+	return c.context.TxConfig.TxEncoder()(txBuilder.GetTx())
+}
+
+// BroadcastSigned broadcasts the signed tx encoded by EncodeTx. In sync
+// broadcast mode, it waits for the tx to be included in a block.
+func (c Client) BroadcastSigned(ctx context.Context, txBytes []byte) (Response, error) {
+	resp, err := c.context.BroadcastTx(txBytes)
+	if err := handleBroadcastResult(resp, err); err != nil {
+		return Response{}, err
+	}
+
+	s := TxService{
+		client:        c,
+		clientContext: c.context,
+	}
+	return s.response(ctx, resp)
+}
+
+// decodeTxJSON decodes the tx encoded in JSON in a tx builder.
+func (c Client) decodeTxJSON(txJSON []byte) (client.TxBuilder, error) {
+	tx, err := c.context.TxConfig.TxJSONDecoder()(txJSON)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid tx")
+	}
+	txBuilder, err := c.context.TxConfig.WrapTxBuilder(tx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return txBuilder, nil
+}
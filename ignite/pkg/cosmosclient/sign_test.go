@@ -0,0 +1,167 @@
+package cosmosclient_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/p2p"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/ignite/pkg/cosmosclient/mocks"
+)
+
+// newSigningClient returns a client that signs with the accounts of its
+// keyring, the accounts are created in the keyring.
+func newSigningClient(t *testing.T, rpc *mocks.RPCClient, names ...string) (cosmosclient.Client, []cosmosaccount.Account) {
+	rpc.EXPECT().String().Return("plop").Maybe()
+	rpc.EXPECT().Status(mock.Anything).
+		Return(&ctypes.ResultStatus{
+			NodeInfo: p2p.DefaultNodeInfo{Network: "mychain"},
+		}, nil).Once()
+	c, err := cosmosclient.New(context.Background(),
+		cosmosclient.WithKeyringBackend(cosmosaccount.KeyringMemory),
+		cosmosclient.WithRPCClient(rpc),
+	)
+	require.NoError(t, err)
+
+	var accounts []cosmosaccount.Account
+	for _, name := range names {
+		a, _, err := c.AccountRegistry.Create(name)
+		require.NoError(t, err)
+		accounts = append(accounts, a)
+	}
+	return c, accounts
+}
+
+// unsignedTxJSON returns the JSON of an unsigned tx sending tokens from the
+// address.
+func unsignedTxJSON(t *testing.T, c cosmosclient.Client, from sdktypes.AccAddress) []byte {
+	txBuilder := c.Context().TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(&banktypes.MsgSend{
+		FromAddress: from.String(),
+		ToAddress:   "cosmos1k8e50d2d8xkdfw9c4et3m45llh69e7xzw6uzga",
+		Amount:      sdktypes.NewCoins(sdktypes.NewInt64Coin("token", 1)),
+	}))
+	txBuilder.SetGasLimit(200000)
+	txJSON, err := c.Context().TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+	return txJSON
+}
+
+// verifyTx verifies the signature of the tx encoded in JSON by the public key.
+func verifyTx(c cosmosclient.Client, txJSON []byte, pubKey cryptotypes.PubKey, signer cosmosclient.SignerData) error {
+	tx, err := c.Context().TxConfig.TxJSONDecoder()(txJSON)
+	if err != nil {
+		return err
+	}
+	sigs, err := tx.(authsigning.SigVerifiableTx).GetSignaturesV2()
+	if err != nil {
+		return err
+	}
+	for _, sig := range sigs {
+		if !sig.PubKey.Equals(pubKey) {
+			continue
+		}
+		return authsigning.VerifySignature(pubKey, authsigning.SignerData{
+			Address:       sdktypes.AccAddress(pubKey.Address()).String(),
+			ChainID:       "mychain",
+			AccountNumber: signer.AccountNumber,
+			Sequence:      signer.Sequence,
+			PubKey:        pubKey,
+		}, sig.Data, c.Context().TxConfig.SignModeHandler(), tx)
+	}
+	return errors.New("no signature of the public key")
+}
+
+func TestClientSignOffline(t *testing.T) {
+	c, accounts := newSigningClient(t, mocks.NewRPCClient(t), "alice")
+	alice := accounts[0]
+	addr, err := alice.Record.GetAddress()
+	require.NoError(t, err)
+	pubKey, err := alice.Record.GetPubKey()
+	require.NoError(t, err)
+	signer := cosmosclient.SignerData{AccountNumber: 1, Sequence: 2}
+	txJSON := unsignedTxJSON(t, c, addr)
+
+	signDoc, err := c.SignDoc(txJSON, signer)
+	require.NoError(t, err)
+	require.Contains(t, string(signDoc), `"account_number":"1","chain_id":"mychain"`)
+	require.Contains(t, string(signDoc), `"sequence":"2"`)
+
+	signedJSON, err := c.SignOffline(alice, txJSON, signer)
+	require.NoError(t, err)
+	require.NoError(t, verifyTx(c, signedJSON, pubKey, signer))
+	require.Error(t, verifyTx(c, signedJSON, pubKey, cosmosclient.SignerData{AccountNumber: 2, Sequence: 2}))
+
+	_, err = c.SignOffline(alice, []byte("{"), signer)
+	require.ErrorContains(t, err, "invalid tx")
+}
+
+func TestClientSignWithMultisig(t *testing.T) {
+	c, accounts := newSigningClient(t, mocks.NewRPCClient(t), "alice", "bob", "carol")
+	var pubKeys []cryptotypes.PubKey
+	for _, a := range accounts {
+		pubKey, err := a.Record.GetPubKey()
+		require.NoError(t, err)
+		pubKeys = append(pubKeys, pubKey)
+	}
+	multisigPubKey := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+	signer := cosmosclient.SignerData{AccountNumber: 4, Sequence: 1}
+	txJSON := unsignedTxJSON(t, c, sdktypes.AccAddress(multisigPubKey.Address()))
+
+	aliceSig, err := c.SignWithMultisig(accounts[0], txJSON, signer)
+	require.NoError(t, err)
+	carolSig, err := c.SignWithMultisig(accounts[2], txJSON, signer)
+	require.NoError(t, err)
+
+	signedJSON, err := c.AggregateMultisig(multisigPubKey, txJSON, signer, aliceSig, carolSig)
+	require.NoError(t, err)
+	require.NoError(t, verifyTx(c, signedJSON, multisigPubKey, signer))
+
+	// the signatures of the members are verified before the aggregation.
+	bobSig, err := c.SignWithMultisig(accounts[1], txJSON, cosmosclient.SignerData{AccountNumber: 4, Sequence: 2})
+	require.NoError(t, err)
+	_, err = c.AggregateMultisig(multisigPubKey, txJSON, signer, aliceSig, bobSig)
+	require.ErrorContains(t, err, "invalid signature of")
+
+	_, err = c.AggregateMultisig(pubKeys[0], txJSON, signer, aliceSig)
+	require.ErrorContains(t, err, "is not a multisig public key")
+}
+
+func TestClientBroadcastSigned(t *testing.T) {
+	var (
+		rpc    = mocks.NewRPCClient(t)
+		txHash = []byte{1, 2, 3}
+	)
+	c, accounts := newSigningClient(t, rpc, "alice")
+	addr, err := accounts[0].Record.GetAddress()
+	require.NoError(t, err)
+	signer := cosmosclient.SignerData{AccountNumber: 1, Sequence: 2}
+	signedJSON, err := c.SignOffline(accounts[0], unsignedTxJSON(t, c, addr), signer)
+	require.NoError(t, err)
+	txBytes, err := c.EncodeTx(signedJSON)
+	require.NoError(t, err)
+
+	rpc.EXPECT().BroadcastTxSync(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, tx tmtypes.Tx) {
+			require.Equal(t, txBytes, []byte(tx))
+		}).
+		Return(&ctypes.ResultBroadcastTx{Hash: txHash}, nil)
+	rpc.EXPECT().Tx(mock.Anything, txHash, false).
+		Return(&ctypes.ResultTx{Hash: txHash}, nil)
+
+	res, err := c.BroadcastSigned(context.Background(), txBytes)
+	require.NoError(t, err)
+	require.Equal(t, "010203", res.TxHash)
+}
@@ -0,0 +1,25 @@
+package cosmosclient
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Delegations returns all the delegations of the delegator.
+func (c Client) Delegations(ctx context.Context, delegatorAddress string) ([]stakingtypes.DelegationResponse, error) {
+	defer c.lockBech32Prefix()()
+
+	queryClient := stakingtypes.NewQueryClient(c.context)
+	return Paginate(ctx, func(ctx context.Context, page *query.PageRequest) ([]stakingtypes.DelegationResponse, *query.PageResponse, error) {
+		res, err := queryClient.DelegatorDelegations(c.QueryContext(ctx), &stakingtypes.QueryDelegatorDelegationsRequest{
+			DelegatorAddr: delegatorAddress,
+			Pagination:    page,
+		})
+		if err != nil {
+			return nil, nil, rpcError(c.nodeAddress, err)
+		}
+		return res.DelegationResponses, res.Pagination, nil
+	})
+}
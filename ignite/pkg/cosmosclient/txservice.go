@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pkg/errors"
@@ -23,33 +24,54 @@ func (s TxService) Gas() uint64 {
 }
 
 // Broadcast signs and broadcasts this tx.
+// In sync broadcast mode, it waits for the tx to be included in a block.
 // If faucet is enabled and if the from account doesn't have enough funds, is
 // it automatically filled with the default amount, and the tx is broadcasted
 // again. Note that this may still end with the same error if the amount is
 // greater than the amount dumped by the faucet.
 func (s TxService) Broadcast(ctx context.Context) (Response, error) {
+	resp, err := s.broadcast()
+	if err := handleBroadcastResult(resp, err); err != nil {
+		return Response{}, err
+	}
+
+	return s.response(ctx, resp)
+}
+
+// broadcast signs and broadcasts this tx without waiting for its inclusion in
+// a block.
+func (s TxService) broadcast() (*sdktypes.TxResponse, error) {
 	defer s.client.lockBech32Prefix()()
 
 	// validate msgs.
 	for _, msg := range s.txBuilder.GetTx().GetMsgs() {
 		if err := msg.ValidateBasic(); err != nil {
-			return Response{}, errors.WithStack(err)
+			return nil, errors.WithStack(err)
 		}
 	}
 
 	accountName := s.clientContext.GetFromName()
 	if err := s.client.signer.Sign(s.txFactory, accountName, s.txBuilder, true); err != nil {
-		return Response{}, errors.WithStack(err)
+		return nil, errors.WithStack(err)
 	}
 
 	txBytes, err := s.clientContext.TxConfig.TxEncoder()(s.txBuilder.GetTx())
 	if err != nil {
-		return Response{}, errors.WithStack(err)
+		return nil, errors.WithStack(err)
 	}
 
-	resp, err := s.clientContext.BroadcastTx(txBytes)
-	if err := handleBroadcastResult(resp, err); err != nil {
-		return Response{}, err
+	return s.clientContext.BroadcastTx(txBytes)
+}
+
+// response returns the response of the broadcasted tx, it waits for the tx to
+// be included in a block in sync broadcast mode.
+func (s TxService) response(ctx context.Context, resp *sdktypes.TxResponse) (Response, error) {
+	if s.clientContext.BroadcastMode != flags.BroadcastSync {
+		// async txs are not checked yet and block txs are already included.
+		return Response{
+			Codec:      s.clientContext.Codec,
+			TxResponse: resp,
+		}, nil
 	}
 
 	res, err := s.client.WaitForTx(ctx, resp.TxHash)
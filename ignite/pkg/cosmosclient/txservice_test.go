@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -122,6 +123,29 @@ func TestTxServiceBroadcast(t *testing.T) {
 					}, nil)
 			},
 		},
+		{
+			name: "ok: async broadcast mode",
+			msg:  msg,
+			opts: []cosmosclient.Option{
+				cosmosclient.WithBroadcastMode(flags.BroadcastAsync),
+			},
+			expectedResponse: &sdktypes.TxResponse{
+				TxHash: txHashStr,
+			},
+
+			setup: func(s suite) {
+				s.expectPrepareFactory(sdkaddress)
+				s.signer.EXPECT().
+					Sign(mock.Anything, "bob", mock.Anything, true).
+					Return(nil)
+				// Tx is not waited for in async mode
+				s.rpcClient.EXPECT().
+					BroadcastTxAsync(mock.Anything, mock.Anything).
+					Return(&ctypes.ResultBroadcastTx{
+						Hash: txHash,
+					}, nil)
+			},
+		},
 		{
 			name:          "fail: tx confirmed with error code",
 			msg:           msg,
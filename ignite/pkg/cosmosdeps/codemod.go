@@ -0,0 +1,102 @@
+package cosmosdeps
+
+import (
+	"bytes"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// RewriteImports rewrites the Go import paths of all the Go files inside the
+// root directory. The rewrites map contains the import path prefixes to replace,
+// indexed by the old prefix. It returns the paths of the modified files.
+func RewriteImports(root string, rewrites map[string]string) (files []string, err error) {
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			// Skip vendored and hidden directories
+			name := d.Name()
+			if path != root && (name == "vendor" || strings.HasPrefix(name, ".")) {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if filepath.Ext(path) != ".go" {
+			return nil
+		}
+
+		changed, err := rewriteFileImports(path, rewrites)
+		if err != nil {
+			return err
+		}
+
+		if changed {
+			files = append(files, path)
+		}
+
+		return nil
+	})
+
+	return files, err
+}
+
+func rewriteFileImports(path string, rewrites map[string]string) (bool, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+	if err != nil {
+		return false, err
+	}
+
+	var changed bool
+	for _, imp := range f.Imports {
+		importPath, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			return false, err
+		}
+
+		if newPath, ok := rewriteImportPath(importPath, rewrites); ok {
+			imp.Path.Value = strconv.Quote(newPath)
+			changed = true
+		}
+	}
+
+	if !changed {
+		return false, nil
+	}
+
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, f); err != nil {
+		return false, err
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return false, err
+	}
+
+	return true, os.WriteFile(path, buf.Bytes(), info.Mode())
+}
+
+func rewriteImportPath(path string, rewrites map[string]string) (string, bool) {
+	for old, new := range rewrites {
+		if path == old {
+			return new, true
+		}
+
+		if strings.HasPrefix(path, old+"/") {
+			return new + strings.TrimPrefix(path, old), true
+		}
+	}
+
+	return path, false
+}
@@ -0,0 +1,221 @@
+// Package cosmosdeps provides the sets of dependency versions that are known to
+// be compatible with each Cosmos SDK release line, together with the source code
+// modifications required to move a chain from one release line to the next.
+package cosmosdeps
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	"golang.org/x/mod/module"
+)
+
+const (
+	PathCosmosSDK     = "github.com/cosmos/cosmos-sdk"
+	PathTendermint    = "github.com/tendermint/tendermint"
+	PathTendermintDB  = "github.com/tendermint/tm-db"
+	PathCometBFT      = "github.com/cometbft/cometbft"
+	PathCometBFTDB    = "github.com/cometbft/cometbft-db"
+	PathGogoProto     = "github.com/gogo/protobuf"
+	PathCosmosGogo    = "github.com/cosmos/gogoproto"
+	PathIBCGoV5       = "github.com/cosmos/ibc-go/v5"
+	PathIBCGoV6       = "github.com/cosmos/ibc-go/v6"
+	PathIBCGoV7       = "github.com/cosmos/ibc-go/v7"
+	PathRegenProtobuf = "github.com/regen-network/protobuf"
+)
+
+// Set is a group of dependency versions that are compatible with
+// a Cosmos SDK release line.
+type Set struct {
+	// Line is the Cosmos SDK release line, for example "v0.47".
+	Line string
+
+	// Require contains the module versions that must be required by the chain.
+	Require []module.Version
+
+	// Replace maps module paths from previous release lines to the module paths
+	// that replace them in this release line, for example the Tendermint module
+	// which is replaced by CometBFT.
+	Replace map[string]string
+
+	// DropReplace contains the module paths that must not be replaced anymore
+	// in the chain's go.mod file.
+	DropReplace []string
+
+	// ManualSteps contains the changes that must be applied manually to
+	// the chain's source code after upgrading to this release line.
+	ManualSteps []string
+}
+
+// SDKVersion returns the Cosmos SDK version required by the set.
+func (s Set) SDKVersion() string {
+	for _, r := range s.Require {
+		if r.Path == PathCosmosSDK {
+			return r.Version
+		}
+	}
+
+	return ""
+}
+
+// ImportRewrites returns the Go import path prefixes that must be rewritten
+// in the chain's source code, indexed by the old path.
+func (s Set) ImportRewrites() map[string]string {
+	rewrites := make(map[string]string, len(s.Replace))
+	for old, new := range s.Replace {
+		rewrites[old] = new
+	}
+
+	return rewrites
+}
+
+// Sets contains the known sets of compatible dependencies sorted by release line.
+var Sets = []Set{
+	{
+		Line: "v0.46",
+		Require: []module.Version{
+			{Path: PathCosmosSDK, Version: "v0.46.4"},
+			{Path: PathIBCGoV5, Version: "v5.0.1"},
+			{Path: PathTendermint, Version: "v0.34.22"},
+			{Path: PathTendermintDB, Version: "v0.6.7"},
+		},
+		ManualSteps: []string{
+			"Register the x/group and x/nft modules in app/app.go if the chain uses them",
+			"Migrate gov proposal handlers to the gov v1 message based proposals",
+		},
+	},
+	{
+		Line: "v0.47",
+		Require: []module.Version{
+			{Path: PathCosmosSDK, Version: "v0.47.0"},
+			{Path: PathIBCGoV7, Version: "v7.0.0"},
+			{Path: PathCometBFT, Version: "v0.37.0"},
+			{Path: PathCometBFTDB, Version: "v0.7.0"},
+			{Path: PathCosmosGogo, Version: "v1.4.6"},
+		},
+		Replace: map[string]string{
+			PathTendermint:   PathCometBFT,
+			PathTendermintDB: PathCometBFTDB,
+			PathGogoProto:    PathCosmosGogo,
+			PathIBCGoV5:      PathIBCGoV7,
+			PathIBCGoV6:      PathIBCGoV7,
+		},
+		DropReplace: []string{PathGogoProto},
+		ManualSteps: []string{
+			"Add the x/consensus module to app/app.go and migrate the consensus params out of x/params",
+			"Migrate custom module params to be self-managed by each module keeper",
+			"Register the 07-tendermint light client module with the IBC module basics",
+			"Replace usages of the removed github.com/cosmos/cosmos-sdk/simapp package",
+			"Regenerate the protobuf files with buf using github.com/cosmos/gogoproto",
+		},
+	},
+}
+
+// Find returns the set of compatible dependencies for a Cosmos SDK version.
+// The version can be a release line like "v0.47", a patch wildcard like
+// "v0.47.x" or an exact version like "v0.47.1" which overrides the SDK
+// version of the release line set.
+func Find(version string) (Set, error) {
+	version = strings.TrimSuffix(version, ".x")
+
+	line, exact, err := parseLine(version)
+	if err != nil {
+		return Set{}, err
+	}
+
+	for _, s := range Sets {
+		if s.Line != line {
+			continue
+		}
+
+		if exact == "" {
+			return s, nil
+		}
+
+		// Copy the requirements to avoid modifying the known sets
+		s.Require = append([]module.Version(nil), s.Require...)
+		for i, r := range s.Require {
+			if r.Path == PathCosmosSDK {
+				s.Require[i].Version = exact
+			}
+		}
+
+		return s, nil
+	}
+
+	return Set{}, fmt.Errorf("cosmos sdk %s is not supported, supported versions: %s", version, strings.Join(Lines(), ", "))
+}
+
+// Lines returns the supported Cosmos SDK release lines.
+func Lines() []string {
+	lines := make([]string, len(Sets))
+	for i, s := range Sets {
+		lines[i] = s.Line
+	}
+
+	sort.Strings(lines)
+
+	return lines
+}
+
+// Path returns the sets that must be applied in order to move a chain from
+// the release line of the current SDK version to the release line of target.
+func Path(current string, target Set) ([]Set, error) {
+	currentLine, _, err := parseLine(current)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		sets    []Set
+		started bool
+	)
+
+	for _, s := range Sets {
+		if s.Line == currentLine {
+			started = true
+			continue
+		}
+
+		if !started {
+			continue
+		}
+
+		if s.Line == target.Line {
+			return append(sets, target), nil
+		}
+
+		sets = append(sets, s)
+	}
+
+	if currentLine == target.Line {
+		return []Set{target}, nil
+	}
+
+	return nil, fmt.Errorf("cannot upgrade from cosmos sdk %s to %s", current, target.Line)
+}
+
+// parseLine parses a version returning its release line and, when the
+// version includes the patch number, the exact version.
+func parseLine(version string) (line, exact string, err error) {
+	v := strings.TrimPrefix(version, "v")
+
+	parts := strings.Split(v, ".")
+	if len(parts) == 2 {
+		v += ".0"
+	}
+
+	sv, err := semver.Parse(v)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid cosmos sdk version %q: %w", version, err)
+	}
+
+	line = fmt.Sprintf("v%d.%d", sv.Major, sv.Minor)
+	if len(parts) > 2 {
+		exact = "v" + v
+	}
+
+	return line, exact, nil
+}
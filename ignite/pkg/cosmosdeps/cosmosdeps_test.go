@@ -0,0 +1,161 @@
+package cosmosdeps_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/mod/modfile"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosdeps"
+)
+
+func TestFind(t *testing.T) {
+	cases := []struct {
+		name    string
+		version string
+		line    string
+		sdk     string
+		wantErr bool
+	}{
+		{
+			name:    "release line",
+			version: "v0.47",
+			line:    "v0.47",
+			sdk:     "v0.47.0",
+		},
+		{
+			name:    "patch wildcard",
+			version: "v0.47.x",
+			line:    "v0.47",
+			sdk:     "v0.47.0",
+		},
+		{
+			name:    "exact version",
+			version: "v0.47.2",
+			line:    "v0.47",
+			sdk:     "v0.47.2",
+		},
+		{
+			name:    "unsupported version",
+			version: "v0.40.x",
+			wantErr: true,
+		},
+		{
+			name:    "invalid version",
+			version: "latest",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := cosmosdeps.Find(tt.version)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.line, s.Line)
+			require.Equal(t, tt.sdk, s.SDKVersion())
+		})
+	}
+
+	// The known sets must not be modified when an exact version is requested
+	s, err := cosmosdeps.Find("v0.47")
+	require.NoError(t, err)
+	require.Equal(t, "v0.47.0", s.SDKVersion())
+}
+
+func TestPath(t *testing.T) {
+	target, err := cosmosdeps.Find("v0.47.x")
+	require.NoError(t, err)
+
+	sets, err := cosmosdeps.Path("v0.46.4", target)
+	require.NoError(t, err)
+	require.Len(t, sets, 1)
+	require.Equal(t, "v0.47", sets[0].Line)
+
+	sets, err = cosmosdeps.Path("v0.47.0", target)
+	require.NoError(t, err)
+	require.Len(t, sets, 1)
+
+	older, err := cosmosdeps.Find("v0.46")
+	require.NoError(t, err)
+
+	_, err = cosmosdeps.Path("v0.47.0", older)
+	require.Error(t, err)
+}
+
+func TestUpdateGoMod(t *testing.T) {
+	gomod := `module github.com/foo/bar
+
+go 1.18
+
+require (
+	github.com/cosmos/cosmos-sdk v0.46.4
+	github.com/cosmos/ibc-go/v5 v5.0.1
+	github.com/tendermint/tendermint v0.34.22
+	github.com/spf13/cobra v1.6.1
+)
+
+replace github.com/gogo/protobuf => github.com/regen-network/protobuf v1.3.3-alpha.regen.1
+`
+	f, err := modfile.Parse("go.mod", []byte(gomod), nil)
+	require.NoError(t, err)
+
+	s, err := cosmosdeps.Find("v0.47.x")
+	require.NoError(t, err)
+
+	changes, err := s.UpdateGoMod(f)
+	require.NoError(t, err)
+	require.NotEmpty(t, changes)
+
+	required := make(map[string]string)
+	for _, r := range f.Require {
+		required[r.Mod.Path] = r.Mod.Version
+	}
+
+	require.Equal(t, map[string]string{
+		"github.com/cosmos/cosmos-sdk": "v0.47.0",
+		"github.com/cosmos/ibc-go/v7":  "v7.0.0",
+		"github.com/cometbft/cometbft": "v0.37.0",
+		"github.com/spf13/cobra":       "v1.6.1",
+	}, required)
+	require.Empty(t, f.Replace)
+}
+
+func TestRewriteImports(t *testing.T) {
+	dir := t.TempDir()
+	src := `package app
+
+import (
+	// Tendermint types
+	tmtypes "github.com/tendermint/tendermint/types"
+	"github.com/tendermint/tendermint-other"
+	ibc "github.com/cosmos/ibc-go/v5/modules/core"
+)
+
+var _ tmtypes.Block
+`
+	path := filepath.Join(dir, "app.go")
+	require.NoError(t, os.WriteFile(path, []byte(src), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "vendor"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "vendor", "a.go"), []byte(src), 0o644))
+
+	files, err := cosmosdeps.RewriteImports(dir, map[string]string{
+		"github.com/tendermint/tendermint": "github.com/cometbft/cometbft",
+		"github.com/cosmos/ibc-go/v5":      "github.com/cosmos/ibc-go/v7",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{path}, files)
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(content), `// Tendermint types`)
+	require.Contains(t, string(content), `tmtypes "github.com/cometbft/cometbft/types"`)
+	require.Contains(t, string(content), `"github.com/tendermint/tendermint-other"`)
+	require.Contains(t, string(content), `ibc "github.com/cosmos/ibc-go/v7/modules/core"`)
+}
@@ -0,0 +1,74 @@
+package cosmosdeps
+
+import (
+	"fmt"
+
+	"golang.org/x/mod/modfile"
+)
+
+// UpdateGoMod updates the requirements of a go.mod file to match the set.
+// Only the modules already required by the go.mod file, or modules replacing
+// them, are added so the chain doesn't end up with unused requirements.
+// It returns a description of each change applied to the file.
+func (s Set) UpdateGoMod(f *modfile.File) (changes []string, err error) {
+	required := make(map[string]string)
+	for _, r := range f.Require {
+		required[r.Mod.Path] = r.Mod.Version
+	}
+
+	// Drop the modules that are replaced by new ones in the set
+	for old, new := range s.Replace {
+		if _, ok := required[old]; !ok {
+			continue
+		}
+
+		if err := f.DropRequire(old); err != nil {
+			return nil, err
+		}
+
+		delete(required, old)
+		if _, ok := required[new]; !ok {
+			required[new] = ""
+		}
+		changes = append(changes, fmt.Sprintf("%s replaced by %s", old, new))
+	}
+
+	for _, path := range s.DropReplace {
+		for _, r := range f.Replace {
+			if r.Old.Path != path {
+				continue
+			}
+
+			if err := f.DropReplace(r.Old.Path, r.Old.Version); err != nil {
+				return nil, err
+			}
+
+			changes = append(changes, fmt.Sprintf("replace directive for %s removed", path))
+		}
+	}
+
+	for _, r := range s.Require {
+		version, ok := required[r.Path]
+		if !ok && r.Path != PathCosmosSDK {
+			continue
+		}
+
+		if version == r.Version {
+			continue
+		}
+
+		if err := f.AddRequire(r.Path, r.Version); err != nil {
+			return nil, err
+		}
+
+		if version == "" {
+			changes = append(changes, fmt.Sprintf("%s %s added", r.Path, r.Version))
+		} else {
+			changes = append(changes, fmt.Sprintf("%s updated from %s to %s", r.Path, version, r.Version))
+		}
+	}
+
+	f.Cleanup()
+
+	return changes, nil
+}
@@ -0,0 +1,156 @@
+package cosmosfaucet
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+var (
+	// ErrInvalidAddress is returned when a transfer request has an address
+	// that is not a bech32 address of the chain.
+	ErrInvalidAddress = errors.New("invalid address")
+
+	// ErrAccessDenied is returned when the address or the client IP of a
+	// transfer request is denied, or not allowed by the allowlist.
+	ErrAccessDenied = errors.New("access denied")
+)
+
+// AddressPrefix sets the bech32 prefix of the addresses of the chain, the
+// requests for addresses with another prefix are rejected.
+func AddressPrefix(prefix string) Option {
+	return func(f *Faucet) {
+		f.addressPrefix = prefix
+	}
+}
+
+// WithAccessLists configures the addresses and the client IPs allowed and
+// denied by the faucet. When the allowlist has addresses, only its addresses
+// can request coins, and when it has IPs, only its IPs can send requests.
+func WithAccessLists(allow, deny AccessList) Option {
+	return func(f *Faucet) {
+		f.allowlist = allow
+		f.denylist = deny
+	}
+}
+
+// AccessList is a list of addresses and of client IPs or networks.
+type AccessList struct {
+	addresses map[string]bool
+	networks  []*net.IPNet
+}
+
+// ParseAccessList parses a list with an entry per line: an address, an IP or
+// a network in CIDR notation like "10.0.0.0/8". The empty lines and the
+// comments starting with "#" are ignored.
+func ParseAccessList(r io.Reader) (AccessList, error) {
+	l := AccessList{addresses: make(map[string]bool)}
+
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		entry := scanner.Text()
+		if i := strings.Index(entry, "#"); i >= 0 {
+			entry = entry[:i]
+		}
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		if _, network, err := net.ParseCIDR(entry); err == nil {
+			l.networks = append(l.networks, network)
+			continue
+		}
+		if ip := net.ParseIP(entry); ip != nil {
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			l.networks = append(l.networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		if _, _, err := bech32.DecodeAndConvert(entry); err != nil {
+			return AccessList{}, fmt.Errorf("line %d: %q is not an address, an IP or a CIDR", n, entry)
+		}
+		l.addresses[entry] = true
+	}
+
+	return l, scanner.Err()
+}
+
+// LoadAccessList reads the access list of the file at path.
+func LoadAccessList(path string) (AccessList, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return AccessList{}, err
+	}
+	defer f.Close()
+
+	l, err := ParseAccessList(f)
+	if err != nil {
+		return AccessList{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return l, nil
+}
+
+// hasAddress returns true when the address is in the list.
+func (l AccessList) hasAddress(address string) bool {
+	return l.addresses[address]
+}
+
+// hasIP returns true when the IP is in a network of the list.
+func (l AccessList) hasIP(ip string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, network := range l.networks {
+		if network.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkIP returns the reason and the error when the client IP can't send
+// transfer requests.
+func (f Faucet) checkIP(ip string) (RejectReason, error) {
+	if f.denylist.hasIP(ip) {
+		return RejectDenied, fmt.Errorf("%w: the client IP is denied", ErrAccessDenied)
+	}
+	if len(f.allowlist.networks) > 0 && !f.allowlist.hasIP(ip) {
+		return RejectNotAllowed, fmt.Errorf("%w: the client IP is not allowed", ErrAccessDenied)
+	}
+	return "", nil
+}
+
+// checkAddress returns the reason and the error when coins can't be sent to
+// the address.
+func (f Faucet) checkAddress(address string) (RejectReason, error) {
+	prefix, _, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return RejectInvalidAddress, fmt.Errorf("%w: %s", ErrInvalidAddress, err)
+	}
+	if f.addressPrefix != "" && prefix != f.addressPrefix {
+		return RejectInvalidAddress, fmt.Errorf(
+			"%w: the address prefix must be %q instead of %q",
+			ErrInvalidAddress,
+			f.addressPrefix,
+			prefix,
+		)
+	}
+
+	if f.denylist.hasAddress(address) {
+		return RejectDenied, fmt.Errorf("%w: the address is denied", ErrAccessDenied)
+	}
+	if len(f.allowlist.addresses) > 0 && !f.allowlist.hasAddress(address) {
+		return RejectNotAllowed, fmt.Errorf("%w: the address is not allowed", ErrAccessDenied)
+	}
+	return "", nil
+}
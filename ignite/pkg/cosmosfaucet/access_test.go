@@ -0,0 +1,118 @@
+package cosmosfaucet
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	alice = "cosmos1uzv4v9g9xln2qx2vtqhz99yxum33calja5vruz"
+	bob   = "cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj"
+	mars  = "mars1c6ac48k2ur8tl3tf0cpntlw5068kvp8xf4xq37"
+)
+
+func TestParseAccessList(t *testing.T) {
+	l, err := ParseAccessList(strings.NewReader(`
+# team addresses
+` + alice + `
+10.0.0.0/8   # office
+192.168.1.7
+2001:db8::1
+`))
+	require.NoError(t, err)
+
+	require.True(t, l.hasAddress(alice))
+	require.False(t, l.hasAddress(bob))
+	require.True(t, l.hasIP("10.1.2.3"))
+	require.True(t, l.hasIP("192.168.1.7"))
+	require.False(t, l.hasIP("192.168.1.8"))
+	require.True(t, l.hasIP("2001:db8::1"))
+	require.False(t, l.hasIP("invalid"))
+
+	_, err = ParseAccessList(strings.NewReader("10.0.0.0/8\nnot-an-address"))
+	require.EqualError(t, err, `line 2: "not-an-address" is not an address, an IP or a CIDR`)
+}
+
+func TestServeHTTPAccess(t *testing.T) {
+	parse := func(list string) AccessList {
+		l, err := ParseAccessList(strings.NewReader(list))
+		require.NoError(t, err)
+		return l
+	}
+
+	f := Faucet{coinsMax: make(map[string]uint64)}
+	AddressPrefix("cosmos")(&f)
+	WithAccessLists(parse(alice+"\n"+bob+"\n10.0.0.0/8"), parse(bob+"\n10.0.0.66"))(&f)
+
+	cases := []struct {
+		name, address, ip string
+		status            int
+		code              RejectReason
+	}{
+		{
+			name:    "invalid address",
+			address: "cosmos1invalid",
+			ip:      "10.0.0.1",
+			status:  http.StatusBadRequest,
+			code:    RejectInvalidAddress,
+		},
+		{
+			name:    "address of another chain",
+			address: mars,
+			ip:      "10.0.0.1",
+			status:  http.StatusBadRequest,
+			code:    RejectInvalidAddress,
+		},
+		{
+			name:    "denied address",
+			address: bob,
+			ip:      "10.0.0.1",
+			status:  http.StatusForbidden,
+			code:    RejectDenied,
+		},
+		{
+			name:    "denied IP",
+			address: alice,
+			ip:      "10.0.0.66",
+			status:  http.StatusForbidden,
+			code:    RejectDenied,
+		},
+		{
+			name:    "IP not allowed",
+			address: alice,
+			ip:      "172.16.0.1",
+			status:  http.StatusForbidden,
+			code:    RejectNotAllowed,
+		},
+		{
+			// the request passes the access checks and fails on its coins
+			name:    "allowed",
+			address: alice,
+			ip:      "10.0.0.1",
+			status:  http.StatusBadRequest,
+			code:    RejectInvalidRequest,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			body := fmt.Sprintf(`{"address":%q,"denoms":["foo"]}`, tt.address)
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+			req.RemoteAddr = tt.ip + ":1234"
+
+			res := httptest.NewRecorder()
+			f.ServeHTTP(res, req)
+			require.Equal(t, tt.status, res.Code)
+
+			var out TransferResponse
+			require.NoError(t, json.NewDecoder(res.Body).Decode(&out))
+			require.Equal(t, tt.code, out.Code)
+		})
+	}
+}
@@ -0,0 +1,301 @@
+package cosmosfaucet
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultAlertInterval is the default time between the checks of the alerts.
+	DefaultAlertInterval = time.Minute
+
+	// alertMinRequests is the min number of requests between two checks to
+	// compute their error rate, so a few failures don't fire an alert.
+	alertMinRequests = 10
+
+	// webhookTimeout is the time to send an alert to a webhook.
+	webhookTimeout = time.Second * 10
+)
+
+// WebhookKind is the payload format of a webhook.
+type WebhookKind string
+
+const (
+	// WebhookGeneric receives the alerts as JSON Alert objects.
+	WebhookGeneric WebhookKind = "generic"
+
+	// WebhookSlack is a Slack incoming webhook.
+	WebhookSlack WebhookKind = "slack"
+
+	// WebhookDiscord is a Discord webhook.
+	WebhookDiscord WebhookKind = "discord"
+)
+
+// Webhook is an endpoint notified of the alerts of the faucet.
+type Webhook struct {
+	URL  string
+	Kind WebhookKind
+}
+
+// Alerts configures the alerts of the faucet, so the operators learn about a
+// drained or a failing faucet before its users do.
+type Alerts struct {
+	// Webhooks are notified when an alert fires and when it is resolved.
+	Webhooks []Webhook
+
+	// MinBalances are the balances of the faucet account under which an alert
+	// fires, by denom.
+	MinBalances sdk.Coins
+
+	// MaxErrorRate is the ratio of the requests that fail to be sent between
+	// two checks above which an alert fires, there is no alert when zero.
+	MaxErrorRate float64
+
+	// Interval is the time between the checks, DefaultAlertInterval when zero.
+	Interval time.Duration
+}
+
+// AlertKind is the kind of an alert.
+type AlertKind string
+
+const (
+	// AlertLowBalance fires when a balance of the faucet account is under its
+	// min balance.
+	AlertLowBalance AlertKind = "low_balance"
+
+	// AlertErrorRate fires when too many requests fail to be sent.
+	AlertErrorRate AlertKind = "error_rate"
+)
+
+// Alert is the payload of the generic webhooks.
+type Alert struct {
+	Kind AlertKind `json:"kind"`
+
+	// Resolved is true when the alert is resolved, like when the faucet
+	// account is refilled.
+	Resolved bool `json:"resolved"`
+
+	ChainID string    `json:"chain_id"`
+	Message string    `json:"message"`
+	Time    time.Time `json:"time"`
+
+	// Denom, Balance and MinBalance are set by the low balance alerts.
+	Denom      string `json:"denom,omitempty"`
+	Balance    string `json:"balance,omitempty"`
+	MinBalance string `json:"min_balance,omitempty"`
+
+	// ErrorRate is set by the error rate alerts.
+	ErrorRate float64 `json:"error_rate,omitempty"`
+}
+
+// WithAlerts checks the balances of the faucet account and the error rate of
+// the requests every interval, and notifies the webhooks when an alert fires
+// or is resolved. The alerts are checked by Faucet.MonitorAlerts.
+func WithAlerts(alerts Alerts) Option {
+	return func(f *Faucet) {
+		if len(alerts.Webhooks) == 0 || (alerts.MinBalances.Empty() && alerts.MaxErrorRate <= 0) {
+			return
+		}
+		if alerts.Interval <= 0 {
+			alerts.Interval = DefaultAlertInterval
+		}
+		f.alerter = &alerter{
+			Alerts:     alerts,
+			httpClient: http.DefaultClient,
+			now:        time.Now,
+			lowBalance: make(map[string]bool),
+		}
+	}
+}
+
+// alerter fires the alerts of a faucet.
+type alerter struct {
+	Alerts
+
+	httpClient *http.Client
+	now        func() time.Time
+
+	mu sync.Mutex
+	// requests and failures are the numbers of requests and of the requests
+	// that failed to be sent since the last check.
+	requests, failures int
+
+	// lowBalance are the denoms with a low balance alert, and highErrorRate
+	// is true when the error rate alert fires, so an alert is notified once.
+	lowBalance    map[string]bool
+	highErrorRate bool
+}
+
+// record records a request and whether it failed to be sent.
+func (a *alerter) record(failed bool) {
+	if a == nil {
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.requests++
+	if failed {
+		a.failures++
+	}
+}
+
+// checkBalances returns the low balance alerts fired or resolved by the
+// balances of the faucet account.
+func (a *alerter) checkBalances(chainID string, balances sdk.Coins) []Alert {
+	var alerts []Alert
+	for _, min := range a.MinBalances {
+		balance := sdk.NewCoin(min.Denom, balances.AmountOf(min.Denom))
+		low := balance.Amount.LT(min.Amount)
+		if low == a.lowBalance[min.Denom] {
+			continue
+		}
+		a.lowBalance[min.Denom] = low
+
+		alert := Alert{
+			Kind:       AlertLowBalance,
+			Resolved:   !low,
+			ChainID:    chainID,
+			Time:       a.now().UTC(),
+			Denom:      min.Denom,
+			Balance:    balance.String(),
+			MinBalance: min.String(),
+		}
+		if low {
+			alert.Message = fmt.Sprintf("Faucet of chain %s is low on %s: its balance is %s, under %s", chainID, min.Denom, balance, min)
+		} else {
+			alert.Message = fmt.Sprintf("Faucet of chain %s is refilled with %s: its balance is %s", chainID, min.Denom, balance)
+		}
+		alerts = append(alerts, alert)
+	}
+	return alerts
+}
+
+// checkErrorRate returns the error rate alert fired or resolved by the
+// requests since the last check.
+func (a *alerter) checkErrorRate(chainID string) []Alert {
+	a.mu.Lock()
+	requests, failures := a.requests, a.failures
+	a.requests, a.failures = 0, 0
+	a.mu.Unlock()
+
+	if a.MaxErrorRate <= 0 || requests < alertMinRequests {
+		return nil
+	}
+
+	rate := float64(failures) / float64(requests)
+	high := rate > a.MaxErrorRate
+	if high == a.highErrorRate {
+		return nil
+	}
+	a.highErrorRate = high
+
+	alert := Alert{
+		Kind:      AlertErrorRate,
+		Resolved:  !high,
+		ChainID:   chainID,
+		Time:      a.now().UTC(),
+		ErrorRate: rate,
+	}
+	if high {
+		alert.Message = fmt.Sprintf(
+			"Faucet of chain %s failed to send %d of %d requests (%.0f%%) in the last %s",
+			chainID, failures, requests, rate*100, a.Interval,
+		)
+	} else {
+		alert.Message = fmt.Sprintf("Faucet of chain %s failed to send %.0f%% of the requests in the last %s", chainID, rate*100, a.Interval)
+	}
+	return []Alert{alert}
+}
+
+// send sends the alert to a webhook in its payload format.
+func (a *alerter) send(ctx context.Context, w Webhook, alert Alert) error {
+	var payload interface{}
+	switch w.Kind {
+	case WebhookSlack:
+		payload = map[string]string{"text": alert.Message}
+	case WebhookDiscord:
+		payload = map[string]string{"content": alert.Message}
+	default:
+		payload = alert
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := a.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("alert webhook %s responded %s", req.URL.Host, res.Status)
+	}
+	return nil
+}
+
+// MonitorAlerts checks the alerts of the faucet every interval until the
+// context is canceled, it returns at once when the faucet has no alerts.
+func (f Faucet) MonitorAlerts(ctx context.Context) error {
+	if f.alerter == nil {
+		return nil
+	}
+
+	ticker := time.NewTicker(f.alerter.Interval)
+	defer ticker.Stop()
+
+	for {
+		f.checkAlerts(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkAlerts checks the alerts of the faucet and notifies the webhooks of
+// the alerts fired or resolved.
+func (f Faucet) checkAlerts(ctx context.Context) {
+	alerts := f.alerter.checkErrorRate(f.chainID)
+
+	if !f.alerter.MinBalances.Empty() {
+		balancesCtx, cancel := context.WithTimeout(ctx, balancesTimeout)
+		balances, err := f.accountBalances(balancesCtx)
+		cancel()
+		if err != nil {
+			f.logger.log(logEntry{Level: "error", Msg: "faucet balances can't be checked", Error: err.Error()})
+		} else {
+			alerts = append(alerts, f.alerter.checkBalances(f.chainID, balances)...)
+		}
+	}
+
+	for _, alert := range alerts {
+		for _, w := range f.alerter.Webhooks {
+			if err := f.alerter.send(ctx, w, alert); err != nil {
+				f.logger.log(logEntry{Level: "error", Msg: "alert can't be sent", Error: err.Error()})
+			}
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlerterBalances(t *testing.T) {
+	f := Faucet{}
+	WithAlerts(Alerts{
+		Webhooks:    []Webhook{{URL: "http://localhost"}},
+		MinBalances: sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("token", 100)),
+	})(&f)
+	a := f.alerter
+
+	// The alerts fire once when the balance gets low
+	alerts := a.checkBalances("mars", sdk.NewCoins(sdk.NewInt64Coin("stake", 50), sdk.NewInt64Coin("token", 99)))
+	require.Len(t, alerts, 1)
+	require.Equal(t, AlertLowBalance, alerts[0].Kind)
+	require.False(t, alerts[0].Resolved)
+	require.Equal(t, "token", alerts[0].Denom)
+	require.Equal(t, "99token", alerts[0].Balance)
+	require.Equal(t, "100token", alerts[0].MinBalance)
+
+	require.Empty(t, a.checkBalances("mars", sdk.NewCoins(sdk.NewInt64Coin("stake", 50))))
+
+	// A missing balance is an empty balance
+	alerts = a.checkBalances("mars", sdk.NewCoins(sdk.NewInt64Coin("token", 1000)))
+	require.Len(t, alerts, 2)
+	require.Equal(t, "stake", alerts[0].Denom)
+	require.False(t, alerts[0].Resolved)
+	require.Equal(t, "token", alerts[1].Denom)
+	require.True(t, alerts[1].Resolved)
+}
+
+func TestAlerterErrorRate(t *testing.T) {
+	f := Faucet{}
+	WithAlerts(Alerts{
+		Webhooks:     []Webhook{{URL: "http://localhost"}},
+		MaxErrorRate: 0.5,
+	})(&f)
+	a := f.alerter
+
+	record := func(requests, failures int) {
+		for i := 0; i < requests; i++ {
+			a.record(i < failures)
+		}
+	}
+
+	// A few failures don't fire an alert
+	record(5, 5)
+	require.Empty(t, a.checkErrorRate("mars"))
+
+	record(10, 6)
+	alerts := a.checkErrorRate("mars")
+	require.Len(t, alerts, 1)
+	require.Equal(t, AlertErrorRate, alerts[0].Kind)
+	require.False(t, alerts[0].Resolved)
+	require.Equal(t, 0.6, alerts[0].ErrorRate)
+
+	record(10, 7)
+	require.Empty(t, a.checkErrorRate("mars"))
+
+	record(10, 1)
+	alerts = a.checkErrorRate("mars")
+	require.Len(t, alerts, 1)
+	require.True(t, alerts[0].Resolved)
+}
+
+func TestMonitorAlerts(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		payloads = make(map[string]map[string]interface{})
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+
+		mu.Lock()
+		defer mu.Unlock()
+		payloads[r.URL.Path] = payload
+	}))
+	defer server.Close()
+
+	f := Faucet{chainID: "mars"}
+	WithAlerts(Alerts{
+		Webhooks: []Webhook{
+			{URL: server.URL + "/generic"},
+			{URL: server.URL + "/slack", Kind: WebhookSlack},
+			{URL: server.URL + "/discord", Kind: WebhookDiscord},
+		},
+		MaxErrorRate: 0.1,
+		Interval:     time.Hour,
+	})(&f)
+	for i := 0; i < alertMinRequests; i++ {
+		f.alerter.record(true)
+	}
+
+	// The alerts are checked when the monitor starts
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*500)
+	defer cancel()
+	require.NoError(t, f.MonitorAlerts(ctx))
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	message := "Faucet of chain mars failed to send 10 of 10 requests (100%) in the last 1h0m0s"
+	require.Equal(t, message, payloads["/slack"]["text"])
+	require.Equal(t, message, payloads["/discord"]["content"])
+	require.Equal(t, message, payloads["/generic"]["message"])
+	require.Equal(t, string(AlertErrorRate), payloads["/generic"]["kind"])
+	require.Equal(t, "mars", payloads["/generic"]["chain_id"])
+}
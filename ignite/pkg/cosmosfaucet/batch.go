@@ -0,0 +1,228 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+)
+
+const (
+	// DefaultBatchMaxSize is the default max number of transfers sent in a
+	// single multi-send tx.
+	DefaultBatchMaxSize = 50
+
+	// batchTimeout is the time to send a batch and to confirm its tx.
+	batchTimeout = time.Minute
+)
+
+// ErrTransferPending is returned when a transfer to an address is requested
+// while another transfer to the address is not sent yet.
+var ErrTransferPending = errors.New("a transfer to the address is already pending")
+
+// WithBatching queues the transfers and sends them every interval in a single
+// multi-send tx of at most maxSize transfers, so the bursts of requests don't
+// contend for the sequence of the faucet account and pay a single fee. A batch
+// is sent as soon as it is full. The transfers are sent one by one when the
+// interval is zero.
+func WithBatching(interval time.Duration, maxSize int) Option {
+	return func(f *Faucet) {
+		if interval <= 0 {
+			return
+		}
+		if maxSize <= 0 {
+			maxSize = DefaultBatchMaxSize
+		}
+		f.batcher = &batcher{
+			interval: interval,
+			maxSize:  maxSize,
+			full:     make(chan struct{}, 1),
+			pending:  make(map[string]bool),
+		}
+	}
+}
+
+// batchedTransfer is a transfer queued in a batch, its result is sent once its
+// batch is sent.
+type batchedTransfer struct {
+	address string
+	coins   sdk.Coins
+	result  chan error
+}
+
+// batcher queues the transfers of a faucet and sends them in batches.
+type batcher struct {
+	interval time.Duration
+	maxSize  int
+
+	// send sends a batch of transfers.
+	send func(context.Context, []*batchedTransfer) error
+
+	// full is signaled when the queue holds a full batch.
+	full chan struct{}
+
+	mu      sync.Mutex
+	queue   []*batchedTransfer
+	running bool
+	// pending are the addresses of the transfers queued or being sent, with
+	// the total of their coins.
+	pending      map[string]bool
+	pendingCoins sdk.Coins
+}
+
+// enqueue queues a transfer and returns the channel of its result. The batches
+// are sent by a goroutine that runs until the queue is empty.
+func (b *batcher) enqueue(address string, coins sdk.Coins) (<-chan error, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.pending[address] {
+		return nil, ErrTransferPending
+	}
+	b.pending[address] = true
+	b.pendingCoins = b.pendingCoins.Add(coins...)
+
+	t := &batchedTransfer{
+		address: address,
+		coins:   coins,
+		result:  make(chan error, 1),
+	}
+	b.queue = append(b.queue, t)
+
+	if len(b.queue) >= b.maxSize {
+		select {
+		case b.full <- struct{}{}:
+		default:
+		}
+	}
+	if !b.running {
+		b.running = true
+		go b.run()
+	}
+
+	return t.result, nil
+}
+
+// pendingTotal returns the total of the coins of the transfers not sent yet.
+func (b *batcher) pendingTotal() sdk.Coins {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.pendingCoins
+}
+
+// run sends the batches of the queue until it is empty.
+func (b *batcher) run() {
+	timer := time.NewTimer(b.interval)
+	defer timer.Stop()
+
+	for {
+		// wait for the interval unless a batch is full.
+		b.mu.Lock()
+		isFull := len(b.queue) >= b.maxSize
+		b.mu.Unlock()
+		if !isFull {
+			select {
+			case <-timer.C:
+			case <-b.full:
+			}
+		}
+
+		b.mu.Lock()
+		batch := b.queue
+		if len(batch) > b.maxSize {
+			batch = batch[:b.maxSize]
+		}
+		b.queue = b.queue[len(batch):]
+		b.mu.Unlock()
+
+		ctx, cancel := context.WithTimeout(context.Background(), batchTimeout)
+		err := b.send(ctx, batch)
+		cancel()
+
+		b.mu.Lock()
+		for _, t := range batch {
+			t.result <- err
+			delete(b.pending, t.address)
+			b.pendingCoins = b.pendingCoins.Sub(t.coins...)
+		}
+		if len(b.queue) == 0 {
+			b.running = false
+			b.mu.Unlock()
+			return
+		}
+		b.mu.Unlock()
+
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		timer.Reset(b.interval)
+	}
+}
+
+// transferBatched checks and queues a transfer, and waits until its batch is sent.
+func (f *Faucet) transferBatched(ctx context.Context, toAccountAddress string, coins sdk.Coins) error {
+	result, err := func() (<-chan error, error) {
+		transferMutex.Lock()
+		defer transferMutex.Unlock()
+
+		if err := f.checkTransfer(ctx, toAccountAddress, coins, f.batcher.pendingTotal()); err != nil {
+			return nil, err
+		}
+		return f.batcher.enqueue(toAccountAddress, coins)
+	}()
+	if err != nil {
+		return err
+	}
+
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// sendBatch sends the transfers of a batch in a single multi-send tx from the
+// faucet account and records them in the limits of the faucet.
+func (f Faucet) sendBatch(ctx context.Context, transfers []*batchedTransfer) error {
+	fromAccount, err := f.runner.ShowAccount(ctx, f.accountName)
+	if err != nil {
+		return err
+	}
+
+	outputs := make([]chaincmdrunner.BankOutput, 0, len(transfers))
+	for _, t := range transfers {
+		outputs = append(outputs, chaincmdrunner.BankOutput{
+			Address: t.address,
+			Coins:   t.coins,
+		})
+	}
+
+	txHash, err := f.runner.BankMultiSend(ctx, fromAccount, outputs)
+	if err != nil {
+		return err
+	}
+
+	// wait for the multi-send tx to be confirmed
+	if err := f.runner.WaitTx(ctx, txHash, time.Second, 30); err != nil {
+		return err
+	}
+
+	if f.limits != nil {
+		for _, t := range transfers {
+			if err := f.limits.recordTransfer(t.address, t.coins); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBatcher(maxSize int, send func(context.Context, []*batchedTransfer) error) *batcher {
+	f := Faucet{}
+	WithBatching(time.Millisecond*20, maxSize)(&f)
+	f.batcher.send = send
+	return f.batcher
+}
+
+func TestBatcher(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		batches [][]string
+		coins   = sdk.NewCoins(sdk.NewInt64Coin("token", 10))
+		release = make(chan struct{})
+	)
+	b := newTestBatcher(2, func(_ context.Context, transfers []*batchedTransfer) error {
+		<-release
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		var addresses []string
+		for _, t := range transfers {
+			addresses = append(addresses, t.address)
+		}
+		batches = append(batches, addresses)
+		return nil
+	})
+
+	var results []<-chan error
+	for _, address := range []string{"alice", "bob", "carol"} {
+		result, err := b.enqueue(address, coins)
+		require.NoError(t, err)
+		results = append(results, result)
+	}
+	require.Equal(t, "30token", b.pendingTotal().String())
+
+	// A transfer to a pending address is rejected
+	_, err := b.enqueue("alice", coins)
+	require.ErrorIs(t, err, ErrTransferPending)
+
+	close(release)
+	for _, result := range results {
+		require.NoError(t, <-result)
+	}
+	require.Equal(t, [][]string{{"alice", "bob"}, {"carol"}}, batches)
+	require.True(t, b.pendingTotal().IsZero())
+
+	// The address can be sent coins again once its transfer is sent
+	result, err := b.enqueue("alice", coins)
+	require.NoError(t, err)
+	require.NoError(t, <-result)
+}
+
+func TestBatcherError(t *testing.T) {
+	errSend := errors.New("account sequence mismatch")
+	b := newTestBatcher(10, func(context.Context, []*batchedTransfer) error {
+		return errSend
+	})
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("token", 10))
+	alice, err := b.enqueue("alice", coins)
+	require.NoError(t, err)
+	bob, err := b.enqueue("bob", coins)
+	require.NoError(t, err)
+
+	// Each transfer of the batch gets the result of the batch
+	require.ErrorIs(t, <-alice, errSend)
+	require.ErrorIs(t, <-bob, errSend)
+}
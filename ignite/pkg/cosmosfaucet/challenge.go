@@ -0,0 +1,254 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/bits"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CaptchaProvider is a CAPTCHA service that verifies the tokens of the clients.
+type CaptchaProvider string
+
+const (
+	// CaptchaHCaptcha is the hCaptcha service.
+	CaptchaHCaptcha CaptchaProvider = "hcaptcha"
+
+	// CaptchaTurnstile is the Cloudflare Turnstile service.
+	CaptchaTurnstile CaptchaProvider = "turnstile"
+)
+
+// captchaVerifyURLs are the endpoints that verify the tokens by provider.
+var captchaVerifyURLs = map[CaptchaProvider]string{
+	CaptchaHCaptcha:  "https://hcaptcha.com/siteverify",
+	CaptchaTurnstile: "https://challenges.cloudflare.com/turnstile/v0/siteverify",
+}
+
+// challengeTTL is the time to solve a proof-of-work challenge.
+const challengeTTL = time.Minute * 5
+
+// ErrChallengeFailed is returned when a request doesn't solve the challenge of the faucet.
+var ErrChallengeFailed = errors.New("faucet challenge failed")
+
+// Challenge configures the challenges that the clients must solve before the
+// faucet sends them coins, to keep the bots from draining public faucets.
+type Challenge struct {
+	// Captcha is the CAPTCHA service that verifies the token sent with the
+	// requests, the requests are not verified when empty.
+	Captcha CaptchaProvider
+
+	// CaptchaSiteKey is the public site key rendered by the CAPTCHA widget of
+	// the frontends.
+	CaptchaSiteKey string
+
+	// CaptchaSecret is the secret key used to verify the tokens.
+	CaptchaSecret string
+
+	// PoWDifficulty is the number of leading zero bits of the SHA-256 hash of a
+	// proof-of-work challenge followed by its nonce, the requests don't need a
+	// proof-of-work when zero.
+	PoWDifficulty int
+}
+
+// WithChallenge configures the challenges that the clients must solve before
+// the faucet sends them coins.
+func WithChallenge(challenge Challenge) Option {
+	return func(f *Faucet) {
+		if challenge.Captcha == "" && challenge.PoWDifficulty <= 0 {
+			return
+		}
+		f.challenger = &challenger{
+			Challenge:  challenge,
+			verifyURL:  captchaVerifyURLs[challenge.Captcha],
+			httpClient: http.DefaultClient,
+			now:        time.Now,
+			issued:     make(map[string]time.Time),
+		}
+	}
+}
+
+// ChallengeResponse is the challenge payload of the faucet.
+type ChallengeResponse struct {
+	// Captcha is the CAPTCHA service of the faucet, empty when the requests
+	// don't need a CAPTCHA token.
+	Captcha CaptchaProvider `json:"captcha,omitempty"`
+
+	// CaptchaSiteKey is the site key of the CAPTCHA widget.
+	CaptchaSiteKey string `json:"captcha_site_key,omitempty"`
+
+	// Challenge is the proof-of-work challenge to solve, empty when the
+	// requests don't need a proof-of-work.
+	Challenge string `json:"challenge,omitempty"`
+
+	// Difficulty is the number of leading zero bits of the SHA-256 hash of the
+	// challenge followed by the nonce.
+	Difficulty int `json:"difficulty,omitempty"`
+
+	// ExpiresAt is the time until the challenge can be solved.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// challenger verifies that the requests solve the challenges of a faucet.
+type challenger struct {
+	Challenge
+
+	verifyURL  string
+	httpClient *http.Client
+	now        func() time.Time
+
+	mu sync.Mutex
+	// issued are the expiry times of the proof-of-work challenges not solved yet.
+	issued map[string]time.Time
+}
+
+// newChallenge returns the challenge of a client, with a new proof-of-work
+// challenge when the faucet requires one.
+func (c *challenger) newChallenge() (ChallengeResponse, error) {
+	res := ChallengeResponse{
+		Captcha:        c.Captcha,
+		CaptchaSiteKey: c.CaptchaSiteKey,
+	}
+	if c.PoWDifficulty <= 0 {
+		return res, nil
+	}
+
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ChallengeResponse{}, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	c.prune(now)
+
+	expiresAt := now.Add(challengeTTL)
+	res.Challenge = hex.EncodeToString(b)
+	res.Difficulty = c.PoWDifficulty
+	res.ExpiresAt = &expiresAt
+	c.issued[res.Challenge] = expiresAt
+
+	return res, nil
+}
+
+// prune removes the expired challenges.
+func (c *challenger) prune(now time.Time) {
+	for challenge, expiresAt := range c.issued {
+		if !now.Before(expiresAt) {
+			delete(c.issued, challenge)
+		}
+	}
+}
+
+// verify returns an error when the request of the client IP doesn't solve the
+// challenges of the faucet.
+func (c *challenger) verify(ctx context.Context, req TransferRequest, ip string) error {
+	if c.PoWDifficulty > 0 {
+		if err := c.verifyProofOfWork(req.Challenge, req.Nonce); err != nil {
+			return err
+		}
+	}
+	if c.Captcha != "" {
+		return c.verifyCaptcha(ctx, req.CaptchaToken, ip)
+	}
+	return nil
+}
+
+// verifyProofOfWork verifies the nonce of an issued challenge, a challenge can
+// only be solved once.
+func (c *challenger) verifyProofOfWork(challenge, nonce string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.prune(c.now())
+
+	if _, ok := c.issued[challenge]; !ok {
+		return fmt.Errorf("%w: unknown or expired proof-of-work challenge", ErrChallengeFailed)
+	}
+	if leadingZeroBits(challenge, nonce) < c.PoWDifficulty {
+		return fmt.Errorf("%w: invalid proof-of-work nonce", ErrChallengeFailed)
+	}
+	delete(c.issued, challenge)
+
+	return nil
+}
+
+// verifyCaptcha verifies the CAPTCHA token with the CAPTCHA service.
+func (c *challenger) verifyCaptcha(ctx context.Context, token, ip string) error {
+	if token == "" {
+		return fmt.Errorf("%w: missing CAPTCHA token", ErrChallengeFailed)
+	}
+
+	form := url.Values{
+		"secret":   {c.CaptchaSecret},
+		"response": {token},
+	}
+	if ip != "" {
+		form.Set("remoteip", ip)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.verifyURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("verify CAPTCHA token: %w", err)
+	}
+	defer res.Body.Close()
+
+	var out struct {
+		Success    bool     `json:"success"`
+		ErrorCodes []string `json:"error-codes"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return fmt.Errorf("verify CAPTCHA token: %w", err)
+	}
+	if !out.Success {
+		if len(out.ErrorCodes) > 0 {
+			return fmt.Errorf("%w: invalid CAPTCHA token (%s)", ErrChallengeFailed, strings.Join(out.ErrorCodes, ", "))
+		}
+		return fmt.Errorf("%w: invalid CAPTCHA token", ErrChallengeFailed)
+	}
+
+	return nil
+}
+
+// SolveProofOfWork returns the first nonce that solves the proof-of-work
+// challenge with the difficulty. The nonces are decimal numbers.
+func SolveProofOfWork(challenge string, difficulty int) string {
+	for i := 0; ; i++ {
+		nonce := strconv.Itoa(i)
+		if leadingZeroBits(challenge, nonce) >= difficulty {
+			return nonce
+		}
+	}
+}
+
+// leadingZeroBits returns the number of leading zero bits of the SHA-256 hash
+// of the challenge followed by the nonce.
+func leadingZeroBits(challenge, nonce string) int {
+	hash := sha256.Sum256([]byte(challenge + nonce))
+
+	var n int
+	for _, b := range hash {
+		if b != 0 {
+			return n + bits.LeadingZeros8(b)
+		}
+		n += 8
+	}
+	return n
+}
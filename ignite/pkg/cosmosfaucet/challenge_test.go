@@ -0,0 +1,92 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestChallenger(challenge Challenge, now *time.Time) *challenger {
+	var f Faucet
+	WithChallenge(challenge)(&f)
+	f.challenger.now = func() time.Time { return *now }
+	return f.challenger
+}
+
+func TestChallengerProofOfWork(t *testing.T) {
+	var (
+		now = time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC)
+		c   = newTestChallenger(Challenge{PoWDifficulty: 8}, &now)
+	)
+
+	res, err := c.newChallenge()
+	require.NoError(t, err)
+	require.Len(t, res.Challenge, 32)
+	require.Equal(t, 8, res.Difficulty)
+	require.Equal(t, now.Add(challengeTTL), *res.ExpiresAt)
+
+	nonce := SolveProofOfWork(res.Challenge, 8)
+	require.GreaterOrEqual(t, leadingZeroBits(res.Challenge, nonce), 8)
+
+	// A nonce that doesn't solve the challenge
+	invalid := "invalid"
+	for leadingZeroBits(res.Challenge, invalid) >= 8 {
+		invalid += "x"
+	}
+	err = c.verify(context.Background(), TransferRequest{Challenge: res.Challenge, Nonce: invalid}, "")
+	require.ErrorIs(t, err, ErrChallengeFailed)
+
+	req := TransferRequest{Challenge: res.Challenge, Nonce: nonce}
+	require.NoError(t, c.verify(context.Background(), req, ""))
+
+	// A challenge is only solved once
+	require.ErrorIs(t, c.verify(context.Background(), req, ""), ErrChallengeFailed)
+
+	// An expired challenge can't be solved
+	res, err = c.newChallenge()
+	require.NoError(t, err)
+	now = now.Add(challengeTTL)
+	req = TransferRequest{Challenge: res.Challenge, Nonce: SolveProofOfWork(res.Challenge, 8)}
+	require.ErrorIs(t, c.verify(context.Background(), req, ""), ErrChallengeFailed)
+	require.Empty(t, c.issued)
+}
+
+func TestChallengerCaptcha(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "secret", r.PostForm.Get("secret"))
+		require.Equal(t, "1.2.3.4", r.PostForm.Get("remoteip"))
+		if r.PostForm.Get("response") == "valid" {
+			w.Write([]byte(`{"success":true}`))
+			return
+		}
+		w.Write([]byte(`{"success":false,"error-codes":["invalid-input-response"]}`))
+	}))
+	defer server.Close()
+
+	var (
+		now = time.Now()
+		c   = newTestChallenger(Challenge{Captcha: CaptchaTurnstile, CaptchaSecret: "secret"}, &now)
+		ctx = context.Background()
+	)
+	c.verifyURL = server.URL
+
+	require.NoError(t, c.verify(ctx, TransferRequest{CaptchaToken: "valid"}, "1.2.3.4"))
+
+	err := c.verify(ctx, TransferRequest{CaptchaToken: "invalid"}, "1.2.3.4")
+	require.ErrorIs(t, err, ErrChallengeFailed)
+	require.EqualError(t, err, "faucet challenge failed: invalid CAPTCHA token (invalid-input-response)")
+
+	require.ErrorIs(t, c.verify(ctx, TransferRequest{}, "1.2.3.4"), ErrChallengeFailed)
+
+	// The errors of the CAPTCHA service are not challenge failures
+	server.Close()
+	err = c.verify(ctx, TransferRequest{CaptchaToken: "valid"}, "1.2.3.4")
+	require.Error(t, err)
+	require.False(t, errors.Is(err, ErrChallengeFailed))
+}
@@ -57,6 +57,39 @@ type Faucet struct {
 
 	limitRefreshWindow time.Duration
 
+	// limits enforces the limits of the faucet, it is nil when the faucet has no limits.
+	limits *limiter
+
+	// challenger verifies the challenges of the requests, it is nil when the
+	// requests don't need to solve a challenge.
+	challenger *challenger
+
+	// addressPrefix is the bech32 prefix of the addresses of the chain, the
+	// prefix of the addresses is not checked when empty.
+	addressPrefix string
+
+	// allowlist and denylist are the addresses and the client IPs allowed
+	// and denied by the faucet.
+	allowlist, denylist AccessList
+
+	// batcher sends the transfers in batches, it is nil when the transfers are
+	// sent one by one.
+	batcher *batcher
+
+	// alerter fires the alerts of the faucet, it is nil when the faucet has no
+	// alerts.
+	alerter *alerter
+
+	// metrics are the Prometheus metrics of the faucet.
+	metrics *metrics
+
+	// logger writes the structured logs of the requests, it is nil when the
+	// requests are not logged.
+	logger *logger
+
+	// ui serves the web page of the faucet at its root when true.
+	ui bool
+
 	// openAPIData holds template data customizations for serving OpenAPI page & spec.
 	openAPIData openAPIData
 }
@@ -129,6 +162,18 @@ func New(ctx context.Context, ccr chaincmdrunner.Runner, options ...Option) (Fau
 		RefreshWindow(DefaultRefreshWindow)(&f)
 	}
 
+	f.metrics = newMetrics(f.accountBalances)
+
+	if f.batcher != nil {
+		f.batcher.send = f.sendBatch
+	}
+
+	if f.limits != nil {
+		if err := f.limits.load(); err != nil {
+			return Faucet{}, err
+		}
+	}
+
 	// import the account if mnemonic is provided.
 	if f.accountMnemonic != "" {
 		_, err := f.runner.AddAccount(ctx, f.accountName, f.accountMnemonic, f.coinType)
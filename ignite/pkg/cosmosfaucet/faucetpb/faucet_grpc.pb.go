@@ -0,0 +1,189 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.20.0
+// source: ignite/faucet/v1/faucet.proto
+
+package faucetpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// FaucetClient is the client API for Faucet service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FaucetClient interface {
+	// Transfer sends coins to an account. The rejected requests fail with an
+	// ErrorInfo detail of domain "faucet.ignite.com" whose reason is the code
+	// of the rejection, like "rate_limited" or "invalid_address".
+	Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferResponse, error)
+	// Info returns the chain of the faucet and the coins it distributes.
+	Info(ctx context.Context, in *InfoRequest, opts ...grpc.CallOption) (*InfoResponse, error)
+	// Challenge returns the challenges to solve before requesting coins, with a
+	// new proof-of-work challenge when the faucet requires one.
+	Challenge(ctx context.Context, in *ChallengeRequest, opts ...grpc.CallOption) (*ChallengeResponse, error)
+}
+
+type faucetClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFaucetClient(cc grpc.ClientConnInterface) FaucetClient {
+	return &faucetClient{cc}
+}
+
+func (c *faucetClient) Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferResponse, error) {
+	out := new(TransferResponse)
+	err := c.cc.Invoke(ctx, "/ignite.faucet.v1.Faucet/Transfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faucetClient) Info(ctx context.Context, in *InfoRequest, opts ...grpc.CallOption) (*InfoResponse, error) {
+	out := new(InfoResponse)
+	err := c.cc.Invoke(ctx, "/ignite.faucet.v1.Faucet/Info", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faucetClient) Challenge(ctx context.Context, in *ChallengeRequest, opts ...grpc.CallOption) (*ChallengeResponse, error) {
+	out := new(ChallengeResponse)
+	err := c.cc.Invoke(ctx, "/ignite.faucet.v1.Faucet/Challenge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FaucetServer is the server API for Faucet service.
+// All implementations must embed UnimplementedFaucetServer
+// for forward compatibility
+type FaucetServer interface {
+	// Transfer sends coins to an account. The rejected requests fail with an
+	// ErrorInfo detail of domain "faucet.ignite.com" whose reason is the code
+	// of the rejection, like "rate_limited" or "invalid_address".
+	Transfer(context.Context, *TransferRequest) (*TransferResponse, error)
+	// Info returns the chain of the faucet and the coins it distributes.
+	Info(context.Context, *InfoRequest) (*InfoResponse, error)
+	// Challenge returns the challenges to solve before requesting coins, with a
+	// new proof-of-work challenge when the faucet requires one.
+	Challenge(context.Context, *ChallengeRequest) (*ChallengeResponse, error)
+	mustEmbedUnimplementedFaucetServer()
+}
+
+// UnimplementedFaucetServer must be embedded to have forward compatible implementations.
+type UnimplementedFaucetServer struct {
+}
+
+func (UnimplementedFaucetServer) Transfer(context.Context, *TransferRequest) (*TransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
+}
+func (UnimplementedFaucetServer) Info(context.Context, *InfoRequest) (*InfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Info not implemented")
+}
+func (UnimplementedFaucetServer) Challenge(context.Context, *ChallengeRequest) (*ChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Challenge not implemented")
+}
+func (UnimplementedFaucetServer) mustEmbedUnimplementedFaucetServer() {}
+
+// UnsafeFaucetServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FaucetServer will
+// result in compilation errors.
+type UnsafeFaucetServer interface {
+	mustEmbedUnimplementedFaucetServer()
+}
+
+func RegisterFaucetServer(s grpc.ServiceRegistrar, srv FaucetServer) {
+	s.RegisterService(&Faucet_ServiceDesc, srv)
+}
+
+func _Faucet_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaucetServer).Transfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ignite.faucet.v1.Faucet/Transfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaucetServer).Transfer(ctx, req.(*TransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Faucet_Info_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaucetServer).Info(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ignite.faucet.v1.Faucet/Info",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaucetServer).Info(ctx, req.(*InfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Faucet_Challenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaucetServer).Challenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ignite.faucet.v1.Faucet/Challenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaucetServer).Challenge(ctx, req.(*ChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Faucet_ServiceDesc is the grpc.ServiceDesc for Faucet service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Faucet_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ignite.faucet.v1.Faucet",
+	HandlerType: (*FaucetServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Transfer",
+			Handler:    _Faucet_Transfer_Handler,
+		},
+		{
+			MethodName: "Info",
+			Handler:    _Faucet_Info_Handler,
+		},
+		{
+			MethodName: "Challenge",
+			Handler:    _Faucet_Challenge_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ignite/faucet/v1/faucet.proto",
+}
@@ -0,0 +1,171 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosfaucet/faucetpb"
+)
+
+// GRPCErrorDomain is the domain of the ErrorInfo details of the rejected gRPC
+// transfer requests, their reason is the code of the rejection.
+const GRPCErrorDomain = "faucet.ignite.com"
+
+// grpcCodes are the gRPC codes of the HTTP statuses of the rejections.
+var grpcCodes = map[int]codes.Code{
+	http.StatusBadRequest:          codes.InvalidArgument,
+	http.StatusForbidden:           codes.PermissionDenied,
+	http.StatusTooManyRequests:     codes.ResourceExhausted,
+	http.StatusInternalServerError: codes.Internal,
+}
+
+// grpcServer is the gRPC service of a faucet.
+type grpcServer struct {
+	faucetpb.UnimplementedFaucetServer
+
+	f Faucet
+}
+
+// RegisterGRPC registers the gRPC service of the faucet to s.
+func (f Faucet) RegisterGRPC(s *grpc.Server) {
+	faucetpb.RegisterFaucetServer(s, grpcServer{f: f})
+}
+
+// ServeGRPC serves the gRPC service of the faucet at address until the
+// context is canceled, with the server reflection for the clients like grpcurl.
+func (f Faucet) ServeGRPC(ctx context.Context, address string) error {
+	l, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+
+	s := grpc.NewServer()
+	f.RegisterGRPC(s)
+	reflection.Register(s)
+
+	go func() {
+		<-ctx.Done()
+		s.GracefulStop()
+	}()
+
+	if err := s.Serve(l); !errors.Is(err, grpc.ErrServerStopped) {
+		return err
+	}
+	return nil
+}
+
+// Transfer implements faucetpb.FaucetServer.
+func (s grpcServer) Transfer(ctx context.Context, req *faucetpb.TransferRequest) (*faucetpb.TransferResponse, error) {
+	coins, rej := s.f.handleTransfer(ctx, peerIP(ctx), func(r *TransferRequest) error {
+		*r = TransferRequest{
+			AccountAddress: req.Address,
+			Coins:          req.Coins,
+			Denoms:         req.Denoms,
+			CaptchaToken:   req.CaptchaToken,
+			Challenge:      req.Challenge,
+			Nonce:          req.Nonce,
+		}
+		return nil
+	})
+	if rej != nil {
+		return nil, rej.grpcStatus().Err()
+	}
+
+	res := &faucetpb.TransferResponse{}
+	for _, c := range coins {
+		res.Coins = append(res.Coins, c.String())
+	}
+	return res, nil
+}
+
+// Info implements faucetpb.FaucetServer.
+func (s grpcServer) Info(context.Context, *faucetpb.InfoRequest) (*faucetpb.InfoResponse, error) {
+	info := s.f.info()
+
+	res := &faucetpb.InfoResponse{
+		IsAFaucet: info.IsAFaucet,
+		ChainId:   info.ChainID,
+	}
+	for _, c := range info.Coins {
+		res.Coins = append(res.Coins, &faucetpb.Coin{
+			Denom:     c.Denom,
+			Amount:    c.Amount,
+			MaxAmount: c.MaxAmount,
+		})
+	}
+	return res, nil
+}
+
+// Challenge implements faucetpb.FaucetServer.
+func (s grpcServer) Challenge(context.Context, *faucetpb.ChallengeRequest) (*faucetpb.ChallengeResponse, error) {
+	challenge, err := s.f.challenge()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &faucetpb.ChallengeResponse{
+		Captcha:        string(challenge.Captcha),
+		CaptchaSiteKey: challenge.CaptchaSiteKey,
+		Challenge:      challenge.Challenge,
+		Difficulty:     int32(challenge.Difficulty),
+	}
+	if challenge.ExpiresAt != nil {
+		res.ExpiresAt = timestamppb.New(*challenge.ExpiresAt)
+	}
+	return res, nil
+}
+
+// grpcStatus returns the gRPC status of the rejection, with the code of the
+// rejection in an ErrorInfo detail and the time to wait before the next
+// request in a RetryInfo detail when the client is rate limited.
+func (r rejection) grpcStatus() *status.Status {
+	if r.reason == RejectCanceled {
+		return status.New(codes.Canceled, r.err.Error())
+	}
+
+	code, ok := grpcCodes[r.status]
+	if !ok {
+		code = codes.Unknown
+	}
+
+	st := status.New(code, r.err.Error())
+	info := &errdetails.ErrorInfo{
+		Reason: string(r.reason),
+		Domain: GRPCErrorDomain,
+	}
+
+	var err error
+	if r.retryAfter > 0 {
+		st, err = st.WithDetails(info, &errdetails.RetryInfo{RetryDelay: durationpb.New(r.retryAfter)})
+	} else {
+		st, err = st.WithDetails(info)
+	}
+	if err != nil {
+		return status.New(code, r.err.Error())
+	}
+	return st
+}
+
+// peerIP returns the IP of the client of a gRPC request.
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
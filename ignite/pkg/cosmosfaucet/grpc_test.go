@@ -0,0 +1,78 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosfaucet/faucetpb"
+)
+
+func newTestGRPCClient(t *testing.T, f Faucet) faucetpb.FaucetClient {
+	l := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	f.RegisterGRPC(s)
+	go s.Serve(l)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.Dial(
+		"bufconn",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return l.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return faucetpb.NewFaucetClient(conn)
+}
+
+func TestGRPC(t *testing.T) {
+	f := Faucet{chainID: "mars", coinsMax: make(map[string]uint64)}
+	Coin(10, 100, "token")(&f)
+	AddressPrefix("cosmos")(&f)
+	WithChallenge(Challenge{PoWDifficulty: 8})(&f)
+
+	client := newTestGRPCClient(t, f)
+	ctx := context.Background()
+
+	info, err := client.Info(ctx, &faucetpb.InfoRequest{})
+	require.NoError(t, err)
+	require.True(t, info.IsAFaucet)
+	require.Equal(t, "mars", info.ChainId)
+	require.Len(t, info.Coins, 1)
+	require.Equal(t, "token", info.Coins[0].Denom)
+	require.Equal(t, "10", info.Coins[0].Amount)
+	require.Equal(t, "100", info.Coins[0].MaxAmount)
+
+	challenge, err := client.Challenge(ctx, &faucetpb.ChallengeRequest{})
+	require.NoError(t, err)
+	require.NotEmpty(t, challenge.Challenge)
+	require.EqualValues(t, 8, challenge.Difficulty)
+	require.NotNil(t, challenge.ExpiresAt)
+
+	// The rejected requests have the code of the rejection as reason
+	_, err = client.Transfer(ctx, &faucetpb.TransferRequest{
+		Address:   "cosmos1invalid",
+		Challenge: challenge.Challenge,
+		Nonce:     SolveProofOfWork(challenge.Challenge, int(challenge.Difficulty)),
+	})
+	st := status.Convert(err)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+
+	errInfo, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	require.Equal(t, GRPCErrorDomain, errInfo.Domain)
+	require.Equal(t, string(RejectInvalidAddress), errInfo.Reason)
+
+	_, err = client.Transfer(ctx, &faucetpb.TransferRequest{Address: alice})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -23,8 +23,28 @@ func (f Faucet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		Methods(http.MethodGet, http.MethodOptions)
 
 	router.
-		HandleFunc("/", openapiconsole.Handler("Faucet", "openapi.yml")).
-		Methods(http.MethodGet)
+		Handle("/challenge", cors.Default().Handler(http.HandlerFunc(f.faucetChallengeHandler))).
+		Methods(http.MethodGet, http.MethodOptions)
+
+	if f.metrics != nil {
+		router.
+			Handle("/metrics", f.metrics.handler()).
+			Methods(http.MethodGet)
+	}
+
+	if f.ui {
+		router.
+			HandleFunc("/", f.uiHandler).
+			Methods(http.MethodGet)
+
+		router.
+			HandleFunc(consolePath, openapiconsole.Handler("Faucet", "openapi.yml")).
+			Methods(http.MethodGet)
+	} else {
+		router.
+			HandleFunc("/", openapiconsole.Handler("Faucet", "openapi.yml")).
+			Methods(http.MethodGet)
+	}
 
 	router.
 		HandleFunc("/openapi.yml", f.openAPISpecHandler).
@@ -1,15 +1,23 @@
 package cosmosfaucet
 
 import (
-	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
 	"net/http"
+	"strconv"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/ignite/cli/ignite/pkg/xhttp"
 )
 
+// ErrDenomNotDistributed is returned when a transfer request asks for a denom
+// that is not distributed by the faucet.
+var ErrDenomNotDistributed = errors.New("denom not distributed by the faucet")
+
 type TransferRequest struct {
 	// AccountAddress to request for coins.
 	AccountAddress string `json:"address"`
@@ -17,6 +25,21 @@ type TransferRequest struct {
 	// Coins that are requested.
 	// default ones used when this one isn't provided.
 	Coins []string `json:"coins"`
+
+	// Denoms are the denoms of the coins requested, the configured amounts of
+	// the denoms are sent. It can't be used with Coins.
+	Denoms []string `json:"denoms,omitempty"`
+
+	// CaptchaToken is the token of the CAPTCHA solved by the client, required
+	// when the faucet has a CAPTCHA.
+	CaptchaToken string `json:"captcha_token,omitempty"`
+
+	// Challenge is the proof-of-work challenge solved by the client, required
+	// when the faucet has a proof-of-work.
+	Challenge string `json:"challenge,omitempty"`
+
+	// Nonce is the solution of the proof-of-work challenge.
+	Nonce string `json:"nonce,omitempty"`
 }
 
 func NewTransferRequest(accountAddress string, coins []string) TransferRequest {
@@ -27,34 +50,33 @@ func NewTransferRequest(accountAddress string, coins []string) TransferRequest {
 }
 
 type TransferResponse struct {
+	// Coins are the coins sent to the account.
+	Coins []string `json:"coins,omitempty"`
+
 	Error string `json:"error,omitempty"`
+
+	// Code is the reason why the request is rejected, so the frontends can
+	// show friendly messages.
+	Code RejectReason `json:"code,omitempty"`
 }
 
 func (f Faucet) faucetHandler(w http.ResponseWriter, r *http.Request) {
-	var req TransferRequest
-
-	// decode request into req.
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		responseError(w, http.StatusBadRequest, err)
+	coins, rej := f.handleTransfer(r.Context(), xhttp.ClientIP(r), func(req *TransferRequest) error {
+		return json.NewDecoder(r.Body).Decode(req)
+	})
+	if rej == nil {
+		responseSuccess(w, coins)
 		return
 	}
 
-	// determine coins to transfer.
-	coins, err := f.coinsFromRequest(req)
-	if err != nil {
-		responseError(w, http.StatusBadRequest, err)
+	// the client is gone, there is no one to respond to.
+	if rej.reason == RejectCanceled {
 		return
 	}
-
-	// try performing the transfer
-	if err := f.Transfer(r.Context(), req.AccountAddress, coins); err != nil {
-		if err == context.Canceled {
-			return
-		}
-		responseError(w, http.StatusInternalServerError, err)
-	} else {
-		responseSuccess(w)
+	if rej.retryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(rej.retryAfter.Seconds()))))
 	}
+	responseError(w, rej.status, rej.reason, rej.err)
 }
 
 // FaucetInfoResponse is the faucet info payload.
@@ -65,39 +87,140 @@ type FaucetInfoResponse struct {
 
 	// ChainID is chain id of the chain that faucet is running for.
 	ChainID string `json:"chain_id"`
+
+	// Coins are the coins distributed by the faucet.
+	Coins []FaucetCoin `json:"coins"`
+}
+
+// FaucetCoin is a coin distributed by the faucet.
+type FaucetCoin struct {
+	// Denom is the denom of the coin, like "uatom" or an "ibc/..." denom.
+	Denom string `json:"denom"`
+
+	// Amount is the max amount sent per request.
+	Amount string `json:"amount"`
+
+	// MaxAmount is the max amount sent to an account, zero when unlimited.
+	MaxAmount string `json:"max_amount"`
 }
 
 func (f Faucet) faucetInfoHandler(w http.ResponseWriter, r *http.Request) {
-	xhttp.ResponseJSON(w, http.StatusOK, FaucetInfoResponse{
+	xhttp.ResponseJSON(w, http.StatusOK, f.info())
+}
+
+func (f Faucet) faucetChallengeHandler(w http.ResponseWriter, r *http.Request) {
+	res, err := f.challenge()
+	if err != nil {
+		responseError(w, http.StatusInternalServerError, "", err)
+		return
+	}
+
+	xhttp.ResponseJSON(w, http.StatusOK, res)
+}
+
+// info returns the info of the faucet.
+func (f Faucet) info() FaucetInfoResponse {
+	coins := make([]FaucetCoin, 0, len(f.coins))
+	for _, c := range f.coins {
+		coins = append(coins, FaucetCoin{
+			Denom:     c.Denom,
+			Amount:    c.Amount.String(),
+			MaxAmount: strconv.FormatUint(f.coinsMax[c.Denom], 10),
+		})
+	}
+
+	return FaucetInfoResponse{
 		IsAFaucet: true,
 		ChainID:   f.chainID,
-	})
+		Coins:     coins,
+	}
+}
+
+// challenge returns the challenge of a client, the challenge is empty when the
+// requests don't need to solve one.
+func (f Faucet) challenge() (ChallengeResponse, error) {
+	if f.challenger == nil {
+		return ChallengeResponse{}, nil
+	}
+	return f.challenger.newChallenge()
 }
 
 // coinsFromRequest determines tokens to transfer from transfer request.
+// The requested coins must be distributed by the faucet and can't exceed the
+// amounts sent per request.
 func (f Faucet) coinsFromRequest(req TransferRequest) (sdk.Coins, error) {
+	if len(req.Coins) > 0 && len(req.Denoms) > 0 {
+		return nil, errors.New("coins and denoms can't be requested together")
+	}
+
+	if len(req.Denoms) > 0 {
+		coins := sdk.NewCoins()
+		for _, denom := range req.Denoms {
+			amount, ok := f.coinAmount(denom)
+			if !ok {
+				return nil, fmt.Errorf("%w: %s", ErrDenomNotDistributed, denom)
+			}
+			if coins.AmountOf(denom).IsPositive() {
+				return nil, fmt.Errorf("denom %s is requested more than once", denom)
+			}
+			coins = coins.Add(sdk.NewCoin(denom, amount))
+		}
+		return coins, nil
+	}
+
 	if len(req.Coins) == 0 {
 		return f.coins, nil
 	}
 
-	var coins []sdk.Coin
+	coins := sdk.NewCoins()
 	for _, c := range req.Coins {
 		coin, err := sdk.ParseCoinNormalized(c)
 		if err != nil {
 			return nil, err
 		}
-		coins = append(coins, coin)
+
+		max, ok := f.coinAmount(coin.Denom)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrDenomNotDistributed, coin.Denom)
+		}
+		if !coin.Amount.IsPositive() {
+			return nil, fmt.Errorf("the amount of %s must be positive", coin.Denom)
+		}
+		if coins.AmountOf(coin.Denom).IsPositive() {
+			return nil, fmt.Errorf("denom %s is requested more than once", coin.Denom)
+		}
+		if coin.Amount.GT(max) {
+			return nil, fmt.Errorf("the faucet can't send more than %s per request", sdk.NewCoin(coin.Denom, max))
+		}
+		coins = coins.Add(coin)
 	}
 
 	return coins, nil
 }
 
-func responseSuccess(w http.ResponseWriter) {
-	xhttp.ResponseJSON(w, http.StatusOK, TransferResponse{})
+// coinAmount returns the amount of the denom sent per request, the coins of the
+// faucet are kept in the order of their options so they are not searched as
+// sorted coins.
+func (f Faucet) coinAmount(denom string) (sdkmath.Int, bool) {
+	for _, c := range f.coins {
+		if c.Denom == denom {
+			return c.Amount, true
+		}
+	}
+	return sdkmath.Int{}, false
+}
+
+func responseSuccess(w http.ResponseWriter, coins sdk.Coins) {
+	res := TransferResponse{}
+	for _, c := range coins {
+		res.Coins = append(res.Coins, c.String())
+	}
+	xhttp.ResponseJSON(w, http.StatusOK, res)
 }
 
-func responseError(w http.ResponseWriter, code int, err error) {
+func responseError(w http.ResponseWriter, code int, reason RejectReason, err error) {
 	xhttp.ResponseJSON(w, code, TransferResponse{
 		Error: err.Error(),
+		Code:  reason,
 	})
 }
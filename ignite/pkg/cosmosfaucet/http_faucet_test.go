@@ -0,0 +1,84 @@
+package cosmosfaucet
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoinsFromRequest(t *testing.T) {
+	const ibcDenom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+	f := Faucet{coinsMax: make(map[string]uint64)}
+	Coin(10, 100, "token")(&f)
+	Coin(5, 0, ibcDenom)(&f)
+	Coin(1, 0, "foo")(&f)
+
+	cases := []struct {
+		name  string
+		req   TransferRequest
+		coins string
+		err   string
+	}{
+		{
+			name:  "default coins",
+			coins: "1foo,5" + ibcDenom + ",10token",
+		},
+		{
+			name:  "denoms",
+			req:   TransferRequest{Denoms: []string{"token", ibcDenom}},
+			coins: "5" + ibcDenom + ",10token",
+		},
+		{
+			name:  "coins",
+			req:   TransferRequest{Coins: []string{"3" + ibcDenom, "1foo"}},
+			coins: "1foo,3" + ibcDenom,
+		},
+		{
+			name: "denom not distributed",
+			req:  TransferRequest{Denoms: []string{"ibc/ABCDEF"}},
+			err:  "denom not distributed by the faucet: ibc/ABCDEF",
+		},
+		{
+			name: "coin not distributed",
+			req:  TransferRequest{Coins: []string{"1bar"}},
+			err:  "denom not distributed by the faucet: bar",
+		},
+		{
+			name: "amount over the request amount",
+			req:  TransferRequest{Coins: []string{"11token"}},
+			err:  "the faucet can't send more than 10token per request",
+		},
+		{
+			name: "zero amount",
+			req:  TransferRequest{Coins: []string{"0token"}},
+			err:  "the amount of token must be positive",
+		},
+		{
+			name: "duplicated denom",
+			req:  TransferRequest{Denoms: []string{"token", "token"}},
+			err:  "denom token is requested more than once",
+		},
+		{
+			name: "coins and denoms",
+			req:  TransferRequest{Coins: []string{"1token"}, Denoms: []string{"foo"}},
+			err:  "coins and denoms can't be requested together",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			coins, err := f.coinsFromRequest(tt.req)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			want, err := sdk.ParseCoinsNormalized(tt.coins)
+			require.NoError(t, err)
+			require.Equal(t, want.String(), sdk.NewCoins(coins...).String())
+		})
+	}
+}
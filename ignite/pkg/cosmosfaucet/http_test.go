@@ -1,12 +1,17 @@
 package cosmosfaucet_test
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
 	"github.com/ignite/cli/ignite/pkg/cosmosfaucet"
 )
 
@@ -42,3 +47,84 @@ func TestServeHTTPCORS(t *testing.T) {
 		})
 	}
 }
+
+func TestServeHTTPLimits(t *testing.T) {
+	f, err := cosmosfaucet.New(
+		context.Background(),
+		chaincmdrunner.Runner{},
+		cosmosfaucet.ChainID("mars"),
+		cosmosfaucet.WithLimits(cosmosfaucet.Limits{IPRequests: 1}, ""),
+	)
+	require.NoError(t, err)
+
+	send := func() *http.Response {
+		res := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("invalid"))
+		f.ServeHTTP(res, req)
+		return res.Result()
+	}
+
+	require.Equal(t, http.StatusBadRequest, send().StatusCode)
+
+	res := send()
+	require.Equal(t, http.StatusTooManyRequests, res.StatusCode)
+	require.Equal(t, "3600", res.Header.Get("Retry-After"))
+}
+
+func TestServeHTTPChallenge(t *testing.T) {
+	f, err := cosmosfaucet.New(
+		context.Background(),
+		chaincmdrunner.Runner{},
+		cosmosfaucet.ChainID("mars"),
+		cosmosfaucet.WithChallenge(cosmosfaucet.Challenge{PoWDifficulty: 4}),
+	)
+	require.NoError(t, err)
+
+	send := func(challenge, nonce string) *http.Response {
+		body := fmt.Sprintf(`{"address":"cosmos1","coins":["invalid"],"challenge":%q,"nonce":%q}`, challenge, nonce)
+		res := httptest.NewRecorder()
+		f.ServeHTTP(res, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+		return res.Result()
+	}
+
+	require.Equal(t, http.StatusForbidden, send("", "").StatusCode)
+
+	res := httptest.NewRecorder()
+	f.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/challenge", nil))
+	require.Equal(t, http.StatusOK, res.Code)
+
+	var challenge cosmosfaucet.ChallengeResponse
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&challenge))
+	require.Equal(t, 4, challenge.Difficulty)
+
+	// The request passes the challenge and fails on its invalid coins
+	nonce := cosmosfaucet.SolveProofOfWork(challenge.Challenge, challenge.Difficulty)
+	require.Equal(t, http.StatusBadRequest, send(challenge.Challenge, nonce).StatusCode)
+}
+
+func TestServeHTTPUI(t *testing.T) {
+	get := func(f cosmosfaucet.Faucet, path string) *httptest.ResponseRecorder {
+		res := httptest.NewRecorder()
+		f.ServeHTTP(res, httptest.NewRequest(http.MethodGet, path, nil))
+		return res
+	}
+
+	f, err := cosmosfaucet.New(
+		context.Background(),
+		chaincmdrunner.Runner{},
+		cosmosfaucet.ChainID("mars"),
+		cosmosfaucet.WithUI(),
+	)
+	require.NoError(t, err)
+
+	res := get(f, "/")
+	require.Equal(t, http.StatusOK, res.Code)
+	require.Contains(t, res.Body.String(), "<title>mars faucet</title>")
+	require.Contains(t, get(f, "/console").Body.String(), "swagger-ui")
+
+	// The OpenAPI console is served at the root without the web UI
+	f, err = cosmosfaucet.New(context.Background(), chaincmdrunner.Runner{}, cosmosfaucet.ChainID("mars"))
+	require.NoError(t, err)
+	require.Contains(t, get(f, "/").Body.String(), "swagger-ui")
+	require.Equal(t, http.StatusNotFound, get(f, "/console").Code)
+}
@@ -0,0 +1,39 @@
+package cosmosfaucet
+
+import (
+	_ "embed" // used for embedding the web UI.
+	"html/template"
+	"net/http"
+)
+
+const (
+	fileNameUI = "ui/index.html.tmpl"
+
+	// consolePath is the path of the OpenAPI console when the web UI is served
+	// at the root of the faucet.
+	consolePath = "/console"
+)
+
+//go:embed ui/index.html.tmpl
+var bytesUI []byte
+
+var tmplUI = template.Must(template.New(fileNameUI).Parse(string(bytesUI)))
+
+// WithUI serves a web page at the root of the faucet to request coins from a
+// browser, the OpenAPI console is served at /console instead.
+func WithUI() Option {
+	return func(f *Faucet) {
+		f.ui = true
+	}
+}
+
+func (f Faucet) uiHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	tmplUI.Execute(w, struct {
+		ChainID     string
+		ConsolePath string
+	}{
+		f.chainID,
+		consolePath,
+	})
+}
@@ -0,0 +1,251 @@
+package cosmosfaucet
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v2"
+)
+
+// DefaultIPWindow is the default window of the requests limit of a client IP.
+const DefaultIPWindow = time.Hour
+
+// capWindow is the window of the coin caps.
+const capWindow = time.Hour * 24
+
+// ErrLimitExceeded is returned when a request exceeds a limit of the faucet.
+var ErrLimitExceeded = errors.New("faucet limit exceeded")
+
+// Limits configures the limits of the faucet in addition to the max amounts
+// of the coins, the requests and the transfers are persisted in the store
+// of the limits so the limits survive the restarts of the faucet.
+type Limits struct {
+	// IPRequests is the number of requests accepted from a client IP in
+	// IPWindow, the requests are not limited when zero.
+	IPRequests int
+
+	// IPWindow is the window of IPRequests, DefaultIPWindow when zero.
+	IPWindow time.Duration
+
+	// AddressDailyCap are the max coins sent to an address in 24 hours.
+	AddressDailyCap sdk.Coins
+
+	// DailyCap are the max coins sent by the faucet to all the addresses
+	// in 24 hours.
+	DailyCap sdk.Coins
+}
+
+// WithLimits configures the limits of the faucet, the requests and the
+// transfers are persisted in the file at storePath. They are only kept
+// in memory when storePath is empty.
+func WithLimits(limits Limits, storePath string) Option {
+	return func(f *Faucet) {
+		if limits.IPWindow == 0 {
+			limits.IPWindow = DefaultIPWindow
+		}
+		f.limits = &limiter{
+			Limits: limits,
+			path:   storePath,
+			now:    time.Now,
+		}
+	}
+}
+
+// limiter enforces the limits of a faucet.
+type limiter struct {
+	Limits
+
+	path string
+	now  func() time.Time
+
+	mu    sync.Mutex
+	store limitStore
+}
+
+// limitStore holds the requests and the transfers within the windows of the limits.
+type limitStore struct {
+	// Requests are the times of the requests by client IP.
+	Requests map[string][]time.Time `yaml:"requests,omitempty"`
+
+	// Transfers are the transfers of the faucet.
+	Transfers []transferRecord `yaml:"transfers,omitempty"`
+}
+
+type transferRecord struct {
+	Address string    `yaml:"address"`
+	Coins   string    `yaml:"coins"`
+	Time    time.Time `yaml:"time"`
+}
+
+// load loads the persisted requests and transfers.
+func (l *limiter) load() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.store = limitStore{Requests: make(map[string][]time.Time)}
+	if l.path == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(l.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := yaml.Unmarshal(data, &l.store); err != nil {
+		return fmt.Errorf("faucet limits store %s: %w", l.path, err)
+	}
+	if l.store.Requests == nil {
+		l.store.Requests = make(map[string][]time.Time)
+	}
+
+	return nil
+}
+
+// save persists the requests and the transfers.
+func (l *limiter) save() error {
+	if l.path == "" {
+		return nil
+	}
+
+	data, err := yaml.Marshal(l.store)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(l.path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(l.path, data, 0o644)
+}
+
+// prune removes the requests and the transfers out of the windows of the limits.
+func (l *limiter) prune(now time.Time) {
+	for ip, times := range l.store.Requests {
+		var kept []time.Time
+		for _, t := range times {
+			if now.Sub(t) < l.IPWindow {
+				kept = append(kept, t)
+			}
+		}
+		if len(kept) == 0 {
+			delete(l.store.Requests, ip)
+		} else {
+			l.store.Requests[ip] = kept
+		}
+	}
+
+	var kept []transferRecord
+	for _, t := range l.store.Transfers {
+		if now.Sub(t.Time) < capWindow {
+			kept = append(kept, t)
+		}
+	}
+	l.store.Transfers = kept
+}
+
+// allowRequest records a request of the client IP. When the client exceeds
+// its requests limit, it returns the time until a request is accepted.
+func (l *limiter) allowRequest(ip string) (retryAfter time.Duration, err error) {
+	if l.IPRequests <= 0 {
+		return 0, nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.prune(now)
+
+	times := l.store.Requests[ip]
+	if len(times) >= l.IPRequests {
+		// The oldest request leaves the window first
+		retryAfter = times[0].Add(l.IPWindow).Sub(now)
+		return retryAfter, fmt.Errorf(
+			"%w: the client can't send more than %d requests in %s",
+			ErrLimitExceeded,
+			l.IPRequests,
+			l.IPWindow,
+		)
+	}
+
+	l.store.Requests[ip] = append(times, now)
+
+	return 0, l.save()
+}
+
+// checkTransfer returns an error when sending the coins to the address
+// exceeds the daily caps. pending are the coins not sent yet by the faucet
+// that count in its daily cap.
+func (l *limiter) checkTransfer(address string, coins, pending sdk.Coins) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.prune(l.now())
+
+	sentTo, sent := sdk.NewCoins(), sdk.NewCoins(pending...)
+	for _, t := range l.store.Transfers {
+		c, err := sdk.ParseCoinsNormalized(t.Coins)
+		if err != nil {
+			return err
+		}
+		sent = sent.Add(c...)
+		if t.Address == address {
+			sentTo = sentTo.Add(c...)
+		}
+	}
+
+	for _, c := range coins {
+		if exceedsCap(l.AddressDailyCap, sentTo, c) {
+			return fmt.Errorf(
+				"%w: the address can't receive more than %s in 24 hours",
+				ErrLimitExceeded,
+				sdk.NewCoin(c.Denom, l.AddressDailyCap.AmountOf(c.Denom)),
+			)
+		}
+		if exceedsCap(l.DailyCap, sent, c) {
+			return fmt.Errorf(
+				"%w: the faucet can't send more than %s in 24 hours",
+				ErrLimitExceeded,
+				sdk.NewCoin(c.Denom, l.DailyCap.AmountOf(c.Denom)),
+			)
+		}
+	}
+
+	return nil
+}
+
+// recordTransfer records the coins sent to the address.
+func (l *limiter) recordTransfer(address string, coins sdk.Coins) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.store.Transfers = append(l.store.Transfers, transferRecord{
+		Address: address,
+		Coins:   coins.String(),
+		Time:    l.now(),
+	})
+
+	return l.save()
+}
+
+// exceedsCap returns true when sending coin in addition to the sent coins
+// exceeds the cap of its denom, denoms without cap are not limited.
+func exceedsCap(limit, sent sdk.Coins, coin sdk.Coin) bool {
+	max := limit.AmountOf(coin.Denom)
+	if max.IsZero() {
+		return false
+	}
+
+	total := sent.AmountOf(coin.Denom).Add(coin.Amount)
+	return total.GT(max)
+}
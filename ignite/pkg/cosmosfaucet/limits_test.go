@@ -0,0 +1,87 @@
+package cosmosfaucet
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLimiter(t *testing.T, limits Limits, path string, now *time.Time) *limiter {
+	t.Helper()
+
+	var f Faucet
+	WithLimits(limits, path)(&f)
+	f.limits.now = func() time.Time { return *now }
+	require.NoError(t, f.limits.load())
+
+	return f.limits
+}
+
+func TestLimiterRequests(t *testing.T) {
+	var (
+		now  = time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC)
+		path = filepath.Join(t.TempDir(), "limits.yml")
+		l    = newTestLimiter(t, Limits{IPRequests: 2}, path, &now)
+	)
+
+	for i := 0; i < 2; i++ {
+		_, err := l.allowRequest("1.2.3.4")
+		require.NoError(t, err)
+		now = now.Add(time.Minute)
+	}
+
+	retryAfter, err := l.allowRequest("1.2.3.4")
+	require.ErrorIs(t, err, ErrLimitExceeded)
+	require.Equal(t, 58*time.Minute, retryAfter)
+
+	_, err = l.allowRequest("5.6.7.8")
+	require.NoError(t, err)
+
+	// The requests survive the restarts
+	l = newTestLimiter(t, Limits{IPRequests: 2}, path, &now)
+	_, err = l.allowRequest("1.2.3.4")
+	require.ErrorIs(t, err, ErrLimitExceeded)
+
+	now = now.Add(time.Hour)
+	_, err = l.allowRequest("1.2.3.4")
+	require.NoError(t, err)
+}
+
+func TestLimiterCaps(t *testing.T) {
+	var (
+		now    = time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC)
+		path   = filepath.Join(t.TempDir(), "limits.yml")
+		limits = Limits{
+			AddressDailyCap: sdk.NewCoins(sdk.NewInt64Coin("token", 100)),
+			DailyCap:        sdk.NewCoins(sdk.NewInt64Coin("token", 150)),
+		}
+		l     = newTestLimiter(t, limits, path, &now)
+		coins = sdk.NewCoins(sdk.NewInt64Coin("token", 60), sdk.NewInt64Coin("stake", 1000))
+	)
+
+	require.NoError(t, l.checkTransfer("alice", coins, nil))
+	require.NoError(t, l.recordTransfer("alice", coins))
+
+	err := l.checkTransfer("alice", coins, nil)
+	require.ErrorIs(t, err, ErrLimitExceeded)
+	require.EqualError(t, err, "faucet limit exceeded: the address can't receive more than 100token in 24 hours")
+
+	require.NoError(t, l.checkTransfer("bob", coins, nil))
+	require.NoError(t, l.recordTransfer("bob", coins))
+
+	// The transfers survive the restarts
+	l = newTestLimiter(t, limits, path, &now)
+	err = l.checkTransfer("carol", coins, nil)
+	require.EqualError(t, err, "faucet limit exceeded: the faucet can't send more than 150token in 24 hours")
+
+	now = now.Add(capWindow)
+	require.NoError(t, l.checkTransfer("alice", coins, nil))
+
+	// The pending coins count in the daily cap of the faucet
+	pending := sdk.NewCoins(sdk.NewInt64Coin("token", 100))
+	err = l.checkTransfer("alice", coins, pending)
+	require.EqualError(t, err, "faucet limit exceeded: the faucet can't send more than 150token in 24 hours")
+}
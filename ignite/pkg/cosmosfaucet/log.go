@@ -0,0 +1,49 @@
+package cosmosfaucet
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+// WithLogger writes the structured logs of the transfer requests to w, one
+// JSON object per line.
+func WithLogger(w io.Writer) Option {
+	return func(f *Faucet) {
+		f.logger = &logger{w: w, now: time.Now}
+	}
+}
+
+// logEntry is a structured log line of a transfer request.
+type logEntry struct {
+	Time     time.Time    `json:"time"`
+	Level    string       `json:"level"`
+	Msg      string       `json:"msg"`
+	IP       string       `json:"ip,omitempty"`
+	Address  string       `json:"address,omitempty"`
+	Coins    string       `json:"coins,omitempty"`
+	Status   int          `json:"status"`
+	Reason   RejectReason `json:"reason,omitempty"`
+	Error    string       `json:"error,omitempty"`
+	Duration float64      `json:"duration_ms"`
+}
+
+// logger writes the structured logs of a faucet.
+type logger struct {
+	mu  sync.Mutex
+	w   io.Writer
+	now func() time.Time
+}
+
+func (l *logger) log(e logEntry) {
+	if l == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	e.Time = l.now().UTC()
+	_ = json.NewEncoder(l.w).Encode(e)
+}
@@ -0,0 +1,168 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	metricsNamespace = "ignite"
+	metricsSubsystem = "faucet"
+
+	// balancesTimeout is the time to query the balances of the faucet account
+	// when the metrics are scraped.
+	balancesTimeout = time.Second * 10
+)
+
+// RejectReason is the reason why the faucet rejected a transfer request, it
+// labels the rejections in the metrics and the logs.
+type RejectReason string
+
+// The reasons why the faucet rejects the transfer requests.
+const (
+	RejectRateLimited     RejectReason = "rate_limited"
+	RejectChallengeFailed RejectReason = "challenge_failed"
+	RejectInvalidRequest  RejectReason = "invalid_request"
+	RejectInvalidAddress  RejectReason = "invalid_address"
+	RejectDenied          RejectReason = "denied"
+	RejectNotAllowed      RejectReason = "not_allowed"
+	RejectLimitExceeded   RejectReason = "limit_exceeded"
+	RejectTransferFailed  RejectReason = "transfer_failed"
+	RejectCanceled        RejectReason = "canceled"
+)
+
+// metrics are the Prometheus metrics of a faucet, they are kept in their own
+// registry so the faucets don't share their metrics.
+type metrics struct {
+	registry *prometheus.Registry
+
+	requests     prometheus.Counter
+	grants       prometheus.Counter
+	grantedCoins *prometheus.CounterVec
+	rejections   *prometheus.CounterVec
+}
+
+func newMetrics(balances func(context.Context) (sdk.Coins, error)) *metrics {
+	m := &metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "requests_total",
+			Help:      "Number of transfer requests received by the faucet.",
+		}),
+		grants: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "grants_total",
+			Help:      "Number of transfer requests granted by the faucet.",
+		}),
+		grantedCoins: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "granted_amount_total",
+			Help:      "Amount of coins sent by the faucet by denom.",
+		}, []string{"denom"}),
+		rejections: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "rejections_total",
+			Help:      "Number of transfer requests rejected by the faucet by reason.",
+		}, []string{"reason"}),
+	}
+
+	m.registry.MustRegister(
+		m.requests,
+		m.grants,
+		m.grantedCoins,
+		m.rejections,
+		balanceCollector{
+			desc: prometheus.NewDesc(
+				prometheus.BuildFQName(metricsNamespace, metricsSubsystem, "balance"),
+				"Balance of the faucet account by denom.",
+				[]string{"denom"},
+				nil,
+			),
+			balances: balances,
+		},
+	)
+
+	return m
+}
+
+func (m *metrics) request() {
+	if m != nil {
+		m.requests.Inc()
+	}
+}
+
+func (m *metrics) grant(coins sdk.Coins) {
+	if m == nil {
+		return
+	}
+	m.grants.Inc()
+	for _, c := range coins {
+		m.grantedCoins.WithLabelValues(c.Denom).Add(amountFloat(c.Amount))
+	}
+}
+
+func (m *metrics) reject(reason RejectReason) {
+	if m != nil {
+		m.rejections.WithLabelValues(string(reason)).Inc()
+	}
+}
+
+func (m *metrics) handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{
+		// keep serving the counters when the balances can't be queried.
+		ErrorHandling: promhttp.ContinueOnError,
+	})
+}
+
+// balanceCollector collects the balances of the faucet account when the
+// metrics are scraped.
+type balanceCollector struct {
+	desc     *prometheus.Desc
+	balances func(context.Context) (sdk.Coins, error)
+}
+
+func (c balanceCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c balanceCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), balancesTimeout)
+	defer cancel()
+
+	balances, err := c.balances(ctx)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(c.desc, err)
+		return
+	}
+
+	for _, b := range balances {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, amountFloat(b.Amount), b.Denom)
+	}
+}
+
+// amountFloat returns the amount as a float, the metrics values are floats.
+func amountFloat(amount sdkmath.Int) float64 {
+	f, _ := new(big.Float).SetInt(amount.BigInt()).Float64()
+	return f
+}
+
+// accountBalances returns the balances of the faucet account.
+func (f Faucet) accountBalances(ctx context.Context) (sdk.Coins, error) {
+	account, err := f.runner.ShowAccount(ctx, f.accountName)
+	if err != nil {
+		return nil, err
+	}
+	return f.runner.BankBalances(ctx, account.Address)
+}
@@ -0,0 +1,93 @@
+package cosmosfaucet
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsAndLogs(t *testing.T) {
+	var (
+		logs     bytes.Buffer
+		now      = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+		balances = func(context.Context) (sdk.Coins, error) {
+			return sdk.NewCoins(sdk.NewInt64Coin("token", 1000), sdk.NewInt64Coin("stake", 5)), nil
+		}
+	)
+
+	f := Faucet{
+		coinsMax: make(map[string]uint64),
+		metrics:  newMetrics(balances),
+		logger:   &logger{w: &logs, now: func() time.Time { return now }},
+	}
+	Coin(10, 0, "token")(&f)
+
+	send := func(body string) {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.RemoteAddr = "10.0.0.1:1234"
+		f.ServeHTTP(httptest.NewRecorder(), req)
+	}
+	send(`{`)
+	send(`{"address": "cosmos1uzv4v9g9xln2qx2vtqhz99yxum33calja5vruz", "denoms": ["foo"]}`)
+
+	res := httptest.NewRecorder()
+	f.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, res.Code)
+
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	for _, metric := range []string{
+		`ignite_faucet_requests_total 2`,
+		`ignite_faucet_rejections_total{reason="invalid_request"} 2`,
+		`ignite_faucet_balance{denom="stake"} 5`,
+		`ignite_faucet_balance{denom="token"} 1000`,
+	} {
+		require.Contains(t, string(body), metric)
+	}
+
+	// Grants are counted by denom
+	f.metrics.grant(sdk.NewCoins(sdk.NewInt64Coin("token", 10)))
+	res = httptest.NewRecorder()
+	f.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Contains(t, res.Body.String(), `ignite_faucet_grants_total 1`)
+	require.Contains(t, res.Body.String(), `ignite_faucet_granted_amount_total{denom="token"} 10`)
+
+	// The requests are logged as JSON lines
+	lines := strings.Split(strings.TrimSpace(logs.String()), "\n")
+	require.Len(t, lines, 2)
+
+	var e logEntry
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &e))
+	require.Equal(t, now, e.Time)
+	require.Equal(t, "warn", e.Level)
+	require.Equal(t, "10.0.0.1", e.IP)
+	require.Equal(t, "cosmos1uzv4v9g9xln2qx2vtqhz99yxum33calja5vruz", e.Address)
+	require.Equal(t, http.StatusBadRequest, e.Status)
+	require.Equal(t, RejectInvalidRequest, e.Reason)
+	require.Contains(t, e.Error, "foo")
+}
+
+func TestMetricsBalancesError(t *testing.T) {
+	f := Faucet{
+		metrics: newMetrics(func(context.Context) (sdk.Coins, error) {
+			return nil, errors.New("node not ready")
+		}),
+	}
+
+	// The counters are served when the balances can't be queried
+	res := httptest.NewRecorder()
+	f.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, res.Code)
+	require.Contains(t, res.Body.String(), `ignite_faucet_requests_total 0`)
+	require.NotContains(t, res.Body.String(), `ignite_faucet_balance{`)
+}
@@ -0,0 +1,134 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// rejection is a rejected transfer request.
+type rejection struct {
+	// status is the HTTP status of the rejection.
+	status int
+
+	// reason is the code of the rejection.
+	reason RejectReason
+
+	// retryAfter is the time until the client can send requests again, it is
+	// zero unless the client is rate limited.
+	retryAfter time.Duration
+
+	err error
+}
+
+// handleTransfer checks and performs a transfer request of the client ip, for
+// the HTTP and the gRPC services of the faucet. decode decodes the request once
+// the client is allowed to send requests. The request is recorded in the
+// metrics and the logs of the faucet, the coins sent are returned unless the
+// request is rejected.
+func (f Faucet) handleTransfer(
+	ctx context.Context,
+	ip string,
+	decode func(*TransferRequest) error,
+) (sdk.Coins, *rejection) {
+	var (
+		req   TransferRequest
+		start = time.Now()
+	)
+
+	f.metrics.request()
+
+	entry := func(status int) logEntry {
+		return logEntry{
+			IP:       ip,
+			Address:  req.AccountAddress,
+			Status:   status,
+			Duration: float64(time.Since(start).Microseconds()) / 1000,
+		}
+	}
+	reject := func(status int, reason RejectReason, err error) *rejection {
+		f.metrics.reject(reason)
+		f.alerter.record(status == http.StatusInternalServerError)
+
+		e := entry(status)
+		e.Level, e.Msg, e.Reason, e.Error = "warn", "transfer request rejected", reason, err.Error()
+		if status == http.StatusInternalServerError {
+			e.Level = "error"
+		}
+		f.logger.log(e)
+
+		return &rejection{status: status, reason: reason, err: err}
+	}
+
+	// check that the client IP can send requests.
+	if reason, err := f.checkIP(ip); err != nil {
+		return nil, reject(http.StatusForbidden, reason, err)
+	}
+
+	// limit the requests of the client.
+	if f.limits != nil {
+		retryAfter, err := f.limits.allowRequest(ip)
+		if errors.Is(err, ErrLimitExceeded) {
+			rej := reject(http.StatusTooManyRequests, RejectRateLimited, err)
+			rej.retryAfter = retryAfter
+			return nil, rej
+		}
+		if err != nil {
+			return nil, reject(http.StatusInternalServerError, RejectTransferFailed, err)
+		}
+	}
+
+	if err := decode(&req); err != nil {
+		return nil, reject(http.StatusBadRequest, RejectInvalidRequest, err)
+	}
+
+	// verify that the client solved the challenges.
+	if f.challenger != nil {
+		err := f.challenger.verify(ctx, req, ip)
+		if errors.Is(err, ErrChallengeFailed) {
+			return nil, reject(http.StatusForbidden, RejectChallengeFailed, err)
+		}
+		if err != nil {
+			return nil, reject(http.StatusInternalServerError, RejectChallengeFailed, err)
+		}
+	}
+
+	// check that coins can be sent to the address.
+	if reason, err := f.checkAddress(req.AccountAddress); err != nil {
+		status := http.StatusForbidden
+		if reason == RejectInvalidAddress {
+			status = http.StatusBadRequest
+		}
+		return nil, reject(status, reason, err)
+	}
+
+	// determine coins to transfer.
+	coins, err := f.coinsFromRequest(req)
+	if err != nil {
+		return nil, reject(http.StatusBadRequest, RejectInvalidRequest, err)
+	}
+
+	// try performing the transfer
+	if err := f.Transfer(ctx, req.AccountAddress, coins); err != nil {
+		if err == context.Canceled {
+			f.metrics.reject(RejectCanceled)
+			return nil, &rejection{reason: RejectCanceled, err: err}
+		}
+		if errors.Is(err, ErrLimitExceeded) {
+			return nil, reject(http.StatusTooManyRequests, RejectLimitExceeded, err)
+		}
+		return nil, reject(http.StatusInternalServerError, RejectTransferFailed, err)
+	}
+
+	f.metrics.grant(coins)
+	f.alerter.record(false)
+
+	e := entry(http.StatusOK)
+	e.Level, e.Msg, e.Coins = "info", "coins sent", coins.String()
+	f.logger.log(e)
+
+	return coins, nil
+}
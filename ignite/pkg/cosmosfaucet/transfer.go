@@ -3,7 +3,6 @@ package cosmosfaucet
 import (
 	"context"
 	"fmt"
-	"strings"
 	"sync"
 	"time"
 
@@ -52,12 +51,46 @@ func (f Faucet) TotalTransferredAmount(ctx context.Context, toAccountAddress, de
 }
 
 // Transfer transfer amount of tokens from the faucet account to toAccountAddress.
+// When the faucet batches its transfers, the transfer is queued and sent with the
+// other transfers of its batch in a single multi-send tx.
 func (f *Faucet) Transfer(ctx context.Context, toAccountAddress string, coins sdk.Coins) error {
+	if f.batcher != nil {
+		return f.transferBatched(ctx, toAccountAddress, coins)
+	}
+
 	transferMutex.Lock()
 	defer transferMutex.Unlock()
 
-	var coinsStr []string
+	if err := f.checkTransfer(ctx, toAccountAddress, coins, nil); err != nil {
+		return err
+	}
+
+	// perform transfer for all coins
+	fromAccount, err := f.runner.ShowAccount(ctx, f.accountName)
+	if err != nil {
+		return err
+	}
+	txHash, err := f.runner.BankSend(ctx, fromAccount.Address, toAccountAddress, coins.String())
+	if err != nil {
+		return err
+	}
+
+	// wait for the send tx to be confirmed
+	if err := f.runner.WaitTx(ctx, txHash, time.Second, 30); err != nil {
+		return err
+	}
+
+	if f.limits != nil {
+		return f.limits.recordTransfer(toAccountAddress, coins)
+	}
+
+	return nil
+}
 
+// checkTransfer returns an error when sending the coins to toAccountAddress
+// exceeds the max amounts or the limits of the faucet. pending are the coins
+// queued to be sent to other addresses.
+func (f *Faucet) checkTransfer(ctx context.Context, toAccountAddress string, coins, pending sdk.Coins) error {
 	// check for each coin, the max transferred amount hasn't been reached
 	for _, c := range coins {
 		totalSent, err := f.TotalTransferredAmount(ctx, toAccountAddress, c.Denom)
@@ -82,20 +115,11 @@ func (f *Faucet) Transfer(ctx context.Context, toAccountAddress string, coins sd
 				)
 			}
 		}
-
-		coinsStr = append(coinsStr, c.String())
 	}
 
-	// perform transfer for all coins
-	fromAccount, err := f.runner.ShowAccount(ctx, f.accountName)
-	if err != nil {
-		return err
-	}
-	txHash, err := f.runner.BankSend(ctx, fromAccount.Address, toAccountAddress, strings.Join(coinsStr, ","))
-	if err != nil {
-		return err
+	if f.limits != nil {
+		return f.limits.checkTransfer(toAccountAddress, coins, pending)
 	}
 
-	// wait for the send tx to be confirmed
-	return f.runner.WaitTx(ctx, txHash, time.Second, 30)
+	return nil
 }
@@ -0,0 +1,65 @@
+package cosmosgen
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ignite/cli/ignite/pkg/buf"
+	"github.com/ignite/cli/ignite/pkg/xfilepath"
+)
+
+// bufDepsPath is the directory of the proto files of the dependencies pinned
+// in the buf lock files of the apps, by registry, owner, repository and commit.
+var bufDepsPath = xfilepath.JoinFromHome(xfilepath.Path(".ignite/buf"))
+
+// resolveBufInclude returns the include paths of the dependencies pinned in the
+// buf lock file of the app, they are exported from the Buf Schema Registry once
+// per commit. The proto files of the apps without lock file, or when buf is not
+// installed, are only resolved from the Go module cache.
+func (g *generator) resolveBufInclude() ([]string, error) {
+	deps, err := buf.Dependencies(filepath.Join(g.appPath, g.protoDir))
+	if err != nil || len(deps) == 0 || !buf.IsInstalled() {
+		return nil, err
+	}
+
+	root, err := bufDepsPath()
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	for _, dep := range deps {
+		out := filepath.Join(root, dep.Path())
+		if _, err := os.Stat(out); os.IsNotExist(err) {
+			if err := exportBufDependency(g, dep, out); err != nil {
+				return nil, err
+			}
+		} else if err != nil {
+			return nil, err
+		}
+
+		paths = append(paths, out)
+	}
+
+	return paths, nil
+}
+
+// exportBufDependency exports the proto files of dep in out. The files are
+// exported in a temporary dir first so interrupted exports are never used.
+func exportBufDependency(g *generator, dep buf.Dependency, out string) error {
+	if err := os.MkdirAll(filepath.Dir(out), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.MkdirTemp(filepath.Dir(out), ".export-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	if err := buf.Export(g.ctx, dep, tmp); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, out)
+}
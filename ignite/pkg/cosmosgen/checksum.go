@@ -0,0 +1,93 @@
+package cosmosgen
+
+import (
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/protoanalysis"
+)
+
+// protoImportRe matches the files imported by a proto file.
+var protoImportRe = regexp.MustCompile(`(?m)^\s*import\s+(?:public\s+|weak\s+)?"([^"]+)"\s*;`)
+
+// packageChecksum returns the checksum of the content of the proto files of
+// the package and of all the proto files they import, directly or not. The
+// imported files are searched in the include paths, the third party proto
+// files are part of the checksum too.
+func packageChecksum(pkg protoanalysis.Package, includePaths []string) ([]byte, error) {
+	var (
+		h       = sha256.New()
+		visited = make(map[string]bool)
+		queue   []string
+	)
+
+	paths := pkg.Files.Paths()
+	sort.Strings(paths)
+	for _, path := range paths {
+		visited[path] = true
+		queue = append(queue, path)
+	}
+
+	for len(queue) > 0 {
+		path := queue[0]
+		queue = queue[1:]
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		h.Write([]byte(filepath.Base(path)))
+		h.Write([]byte{0})
+		h.Write(content)
+
+		for _, match := range protoImportRe.FindAllSubmatch(content, -1) {
+			imported, ok := findImport(string(match[1]), includePaths)
+			if !ok || visited[imported] {
+				// The files that are not found, like the well known types of
+				// protoc, don't change with the app dependencies
+				continue
+			}
+
+			visited[imported] = true
+			queue = append(queue, imported)
+		}
+	}
+
+	return h.Sum(nil), nil
+}
+
+// findImport returns the path of the proto file imported with name from the
+// first include path that has it.
+func findImport(name string, includePaths []string) (string, bool) {
+	for _, include := range includePaths {
+		path := filepath.Join(include, name)
+		if _, err := os.Stat(path); err == nil {
+			return path, true
+		}
+	}
+
+	return "", false
+}
+
+// ClearCache deletes the cached outputs of the code generation so the code of
+// all the proto files is generated again.
+func ClearCache(cacheStorage cache.Storage) error {
+	namespaces := []string{
+		dirchangeCacheNamespace,
+		goCacheNamespace,
+		specCacheNamespace,
+	}
+
+	for _, namespace := range namespaces {
+		if err := cache.New[[]byte](cacheStorage, namespace).Clear(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package cosmosgen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/protoanalysis"
+)
+
+func TestPackageChecksum(t *testing.T) {
+	var (
+		appProto   = t.TempDir()
+		thirdProto = t.TempDir()
+		txPath     = filepath.Join(appProto, "blog", "tx.proto")
+		coinPath   = filepath.Join(thirdProto, "cosmos", "coin.proto")
+		basePath   = filepath.Join(thirdProto, "cosmos", "base.proto")
+		include    = []string{appProto, thirdProto}
+		pkg        = protoanalysis.Package{
+			Name:  "app.blog",
+			Files: protoanalysis.Files{{Path: txPath}},
+		}
+	)
+
+	write := func(path, content string) {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+
+	write(txPath, "syntax = \"proto3\";\nimport \"cosmos/coin.proto\";\nimport \"google/protobuf/any.proto\";\n")
+	write(coinPath, "syntax = \"proto3\";\nimport public \"cosmos/base.proto\";\n")
+	write(basePath, "syntax = \"proto3\";\nmessage Base {}\n")
+
+	checksum, err := packageChecksum(pkg, include)
+	require.NoError(t, err)
+
+	same, err := packageChecksum(pkg, include)
+	require.NoError(t, err)
+	require.Equal(t, checksum, same)
+
+	// A change of a proto file imported indirectly changes the checksum
+	write(basePath, "syntax = \"proto3\";\nmessage Base { string denom = 1; }\n")
+
+	changed, err := packageChecksum(pkg, include)
+	require.NoError(t, err)
+	require.NotEqual(t, checksum, changed)
+}
+
+func TestGeneratedFilesWrite(t *testing.T) {
+	var (
+		dir   = t.TempDir()
+		files = generatedFiles{
+			"x/blog/types/tx.pb.go":    []byte("package types\n"),
+			"x/blog/types/query.pb.go": []byte("package types\n"),
+		}
+	)
+
+	require.NoError(t, files.write(dir))
+
+	unchanged := filepath.Join(dir, "x/blog/types/query.pb.go")
+	before, err := os.Stat(unchanged)
+	require.NoError(t, err)
+
+	read, err := readGeneratedFiles(dir)
+	require.NoError(t, err)
+	require.Equal(t, files, read)
+
+	files["x/blog/types/tx.pb.go"] = []byte("package types\n\ntype MsgCreatePost struct{}\n")
+	require.NoError(t, os.Chtimes(unchanged, before.ModTime().Add(-time.Hour), before.ModTime().Add(-time.Hour)))
+	require.NoError(t, files.write(dir))
+
+	// Only the changed files are written again
+	after, err := os.Stat(unchanged)
+	require.NoError(t, err)
+	require.True(t, after.ModTime().Before(before.ModTime()))
+
+	content, err := os.ReadFile(filepath.Join(dir, "x/blog/types/tx.pb.go"))
+	require.NoError(t, err)
+	require.Equal(t, files["x/blog/types/tx.pb.go"], content)
+
+	empty, err := readGeneratedFiles(filepath.Join(dir, "missing"))
+	require.NoError(t, err)
+	require.Empty(t, empty)
+}
@@ -3,7 +3,9 @@ package cosmosgen
 import (
 	"context"
 	"path/filepath"
+	"strings"
 
+	"github.com/iancoleman/strcase"
 	gomodmodule "golang.org/x/mod/module"
 
 	"github.com/ignite/cli/ignite/pkg/cache"
@@ -22,7 +24,24 @@ type generateOptions struct {
 	vuexOut      func(module.Module) string
 	vuexRootPath string
 
+	composablesOut      func(module.Module) string
+	composablesRootPath string
+
+	hooksOut      func(module.Module) string
+	hooksRootPath string
+
 	specOut string
+
+	pythonClientRootPath string
+	rustClientRootPath   string
+	dartClientRootPath   string
+
+	descriptorSetGenerators []DescriptorSetGenerator
+
+	descriptorsOut string
+
+	// moduleOptions are the generation options of the proto packages of modules, by package name.
+	moduleOptions map[string]ModuleOptions
 }
 
 // TODO add WithInstall.
@@ -50,6 +69,24 @@ func WithVuexGeneration(includeThirdPartyModules bool, out ModulePathFunc, vuexR
 	}
 }
 
+// WithComposablesGeneration adds Vue 3 composables code generation.
+// The composables use the Typescript Client and are generated in rootPath.
+func WithComposablesGeneration(out ModulePathFunc, rootPath string) Option {
+	return func(o *generateOptions) {
+		o.composablesOut = out
+		o.composablesRootPath = rootPath
+	}
+}
+
+// WithHooksGeneration adds React hooks code generation.
+// The hooks use the Typescript Client and are generated in rootPath.
+func WithHooksGeneration(out ModulePathFunc, rootPath string) Option {
+	return func(o *generateOptions) {
+		o.hooksOut = out
+		o.hooksRootPath = rootPath
+	}
+}
+
 // WithGoGeneration adds Go code generation.
 func WithGoGeneration(gomodPath string) Option {
 	return func(o *generateOptions) {
@@ -64,6 +101,48 @@ func WithOpenAPIGeneration(out string) Option {
 	}
 }
 
+// WithPythonClientGeneration adds Python client code generation.
+// The Python packages of the app modules are generated in rootPath.
+func WithPythonClientGeneration(rootPath string) Option {
+	return func(o *generateOptions) {
+		o.pythonClientRootPath = rootPath
+	}
+}
+
+// WithRustClientGeneration adds Rust client code generation.
+// The Rust crate of the app modules is generated in rootPath.
+func WithRustClientGeneration(rootPath string) Option {
+	return func(o *generateOptions) {
+		o.rustClientRootPath = rootPath
+	}
+}
+
+// WithDartClientGeneration adds Dart client code generation.
+// The Dart package of the app modules is generated in rootPath.
+func WithDartClientGeneration(rootPath string) Option {
+	return func(o *generateOptions) {
+		o.dartClientRootPath = rootPath
+	}
+}
+
+// WithDescriptorSetGeneration adds code generation by generators that receive
+// the descriptor set of the proto files of the app, like the code generation
+// targets of plugins.
+func WithDescriptorSetGeneration(generators ...DescriptorSetGenerator) Option {
+	return func(o *generateOptions) {
+		o.descriptorSetGenerators = append(o.descriptorSetGenerators, generators...)
+	}
+}
+
+// WithDescriptorsGeneration adds the generation of the FileDescriptorSet of
+// the proto files of the app modules and of the modules of its dependencies,
+// written to the out file.
+func WithDescriptorsGeneration(out string) Option {
+	return func(o *generateOptions) {
+		o.descriptorsOut = out
+	}
+}
+
 // IncludeDirs configures the third party proto dirs that used by app's proto.
 // relative to the projectPath.
 func IncludeDirs(dirs []string) Option {
@@ -72,6 +151,31 @@ func IncludeDirs(dirs []string) Option {
 	}
 }
 
+// ModuleOptions configures the code generation of a module proto package that
+// uses custom proto options.
+type ModuleOptions struct {
+	// IncludeDirs are the dirs of the proto files defining the custom options,
+	// relative to the projectPath.
+	IncludeDirs []string
+
+	// GoOptions are the parameters of the Go code generator plugin.
+	GoOptions []string
+
+	// OpenAPIOptions are the parameters of the OpenAPI generator plugin.
+	OpenAPIOptions []string
+
+	// TSOptions are the options of the Typescript code generator plugin.
+	TSOptions []string
+}
+
+// WithModuleOptions configures the code generation of the proto packages of
+// modules, the options are indexed by proto package name.
+func WithModuleOptions(options map[string]ModuleOptions) Option {
+	return func(o *generateOptions) {
+		o.moduleOptions = options
+	}
+}
+
 // generator generates code for sdk and sdk apps.
 type generator struct {
 	ctx          context.Context
@@ -83,6 +187,7 @@ type generator struct {
 	deps         []gomodmodule.Version
 	appModules   []module.Module
 	thirdModules map[string][]module.Module // app dependency-modules pair.
+	bufInclude   []string
 }
 
 // Generate generates code from protoDir of an SDK app residing at appPath with given options.
@@ -132,12 +237,56 @@ func Generate(ctx context.Context, cacheStorage cache.Storage, appPath, protoDir
 		}
 	}
 
+	// The composables and the hooks are also added with the ts-client to the
+	// dependencies of the frontend app where they are generated
+	if g.o.composablesOut != nil {
+		if err := g.generateComposables(); err != nil {
+			return err
+		}
+	}
+
+	if g.o.hooksOut != nil {
+		if err := g.generateHooks(); err != nil {
+			return err
+		}
+	}
+
 	if g.o.specOut != "" {
 		if err := generateOpenAPISpec(g); err != nil {
 			return err
 		}
 	}
 
+	if g.o.pythonClientRootPath != "" {
+		if err := g.generatePython(); err != nil {
+			return err
+		}
+	}
+
+	if g.o.rustClientRootPath != "" {
+		if err := g.generateRust(); err != nil {
+			return err
+		}
+	}
+
+	if g.o.dartClientRootPath != "" {
+		if err := g.generateDart(); err != nil {
+			return err
+		}
+	}
+
+	if g.o.descriptorsOut != "" {
+		if err := g.generateDescriptors(); err != nil {
+			return err
+		}
+	}
+
+	if len(g.o.descriptorSetGenerators) > 0 {
+		if err := g.generateFromDescriptorSet(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -148,3 +297,13 @@ func TypescriptModulePath(rootPath string) ModulePathFunc {
 		return filepath.Join(rootPath, m.Pkg.Name)
 	}
 }
+
+// ComposableModulePath generates the paths of the Vue composables and the
+// React hooks of Cosmos SDK modules.
+// The root path is used as prefix for the generated paths.
+func ComposableModulePath(rootPath string) ModulePathFunc {
+	return func(m module.Module) string {
+		replacer := strings.NewReplacer("-", "_", ".", "_")
+		return filepath.Join(rootPath, "use"+strcase.ToCamel(replacer.Replace(m.Pkg.Name)))
+	}
+}
@@ -0,0 +1,73 @@
+package cosmosgen
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+
+	"github.com/ignite/cli/ignite/pkg/protoanalysis"
+)
+
+const (
+	// gatewayService is the name of the services bound to HTTP routes by the
+	// gateway even when their methods have no HTTP rules.
+	gatewayService = "Query"
+
+	// gatewayConfigFile is the name of the gRPC API configuration file of the
+	// gateway and of the OpenAPI generators.
+	gatewayConfigFile = "gateway.yml"
+)
+
+// gatewayConfig returns the gRPC API configuration that binds the methods of
+// the Query services of the package without HTTP rules to GET routes, so the
+// gateway registers the routes of all the queries of the modules. The routes
+// are the package path followed by the method name, e.g. "/mars/blog/posts".
+// It returns nil when all the methods have HTTP rules.
+func gatewayConfig(pkg protoanalysis.Package) []byte {
+	var rules strings.Builder
+	for _, s := range pkg.Services {
+		if s.Name != gatewayService {
+			continue
+		}
+
+		for _, f := range s.RPCFuncs {
+			if len(f.HTTPRules) > 0 {
+				continue
+			}
+
+			fmt.Fprintf(&rules, "    - selector: %s.%s.%s\n", pkg.Name, s.Name, f.Name)
+			fmt.Fprintf(&rules, "      get: %s\n", GatewayRoute(pkg.Name, f.Name))
+		}
+	}
+	if rules.Len() == 0 {
+		return nil
+	}
+
+	return []byte("type: google.api.Service\nconfig_version: 3\nhttp:\n  rules:\n" + rules.String())
+}
+
+// GatewayRoute returns the GET route bound by the gateway to the method of a
+// Query service of the proto package when the method has no HTTP rules.
+func GatewayRoute(pkgName, method string) string {
+	return fmt.Sprintf("/%s/%s", strings.ReplaceAll(pkgName, ".", "/"), strcase.ToSnake(method))
+}
+
+// writeGatewayConfig writes the gRPC API configuration of the package in dir
+// and returns the plugin parameters that use it, or no parameters when the
+// package doesn't need a configuration.
+func writeGatewayConfig(dir string, pkg protoanalysis.Package) ([]string, error) {
+	config := gatewayConfig(pkg)
+	if config == nil {
+		return nil, nil
+	}
+
+	path := filepath.Join(dir, gatewayConfigFile)
+	if err := os.WriteFile(path, config, 0o644); err != nil {
+		return nil, err
+	}
+
+	return []string{"grpc_api_configuration=" + path}, nil
+}
@@ -0,0 +1,39 @@
+package cosmosgen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/protoanalysis"
+)
+
+func TestGatewayConfig(t *testing.T) {
+	pkg := protoanalysis.Package{
+		Name: "mars.blog",
+		Services: []protoanalysis.Service{
+			{
+				Name: "Query",
+				RPCFuncs: []protoanalysis.RPCFunc{
+					{Name: "Params", HTTPRules: []protoanalysis.HTTPRule{{Endpoint: "/mars/blog/params"}}},
+					{Name: "PostsByAuthor"},
+				},
+			},
+			{
+				Name:     "Msg",
+				RPCFuncs: []protoanalysis.RPCFunc{{Name: "CreatePost"}},
+			},
+		},
+	}
+
+	require.Equal(t, `type: google.api.Service
+config_version: 3
+http:
+  rules:
+    - selector: mars.blog.Query.PostsByAuthor
+      get: /mars/blog/posts_by_author
+`, string(gatewayConfig(pkg)))
+
+	pkg.Services[0].RPCFuncs = pkg.Services[0].RPCFuncs[:1]
+	require.Nil(t, gatewayConfig(pkg), "all the queries have HTTP rules")
+}
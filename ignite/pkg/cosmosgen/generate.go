@@ -2,6 +2,7 @@ package cosmosgen
 
 import (
 	"bytes"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
@@ -13,6 +14,8 @@ import (
 	"github.com/ignite/cli/ignite/pkg/cmdrunner/step"
 	"github.com/ignite/cli/ignite/pkg/cosmosanalysis/module"
 	"github.com/ignite/cli/ignite/pkg/gomodule"
+	"github.com/ignite/cli/ignite/pkg/gomodulepath"
+	"github.com/ignite/cli/ignite/pkg/xexec"
 	"github.com/ignite/cli/ignite/pkg/xfilepath"
 )
 
@@ -71,6 +74,13 @@ func (g *generator) setup() (err error) {
 		return err
 	}
 
+	// The dependencies pinned with buf are resolved from the Buf Schema Registry
+	// and take precedence over the proto files found in the Go module cache
+	g.bufInclude, err = g.resolveBufInclude()
+	if err != nil {
+		return err
+	}
+
 	// Discover any custom modules defined by the user's app
 	g.appModules, err = g.discoverModules(g.appPath, g.protoDir)
 	if err != nil {
@@ -135,6 +145,8 @@ func (g *generator) resolveDepencyInclude() ([]string, error) {
 		return nil, err
 	}
 
+	paths = append(paths, g.bufInclude...)
+
 	// Relative paths to proto directories
 	protoDirs := append([]string{g.protoDir}, g.o.includeDirs...)
 
@@ -186,6 +198,43 @@ func (g *generator) resolveInclude(path string) (paths []string, err error) {
 	return paths, nil
 }
 
+// clientInclude returns the include paths to generate the code of all the
+// proto packages of the app modules at once.
+func (g *generator) clientInclude() ([]string, error) {
+	include, err := g.resolveInclude(g.appPath)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range g.appModules {
+		include = g.moduleInclude(include, m.Pkg.Name)
+	}
+
+	return include, nil
+}
+
+// packageNS returns the namespace of the packages of the generated clients.
+func (g *generator) packageNS() (string, error) {
+	chainPath, _, err := gomodulepath.Find(g.appPath)
+	if err != nil {
+		return "", err
+	}
+
+	appModulePath := gomodulepath.ExtractAppPath(chainPath.RawPath)
+	return strings.ReplaceAll(appModulePath, "/", "-"), nil
+}
+
+// findPlugin returns the path of a protoc plugin that is not bundled with
+// Ignite, the error explains how to install the plugin when it is not found.
+func findPlugin(name, install string) (string, error) {
+	path, err := xexec.ResolveAbsPath(name)
+	if err != nil {
+		return "", fmt.Errorf("protoc plugin %s not found, install it with: %s", name, install)
+	}
+
+	return path, nil
+}
+
 func (g *generator) discoverModules(path, protoDir string) ([]module.Module, error) {
 	var filteredModules []module.Module
 
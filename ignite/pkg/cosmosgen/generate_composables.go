@@ -0,0 +1,126 @@
+package cosmosgen
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosanalysis/module"
+	"github.com/ignite/cli/ignite/pkg/workerpool"
+)
+
+var (
+	// vueQueryDependencies are the dependencies of the Vue 3 composables.
+	vueQueryDependencies = map[string]interface{}{
+		"@tanstack/vue-query": "^4.13.0",
+	}
+
+	// reactQueryDependencies are the dependencies of the React hooks.
+	reactQueryDependencies = map[string]interface{}{
+		"@tanstack/react-query": "^4.13.0",
+	}
+)
+
+func (g *generator) generateComposables() error {
+	return g.generateQueryTemplates(
+		g.o.composablesOut,
+		g.o.composablesRootPath,
+		templateComposables,
+		templateComposablesRoot,
+		vueQueryDependencies,
+	)
+}
+
+func (g *generator) generateHooks() error {
+	return g.generateQueryTemplates(
+		g.o.hooksOut,
+		g.o.hooksRootPath,
+		templateHooks,
+		templateHooksRoot,
+		reactQueryDependencies,
+	)
+}
+
+// generateQueryTemplates generates the composables or the hooks of the modules
+// on top of the ts-client and adds them with the ts-client to the dependencies
+// of the frontend app where they are generated.
+func (g *generator) generateQueryTemplates(
+	out func(module.Module) string,
+	rootPath string,
+	moduleTemplate, rootTemplate templateWriter,
+	dependencies map[string]interface{},
+) error {
+	packageNS, err := g.packageNS()
+	if err != nil {
+		return err
+	}
+
+	data := generatePayload{
+		Modules:   g.appModules,
+		PackageNS: packageNS,
+	}
+
+	// The ts-client always includes the third party modules so their queries
+	// and messages are available to the frontend too
+	for _, modules := range g.thirdModules {
+		data.Modules = append(data.Modules, modules...)
+	}
+
+	sort.SliceStable(data.Modules, func(i, j int) bool {
+		return data.Modules[i].Pkg.Name < data.Modules[j].Pkg.Name
+	})
+
+	pool := workerpool.New(g.ctx)
+	for _, m := range data.Modules {
+		m := m
+
+		pool.Go(func(context.Context) error {
+			outDir := out(m)
+			if err := os.MkdirAll(outDir, 0o766); err != nil {
+				return err
+			}
+
+			return moduleTemplate.Write(outDir, "", struct {
+				Module    module.Module
+				PackageNS string
+			}{
+				Module:    m,
+				PackageNS: packageNS,
+			})
+		})
+	}
+
+	if err := pool.Wait(); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(rootPath, 0o766); err != nil {
+		return err
+	}
+
+	if err := rootTemplate.Write(rootPath, "", data); err != nil {
+		return err
+	}
+
+	appDir, ok := findPackageDir(rootPath, g.appPath)
+	if !ok {
+		return nil
+	}
+
+	return g.addPackageDependencies(appDir, dependencies)
+}
+
+// findPackageDir returns the closest parent dir of path that has a package
+// file, the search stops at the app directory.
+func findPackageDir(path, appPath string) (string, bool) {
+	for dir := path; ; dir = filepath.Dir(dir) {
+		if _, err := os.Stat(filepath.Join(dir, "package.json")); err == nil {
+			return dir, true
+		}
+
+		if dir == appPath || dir == filepath.Dir(dir) {
+			return "", false
+		}
+	}
+}
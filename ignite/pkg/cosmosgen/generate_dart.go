@@ -0,0 +1,133 @@
+package cosmosgen
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/ignite/cli/ignite/pkg/protoc"
+)
+
+const dartPlugin = "protoc-gen-dart"
+
+var (
+	dartOut = []string{"--dart_out=grpc:."}
+
+	// dartWalletProtoDirs are the SDK proto packages used by the wallet and the
+	// client of the Dart package to query the accounts and broadcast the txs.
+	dartWalletProtoDirs = []string{
+		"cosmos/auth/v1beta1",
+		"cosmos/crypto/secp256k1",
+		"cosmos/tx/v1beta1",
+	}
+
+	dartInvalidNameCharsRe = regexp.MustCompile(`[^a-z0-9_]+`)
+)
+
+// dartClientPayload is the data of the templates of the Dart package.
+type dartClientPayload struct {
+	generatePayload
+
+	// PackageName is the name of the Dart package.
+	PackageName string
+}
+
+// generateDart generates the null-safe Dart message types and gRPC clients of
+// the app modules, in a package with a wallet to sign their txs and a client
+// to broadcast them with gRPC or gRPC-web.
+func (g *generator) generateDart() error {
+	plugin, err := findPlugin(dartPlugin, "dart pub global activate protoc_plugin")
+	if err != nil {
+		return err
+	}
+
+	include, err := g.clientInclude()
+	if err != nil {
+		return err
+	}
+
+	// The generated code is removed so the proto packages that don't exist
+	// anymore are removed from the package, the wallet and the client are
+	// written next to it from the templates.
+	out := g.o.dartClientRootPath
+	lib := filepath.Join(out, "lib")
+	generated := filepath.Join(lib, "generated")
+	if err := os.RemoveAll(generated); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(generated, 0o766); err != nil {
+		return err
+	}
+
+	protoPaths := []string{filepath.Join(g.appPath, g.protoDir)}
+	for _, dir := range dartWalletProtoDirs {
+		path, err := findProtoDir(include, dir)
+		if err != nil {
+			return err
+		}
+		protoPaths = append(protoPaths, path)
+	}
+
+	cmd, cleanup, err := protoc.Command()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	for _, protoPath := range protoPaths {
+		err = protoc.Generate(
+			g.ctx,
+			generated,
+			protoPath,
+			include,
+			dartOut,
+			protoc.Plugin(plugin),
+			protoc.GenerateDependencies(),
+			protoc.WithCommand(cmd),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	packageNS, err := g.packageNS()
+	if err != nil {
+		return err
+	}
+
+	payload := dartClientPayload{
+		generatePayload: generatePayload{
+			Modules:   g.appModules,
+			PackageNS: packageNS,
+		},
+		PackageName: dartPackageName(packageNS),
+	}
+
+	if err := templateDartClientRoot.Write(out, "", payload); err != nil {
+		return err
+	}
+
+	return templateDartClientLib.Write(lib, "", payload)
+}
+
+// findProtoDir returns the path of the proto package dir in the first include
+// path where it exists.
+func findProtoDir(include []string, dir string) (string, error) {
+	for _, path := range include {
+		p := filepath.Join(path, filepath.FromSlash(dir))
+		if info, err := os.Stat(p); err == nil && info.IsDir() {
+			return p, nil
+		}
+	}
+
+	return "", fmt.Errorf("proto package %s not found in the dependencies of the app", dir)
+}
+
+// dartPackageName returns the name of the Dart package of the client for the
+// package namespace, Dart package names are lower case identifiers.
+func dartPackageName(packageNS string) string {
+	name := dartInvalidNameCharsRe.ReplaceAllString(strings.ToLower(packageNS), "_")
+	return strings.Trim(name, "_") + "_client"
+}
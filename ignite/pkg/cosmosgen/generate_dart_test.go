@@ -0,0 +1,41 @@
+package cosmosgen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDartPackageName(t *testing.T) {
+	tests := []struct {
+		packageNS string
+		want      string
+	}{
+		{"mars", "mars_client"},
+		{"ignite-mars", "ignite_mars_client"},
+		{"Ignite.Mars", "ignite_mars_client"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.packageNS, func(t *testing.T) {
+			require.Equal(t, tt.want, dartPackageName(tt.packageNS))
+		})
+	}
+}
+
+func TestFindProtoDir(t *testing.T) {
+	var (
+		app = t.TempDir()
+		sdk = t.TempDir()
+		dir = filepath.Join(sdk, "cosmos", "tx", "v1beta1")
+	)
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+
+	path, err := findProtoDir([]string{app, sdk}, "cosmos/tx/v1beta1")
+	require.NoError(t, err)
+	require.Equal(t, dir, path)
+
+	_, err = findProtoDir([]string{app, sdk}, "cosmos/auth/v1beta1")
+	require.Error(t, err)
+}
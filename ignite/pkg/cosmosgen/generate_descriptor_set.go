@@ -0,0 +1,86 @@
+package cosmosgen
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/ignite/cli/ignite/pkg/protoanalysis"
+	"github.com/ignite/cli/ignite/pkg/protoc"
+)
+
+// DescriptorSet is the descriptor set of the proto files of an app.
+type DescriptorSet struct {
+	// AppPath is the path of the app.
+	AppPath string
+
+	// Content is the serialized FileDescriptorSet of the proto files of the app
+	// and of the files they import, with their source info.
+	Content []byte
+
+	// Files are the names of the proto files of the app in the descriptor set,
+	// the files to generate the code of.
+	Files []string
+}
+
+// DescriptorSetGenerator generates code from the descriptor set of the proto
+// files of an app.
+type DescriptorSetGenerator func(ctx context.Context, set DescriptorSet) error
+
+// generateFromDescriptorSet builds the descriptor set of the proto files of the
+// app once and generates code with each descriptor set generator.
+func (g *generator) generateFromDescriptorSet() error {
+	include, err := g.clientInclude()
+	if err != nil {
+		return err
+	}
+
+	dir, err := os.MkdirTemp("", "descriptor-set")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	protoPath := filepath.Join(g.appPath, g.protoDir)
+	out := filepath.Join(dir, "descriptor-set.bin")
+	if err := protoc.DescriptorSet(g.ctx, out, protoPath, include); err != nil {
+		return err
+	}
+
+	set := DescriptorSet{AppPath: g.appPath}
+	if set.Content, err = os.ReadFile(out); err != nil {
+		return err
+	}
+	if set.Files, err = protoFileNames(g.ctx, protoPath); err != nil {
+		return err
+	}
+
+	for _, generate := range g.o.descriptorSetGenerators {
+		if err := generate(g.ctx, set); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// protoFileNames returns the names of the proto files of protoPath relative to it.
+func protoFileNames(ctx context.Context, protoPath string) ([]string, error) {
+	packages, err := protoanalysis.Parse(ctx, protoanalysis.NewCache(), protoPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, path := range packages.Files().Paths() {
+		name, err := filepath.Rel(protoPath, path)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, filepath.ToSlash(name))
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
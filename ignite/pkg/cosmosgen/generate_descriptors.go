@@ -0,0 +1,119 @@
+package cosmosgen
+
+import (
+	"os"
+	"path/filepath"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosanalysis/module"
+	"github.com/ignite/cli/ignite/pkg/protoc"
+)
+
+// generateDescriptors writes the FileDescriptorSet of the proto files of the
+// app modules and of the modules of its dependencies, like the Cosmos SDK
+// modules, with the files they import and their source info.
+func (g *generator) generateDescriptors() error {
+	// The protoc command is shared by the modules
+	command, cleanup, err := protoc.Command()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	dir, err := os.MkdirTemp("", "descriptors")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	var set descriptorSet
+
+	add := func(src string, modules []module.Module) error {
+		include, err := g.resolveInclude(src)
+		if err != nil {
+			return err
+		}
+
+		for _, m := range modules {
+			out := filepath.Join(dir, "descriptor-set.bin")
+			err := protoc.DescriptorSet(
+				g.ctx,
+				out,
+				m.Pkg.Path,
+				g.moduleInclude(include, m.Pkg.Name),
+				protoc.WithCommand(command),
+			)
+			if err != nil {
+				return err
+			}
+
+			content, err := os.ReadFile(out)
+			if err != nil {
+				return err
+			}
+			if err := set.add(content); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if err := add(g.appPath, g.appModules); err != nil {
+		return err
+	}
+	for src, modules := range g.thirdModules {
+		if err := add(src, modules); err != nil {
+			return err
+		}
+	}
+
+	content, err := set.bytes()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(g.o.descriptorsOut), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(g.o.descriptorsOut, content, 0o644)
+}
+
+// descriptorSet merges the descriptor sets of proto packages, the files
+// imported by several packages are only added once.
+type descriptorSet struct {
+	files []*descriptorpb.FileDescriptorProto
+	names map[string]bool
+}
+
+// add adds the files of the serialized FileDescriptorSet that are not part of
+// the set. The files of a set are sorted so that a file follows the files it
+// imports, adding them in order keeps the merged set sorted.
+func (s *descriptorSet) add(content []byte) error {
+	var set descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(content, &set); err != nil {
+		return err
+	}
+
+	if s.names == nil {
+		s.names = make(map[string]bool)
+	}
+
+	for _, f := range set.File {
+		if s.names[f.GetName()] {
+			continue
+		}
+		s.names[f.GetName()] = true
+		s.files = append(s.files, f)
+	}
+
+	return nil
+}
+
+// bytes returns the serialized FileDescriptorSet of the files of the set.
+func (s *descriptorSet) bytes() ([]byte, error) {
+	return proto.Marshal(&descriptorpb.FileDescriptorSet{File: s.files})
+}
@@ -0,0 +1,42 @@
+package cosmosgen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestDescriptorSet(t *testing.T) {
+	marshal := func(names ...string) []byte {
+		var set descriptorpb.FileDescriptorSet
+		for _, name := range names {
+			set.File = append(set.File, &descriptorpb.FileDescriptorProto{Name: proto.String(name)})
+		}
+		content, err := proto.Marshal(&set)
+		require.NoError(t, err)
+		return content
+	}
+
+	var set descriptorSet
+	require.NoError(t, set.add(marshal("gogoproto/gogo.proto", "mars/blog/query.proto")))
+	require.NoError(t, set.add(marshal("gogoproto/gogo.proto", "cosmos/bank/v1beta1/query.proto")))
+	require.Error(t, set.add([]byte("invalid")))
+
+	content, err := set.bytes()
+	require.NoError(t, err)
+
+	var merged descriptorpb.FileDescriptorSet
+	require.NoError(t, proto.Unmarshal(content, &merged))
+
+	var names []string
+	for _, f := range merged.File {
+		names = append(names, f.GetName())
+	}
+	require.Equal(t, []string{
+		"gogoproto/gogo.proto",
+		"mars/blog/query.proto",
+		"cosmos/bank/v1beta1/query.proto",
+	}, names)
+}
@@ -1,16 +1,22 @@
 package cosmosgen
 
 import (
+	"bytes"
+	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
 
-	"github.com/otiai10/copy"
 	"github.com/pkg/errors"
 
+	"github.com/ignite/cli/ignite/pkg/cache"
 	"github.com/ignite/cli/ignite/pkg/protoanalysis"
 	"github.com/ignite/cli/ignite/pkg/protoc"
 )
 
+const goCacheNamespace = "generate.go.files"
+
 var goOuts = []string{
 	"--gocosmos_out=plugins=interfacetype+grpc,Mgoogle/protobuf/any.proto=github.com/cosmos/cosmos-sdk/codec/types:.",
 	"--grpc-gateway_out=logtostderr=true:.",
@@ -22,40 +28,128 @@ func (g *generator) generateGo() error {
 		return err
 	}
 
+	// discover proto packages in the app.
+	pp := filepath.Join(g.appPath, g.protoDir)
+	pkgs, err := protoanalysis.Parse(g.ctx, nil, pp)
+	if err != nil {
+		return err
+	}
+
+	// The generated code of each package is cached with the checksum of its proto
+	// files and of the files they import so only the changed packages are generated
+	goCache := cache.New[generatedFiles](g.cacheStorage, goCacheNamespace)
+
+	// code generate for each module.
+	for _, pkg := range pkgs {
+		var (
+			include = g.moduleInclude(includePaths, pkg.Name)
+			outs    = moduleOuts(goOuts, "gocosmos", g.o.moduleOptions[pkg.Name].GoOptions)
+		)
+
+		checksum, err := packageChecksum(pkg, include)
+		if err != nil {
+			return err
+		}
+
+		// The routes bound by the gateway config are part of the generated code
+		cacheKey := cache.Key(fmt.Sprintf("%x", checksum), strings.Join(outs, ","), string(gatewayConfig(pkg)))
+		files, err := goCache.Get(cacheKey)
+		if err != nil && err != cache.ErrorNotFound {
+			return err
+		}
+
+		if err == cache.ErrorNotFound {
+			if files, err = g.generateGoPackage(pkg, include, outs); err != nil {
+				return err
+			}
+
+			if err := goCache.Put(cacheKey, files); err != nil {
+				return err
+			}
+		}
+
+		// move generated code for the app under the relative locations in its source code.
+		if err := files.write(g.appPath); err != nil {
+			return errors.Wrap(err, "cannot write generated code")
+		}
+	}
+
+	return nil
+}
+
+// generateGoPackage generates the Go code of a proto package and returns the
+// generated files of the app.
+func (g *generator) generateGoPackage(pkg protoanalysis.Package, include, outs []string) (generatedFiles, error) {
 	// created a temporary dir to locate generated code under which later only some of them will be moved to the
 	// app's source code. this also prevents having leftover files in the app's source code or its parent dir -when
 	// command executed directly there- in case of an interrupt.
 	tmp, err := os.MkdirTemp("", "")
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer os.RemoveAll(tmp)
 
-	// discover proto packages in the app.
-	pp := filepath.Join(g.appPath, g.protoDir)
-	pkgs, err := protoanalysis.Parse(g.ctx, nil, pp)
+	gatewayParams, err := writeGatewayConfig(tmp, pkg)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	outs = moduleOuts(outs, "grpc-gateway", gatewayParams)
 
-	// code generate for each module.
-	for _, pkg := range pkgs {
-		if err := protoc.Generate(g.ctx, tmp, pkg.Path, includePaths, goOuts); err != nil {
+	if err := protoc.Generate(g.ctx, tmp, pkg.Path, include, outs); err != nil {
+		return nil, err
+	}
+
+	return readGeneratedFiles(filepath.Join(tmp, g.o.gomodPath))
+}
+
+// generatedFiles are the contents of generated files by path relative to the
+// app directory.
+type generatedFiles map[string][]byte
+
+// readGeneratedFiles reads the files generated in dir.
+func readGeneratedFiles(dir string) (generatedFiles, error) {
+	files := make(generatedFiles)
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
 			return err
 		}
-	}
 
-	// move generated code for the app under the relative locations in its source code.
-	generatedPath := filepath.Join(tmp, g.o.gomodPath)
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
 
-	_, err = os.Stat(generatedPath)
-	if err == nil {
-		err = copy.Copy(generatedPath, g.appPath)
+		rel, err := filepath.Rel(dir, path)
 		if err != nil {
-			return errors.Wrap(err, "cannot copy path")
+			return err
+		}
+
+		files[filepath.ToSlash(rel)] = content
+		return nil
+	})
+	if os.IsNotExist(err) {
+		return files, nil
+	}
+
+	return files, err
+}
+
+// write writes the files in dir, the files that didn't change are not written
+// again so the source watcher of the app isn't triggered by them.
+func (f generatedFiles) write(dir string) error {
+	for name, content := range f {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if existing, err := os.ReadFile(path); err == nil && bytes.Equal(existing, content) {
+			continue
+		}
+
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+
+		if err := os.WriteFile(path, content, 0o644); err != nil {
+			return err
 		}
-	} else if !os.IsNotExist(err) {
-		return err
 	}
 
 	return nil
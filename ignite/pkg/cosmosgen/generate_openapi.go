@@ -5,13 +5,14 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
 
 	"github.com/iancoleman/strcase"
 
 	"github.com/ignite/cli/ignite/pkg/cache"
 	"github.com/ignite/cli/ignite/pkg/cosmosanalysis/module"
 	"github.com/ignite/cli/ignite/pkg/dirchange"
-	swaggercombine "github.com/ignite/cli/ignite/pkg/nodetime/programs/swagger-combine"
+	"github.com/ignite/cli/ignite/pkg/openapi"
 	"github.com/ignite/cli/ignite/pkg/protoc"
 )
 
@@ -19,16 +20,15 @@ var openAPIOut = []string{
 	"--openapiv2_out=logtostderr=true,allow_merge=true,json_names_for_fields=false,fqn_for_openapi_name=true,simple_operation_ids=true,Mgoogle/protobuf/any.proto=github.com/cosmos/cosmos-sdk/codec/types:.",
 }
 
-const specCacheNamespace = "generate.openapi.spec"
+// The namespace changes with the OpenAPI version of the combined spec so the
+// specs combined with a previous version are generated again.
+const specCacheNamespace = "generate.openapi.v3.spec"
 
 func generateOpenAPISpec(g *generator) error {
 	var (
 		specDirs []string
-		conf     = swaggercombine.Config{
-			Swagger: "2.0",
-			Info: swaggercombine.Info{
-				Title: "HTTP API Console",
-			},
+		conf     = openapi.Config{
+			Title: "HTTP API Console",
 		}
 	)
 
@@ -43,7 +43,7 @@ func generateOpenAPISpec(g *generator) error {
 	var hasAnySpecChanged bool
 
 	// gen generates a spec for a module where it's source code resides at src.
-	// and adds needed swaggercombine configure for it.
+	// and adds it to the openapi config.
 	gen := func(src string, m module.Module) (err error) {
 		dir, err := os.MkdirTemp("", "gen-openapi-module-spec")
 		if err != nil {
@@ -51,12 +51,39 @@ func generateOpenAPISpec(g *generator) error {
 		}
 		specPath := filepath.Join(dir, "apidocs.swagger.json")
 
+		include, err := g.resolveInclude(src)
+		if err != nil {
+			return err
+		}
+		include = g.moduleInclude(include, m.Pkg.Name)
+
+		// The custom options of the module are part of the spec
+		opts := g.o.moduleOptions[m.Pkg.Name]
 		checksumPaths := append([]string{m.Pkg.Path}, g.o.includeDirs...)
+		checksumPaths = g.moduleInclude(checksumPaths, m.Pkg.Name)
 		checksum, err := dirchange.ChecksumFromPaths(src, checksumPaths...)
 		if err != nil {
 			return err
 		}
-		cacheKey := fmt.Sprintf("%x", checksum)
+
+		// The spec is generated again when an imported proto file changes
+		importsChecksum, err := packageChecksum(m.Pkg, include)
+		if err != nil {
+			return err
+		}
+
+		// The gateway config is only used for the custom modules
+		var gatewayKey string
+		if src == g.appPath {
+			gatewayKey = string(gatewayConfig(m.Pkg))
+		}
+
+		cacheKey := cache.Key(
+			fmt.Sprintf("%x", checksum),
+			fmt.Sprintf("%x", importsChecksum),
+			strings.Join(opts.OpenAPIOptions, ","),
+			gatewayKey,
+		)
 		existingSpec, err := specCache.Get(cacheKey)
 		if err != nil && err != cache.ErrorNotFound {
 			return err
@@ -68,9 +95,16 @@ func generateOpenAPISpec(g *generator) error {
 			}
 		} else {
 			hasAnySpecChanged = true
-			include, err := g.resolveInclude(src)
-			if err != nil {
-				return err
+
+			// The spec of a custom module documents the routes bound by the
+			// gateway config of the module
+			params := append([]string{}, opts.OpenAPIOptions...)
+			if src == g.appPath {
+				gatewayParams, err := writeGatewayConfig(dir, m.Pkg)
+				if err != nil {
+					return err
+				}
+				params = append(params, gatewayParams...)
 			}
 
 			err = protoc.Generate(
@@ -78,7 +112,7 @@ func generateOpenAPISpec(g *generator) error {
 				dir,
 				m.Pkg.Path,
 				include,
-				openAPIOut,
+				moduleOuts(openAPIOut, "openapiv2", params),
 			)
 			if err != nil {
 				return err
@@ -99,8 +133,8 @@ func generateOpenAPISpec(g *generator) error {
 	}
 
 	// generate specs for each module and persist them in the file system
-	// after add their path and config to swaggercombine.Config so we can combine them
-	// into a single spec.
+	// after add them to openapi.Config so we can combine them into a single
+	// OpenAPI 3 spec.
 
 	add := func(src string, modules []module.Module) error {
 		for _, m := range modules {
@@ -117,6 +151,10 @@ func generateOpenAPISpec(g *generator) error {
 		return err
 	}
 
+	// The routes of the custom modules have precedence over the conflicting
+	// routes of the third party modules.
+	appAPIs := len(conf.APIs)
+
 	for src, modules := range g.thirdModules {
 		if err := add(src, modules); err != nil {
 			return err
@@ -137,7 +175,9 @@ func generateOpenAPISpec(g *generator) error {
 		}
 	}
 
-	sort.Slice(conf.APIs, func(a, b int) bool { return conf.APIs[a].ID < conf.APIs[b].ID })
+	for _, apis := range [][]openapi.API{conf.APIs[:appAPIs], conf.APIs[appAPIs:]} {
+		sort.Slice(apis, func(a, b int) bool { return apis[a].ID < apis[b].ID })
+	}
 
 	// ensure out dir exists.
 	outDir := filepath.Dir(out)
@@ -146,7 +186,7 @@ func generateOpenAPISpec(g *generator) error {
 	}
 
 	// combine specs into one and save to out.
-	if err := swaggercombine.Combine(g.ctx, conf, out); err != nil {
+	if err := openapi.Combine(conf, out); err != nil {
 		return err
 	}
 
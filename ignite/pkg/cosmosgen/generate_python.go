@@ -0,0 +1,57 @@
+package cosmosgen
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ignite/cli/ignite/pkg/protoc"
+)
+
+const pythonPlugin = "protoc-gen-python_betterproto"
+
+var pythonOut = []string{"--python_betterproto_out=."}
+
+// generatePython generates the Python message types and gRPC clients of the
+// app modules with betterproto.
+func (g *generator) generatePython() error {
+	plugin, err := findPlugin(pythonPlugin, `pip install "betterproto[compiler]"`)
+	if err != nil {
+		return err
+	}
+
+	include, err := g.clientInclude()
+	if err != nil {
+		return err
+	}
+
+	out := g.o.pythonClientRootPath
+	if err := os.MkdirAll(out, 0o766); err != nil {
+		return err
+	}
+
+	// betterproto generates a Python package for each proto package, the app
+	// proto files are generated at once so the packages shared by the modules
+	// and their dependencies contain all of their messages.
+	err = protoc.Generate(
+		g.ctx,
+		out,
+		filepath.Join(g.appPath, g.protoDir),
+		include,
+		pythonOut,
+		protoc.Plugin(plugin),
+		protoc.GenerateDependencies(),
+	)
+	if err != nil {
+		return err
+	}
+
+	packageNS, err := g.packageNS()
+	if err != nil {
+		return err
+	}
+
+	return templatePythonClientRoot.Write(out, "", generatePayload{
+		Modules:   g.appModules,
+		PackageNS: packageNS,
+	})
+}
@@ -0,0 +1,149 @@
+package cosmosgen
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ignite/cli/ignite/pkg/protoc"
+)
+
+const (
+	rustPlugin     = "protoc-gen-prost"
+	rustGRPCPlugin = "protoc-gen-tonic"
+)
+
+var (
+	rustOut     = []string{"--prost_out=."}
+	rustGRPCOut = []string{"--tonic_out=."}
+)
+
+// generateRust generates the Rust message types with prost and the gRPC clients
+// with tonic of the app modules, in a crate.
+func (g *generator) generateRust() error {
+	plugin, err := findPlugin(rustPlugin, "cargo install protoc-gen-prost")
+	if err != nil {
+		return err
+	}
+
+	grpcPlugin, err := findPlugin(rustGRPCPlugin, "cargo install protoc-gen-tonic")
+	if err != nil {
+		return err
+	}
+
+	include, err := g.clientInclude()
+	if err != nil {
+		return err
+	}
+
+	// The sources of the crate are all generated, they are removed so the
+	// proto packages that don't exist anymore are removed from the crate.
+	out := g.o.rustClientRootPath
+	src := filepath.Join(out, "src")
+	if err := os.RemoveAll(src); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(src, 0o766); err != nil {
+		return err
+	}
+
+	protoPath := filepath.Join(g.appPath, g.protoDir)
+	for _, gen := range []struct {
+		plugin string
+		outs   []string
+	}{
+		{plugin, rustOut},
+		{grpcPlugin, rustGRPCOut},
+	} {
+		err = protoc.Generate(
+			g.ctx,
+			src,
+			protoPath,
+			include,
+			gen.outs,
+			protoc.Plugin(gen.plugin),
+			protoc.GenerateDependencies(),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := writeRustLib(src); err != nil {
+		return err
+	}
+
+	packageNS, err := g.packageNS()
+	if err != nil {
+		return err
+	}
+
+	return templateRustClientRoot.Write(out, "", generatePayload{
+		Modules:   g.appModules,
+		PackageNS: packageNS,
+	})
+}
+
+// rustModule is a Rust module of a proto package, or of a part of its name.
+type rustModule struct {
+	includes []string
+	modules  map[string]*rustModule
+}
+
+// writeRustLib writes the lib.rs of the crate that includes the files
+// generated for each proto package, prost names these files after the proto
+// packages, e.g. cosmos.bank.v1beta1.rs, and tonic adds the .tonic.rs suffix.
+// The modules are nested like the proto packages so the generated code can
+// refer to the types of the other packages with their relative paths.
+func writeRustLib(src string) error {
+	files, err := filepath.Glob(filepath.Join(src, "*.rs"))
+	if err != nil {
+		return err
+	}
+	sort.Strings(files)
+
+	root := &rustModule{}
+	for _, file := range files {
+		name := filepath.Base(file)
+		pkg := strings.TrimSuffix(strings.TrimSuffix(name, ".rs"), ".tonic")
+
+		m := root
+		for _, part := range strings.Split(pkg, ".") {
+			if m.modules == nil {
+				m.modules = make(map[string]*rustModule)
+			}
+			if m.modules[part] == nil {
+				m.modules[part] = &rustModule{}
+			}
+			m = m.modules[part]
+		}
+		m.includes = append(m.includes, name)
+	}
+
+	var b strings.Builder
+	b.WriteString("// Generated by Ignite ignite.com/cli\n")
+	writeRustModules(&b, root, 0)
+
+	return os.WriteFile(filepath.Join(src, "lib.rs"), []byte(b.String()), 0o644)
+}
+
+func writeRustModules(b *strings.Builder, m *rustModule, depth int) {
+	indent := strings.Repeat("    ", depth)
+	for _, name := range m.includes {
+		fmt.Fprintf(b, "%sinclude!(%q);\n", indent, name)
+	}
+
+	names := make([]string, 0, len(m.modules))
+	for name := range m.modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fmt.Fprintf(b, "%spub mod %s {\n", indent, name)
+		writeRustModules(b, m.modules[name], depth+1)
+		fmt.Fprintf(b, "%s}\n", indent)
+	}
+}
@@ -0,0 +1,42 @@
+package cosmosgen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteRustLib(t *testing.T) {
+	src := t.TempDir()
+	for _, name := range []string{
+		"cosmos.base.v1beta1.rs",
+		"mars.blog.rs",
+		"mars.blog.tonic.rs",
+		"mars.rs",
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(src, name), nil, 0o644))
+	}
+
+	require.NoError(t, writeRustLib(src))
+
+	lib, err := os.ReadFile(filepath.Join(src, "lib.rs"))
+	require.NoError(t, err)
+	require.Equal(t, `// Generated by Ignite ignite.com/cli
+pub mod cosmos {
+    pub mod base {
+        pub mod v1beta1 {
+            include!("cosmos.base.v1beta1.rs");
+        }
+    }
+}
+pub mod mars {
+    include!("mars.rs");
+    pub mod blog {
+        include!("mars.blog.rs");
+        include!("mars.blog.tonic.rs");
+    }
+}
+`, string(lib))
+}
@@ -2,20 +2,19 @@ package cosmosgen
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 	"sort"
 	"strings"
 
-	"golang.org/x/sync/errgroup"
-
 	"github.com/ignite/cli/ignite/pkg/cache"
 	"github.com/ignite/cli/ignite/pkg/cosmosanalysis/module"
 	"github.com/ignite/cli/ignite/pkg/dirchange"
-	"github.com/ignite/cli/ignite/pkg/gomodulepath"
 	"github.com/ignite/cli/ignite/pkg/nodetime/programs/sta"
 	tsproto "github.com/ignite/cli/ignite/pkg/nodetime/programs/ts-proto"
 	"github.com/ignite/cli/ignite/pkg/protoc"
+	"github.com/ignite/cli/ignite/pkg/workerpool"
 )
 
 var (
@@ -38,15 +37,14 @@ func newTSGenerator(g *generator) *tsGenerator {
 }
 
 func (g *generator) generateTS() error {
-	chainPath, _, err := gomodulepath.Find(g.appPath)
+	packageNS, err := g.packageNS()
 	if err != nil {
 		return err
 	}
 
-	appModulePath := gomodulepath.ExtractAppPath(chainPath.RawPath)
 	data := generatePayload{
 		Modules:   g.appModules,
-		PackageNS: strings.ReplaceAll(appModulePath, "/", "-"),
+		PackageNS: packageNS,
 	}
 
 	// Third party modules are always required to generate the root
@@ -94,15 +92,35 @@ func (g *tsGenerator) generateModuleTemplates() error {
 
 	defer cleanupSTA()
 
-	gg := &errgroup.Group{}
+	// Each module generation runs protoc and Node.js processes, the pool limits
+	// the number of processes and stops them when the generation is canceled
+	pool := workerpool.New(g.g.ctx)
 	dirCache := cache.New[[]byte](g.g.cacheStorage, dirchangeCacheNamespace)
 	add := func(sourcePath string, modules []module.Module) {
 		for _, m := range modules {
 			m := m
 
-			gg.Go(func() error {
-				cacheKey := m.Pkg.Path
+			pool.Go(func(ctx context.Context) error {
+				// The checksum of the imported proto files regenerates the client
+				// of the module when a proto file of a dependency changes
+				include, err := g.g.resolveInclude(sourcePath)
+				if err != nil {
+					return err
+				}
+				checksum, err := packageChecksum(m.Pkg, g.g.moduleInclude(include, m.Pkg.Name))
+				if err != nil {
+					return err
+				}
+
+				opts := g.g.o.moduleOptions[m.Pkg.Name]
+				cacheKey := cache.Key(
+					m.Pkg.Path,
+					fmt.Sprintf("%x", checksum),
+					strings.Join(opts.TSOptions, ","),
+					strings.Join(opts.OpenAPIOptions, ","),
+				)
 				paths := append([]string{m.Pkg.Path, g.g.o.jsOut(m)}, g.g.o.includeDirs...)
+				paths = g.g.moduleInclude(paths, m.Pkg.Name)
 				changed, err := dirchange.HasDirChecksumChanged(dirCache, cacheKey, sourcePath, paths...)
 				if err != nil {
 					return err
@@ -112,7 +130,7 @@ func (g *tsGenerator) generateModuleTemplates() error {
 					return nil
 				}
 
-				err = g.generateModuleTemplate(g.g.ctx, protocCmd, staCmd, tsprotoPluginPath, sourcePath, m)
+				err = g.generateModuleTemplate(ctx, protocCmd, staCmd, tsprotoPluginPath, sourcePath, m)
 				if err != nil {
 					return err
 				}
@@ -133,7 +151,7 @@ func (g *tsGenerator) generateModuleTemplates() error {
 		add(sourcePath, modules)
 	}
 
-	return gg.Wait()
+	return pool.Wait()
 }
 
 func (g *tsGenerator) generateModuleTemplate(
@@ -146,12 +164,19 @@ func (g *tsGenerator) generateModuleTemplate(
 	var (
 		out      = g.g.o.jsOut(m)
 		typesOut = filepath.Join(out, "types")
+		opts     = g.g.o.moduleOptions[m.Pkg.Name]
 	)
 
 	includePaths, err := g.g.resolveInclude(appPath)
 	if err != nil {
 		return err
 	}
+	includePaths = g.g.moduleInclude(includePaths, m.Pkg.Name)
+
+	tsprotoOptions := []string{"--ts_proto_opt=snakeToCamel=true", "--ts_proto_opt=esModuleInterop=true"}
+	for _, o := range opts.TSOptions {
+		tsprotoOptions = append(tsprotoOptions, "--ts_proto_opt="+o)
+	}
 
 	if err := os.MkdirAll(typesOut, 0o766); err != nil {
 		return err
@@ -164,7 +189,7 @@ func (g *tsGenerator) generateModuleTemplate(
 		m.Pkg.Path,
 		includePaths,
 		tsOut,
-		protoc.Plugin(tsprotoPluginPath, "--ts_proto_opt=snakeToCamel=true", "--ts_proto_opt=esModuleInterop=true"),
+		protoc.Plugin(tsprotoPluginPath, tsprotoOptions...),
 		protoc.Env("NODE_OPTIONS="), // unset nodejs options to avoid unexpected issues with vercel "pkg"
 		protoc.WithCommand(protocCmd),
 	)
@@ -185,7 +210,7 @@ func (g *tsGenerator) generateModuleTemplate(
 		oaitemp,
 		m.Pkg.Path,
 		includePaths,
-		jsOpenAPIOut,
+		moduleOuts(jsOpenAPIOut, "openapiv2", opts.OpenAPIOptions),
 		protoc.WithCommand(protocCmd),
 	)
 	if err != nil {
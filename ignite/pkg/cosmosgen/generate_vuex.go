@@ -1,6 +1,7 @@
 package cosmosgen
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -8,12 +9,11 @@ import (
 	"path/filepath"
 	"strings"
 
-	"golang.org/x/sync/errgroup"
-
 	"github.com/imdario/mergo"
 
 	"github.com/ignite/cli/ignite/pkg/cosmosanalysis/module"
 	"github.com/ignite/cli/ignite/pkg/gomodulepath"
+	"github.com/ignite/cli/ignite/pkg/workerpool"
 )
 
 type vuexGenerator struct {
@@ -32,7 +32,15 @@ func (g *generator) updateVueDependencies() error {
 		return nil
 	}
 
-	// Read the Vue app package file
+	return g.addPackageDependencies(vuePath, nil)
+}
+
+// addPackageDependencies adds the link to the ts-client and the dependencies
+// to the package file of the frontend app in appDir.
+func (g *generator) addPackageDependencies(appDir string, dependencies map[string]interface{}) error {
+	packagesPath := filepath.Join(appDir, "package.json")
+
+	// Read the app package file
 	b, err := os.ReadFile(packagesPath)
 	if err != nil {
 		return err
@@ -55,22 +63,25 @@ func (g *generator) updateVueDependencies() error {
 		return fmt.Errorf("failed to read the absolute typescript client path: %w", err)
 	}
 
-	// Add the link to the ts-client to the VUE app dependencies
+	// Add the link to the ts-client to the app dependencies
 	appModulePath := gomodulepath.ExtractAppPath(chainPath.RawPath)
 	tsClientNS := strings.ReplaceAll(appModulePath, "/", "-")
 	tsClientName := fmt.Sprintf("%s-client-ts", tsClientNS)
-	tsClientRelPath, err := filepath.Rel(vuePath, tsClientPath)
+	tsClientRelPath, err := filepath.Rel(appDir, tsClientPath)
 	if err != nil {
 		return err
 	}
 
-	err = mergo.Merge(&pkg, map[string]interface{}{
-		"dependencies": map[string]interface{}{
-			tsClientName: fmt.Sprintf("file:%s", tsClientRelPath),
-		},
-	})
+	deps := map[string]interface{}{
+		tsClientName: fmt.Sprintf("file:%s", tsClientRelPath),
+	}
+	for name, version := range dependencies {
+		deps[name] = version
+	}
+
+	err = mergo.Merge(&pkg, map[string]interface{}{"dependencies": deps})
 	if err != nil {
-		return fmt.Errorf("failed to link ts-client dependency in the Vue app: %w", err)
+		return fmt.Errorf("failed to link ts-client dependency in %s: %w", packagesPath, err)
 	}
 
 	// Save the modified package.json with the new dependencies
@@ -117,17 +128,17 @@ func (g *generator) generateVuex() error {
 }
 
 func (g *vuexGenerator) generateVueTemplates(p generatePayload) error {
-	gg := &errgroup.Group{}
+	pool := workerpool.New(g.g.ctx)
 
 	for _, m := range p.Modules {
 		m := m
 
-		gg.Go(func() error {
+		pool.Go(func(context.Context) error {
 			return g.generateVueTemplate(m, p)
 		})
 	}
 
-	return gg.Wait()
+	return pool.Wait()
 }
 
 func (g *vuexGenerator) generateVueTemplate(m module.Module, p generatePayload) error {
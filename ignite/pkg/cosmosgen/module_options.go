@@ -0,0 +1,45 @@
+package cosmosgen
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// moduleInclude returns the include paths to generate the code of a proto package,
+// which are the include paths followed by the dirs of the package's custom options.
+func (g *generator) moduleInclude(include []string, pkgName string) []string {
+	paths := append([]string{}, include...)
+	for _, dir := range g.o.moduleOptions[pkgName].IncludeDirs {
+		paths = append(paths, filepath.Join(g.appPath, dir))
+	}
+	return paths
+}
+
+// moduleOuts returns the protoc out flags to generate the code of a proto package,
+// the parameters are added to the out flags of the plugin.
+func moduleOuts(outs []string, plugin string, params []string) []string {
+	moduleOuts := make([]string, len(outs))
+	for i, out := range outs {
+		if strings.HasPrefix(out, "--"+plugin+"_out=") {
+			out = withPluginParams(out, params)
+		}
+		moduleOuts[i] = out
+	}
+	return moduleOuts
+}
+
+// withPluginParams adds parameters to a protoc plugin out flag with the
+// "--name_out=params:dir" format.
+func withPluginParams(out string, params []string) string {
+	if len(params) == 0 {
+		return out
+	}
+
+	flag, value, _ := strings.Cut(out, "=")
+	dir := value
+	if i := strings.LastIndex(value, ":"); i >= 0 {
+		params = append(strings.Split(value[:i], ","), params...)
+		dir = value[i+1:]
+	}
+	return flag + "=" + strings.Join(params, ",") + ":" + dir
+}
@@ -0,0 +1,64 @@
+package cosmosgen
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestModuleOuts(t *testing.T) {
+	cases := []struct {
+		name   string
+		outs   []string
+		plugin string
+		params []string
+		want   []string
+	}{
+		{
+			name:   "no params",
+			outs:   goOuts,
+			plugin: "gocosmos",
+			want:   goOuts,
+		},
+		{
+			name:   "plugin with params",
+			outs:   []string{"--gocosmos_out=plugins=grpc:.", "--grpc-gateway_out=logtostderr=true:."},
+			plugin: "gocosmos",
+			params: []string{"Mfoo/bar.proto=example.com/foo/bar"},
+			want: []string{
+				"--gocosmos_out=plugins=grpc,Mfoo/bar.proto=example.com/foo/bar:.",
+				"--grpc-gateway_out=logtostderr=true:.",
+			},
+		},
+		{
+			name:   "plugin without params",
+			outs:   []string{"--openapiv2_out=."},
+			plugin: "openapiv2",
+			params: []string{"use_go_templates=true", "disable_default_errors=true"},
+			want:   []string{"--openapiv2_out=use_go_templates=true,disable_default_errors=true:."},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, moduleOuts(tt.outs, tt.plugin, tt.params))
+		})
+	}
+}
+
+func TestModuleInclude(t *testing.T) {
+	g := &generator{
+		appPath: "/app",
+		o: &generateOptions{
+			moduleOptions: map[string]ModuleOptions{
+				"mars.mars": {IncludeDirs: []string{"proto_options"}},
+			},
+		},
+	}
+	include := []string{"/app/proto"}
+
+	require.Equal(t, []string{"/app/proto", filepath.Join("/app", "proto_options")}, g.moduleInclude(include, "mars.mars"))
+	require.Equal(t, []string{"/app/proto"}, g.moduleInclude(include, "venus.venus"))
+	require.Equal(t, []string{"/app/proto"}, include, "the include paths must not be modified")
+}
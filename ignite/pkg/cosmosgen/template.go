@@ -17,10 +17,18 @@ var (
 	//go:embed templates/*
 	templates embed.FS
 
-	templateTSClientRoot    = newTemplateWriter("root")
-	templateTSClientModule  = newTemplateWriter("module")
-	templateTSClientVue     = newTemplateWriter("vue")
-	templateTSClientVueRoot = newTemplateWriter("vue-root")
+	templateTSClientRoot     = newTemplateWriter("root")
+	templateTSClientModule   = newTemplateWriter("module")
+	templateTSClientVue      = newTemplateWriter("vue")
+	templateTSClientVueRoot  = newTemplateWriter("vue-root")
+	templateComposables      = newTemplateWriter("composables")
+	templateComposablesRoot  = newTemplateWriter("composables-root")
+	templateHooks            = newTemplateWriter("hooks")
+	templateHooksRoot        = newTemplateWriter("hooks-root")
+	templatePythonClientRoot = newTemplateWriter("python-root")
+	templateRustClientRoot   = newTemplateWriter("rust-root")
+	templateDartClientRoot   = newTemplateWriter("dart-root")
+	templateDartClientLib    = newTemplateWriter("dart-lib")
 )
 
 type templateWriter struct {
@@ -78,7 +86,9 @@ func (t templateWriter) Write(destDir, protoPath string, data interface{}) error
 		"inc": func(i int) int {
 			return i + 1
 		},
-		"replace": strings.ReplaceAll,
+		"replace":     strings.ReplaceAll,
+		"jsonName":    jsonName,
+		"sampleValue": sampleValue,
 	}
 
 	// render and write the template.
@@ -110,3 +120,42 @@ func (t templateWriter) Write(destDir, protoPath string, data interface{}) error
 
 	return nil
 }
+
+// jsonName returns the JSON name of a proto field the way protoc computes it,
+// the underscores are removed and the letters that follow them capitalized.
+func jsonName(field string) string {
+	var (
+		b     strings.Builder
+		upper bool
+	)
+	for _, r := range field {
+		switch {
+		case r == '_':
+			upper = true
+		case upper:
+			b.WriteString(strings.ToUpper(string(r)))
+			upper = false
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// sampleValue returns a TypeScript literal of a sample JSON value for a proto
+// scalar type, or an empty string for the other types.
+func sampleValue(protoType string) string {
+	switch protoType {
+	case "string":
+		return `"test"`
+	case "bool":
+		return "true"
+	case "bytes":
+		return `"dGVzdA=="`
+	case "int32", "uint32", "sint32", "fixed32", "sfixed32", "float", "double":
+		return "1"
+	case "int64", "uint64", "sint64", "fixed64", "sfixed64":
+		return `"1"`
+	}
+	return ""
+}
@@ -0,0 +1,152 @@
+package cosmosgen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosanalysis/module"
+	"github.com/ignite/cli/ignite/pkg/protoanalysis"
+)
+
+func TestWriteModuleTemplates(t *testing.T) {
+	var (
+		protoPath = "/app/proto"
+		out       = t.TempDir()
+		m         = module.Module{
+			Name: "blog",
+			Pkg:  protoanalysis.Package{Name: "app.blog"},
+			Msgs: []module.Msg{
+				{
+					Name:      "MsgCreatePost",
+					URI:       "app.blog.MsgCreatePost",
+					FilePath:  "/app/proto/blog/tx.proto",
+					AminoName: "blog/CreatePost",
+					Fields: map[string]string{
+						"creator":   "string",
+						"post_id":   "uint64",
+						"published": "bool",
+						"tags":      "Tag",
+					},
+				},
+				{
+					Name:     "MsgDeletePost",
+					URI:      "app.blog.MsgDeletePost",
+					FilePath: "/app/proto/blog/tx.proto",
+				},
+			},
+		}
+	)
+
+	err := templateTSClientModule.Write(out, protoPath, struct{ Module module.Module }{m})
+	require.NoError(t, err)
+
+	amino, err := os.ReadFile(filepath.Join(out, "amino.ts"))
+	require.NoError(t, err)
+	require.Contains(t, string(amino), `import { MsgCreatePost } from "./types/blog/tx";`)
+	require.Contains(t, string(amino), `"/app.blog.MsgCreatePost": aminoConverter("blog/CreatePost", MsgCreatePost, {`)
+	require.Contains(t, string(amino), `"postId": "post_id",`)
+	require.NotContains(t, string(amino), "MsgDeletePost")
+
+	test, err := os.ReadFile(filepath.Join(out, "module.test.ts"))
+	require.NoError(t, err)
+	require.Contains(t, string(test), `toEqual(["/app.blog.MsgCreatePost", "/app.blog.MsgDeletePost"])`)
+	require.Contains(t, string(test), `it("round-trips MsgDeletePost", () => {`)
+	require.Contains(t, string(test), "creator: \"test\",\n      postId: \"1\",\n      published: true,\n      })")
+	require.Contains(t, string(test), `expect(converter.aminoType).toEqual("blog/CreatePost");`)
+}
+
+func TestJSONName(t *testing.T) {
+	require.Equal(t, "postId", jsonName("post_id"))
+	require.Equal(t, "postID", jsonName("postID"))
+	require.Equal(t, "fooBarBaz", jsonName("foo_bar__baz"))
+}
+
+func TestWriteComposablesTemplates(t *testing.T) {
+	m := module.Module{
+		Name: "blog",
+		Pkg:  protoanalysis.Package{Name: "app.blog"},
+		Msgs: []module.Msg{
+			{Name: "MsgCreatePost", URI: "app.blog.MsgCreatePost"},
+		},
+		HTTPQueries: []module.HTTPQuery{
+			{
+				Name:     "Post",
+				FullName: "QueryPost",
+				Rules:    []protoanalysis.HTTPRule{{Params: []string{"id"}}},
+			},
+			{
+				Name:      "PostAll",
+				FullName:  "QueryPostAll",
+				Rules:     []protoanalysis.HTTPRule{{HasQuery: true}},
+				Paginated: true,
+			},
+		},
+	}
+
+	cases := []struct {
+		name     string
+		template templateWriter
+		contains []string
+	}{
+		{
+			name:     "composables",
+			template: templateComposables,
+			contains: []string{
+				`from "@tanstack/vue-query";`,
+				`const QueryPost = (params: MaybeRef<{ id: string; }>, options: any = {}) => {`,
+			},
+		},
+		{
+			name:     "hooks",
+			template: templateHooks,
+			contains: []string{
+				`from "@tanstack/react-query";`,
+				`const QueryPost = (params: { id: string; }, options: any = {}) => {`,
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			out := t.TempDir()
+
+			err := tt.template.Write(out, "", struct {
+				Module    module.Module
+				PackageNS string
+			}{m, "app-blog"})
+			require.NoError(t, err)
+
+			content, err := os.ReadFile(filepath.Join(out, "index.ts"))
+			require.NoError(t, err)
+
+			for _, s := range tt.contains {
+				require.Contains(t, string(content), s)
+			}
+			require.Contains(t, string(content), "export default function useAppBlog() {")
+			require.Contains(t, string(content), `return client.AppBlog.query.queryPost(p.id).then((res) => res.data);`)
+			require.Contains(t, string(content), `return useInfiniteQuery(key, ({ queryKey, pageParam }) => {`)
+			require.Contains(t, string(content), `client.AppBlog.query.queryPostAll({ ...q, "pagination.key": pageParam } as any)`)
+			require.Contains(t, string(content), `client.AppBlog.tx.sendMsgCreatePost(params),`)
+			require.Contains(t, string(content), `queryClient.invalidateQueries(["app.blog"]);`)
+		})
+	}
+}
+
+func TestFindPackageDir(t *testing.T) {
+	appPath := t.TempDir()
+	vuePath := filepath.Join(appPath, "vue")
+	composablesPath := filepath.Join(vuePath, "src", "composables")
+	require.NoError(t, os.MkdirAll(composablesPath, 0o755))
+
+	_, ok := findPackageDir(composablesPath, appPath)
+	require.False(t, ok)
+
+	require.NoError(t, os.WriteFile(filepath.Join(vuePath, "package.json"), []byte("{}"), 0o644))
+
+	dir, ok := findPackageDir(composablesPath, appPath)
+	require.True(t, ok)
+	require.Equal(t, vuePath, dir)
+}
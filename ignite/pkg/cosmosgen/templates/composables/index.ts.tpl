@@ -0,0 +1,68 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+import { useQuery, useInfiniteQuery, useMutation, useQueryClient } from "@tanstack/vue-query";
+import type { Ref } from "vue";
+import { useClient } from "../useClient";
+
+type MaybeRef<T> = T | Ref<T>;
+
+export default function use{{ camelCaseUpperSta .Module.Pkg.Name }}() {
+  const client = useClient();
+  const queryClient = useQueryClient();
+  {{- range .Module.HTTPQueries }}{{ $FullName := .FullName }}{{ $Paginated := .Paginated }}{{ range $i, $rule := .Rules }}{{ $n := "" }}{{ if (gt $i 0) }}{{ $n = inc $i }}{{ end }}
+
+  const {{ $FullName }}{{ $n }} = (
+    {{- if $rule.Params }}params: MaybeRef<{ {{ range $rule.Params }}{{ . }}: string; {{ end }}}>, {{ end -}}
+    {{- if $rule.HasQuery }}query: MaybeRef<Record<string, any>> = {}, {{ end -}}
+    options: any = {}) => {
+    const key = ["{{ $.Module.Pkg.Name }}", "{{ $FullName }}{{ $n }}"{{ if $rule.Params }}, params{{ end }}{{ if $rule.HasQuery }}, query{{ end }}];
+    {{- if and $Paginated $rule.HasQuery }}
+    return useInfiniteQuery(key, ({ queryKey, pageParam }) => {
+      const [, , {{ if $rule.Params }}p{{ end }}{{ if $rule.HasQuery }}{{ if $rule.Params }}, {{ end }}q{{ end }}] = queryKey as any[];
+      return client.{{ camelCaseUpperSta $.Module.Pkg.Name }}.query.{{ camelCaseSta $FullName }}{{ $n }}(
+        {{- range $rule.Params }}p.{{ . }}, {{ end -}}
+        { ...q, "pagination.key": pageParam } as any
+        {{- if $rule.HasBody }}, { ...p }{{ end -}}
+      ).then((res) => res.data);
+    }, {
+      ...options,
+      getNextPageParam: (lastPage: any) => lastPage.pagination?.next_key || undefined,
+    });
+    {{- else }}
+    return useQuery(key, ({{ if or $rule.Params $rule.HasQuery }}{ queryKey }{{ end }}) => {
+      {{- if or $rule.Params $rule.HasQuery }}
+      const [, , {{ if $rule.Params }}p{{ end }}{{ if $rule.HasQuery }}{{ if $rule.Params }}, {{ end }}q{{ end }}] = queryKey as any[];
+      {{- end }}
+      return client.{{ camelCaseUpperSta $.Module.Pkg.Name }}.query.{{ camelCaseSta $FullName }}{{ $n }}(
+        {{- range $j, $a := $rule.Params }}{{ if (gt $j 0) }}, {{ end }}p.{{ $a }}{{ end -}}
+        {{- if $rule.HasQuery }}{{ if $rule.Params }}, {{ end }}q{{ end -}}
+        {{- if $rule.HasBody }}{{ if or $rule.Params $rule.HasQuery }}, {{ end }}{ ...p }{{ end -}}
+      ).then((res) => res.data);
+    }, options);
+    {{- end }}
+  };
+  {{- end }}{{ end }}{{ range .Module.Msgs }}
+
+  const send{{ .Name }} = (options: any = {}) => {
+    return useMutation(
+      (params: Parameters<typeof client.{{ camelCaseUpperSta $.Module.Pkg.Name }}.tx.send{{ .Name }}>[0]) =>
+        client.{{ camelCaseUpperSta $.Module.Pkg.Name }}.tx.send{{ .Name }}(params),
+      {
+        ...options,
+        onSuccess: (...args: any[]) => {
+          queryClient.invalidateQueries(["{{ $.Module.Pkg.Name }}"]);
+          return options.onSuccess?.(...args);
+        },
+      },
+    );
+  };
+  {{- end }}
+
+  return {
+    {{- range .Module.HTTPQueries }}{{ $FullName := .FullName }}{{ range $i, $rule := .Rules }}{{ $n := "" }}{{ if (gt $i 0) }}{{ $n = inc $i }}{{ end }}
+    {{ $FullName }}{{ $n }},
+    {{- end }}{{ end }}{{ range .Module.Msgs }}
+    send{{ .Name }},
+    {{- end }}
+  };
+}
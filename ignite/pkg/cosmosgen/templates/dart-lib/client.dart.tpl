@@ -0,0 +1,85 @@
+// Generated by Ignite ignite.com/cli
+
+import 'package:grpc/grpc_or_grpcweb.dart';
+import 'package:protobuf/protobuf.dart';
+
+import 'generated/cosmos/auth/v1beta1/auth.pb.dart';
+import 'generated/cosmos/auth/v1beta1/query.pbgrpc.dart' as auth;
+import 'generated/cosmos/base/abci/v1beta1/abci.pb.dart';
+import 'generated/cosmos/tx/v1beta1/service.pbgrpc.dart' as tx;
+import 'generated/cosmos/tx/v1beta1/tx.pb.dart';
+import 'wallet.dart';
+
+/// Client is the client of a node of the chain with the id [chainId], it
+/// queries the accounts and broadcasts the transactions signed by a [Wallet].
+///
+/// The node is reached with gRPC-web in the browser and with gRPC on the other
+/// platforms, the gRPC-web endpoint must be enabled in the app config of the
+/// node.
+class Client {
+  /// Creates the client of the node on [host].
+  Client({
+    required this.chainId,
+    required String host,
+    int grpcPort = 9090,
+    int grpcWebPort = 9091,
+    bool secure = false,
+  }) : channel = GrpcOrGrpcWebClientChannel.toSeparatePorts(
+          host: host,
+          grpcPort: grpcPort,
+          grpcTransportSecure: secure,
+          grpcWebPort: grpcWebPort,
+          grpcWebTransportSecure: secure,
+        );
+
+  /// The id of the chain.
+  final String chainId;
+
+  /// The channel to the node, it can be used with the generated gRPC clients
+  /// of the modules.
+  final GrpcOrGrpcWebClientChannel channel;
+
+  /// Returns the account with [address].
+  Future<BaseAccount> account(String address) async {
+    final res = await auth.QueryClient(channel).account(
+      auth.QueryAccountRequest(address: address),
+    );
+
+    return res.account.unpackInto(BaseAccount());
+  }
+
+  /// Broadcasts the signed transaction [txRaw] and returns its response once
+  /// it's checked by the node.
+  Future<TxResponse> broadcast(TxRaw txRaw) async {
+    final res = await tx.ServiceClient(channel).broadcastTx(
+      tx.BroadcastTxRequest(
+        txBytes: txRaw.writeToBuffer(),
+        mode: tx.BroadcastMode.BROADCAST_MODE_SYNC,
+      ),
+    );
+
+    return res.txResponse;
+  }
+
+  /// Signs the transaction of [messages] with [wallet] and broadcasts it.
+  Future<TxResponse> signAndBroadcast(
+    Wallet wallet,
+    Iterable<GeneratedMessage> messages, {
+    required Fee fee,
+    String memo = '',
+  }) async {
+    final account = await this.account(wallet.address);
+
+    return broadcast(wallet.sign(
+      messages: messages,
+      chainId: chainId,
+      accountNumber: account.accountNumber,
+      sequence: account.sequence,
+      fee: fee,
+      memo: memo,
+    ));
+  }
+
+  /// Closes the channel to the node.
+  Future<void> close() => channel.shutdown();
+}
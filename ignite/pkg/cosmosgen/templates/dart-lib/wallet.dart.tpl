@@ -0,0 +1,134 @@
+// Generated by Ignite ignite.com/cli
+
+import 'dart:typed_data';
+
+import 'package:bech32/bech32.dart';
+import 'package:bip32/bip32.dart' as bip32;
+import 'package:bip39/bip39.dart' as bip39;
+import 'package:crypto/crypto.dart';
+import 'package:fixnum/fixnum.dart';
+import 'package:pointycastle/digests/ripemd160.dart';
+import 'package:protobuf/protobuf.dart';
+import 'package:protobuf/well_known_types/google/protobuf/any.pb.dart';
+
+import 'generated/cosmos/crypto/secp256k1/keys.pb.dart' as secp256k1;
+import 'generated/cosmos/tx/signing/v1beta1/signing.pbenum.dart';
+import 'generated/cosmos/tx/v1beta1/tx.pb.dart';
+
+/// Wallet is an account of the chain with a secp256k1 key derived from a
+/// mnemonic, it signs the transactions of the account.
+class Wallet {
+  Wallet._(this._node, this.address);
+
+  /// The default HD path of the Cosmos accounts.
+  static const defaultHDPath = "m/44'/118'/0'/0/0";
+
+  /// The default prefix of the account addresses.
+  static const defaultPrefix = 'cosmos';
+
+  /// Creates the wallet of the account derived from [mnemonic].
+  factory Wallet.fromMnemonic(
+    String mnemonic, {
+    String prefix = defaultPrefix,
+    String hdPath = defaultHDPath,
+  }) {
+    if (!bip39.validateMnemonic(mnemonic)) {
+      throw ArgumentError.value(mnemonic, 'mnemonic', 'invalid mnemonic');
+    }
+
+    final seed = bip39.mnemonicToSeed(mnemonic);
+    final node = bip32.BIP32.fromSeed(seed).derivePath(hdPath);
+
+    return Wallet._(node, _address(node.publicKey, prefix));
+  }
+
+  /// Generates a new mnemonic of 24 words.
+  static String generateMnemonic() => bip39.generateMnemonic(strength: 256);
+
+  final bip32.BIP32 _node;
+
+  /// The bech32 address of the account.
+  final String address;
+
+  /// The compressed secp256k1 public key of the account.
+  Uint8List get publicKey => _node.publicKey;
+
+  /// Signs the transaction of [messages] in SIGN_MODE_DIRECT for the account
+  /// with [accountNumber] and [sequence] on the chain with [chainId].
+  TxRaw sign({
+    required Iterable<GeneratedMessage> messages,
+    required String chainId,
+    required Int64 accountNumber,
+    required Int64 sequence,
+    required Fee fee,
+    String memo = '',
+  }) {
+    final body = TxBody(
+      messages: messages.map(_pack),
+      memo: memo,
+    );
+    final authInfo = AuthInfo(
+      signerInfos: [
+        SignerInfo(
+          publicKey: _pack(secp256k1.PubKey(key: publicKey)),
+          modeInfo: ModeInfo(
+            single: ModeInfo_Single(mode: SignMode.SIGN_MODE_DIRECT),
+          ),
+          sequence: sequence,
+        ),
+      ],
+      fee: fee,
+    );
+
+    final bodyBytes = body.writeToBuffer();
+    final authInfoBytes = authInfo.writeToBuffer();
+    final signDoc = SignDoc(
+      bodyBytes: bodyBytes,
+      authInfoBytes: authInfoBytes,
+      chainId: chainId,
+      accountNumber: accountNumber,
+    );
+
+    final hash = sha256.convert(signDoc.writeToBuffer()).bytes;
+
+    return TxRaw(
+      bodyBytes: bodyBytes,
+      authInfoBytes: authInfoBytes,
+      signatures: [_node.sign(Uint8List.fromList(hash))],
+    );
+  }
+
+  // The type URLs of the Cosmos messages have no prefix.
+  static Any _pack(GeneratedMessage message) =>
+      Any.pack(message, typeUrlPrefix: '');
+
+  static String _address(Uint8List publicKey, String prefix) {
+    final hash = RIPEMD160Digest().process(
+      Uint8List.fromList(sha256.convert(publicKey).bytes),
+    );
+
+    return bech32.encode(Bech32(prefix, _convertBits(hash, 8, 5)));
+  }
+
+  static List<int> _convertBits(List<int> data, int from, int to) {
+    var acc = 0;
+    var bits = 0;
+    final result = <int>[];
+    final maxValue = (1 << to) - 1;
+    final maxAcc = (1 << (from + to - 1)) - 1;
+
+    for (final value in data) {
+      acc = ((acc << from) | value) & maxAcc;
+      bits += from;
+      while (bits >= to) {
+        bits -= to;
+        result.add((acc >> bits) & maxValue);
+      }
+    }
+    if (bits > 0) {
+      result.add((acc << (to - bits)) & maxValue);
+    }
+
+    return result;
+  }
+}
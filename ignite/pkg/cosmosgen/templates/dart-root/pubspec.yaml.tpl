@@ -0,0 +1,19 @@
+# Generated by Ignite ignite.com/cli
+
+name: {{ .PackageName }}
+description: Autogenerated Dart client
+version: 0.0.1
+publish_to: none
+
+environment:
+  sdk: ">=2.19.0 <4.0.0"
+
+dependencies:
+  bech32: ^0.2.2
+  bip32: ^2.0.0
+  bip39: ^1.0.6
+  crypto: ^3.0.2
+  fixnum: ^1.1.0
+  grpc: ^3.2.0
+  pointycastle: ^3.7.0
+  protobuf: ^3.0.0
@@ -0,0 +1,5 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+export { initClient, useClient } from "./useClient";
+{{ range .Modules }}export { default as use{{ camelCaseUpperSta .Pkg.Name }} } from "./use{{ camelCaseUpperSta .Pkg.Name }}";
+{{ end }}
@@ -0,0 +1,27 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+import { Client } from "{{ .PackageNS }}-client-ts";
+import type { Env } from "{{ .PackageNS }}-client-ts/env";
+
+let client: InstanceType<typeof Client> | undefined;
+
+// initClient creates the client used by the hooks with the env of the
+// chain, call it before using the hooks.
+export function initClient(env: Env) {
+  client = new Client(env);
+  return client;
+}
+
+// useClient returns the client used by the hooks, the client connects to
+// the local chain when it isn't initialized with initClient.
+export function useClient() {
+  if (!client) {
+    client = new Client({
+      apiURL: "http://localhost:1317",
+      rpcURL: "http://localhost:26657",
+      faucetURL: "http://localhost:4500",
+      prefix: "cosmos",
+    });
+  }
+  return client;
+}
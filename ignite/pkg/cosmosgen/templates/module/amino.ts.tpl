@@ -0,0 +1,14 @@
+// Generated by Ignite ignite.com/cli
+
+import { AminoConverter } from "@cosmjs/stargate";
+import { aminoConverter } from "../helpers";
+{{ range .Module.Msgs }}{{ if .AminoName }}import { {{ .Name }} } from "./types/{{ resolveFile .FilePath }}";
+{{ end }}{{ end }}
+const aminoConverters: Record<string, AminoConverter> = {
+  {{ range .Module.Msgs }}{{ if .AminoName }}"/{{ .URI }}": aminoConverter("{{ .AminoName }}", {{ .Name }}, {
+    {{ range $name, $type := .Fields }}"{{ jsonName $name }}": "{{ $name }}",
+    {{ end }}}),
+  {{ end }}{{ end }}
+};
+
+export { aminoConverters }
@@ -1,6 +1,7 @@
 import Module from './module';
 import { txClient, queryClient, registry } from './module';
 import { msgTypes } from './registry';
+import { aminoConverters } from './amino';
 
 export * from "./types";
-export { Module, msgTypes, txClient, queryClient, registry };
+export { Module, msgTypes, aminoConverters, txClient, queryClient, registry };
@@ -0,0 +1,30 @@
+// Generated by Ignite ignite.com/cli
+
+import { describe, expect, it } from "vitest";
+import { registry } from "./module";
+import { msgTypes } from "./registry";
+import { aminoConverters } from "./amino";
+{{ range .Module.Msgs }}import { {{ .Name }} } from "./types/{{ resolveFile .FilePath }}";
+{{ end }}
+describe("{{ .Module.Pkg.Name }}", () => {
+  it("registers the messages", () => {
+    expect(msgTypes.map(([typeUrl]) => typeUrl)).toEqual([{{ range $i, $msg := .Module.Msgs }}{{ if (gt $i 0) }}, {{ end }}"/{{ $msg.URI }}"{{ end }}]);
+  });
+{{ range .Module.Msgs }}
+  it("round-trips {{ .Name }}", () => {
+    const typeUrl = "/{{ .URI }}";
+    const value = {{ .Name }}.fromJSON({
+      {{ range $name, $type := .Fields }}{{ with sampleValue $type }}{{ jsonName $name }}: {{ . }},
+      {{ end }}{{ end }}});
+    const json = {{ .Name }}.toJSON(value);
+
+    const bytes = {{ .Name }}.encode(value).finish();
+    expect({{ .Name }}.toJSON({{ .Name }}.decode(bytes))).toEqual(json);
+    expect({{ .Name }}.toJSON(registry.decode({ typeUrl, value: registry.encode({ typeUrl, value }) }))).toEqual(json);
+    {{ if .AminoName }}
+    const converter = aminoConverters[typeUrl];
+    expect(converter.aminoType).toEqual("{{ .AminoName }}");
+    expect({{ .Name }}.toJSON(converter.fromAmino(converter.toAmino(value)))).toEqual(json);
+    {{ end }}
+  });
+{{ end }}});
@@ -1,11 +1,12 @@
 // Generated by Ignite ignite.com/cli
 
 import { StdFee } from "@cosmjs/launchpad";
-import { SigningStargateClient, DeliverTxResponse } from "@cosmjs/stargate";
+import { AminoTypes, SigningStargateClient, DeliverTxResponse } from "@cosmjs/stargate";
 import { EncodeObject, GeneratedType, OfflineSigner, Registry } from "@cosmjs/proto-signing";
 import { msgTypes } from './registry';
+import { aminoConverters } from './amino';
 import { IgniteClient } from "../client"
-import { MissingWalletError } from "../helpers"
+import { MissingWalletError, resolveFee } from "../helpers"
 import { Api } from "./rest";
 {{ range .Module.Msgs }}import { {{ .Name }} } from "./types/{{ resolveFile .FilePath }}";
 {{ end }}
@@ -14,7 +15,7 @@ export { {{ range $i,$type:=.Module.Msgs }}{{ if (gt $i 0) }}, {{ end }}{{ $type
 {{ range .Module.Msgs }}
 type send{{ .Name }}Params = {
   value: {{ .Name }},
-  fee?: StdFee,
+  fee?: StdFee | "auto",
   memo?: string
 };
 {{ end }}
@@ -26,18 +27,14 @@ type {{ camelCase .Name }}Params = {
 
 export const registry = new Registry(msgTypes);
 
-const defaultFee = {
-  amount: [],
-  gas: "200000",
-};
-
 interface TxClientOptions {
   addr: string
 	prefix: string
 	signer?: OfflineSigner
+	gasPrice?: string
 }
 
-export const txClient = ({ signer, prefix, addr }: TxClientOptions = { addr: "http://localhost:26657", prefix: "cosmos" }) => {
+export const txClient = ({ signer, prefix, addr, gasPrice }: TxClientOptions = { addr: "http://localhost:26657", prefix: "cosmos" }) => {
 
   return {
 		{{ range .Module.Msgs }}
@@ -47,9 +44,10 @@ export const txClient = ({ signer, prefix, addr }: TxClientOptions = { addr: "ht
 			}
 			try {			
 				const { address } = (await signer.getAccounts())[0]; 
-				const signingClient = await SigningStargateClient.connectWithSigner(addr,signer,{registry, prefix});
+				const aminoTypes = new AminoTypes({ prefix, additions: aminoConverters });
+				const signingClient = await SigningStargateClient.connectWithSigner(addr,signer,{registry, prefix, aminoTypes});
 				let msg = this.{{ camelCase .Name }}({ value: {{ .Name }}.fromPartial(value) })
-				return await signingClient.signAndBroadcast(address, [msg], fee ? fee : defaultFee, memo)
+				return await signingClient.signAndBroadcast(address, [msg], await resolveFee(signingClient, address, [msg], fee, memo, gasPrice), memo)
 			} catch (e: any) {
 				throw new Error('TxClient:send{{ .Name }}: Could not broadcast Tx: '+ e.message)
 			}
@@ -94,6 +92,7 @@ class SDKModule {
         signer: client.signer,
         addr: client.env.rpcURL,
         prefix: client.env.prefix ?? "cosmos",
+        gasPrice: client.env.gasPrice,
     })
 	
     this.tx = methods;
@@ -108,7 +107,8 @@ const Module = (test: IgniteClient) => {
 		module: {
 			{{ camelCaseUpperSta .Module.Pkg.Name }}: new SDKModule(test)
 		},
-		registry: msgTypes
+		registry: msgTypes,
+		amino: aminoConverters
   }
 }
 export default Module;
\ No newline at end of file
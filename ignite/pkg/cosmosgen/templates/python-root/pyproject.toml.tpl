@@ -0,0 +1,20 @@
+# Generated by Ignite ignite.com/cli
+
+[build-system]
+requires = ["setuptools>=61"]
+build-backend = "setuptools.build_meta"
+
+[project]
+name = "{{ .PackageNS }}-client"
+version = "0.0.1"
+description = "Autogenerated Python client"
+license = { text = "Apache-2.0" }
+requires-python = ">=3.7"
+dependencies = [
+  "betterproto==2.0.0b5",
+  "grpclib>=0.4.3",
+]
+
+[tool.setuptools.packages.find]
+where = ["."]
+namespaces = true
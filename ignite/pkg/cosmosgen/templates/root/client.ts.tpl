@@ -5,23 +5,20 @@ import {
   Registry,
 } from "@cosmjs/proto-signing";
 import { StdFee } from "@cosmjs/launchpad";
-import { SigningStargateClient } from "@cosmjs/stargate";
+import { AminoConverter, AminoTypes, SigningStargateClient } from "@cosmjs/stargate";
 import { Env } from "./env";
-import { UnionToIntersection, Return, Constructor } from "./helpers";
+import { UnionToIntersection, Return, Constructor, estimateFee, resolveFee } from "./helpers";
 import { Module } from "./modules";
+import { FaucetClient } from "./faucet";
 import { EventEmitter } from "events";
 import { ChainInfo } from "@keplr-wallet/types";
 
-const defaultFee = {
-  amount: [],
-  gas: "200000",
-};
-
 export class IgniteClient extends EventEmitter {
 	static plugins: Module[] = [];
   env: Env;
   signer: OfflineSigner;
   registry: Array<[string, GeneratedType]> = [];
+  aminoConverters: Record<string, AminoConverter> = {};
   static plugin<T extends Module | Module[]>(plugin: T) {
     const currentPlugins = this.plugins;
 
@@ -38,16 +35,34 @@ export class IgniteClient extends EventEmitter {
     return AugmentedClient as typeof AugmentedClient & Constructor<Extension>;
   }
 
-  async signAndBroadcast(msgs: EncodeObject[], fee: StdFee, memo: string) {
+  async signAndBroadcast(msgs: EncodeObject[], fee: StdFee | "auto", memo: string) {
+    if (this.signer) {
+      const { address } = (await this.signer.getAccounts())[0];
+      const signingClient = await this.signingClient();
+      return await signingClient.signAndBroadcast(address, msgs, await resolveFee(signingClient, address, msgs, fee, memo, this.env.gasPrice), memo)
+    } else {
+      throw new Error(" Signer is not present.");
+    }
+  }
+
+  async estimateFee(msgs: EncodeObject[], memo = "", gasAdjustment?: number): Promise<StdFee> {
     if (this.signer) {
       const { address } = (await this.signer.getAccounts())[0];
-      const signingClient = await SigningStargateClient.connectWithSigner(this.env.rpcURL, this.signer, { registry: new Registry(this.registry), prefix: this.env.prefix });
-      return await signingClient.signAndBroadcast(address, msgs, fee ? fee : defaultFee, memo)
+      return await estimateFee(await this.signingClient(), address, msgs, memo, this.env.gasPrice, gasAdjustment)
     } else {
       throw new Error(" Signer is not present.");
     }
   }
 
+  private async signingClient() {
+    const prefix = this.env.prefix ?? "cosmos";
+    return await SigningStargateClient.connectWithSigner(this.env.rpcURL, this.signer, {
+      registry: new Registry(this.registry),
+      aminoTypes: new AminoTypes({ prefix, additions: this.aminoConverters }),
+      prefix,
+    });
+  }
+
   constructor(env: Env, signer?: OfflineSigner) {
     super();
     this.env = env;
@@ -60,13 +75,24 @@ export class IgniteClient extends EventEmitter {
       if (this.registry) {
         this.registry = this.registry.concat(pluginInstance.registry)
       }
+      Object.assign(this.aminoConverters, pluginInstance.amino)
 		});		
   }
+  // faucet returns the client of the faucet of the chain.
+  faucet(): FaucetClient {
+    if (!this.env.faucetURL) {
+      throw new Error("the faucet URL of the chain is not set in the env");
+    }
+    return new FaucetClient(this.env.faucetURL);
+  }
   async useSigner(signer: OfflineSigner) {    
       this.signer = signer;
       this.emit("signer-changed", this.signer);
   }
   async useKeplr(keplrChainInfo: Partial<ChainInfo> = {}) {
+    if (typeof window === "undefined" || !window.keplr) {
+      throw new Error("Keplr is only available in a browser with the Keplr extension, use useSigner() instead.");
+    }
     // Using queryClients directly because BaseClient has no knowledge of the modules at this stage
     try {
       const queryClient = (
@@ -95,10 +121,15 @@ export class IgniteClient extends EventEmitter {
         coinDecimals: 0,
       };
 
+      let coinType = this.env.coinType ?? 118;
+
       let bip44 = {
-        coinType: 118,
+        coinType,
       };
 
+      // the EVM-enabled chains derive and sign with eth_secp256k1 keys
+      let features = coinType === 60 ? ["eth-address-gen", "eth-key-sign"] : [];
+
       let bech32Config = {
         bech32PrefixAccAddr: addrPrefix,
         bech32PrefixAccPub: addrPrefix + "pub",
@@ -128,8 +159,6 @@ export class IgniteClient extends EventEmitter {
           return y;
         }) ?? [];
 
-      let coinType = 118;
-
       if (chainId) {
         const suggestOptions: ChainInfo = {
           chainId,
@@ -142,6 +171,7 @@ export class IgniteClient extends EventEmitter {
           currencies,
           feeCurrencies,
           coinType,
+          features,
           ...keplrChainInfo,
         };
         await window.keplr.experimentalSuggestChain(suggestOptions);
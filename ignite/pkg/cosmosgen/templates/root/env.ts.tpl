@@ -3,5 +3,13 @@ import { OfflineSigner } from "@cosmjs/proto-signing";
 export interface Env {
   apiURL: string
   rpcURL: string
+  // faucetURL is the address of the faucet of the chain, e.g.
+  // "http://localhost:4500"
+  faucetURL?: string
   prefix?: string
+  // gasPrice prices the estimated fees, e.g. "0.025stake"
+  gasPrice?: string
+  // coinType is the BIP-44 coin type of the accounts, 60 for the EVM-enabled
+  // chains with eth_secp256k1 accounts
+  coinType?: number
 }
\ No newline at end of file
@@ -0,0 +1,121 @@
+// Generated by Ignite ignite.com/cli
+//
+// Typed client of the faucet of the chain, the types follow the messages of
+// the ignite.faucet.v1.Faucet gRPC service. The calls are sent to the HTTP
+// API of the faucet, which has the same checks and limits as its gRPC service.
+
+// FaucetRejection is the code of a rejected transfer request.
+export type FaucetRejection =
+  | "rate_limited"
+  | "challenge_failed"
+  | "invalid_request"
+  | "invalid_address"
+  | "denied"
+  | "not_allowed"
+  | "limit_exceeded"
+  | "transfer_failed";
+
+export interface FaucetTransferRequest {
+  // address is the account to send coins to.
+  address: string;
+  // coins to send, like "10token", the coins sent per request are sent when
+  // neither coins nor denoms are set.
+  coins?: string[];
+  // denoms of the coins to send with the amounts sent per request.
+  denoms?: string[];
+  // captcha_token is the token of the CAPTCHA solved by the client.
+  captcha_token?: string;
+  // challenge is the proof-of-work challenge solved by the client.
+  challenge?: string;
+  // nonce solves the proof-of-work challenge.
+  nonce?: string;
+}
+
+export interface FaucetTransferResponse {
+  // coins are the coins sent to the account.
+  coins?: string[];
+  error?: string;
+  code?: FaucetRejection;
+}
+
+export interface FaucetCoin {
+  denom: string;
+  // amount is the max amount sent per request.
+  amount: string;
+  // max_amount is the max amount sent to an account, "0" when unlimited.
+  max_amount: string;
+}
+
+export interface FaucetInfoResponse {
+  is_a_faucet: boolean;
+  chain_id: string;
+  coins: FaucetCoin[];
+}
+
+export interface FaucetChallengeResponse {
+  // captcha is the CAPTCHA service of the faucet, unset when the requests
+  // don't need a CAPTCHA token.
+  captcha?: "hcaptcha" | "turnstile";
+  captcha_site_key?: string;
+  // challenge is the proof-of-work challenge, unset when the requests don't
+  // need a proof-of-work.
+  challenge?: string;
+  // difficulty is the number of leading zero bits of the SHA-256 hash of the
+  // challenge followed by the nonce.
+  difficulty?: number;
+  expires_at?: string;
+}
+
+// FaucetError is thrown when the faucet rejects a transfer request.
+export class FaucetError extends Error {
+  code?: FaucetRejection;
+  status: number;
+
+  constructor(message: string, status: number, code?: FaucetRejection) {
+    super(message);
+    this.name = "FaucetError";
+    this.status = status;
+    this.code = code;
+  }
+}
+
+export class FaucetClient {
+  url: string;
+
+  constructor(url: string) {
+    this.url = url.replace(/\/+$/, "");
+  }
+
+  // transfer sends coins to an account, it throws a FaucetError with the code
+  // of the rejection when the request is rejected.
+  async transfer(req: FaucetTransferRequest): Promise<FaucetTransferResponse> {
+    const res = await fetch(this.url + "/", {
+      method: "POST",
+      headers: { "Content-Type": "application/json" },
+      body: JSON.stringify(req),
+    });
+    const body: FaucetTransferResponse = await res.json().catch(() => ({}));
+    if (!res.ok) {
+      throw new FaucetError(body.error ?? res.statusText, res.status, body.code);
+    }
+    return body;
+  }
+
+  // info returns the chain of the faucet and the coins it distributes.
+  async info(): Promise<FaucetInfoResponse> {
+    return this.get<FaucetInfoResponse>("/info");
+  }
+
+  // challenge returns the challenges to solve before requesting coins.
+  async challenge(): Promise<FaucetChallengeResponse> {
+    return this.get<FaucetChallengeResponse>("/challenge");
+  }
+
+  private async get<T>(path: string): Promise<T> {
+    const res = await fetch(this.url + path);
+    if (!res.ok) {
+      throw new FaucetError(res.statusText, res.status);
+    }
+    return res.json();
+  }
+}
@@ -1,3 +1,7 @@
+import { StdFee } from "@cosmjs/launchpad";
+import { AminoConverter, SigningStargateClient, GasPrice, calculateFee } from "@cosmjs/stargate";
+import { EncodeObject } from "@cosmjs/proto-signing";
+
 export type Constructor<T> = new (...args: any[]) => T;
 
 export type AnyFunction = (...args: any) => any;
@@ -29,4 +33,89 @@ export function getStructure(template) {
 		structure.fields.push(field)
 	}
 	return structure
-}
\ No newline at end of file
+}
+
+export const defaultFee: StdFee = {
+  amount: [],
+  gas: "200000",
+};
+
+export const defaultGasAdjustment = 1.3;
+
+// estimateFee simulates the transaction of the messages and returns a fee for
+// the gas used multiplied by the gas adjustment. The fee amount is computed
+// with the gas price when it is set, e.g. "0.025stake".
+export async function estimateFee(
+  client: SigningStargateClient,
+  address: string,
+  msgs: EncodeObject[],
+  memo = "",
+  gasPrice?: string,
+  gasAdjustment = defaultGasAdjustment,
+): Promise<StdFee> {
+  const gas = Math.ceil((await client.simulate(address, msgs, memo)) * gasAdjustment);
+  if (gasPrice) {
+    return calculateFee(gas, GasPrice.fromString(gasPrice));
+  }
+  return { amount: [], gas: gas.toString() };
+}
+
+// resolveFee returns the fee of a transaction, the fee is estimated when it is
+// "auto" and the default fee is used when it is not set.
+export async function resolveFee(
+  client: SigningStargateClient,
+  address: string,
+  msgs: EncodeObject[],
+  fee: StdFee | "auto" | undefined,
+  memo = "",
+  gasPrice?: string,
+): Promise<StdFee> {
+  if (fee === "auto") {
+    return estimateFee(client, address, msgs, memo, gasPrice);
+  }
+  return fee ?? defaultFee;
+}
+
+type JSONType = {
+  toJSON(message: any): unknown;
+  fromJSON(object: any): any;
+};
+
+// aminoConverter returns the Amino converter of a message from its JSON
+// encoding. The fields are renamed from their JSON names to their proto names
+// and the empty values are omitted, like the Amino JSON of the Cosmos SDK.
+export function aminoConverter(
+  aminoType: string,
+  type: JSONType,
+  fields: Record<string, string>,
+): AminoConverter {
+  const jsonNames: Record<string, string> = {};
+  for (const [jsonName, protoName] of Object.entries(fields)) {
+    jsonNames[protoName] = jsonName;
+  }
+
+  return {
+    aminoType,
+    toAmino: (value: any) => {
+      const amino: Record<string, unknown> = {};
+      for (const [key, v] of Object.entries(type.toJSON(value) as Record<string, unknown>)) {
+        if (!isEmpty(v)) {
+          amino[fields[key] ?? key] = v;
+        }
+      }
+      return amino;
+    },
+    fromAmino: (amino: Record<string, unknown>) => {
+      const json: Record<string, unknown> = {};
+      for (const [key, v] of Object.entries(amino)) {
+        json[jsonNames[key] ?? key] = v;
+      }
+      return type.fromJSON(json);
+    },
+  };
+}
+
+function isEmpty(value: unknown): boolean {
+  return value === undefined || value === null || value === "" || value === false ||
+    value === 0 || value === "0" || (Array.isArray(value) && value.length === 0);
+}
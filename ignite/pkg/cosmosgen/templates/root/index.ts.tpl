@@ -2,7 +2,8 @@
 import { Registry } from '@cosmjs/proto-signing'
 import { IgniteClient } from "./client";
 import { MissingWalletError } from "./helpers";
-{{ range .Modules }}import { Module as {{ camelCaseUpperSta .Pkg.Name }}, msgTypes as {{ camelCaseUpperSta .Pkg.Name }}MsgTypes } from './{{ .Pkg.Name }}'
+import { FaucetClient, FaucetError } from "./faucet";
+{{ range .Modules }}import { Module as {{ camelCaseUpperSta .Pkg.Name }}, msgTypes as {{ camelCaseUpperSta .Pkg.Name }}MsgTypes, aminoConverters as {{ camelCaseUpperSta .Pkg.Name }}AminoConverters } from './{{ .Pkg.Name }}'
 {{ end }}
 
 const Client = IgniteClient.plugin([
@@ -14,8 +15,16 @@ const registry = new Registry([
   {{ end }}
 ])
 
+const aminoConverters = {
+  {{ range .Modules }}...{{ camelCaseUpperSta .Pkg.Name }}AminoConverters,
+  {{ end }}
+}
+
 export {
     Client,
     registry,
-    MissingWalletError
+    aminoConverters,
+    MissingWalletError,
+    FaucetClient,
+    FaucetError
 }
@@ -1,5 +1,6 @@
 import { IgniteClient } from "./client";
 import { GeneratedType } from "@cosmjs/proto-signing";
+import { AminoConverter } from "@cosmjs/stargate";
 
 export type ModuleInterface = { [key: string]: any }
-export type Module = (instance: IgniteClient) => { module: ModuleInterface, registry: [string, GeneratedType][] }
+export type Module = (instance: IgniteClient) => { module: ModuleInterface, registry: [string, GeneratedType][], amino?: Record<string, AminoConverter> }
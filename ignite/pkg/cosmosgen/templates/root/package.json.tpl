@@ -11,6 +11,11 @@
     }
   ],
   "main": "index.ts",
+  "types": "index.ts",
+  "scripts": {
+    "build": "tsc",
+    "test": "vitest run"
+  },
   "publishConfig": {
     "access": "public"
   },
@@ -29,6 +34,8 @@
     "@cosmjs/stargate": "0.27.0"
   }, 
   "devDependencies": {
-    "@types/events": "^3.0.0"
+    "@types/events": "^3.0.0",
+    "typescript": "^4.8.4",
+    "vitest": "^0.24.3"
   }
 }
@@ -0,0 +1,14 @@
+{
+  "compilerOptions": {
+    "target": "es2020",
+    "module": "commonjs",
+    "moduleResolution": "node",
+    "lib": ["es2020", "dom"],
+    "declaration": true,
+    "esModuleInterop": true,
+    "skipLibCheck": true,
+    "outDir": "lib"
+  },
+  "include": ["**/*.ts"],
+  "exclude": ["lib", "node_modules", "**/*.test.ts"]
+}
@@ -0,0 +1,13 @@
+# Generated by Ignite ignite.com/cli
+
+[package]
+name = "{{ .PackageNS }}-client"
+version = "0.0.1"
+edition = "2021"
+description = "Autogenerated Rust client"
+license = "Apache-2.0"
+
+[dependencies]
+prost = "0.11"
+prost-types = "0.11"
+tonic = "0.8"
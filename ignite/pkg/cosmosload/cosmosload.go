@@ -0,0 +1,271 @@
+// Package cosmosload generates a load of txs on a chain to test the performance
+// of its fee market and of the ordering of its txs. The txs are sent in lanes,
+// each lane has its own accounts, rate, distribution of gas prices and mix of
+// msgs, and the latency of the txs is reported per lane.
+package cosmosload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+)
+
+const (
+	defaultDuration = time.Minute
+
+	// maxRate is the rate of a lane that sends a tx every nanosecond.
+	maxRate = float64(time.Second)
+)
+
+// Sender broadcasts the txs of an account and waits for their inclusion in a
+// block, see cosmosclient.TxPipeline.
+type Sender interface {
+	BroadcastTxWithGasPrice(ctx context.Context, gasPrice sdktypes.DecCoin, msgs ...sdktypes.Msg) (cosmosclient.Response, error)
+}
+
+// Account is an account that sends the txs of a lane.
+type Account struct {
+	// Address is the address of the account, it is the sender of the msgs.
+	Address string
+
+	// Sender broadcasts the txs of the account.
+	Sender Sender
+}
+
+// NewAccount returns the account of the keyring of the client that sends its
+// txs with a tx pipeline of the client.
+func NewAccount(c cosmosclient.Client, account cosmosaccount.Account) (Account, error) {
+	address, err := c.Address(account.Name)
+	if err != nil {
+		return Account{}, err
+	}
+	return Account{
+		Address: address,
+		Sender:  c.NewTxPipeline(account),
+	}, nil
+}
+
+// MsgsFunc returns the msgs of a tx sent by the account of the address.
+type MsgsFunc func(from string) []sdktypes.Msg
+
+// BankSend returns the msgs of a tx that sends the amount to the address.
+func BankSend(to string, amount sdktypes.Coins) MsgsFunc {
+	return func(from string) []sdktypes.Msg {
+		return []sdktypes.Msg{&banktypes.MsgSend{
+			FromAddress: from,
+			ToAddress:   to,
+			Amount:      amount,
+		}}
+	}
+}
+
+// Workload is a kind of txs of a lane.
+type Workload struct {
+	// Name is the name of the workload in the report.
+	Name string
+
+	// Weight is the weight of the workload in the txs of the lane, a workload
+	// of weight 3 is sent three times more than a workload of weight 1.
+	Weight int
+
+	// Msgs returns the msgs of the txs of the workload.
+	Msgs MsgsFunc
+}
+
+// Lane is a flow of txs sent at a fixed rate.
+type Lane struct {
+	// Name is the name of the lane in the report.
+	Name string
+
+	// Accounts send the txs of the lane in turn. The accounts are reserved to
+	// the lane, the txs of the lanes don't wait for each other's sequences.
+	Accounts []Account
+
+	// Rate is the number of txs sent per second.
+	Rate float64
+
+	// GasPrice is the distribution of the gas prices of the txs.
+	GasPrice GasPriceDistribution
+
+	// Workloads are the kinds of txs of the lane.
+	Workloads []Workload
+}
+
+type options struct {
+	duration time.Duration
+	seed     int64
+}
+
+// Option configures the load.
+type Option func(*options)
+
+// WithDuration sets the duration of the load, the txs sent before the end of
+// the load are waited for, one minute by default.
+func WithDuration(duration time.Duration) Option {
+	return func(o *options) {
+		o.duration = duration
+	}
+}
+
+// WithSeed sets the seed of the random gas prices and workloads of the txs, so
+// a load can be replayed.
+func WithSeed(seed int64) Option {
+	return func(o *options) {
+		o.seed = seed
+	}
+}
+
+// Run sends the txs of the lanes during the duration of the load and reports
+// the txs of each lane once they are all included in a block or failed.
+func Run(ctx context.Context, lanes []Lane, options ...Option) (Report, error) {
+	o := newOptions(options...)
+	if err := validateLanes(lanes); err != nil {
+		return Report{}, err
+	}
+
+	loadCtx, cancel := context.WithTimeout(ctx, o.duration)
+	defer cancel()
+
+	var (
+		start      = time.Now()
+		wg         sync.WaitGroup
+		collectors = make([]*collector, len(lanes))
+	)
+	for i, lane := range lanes {
+		collectors[i] = newCollector(lane)
+
+		wg.Add(1)
+		go func(i int, lane Lane) {
+			defer wg.Done()
+			runLane(ctx, loadCtx, lane, rand.New(rand.NewSource(o.seed+int64(i))), collectors[i])
+		}(i, lane)
+	}
+	wg.Wait()
+
+	report := Report{Duration: time.Since(start)}
+	for _, c := range collectors {
+		report.Lanes = append(report.Lanes, c.report())
+	}
+	return report, ctx.Err()
+}
+
+// runLane sends the txs of the lane until the load context is done, then waits
+// for the txs sent. The txs are broadcasted with the context of the run.
+func runLane(ctx, loadCtx context.Context, lane Lane, r *rand.Rand, c *collector) {
+	ticker := time.NewTicker(time.Duration(float64(time.Second) / lane.Rate))
+	defer ticker.Stop()
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	for n := 0; ; n++ {
+		select {
+		case <-loadCtx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		var (
+			account  = lane.Accounts[n%len(lane.Accounts)]
+			workload = pickWorkload(lane.Workloads, r)
+			gasPrice = lane.GasPrice.GasPrice(r)
+			msgs     = workload.Msgs(account.Address)
+			sent     = time.Now()
+		)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := account.Sender.BroadcastTxWithGasPrice(ctx, gasPrice, msgs...)
+			c.add(workload.Name, time.Since(sent), err)
+		}()
+	}
+}
+
+// pickWorkload draws a workload according to the weights of the workloads.
+func pickWorkload(workloads []Workload, r *rand.Rand) Workload {
+	var total int
+	for _, w := range workloads {
+		total += w.Weight
+	}
+	n := r.Intn(total)
+	for _, w := range workloads {
+		if n < w.Weight {
+			return w
+		}
+		n -= w.Weight
+	}
+	return workloads[len(workloads)-1]
+}
+
+func newOptions(opts ...Option) options {
+	o := options{
+		duration: defaultDuration,
+		seed:     time.Now().UnixNano(),
+	}
+	for _, apply := range opts {
+		apply(&o)
+	}
+	return o
+}
+
+func validateLanes(lanes []Lane) error {
+	if len(lanes) == 0 {
+		return errors.New("no lanes to load")
+	}
+
+	var (
+		names    = make(map[string]bool)
+		accounts = make(map[string]string)
+	)
+	for _, lane := range lanes {
+		if lane.Name == "" {
+			return errors.New("lane without a name")
+		}
+		if names[lane.Name] {
+			return fmt.Errorf("duplicated lane %q", lane.Name)
+		}
+		names[lane.Name] = true
+
+		if len(lane.Accounts) == 0 {
+			return fmt.Errorf("no accounts in the lane %q", lane.Name)
+		}
+		for _, a := range lane.Accounts {
+			if other, ok := accounts[a.Address]; ok {
+				return fmt.Errorf("account %s in the lanes %q and %q, the accounts are reserved to a lane", a.Address, other, lane.Name)
+			}
+			accounts[a.Address] = lane.Name
+		}
+
+		if lane.Rate <= 0 {
+			return fmt.Errorf("rate of the lane %q must be positive", lane.Name)
+		}
+		if lane.Rate > maxRate {
+			return fmt.Errorf("rate of the lane %q must be at most %.0f txs per second", lane.Name, maxRate)
+		}
+		if lane.GasPrice == nil {
+			return fmt.Errorf("no gas price distribution in the lane %q", lane.Name)
+		}
+		if len(lane.Workloads) == 0 {
+			return fmt.Errorf("no workloads in the lane %q", lane.Name)
+		}
+		for _, w := range lane.Workloads {
+			if w.Weight <= 0 {
+				return fmt.Errorf("weight of the workload %q of the lane %q must be positive", w.Name, lane.Name)
+			}
+			if w.Msgs == nil {
+				return fmt.Errorf("no msgs in the workload %q of the lane %q", w.Name, lane.Name)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+package cosmosload_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/ignite/pkg/cosmosload"
+)
+
+const (
+	alice = "cosmos1k8e50d2d8xkdfw9c4et3m45llh69e7xzw6uzga"
+	bob   = "cosmos1vfhkyh6lta047h6lta047h6lta047h6ludswkc"
+	carol = "cosmos1vdshymmvta047h6lta047h6lta047h6lepvpy3"
+)
+
+// sender is a sender that includes the txs after its latency.
+type sender struct {
+	latency time.Duration
+	err     error
+
+	mu        sync.Mutex
+	gasPrices []sdktypes.DecCoin
+	msgs      []sdktypes.Msg
+}
+
+func (s *sender) BroadcastTxWithGasPrice(_ context.Context, gasPrice sdktypes.DecCoin, msgs ...sdktypes.Msg) (cosmosclient.Response, error) {
+	time.Sleep(s.latency)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.gasPrices = append(s.gasPrices, gasPrice)
+	s.msgs = append(s.msgs, msgs...)
+	return cosmosclient.Response{}, s.err
+}
+
+func TestRun(t *testing.T) {
+	var (
+		amount   = sdktypes.NewCoins(sdktypes.NewInt64Coin("stake", 1))
+		high     = &sender{latency: 10 * time.Millisecond}
+		low      = &sender{latency: 50 * time.Millisecond}
+		errLow   = errors.New("insufficient fees")
+		rejected = &sender{err: errLow}
+	)
+	gasPrice, err := cosmosload.UniformGasPrice(
+		sdktypes.NewDecCoinFromDec("stake", sdktypes.MustNewDecFromStr("0.2")),
+		sdktypes.NewDecCoinFromDec("stake", sdktypes.MustNewDecFromStr("0.5")),
+	)
+	require.NoError(t, err)
+
+	report, err := cosmosload.Run(context.Background(), []cosmosload.Lane{
+		{
+			Name:     "high",
+			Accounts: []cosmosload.Account{{Address: alice, Sender: high}},
+			Rate:     100,
+			GasPrice: gasPrice,
+			Workloads: []cosmosload.Workload{
+				{Name: "bank", Weight: 3, Msgs: cosmosload.BankSend(bob, amount)},
+				{Name: "custom", Weight: 1, Msgs: func(from string) []sdktypes.Msg {
+					return []sdktypes.Msg{&banktypes.MsgMultiSend{}}
+				}},
+			},
+		},
+		{
+			Name: "low",
+			Accounts: []cosmosload.Account{
+				{Address: bob, Sender: low},
+				{Address: carol, Sender: rejected},
+			},
+			Rate:      50,
+			GasPrice:  cosmosload.FixedGasPrice(sdktypes.NewDecCoin("stake", sdktypes.ZeroInt())),
+			Workloads: []cosmosload.Workload{{Name: "bank", Weight: 1, Msgs: cosmosload.BankSend(alice, amount)}},
+		},
+	}, cosmosload.WithDuration(500*time.Millisecond), cosmosload.WithSeed(1))
+	require.NoError(t, err)
+	require.Len(t, report.Lanes, 2)
+
+	// the txs of the lanes are all sent with their gas prices.
+	highLane := report.Lanes[0]
+	require.Equal(t, "high", highLane.Name)
+	require.NotZero(t, highLane.Sent)
+	require.Zero(t, highLane.Failed)
+	require.Len(t, high.gasPrices, highLane.Sent)
+	for _, p := range high.gasPrices {
+		require.True(t, p.Amount.GTE(sdktypes.MustNewDecFromStr("0.2")), p.String())
+		require.True(t, p.Amount.LTE(sdktypes.MustNewDecFromStr("0.5")), p.String())
+	}
+
+	// the workloads are mixed according to their weights.
+	require.Equal(t, highLane.Sent, highLane.Workloads[0].Sent+highLane.Workloads[1].Sent)
+	require.Greater(t, highLane.Workloads[0].Sent, highLane.Workloads[1].Sent)
+	for _, msg := range high.msgs {
+		if send, ok := msg.(*banktypes.MsgSend); ok {
+			require.Equal(t, alice, send.FromAddress)
+			require.Equal(t, bob, send.ToAddress)
+		}
+	}
+
+	// the latency of the lanes is the latency of their included txs.
+	require.GreaterOrEqual(t, highLane.Latency.P50, 10*time.Millisecond)
+	require.LessOrEqual(t, highLane.Latency.P50, highLane.Latency.P90)
+	require.LessOrEqual(t, highLane.Latency.P90, highLane.Latency.P99)
+	require.LessOrEqual(t, highLane.Latency.P99, highLane.Latency.Max)
+
+	// the accounts of a lane send its txs in turn.
+	lowLane := report.Lanes[1]
+	require.Equal(t, "low", lowLane.Name)
+	require.Equal(t, len(low.msgs)+len(rejected.msgs), lowLane.Sent)
+	require.Equal(t, len(rejected.msgs), lowLane.Failed)
+	require.ErrorIs(t, lowLane.Err, errLow)
+	require.GreaterOrEqual(t, lowLane.Latency.P50, 50*time.Millisecond)
+}
+
+func TestRunInvalidLanes(t *testing.T) {
+	var (
+		account  = cosmosload.Account{Address: alice, Sender: &sender{}}
+		gasPrice = cosmosload.FixedGasPrice(sdktypes.NewDecCoin("stake", sdktypes.ZeroInt()))
+		workload = cosmosload.Workload{Name: "bank", Weight: 1, Msgs: cosmosload.BankSend(bob, nil)}
+		lane     = func(name string, accounts ...cosmosload.Account) cosmosload.Lane {
+			return cosmosload.Lane{
+				Name:      name,
+				Accounts:  accounts,
+				Rate:      1,
+				GasPrice:  gasPrice,
+				Workloads: []cosmosload.Workload{workload},
+			}
+		}
+	)
+
+	tests := []struct {
+		name          string
+		lanes         []cosmosload.Lane
+		expectedError string
+	}{
+		{
+			name:          "no lanes",
+			expectedError: "no lanes to load",
+		},
+		{
+			name:          "duplicated lane",
+			lanes:         []cosmosload.Lane{lane("a", account), lane("a", cosmosload.Account{Address: bob})},
+			expectedError: `duplicated lane "a"`,
+		},
+		{
+			name:          "account of several lanes",
+			lanes:         []cosmosload.Lane{lane("a", account), lane("b", account)},
+			expectedError: `account ` + alice + ` in the lanes "a" and "b", the accounts are reserved to a lane`,
+		},
+		{
+			name:          "no accounts",
+			lanes:         []cosmosload.Lane{lane("a")},
+			expectedError: `no accounts in the lane "a"`,
+		},
+		{
+			name: "rate above one tx per nanosecond",
+			lanes: []cosmosload.Lane{func() cosmosload.Lane {
+				l := lane("a", account)
+				l.Rate = 2e9
+				return l
+			}()},
+			expectedError: `rate of the lane "a" must be at most 1000000000 txs per second`,
+		},
+		{
+			name: "zero weight",
+			lanes: []cosmosload.Lane{func() cosmosload.Lane {
+				l := lane("a", account)
+				l.Workloads = []cosmosload.Workload{{Name: "bank", Msgs: workload.Msgs}}
+				return l
+			}()},
+			expectedError: `weight of the workload "bank" of the lane "a" must be positive`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := cosmosload.Run(context.Background(), tt.lanes)
+			require.EqualError(t, err, tt.expectedError)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package cosmosload
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GasPriceDistribution draws the gas prices of the txs of a lane. The mempool
+// of the node orders the txs by priority, which is the gas price of the txs by
+// default, so the distribution of the gas prices of a lane sets its priority.
+type GasPriceDistribution interface {
+	// GasPrice draws a gas price with the random source.
+	GasPrice(r *rand.Rand) sdktypes.DecCoin
+}
+
+type fixedGasPrice struct {
+	price sdktypes.DecCoin
+}
+
+// FixedGasPrice returns a distribution that always draws the price.
+func FixedGasPrice(price sdktypes.DecCoin) GasPriceDistribution {
+	return fixedGasPrice{price}
+}
+
+func (d fixedGasPrice) GasPrice(*rand.Rand) sdktypes.DecCoin {
+	return d.price
+}
+
+type uniformGasPrice struct {
+	min, max sdktypes.DecCoin
+}
+
+// UniformGasPrice returns a distribution that draws the prices uniformly
+// between the min and the max prices, the prices must have the same denom.
+func UniformGasPrice(min, max sdktypes.DecCoin) (GasPriceDistribution, error) {
+	if min.Denom != max.Denom {
+		return nil, fmt.Errorf("different denoms %q and %q for the min and max gas prices", min.Denom, max.Denom)
+	}
+	if min.Amount.GT(max.Amount) {
+		return nil, fmt.Errorf("min gas price %s above the max gas price %s", min, max)
+	}
+	return uniformGasPrice{min, max}, nil
+}
+
+func (d uniformGasPrice) GasPrice(r *rand.Rand) sdktypes.DecCoin {
+	spread := d.max.Amount.Sub(d.min.Amount)
+	return sdktypes.NewDecCoinFromDec(d.min.Denom, d.min.Amount.Add(spread.Mul(floatToDec(r.Float64()))))
+}
+
+type normalGasPrice struct {
+	mean   sdktypes.DecCoin
+	stdDev sdktypes.Dec
+}
+
+// NormalGasPrice returns a distribution that draws the prices from a normal
+// distribution of the mean price and of the standard deviation, the prices
+// below zero are drawn as zero.
+func NormalGasPrice(mean sdktypes.DecCoin, stdDev sdktypes.Dec) (GasPriceDistribution, error) {
+	if stdDev.IsNegative() {
+		return nil, fmt.Errorf("negative standard deviation %s of the gas price", stdDev)
+	}
+	return normalGasPrice{mean, stdDev}, nil
+}
+
+func (d normalGasPrice) GasPrice(r *rand.Rand) sdktypes.DecCoin {
+	price := d.mean.Amount.Add(d.stdDev.Mul(floatToDec(r.NormFloat64())))
+	if price.IsNegative() {
+		price = sdktypes.ZeroDec()
+	}
+	return sdktypes.NewDecCoinFromDec(d.mean.Denom, price)
+}
+
+// floatToDec converts a float to a decimal with the precision of the decimals.
+func floatToDec(f float64) sdktypes.Dec {
+	return sdktypes.MustNewDecFromStr(strconv.FormatFloat(f, 'f', sdktypes.Precision, 64))
+}
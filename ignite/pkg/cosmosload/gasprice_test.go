@@ -0,0 +1,55 @@
+package cosmosload_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosload"
+)
+
+func TestUniformGasPrice(t *testing.T) {
+	var (
+		min = sdktypes.NewDecCoinFromDec("stake", sdktypes.MustNewDecFromStr("0.1"))
+		max = sdktypes.NewDecCoinFromDec("stake", sdktypes.MustNewDecFromStr("0.3"))
+		r   = rand.New(rand.NewSource(1))
+	)
+
+	d, err := cosmosload.UniformGasPrice(min, max)
+	require.NoError(t, err)
+	for i := 0; i < 100; i++ {
+		p := d.GasPrice(r)
+		require.Equal(t, "stake", p.Denom)
+		require.True(t, p.Amount.GTE(min.Amount) && p.Amount.LTE(max.Amount), p.String())
+	}
+
+	_, err = cosmosload.UniformGasPrice(max, min)
+	require.EqualError(t, err, "min gas price 0.300000000000000000stake above the max gas price 0.100000000000000000stake")
+
+	_, err = cosmosload.UniformGasPrice(min, sdktypes.NewDecCoinFromDec("uatom", max.Amount))
+	require.EqualError(t, err, `different denoms "stake" and "uatom" for the min and max gas prices`)
+}
+
+func TestNormalGasPrice(t *testing.T) {
+	var (
+		mean = sdktypes.NewDecCoinFromDec("stake", sdktypes.MustNewDecFromStr("0.1"))
+		r    = rand.New(rand.NewSource(1))
+	)
+
+	d, err := cosmosload.NormalGasPrice(mean, sdktypes.MustNewDecFromStr("0.2"))
+	require.NoError(t, err)
+
+	sum := sdktypes.ZeroDec()
+	for i := 0; i < 1000; i++ {
+		p := d.GasPrice(r)
+		require.False(t, p.Amount.IsNegative(), p.String())
+		sum = sum.Add(p.Amount)
+	}
+	// the prices below zero are drawn as zero, which raises the mean.
+	require.True(t, sum.QuoInt64(1000).GT(mean.Amount), sum.String())
+
+	_, err = cosmosload.NormalGasPrice(mean, sdktypes.MustNewDecFromStr("-1"))
+	require.EqualError(t, err, "negative standard deviation -1.000000000000000000 of the gas price")
+}
@@ -0,0 +1,138 @@
+package cosmosload
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Report is the report of a load.
+type Report struct {
+	// Duration is the duration of the load, including the wait for the
+	// inclusion of the last txs.
+	Duration time.Duration
+
+	// Lanes are the reports of the lanes, in the order of the lanes.
+	Lanes []LaneReport
+}
+
+// LaneReport is the report of the txs of a lane.
+type LaneReport struct {
+	// Name is the name of the lane.
+	Name string
+
+	// Sent is the number of txs sent.
+	Sent int
+
+	// Failed is the number of txs rejected by the node or failed in a block.
+	Failed int
+
+	// Err is the error of the first failed tx.
+	Err error
+
+	// Workloads are the reports of the workloads, in the order of the
+	// workloads of the lane.
+	Workloads []WorkloadReport
+
+	// Latency is the latency of the txs included in a block.
+	Latency Latency
+}
+
+// WorkloadReport is the report of the txs of a workload.
+type WorkloadReport struct {
+	// Name is the name of the workload.
+	Name string
+
+	// Sent is the number of txs sent.
+	Sent int
+
+	// Failed is the number of failed txs.
+	Failed int
+}
+
+// Latency holds the percentiles of the durations between the scheduled send of
+// the txs and their inclusion in a block.
+type Latency struct {
+	P50 time.Duration
+	P90 time.Duration
+	P99 time.Duration
+	Max time.Duration
+}
+
+// collector collects the results of the txs of a lane.
+type collector struct {
+	mu        sync.Mutex
+	lane      LaneReport
+	latencies []time.Duration
+}
+
+func newCollector(lane Lane) *collector {
+	c := &collector{lane: LaneReport{Name: lane.Name}}
+	for _, w := range lane.Workloads {
+		c.lane.Workloads = append(c.lane.Workloads, WorkloadReport{Name: w.Name})
+	}
+	return c
+}
+
+func (c *collector) add(workload string, latency time.Duration, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.lane.Sent++
+	for i := range c.lane.Workloads {
+		if c.lane.Workloads[i].Name != workload {
+			continue
+		}
+		c.lane.Workloads[i].Sent++
+		if err != nil {
+			c.lane.Workloads[i].Failed++
+		}
+		break
+	}
+
+	if err != nil {
+		c.lane.Failed++
+		if c.lane.Err == nil {
+			c.lane.Err = err
+		}
+		return
+	}
+	c.latencies = append(c.latencies, latency)
+}
+
+func (c *collector) report() LaneReport {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	lane := c.lane
+	lane.Latency = latencyOf(c.latencies)
+	return lane
+}
+
+// latencyOf returns the percentiles of the latencies with the nearest rank
+// method.
+func latencyOf(latencies []time.Duration) Latency {
+	if len(latencies) == 0 {
+		return Latency{}
+	}
+
+	sorted := make([]time.Duration, len(latencies))
+	copy(sorted, latencies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	percentile := func(p float64) time.Duration {
+		rank := int(math.Ceil(p * float64(len(sorted))))
+		if rank < 1 {
+			rank = 1
+		}
+		return sorted[rank-1]
+	}
+
+	return Latency{
+		P50: percentile(0.5),
+		P90: percentile(0.9),
+		P99: percentile(0.99),
+		Max: sorted[len(sorted)-1],
+	}
+}
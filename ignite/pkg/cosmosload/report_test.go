@@ -0,0 +1,29 @@
+package cosmosload
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLatencyOf(t *testing.T) {
+	var latencies []time.Duration
+	for i := 100; i > 0; i-- {
+		latencies = append(latencies, time.Duration(i)*time.Millisecond)
+	}
+
+	require.Equal(t, Latency{
+		P50: 50 * time.Millisecond,
+		P90: 90 * time.Millisecond,
+		P99: 99 * time.Millisecond,
+		Max: 100 * time.Millisecond,
+	}, latencyOf(latencies))
+	require.Equal(t, Latency{
+		P50: time.Millisecond,
+		P90: time.Millisecond,
+		P99: time.Millisecond,
+		Max: time.Millisecond,
+	}, latencyOf([]time.Duration{time.Millisecond}))
+	require.Equal(t, Latency{}, latencyOf(nil))
+}
@@ -0,0 +1,224 @@
+package cosmosmetrics
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	// DatasourceUID is the UID of the Prometheus datasource of the dashboards.
+	DatasourceUID = "prometheus"
+
+	panelWidth  = 12
+	panelHeight = 8
+)
+
+// Dashboard is a Grafana dashboard.
+type Dashboard struct {
+	UID           string   `json:"uid"`
+	Title         string   `json:"title"`
+	Tags          []string `json:"tags"`
+	Timezone      string   `json:"timezone"`
+	SchemaVersion int      `json:"schemaVersion"`
+	Refresh       string   `json:"refresh"`
+	Time          struct {
+		From string `json:"from"`
+		To   string `json:"to"`
+	} `json:"time"`
+	Panels []Panel `json:"panels"`
+}
+
+// Panel is a panel of a Grafana dashboard.
+type Panel struct {
+	ID          int         `json:"id"`
+	Type        string      `json:"type"`
+	Title       string      `json:"title"`
+	Datasource  Datasource  `json:"datasource"`
+	GridPos     GridPos     `json:"gridPos"`
+	FieldConfig FieldConfig `json:"fieldConfig"`
+	Targets     []Target    `json:"targets"`
+}
+
+// Datasource is the datasource of a panel.
+type Datasource struct {
+	Type string `json:"type"`
+	UID  string `json:"uid"`
+}
+
+// GridPos is the position of a panel in the grid of a dashboard.
+type GridPos struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+	W int `json:"w"`
+	H int `json:"h"`
+}
+
+// FieldConfig configures the display of the values of a panel.
+type FieldConfig struct {
+	Defaults struct {
+		Unit string `json:"unit"`
+	} `json:"defaults"`
+	Overrides []interface{} `json:"overrides"`
+}
+
+// Target is a Prometheus query of a panel.
+type Target struct {
+	RefID        string `json:"refId"`
+	Expr         string `json:"expr"`
+	LegendFormat string `json:"legendFormat,omitempty"`
+}
+
+// newDashboard returns a dashboard with the panels laid out on two columns.
+func newDashboard(uid, title string, tags []string, panels ...Panel) Dashboard {
+	d := Dashboard{
+		UID:           uid,
+		Title:         title,
+		Tags:          tags,
+		Timezone:      "browser",
+		SchemaVersion: 37,
+		Refresh:       "5s",
+		Panels:        panels,
+	}
+	d.Time.From = "now-15m"
+	d.Time.To = "now"
+
+	for i := range d.Panels {
+		d.Panels[i].ID = i + 1
+		d.Panels[i].GridPos = GridPos{
+			X: (i % 2) * panelWidth,
+			Y: (i / 2) * panelHeight,
+			W: panelWidth,
+			H: panelHeight,
+		}
+	}
+
+	return d
+}
+
+// newPanel returns a panel of the type with a query for each expression,
+// expressions are written as "expr" or "expr|legend".
+func newPanel(panelType, title, unit string, exprs ...string) Panel {
+	p := Panel{
+		Type:       panelType,
+		Title:      title,
+		Datasource: Datasource{Type: "prometheus", UID: DatasourceUID},
+	}
+	p.FieldConfig.Defaults.Unit = unit
+	p.FieldConfig.Overrides = []interface{}{}
+
+	for i, expr := range exprs {
+		expr, legend, _ := strings.Cut(expr, "|")
+		p.Targets = append(p.Targets, Target{
+			RefID:        string(rune('A' + i)),
+			Expr:         expr,
+			LegendFormat: legend,
+		})
+	}
+
+	return p
+}
+
+func timeSeries(title, unit string, exprs ...string) Panel {
+	return newPanel("timeseries", title, unit, exprs...)
+}
+
+func stat(title, unit string, exprs ...string) Panel {
+	return newPanel("stat", title, unit, exprs...)
+}
+
+// ConsensusDashboard returns a dashboard of the Tendermint consensus metrics
+// of the nodes of the chain.
+func ConsensusDashboard(chainID, namespace string) Dashboard {
+	m := metricName(namespace)
+	return newDashboard(
+		uid(chainID, "consensus"),
+		fmt.Sprintf("%s consensus", chainID),
+		[]string{"ignite", chainID},
+		stat("Block height", "none", m("consensus_height")),
+		stat("Peers", "none", m("p2p_peers")),
+		timeSeries("Block interval", "s",
+			fmt.Sprintf("rate(%s[1m]) / rate(%s[1m])|interval", m("consensus_block_interval_seconds_sum"), m("consensus_block_interval_seconds_count")),
+		),
+		timeSeries("Transactions per block", "none", m("consensus_num_txs")+"|txs"),
+		timeSeries("Validators", "none",
+			m("consensus_validators")+"|validators",
+			m("consensus_missing_validators")+"|missing",
+			m("consensus_byzantine_validators")+"|byzantine",
+		),
+		timeSeries("Voting power", "none",
+			m("consensus_validators_power")+"|total",
+			m("consensus_missing_validators_power")+"|missing",
+		),
+		timeSeries("Rounds", "none", m("consensus_rounds")+"|rounds"),
+		timeSeries("Block size", "bytes", m("consensus_block_size_bytes")+"|size"),
+	)
+}
+
+// MempoolDashboard returns a dashboard of the Tendermint mempool metrics of
+// the nodes of the chain.
+func MempoolDashboard(chainID, namespace string) Dashboard {
+	m := metricName(namespace)
+	return newDashboard(
+		uid(chainID, "mempool"),
+		fmt.Sprintf("%s mempool", chainID),
+		[]string{"ignite", chainID},
+		timeSeries("Mempool size", "none", m("mempool_size")+"|txs"),
+		timeSeries("Transaction size", "bytes",
+			fmt.Sprintf("rate(%s[1m]) / rate(%s[1m])|average", m("mempool_tx_size_bytes_sum"), m("mempool_tx_size_bytes_count")),
+		),
+		timeSeries("Failed transactions", "ops", fmt.Sprintf("rate(%s[1m])|failed", m("mempool_failed_txs"))),
+		timeSeries("Rechecks", "ops", fmt.Sprintf("rate(%s[1m])|rechecks", m("mempool_recheck_times"))),
+	)
+}
+
+// ModulesDashboard returns a dashboard of the metrics of the custom modules
+// of the app, by module name. There is a panel for each metric.
+func ModulesDashboard(chainID string, metrics map[string][]Metric) Dashboard {
+	modules := make([]string, 0, len(metrics))
+	for name := range metrics {
+		modules = append(modules, name)
+	}
+	sort.Strings(modules)
+
+	var panels []Panel
+	for _, module := range modules {
+		for _, m := range metrics[module] {
+			title := fmt.Sprintf("%s: %s", module, m.Name)
+			switch m.Type {
+			case Counter:
+				panels = append(panels, timeSeries(title, "ops", fmt.Sprintf("sum(rate(%s[1m]))|%s", m.Name, m.Name)))
+			case Gauge:
+				panels = append(panels, timeSeries(title, "none", m.Name+"|"+m.Name))
+			case Summary:
+				panels = append(panels, timeSeries(title, "ms",
+					fmt.Sprintf(`%s{quantile="0.5"}|p50`, m.Name),
+					fmt.Sprintf(`%s{quantile="0.99"}|p99`, m.Name),
+				))
+			}
+		}
+	}
+
+	return newDashboard(
+		uid(chainID, "modules"),
+		fmt.Sprintf("%s modules", chainID),
+		[]string{"ignite", chainID},
+		panels...,
+	)
+}
+
+// metricName returns a func that prefixes the metric names with the namespace.
+func metricName(namespace string) func(string) string {
+	return func(name string) string {
+		return namespace + "_" + name
+	}
+}
+
+// uid returns the UID of a dashboard, Grafana limits UIDs to 40 characters.
+func uid(chainID, name string) string {
+	id := invalidNameChars.ReplaceAllString(chainID, "-") + "-" + name
+	if len(id) > 40 {
+		id = id[len(id)-40:]
+	}
+	return id
+}
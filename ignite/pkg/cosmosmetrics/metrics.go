@@ -0,0 +1,167 @@
+// Package cosmosmetrics finds the metrics of the modules of a Cosmos SDK app
+// and writes Grafana dashboards of the metrics of a chain, with a Prometheus
+// and Grafana stack that scrapes the metrics of a development node.
+package cosmosmetrics
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MetricType is the Prometheus type of a metric.
+type MetricType string
+
+const (
+	// Counter is a metric that only increases.
+	Counter MetricType = "counter"
+
+	// Gauge is a metric that is set to a value.
+	Gauge MetricType = "gauge"
+
+	// Summary is a metric of the quantiles of measured durations, in milliseconds.
+	Summary MetricType = "summary"
+)
+
+// Metric is a metric emitted by a module with the Cosmos SDK telemetry package.
+type Metric struct {
+	// Name is the Prometheus name of the metric.
+	Name string
+
+	// Type is the Prometheus type of the metric.
+	Type MetricType
+}
+
+// metricFuncs are the funcs of the telemetry package of the Cosmos SDK and of
+// the go-metrics package used by the telemetry that emit metrics, by package
+// name, with the index of their first key argument. The keys are either
+// variadic or a []string literal for the funcs with labels.
+var metricFuncs = map[string]map[string]struct {
+	metricType MetricType
+	keysArg    int
+	keysSlice  bool
+}{
+	"telemetry": {
+		"IncrCounter":           {Counter, 1, false},
+		"IncrCounterWithLabels": {Counter, 0, true},
+		"SetGauge":              {Gauge, 1, false},
+		"SetGaugeWithLabels":    {Gauge, 0, true},
+		"ModuleSetGauge":        {Gauge, 2, false},
+		"MeasureSince":          {Summary, 1, false},
+		"ModuleMeasureSince":    {Summary, 2, false},
+	},
+	"metrics": {
+		"IncrCounterWithLabels":  {Counter, 0, true},
+		"SetGaugeWithLabels":     {Gauge, 0, true},
+		"MeasureSinceWithLabels": {Summary, 0, true},
+	},
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-zA-Z0-9_:]`)
+
+// FindModuleMetrics finds the metrics emitted with the Cosmos SDK telemetry
+// package, or with go-metrics for the metrics with labels, in the Go files of
+// the module at path. The ModuleName constant of the module is resolved to
+// moduleName, the metrics with other keys that are not string literals are
+// skipped because their names are only known at runtime.
+func FindModuleMetrics(path, moduleName string) ([]Metric, error) {
+	found := make(map[string]Metric)
+	err := filepath.WalkDir(path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || filepath.Ext(path) != ".go" || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		f, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+		if err != nil {
+			return err
+		}
+
+		ast.Inspect(f, func(n ast.Node) bool {
+			if m, ok := metricOf(n, moduleName); ok {
+				found[m.Name] = m
+			}
+			return true
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := make([]Metric, 0, len(found))
+	for _, m := range found {
+		metrics = append(metrics, m)
+	}
+	sort.Slice(metrics, func(i, j int) bool { return metrics[i].Name < metrics[j].Name })
+
+	return metrics, nil
+}
+
+func metricOf(n ast.Node, moduleName string) (Metric, bool) {
+	call, ok := n.(*ast.CallExpr)
+	if !ok {
+		return Metric{}, false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return Metric{}, false
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return Metric{}, false
+	}
+	fn, ok := metricFuncs[pkg.Name][sel.Sel.Name]
+	if !ok || len(call.Args) <= fn.keysArg {
+		return Metric{}, false
+	}
+
+	args := call.Args[fn.keysArg:]
+	if fn.keysSlice {
+		lit, ok := args[0].(*ast.CompositeLit)
+		if !ok {
+			return Metric{}, false
+		}
+		args = lit.Elts
+	}
+
+	keys := make([]string, len(args))
+	for i, arg := range args {
+		key, ok := keyOf(arg, moduleName)
+		if !ok {
+			return Metric{}, false
+		}
+		keys[i] = key
+	}
+	if len(keys) == 0 {
+		return Metric{}, false
+	}
+
+	// The Prometheus sink of go-metrics joins the keys with underscores
+	name := invalidNameChars.ReplaceAllString(strings.Join(keys, "_"), "_")
+	return Metric{Name: name, Type: fn.metricType}, true
+}
+
+func keyOf(expr ast.Expr, moduleName string) (string, bool) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		if e.Kind != token.STRING {
+			return "", false
+		}
+		key, err := strconv.Unquote(e.Value)
+		return key, err == nil
+	case *ast.Ident:
+		return moduleName, e.Name == "ModuleName"
+	case *ast.SelectorExpr:
+		return moduleName, e.Sel.Name == "ModuleName"
+	}
+	return "", false
+}
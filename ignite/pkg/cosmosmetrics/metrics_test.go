@@ -0,0 +1,52 @@
+package cosmosmetrics_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosmetrics"
+)
+
+const keeperFile = `package keeper
+
+import (
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/example/mars/x/mars/types"
+)
+
+func (k Keeper) CreatePost(title string, key string) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), "create_post")
+
+	telemetry.IncrCounter(1, types.ModuleName, "posts")
+	telemetry.IncrCounterWithLabels([]string{types.ModuleName, "post-title"}, 1, nil)
+	telemetry.SetGauge(float32(len(title)), ModuleName, "title", "length")
+	metrics.MeasureSinceWithLabels([]string{types.ModuleName, "post", "duration"}, time.Now(), nil)
+
+	// The names of the metrics with runtime keys are unknown
+	telemetry.IncrCounter(1, types.ModuleName, key)
+}
+`
+
+func TestFindModuleMetrics(t *testing.T) {
+	dir := t.TempDir()
+	keeperDir := filepath.Join(dir, "keeper")
+	require.NoError(t, os.MkdirAll(keeperDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(keeperDir, "post.go"), []byte(keeperFile), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(keeperDir, "post_test.go"), []byte(keeperFile), 0o644))
+
+	metrics, err := cosmosmetrics.FindModuleMetrics(dir, "mars")
+	require.NoError(t, err)
+	require.Equal(t, []cosmosmetrics.Metric{
+		{Name: "create_post", Type: cosmosmetrics.Summary},
+		{Name: "mars_post_duration", Type: cosmosmetrics.Summary},
+		{Name: "mars_post_title", Type: cosmosmetrics.Counter},
+		{Name: "mars_posts", Type: cosmosmetrics.Counter},
+		{Name: "mars_title_length", Type: cosmosmetrics.Gauge},
+	}, metrics)
+}
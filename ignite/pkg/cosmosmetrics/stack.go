@@ -0,0 +1,214 @@
+package cosmosmetrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+const (
+	// PrometheusPort is the host port of the Prometheus server of the stack.
+	PrometheusPort = 9095
+
+	// GrafanaPort is the host port of the Grafana server of the stack.
+	GrafanaPort = 3000
+
+	// dockerHost is the host name of the host machine in the containers.
+	dockerHost = "host.docker.internal"
+)
+
+// StackOptions configures the Prometheus and Grafana stack of a chain.
+type StackOptions struct {
+	// ChainID is the ID of the chain.
+	ChainID string
+
+	// Namespace is the namespace of the Tendermint metrics of the node.
+	Namespace string
+
+	// PrometheusAddress is the address of the Tendermint metrics server of the node.
+	PrometheusAddress string
+
+	// APIAddress is the address of the API of the node that serves the
+	// Cosmos SDK telemetry.
+	APIAddress string
+
+	// Modules are the metrics of the custom modules of the app by module name,
+	// the modules dashboard is only written when there is a metric.
+	Modules map[string][]Metric
+}
+
+var dockerCompose = template.Must(template.New("docker-compose.yml").Parse(`# Prometheus and Grafana stack of the {{ .ChainID }} chain.
+# Start the stack with: docker compose up -d
+# Grafana: http://localhost:{{ .GrafanaPort }}
+# Prometheus: http://localhost:{{ .PrometheusPort }}
+version: "3"
+services:
+  prometheus:
+    image: prom/prometheus:latest
+    command:
+      - --config.file=/etc/prometheus/prometheus.yml
+    ports:
+      - "{{ .PrometheusPort }}:9090"
+    volumes:
+      - ./prometheus/prometheus.yml:/etc/prometheus/prometheus.yml:ro
+    extra_hosts:
+      - "{{ .DockerHost }}:host-gateway"
+  grafana:
+    image: grafana/grafana:latest
+    environment:
+      - GF_AUTH_ANONYMOUS_ENABLED=true
+      - GF_AUTH_ANONYMOUS_ORG_ROLE=Admin
+      - GF_AUTH_DISABLE_LOGIN_FORM=true
+    ports:
+      - "{{ .GrafanaPort }}:3000"
+    volumes:
+      - ./grafana/provisioning:/etc/grafana/provisioning:ro
+      - ./grafana/dashboards:/var/lib/grafana/dashboards:ro
+    depends_on:
+      - prometheus
+`))
+
+var prometheusConfig = template.Must(template.New("prometheus.yml").Parse(`global:
+  scrape_interval: 5s
+scrape_configs:
+  - job_name: tendermint
+    static_configs:
+      - targets: ["{{ .PrometheusTarget }}"]
+        labels:
+          chain_id: {{ .ChainID }}
+  - job_name: cosmos
+    metrics_path: /metrics
+    params:
+      format: ["prometheus"]
+    static_configs:
+      - targets: ["{{ .APITarget }}"]
+        labels:
+          chain_id: {{ .ChainID }}
+`))
+
+var grafanaDatasource = template.Must(template.New("prometheus.yml").Parse(`apiVersion: 1
+datasources:
+  - name: Prometheus
+    type: prometheus
+    uid: {{ .DatasourceUID }}
+    access: proxy
+    url: http://prometheus:9090
+    isDefault: true
+`))
+
+var grafanaDashboards = template.Must(template.New("dashboards.yml").Parse(`apiVersion: 1
+providers:
+  - name: {{ .ChainID }}
+    folder: {{ .ChainID }}
+    type: file
+    options:
+      path: /var/lib/grafana/dashboards
+`))
+
+// WriteStack writes in dir the Grafana dashboards of the chain with a Docker
+// Compose file of a Prometheus and Grafana stack that scrapes the metrics of
+// the node and provisions the dashboards.
+func WriteStack(dir string, o StackOptions) error {
+	prometheusTarget, err := scrapeTarget(o.PrometheusAddress)
+	if err != nil {
+		return fmt.Errorf("invalid prometheus address: %w", err)
+	}
+	apiTarget, err := scrapeTarget(o.APIAddress)
+	if err != nil {
+		return fmt.Errorf("invalid api address: %w", err)
+	}
+
+	data := struct {
+		StackOptions
+		PrometheusPort   int
+		GrafanaPort      int
+		DockerHost       string
+		DatasourceUID    string
+		PrometheusTarget string
+		APITarget        string
+	}{o, PrometheusPort, GrafanaPort, dockerHost, DatasourceUID, prometheusTarget, apiTarget}
+
+	for path, tpl := range map[string]*template.Template{
+		"docker-compose.yml":                              dockerCompose,
+		"prometheus/prometheus.yml":                       prometheusConfig,
+		"grafana/provisioning/datasources/prometheus.yml": grafanaDatasource,
+		"grafana/provisioning/dashboards/dashboards.yml":  grafanaDashboards,
+	} {
+		if err := writeTemplate(filepath.Join(dir, path), tpl, data); err != nil {
+			return err
+		}
+	}
+
+	dashboards := []Dashboard{
+		ConsensusDashboard(o.ChainID, o.Namespace),
+		MempoolDashboard(o.ChainID, o.Namespace),
+	}
+	if len(o.Modules) > 0 {
+		dashboards = append(dashboards, ModulesDashboard(o.ChainID, o.Modules))
+	}
+
+	// Remove the dashboards of a previous generation, like the dashboard of
+	// modules that are not instrumented anymore
+	dashboardsDir := filepath.Join(dir, "grafana", "dashboards")
+	if err := os.RemoveAll(dashboardsDir); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dashboardsDir, 0o755); err != nil {
+		return err
+	}
+	for _, d := range dashboards {
+		content, err := json.MarshalIndent(d, "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(dashboardsDir, d.UID+".json"), content, 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeTemplate(path string, tpl *template.Template, data interface{}) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return tpl.Execute(f, data)
+}
+
+// scrapeTarget returns the target that Prometheus scrapes from its container
+// for the address of a server of the node, the servers that listen on the
+// local or on all interfaces are reached through the host machine.
+func scrapeTarget(addr string) (string, error) {
+	// Tendermint addresses can have a scheme like tcp://
+	if _, a, ok := strings.Cut(addr, "://"); ok {
+		addr = a
+	}
+
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return "", err
+	}
+
+	switch host {
+	case "", "0.0.0.0", "::", "localhost":
+		host = dockerHost
+	default:
+		if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+			host = dockerHost
+		}
+	}
+
+	return net.JoinHostPort(host, port), nil
+}
@@ -0,0 +1,59 @@
+package cosmosmetrics_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosmetrics"
+)
+
+func TestWriteStack(t *testing.T) {
+	dir := t.TempDir()
+	o := cosmosmetrics.StackOptions{
+		ChainID:           "mars",
+		Namespace:         "tendermint",
+		PrometheusAddress: ":26660",
+		APIAddress:        "tcp://0.0.0.0:1317",
+		Modules: map[string][]cosmosmetrics.Metric{
+			"mars": {
+				{Name: "mars_posts", Type: cosmosmetrics.Counter},
+				{Name: "create_post", Type: cosmosmetrics.Summary},
+			},
+		},
+	}
+
+	require.NoError(t, cosmosmetrics.WriteStack(dir, o))
+
+	for _, path := range []string{
+		"docker-compose.yml",
+		"grafana/provisioning/datasources/prometheus.yml",
+		"grafana/provisioning/dashboards/dashboards.yml",
+	} {
+		require.FileExists(t, filepath.Join(dir, path))
+	}
+
+	prometheus, err := os.ReadFile(filepath.Join(dir, "prometheus", "prometheus.yml"))
+	require.NoError(t, err)
+	require.Contains(t, string(prometheus), `targets: ["host.docker.internal:26660"]`)
+	require.Contains(t, string(prometheus), `targets: ["host.docker.internal:1317"]`)
+
+	var modules cosmosmetrics.Dashboard
+	content, err := os.ReadFile(filepath.Join(dir, "grafana", "dashboards", "mars-modules.json"))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(content, &modules))
+	require.Len(t, modules.Panels, 2)
+	require.Equal(t, "sum(rate(mars_posts[1m]))", modules.Panels[0].Targets[0].Expr)
+	require.Equal(t, `create_post{quantile="0.99"}`, modules.Panels[1].Targets[1].Expr)
+	require.Equal(t, cosmosmetrics.GridPos{X: 12, Y: 0, W: 12, H: 8}, modules.Panels[1].GridPos)
+
+	// The dashboard of the modules is removed when no module is instrumented
+	o.Modules = nil
+	require.NoError(t, cosmosmetrics.WriteStack(dir, o))
+	require.FileExists(t, filepath.Join(dir, "grafana", "dashboards", "mars-consensus.json"))
+	require.FileExists(t, filepath.Join(dir, "grafana", "dashboards", "mars-mempool.json"))
+	require.NoFileExists(t, filepath.Join(dir, "grafana", "dashboards", "mars-modules.json"))
+}
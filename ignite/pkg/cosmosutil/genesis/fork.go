@@ -0,0 +1,624 @@
+package genesis
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+const (
+	typeEd25519PubKey   = "/cosmos.crypto.ed25519.PubKey"
+	typeSecp256k1PubKey = "/cosmos.crypto.secp256k1.PubKey"
+	typeBaseAccount     = "/cosmos.auth.v1beta1.BaseAccount"
+	typeModuleAccount   = "/cosmos.auth.v1beta1.ModuleAccount"
+	typeTMEd25519PubKey = "tendermint/PubKeyEd25519"
+
+	bondedPoolName = "bonded_tokens_pool"
+)
+
+// ForkOptions configures the fork of the exported state of a network.
+type ForkOptions struct {
+	// ChainID is the chain ID of the fork, the chain ID of the network is kept
+	// when empty.
+	ChainID string
+
+	// ConsensusKey is the Ed25519 public key of the validator node of the fork.
+	ConsensusKey []byte
+
+	// Operator is the address of the local account that delegates the voting
+	// power of the validator of the fork.
+	Operator string
+
+	// OperatorCoins are added to the balance of the operator.
+	OperatorCoins sdk.Coins
+
+	// VotingPeriod is the voting period of the governance proposals of the
+	// fork, the voting period of the network is kept when zero.
+	VotingPeriod time.Duration
+}
+
+// ForkedValidator is the validator of the network taken over by the
+// consensus key of the fork.
+type ForkedValidator struct {
+	OperatorAddress string
+	Moniker         string
+
+	// Power is the voting power of the validator in the fork, more than 2/3
+	// of the voting power of the validator set.
+	Power int64
+}
+
+type object = map[string]interface{}
+
+// fork rewrites the exported state of a network.
+type fork struct {
+	o        ForkOptions
+	doc      object
+	appState object
+
+	height      int64
+	bondDenom   string
+	validator   ForkedValidator
+	oldConsAddr sdk.ConsAddress
+	newConsKey  *ed25519.PubKey
+	deltaTokens sdk.Int
+	deltaShares sdk.Dec
+}
+
+// Fork returns the genesis of a fork of the network from its exported state.
+// The validator of the network with the most voting power is taken over: its
+// consensus key is replaced by the key of the options and the operator
+// delegates it enough tokens to get more than 2/3 of the voting power, so a
+// single local node produces the blocks of the fork. The consensus params
+// accept the Ed25519 keys of the nodes.
+func Fork(exported []byte, o ForkOptions) ([]byte, ForkedValidator, error) {
+	if len(o.ConsensusKey) != ed25519.PubKeySize {
+		return nil, ForkedValidator{}, fmt.Errorf("invalid Ed25519 consensus key of %d bytes", len(o.ConsensusKey))
+	}
+	if o.Operator == "" {
+		return nil, ForkedValidator{}, errors.New("the operator of the fork is required")
+	}
+
+	d := json.NewDecoder(bytes.NewReader(exported))
+	d.UseNumber()
+
+	f := &fork{
+		o:          o,
+		newConsKey: &ed25519.PubKey{Key: o.ConsensusKey},
+	}
+	if err := d.Decode(&f.doc); err != nil {
+		return nil, ForkedValidator{}, fmt.Errorf("invalid exported state: %w", err)
+	}
+
+	var err error
+	if f.appState, err = objectField(f.doc, fieldPathAppState); err != nil {
+		return nil, ForkedValidator{}, err
+	}
+	if h, ok := f.doc["initial_height"]; ok {
+		if f.height, err = strconv.ParseInt(stringValue(h), 10, 64); err != nil {
+			return nil, ForkedValidator{}, fmt.Errorf("invalid initial height: %w", err)
+		}
+		// the state is exported at the height before the initial height.
+		if f.height > 0 {
+			f.height--
+		}
+	}
+
+	for _, step := range []func() error{
+		f.rewriteChain,
+		f.takeOverValidator,
+		f.rewriteAccounts,
+		f.rewriteDistribution,
+		f.rewriteSlashing,
+		f.rewriteValidatorSet,
+		f.rewriteGov,
+	} {
+		if err := step(); err != nil {
+			return nil, ForkedValidator{}, err
+		}
+	}
+
+	out, err := json.MarshalIndent(f.doc, "", "  ")
+	return out, f.validator, err
+}
+
+// rewriteChain sets the chain ID and the consensus params of the fork.
+func (f *fork) rewriteChain() error {
+	if f.o.ChainID != "" {
+		f.doc[FieldChainID] = f.o.ChainID
+	}
+
+	params, err := objectField(f.doc, fieldPathConsensusParams)
+	if err != nil {
+		return err
+	}
+	validator, ok := params["validator"].(object)
+	if !ok {
+		validator = object{}
+		params["validator"] = validator
+	}
+	keyTypes, _ := validator["pub_key_types"].([]interface{})
+	for _, t := range keyTypes {
+		if t == "ed25519" {
+			return nil
+		}
+	}
+	validator["pub_key_types"] = append(keyTypes, "ed25519")
+	return nil
+}
+
+// takeOverValidator replaces the consensus key of the validator with the most
+// voting power and delegates it the tokens of its new voting power.
+func (f *fork) takeOverValidator() error {
+	staking, err := objectField(f.appState, "staking")
+	if err != nil {
+		return err
+	}
+	if err := field(staking, "params.bond_denom", &f.bondDenom); err != nil {
+		return err
+	}
+
+	powers, err := arrayField(staking, "last_validator_powers")
+	if err != nil {
+		return err
+	}
+	var (
+		total int64
+		power int64
+		entry object
+	)
+	for i, raw := range powers {
+		p, ok := raw.(object)
+		if !ok {
+			return fmt.Errorf("invalid entry %d of the last validator powers: %v", i, raw)
+		}
+		v, err := strconv.ParseInt(stringValue(p["power"]), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid power of validator %s: %w", p["address"], err)
+		}
+		total += v
+		if v > power {
+			power, entry = v, p
+		}
+	}
+	if entry == nil {
+		return errors.New("the exported state has no bonded validators")
+	}
+	f.validator.OperatorAddress = stringValue(entry["address"])
+
+	// the other validators keep less than 1/3 of the voting power.
+	newPower := 3*(total-power) + 1
+	if newPower < power {
+		newPower = power
+	}
+	f.validator.Power = newPower
+	entry["power"] = strconv.FormatInt(newPower, 10)
+	staking["last_total_power"] = strconv.FormatInt(total-power+newPower, 10)
+	f.deltaTokens = sdk.TokensFromConsensusPower(newPower-power, sdk.DefaultPowerReduction)
+
+	validators, err := arrayField(staking, "validators")
+	if err != nil {
+		return err
+	}
+	validator := findObject(validators, "operator_address", f.validator.OperatorAddress)
+	if validator == nil {
+		return fmt.Errorf("validator %s not found", f.validator.OperatorAddress)
+	}
+	_ = field(validator, "description.moniker", &f.validator.Moniker)
+
+	consKey, _ := validator["consensus_pubkey"].(object)
+	if f.oldConsAddr, err = consensusAddress(consKey); err != nil {
+		return fmt.Errorf("validator %s: %w", f.validator.OperatorAddress, err)
+	}
+	validator["consensus_pubkey"] = object{
+		"@type": typeEd25519PubKey,
+		"key":   base64.StdEncoding.EncodeToString(f.o.ConsensusKey),
+	}
+
+	tokens, ok := sdk.NewIntFromString(stringValue(validator["tokens"]))
+	if !ok || !tokens.IsPositive() {
+		return fmt.Errorf("invalid tokens %v of validator %s", validator["tokens"], f.validator.OperatorAddress)
+	}
+	shares, err := sdk.NewDecFromStr(stringValue(validator["delegator_shares"]))
+	if err != nil {
+		return fmt.Errorf("invalid shares of validator %s: %w", f.validator.OperatorAddress, err)
+	}
+	f.deltaShares = shares.MulInt(f.deltaTokens).QuoInt(tokens)
+	validator["tokens"] = tokens.Add(f.deltaTokens).String()
+	validator["delegator_shares"] = shares.Add(f.deltaShares).String()
+
+	if f.deltaTokens.IsZero() {
+		return nil
+	}
+	delegations, err := arrayField(staking, "delegations")
+	if err != nil {
+		return err
+	}
+	staking["delegations"] = append(delegations, object{
+		"delegator_address": f.o.Operator,
+		"validator_address": f.validator.OperatorAddress,
+		"shares":            f.deltaShares.String(),
+	})
+	return nil
+}
+
+// rewriteAccounts adds the account of the operator and the balances of the
+// operator and of the delegated tokens.
+func (f *fork) rewriteAccounts() error {
+	auth, err := objectField(f.appState, "auth")
+	if err != nil {
+		return err
+	}
+	accounts, err := arrayField(auth, "accounts")
+	if err != nil {
+		return err
+	}
+
+	var (
+		bondedPool    string
+		operatorFound bool
+		nextNumber    int64
+	)
+	for i, raw := range accounts {
+		a, ok := raw.(object)
+		if !ok {
+			return fmt.Errorf("invalid account %d of the auth state: %v", i, raw)
+		}
+		base := a
+		if b, ok := a["base_account"].(object); ok {
+			base = b
+		}
+		if n, err := strconv.ParseInt(stringValue(base["account_number"]), 10, 64); err == nil && n >= nextNumber {
+			nextNumber = n + 1
+		}
+		switch {
+		case a["@type"] == typeModuleAccount && a["name"] == bondedPoolName:
+			bondedPool = stringValue(base["address"])
+		case base["address"] == f.o.Operator:
+			operatorFound = true
+		}
+	}
+	if bondedPool == "" {
+		return errors.New("the account of the bonded tokens pool is not found")
+	}
+	if !operatorFound {
+		auth["accounts"] = append(accounts, object{
+			"@type":          typeBaseAccount,
+			"address":        f.o.Operator,
+			"pub_key":        nil,
+			"account_number": strconv.FormatInt(nextNumber, 10),
+			"sequence":       "0",
+		})
+	}
+
+	bank, err := objectField(f.appState, "bank")
+	if err != nil {
+		return err
+	}
+	bonded := sdk.NewCoins(sdk.NewCoin(f.bondDenom, f.deltaTokens))
+	for _, b := range []struct {
+		address string
+		coins   sdk.Coins
+	}{
+		{bondedPool, bonded},
+		{f.o.Operator, f.o.OperatorCoins},
+	} {
+		if b.coins.Empty() {
+			continue
+		}
+		if err := addBalance(bank, b.address, b.coins); err != nil {
+			return err
+		}
+	}
+
+	supply, err := coinsField(bank, "supply")
+	if err != nil {
+		return err
+	}
+	bank["supply"] = coinsValue(supply.Add(bonded...).Add(f.o.OperatorCoins...))
+	return nil
+}
+
+// rewriteDistribution adds the starting info of the rewards of the delegation
+// of the operator.
+func (f *fork) rewriteDistribution() error {
+	if f.deltaTokens.IsZero() {
+		return nil
+	}
+	distribution, err := objectField(f.appState, "distribution")
+	if err != nil {
+		return err
+	}
+
+	current, err := arrayField(distribution, "validator_current_rewards")
+	if err != nil {
+		return err
+	}
+	rewards := findObject(current, "validator_address", f.validator.OperatorAddress)
+	if rewards == nil {
+		return fmt.Errorf("current rewards of validator %s not found", f.validator.OperatorAddress)
+	}
+	var period string
+	if err := field(rewards, "rewards.period", &period); err != nil {
+		return err
+	}
+	currentPeriod, err := strconv.ParseUint(period, 10, 64)
+	if err != nil || currentPeriod == 0 {
+		return fmt.Errorf("invalid rewards period %s of validator %s", period, f.validator.OperatorAddress)
+	}
+	previousPeriod := strconv.FormatUint(currentPeriod-1, 10)
+
+	// the delegation references the rewards of the previous period.
+	historical, err := arrayField(distribution, "validator_historical_rewards")
+	if err != nil {
+		return err
+	}
+	var referenced bool
+	for i, raw := range historical {
+		h, ok := raw.(object)
+		if !ok {
+			return fmt.Errorf("invalid entry %d of the validator historical rewards: %v", i, raw)
+		}
+		if h["validator_address"] != f.validator.OperatorAddress || stringValue(h["period"]) != previousPeriod {
+			continue
+		}
+		r, ok := h["rewards"].(object)
+		if !ok {
+			return fmt.Errorf("invalid historical rewards of period %s of validator %s", previousPeriod, f.validator.OperatorAddress)
+		}
+		count, err := strconv.ParseUint(stringValue(r["reference_count"]), 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid reference count of the rewards of validator %s", f.validator.OperatorAddress)
+		}
+		r["reference_count"] = count + 1
+		referenced = true
+	}
+	if !referenced {
+		return fmt.Errorf("rewards of period %s of validator %s not found", previousPeriod, f.validator.OperatorAddress)
+	}
+
+	starting, err := arrayField(distribution, "delegator_starting_infos")
+	if err != nil {
+		return err
+	}
+	distribution["delegator_starting_infos"] = append(starting, object{
+		"delegator_address": f.o.Operator,
+		"validator_address": f.validator.OperatorAddress,
+		"starting_info": object{
+			"previous_period": previousPeriod,
+			"stake":           sdk.NewDecFromInt(f.deltaTokens).String(),
+			"height":          strconv.FormatInt(f.height, 10),
+		},
+	})
+	return nil
+}
+
+// rewriteSlashing moves the signing info of the validator to its new
+// consensus address and clears its missed blocks.
+func (f *fork) rewriteSlashing() error {
+	slashing, err := objectField(f.appState, "slashing")
+	if err != nil {
+		return err
+	}
+
+	operatorPrefix, _, err := bech32.DecodeAndConvert(f.validator.OperatorAddress)
+	if err != nil {
+		return err
+	}
+	consPrefix := strings.TrimSuffix(operatorPrefix, "valoper") + "valcons"
+	oldAddr, err := bech32.ConvertAndEncode(consPrefix, f.oldConsAddr)
+	if err != nil {
+		return err
+	}
+	newAddr, err := bech32.ConvertAndEncode(consPrefix, f.newConsKey.Address())
+	if err != nil {
+		return err
+	}
+
+	infos, err := arrayField(slashing, "signing_infos")
+	if err != nil {
+		return err
+	}
+	info := findObject(infos, "address", oldAddr)
+	if info == nil {
+		info = object{}
+		infos = append(infos, info)
+		slashing["signing_infos"] = infos
+	}
+	info["address"] = newAddr
+	info["validator_signing_info"] = object{
+		"address":               newAddr,
+		"start_height":          strconv.FormatInt(f.height, 10),
+		"index_offset":          "0",
+		"jailed_until":          "1970-01-01T00:00:00Z",
+		"tombstoned":            false,
+		"missed_blocks_counter": "0",
+	}
+
+	missed, err := arrayField(slashing, "missed_blocks")
+	if err != nil {
+		return err
+	}
+	if m := findObject(missed, "address", oldAddr); m != nil {
+		m["address"] = newAddr
+		m["missed_blocks"] = []interface{}{}
+	}
+	return nil
+}
+
+// rewriteValidatorSet replaces the validator in the validator set of the
+// consensus engine.
+func (f *fork) rewriteValidatorSet() error {
+	validators, err := arrayField(f.doc, "validators")
+	if err != nil {
+		return err
+	}
+	validator := findObject(validators, "address", strings.ToUpper(hex.EncodeToString(f.oldConsAddr)))
+	if validator == nil {
+		return fmt.Errorf("validator %s not found in the validator set", f.validator.OperatorAddress)
+	}
+	validator["address"] = f.newConsKey.Address().String()
+	validator["power"] = strconv.FormatInt(f.validator.Power, 10)
+	validator["pub_key"] = object{
+		"type":  typeTMEd25519PubKey,
+		"value": base64.StdEncoding.EncodeToString(f.o.ConsensusKey),
+	}
+	return nil
+}
+
+// rewriteGov sets the voting period of the proposals.
+func (f *fork) rewriteGov() error {
+	if f.o.VotingPeriod == 0 {
+		return nil
+	}
+	gov, err := objectField(f.appState, "gov")
+	if err != nil {
+		return err
+	}
+	period := strconv.FormatFloat(f.o.VotingPeriod.Seconds(), 'f', -1, 64) + "s"
+	for _, key := range []string{"voting_params", "params"} {
+		if params, ok := gov[key].(object); ok && params["voting_period"] != nil {
+			params["voting_period"] = period
+		}
+	}
+	return nil
+}
+
+// consensusAddress returns the address of the consensus key of a validator.
+func consensusAddress(key object) (sdk.ConsAddress, error) {
+	b, err := base64.StdEncoding.DecodeString(stringValue(key["key"]))
+	if err != nil {
+		return nil, fmt.Errorf("invalid consensus key: %w", err)
+	}
+
+	var pubKey cryptotypes.PubKey
+	switch key["@type"] {
+	case typeEd25519PubKey:
+		pubKey = &ed25519.PubKey{Key: b}
+	case typeSecp256k1PubKey:
+		pubKey = &secp256k1.PubKey{Key: b}
+	default:
+		return nil, fmt.Errorf("unsupported consensus key type %v", key["@type"])
+	}
+	return sdk.ConsAddress(pubKey.Address()), nil
+}
+
+// addBalance adds the coins to the balance of the address.
+func addBalance(bank object, address string, coins sdk.Coins) error {
+	balances, err := arrayField(bank, "balances")
+	if err != nil {
+		return err
+	}
+	balance := findObject(balances, "address", address)
+	if balance == nil {
+		balance = object{"address": address, "coins": []interface{}{}}
+		bank["balances"] = append(balances, balance)
+	}
+	current, err := coinsField(balance, "coins")
+	if err != nil {
+		return err
+	}
+	balance["coins"] = coinsValue(current.Add(coins...))
+	return nil
+}
+
+func coinsField(v object, key string) (sdk.Coins, error) {
+	values, err := arrayField(v, key)
+	if err != nil {
+		return nil, err
+	}
+	coins := make(sdk.Coins, 0, len(values))
+	for _, c := range values {
+		c, _ := c.(object)
+		amount, ok := sdk.NewIntFromString(stringValue(c["amount"]))
+		if !ok {
+			return nil, fmt.Errorf("invalid amount %v of %s", c["amount"], key)
+		}
+		coins = append(coins, sdk.Coin{Denom: stringValue(c["denom"]), Amount: amount})
+	}
+	return coins.Sort(), nil
+}
+
+func coinsValue(coins sdk.Coins) []interface{} {
+	values := make([]interface{}, len(coins))
+	for i, c := range coins {
+		values[i] = object{"denom": c.Denom, "amount": c.Amount.String()}
+	}
+	return values
+}
+
+// findObject returns the first object of the values with the value of the key.
+func findObject(values []interface{}, key, value string) object {
+	for _, v := range values {
+		if o, ok := v.(object); ok && stringValue(o[key]) == value {
+			return o
+		}
+	}
+	return nil
+}
+
+// field decodes the value of the dotted path of the object into v.
+func field(o object, path string, v interface{}) error {
+	var value interface{} = o
+	for _, key := range strings.Split(path, ".") {
+		m, ok := value.(object)
+		if !ok {
+			return fmt.Errorf("field %s not found", path)
+		}
+		if value, ok = m[key]; !ok {
+			return fmt.Errorf("field %s not found", path)
+		}
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+func objectField(o object, key string) (object, error) {
+	v, ok := o[key].(object)
+	if !ok {
+		return nil, fmt.Errorf("field %s not found", key)
+	}
+	return v, nil
+}
+
+// arrayField returns the array of the key, the null arrays are empty.
+func arrayField(o object, key string) ([]interface{}, error) {
+	v, ok := o[key]
+	if !ok {
+		return nil, fmt.Errorf("field %s not found", key)
+	}
+	if v == nil {
+		return nil, nil
+	}
+	a, ok := v.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("field %s is not an array", key)
+	}
+	return a, nil
+}
+
+// stringValue returns the string of a JSON string or number.
+func stringValue(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	}
+	return ""
+}
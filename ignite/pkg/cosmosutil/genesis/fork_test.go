@@ -0,0 +1,169 @@
+package genesis
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFork(t *testing.T) {
+	const (
+		operator  = "cosmos1dcv6q0ww8nfzw68nj2zy00r547qm50dxsac5hu"
+		validator = "cosmosvaloper1kd5g6r7jjg5q4dp7q4mmp5cd2ayku6dhnzpmn3"
+		consAddr  = "cosmosvalcons1dcv6q0ww8nfzw68nj2zy00r547qm50dxp6lahw"
+	)
+	consKey, err := base64.StdEncoding.DecodeString("c3eLq3E5Isb7R/GJhELW5uv7gP2aA+Fa0DYLmag35DM=")
+	require.NoError(t, err)
+	exported, err := os.ReadFile("testdata/exported.json")
+	require.NoError(t, err)
+
+	forked, v, err := Fork(exported, ForkOptions{
+		ChainID:       "mars-fork",
+		ConsensusKey:  consKey,
+		Operator:      operator,
+		OperatorCoins: sdk.NewCoins(sdk.NewInt64Coin("stake", 1000), sdk.NewInt64Coin("token", 10)),
+		VotingPeriod:  time.Minute,
+	})
+	require.NoError(t, err)
+	require.Equal(t, ForkedValidator{OperatorAddress: validator, Moniker: "venus", Power: 31}, v)
+
+	var doc object
+	require.NoError(t, json.Unmarshal(forked, &doc))
+	get := func(path string) interface{} {
+		var value interface{}
+		require.NoError(t, field(doc, path, &value))
+		return value
+	}
+
+	require.Equal(t, "mars-fork", get("chain_id"))
+	require.Equal(t, []interface{}{"secp256k1", "ed25519"}, get("consensus_params.validator.pub_key_types"))
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			"address": "6E19A03DCE3CD22768F3928447BC74AF81BA3DA6",
+			"name":    "venus",
+			"power":   "31",
+			"pub_key": map[string]interface{}{
+				"type":  "tendermint/PubKeyEd25519",
+				"value": "c3eLq3E5Isb7R/GJhELW5uv7gP2aA+Fa0DYLmag35DM=",
+			},
+		},
+		map[string]interface{}{
+			"address": "D3599DF1EBDEDFEC20AF2F3C2C3D2E830108E486",
+			"name":    "jupiter",
+			"power":   "10",
+			"pub_key": map[string]interface{}{
+				"type":  "tendermint/PubKeyEd25519",
+				"value": "zf0g/4qCwmA6DDNYp4pvhXPUYG8qTwvAUZU7Dmf0OMo=",
+			},
+		},
+	}, get("validators"))
+
+	// staking
+	require.Equal(t, "41", get("app_state.staking.last_total_power"))
+	staking := get("app_state.staking").(map[string]interface{})
+	forkedValidator := staking["validators"].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, "31000000", forkedValidator["tokens"])
+	require.Equal(t, "62000000.000000000000000000", forkedValidator["delegator_shares"])
+	require.Equal(t, map[string]interface{}{
+		"@type": "/cosmos.crypto.ed25519.PubKey",
+		"key":   "c3eLq3E5Isb7R/GJhELW5uv7gP2aA+Fa0DYLmag35DM=",
+	}, forkedValidator["consensus_pubkey"])
+	require.Contains(t, staking["delegations"], map[string]interface{}{
+		"delegator_address": operator,
+		"validator_address": validator,
+		"shares":            "22000000.000000000000000000",
+	})
+
+	// accounts and balances
+	require.Contains(t, get("app_state.auth.accounts"), map[string]interface{}{
+		"@type":          "/cosmos.auth.v1beta1.BaseAccount",
+		"address":        operator,
+		"pub_key":        nil,
+		"account_number": "5",
+		"sequence":       "0",
+	})
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			"address": "cosmos1kd5g6r7jjg5q4dp7q4mmp5cd2ayku6dhkk4wlz",
+			"coins":   []interface{}{map[string]interface{}{"denom": "stake", "amount": "5000000"}},
+		},
+		map[string]interface{}{
+			"address": "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh",
+			"coins":   []interface{}{map[string]interface{}{"denom": "stake", "amount": "41000000"}},
+		},
+		map[string]interface{}{
+			"address": operator,
+			"coins": []interface{}{
+				map[string]interface{}{"denom": "stake", "amount": "1000"},
+				map[string]interface{}{"denom": "token", "amount": "10"},
+			},
+		},
+	}, get("app_state.bank.balances"))
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"denom": "stake", "amount": "46001000"},
+		map[string]interface{}{"denom": "token", "amount": "10"},
+	}, get("app_state.bank.supply"))
+
+	// distribution
+	require.Equal(t, float64(2), get("app_state.distribution.validator_historical_rewards").([]interface{})[0].(map[string]interface{})["rewards"].(map[string]interface{})["reference_count"])
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			"delegator_address": operator,
+			"validator_address": validator,
+			"starting_info": map[string]interface{}{
+				"previous_period": "2",
+				"stake":           "11000000.000000000000000000",
+				"height":          "1200",
+			},
+		},
+	}, get("app_state.distribution.delegator_starting_infos"))
+
+	// slashing
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			"address": consAddr,
+			"validator_signing_info": map[string]interface{}{
+				"address":               consAddr,
+				"start_height":          "1200",
+				"index_offset":          "0",
+				"jailed_until":          "1970-01-01T00:00:00Z",
+				"tombstoned":            false,
+				"missed_blocks_counter": "0",
+			},
+		},
+	}, get("app_state.slashing.signing_infos"))
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"address": consAddr, "missed_blocks": []interface{}{}},
+	}, get("app_state.slashing.missed_blocks"))
+
+	require.Equal(t, "60s", get("app_state.gov.voting_params.voting_period"))
+}
+
+func TestForkErrors(t *testing.T) {
+	exported, err := os.ReadFile("testdata/exported.json")
+	require.NoError(t, err)
+	consKey := make([]byte, 32)
+
+	_, _, err = Fork(exported, ForkOptions{ConsensusKey: consKey[:31], Operator: "cosmos1"})
+	require.EqualError(t, err, "invalid Ed25519 consensus key of 31 bytes")
+
+	_, _, err = Fork(exported, ForkOptions{ConsensusKey: consKey})
+	require.EqualError(t, err, "the operator of the fork is required")
+
+	_, _, err = Fork([]byte(`{"app_state":{"staking":{"params":{"bond_denom":"stake"},"last_validator_powers":[]}},"consensus_params":{}}`), ForkOptions{
+		ConsensusKey: consKey,
+		Operator:     "cosmos1",
+	})
+	require.EqualError(t, err, "the exported state has no bonded validators")
+
+	_, _, err = Fork([]byte(`{"app_state":{"staking":{"params":{"bond_denom":"stake"},"last_validator_powers":["validator"]}},"consensus_params":{}}`), ForkOptions{
+		ConsensusKey: consKey,
+		Operator:     "cosmos1",
+	})
+	require.EqualError(t, err, "invalid entry 0 of the last validator powers: validator")
+}
@@ -1,9 +1,12 @@
 package genesis
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"os"
 
+	"github.com/ignite/cli/ignite/pkg/downloader"
 	"github.com/ignite/cli/ignite/pkg/jsonfile"
 )
 
@@ -14,6 +17,9 @@ const (
 	fieldPathChainID    = "chain_id"
 	fieldPathAccounts   = "app_state.auth.accounts"
 	fieldPathGentxs     = "app_state.genutil.gen_txs"
+	fieldPathAppState   = "app_state"
+
+	fieldPathConsensusParams = "consensus_params"
 
 	FieldGenesisTime                 = "genesis_time"
 	FieldChainID                     = "chain_id"
@@ -46,8 +52,8 @@ func FromPath(path string) (*Genesis, error) {
 }
 
 // FromURL fetches the genesis from the given URL and returns its content.
-func FromURL(ctx context.Context, url, path string) (*Genesis, error) {
-	file, err := jsonfile.FromURL(ctx, url, path, genesisFilename)
+func FromURL(ctx context.Context, url, path string, options ...downloader.Option) (*Genesis, error) {
+	file, err := jsonfile.FromURL(ctx, url, path, genesisFilename, options...)
 	return &Genesis{
 		JSONFile: file,
 	}, err
@@ -112,3 +118,69 @@ func (g *Genesis) GentxCount() (int, error) {
 	err := g.Field(fieldPathGentxs, &gentxs)
 	return len(gentxs), err
 }
+
+// ConsensusParams returns the consensus params from the genesis
+func (g *Genesis) ConsensusParams() (map[string]interface{}, error) {
+	return g.objectField(fieldPathConsensusParams)
+}
+
+// ModuleParams returns the params of the modules from the genesis app state indexed
+// by module name, the modules without params are not included
+func (g *Genesis) ModuleParams() (map[string]interface{}, error) {
+	appState, err := g.objectField(fieldPathAppState)
+	if err != nil {
+		return nil, err
+	}
+
+	params := make(map[string]interface{})
+	for module, state := range appState {
+		moduleState, ok := state.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if p, ok := moduleState["params"]; ok && p != nil {
+			params[module] = p
+		}
+	}
+	return params, nil
+}
+
+// objectField returns the value of a JSON object field keeping the integer numbers
+// as integers instead of converting them to floats
+func (g *Genesis) objectField(key string) (map[string]interface{}, error) {
+	var raw json.RawMessage
+	if err := g.Field(key, &raw); err != nil {
+		return nil, err
+	}
+
+	d := json.NewDecoder(bytes.NewReader(raw))
+	d.UseNumber()
+
+	var value map[string]interface{}
+	if err := d.Decode(&value); err != nil {
+		return nil, err
+	}
+	return convertNumbers(value).(map[string]interface{}), nil
+}
+
+func convertNumbers(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			v[k] = convertNumbers(e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = convertNumbers(e)
+		}
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+		return v.String()
+	}
+	return v
+}
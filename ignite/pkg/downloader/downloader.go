@@ -0,0 +1,209 @@
+// Package downloader fetches the files and repositories used by Ignite over the
+// network, so every subsystem behaves the same behind a proxy or on a flaky
+// connection: the HTTP(S)_PROXY and NO_PROXY environment variables are honored,
+// failed transfers are retried with an exponential backoff and interrupted
+// file downloads are resumed.
+package downloader
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/cenkalti/backoff"
+
+	"github.com/ignite/cli/ignite/pkg/ctxreader"
+)
+
+const (
+	// DefaultRetries is the default number of retries of a failed download.
+	DefaultRetries = 3
+
+	// DefaultBackoff is the default delay before the first retry, it doubles on each retry.
+	DefaultBackoff = time.Second
+
+	// PartialSuffix is appended to the destination path of a file while it's downloaded.
+	PartialSuffix = ".part"
+)
+
+// StatusError is returned when the server responds to a download with an unexpected status.
+type StatusError struct {
+	URL        string
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("download %s: %s", e.URL, http.StatusText(e.StatusCode))
+}
+
+// Temporary returns true if the download might succeed when retried.
+func (e *StatusError) Temporary() bool {
+	return e.StatusCode == http.StatusRequestTimeout ||
+		e.StatusCode == http.StatusTooManyRequests ||
+		e.StatusCode >= http.StatusInternalServerError
+}
+
+// Manager downloads files over HTTP(S).
+type Manager struct {
+	client   *http.Client
+	retries  uint64
+	backoff  time.Duration
+	progress ProgressFunc
+}
+
+// Option configures a Manager.
+type Option func(*Manager)
+
+// WithClient sets the HTTP client used to download the files.
+// The default client uses the proxy configured by the environment.
+func WithClient(client *http.Client) Option {
+	return func(m *Manager) {
+		m.client = client
+	}
+}
+
+// WithRetries sets the number of retries of a failed download, zero disables them.
+func WithRetries(retries uint64) Option {
+	return func(m *Manager) {
+		m.retries = retries
+	}
+}
+
+// WithBackoff sets the delay before the first retry of a failed download.
+func WithBackoff(d time.Duration) Option {
+	return func(m *Manager) {
+		m.backoff = d
+	}
+}
+
+// WithProgress sets a function called with the progress of the downloads.
+func WithProgress(f ProgressFunc) Option {
+	return func(m *Manager) {
+		m.progress = f
+	}
+}
+
+// New creates a new download manager.
+func New(options ...Option) *Manager {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyFromEnvironment
+
+	m := &Manager{
+		client:   &http.Client{Transport: transport},
+		retries:  DefaultRetries,
+		backoff:  DefaultBackoff,
+		progress: func(Progress) {},
+	}
+	for _, apply := range options {
+		apply(m)
+	}
+	return m
+}
+
+// Client returns the HTTP client of the manager.
+func (m *Manager) Client() *http.Client {
+	return m.client
+}
+
+// Retry calls operation until it succeeds or the retries are exhausted, waiting
+// with an exponential backoff between the attempts.
+// The errors wrapped with backoff.Permanent are not retried.
+func (m *Manager) Retry(ctx context.Context, operation func() error) error {
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = m.backoff
+	b.MaxElapsedTime = 0
+	return backoff.Retry(operation, backoff.WithContext(backoff.WithMaxRetries(b, m.retries), ctx))
+}
+
+// File downloads the content of url to dest.
+// The content is written to dest with the PartialSuffix first so a failed download
+// doesn't leave a partial file at dest. The failed downloads are resumed from the
+// partial file when the server supports range requests.
+func (m *Manager) File(ctx context.Context, url, dest string) error {
+	partial := dest + PartialSuffix
+	if err := m.Retry(ctx, func() error { return m.download(ctx, url, partial) }); err != nil {
+		// Keep the partial content to resume the download later
+		if info, statErr := os.Stat(partial); statErr == nil && info.Size() == 0 {
+			os.Remove(partial)
+		}
+		return err
+	}
+	return os.Rename(partial, dest)
+}
+
+// download downloads url to path, resuming from the content already in path.
+func (m *Manager) download(ctx context.Context, url, path string) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return backoff.Permanent(err)
+	}
+	defer f.Close()
+
+	offset, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return backoff.Permanent(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return backoff.Permanent(err)
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return backoff.Permanent(ctx.Err())
+		}
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusPartialContent && offset > 0:
+	case resp.StatusCode == http.StatusOK:
+		// The server doesn't support range requests, download the whole content again
+		if offset, err = restart(f); err != nil {
+			return backoff.Permanent(err)
+		}
+	case resp.StatusCode == http.StatusRequestedRangeNotSatisfiable:
+		// The partial file doesn't match the content anymore, restart on the next attempt
+		if _, err := restart(f); err != nil {
+			return backoff.Permanent(err)
+		}
+		return &StatusError{URL: url, StatusCode: resp.StatusCode}
+	default:
+		err := &StatusError{URL: url, StatusCode: resp.StatusCode}
+		if !err.Temporary() {
+			return backoff.Permanent(err)
+		}
+		return err
+	}
+
+	total := int64(-1)
+	if resp.ContentLength >= 0 {
+		total = offset + resp.ContentLength
+	}
+	w := newProgressWriter(f, Progress{URL: url, Downloaded: offset, Total: total}, m.progress)
+	if _, err := io.Copy(w, ctxreader.New(ctx, resp.Body)); err != nil {
+		if ctx.Err() != nil {
+			return backoff.Permanent(ctx.Err())
+		}
+		return err
+	}
+	w.done()
+	return nil
+}
+
+// restart truncates the partial file f to download its content from the start.
+func restart(f *os.File) (int64, error) {
+	if err := f.Truncate(0); err != nil {
+		return 0, err
+	}
+	return f.Seek(0, io.SeekStart)
+}
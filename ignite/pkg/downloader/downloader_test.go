@@ -0,0 +1,97 @@
+package downloader
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var content = bytes.Repeat([]byte("ignite"), 1000)
+
+func serveContent(w http.ResponseWriter, r *http.Request) {
+	http.ServeContent(w, r, "file", time.Time{}, bytes.NewReader(content))
+}
+
+func TestFile(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(serveContent))
+	defer srv.Close()
+
+	var last Progress
+	dest := filepath.Join(t.TempDir(), "file")
+	m := New(WithProgress(func(p Progress) { last = p }))
+
+	require.NoError(t, m.File(context.Background(), srv.URL, dest))
+	got, err := os.ReadFile(dest)
+	require.NoError(t, err)
+	require.Equal(t, content, got)
+	require.NoFileExists(t, dest+PartialSuffix)
+	require.Equal(t, Progress{URL: srv.URL, Downloaded: int64(len(content)), Total: int64(len(content))}, last)
+}
+
+func TestFileResume(t *testing.T) {
+	var rangeHeader string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rangeHeader = r.Header.Get("Range")
+		serveContent(w, r)
+	}))
+	defer srv.Close()
+
+	dest := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(dest+PartialSuffix, content[:100], 0o644))
+
+	require.NoError(t, New().File(context.Background(), srv.URL, dest))
+	require.Equal(t, "bytes=100-", rangeHeader)
+	got, err := os.ReadFile(dest)
+	require.NoError(t, err)
+	require.Equal(t, content, got)
+}
+
+func TestFileRetry(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		serveContent(w, r)
+	}))
+	defer srv.Close()
+
+	dest := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, New(WithBackoff(time.Millisecond)).File(context.Background(), srv.URL, dest))
+	require.EqualValues(t, 3, attempts)
+	require.FileExists(t, dest)
+}
+
+func TestFileNotFound(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		http.NotFound(w, r)
+	}))
+	defer srv.Close()
+
+	dest := filepath.Join(t.TempDir(), "file")
+	err := New(WithBackoff(time.Millisecond)).File(context.Background(), srv.URL, dest)
+
+	var statusErr *StatusError
+	require.True(t, errors.As(err, &statusErr))
+	require.Equal(t, http.StatusNotFound, statusErr.StatusCode)
+	require.EqualValues(t, 1, attempts)
+	require.NoFileExists(t, dest)
+	require.NoFileExists(t, dest+PartialSuffix)
+}
+
+func TestProgressString(t *testing.T) {
+	require.Equal(t, "512 B", Progress{Downloaded: 512, Total: -1}.String())
+	require.Equal(t, "1.5 MB / 3.0 MB (50%)", Progress{Downloaded: 1500000, Total: 3000000}.String())
+}
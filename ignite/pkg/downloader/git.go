@@ -0,0 +1,54 @@
+package downloader
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/cenkalti/backoff"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+)
+
+// Clone clones a git repository to dir, the clone is retried when it fails
+// because of the network. The options are tried in order until one succeeds,
+// e.g. to clone a reference that can be either a tag or a branch.
+// dir is removed when the clone fails.
+func (m *Manager) Clone(ctx context.Context, dir string, options ...*git.CloneOptions) (repo *git.Repository, err error) {
+	for _, o := range options {
+		err = m.Retry(ctx, func() error {
+			// A failed clone can leave a partial repository
+			if err := os.RemoveAll(dir); err != nil {
+				return backoff.Permanent(err)
+			}
+			repo, err = git.PlainCloneContext(ctx, dir, false, o)
+			if err != nil && !isTemporaryCloneError(ctx, err) {
+				return backoff.Permanent(err)
+			}
+			return err
+		})
+		if err == nil {
+			return repo, nil
+		}
+	}
+	os.RemoveAll(dir)
+	return nil, err
+}
+
+// isTemporaryCloneError returns true if a clone might succeed when retried.
+func isTemporaryCloneError(ctx context.Context, err error) bool {
+	var refSpecErr git.NoMatchingRefSpecError
+	switch {
+	case ctx.Err() != nil,
+		errors.As(err, &refSpecErr),
+		errors.Is(err, plumbing.ErrReferenceNotFound),
+		errors.Is(err, transport.ErrRepositoryNotFound),
+		errors.Is(err, transport.ErrEmptyRemoteRepository),
+		errors.Is(err, transport.ErrAuthenticationRequired),
+		errors.Is(err, transport.ErrAuthorizationFailed),
+		errors.Is(err, transport.ErrInvalidAuthMethod):
+		return false
+	}
+	return true
+}
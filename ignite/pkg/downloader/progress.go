@@ -0,0 +1,79 @@
+package downloader
+
+import (
+	"fmt"
+	"io"
+	"time"
+)
+
+// progressInterval is the minimum interval between two progress reports of a download.
+const progressInterval = 200 * time.Millisecond
+
+// Progress is the progress of a download.
+type Progress struct {
+	// URL of the downloaded file.
+	URL string
+
+	// Downloaded is the number of bytes downloaded, including the resumed ones.
+	Downloaded int64
+
+	// Total is the size of the file in bytes, it's -1 when the size is unknown.
+	Total int64
+}
+
+// ProgressFunc is called with the progress of the downloads.
+type ProgressFunc func(Progress)
+
+// String returns the progress in a human-readable form, e.g. "1.5 MB / 3.0 MB (50%)".
+func (p Progress) String() string {
+	if p.Total < 0 {
+		return formatBytes(p.Downloaded)
+	}
+	percent := int64(100)
+	if p.Total > 0 {
+		percent = p.Downloaded * 100 / p.Total
+	}
+	return fmt.Sprintf("%s / %s (%d%%)", formatBytes(p.Downloaded), formatBytes(p.Total), percent)
+}
+
+// formatBytes formats a number of bytes with a decimal unit.
+func formatBytes(n int64) string {
+	const unit = 1000
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(n)/float64(div), "kMGTPE"[exp])
+}
+
+// progressWriter reports the progress of the content written to a download.
+type progressWriter struct {
+	w        io.Writer
+	progress Progress
+	report   ProgressFunc
+	last     time.Time
+}
+
+func newProgressWriter(w io.Writer, p Progress, report ProgressFunc) *progressWriter {
+	report(p)
+	return &progressWriter{w: w, progress: p, report: report, last: time.Now()}
+}
+
+func (w *progressWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.progress.Downloaded += int64(n)
+	if time.Since(w.last) >= progressInterval {
+		w.report(w.progress)
+		w.last = time.Now()
+	}
+	return n, err
+}
+
+// done reports the progress of the completed download.
+func (w *progressWriter) done() {
+	w.report(w.progress)
+}
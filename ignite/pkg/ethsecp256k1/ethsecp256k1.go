@@ -0,0 +1,291 @@
+// Package ethsecp256k1 implements the eth_secp256k1 keys of the EVM-enabled
+// chains, the secp256k1 keys of Ethereum that sign the Keccak-256 hash of the
+// messages and derive their address from the Keccak-256 hash of the public key.
+//
+// The keys are registered with the type URLs and the Amino names of the
+// ethermint keys so they can be stored in the keyrings of ethermint chains.
+package ethsecp256k1
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/gogo/protobuf/proto"
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	// KeyType is the type of the eth_secp256k1 keys.
+	KeyType = "eth_secp256k1"
+
+	// PrivKeySize is the size of the private keys.
+	PrivKeySize = 32
+
+	// PrivKeyName is the Amino name of the private keys.
+	PrivKeyName = "ethermint/PrivKeyEthSecp256k1"
+
+	// PubKeyName is the Amino name of the public keys.
+	PubKeyName = "ethermint/PubKeyEthSecp256k1"
+)
+
+var (
+	_ cryptotypes.PrivKey  = &PrivKey{}
+	_ cryptotypes.PubKey   = &PubKey{}
+	_ codec.AminoMarshaler = &PrivKey{}
+	_ codec.AminoMarshaler = &PubKey{}
+)
+
+// ErrInvalidKey is returned when a private key is not a valid eth_secp256k1 key.
+var ErrInvalidKey = errors.New("invalid eth_secp256k1 private key")
+
+// secp256k1halfN is used to reject malleable signatures.
+var secp256k1halfN = new(big.Int).Rsh(btcec.S256().N, 1)
+
+func init() {
+	proto.RegisterType((*PubKey)(nil), "ethermint.crypto.v1.ethsecp256k1.PubKey")
+	proto.RegisterType((*PrivKey)(nil), "ethermint.crypto.v1.ethsecp256k1.PrivKey")
+
+	// The keyrings armor the private keys with the legacy Amino codec
+	legacy.Cdc.RegisterConcrete(&PubKey{}, PubKeyName, nil)
+	legacy.Cdc.RegisterConcrete(&PrivKey{}, PrivKeyName, nil)
+}
+
+// RegisterInterfaces registers the keys in the interface registry.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &PubKey{})
+	registry.RegisterImplementations((*cryptotypes.PrivKey)(nil), &PrivKey{})
+}
+
+// PrivKey is an eth_secp256k1 private key.
+type PrivKey struct {
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+// PrivKeyFromHex returns the private key of the hex encoded key with or
+// without the 0x prefix, like the keys exported by Ethereum wallets.
+func PrivKeyFromHex(key string) (*PrivKey, error) {
+	key = strings.TrimPrefix(strings.TrimPrefix(strings.TrimSpace(key), "0x"), "0X")
+
+	bz, err := hex.DecodeString(key)
+	if err != nil || len(bz) != PrivKeySize {
+		return nil, ErrInvalidKey
+	}
+
+	// The key must be lower than the order of the curve and not zero
+	n := new(big.Int).SetBytes(bz)
+	if n.Sign() == 0 || n.Cmp(btcec.S256().N) >= 0 {
+		return nil, ErrInvalidKey
+	}
+
+	return &PrivKey{Key: bz}, nil
+}
+
+// Reset implements proto.Message.
+func (privKey *PrivKey) Reset() { *privKey = PrivKey{} }
+
+// String implements proto.Message.
+func (privKey *PrivKey) String() string { return proto.CompactTextString(privKey) }
+
+// ProtoMessage implements proto.Message.
+func (*PrivKey) ProtoMessage() {}
+
+// Bytes returns the bytes of the private key.
+func (privKey *PrivKey) Bytes() []byte {
+	return privKey.Key
+}
+
+// PubKey returns the compressed public key of the private key.
+func (privKey *PrivKey) PubKey() cryptotypes.PubKey {
+	_, pub := btcec.PrivKeyFromBytes(btcec.S256(), privKey.Key)
+	return &PubKey{Key: pub.SerializeCompressed()}
+}
+
+// Equals returns true when the keys are the same, in constant time.
+func (privKey *PrivKey) Equals(other cryptotypes.LedgerPrivKey) bool {
+	return privKey.Type() == other.Type() && subtle.ConstantTimeCompare(privKey.Bytes(), other.Bytes()) == 1
+}
+
+// Type returns the type of the key.
+func (privKey *PrivKey) Type() string {
+	return KeyType
+}
+
+// Sign signs the Keccak-256 hash of msg and returns the signature in the
+// Ethereum format R || S || V, where V is the recovery id 0 or 1.
+func (privKey *PrivKey) Sign(msg []byte) ([]byte, error) {
+	priv, _ := btcec.PrivKeyFromBytes(btcec.S256(), privKey.Key)
+
+	// The compact signature is V || R || S with V offset by 27
+	sig, err := btcec.SignCompact(btcec.S256(), priv, keccak256(msg), false)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(sig[1:], sig[0]-27), nil
+}
+
+// MarshalAmino overrides the Amino binary marshalling.
+func (privKey PrivKey) MarshalAmino() ([]byte, error) {
+	return privKey.Key, nil
+}
+
+// UnmarshalAmino overrides the Amino binary marshalling.
+func (privKey *PrivKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PrivKeySize {
+		return fmt.Errorf("invalid privkey size, expected %d got %d", PrivKeySize, len(bz))
+	}
+	privKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides the Amino JSON marshalling.
+func (privKey PrivKey) MarshalAminoJSON() ([]byte, error) {
+	return privKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides the Amino JSON marshalling.
+func (privKey *PrivKey) UnmarshalAminoJSON(bz []byte) error {
+	return privKey.UnmarshalAmino(bz)
+}
+
+// PubKey is a compressed eth_secp256k1 public key.
+type PubKey struct {
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+// Reset implements proto.Message.
+func (pubKey *PubKey) Reset() { *pubKey = PubKey{} }
+
+// String implements proto.Message.
+func (pubKey *PubKey) String() string {
+	return fmt.Sprintf("EthPubKeySecp256k1{%X}", pubKey.Key)
+}
+
+// ProtoMessage implements proto.Message.
+func (*PubKey) ProtoMessage() {}
+
+// Address returns the Ethereum address of the key, the last 20 bytes of the
+// Keccak-256 hash of the uncompressed public key.
+func (pubKey *PubKey) Address() cryptotypes.Address {
+	pub, err := btcec.ParsePubKey(pubKey.Key, btcec.S256())
+	if err != nil {
+		panic(err)
+	}
+
+	// The uncompressed key is prefixed by 0x04
+	return keccak256(pub.SerializeUncompressed()[1:])[12:]
+}
+
+// EthAddress returns the hex encoded Ethereum address of the key with the
+// mixed case checksum of EIP-55.
+func (pubKey *PubKey) EthAddress() string {
+	return ChecksumAddress(pubKey.Address())
+}
+
+// Bytes returns the bytes of the public key.
+func (pubKey *PubKey) Bytes() []byte {
+	return pubKey.Key
+}
+
+// Type returns the type of the key.
+func (pubKey *PubKey) Type() string {
+	return KeyType
+}
+
+// Equals returns true when the keys are the same.
+func (pubKey *PubKey) Equals(other cryptotypes.PubKey) bool {
+	return pubKey.Type() == other.Type() && bytes.Equal(pubKey.Bytes(), other.Bytes())
+}
+
+// VerifySignature verifies a signature of the Keccak-256 hash of msg in the
+// format R || S || V or R || S. It rejects the signatures not in lower-S form.
+func (pubKey *PubKey) VerifySignature(msg, sig []byte) bool {
+	if len(sig) == 65 {
+		sig = sig[:64]
+	}
+	if len(sig) != 64 {
+		return false
+	}
+
+	pub, err := btcec.ParsePubKey(pubKey.Key, btcec.S256())
+	if err != nil {
+		return false
+	}
+
+	signature := &btcec.Signature{
+		R: new(big.Int).SetBytes(sig[:32]),
+		S: new(big.Int).SetBytes(sig[32:]),
+	}
+	if signature.S.Cmp(secp256k1halfN) > 0 {
+		return false
+	}
+
+	return signature.Verify(keccak256(msg), pub)
+}
+
+// MarshalAmino overrides the Amino binary marshalling.
+func (pubKey PubKey) MarshalAmino() ([]byte, error) {
+	return pubKey.Key, nil
+}
+
+// UnmarshalAmino overrides the Amino binary marshalling.
+func (pubKey *PubKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != btcec.PubKeyBytesLenCompressed {
+		return fmt.Errorf("invalid pubkey size, expected %d got %d", btcec.PubKeyBytesLenCompressed, len(bz))
+	}
+	pubKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides the Amino JSON marshalling.
+func (pubKey PubKey) MarshalAminoJSON() ([]byte, error) {
+	return pubKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides the Amino JSON marshalling.
+func (pubKey *PubKey) UnmarshalAminoJSON(bz []byte) error {
+	return pubKey.UnmarshalAmino(bz)
+}
+
+// ChecksumAddress returns the hex encoded address with the mixed case
+// checksum of EIP-55.
+func ChecksumAddress(addr []byte) string {
+	lower := hex.EncodeToString(addr)
+	hash := keccak256([]byte(lower))
+
+	checksummed := []byte(lower)
+	for i, c := range checksummed {
+		if c < 'a' {
+			continue
+		}
+
+		// A letter is uppercased when its nibble of the hash is 8 or more
+		nibble := hash[i/2]
+		if i%2 == 0 {
+			nibble >>= 4
+		}
+		if nibble&0xf >= 8 {
+			checksummed[i] = c - 32
+		}
+	}
+
+	return "0x" + string(checksummed)
+}
+
+func keccak256(data []byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	h.Write(data)
+	return h.Sum(nil)
+}
@@ -0,0 +1,76 @@
+package ethsecp256k1_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/ethsecp256k1"
+)
+
+// The key and the address of the web3.js documentation.
+const (
+	testKey     = "0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318"
+	testAddress = "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23"
+)
+
+func TestPrivKeyFromHex(t *testing.T) {
+	privKey, err := ethsecp256k1.PrivKeyFromHex(testKey)
+	require.NoError(t, err)
+
+	pubKey := privKey.PubKey().(*ethsecp256k1.PubKey)
+	require.Equal(t, testAddress, pubKey.EthAddress())
+	require.Len(t, pubKey.Bytes(), 33)
+
+	for _, key := range []string{"", "0x", "0xzz", testKey[:64], "0x" + strings.Repeat("00", 32)} {
+		_, err := ethsecp256k1.PrivKeyFromHex(key)
+		require.ErrorIs(t, err, ethsecp256k1.ErrInvalidKey, key)
+	}
+}
+
+func TestSign(t *testing.T) {
+	privKey, err := ethsecp256k1.PrivKeyFromHex(testKey)
+	require.NoError(t, err)
+
+	msg := []byte("hello")
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, 65)
+	require.Contains(t, []byte{0, 1}, sig[64])
+
+	pubKey := privKey.PubKey()
+	require.True(t, pubKey.VerifySignature(msg, sig))
+	require.True(t, pubKey.VerifySignature(msg, sig[:64]))
+	require.False(t, pubKey.VerifySignature([]byte("bye"), sig))
+}
+
+func TestCodec(t *testing.T) {
+	privKey, err := ethsecp256k1.PrivKeyFromHex(testKey)
+	require.NoError(t, err)
+
+	registry := codectypes.NewInterfaceRegistry()
+	ethsecp256k1.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	any, err := codectypes.NewAnyWithValue(privKey)
+	require.NoError(t, err)
+	require.Equal(t, "/ethermint.crypto.v1.ethsecp256k1.PrivKey", any.TypeUrl)
+
+	bz, err := cdc.Marshal(any)
+	require.NoError(t, err)
+
+	var decoded cryptotypes.PrivKey
+	require.NoError(t, cdc.UnmarshalInterface(bz, &decoded))
+	require.True(t, privKey.Equals(decoded))
+
+	armor := crypto.EncryptArmorPrivKey(privKey, "passphrase", ethsecp256k1.KeyType)
+	unarmored, algo, err := crypto.UnarmorDecryptPrivKey(armor, "passphrase")
+	require.NoError(t, err)
+	require.Equal(t, ethsecp256k1.KeyType, algo)
+	require.True(t, privKey.Equals(unarmored))
+}
@@ -0,0 +1,202 @@
+// Package fixture writes and reads the archives of the fixtures of development
+// chains. A fixture contains everything that is required to start a chain in
+// the same state on another machine: the genesis, the keys and the config of
+// the home of the chain, the hash of the binary of the chain and a scenario
+// script that is run against the node.
+package fixture
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// Version is the version of the format of the fixture archives.
+	Version = 1
+
+	// ManifestFile is the name of the manifest of a fixture archive.
+	ManifestFile = "fixture.json"
+
+	// HomeDir is the directory of the home of the chain in a fixture archive.
+	HomeDir = "home"
+
+	// ConfigFile is the name of the config file of the chain in a fixture archive.
+	ConfigFile = "config.yml"
+
+	// ScenarioFile is the name of the scenario script in a fixture archive.
+	ScenarioFile = "scenario.sh"
+)
+
+// ErrInvalidFixture is returned when an archive is not a valid fixture.
+var ErrInvalidFixture = errors.New("invalid fixture")
+
+// Manifest describes the chain of a fixture.
+type Manifest struct {
+	// Version is the version of the format of the fixture.
+	Version int `json:"version"`
+
+	// ChainID is the ID of the chain.
+	ChainID string `json:"chain_id"`
+
+	// Binary is the name of the binary of the chain.
+	Binary string `json:"binary"`
+
+	// BinaryHash is the SHA256 hash of the binary of the chain.
+	BinaryHash string `json:"binary_hash"`
+}
+
+// File is a file of a fixture archive.
+type File struct {
+	// Name is the slash separated path of the file in the archive.
+	Name string
+
+	// Content is the content of the file.
+	Content []byte
+
+	// Executable is true when the file must be executable once extracted.
+	Executable bool
+}
+
+// Write writes the archive of a fixture with its manifest and its files.
+// The archive is deterministic: the same manifest and files always produce
+// the same bytes, whatever the order of the files and the machine.
+func Write(w io.Writer, m Manifest, files []File) error {
+	m.Version = Version
+	manifest, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	files = append([]File{{Name: ManifestFile, Content: manifest}}, files...)
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Name < files[j].Name
+	})
+
+	// The header of the gzip archive doesn't have a name or a modification time
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	seen := make(map[string]struct{})
+	for _, f := range files {
+		name, err := cleanName(f.Name)
+		if err != nil {
+			return err
+		}
+		if _, ok := seen[name]; ok {
+			return fmt.Errorf("duplicated file %s in the fixture", name)
+		}
+		seen[name] = struct{}{}
+
+		var mode int64 = 0o600
+		if f.Executable {
+			mode = 0o700
+		}
+		if err := tw.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     name,
+			Mode:     mode,
+			Size:     int64(len(f.Content)),
+			ModTime:  time.Unix(0, 0),
+			Format:   tar.FormatPAX,
+		}); err != nil {
+			return err
+		}
+		if _, err := tw.Write(f.Content); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+// Extract extracts the archive of a fixture in dir and returns its manifest.
+func Extract(r io.Reader, dir string) (Manifest, error) {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("%w: %s", ErrInvalidFixture, err)
+	}
+	defer gr.Close()
+
+	tr := tar.NewReader(gr)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return Manifest{}, fmt.Errorf("%w: %s", ErrInvalidFixture, err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			return Manifest{}, fmt.Errorf("%w: %s is not a regular file", ErrInvalidFixture, header.Name)
+		}
+
+		name, err := cleanName(header.Name)
+		if err != nil {
+			return Manifest{}, err
+		}
+		out := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(out), 0o700); err != nil {
+			return Manifest{}, err
+		}
+		if err := writeFile(out, tr, fs.FileMode(header.Mode).Perm()); err != nil {
+			return Manifest{}, err
+		}
+	}
+
+	return ReadManifest(dir)
+}
+
+// ReadManifest reads the manifest of a fixture extracted in dir.
+func ReadManifest(dir string) (Manifest, error) {
+	var m Manifest
+	data, err := os.ReadFile(filepath.Join(dir, ManifestFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return m, fmt.Errorf("%w: %s not found", ErrInvalidFixture, ManifestFile)
+	}
+	if err != nil {
+		return m, err
+	}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return m, fmt.Errorf("%w: %s", ErrInvalidFixture, err)
+	}
+	if m.Version != Version {
+		return m, fmt.Errorf("%w: unsupported version %d, expected %d", ErrInvalidFixture, m.Version, Version)
+	}
+	return m, nil
+}
+
+// cleanName returns the clean path of a file of an archive and checks that the
+// file stays in the directory of the archive once extracted.
+func cleanName(name string) (string, error) {
+	clean := path.Clean(name)
+	if name == "" || path.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, "../") {
+		return "", fmt.Errorf("%w: invalid file name %q", ErrInvalidFixture, name)
+	}
+	return clean, nil
+}
+
+func writeFile(name string, r io.Reader, perm fs.FileMode) error {
+	f, err := os.OpenFile(name, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, perm)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(f, r); err != nil {
+		return err
+	}
+	return f.Close()
+}
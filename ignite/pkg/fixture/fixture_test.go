@@ -0,0 +1,69 @@
+package fixture_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/fixture"
+)
+
+func TestWriteExtract(t *testing.T) {
+	manifest := fixture.Manifest{
+		ChainID:    "mars",
+		Binary:     "marsd",
+		BinaryHash: "abcd",
+	}
+	files := []fixture.File{
+		{Name: "home/config/genesis.json", Content: []byte(`{"chain_id":"mars"}`)},
+		{Name: fixture.ScenarioFile, Content: []byte("#!/bin/sh\n"), Executable: true},
+		{Name: "home/keyring-test/alice.info", Content: []byte("alice")},
+	}
+
+	var first, second bytes.Buffer
+	require.NoError(t, fixture.Write(&first, manifest, files))
+
+	// The archive doesn't depend on the order of the files
+	reversed := []fixture.File{files[2], files[1], files[0]}
+	require.NoError(t, fixture.Write(&second, manifest, reversed))
+	require.Equal(t, first.Bytes(), second.Bytes())
+
+	dir := t.TempDir()
+	got, err := fixture.Extract(&first, dir)
+	require.NoError(t, err)
+	manifest.Version = fixture.Version
+	require.Equal(t, manifest, got)
+
+	genesis, err := os.ReadFile(filepath.Join(dir, "home", "config", "genesis.json"))
+	require.NoError(t, err)
+	require.Equal(t, `{"chain_id":"mars"}`, string(genesis))
+
+	info, err := os.Stat(filepath.Join(dir, fixture.ScenarioFile))
+	require.NoError(t, err)
+	require.NotZero(t, info.Mode().Perm()&0o100)
+}
+
+func TestWriteInvalidName(t *testing.T) {
+	for _, name := range []string{"", "../escape", "/abs/path"} {
+		var buf bytes.Buffer
+		err := fixture.Write(&buf, fixture.Manifest{}, []fixture.File{{Name: name}})
+		require.ErrorIs(t, err, fixture.ErrInvalidFixture, name)
+	}
+}
+
+func TestWriteDuplicatedFile(t *testing.T) {
+	var buf bytes.Buffer
+	err := fixture.Write(&buf, fixture.Manifest{}, []fixture.File{
+		{Name: "home/config/genesis.json"},
+		{Name: "home/config/../config/genesis.json"},
+	})
+	require.Error(t, err)
+}
+
+func TestExtractInvalidArchive(t *testing.T) {
+	_, err := fixture.Extract(bytes.NewBufferString("not a fixture"), t.TempDir())
+	require.ErrorIs(t, err, fixture.ErrInvalidFixture)
+}
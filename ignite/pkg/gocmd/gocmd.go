@@ -40,12 +40,17 @@ const (
 	FlagMod              = "-mod"
 	FlagModValueReadOnly = "readonly"
 	FlagLdflags          = "-ldflags"
+	FlagTags             = "-tags"
 	FlagOut              = "-o"
 )
 
 const (
 	EnvGOOS   = "GOOS"
 	EnvGOARCH = "GOARCH"
+
+	EnvCGOEnabled = "CGO_ENABLED"
+	EnvCGOCFlags  = "CGO_CFLAGS"
+	EnvCGOLDFlags = "CGO_LDFLAGS"
 )
 
 // Name returns the name of Go binary to use.
@@ -136,6 +141,11 @@ func Ldflags(flags ...string) string {
 	return strings.Join(flags, " ")
 }
 
+// Tags returns a combined build tags set from tags.
+func Tags(tags ...string) string {
+	return strings.Join(tags, ",")
+}
+
 // BuildTarget builds a GOOS:GOARCH pair.
 func BuildTarget(goos, goarch string) string {
 	return fmt.Sprintf("%s:%s", goos, goarch)
@@ -0,0 +1,339 @@
+// Package journal records the changes made to the files of a directory and
+// reverts the last recorded changes.
+package journal
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DirName is the name of the directory where the journal is saved, relative to the journal root.
+	DirName = ".ignite/journal"
+
+	entriesFile = "journal.json"
+	backupDir   = "backup"
+)
+
+var (
+	// ErrEmpty is returned when there are no recorded entries to undo.
+	ErrEmpty = errors.New("there are no recorded changes to undo")
+
+	// skipDirs are the directories that are not recorded.
+	skipDirs = map[string]struct{}{
+		".git":         {},
+		".ignite":      {},
+		"node_modules": {},
+	}
+)
+
+// ConflictError is returned when the files changed by an entry were modified after it was recorded.
+type ConflictError struct {
+	Files []string
+}
+
+func (e ConflictError) Error() string {
+	return fmt.Sprintf("files were modified after the changes were recorded: %s", strings.Join(e.Files, ", "))
+}
+
+// File is a file changed by an entry.
+type File struct {
+	// Path of the file relative to the journal root.
+	Path string `json:"path"`
+
+	// Mode of the file before the changes.
+	Mode fs.FileMode `json:"mode,omitempty"`
+
+	// Hash of the file content after the changes, empty when the file was deleted.
+	Hash string `json:"hash,omitempty"`
+}
+
+// Entry contains the changes made to the files by an operation.
+type Entry struct {
+	// ID of the entry.
+	ID int `json:"id"`
+
+	// Name of the operation that made the changes.
+	Name string `json:"name"`
+
+	// Time when the changes were recorded.
+	Time time.Time `json:"time"`
+
+	// Created are the files created by the operation.
+	Created []File `json:"created,omitempty"`
+
+	// Modified are the files modified by the operation.
+	Modified []File `json:"modified,omitempty"`
+
+	// Deleted are the files deleted by the operation.
+	Deleted []File `json:"deleted,omitempty"`
+}
+
+// IsEmpty returns true when the entry doesn't contain any change.
+func (e Entry) IsEmpty() bool {
+	return len(e.Created) == 0 && len(e.Modified) == 0 && len(e.Deleted) == 0
+}
+
+// Snapshot is the state of the files of a directory.
+type Snapshot map[string]snapshotFile
+
+type snapshotFile struct {
+	mode    fs.FileMode
+	hash    string
+	content []byte
+}
+
+// Journal records the changes made to the files of a root directory.
+type Journal struct {
+	root string
+}
+
+// New returns a journal for the files of a root directory.
+func New(root string) Journal {
+	return Journal{root: root}
+}
+
+// Snapshot returns the current state of the files of the journal root directory.
+func (j Journal) Snapshot() (Snapshot, error) {
+	s := make(Snapshot)
+	err := filepath.WalkDir(j.root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if _, ok := skipDirs[d.Name()]; ok && path != j.root {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(j.root, path)
+		if err != nil {
+			return err
+		}
+
+		s[filepath.ToSlash(rel)] = snapshotFile{
+			mode:    info.Mode().Perm(),
+			hash:    hash(content),
+			content: content,
+		}
+		return nil
+	})
+	return s, err
+}
+
+// Record compares the current state of the files with a snapshot taken before an
+// operation and saves the changes in a new journal entry.
+// The entry is not saved when the operation didn't change any file.
+func (j Journal) Record(name string, before Snapshot) (Entry, error) {
+	after, err := j.Snapshot()
+	if err != nil {
+		return Entry{}, err
+	}
+
+	entries, err := j.Entries()
+	if err != nil {
+		return Entry{}, err
+	}
+
+	entry := Entry{
+		Name: name,
+		Time: time.Now().UTC(),
+	}
+	if len(entries) > 0 {
+		entry.ID = entries[len(entries)-1].ID + 1
+	}
+
+	var backups []string
+	for path, f := range after {
+		old, ok := before[path]
+		switch {
+		case !ok:
+			entry.Created = append(entry.Created, File{Path: path, Hash: f.hash})
+		case old.hash != f.hash:
+			entry.Modified = append(entry.Modified, File{Path: path, Mode: old.mode, Hash: f.hash})
+			backups = append(backups, path)
+		}
+	}
+	for path, f := range before {
+		if _, ok := after[path]; !ok {
+			entry.Deleted = append(entry.Deleted, File{Path: path, Mode: f.mode})
+			backups = append(backups, path)
+		}
+	}
+
+	if entry.IsEmpty() {
+		return entry, nil
+	}
+
+	sortFiles(entry.Created)
+	sortFiles(entry.Modified)
+	sortFiles(entry.Deleted)
+
+	// Save the original content of the changed files to be able to restore them
+	for _, path := range backups {
+		backup := j.backupPath(entry.ID, path)
+		if err := os.MkdirAll(filepath.Dir(backup), 0o755); err != nil {
+			return Entry{}, err
+		}
+		if err := os.WriteFile(backup, before[path].content, 0o644); err != nil {
+			return Entry{}, err
+		}
+	}
+
+	return entry, j.save(append(entries, entry))
+}
+
+// Entries returns the recorded journal entries, from the oldest to the newest.
+func (j Journal) Entries() ([]Entry, error) {
+	content, err := os.ReadFile(filepath.Join(j.dir(), entriesFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []Entry
+	if err := json.Unmarshal(content, &entries); err != nil {
+		return nil, fmt.Errorf("invalid journal file: %w", err)
+	}
+	return entries, nil
+}
+
+// Undo reverts the changes of the last journal entry and removes the entry from the journal.
+// A ConflictError is returned when the files changed by the entry were modified after it was
+// recorded, unless force is true.
+func (j Journal) Undo(force bool) (Entry, error) {
+	entries, err := j.Entries()
+	if err != nil {
+		return Entry{}, err
+	}
+	if len(entries) == 0 {
+		return Entry{}, ErrEmpty
+	}
+
+	entry := entries[len(entries)-1]
+
+	if !force {
+		if err := j.checkConflicts(entry); err != nil {
+			return Entry{}, err
+		}
+	}
+
+	for _, f := range entry.Created {
+		if err := os.Remove(j.path(f.Path)); err != nil && !os.IsNotExist(err) {
+			return Entry{}, err
+		}
+		j.removeEmptyDirs(filepath.Dir(j.path(f.Path)))
+	}
+	for _, f := range append(entry.Modified, entry.Deleted...) {
+		content, err := os.ReadFile(j.backupPath(entry.ID, f.Path))
+		if err != nil {
+			return Entry{}, err
+		}
+		path := j.path(f.Path)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return Entry{}, err
+		}
+		if err := os.WriteFile(path, content, f.Mode); err != nil {
+			return Entry{}, err
+		}
+	}
+
+	if err := os.RemoveAll(filepath.Join(j.dir(), backupDir, strconv.Itoa(entry.ID))); err != nil {
+		return Entry{}, err
+	}
+
+	return entry, j.save(entries[:len(entries)-1])
+}
+
+func (j Journal) checkConflicts(entry Entry) error {
+	var conflicts []string
+	for _, f := range append(entry.Created, entry.Modified...) {
+		content, err := os.ReadFile(j.path(f.Path))
+		if os.IsNotExist(err) {
+			conflicts = append(conflicts, f.Path)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if hash(content) != f.Hash {
+			conflicts = append(conflicts, f.Path)
+		}
+	}
+	for _, f := range entry.Deleted {
+		if _, err := os.Stat(j.path(f.Path)); err == nil {
+			conflicts = append(conflicts, f.Path)
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return ConflictError{Files: conflicts}
+	}
+	return nil
+}
+
+// removeEmptyDirs removes a directory and its parents while they are empty.
+func (j Journal) removeEmptyDirs(dir string) {
+	for dir != j.root && strings.HasPrefix(dir, j.root) {
+		if err := os.Remove(dir); err != nil {
+			return
+		}
+		dir = filepath.Dir(dir)
+	}
+}
+
+func (j Journal) save(entries []Entry) error {
+	if err := os.MkdirAll(j.dir(), 0o755); err != nil {
+		return err
+	}
+	content, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(j.dir(), entriesFile), content, 0o644)
+}
+
+func (j Journal) dir() string {
+	return filepath.Join(j.root, DirName)
+}
+
+func (j Journal) path(rel string) string {
+	return filepath.Join(j.root, filepath.FromSlash(rel))
+}
+
+func (j Journal) backupPath(id int, rel string) string {
+	return filepath.Join(j.dir(), backupDir, strconv.Itoa(id), filepath.FromSlash(rel))
+}
+
+func hash(content []byte) string {
+	return fmt.Sprintf("%x", sha256.Sum256(content))
+}
+
+func sortFiles(files []File) {
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Path < files[j].Path
+	})
+}
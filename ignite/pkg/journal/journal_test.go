@@ -0,0 +1,117 @@
+package journal_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/journal"
+)
+
+func writeFile(t *testing.T, root, path, content string) {
+	t.Helper()
+
+	path = filepath.Join(root, path)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+}
+
+func readFile(t *testing.T, root, path string) string {
+	t.Helper()
+
+	content, err := os.ReadFile(filepath.Join(root, path))
+	require.NoError(t, err)
+	return string(content)
+}
+
+func TestRecordAndUndo(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, root, "app/app.go", "app")
+	writeFile(t, root, "x/blog/genesis.go", "genesis")
+	writeFile(t, root, "x/blog/old.go", "old")
+	writeFile(t, root, "node_modules/dep/index.js", "dep")
+
+	j := journal.New(root)
+
+	before, err := j.Snapshot()
+	require.NoError(t, err)
+
+	writeFile(t, root, "app/app.go", "app with post")
+	writeFile(t, root, "x/blog/keeper/post.go", "post")
+	writeFile(t, root, "node_modules/dep/index.js", "new dep")
+	require.NoError(t, os.Remove(filepath.Join(root, "x/blog/old.go")))
+
+	entry, err := j.Record("scaffold list post", before)
+	require.NoError(t, err)
+	require.Equal(t, 0, entry.ID)
+	require.Equal(t, "scaffold list post", entry.Name)
+	require.Len(t, entry.Created, 1)
+	require.Equal(t, "x/blog/keeper/post.go", entry.Created[0].Path)
+	require.Len(t, entry.Modified, 1)
+	require.Equal(t, "app/app.go", entry.Modified[0].Path)
+	require.Len(t, entry.Deleted, 1)
+	require.Equal(t, "x/blog/old.go", entry.Deleted[0].Path)
+
+	entries, err := j.Entries()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	undone, err := j.Undo(false)
+	require.NoError(t, err)
+	require.Equal(t, entry.Name, undone.Name)
+
+	require.Equal(t, "app", readFile(t, root, "app/app.go"))
+	require.Equal(t, "old", readFile(t, root, "x/blog/old.go"))
+	require.NoFileExists(t, filepath.Join(root, "x/blog/keeper/post.go"))
+	require.NoDirExists(t, filepath.Join(root, "x/blog/keeper"))
+	require.Equal(t, "genesis", readFile(t, root, "x/blog/genesis.go"))
+
+	_, err = j.Undo(false)
+	require.ErrorIs(t, err, journal.ErrEmpty)
+}
+
+func TestRecordWithoutChanges(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, root, "app/app.go", "app")
+
+	j := journal.New(root)
+
+	before, err := j.Snapshot()
+	require.NoError(t, err)
+
+	entry, err := j.Record("scaffold message", before)
+	require.NoError(t, err)
+	require.True(t, entry.IsEmpty())
+
+	entries, err := j.Entries()
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestUndoConflict(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, root, "app/app.go", "app")
+
+	j := journal.New(root)
+
+	before, err := j.Snapshot()
+	require.NoError(t, err)
+
+	writeFile(t, root, "app/app.go", "app with post")
+
+	_, err = j.Record("scaffold list post", before)
+	require.NoError(t, err)
+
+	writeFile(t, root, "app/app.go", "app with post edited")
+
+	_, err = j.Undo(false)
+	var conflictErr journal.ConflictError
+	require.ErrorAs(t, err, &conflictErr)
+	require.Equal(t, []string{"app/app.go"}, conflictErr.Files)
+
+	_, err = j.Undo(true)
+	require.NoError(t, err)
+	require.Equal(t, "app", readFile(t, root, "app/app.go"))
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/buger/jsonparser"
 	"github.com/pkg/errors"
 
+	"github.com/ignite/cli/ignite/pkg/downloader"
 	"github.com/ignite/cli/ignite/pkg/tarball"
 )
 
@@ -84,38 +85,32 @@ func FromPath(path string) (*JSONFile, error) {
 // FromURL fetches the file from the given URL and returns its content.
 // If tarballFileName is not empty, the URL is interpreted as a tarball file,
 // tarballFileName is extracted from it and is returned instead of the URL
-// content.
-func FromURL(ctx context.Context, url, destPath, tarballFileName string) (*JSONFile, error) {
+// content. The options configure the download manager fetching the file.
+func FromURL(ctx context.Context, url, destPath, tarballFileName string, options ...downloader.Option) (*JSONFile, error) {
 	// TODO create a cache system to avoid download genesis with the same hash again
 
-	// Download the file from URL
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-	if err != nil {
+	// Download to a file next to the destination so an interrupted
+	// download doesn't leave a partial file at the destination path
+	// and can be resumed
+	downloadPath := destPath + ".download"
+	if err := downloader.New(options...).File(ctx, url, downloadPath); err != nil {
+		var statusErr *downloader.StatusError
+		if errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound {
+			return nil, ErrInvalidURL
+		}
 		return nil, err
 	}
+	defer os.Remove(downloadPath)
 
-	resp, err := http.DefaultClient.Do(req)
+	tmp, err := os.OpenFile(downloadPath, os.O_RDWR, 0o644)
 	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrInvalidURL
-	}
-
-	// Remove the old file if exists and create a new one
-	if err := os.RemoveAll(destPath); err != nil {
-		return nil, err
-	}
-	file, err := os.OpenFile(destPath, os.O_RDWR|os.O_APPEND|os.O_CREATE, 0o600)
-	if err != nil {
-		return nil, errors.Wrap(err, "cannot create the file")
+		return nil, errors.Wrap(err, "cannot open the file")
 	}
+	defer tmp.Close()
 
-	// Copy the downloaded file to buffer and the opened file
+	// Copy the downloaded file to buffer
 	var buf bytes.Buffer
-	if _, err := io.Copy(file, io.TeeReader(resp.Body, &buf)); err != nil {
+	if _, err := io.Copy(&buf, tmp); err != nil {
 		return nil, err
 	}
 
@@ -126,12 +121,30 @@ func FromURL(ctx context.Context, url, destPath, tarballFileName string) (*JSONF
 		return nil, err
 	} else if err == nil {
 		// Erase the tarball bite code from the file and copy the correct one
-		if err := truncate(file, 0); err != nil {
+		if err := truncate(tmp, 0); err != nil {
 			return nil, err
 		}
-		if _, err := io.Copy(file, &ext); err != nil {
+		if _, err := tmp.Seek(0, io.SeekStart); err != nil {
 			return nil, err
 		}
+		if _, err := io.Copy(tmp, &ext); err != nil {
+			return nil, err
+		}
+	}
+	if err := tmp.Close(); err != nil {
+		return nil, err
+	}
+
+	// Replace the old file if exists with the downloaded one
+	if err := os.RemoveAll(destPath); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmp.Name(), destPath); err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(destPath, os.O_RDWR|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot open the file")
 	}
 
 	return &JSONFile{
@@ -0,0 +1,85 @@
+package launchstore
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// dirStore is a store in a directory.
+type dirStore struct {
+	root string
+}
+
+func newDirStore(root string) (dirStore, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return dirStore{}, err
+	}
+	return dirStore{root: root}, os.MkdirAll(root, 0o755)
+}
+
+func (s dirStore) path(p string) (string, error) {
+	clean, err := cleanPath(p)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(s.root, filepath.FromSlash(clean)), nil
+}
+
+func (s dirStore) Read(_ context.Context, p string) ([]byte, error) {
+	path, err := s.path(p)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return data, err
+}
+
+func (s dirStore) List(_ context.Context, dir string) ([]string, error) {
+	path, err := s.path(dir)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, e := range entries {
+		if !e.IsDir() {
+			names = append(names, e.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (s dirStore) Write(_ context.Context, files map[string][]byte, _ string) error {
+	for p, data := range files {
+		path, err := s.path(p)
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (dirStore) Close() error {
+	return nil
+}
@@ -0,0 +1,112 @@
+package launchstore
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+
+	"github.com/ignite/cli/ignite/pkg/downloader"
+)
+
+// gitPushAttempts is the number of attempts to push the writes to a git
+// repository, the writes are pushed again on the latest commit when others
+// pushed in the meantime.
+const gitPushAttempts = 3
+
+var gitAuthor = object.Signature{
+	Name:  "Ignite CLI",
+	Email: "hello@ignite.com",
+}
+
+// gitStore is a store in a git repository, the repository is cloned in a
+// temporary directory and the writes are committed and pushed.
+type gitStore struct {
+	dirStore
+	url  string
+	auth transport.AuthMethod
+	repo *git.Repository
+}
+
+func openGit(ctx context.Context, url string, o options) (*gitStore, error) {
+	dir, err := os.MkdirTemp("", "launchstore")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &gitStore{dirStore: dirStore{root: dir}, url: url}
+	if o.token != "" {
+		// the hosting services accept the tokens as password of any user.
+		s.auth = &githttp.BasicAuth{Username: "git", Password: o.token}
+	}
+	if err := s.clone(ctx); err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *gitStore) clone(ctx context.Context) (err error) {
+	s.repo, err = downloader.New().Clone(ctx, s.root, &git.CloneOptions{
+		URL:  s.url,
+		Auth: s.auth,
+	})
+	return err
+}
+
+func (s *gitStore) Write(ctx context.Context, files map[string][]byte, message string) error {
+	var err error
+	for i := 0; i < gitPushAttempts; i++ {
+		if i > 0 {
+			if err := s.clone(ctx); err != nil {
+				return err
+			}
+		}
+		if err = s.commit(ctx, files, message); !errors.Is(err, git.ErrForceNeeded) {
+			return err
+		}
+	}
+	return err
+}
+
+// commit commits and pushes the files.
+func (s *gitStore) commit(ctx context.Context, files map[string][]byte, message string) error {
+	if err := s.dirStore.Write(ctx, files, message); err != nil {
+		return err
+	}
+
+	wt, err := s.repo.Worktree()
+	if err != nil {
+		return err
+	}
+	for p := range files {
+		clean, err := cleanPath(p)
+		if err != nil {
+			return err
+		}
+		if _, err := wt.Add(clean); err != nil {
+			return err
+		}
+	}
+
+	author := gitAuthor
+	author.When = time.Now()
+	if _, err := wt.Commit(message, &git.CommitOptions{Author: &author}); err != nil {
+		return err
+	}
+
+	err = s.repo.PushContext(ctx, &git.PushOptions{Auth: s.auth})
+	if errors.Is(err, git.NoErrAlreadyUpToDate) {
+		return nil
+	}
+	return err
+}
+
+func (s *gitStore) Close() error {
+	return os.RemoveAll(s.root)
+}
@@ -0,0 +1,158 @@
+package launchstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/ignite/cli/ignite/pkg/xhttp"
+)
+
+// maxFileSize is the maximum size of the files written to the HTTP service.
+const maxFileSize = 64 << 20
+
+// httpStore is a store served by an HTTP service, the files are read with GET
+// requests, written with PUT requests and the directories are listed with GET
+// requests of their path ending with a slash.
+type httpStore struct {
+	url   string
+	token string
+}
+
+func newHTTPStore(url string, o options) httpStore {
+	return httpStore{url: strings.TrimSuffix(url, "/"), token: o.token}
+}
+
+func (s httpStore) do(ctx context.Context, method, p string, body []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, s.url+"/"+p, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, ErrNotFound
+	case resp.StatusCode >= http.StatusBadRequest:
+		return nil, fmt.Errorf("%s %s: %s", method, req.URL, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+func (s httpStore) Read(ctx context.Context, p string) ([]byte, error) {
+	clean, err := cleanPath(p)
+	if err != nil {
+		return nil, err
+	}
+	return s.do(ctx, http.MethodGet, clean, nil)
+}
+
+func (s httpStore) List(ctx context.Context, dir string) ([]string, error) {
+	clean, err := cleanPath(dir)
+	if err != nil {
+		return nil, err
+	}
+	data, err := s.do(ctx, http.MethodGet, clean+"/", nil)
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	return names, json.Unmarshal(data, &names)
+}
+
+func (s httpStore) Write(ctx context.Context, files map[string][]byte, _ string) error {
+	for p, data := range files {
+		clean, err := cleanPath(p)
+		if err != nil {
+			return err
+		}
+		if _, err := s.do(ctx, http.MethodPut, clean, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (httpStore) Close() error {
+	return nil
+}
+
+// Handler returns the handler of an HTTP service that serves the store s. The
+// writes are authenticated with one of the bearer tokens when tokens are set.
+func Handler(s Store, tokens ...string) http.Handler {
+	write := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxFileSize))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+		p := strings.TrimPrefix(r.URL.Path, "/")
+		if err := s.Write(r.Context(), map[string][]byte{p: data}, ""); err != nil {
+			writeError(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	if len(tokens) > 0 {
+		write = xhttp.AuthHandler(write, xhttp.Credentials{Tokens: tokens}, "launch store")
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			serveRead(w, r, s)
+		case http.MethodPut:
+			write.ServeHTTP(w, r)
+		default:
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+func serveRead(w http.ResponseWriter, r *http.Request, s Store) {
+	p := strings.TrimPrefix(r.URL.Path, "/")
+	if strings.HasSuffix(p, "/") {
+		names, err := s.List(r.Context(), p)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if names == nil {
+			names = []string{}
+		}
+		xhttp.ResponseJSON(w, http.StatusOK, names) //nolint:errcheck
+		return
+	}
+
+	data, err := s.Read(r.Context(), p)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.Write(data) //nolint:errcheck
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	if errors.Is(err, ErrNotFound) {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+	http.Error(w, err.Error(), http.StatusBadRequest)
+}
@@ -0,0 +1,201 @@
+package launchstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	launchFile          = "launch.json"
+	genesisTemplateFile = "genesis-template.json"
+	genesisFile         = "genesis.json"
+	requestsDir         = "requests"
+)
+
+var (
+	// ErrAlreadyPublished is returned when a launch is published twice.
+	ErrAlreadyPublished = errors.New("the launch is already published")
+
+	// ErrLaunched is returned when a request joins a launched testnet.
+	ErrLaunched = errors.New("the testnet is launched")
+
+	requestNameRe = regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`)
+)
+
+// Launch is the launch of a testnet published by its coordinator.
+type Launch struct {
+	// ChainID is the chain ID of the testnet.
+	ChainID string `json:"chain_id"`
+
+	// GenesisTemplateHash is the SHA256 hash of the genesis of the accounts
+	// of the testnet, the validators generate their gentx from the template.
+	GenesisTemplateHash string `json:"genesis_template_hash"`
+
+	// BinaryHash is the SHA256 hash of the binary of the coordinator.
+	BinaryHash string `json:"binary_hash"`
+
+	// LaunchTime is the genesis time of the testnet.
+	LaunchTime time.Time `json:"launch_time"`
+
+	// Launched is true once the coordinator finalized the genesis.
+	Launched bool `json:"launched"`
+
+	// GenesisHash is the SHA256 hash of the final genesis.
+	GenesisHash string `json:"genesis_hash,omitempty"`
+
+	// Peers are the peer addresses of the nodes of the validators of the
+	// final genesis.
+	Peers []string `json:"peers,omitempty"`
+}
+
+// JoinRequest is the request of a validator to join a testnet.
+type JoinRequest struct {
+	// Name is the name of the request, the ID of the node of the validator.
+	Name string `json:"name"`
+
+	// Address is the address of the account of the validator.
+	Address string `json:"address"`
+
+	// Coins are the coins of the account of the validator in the genesis.
+	Coins string `json:"coins"`
+
+	// Gentx is the gentx of the validator.
+	Gentx json.RawMessage `json:"gentx"`
+}
+
+// Hash returns the SHA256 hash of the content of a file.
+func Hash(data []byte) string {
+	return fmt.Sprintf("%x", sha256.Sum256(data))
+}
+
+// ReadLaunch reads the launch of the chain.
+func ReadLaunch(ctx context.Context, s Store, chainID string) (Launch, error) {
+	data, err := s.Read(ctx, path.Join(chainID, launchFile))
+	if errors.Is(err, ErrNotFound) {
+		return Launch{}, fmt.Errorf("the launch of %s is not published", chainID)
+	}
+	if err != nil {
+		return Launch{}, err
+	}
+
+	var l Launch
+	if err := json.Unmarshal(data, &l); err != nil {
+		return Launch{}, fmt.Errorf("invalid launch of %s: %w", chainID, err)
+	}
+	return l, nil
+}
+
+// Publish publishes the launch with its genesis template.
+func Publish(ctx context.Context, s Store, l Launch, genesisTemplate []byte) error {
+	if _, err := s.Read(ctx, path.Join(l.ChainID, launchFile)); err == nil {
+		return ErrAlreadyPublished
+	} else if !errors.Is(err, ErrNotFound) {
+		return err
+	}
+
+	l.GenesisTemplateHash = Hash(genesisTemplate)
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return err
+	}
+	return s.Write(ctx, map[string][]byte{
+		path.Join(l.ChainID, launchFile):          data,
+		path.Join(l.ChainID, genesisTemplateFile): genesisTemplate,
+	}, fmt.Sprintf("Publish the launch of %s", l.ChainID))
+}
+
+// GenesisTemplate returns the genesis template of the launch.
+func GenesisTemplate(ctx context.Context, s Store, l Launch) ([]byte, error) {
+	return readHashed(ctx, s, path.Join(l.ChainID, genesisTemplateFile), l.GenesisTemplateHash)
+}
+
+// Join submits the request to join the launch.
+func Join(ctx context.Context, s Store, l Launch, r JoinRequest) error {
+	if l.Launched {
+		return ErrLaunched
+	}
+	if !requestNameRe.MatchString(r.Name) {
+		return fmt.Errorf("invalid request name %q", r.Name)
+	}
+
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	return s.Write(ctx, map[string][]byte{
+		path.Join(l.ChainID, requestsDir, r.Name+".json"): data,
+	}, fmt.Sprintf("Join %s with %s", l.ChainID, r.Name))
+}
+
+// Requests returns the requests to join the launch.
+func Requests(ctx context.Context, s Store, l Launch) ([]JoinRequest, error) {
+	dir := path.Join(l.ChainID, requestsDir)
+	names, err := s.List(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var requests []JoinRequest
+	for _, name := range names {
+		if !strings.HasSuffix(name, ".json") {
+			continue
+		}
+		data, err := s.Read(ctx, path.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		var r JoinRequest
+		if err := json.Unmarshal(data, &r); err != nil {
+			return nil, fmt.Errorf("invalid request %s: %w", name, err)
+		}
+		requests = append(requests, r)
+	}
+	return requests, nil
+}
+
+// Finalize publishes the final genesis of the launch and the peers of its
+// validators, the launch doesn't accept requests anymore.
+func Finalize(ctx context.Context, s Store, l Launch, genesis []byte, peers []string) (Launch, error) {
+	if l.Launched {
+		return Launch{}, ErrLaunched
+	}
+	l.Launched = true
+	l.GenesisHash = Hash(genesis)
+	l.Peers = peers
+
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return Launch{}, err
+	}
+	return l, s.Write(ctx, map[string][]byte{
+		path.Join(l.ChainID, launchFile):  data,
+		path.Join(l.ChainID, genesisFile): genesis,
+	}, fmt.Sprintf("Launch %s", l.ChainID))
+}
+
+// Genesis returns the final genesis of the launch.
+func Genesis(ctx context.Context, s Store, l Launch) ([]byte, error) {
+	if !l.Launched {
+		return nil, fmt.Errorf("%s is not launched", l.ChainID)
+	}
+	return readHashed(ctx, s, path.Join(l.ChainID, genesisFile), l.GenesisHash)
+}
+
+// readHashed reads the file and verifies its hash.
+func readHashed(ctx context.Context, s Store, p, hash string) ([]byte, error) {
+	data, err := s.Read(ctx, p)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", p, err)
+	}
+	if h := Hash(data); h != hash {
+		return nil, fmt.Errorf("the hash %s of %s is not the hash of the launch %s", h, p, hash)
+	}
+	return data, nil
+}
@@ -0,0 +1,220 @@
+package launchstore
+
+import (
+	"context"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCleanPath(t *testing.T) {
+	for p, want := range map[string]string{
+		"mars/launch.json": "mars/launch.json",
+		"mars/requests/":   "mars/requests",
+	} {
+		got, err := cleanPath(p)
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	}
+	for _, p := range []string{"", ".", "/mars", "mars/../venus", "../mars", "mars//launch.json"} {
+		_, err := cleanPath(p)
+		require.Error(t, err, p)
+	}
+}
+
+// newGitRemote returns the path of a bare repository with a commit.
+func newGitRemote(t *testing.T) string {
+	dir := t.TempDir()
+	seed := filepath.Join(dir, "seed")
+	repo, err := git.PlainInit(seed, false)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(seed, "README.md"), []byte("launches"), 0o644))
+	wt, err := repo.Worktree()
+	require.NoError(t, err)
+	_, err = wt.Add("README.md")
+	require.NoError(t, err)
+	_, err = wt.Commit("init", &git.CommitOptions{Author: &object.Signature{Name: "mars", When: time.Now()}})
+	require.NoError(t, err)
+
+	remote := filepath.Join(dir, "remote.git")
+	_, err = git.PlainClone(remote, true, &git.CloneOptions{URL: seed})
+	require.NoError(t, err)
+	return remote
+}
+
+func TestStores(t *testing.T) {
+	ctx := context.Background()
+	for name, open := range map[string]func(t *testing.T) Store{
+		"dir": func(t *testing.T) Store {
+			s, err := Open(ctx, t.TempDir())
+			require.NoError(t, err)
+			return s
+		},
+		"http": func(t *testing.T) Store {
+			dir, err := Open(ctx, t.TempDir())
+			require.NoError(t, err)
+			srv := httptest.NewServer(Handler(dir, "secret"))
+			t.Cleanup(srv.Close)
+
+			s, err := Open(ctx, srv.URL, WithToken("secret"))
+			require.NoError(t, err)
+
+			// the writes are authenticated.
+			unauthenticated, err := Open(ctx, srv.URL)
+			require.NoError(t, err)
+			require.Error(t, unauthenticated.Write(ctx, map[string][]byte{"mars/launch.json": nil}, ""))
+			return s
+		},
+		"git": func(t *testing.T) Store {
+			remote := newGitRemote(t)
+			s, err := Open(ctx, remote)
+			require.NoError(t, err)
+			t.Cleanup(func() { s.Close() })
+			return s
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			s := open(t)
+
+			_, err := s.Read(ctx, "mars/launch.json")
+			require.ErrorIs(t, err, ErrNotFound)
+			names, err := s.List(ctx, "mars/requests")
+			require.NoError(t, err)
+			require.Empty(t, names)
+
+			require.NoError(t, s.Write(ctx, map[string][]byte{
+				"mars/launch.json":       []byte(`{}`),
+				"mars/requests/b2.json":  []byte(`b2`),
+				"mars/requests/a1.json":  []byte(`a1`),
+				"venus/requests/c3.json": []byte(`c3`),
+			}, "write"))
+
+			data, err := s.Read(ctx, "mars/requests/a1.json")
+			require.NoError(t, err)
+			require.Equal(t, "a1", string(data))
+			names, err = s.List(ctx, "mars/requests")
+			require.NoError(t, err)
+			require.Equal(t, []string{"a1.json", "b2.json"}, names)
+
+			_, err = s.Read(ctx, "../launch.json")
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestLaunch(t *testing.T) {
+	ctx := context.Background()
+	s, err := Open(ctx, t.TempDir())
+	require.NoError(t, err)
+
+	_, err = ReadLaunch(ctx, s, "mars")
+	require.EqualError(t, err, "the launch of mars is not published")
+
+	launchTime := time.Date(2022, 11, 2, 10, 0, 0, 0, time.UTC)
+	template := []byte(`{"chain_id":"mars"}`)
+	require.NoError(t, Publish(ctx, s, Launch{ChainID: "mars", BinaryHash: "b1", LaunchTime: launchTime}, template))
+	require.ErrorIs(t, Publish(ctx, s, Launch{ChainID: "mars"}, template), ErrAlreadyPublished)
+
+	l, err := ReadLaunch(ctx, s, "mars")
+	require.NoError(t, err)
+	require.Equal(t, Launch{
+		ChainID:             "mars",
+		GenesisTemplateHash: Hash(template),
+		BinaryHash:          "b1",
+		LaunchTime:          launchTime,
+	}, l)
+	got, err := GenesisTemplate(ctx, s, l)
+	require.NoError(t, err)
+	require.Equal(t, template, got)
+
+	// the files of the launch are verified with their hash.
+	require.NoError(t, s.Write(ctx, map[string][]byte{"mars/genesis-template.json": []byte(`{}`)}, ""))
+	_, err = GenesisTemplate(ctx, s, l)
+	require.Error(t, err)
+
+	r := JoinRequest{Name: "a1", Address: "cosmos1a", Coins: "10stake", Gentx: []byte(`{"body":{}}`)}
+	require.NoError(t, Join(ctx, s, l, r))
+	require.Error(t, Join(ctx, s, l, JoinRequest{Name: "../a1"}))
+	requests, err := Requests(ctx, s, l)
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+	require.JSONEq(t, string(r.Gentx), string(requests[0].Gentx))
+	requests[0].Gentx = r.Gentx
+	require.Equal(t, r, requests[0])
+
+	_, err = Genesis(ctx, s, l)
+	require.EqualError(t, err, "mars is not launched")
+
+	genesis := []byte(`{"chain_id":"mars","validators":[]}`)
+	l, err = Finalize(ctx, s, l, genesis, []string{"a1@mars.network:26656"})
+	require.NoError(t, err)
+	require.True(t, l.Launched)
+	require.ErrorIs(t, Join(ctx, s, l, r), ErrLaunched)
+
+	l, err = ReadLaunch(ctx, s, "mars")
+	require.NoError(t, err)
+	require.Equal(t, []string{"a1@mars.network:26656"}, l.Peers)
+	got, err = Genesis(ctx, s, l)
+	require.NoError(t, err)
+	require.Equal(t, genesis, got)
+}
+
+func TestVerify(t *testing.T) {
+	ctx := context.Background()
+	s, err := Open(ctx, t.TempDir())
+	require.NoError(t, err)
+
+	gentx, err := os.ReadFile("testdata/gentx.json")
+	require.NoError(t, err)
+	var (
+		launchTime = time.Date(2022, 11, 2, 10, 0, 0, 0, time.UTC)
+		alice      = `{"address":"cosmos1alice","coins":[{"denom":"stake","amount":"100"}]}`
+		validator  = `{"address":"cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj","coins":[{"denom":"stake","amount":"95000000"}]}`
+		template   = []byte(`{"chain_id":"mars","app_state":{"bank":{"balances":[` + alice + `]}}}`)
+		genesis    = func(balances, gentxs string) []byte {
+			return []byte(`{"chain_id":"mars","genesis_time":"2022-11-02T10:00:00Z","app_state":{"bank":{"balances":[` + balances + `]},"genutil":{"gen_txs":[` + gentxs + `]}}}`)
+		}
+	)
+
+	require.NoError(t, Publish(ctx, s, Launch{ChainID: "mars", BinaryHash: "b1", LaunchTime: launchTime}, template))
+	l, err := ReadLaunch(ctx, s, "mars")
+	require.NoError(t, err)
+	require.NoError(t, Join(ctx, s, l, JoinRequest{
+		Name:    "9b1f4adbfb0c0b513040d914bfb717303c0eaa71",
+		Address: "cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj",
+		Coins:   "95000000stake",
+		Gentx:   gentx,
+	}))
+	final := genesis(alice+","+validator, string(gentx))
+	_, err = Verify(ctx, s, l, final)
+	require.EqualError(t, err, "mars is not launched")
+
+	l, err = Finalize(ctx, s, l, final, nil)
+	require.NoError(t, err)
+
+	r, err := Verify(ctx, s, l, final)
+	require.NoError(t, err)
+	require.Empty(t, r.Failed())
+	require.Equal(t, l.GenesisHash, r.GenesisHash)
+	require.Equal(t, 2, r.Accounts)
+	require.Equal(t, []ReportValidator{{
+		Address:        "cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj",
+		SelfDelegation: "95000000stake",
+		Peer:           "9b1f4adbfb0c0b513040d914bfb717303c0eaa71@192.168.0.148:26656",
+	}}, r.Validators)
+
+	// a genesis without the gentx and with another balance fails the checks.
+	r, err = Verify(ctx, s, l, genesis(alice+`,{"address":"cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj","coins":[{"denom":"token","amount":"1"}]}`, ""))
+	require.NoError(t, err)
+	require.Equal(t, []Check{
+		{Name: "genesis hash", Error: r.Failed()[0].Error},
+		{Name: "gentxs", Error: "the gentx of request 9b1f4adbfb0c0b513040d914bfb717303c0eaa71 is not in the genesis"},
+		{Name: "accounts", Error: "the account cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj has 1token instead of 95000000stake"},
+	}, r.Failed())
+}
@@ -0,0 +1,78 @@
+// Package launchstore stores the launches of coordinated testnets in a location
+// shared by the coordinator and the validators of the testnets: a directory, a
+// git repository or an HTTP service served by Handler.
+package launchstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/ignite/cli/ignite/pkg/xurl"
+)
+
+// ErrNotFound is returned when a file is not found in a store.
+var ErrNotFound = errors.New("not found")
+
+// Store stores the files of the launches.
+type Store interface {
+	// Read returns the content of the file at the path.
+	Read(ctx context.Context, path string) ([]byte, error)
+
+	// List returns the sorted names of the files in the directory at the path,
+	// the list is empty when the directory doesn't exist.
+	List(ctx context.Context, dir string) ([]string, error)
+
+	// Write writes the files at their path, the message describes the change.
+	Write(ctx context.Context, files map[string][]byte, message string) error
+
+	// Close releases the resources of the store.
+	Close() error
+}
+
+// Option configures the access to a store.
+type Option func(*options)
+
+type options struct {
+	token string
+}
+
+// WithToken sets the token of the writes to the store: the bearer token of an
+// HTTP service or the password of a git repository served over HTTPS.
+func WithToken(token string) Option {
+	return func(o *options) {
+		o.token = token
+	}
+}
+
+// Open opens the store at the location. The locations ending with ".git" or
+// starting with "git@" are git repositories, the HTTP URLs are HTTP services
+// and the other locations are directories.
+func Open(ctx context.Context, location string, opts ...Option) (Store, error) {
+	var o options
+	for _, apply := range opts {
+		apply(&o)
+	}
+
+	switch {
+	case location == "":
+		return nil, errors.New("the location of the store is required")
+	case strings.HasSuffix(location, ".git") || strings.HasPrefix(location, "git@"):
+		return openGit(ctx, location, o)
+	case xurl.IsHTTP(location):
+		return newHTTPStore(location, o), nil
+	default:
+		return newDirStore(location)
+	}
+}
+
+// cleanPath returns the clean relative path of a file of a store.
+func cleanPath(p string) (string, error) {
+	clean := path.Clean("/" + p)[1:]
+	if clean == "" || clean != strings.TrimSuffix(p, "/") {
+		return "", fmt.Errorf("invalid path %q", p)
+	}
+	return clean, nil
+}
@@ -0,0 +1,240 @@
+package launchstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+)
+
+// Report is the report of the verification of the final genesis of a launch,
+// the participants compare their reports to agree on the genesis.
+type Report struct {
+	ChainID             string    `json:"chain_id"`
+	GenesisHash         string    `json:"genesis_hash"`
+	GenesisTemplateHash string    `json:"genesis_template_hash"`
+	BinaryHash          string    `json:"binary_hash"`
+	GenesisTime         time.Time `json:"genesis_time"`
+
+	// Validators are the validators of the gentxs of the genesis.
+	Validators []ReportValidator `json:"validators"`
+
+	// Accounts is the number of accounts with a balance in the genesis.
+	Accounts int `json:"accounts"`
+
+	// Checks are the checks of the verification.
+	Checks []Check `json:"checks"`
+}
+
+// ReportValidator is a validator of the genesis.
+type ReportValidator struct {
+	Address        string `json:"address"`
+	SelfDelegation string `json:"self_delegation"`
+	Peer           string `json:"peer,omitempty"`
+}
+
+// Check is a check of the verification, it failed when it has an error.
+type Check struct {
+	Name  string `json:"name"`
+	Error string `json:"error,omitempty"`
+}
+
+// AddCheck adds the check to the report, the check failed when err is not nil.
+func (r *Report) AddCheck(name string, err error) {
+	c := Check{Name: name}
+	if err != nil {
+		c.Error = err.Error()
+	}
+	r.Checks = append(r.Checks, c)
+}
+
+// Failed returns the failed checks of the report.
+func (r Report) Failed() []Check {
+	var failed []Check
+	for _, c := range r.Checks {
+		if c.Error != "" {
+			failed = append(failed, c)
+		}
+	}
+	return failed
+}
+
+// genesisState is the part of a genesis verified against the launch.
+type genesisState struct {
+	ChainID     string    `json:"chain_id"`
+	GenesisTime time.Time `json:"genesis_time"`
+	AppState    struct {
+		Bank struct {
+			Balances []struct {
+				Address string    `json:"address"`
+				Coins   sdk.Coins `json:"coins"`
+			} `json:"balances"`
+		} `json:"bank"`
+		Genutil struct {
+			GenTxs []json.RawMessage `json:"gen_txs"`
+		} `json:"genutil"`
+	} `json:"app_state"`
+}
+
+// Verify verifies that the final genesis of the launch is the genesis template
+// with the gentxs and the accounts of the requests. The genesis and the
+// genesis template are verified with the hashes of the launch.
+func Verify(ctx context.Context, s Store, l Launch, genesis []byte) (Report, error) {
+	if !l.Launched {
+		return Report{}, fmt.Errorf("%s is not launched", l.ChainID)
+	}
+	template, err := s.Read(ctx, path.Join(l.ChainID, genesisTemplateFile))
+	if err != nil {
+		return Report{}, err
+	}
+	requests, err := Requests(ctx, s, l)
+	if err != nil {
+		return Report{}, err
+	}
+
+	r := Report{
+		ChainID:             l.ChainID,
+		GenesisHash:         Hash(genesis),
+		GenesisTemplateHash: Hash(template),
+		BinaryHash:          l.BinaryHash,
+	}
+	r.AddCheck("genesis hash", checkHash(r.GenesisHash, l.GenesisHash))
+	r.AddCheck("genesis template hash", checkHash(r.GenesisTemplateHash, l.GenesisTemplateHash))
+
+	var final, initial genesisState
+	if err := json.Unmarshal(genesis, &final); err != nil {
+		return Report{}, fmt.Errorf("invalid genesis: %w", err)
+	}
+	if err := json.Unmarshal(template, &initial); err != nil {
+		return Report{}, fmt.Errorf("invalid genesis template: %w", err)
+	}
+	r.GenesisTime = final.GenesisTime
+	r.Accounts = len(final.AppState.Bank.Balances)
+
+	if final.ChainID != l.ChainID {
+		r.AddCheck("chain ID", fmt.Errorf("the chain ID is %s instead of %s", final.ChainID, l.ChainID))
+	} else {
+		r.AddCheck("chain ID", nil)
+	}
+	if !final.GenesisTime.Equal(l.LaunchTime) {
+		r.AddCheck("genesis time", fmt.Errorf("the genesis time is %s instead of the launch time %s", final.GenesisTime, l.LaunchTime))
+	} else {
+		r.AddCheck("genesis time", nil)
+	}
+
+	for _, gentx := range final.AppState.Genutil.GenTxs {
+		info, _, err := cosmosutil.ParseGentx(gentx)
+		if err != nil {
+			return Report{}, fmt.Errorf("invalid gentx in the genesis: %w", err)
+		}
+		r.Validators = append(r.Validators, ReportValidator{
+			Address:        info.DelegatorAddress,
+			SelfDelegation: info.SelfDelegation.String(),
+			Peer:           info.Memo,
+		})
+	}
+	r.AddCheck("gentxs", checkGentxs(final.AppState.Genutil.GenTxs, requests))
+	r.AddCheck("accounts", checkAccounts(final, initial, requests))
+
+	return r, nil
+}
+
+func checkHash(hash, expected string) error {
+	if hash != expected {
+		return fmt.Errorf("the hash is %s instead of the hash of the launch %s", hash, expected)
+	}
+	return nil
+}
+
+// checkGentxs checks that the gentxs of the genesis are the gentxs of the
+// requests.
+func checkGentxs(gentxs []json.RawMessage, requests []JoinRequest) error {
+	expected := make(map[string]string)
+	for _, r := range requests {
+		key, err := canonicalJSON(r.Gentx)
+		if err != nil {
+			return fmt.Errorf("invalid gentx of request %s: %w", r.Name, err)
+		}
+		expected[key] = r.Name
+	}
+
+	var errs []string
+	for i, gentx := range gentxs {
+		key, err := canonicalJSON(gentx)
+		if err != nil {
+			return fmt.Errorf("invalid gentx %d of the genesis: %w", i, err)
+		}
+		if _, ok := expected[key]; !ok {
+			errs = append(errs, fmt.Sprintf("the gentx %d of the genesis is not the gentx of a request", i))
+			continue
+		}
+		delete(expected, key)
+	}
+	for _, name := range expected {
+		errs = append(errs, fmt.Sprintf("the gentx of request %s is not in the genesis", name))
+	}
+	return joinErrors(errs)
+}
+
+// checkAccounts checks that the balances of the genesis are the balances of
+// the genesis template and the coins of the accounts of the requests that are
+// not in the template.
+func checkAccounts(final, initial genesisState, requests []JoinRequest) error {
+	expected := make(map[string]sdk.Coins)
+	for _, b := range initial.AppState.Bank.Balances {
+		expected[b.Address] = b.Coins
+	}
+	for _, r := range requests {
+		if _, ok := expected[r.Address]; ok {
+			continue
+		}
+		coins, err := sdk.ParseCoinsNormalized(r.Coins)
+		if err != nil {
+			return fmt.Errorf("invalid coins of request %s: %w", r.Name, err)
+		}
+		expected[r.Address] = coins
+	}
+
+	var errs []string
+	for _, b := range final.AppState.Bank.Balances {
+		coins, ok := expected[b.Address]
+		switch {
+		case !ok:
+			errs = append(errs, fmt.Sprintf("the account %s is not in the template or a request", b.Address))
+		// Coins.IsEqual panics on different denoms.
+		case coins.Sort().String() != b.Coins.Sort().String():
+			errs = append(errs, fmt.Sprintf("the account %s has %s instead of %s", b.Address, b.Coins, coins))
+		}
+		delete(expected, b.Address)
+	}
+	for address := range expected {
+		errs = append(errs, fmt.Sprintf("the account %s is not in the genesis", address))
+	}
+	return joinErrors(errs)
+}
+
+// canonicalJSON returns the JSON with sorted keys and without spaces.
+func canonicalJSON(data []byte) (string, error) {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return "", err
+	}
+	canonical, err := json.Marshal(v)
+	return string(canonical), err
+}
+
+func joinErrors(errs []string) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	sort.Strings(errs)
+	return errors.New(strings.Join(errs, "; "))
+}
@@ -0,0 +1,41 @@
+package localfs
+
+// FileSystem describes the file system where a path is stored.
+type FileSystem struct {
+	// Type is the name of the file system type, e.g. "nfs" or "9p".
+	Type string
+
+	// Remote is true when the file system is a network or virtual file system, like NFS,
+	// SMB, the WSL2 Windows drives or the Docker Desktop volumes. The file modification
+	// times might not be reliable to detect changes in these file systems.
+	Remote bool
+}
+
+// remoteFileSystems are the names of the network and virtual file system types.
+var remoteFileSystems = map[string]struct{}{
+	"nfs":      {},
+	"smb":      {},
+	"smb2":     {},
+	"smbfs":    {},
+	"cifs":     {},
+	"afs":      {},
+	"afpfs":    {},
+	"coda":     {},
+	"ceph":     {},
+	"webdav":   {},
+	"9p":       {},
+	"fuse":     {},
+	"osxfuse":  {},
+	"macfuse":  {},
+	"vboxsf":   {},
+	"drvfs":    {},
+	"virtiofs": {},
+}
+
+func newFileSystem(fsType string) FileSystem {
+	_, remote := remoteFileSystems[fsType]
+	return FileSystem{
+		Type:   fsType,
+		Remote: remote,
+	}
+}
@@ -0,0 +1,12 @@
+package localfs
+
+import "golang.org/x/sys/unix"
+
+// DetectFileSystem returns the file system where the path is stored.
+func DetectFileSystem(path string) (FileSystem, error) {
+	var stat unix.Statfs_t
+	if err := unix.Statfs(path, &stat); err != nil {
+		return FileSystem{}, err
+	}
+	return newFileSystem(unix.ByteSliceToString(stat.Fstypename[:])), nil
+}
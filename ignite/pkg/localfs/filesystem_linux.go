@@ -0,0 +1,40 @@
+package localfs
+
+import "golang.org/x/sys/unix"
+
+// linuxFileSystems are the names of the file system types indexed by their magic number.
+var linuxFileSystems = map[uint32]string{
+	0xEF53:     "ext4",
+	0x9123683E: "btrfs",
+	0x58465342: "xfs",
+	0x01021994: "tmpfs",
+	0x794C7630: "overlay",
+	0x2FC12FC1: "zfs",
+	0x6969:     "nfs",
+	0x517B:     "smb",
+	0xFE534D42: "smb2",
+	0xFF534D42: "cifs",
+	0x5346414F: "afs",
+	0x73757245: "coda",
+	0x00C36400: "ceph",
+	0x01021997: "9p",
+	0x65735546: "fuse",
+	0x786F4256: "vboxsf",
+	0x53464846: "drvfs",
+	0x6A656A63: "virtiofs",
+}
+
+// DetectFileSystem returns the file system where the path is stored.
+func DetectFileSystem(path string) (FileSystem, error) {
+	var stat unix.Statfs_t
+	if err := unix.Statfs(path, &stat); err != nil {
+		return FileSystem{}, err
+	}
+
+	// The type size depends on the architecture
+	fsType, ok := linuxFileSystems[uint32(stat.Type)] //nolint:unconvert
+	if !ok {
+		fsType = "unknown"
+	}
+	return newFileSystem(fsType), nil
+}
@@ -0,0 +1,9 @@
+//go:build !linux && !darwin
+
+package localfs
+
+// DetectFileSystem returns the file system where the path is stored.
+// The file system type can't be detected in this operating system.
+func DetectFileSystem(string) (FileSystem, error) {
+	return newFileSystem("unknown"), nil
+}
@@ -0,0 +1,110 @@
+package localfs
+
+import (
+	"errors"
+	"hash/fnv"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// pollHashes calls the change hook every time the content of the files in the paths
+// changes or when files are created or removed, until the context is canceled.
+func (w *watcher) pollHashes(paths []string) error {
+	prev, err := w.hashFiles(paths)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.ctx.Done():
+			return nil
+		case <-ticker.C:
+			current, err := w.hashFiles(paths)
+			if err != nil {
+				return err
+			}
+			if !equalHashes(prev, current) {
+				w.onChange()
+			}
+			prev = current
+		}
+	}
+}
+
+// hashFiles returns the content hashes of the watched files indexed by path.
+func (w *watcher) hashFiles(paths []string) (map[string]uint64, error) {
+	hashes := make(map[string]uint64)
+	for _, path := range paths {
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(w.workdir, path)
+		}
+
+		err := filepath.WalkDir(path, func(path string, d fs.DirEntry, err error) error {
+			// Files can be removed while the paths are walked
+			if errors.Is(err, os.ErrNotExist) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if w.ignoreHidden && strings.HasPrefix(d.Name(), ".") && d.Name() != "." {
+				if d.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if d.IsDir() || w.isFileIgnored(path) {
+				return nil
+			}
+
+			h, err := hashFile(path)
+			if errors.Is(err, os.ErrNotExist) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			hashes[path] = h
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return hashes, nil
+}
+
+func hashFile(path string) (uint64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	h := fnv.New64a()
+	if _, err := io.Copy(h, f); err != nil {
+		return 0, err
+	}
+	return h.Sum64(), nil
+}
+
+func equalHashes(a, b map[string]uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for path, h := range a {
+		if bh, ok := b[path]; !ok || bh != h {
+			return false
+		}
+	}
+	return true
+}
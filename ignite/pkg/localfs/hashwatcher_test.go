@@ -0,0 +1,66 @@
+package localfs
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchHashPolling(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "x", "mars", "keeper.go")
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte("package keeper // a"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "x", "mars", "types.pb.go"), []byte("a"), 0o644))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	changes := make(chan struct{}, 10)
+	done := make(chan error)
+	go func() {
+		done <- Watch(
+			ctx,
+			[]string{"x"},
+			WatcherWorkdir(dir),
+			WatcherHashPolling(),
+			WatcherPollingInterval(10*time.Millisecond),
+			WatcherIgnoreExt("pb.go"),
+			WatcherOnChange(func() { changes <- struct{}{} }),
+		)
+	}()
+
+	// Wait for the initial hashes to be computed
+	time.Sleep(50 * time.Millisecond)
+
+	// Ignored files don't trigger changes
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "x", "mars", "types.pb.go"), []byte("b"), 0o644))
+	time.Sleep(50 * time.Millisecond)
+	require.Empty(t, changes)
+
+	// A change keeping the same size and modification time is detected
+	require.NoError(t, os.WriteFile(path, []byte("package keeper // b"), 0o644))
+	require.NoError(t, os.Chtimes(path, info.ModTime(), info.ModTime()))
+
+	select {
+	case <-changes:
+	case <-time.After(time.Second):
+		t.Fatal("change not detected")
+	}
+
+	cancel()
+	require.NoError(t, <-done)
+}
+
+func TestDetectFileSystem(t *testing.T) {
+	fs, err := DetectFileSystem(t.TempDir())
+	require.NoError(t, err)
+	require.NotEmpty(t, fs.Type)
+}
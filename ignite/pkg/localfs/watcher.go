@@ -18,6 +18,7 @@ type watcher struct {
 	ignoreHidden  bool
 	ignoreFolders bool
 	ignoreExts    []string
+	hashPolling   bool
 	onChange      func()
 	interval      time.Duration
 	ctx           context.Context
@@ -68,6 +69,15 @@ func WatcherIgnoreExt(exts ...string) WatcherOption {
 	}
 }
 
+// WatcherHashPolling detects changes comparing the content hash of the files instead of
+// their modification time and size. This is slower but it detects the changes made on
+// network or virtual file systems where the modification time is not reliable.
+func WatcherHashPolling() WatcherOption {
+	return func(w *watcher) {
+		w.hashPolling = true
+	}
+}
+
 // Watch starts watching changes on the paths. options are used to configure the
 // behaviour of watch operation.
 func Watch(ctx context.Context, paths []string, options ...WatcherOption) error {
@@ -84,6 +94,10 @@ func Watch(ctx context.Context, paths []string, options ...WatcherOption) error
 		o(w)
 	}
 
+	if w.hashPolling {
+		return w.pollHashes(paths)
+	}
+
 	w.wt.AddFilterHook(func(info os.FileInfo, fullPath string) error {
 		if info.IsDir() && w.ignoreFolders {
 			return wt.ErrSkip
@@ -0,0 +1,164 @@
+// Package localnet writes the definitions of the processes of a local network
+// of the nodes of a chain.
+package localnet
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+const (
+	// PortStep is the difference between the ports of consecutive nodes, the
+	// nodes listen on the ports of the chain config increased by the step
+	// times their index so they run on the same host.
+	PortStep = 10
+
+	// ComposeFile is the file of the Docker Compose definition of a network.
+	ComposeFile = "docker-compose.yml"
+
+	// Procfile is the file of the process supervisor definition of a network,
+	// it's read by process managers like foreman, honcho and overmind.
+	Procfile = "Procfile"
+
+	// DefaultImage is the image of the containers of the nodes, the binary of
+	// the chain is mounted in the containers.
+	DefaultImage = "debian:bookworm-slim"
+
+	// CosmovisorDir is the directory of the binaries of cosmovisor in the home
+	// of a node.
+	CosmovisorDir = "cosmovisor"
+
+	peerHost = "127.0.0.1"
+)
+
+// Node is a node of a local network.
+type Node struct {
+	// Name is the name of the node and of its home directory.
+	Name string
+
+	// Home is the home directory of the node.
+	Home string
+
+	// ID is the ID of the node derived from its node key.
+	ID string
+
+	// Validator is true when the node signs blocks with a validator key of
+	// the genesis.
+	Validator bool
+
+	// P2PAddress, RPCAddress, GRPCAddress and APIAddress are the listen
+	// addresses of the servers of the node.
+	P2PAddress  string
+	RPCAddress  string
+	GRPCAddress string
+	APIAddress  string
+}
+
+// PersistentPeers returns the persistent peers of the node with the name, all
+// the other nodes of the network.
+func PersistentPeers(nodes []Node, name string) (string, error) {
+	var peers []string
+	for _, n := range nodes {
+		if n.Name == name {
+			continue
+		}
+		_, port, err := net.SplitHostPort(n.P2PAddress)
+		if err != nil {
+			return "", fmt.Errorf("invalid p2p address %s of node %s: %w", n.P2PAddress, n.Name, err)
+		}
+		peers = append(peers, fmt.Sprintf("%s@%s", n.ID, net.JoinHostPort(peerHost, port)))
+	}
+	return strings.Join(peers, ","), nil
+}
+
+// CosmovisorEnv returns the environment of cosmovisor running the binary
+// with the name in the home of the node, the node restarts with the binary of
+// an upgrade staged in the home when the upgrade height is reached.
+func CosmovisorEnv(binaryName string, n Node) []string {
+	return []string{
+		"DAEMON_NAME=" + binaryName,
+		"DAEMON_HOME=" + n.Home,
+		"DAEMON_ALLOW_DOWNLOAD_BINARIES=false",
+		"DAEMON_RESTART_AFTER_UPGRADE=true",
+	}
+}
+
+var composeTemplate = template.Must(template.New(ComposeFile).Funcs(templateFuncs).Parse(`# Docker Compose definition of the {{ .ChainID }} local network.
+# Start the network with: docker compose -f {{ .Path }} up
+services:
+{{- range .Nodes }}
+  {{ .Name }}:
+    image: {{ $.Image }}
+    network_mode: host
+    volumes:
+      - {{ $.Binary }}:/usr/local/bin/{{ $.BinaryName }}:ro
+{{- if $.Cosmovisor }}
+      - {{ $.Cosmovisor }}:/usr/local/bin/cosmovisor:ro
+{{- end }}
+      - {{ .Home }}:{{ .Home }}
+{{- if $.Cosmovisor }}
+    environment:
+{{- range cosmovisorEnv $.BinaryName . }}
+      - {{ . }}
+{{- end }}
+    command: ["cosmovisor", "run", "start", "--home", "{{ .Home }}"]
+{{- else }}
+    command: ["{{ $.BinaryName }}", "start", "--home", "{{ .Home }}"]
+{{- end }}
+{{- end }}
+`))
+
+var procfileTemplate = template.Must(template.New(Procfile).Funcs(templateFuncs).Parse(`# Process supervisor definition of the {{ .ChainID }} local network.
+# Start the network with: foreman start -f {{ .Path }}
+{{- range .Nodes }}
+{{- if $.Cosmovisor }}
+{{ .Name }}: env {{ join (cosmovisorEnv $.BinaryName .) " " }} {{ $.Cosmovisor }} run start --home {{ .Home }}
+{{- else }}
+{{ .Name }}: {{ $.Binary }} start --home {{ .Home }}
+{{- end }}
+{{- end }}
+`))
+
+var templateFuncs = template.FuncMap{
+	"cosmovisorEnv": CosmovisorEnv,
+	"join":          strings.Join,
+}
+
+// WriteDefinitions writes in dir the Docker Compose and the process supervisor
+// definitions of the network, the nodes run the binary and share the network
+// of the host. The nodes run with the cosmovisor binary when it's not empty,
+// the binary must be in the cosmovisor directory of the homes of the nodes.
+func WriteDefinitions(dir, chainID, binary, cosmovisor string, nodes []Node) error {
+	for name, t := range map[string]*template.Template{
+		ComposeFile: composeTemplate,
+		Procfile:    procfileTemplate,
+	} {
+		path := filepath.Join(dir, name)
+		if err := writeTemplate(path, t, map[string]interface{}{
+			"ChainID":    chainID,
+			"Path":       path,
+			"Image":      DefaultImage,
+			"Binary":     binary,
+			"BinaryName": filepath.Base(binary),
+			"Cosmovisor": cosmovisor,
+			"Nodes":      nodes,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeTemplate(path string, t *template.Template, data interface{}) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return t.Execute(f, data)
+}
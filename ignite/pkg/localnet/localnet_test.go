@@ -0,0 +1,100 @@
+package localnet
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testNodes(dir string) []Node {
+	return []Node{
+		{
+			Name:       "validator-1",
+			Home:       filepath.Join(dir, "validator-1"),
+			ID:         "a1",
+			Validator:  true,
+			P2PAddress: "0.0.0.0:26656",
+		},
+		{
+			Name:       "validator-2",
+			Home:       filepath.Join(dir, "validator-2"),
+			ID:         "b2",
+			Validator:  true,
+			P2PAddress: "0.0.0.0:26666",
+		},
+		{
+			Name:       "full-node-1",
+			Home:       filepath.Join(dir, "full-node-1"),
+			ID:         "c3",
+			P2PAddress: "0.0.0.0:26676",
+		},
+	}
+}
+
+func TestPersistentPeers(t *testing.T) {
+	nodes := testNodes("testnet")
+
+	peers, err := PersistentPeers(nodes, "validator-2")
+	require.NoError(t, err)
+	require.Equal(t, "a1@127.0.0.1:26656,c3@127.0.0.1:26676", peers)
+
+	nodes[0].P2PAddress = "26656"
+	_, err = PersistentPeers(nodes, "validator-2")
+	require.Error(t, err)
+}
+
+func TestWriteDefinitions(t *testing.T) {
+	dir := t.TempDir()
+	nodes := testNodes(dir)
+
+	require.NoError(t, WriteDefinitions(dir, "mars", "/go/bin/marsd", "", nodes))
+
+	compose, err := os.ReadFile(filepath.Join(dir, ComposeFile))
+	require.NoError(t, err)
+	require.Contains(t, string(compose), `
+  validator-2:
+    image: debian:bookworm-slim
+    network_mode: host
+    volumes:
+      - /go/bin/marsd:/usr/local/bin/marsd:ro
+      - `+nodes[1].Home+`:`+nodes[1].Home+`
+    command: ["marsd", "start", "--home", "`+nodes[1].Home+`"]
+`)
+
+	procfile, err := os.ReadFile(filepath.Join(dir, Procfile))
+	require.NoError(t, err)
+	require.Contains(t, string(procfile), "\nfull-node-1: /go/bin/marsd start --home "+nodes[2].Home+"\n")
+}
+
+func TestWriteDefinitionsWithCosmovisor(t *testing.T) {
+	dir := t.TempDir()
+	nodes := testNodes(dir)
+
+	require.NoError(t, WriteDefinitions(dir, "mars", "/go/bin/marsd", "/go/bin/cosmovisor", nodes))
+
+	home := nodes[1].Home
+	compose, err := os.ReadFile(filepath.Join(dir, ComposeFile))
+	require.NoError(t, err)
+	require.Contains(t, string(compose), `
+  validator-2:
+    image: debian:bookworm-slim
+    network_mode: host
+    volumes:
+      - /go/bin/marsd:/usr/local/bin/marsd:ro
+      - /go/bin/cosmovisor:/usr/local/bin/cosmovisor:ro
+      - `+home+`:`+home+`
+    environment:
+      - DAEMON_NAME=marsd
+      - DAEMON_HOME=`+home+`
+      - DAEMON_ALLOW_DOWNLOAD_BINARIES=false
+      - DAEMON_RESTART_AFTER_UPGRADE=true
+    command: ["cosmovisor", "run", "start", "--home", "`+home+`"]
+`)
+
+	procfile, err := os.ReadFile(filepath.Join(dir, Procfile))
+	require.NoError(t, err)
+	require.Contains(t, string(procfile), "\nvalidator-2: env DAEMON_NAME=marsd DAEMON_HOME="+home+
+		" DAEMON_ALLOW_DOWNLOAD_BINARIES=false DAEMON_RESTART_AFTER_UPGRADE=true /go/bin/cosmovisor run start --home "+home+"\n")
+}
@@ -0,0 +1,282 @@
+// Package notary signs the artifacts of a blockchain project, like its genesis
+// files, its upgrade plans and its release binaries, with an Ed25519 project
+// key. The signatures are written alongside the artifacts so the audit trails
+// of a project can verify who produced an artifact and that it wasn't changed.
+package notary
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	pemTypePrivateKey = "PRIVATE KEY"
+	pemTypePublicKey  = "PUBLIC KEY"
+
+	// SignatureExt is the extension of the signature files, they are written
+	// next to their artifact.
+	SignatureExt = ".sig"
+
+	// signatureVersion is the version of the signed message format.
+	signatureVersion = "ignite-artifact-v1"
+)
+
+var (
+	// ErrInvalidSignature is returned when a signature doesn't match its artifact
+	// or is not signed by the trusted key.
+	ErrInvalidSignature = errors.New("invalid artifact signature")
+
+	// ErrUntrustedKey is returned when an artifact is signed by another key than
+	// the trusted key.
+	ErrUntrustedKey = errors.New("artifact signed by an untrusted key")
+)
+
+// Key is a PEM encoded Ed25519 private key of a project.
+type Key []byte
+
+// NewKey creates a new project key.
+func NewKey() (Key, error) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		return nil, err
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: pemTypePrivateKey, Bytes: der}), nil
+}
+
+// LoadKey reads a PEM encoded project key.
+func LoadKey(path string) (Key, error) {
+	k, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := Key(k).parse(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return k, nil
+}
+
+// Save writes the key to keyPath, only readable by the user, and its public key
+// to pubKeyPath.
+func (k Key) Save(keyPath, pubKeyPath string) error {
+	pub, err := k.PublicKey()
+	if err != nil {
+		return err
+	}
+
+	for _, path := range []string{keyPath, pubKeyPath} {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+	}
+	if err := os.WriteFile(pubKeyPath, pub, 0o644); err != nil {
+		return err
+	}
+	return os.WriteFile(keyPath, k, 0o600)
+}
+
+// PublicKey returns the PEM encoded public key of the key.
+func (k Key) PublicKey() ([]byte, error) {
+	priv, err := k.parse()
+	if err != nil {
+		return nil, err
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(priv.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: pemTypePublicKey, Bytes: der}), nil
+}
+
+func (k Key) parse() (ed25519.PrivateKey, error) {
+	block, _ := pem.Decode(k)
+	if block == nil || block.Type != pemTypePrivateKey {
+		return nil, errors.New("invalid PEM private key")
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	priv, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, errors.New("not an Ed25519 private key")
+	}
+	return priv, nil
+}
+
+// LoadPublicKey reads a PEM encoded public key.
+func LoadPublicKey(path string) (ed25519.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	pub, err := parsePublicKey(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return pub, nil
+}
+
+func parsePublicKey(data []byte) (ed25519.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != pemTypePublicKey {
+		return nil, errors.New("invalid PEM public key")
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	pub, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, errors.New("not an Ed25519 public key")
+	}
+	return pub, nil
+}
+
+// Signature is the content of the signature file of an artifact.
+type Signature struct {
+	// Artifact is the file name of the artifact.
+	Artifact string `json:"artifact"`
+
+	// SHA256 is the hex encoded SHA-256 hash of the artifact.
+	SHA256 string `json:"sha256"`
+
+	// SignedAt is the time of the signature.
+	SignedAt time.Time `json:"signed_at"`
+
+	// PublicKey is the PEM encoded public key of the signer.
+	PublicKey string `json:"public_key"`
+
+	// Signature is the Ed25519 signature of the artifact name, its hash and
+	// the time of the signature.
+	Signature []byte `json:"signature"`
+}
+
+// message returns the signed message of the signature.
+func (s Signature) message() []byte {
+	return []byte(fmt.Sprintf(
+		"%s\n%s\n%s\n%s",
+		signatureVersion,
+		s.Artifact,
+		s.SHA256,
+		s.SignedAt.UTC().Format(time.RFC3339),
+	))
+}
+
+// SignaturePath returns the path of the signature file of an artifact.
+func SignaturePath(artifactPath string) string {
+	return artifactPath + SignatureExt
+}
+
+// Sign hashes and signs the artifact with the key and writes the signature file
+// next to the artifact. It returns the path of the signature file.
+func Sign(key Key, artifactPath string) (string, error) {
+	priv, err := key.parse()
+	if err != nil {
+		return "", err
+	}
+
+	pub, err := key.PublicKey()
+	if err != nil {
+		return "", err
+	}
+
+	hash, err := hashFile(artifactPath)
+	if err != nil {
+		return "", err
+	}
+
+	s := Signature{
+		Artifact:  filepath.Base(artifactPath),
+		SHA256:    hash,
+		SignedAt:  time.Now().UTC().Truncate(time.Second),
+		PublicKey: string(pub),
+	}
+	s.Signature = ed25519.Sign(priv, s.message())
+
+	content, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	path := SignaturePath(artifactPath)
+	if err := os.WriteFile(path, append(content, '\n'), 0o644); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+// Verify verifies that the signature file at sigPath signs the artifact, and
+// that it is signed by the trusted public key.
+func Verify(artifactPath, sigPath string, trusted ed25519.PublicKey) (Signature, error) {
+	content, err := os.ReadFile(sigPath)
+	if err != nil {
+		return Signature{}, err
+	}
+
+	var s Signature
+	if err := json.Unmarshal(content, &s); err != nil {
+		return Signature{}, fmt.Errorf("%s: %w", sigPath, err)
+	}
+
+	pub, err := parsePublicKey([]byte(s.PublicKey))
+	if err != nil {
+		return Signature{}, fmt.Errorf("%s: %w", sigPath, err)
+	}
+	if !pub.Equal(trusted) {
+		return s, ErrUntrustedKey
+	}
+	if !ed25519.Verify(pub, s.message(), s.Signature) {
+		return s, fmt.Errorf("%w: the signature doesn't match its content", ErrInvalidSignature)
+	}
+
+	hash, err := hashFile(artifactPath)
+	if err != nil {
+		return s, err
+	}
+	if hash != s.SHA256 {
+		return s, fmt.Errorf("%w: the artifact was changed since it was signed", ErrInvalidSignature)
+	}
+
+	return s, nil
+}
+
+// hashFile returns the hex encoded SHA-256 hash of the file.
+func hashFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
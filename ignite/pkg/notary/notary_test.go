@@ -0,0 +1,57 @@
+package notary_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/notary"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	var (
+		dir        = t.TempDir()
+		keyPath    = filepath.Join(dir, "key.pem")
+		pubKeyPath = filepath.Join(dir, "key.pub.pem")
+		artifact   = filepath.Join(dir, "genesis.json")
+	)
+
+	key, err := notary.NewKey()
+	require.NoError(t, err)
+	require.NoError(t, key.Save(keyPath, pubKeyPath))
+
+	key, err = notary.LoadKey(keyPath)
+	require.NoError(t, err)
+	pub, err := notary.LoadPublicKey(pubKeyPath)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(artifact, []byte(`{"chain_id":"mars"}`), 0o644))
+
+	sigPath, err := notary.Sign(key, artifact)
+	require.NoError(t, err)
+	require.Equal(t, artifact+".sig", sigPath)
+
+	s, err := notary.Verify(artifact, sigPath, pub)
+	require.NoError(t, err)
+	require.Equal(t, "genesis.json", s.Artifact)
+	require.Equal(t, "ad3da82f64b542869f9a5ae54cf0c4baf0f9df30606444c1c91d4499ee78252e", s.SHA256)
+
+	// Another key is not trusted
+	other, err := notary.NewKey()
+	require.NoError(t, err)
+	otherPub, err := other.PublicKey()
+	require.NoError(t, err)
+	otherPubPath := filepath.Join(dir, "other.pub.pem")
+	require.NoError(t, os.WriteFile(otherPubPath, otherPub, 0o644))
+	untrusted, err := notary.LoadPublicKey(otherPubPath)
+	require.NoError(t, err)
+	_, err = notary.Verify(artifact, sigPath, untrusted)
+	require.ErrorIs(t, err, notary.ErrUntrustedKey)
+
+	// A changed artifact doesn't match its signature
+	require.NoError(t, os.WriteFile(artifact, []byte(`{"chain_id":"venus"}`), 0o644))
+	_, err = notary.Verify(artifact, sigPath, pub)
+	require.ErrorIs(t, err, notary.ErrInvalidSignature)
+}
@@ -0,0 +1,350 @@
+// Package openapi combines the Swagger 2.0 specs generated for the modules of
+// a chain into a single OpenAPI 3.0 spec.
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+)
+
+const (
+	// Version is the OpenAPI version of the combined specs.
+	Version = "3.0.0"
+
+	definitionsRef = "#/definitions/"
+	schemasRef     = "#/components/schemas/"
+	jsonMediaType  = "application/json"
+)
+
+// Config configures the combination of specs.
+type Config struct {
+	// Title is the title of the combined spec.
+	Title string
+
+	// Description is the description of the combined spec.
+	Description string
+
+	// APIs are the specs to combine, the paths of the first specs have
+	// precedence over the conflicting paths of the next ones.
+	APIs []API
+}
+
+// API is a Swagger 2.0 spec to combine.
+type API struct {
+	// ID is the unique ID of the spec, it prefixes the operation IDs of the
+	// spec and names the tag of its operations.
+	ID string
+
+	// Spec is the Swagger 2.0 spec.
+	Spec map[string]interface{}
+}
+
+// AddSpec adds the Swagger 2.0 spec at path in the fs to Config with the
+// unique id of the spec.
+func (c *Config) AddSpec(id, path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var spec map[string]interface{}
+	if err := json.Unmarshal(content, &spec); err != nil {
+		return fmt.Errorf("invalid spec %s: %w", path, err)
+	}
+	if v, _ := spec["swagger"].(string); v != "2.0" {
+		return fmt.Errorf("spec %s is not a Swagger 2.0 spec", path)
+	}
+
+	c.APIs = append(c.APIs, API{ID: id, Spec: spec})
+
+	return nil
+}
+
+// Merge merges the specs of the config into a single OpenAPI 3.0 spec.
+//
+// The definitions that are shared by several specs are only added once, the
+// definitions with the same name but different schemas are renamed with the
+// ID of their spec as prefix.
+func Merge(c Config) map[string]interface{} {
+	var (
+		paths   = make(map[string]interface{})
+		schemas = make(map[string]interface{})
+		tags    []interface{}
+	)
+
+	for _, api := range c.APIs {
+		definitions, _ := api.Spec["definitions"].(map[string]interface{})
+
+		rename := conflictingDefinitions(api.ID, definitions, schemas)
+		for _, name := range sortedKeys(definitions) {
+			merged := name
+			if n, ok := rename[name]; ok {
+				merged = n
+			}
+			if _, ok := schemas[merged]; !ok {
+				schemas[merged] = convertSchema(definitions[name], rename)
+			}
+		}
+
+		specPaths, _ := api.Spec["paths"].(map[string]interface{})
+		if len(specPaths) == 0 {
+			continue
+		}
+		tags = append(tags, map[string]interface{}{"name": api.ID})
+
+		for _, path := range sortedKeys(specPaths) {
+			item, _ := specPaths[path].(map[string]interface{})
+			merged, ok := paths[path].(map[string]interface{})
+			if !ok {
+				merged = make(map[string]interface{})
+				paths[path] = merged
+			}
+
+			for method, op := range item {
+				if _, ok := merged[method]; ok {
+					continue
+				}
+				if op, ok := op.(map[string]interface{}); ok {
+					merged[method] = convertOperation(api.ID, op, rename)
+				}
+			}
+		}
+	}
+
+	info := map[string]interface{}{
+		"title":   c.Title,
+		"version": "version not set",
+	}
+	if c.Description != "" {
+		info["description"] = c.Description
+	}
+
+	return map[string]interface{}{
+		"openapi":    Version,
+		"info":       info,
+		"tags":       tags,
+		"paths":      paths,
+		"components": map[string]interface{}{"schemas": schemas},
+	}
+}
+
+// Combine merges the specs of the config into a single OpenAPI 3.0 spec and
+// saves it to out, in YAML or in JSON when out has a .json extension.
+func Combine(c Config, out string) error {
+	content, err := json.Marshal(Merge(c))
+	if err != nil {
+		return err
+	}
+
+	if filepath.Ext(out) != ".json" {
+		if content, err = yaml.JSONToYAML(content); err != nil {
+			return err
+		}
+	}
+
+	return os.WriteFile(out, content, 0o644)
+}
+
+// convertOperation converts a Swagger 2.0 operation to OpenAPI 3.0.
+func convertOperation(id string, op map[string]interface{}, rename map[string]string) map[string]interface{} {
+	converted := map[string]interface{}{"tags": []interface{}{id}}
+	for k, v := range op {
+		switch k {
+		case "parameters", "responses", "consumes", "produces", "schemes", "tags":
+		case "operationId":
+			converted[k] = fmt.Sprintf("%s%s", id, v)
+		default:
+			converted[k] = v
+		}
+	}
+
+	var params []interface{}
+	parameters, _ := op["parameters"].([]interface{})
+	for _, p := range parameters {
+		p, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if p["in"] == "body" {
+			body := map[string]interface{}{
+				"content": map[string]interface{}{
+					jsonMediaType: map[string]interface{}{"schema": convertSchema(p["schema"], rename)},
+				},
+			}
+			if r, ok := p["required"]; ok {
+				body["required"] = r
+			}
+			if d, ok := p["description"]; ok {
+				body["description"] = d
+			}
+			converted["requestBody"] = body
+			continue
+		}
+
+		params = append(params, convertParameter(p, rename))
+	}
+	if len(params) > 0 {
+		converted["parameters"] = params
+	}
+
+	responses := make(map[string]interface{})
+	specResponses, _ := op["responses"].(map[string]interface{})
+	for code, r := range specResponses {
+		r, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		response := map[string]interface{}{"description": r["description"]}
+		if response["description"] == nil {
+			response["description"] = ""
+		}
+		if schema, ok := r["schema"]; ok {
+			response["content"] = map[string]interface{}{
+				jsonMediaType: map[string]interface{}{"schema": convertSchema(schema, rename)},
+			}
+		}
+		responses[code] = response
+	}
+	converted["responses"] = responses
+
+	return converted
+}
+
+// parameterSchemaKeys are the keys of a Swagger 2.0 parameter that are part of
+// the schema of the parameter in OpenAPI 3.0.
+var parameterSchemaKeys = map[string]bool{
+	"type":    true,
+	"format":  true,
+	"items":   true,
+	"enum":    true,
+	"default": true,
+	"minimum": true,
+	"maximum": true,
+	"pattern": true,
+}
+
+// convertParameter converts a Swagger 2.0 non body parameter to OpenAPI 3.0.
+func convertParameter(p map[string]interface{}, rename map[string]string) map[string]interface{} {
+	var (
+		converted = make(map[string]interface{})
+		schema    = make(map[string]interface{})
+	)
+	for k, v := range p {
+		switch {
+		case k == "collectionFormat":
+			// Repeated query parameters are written once for each value
+			converted["explode"] = v == "multi"
+			if v == "multi" || v == "csv" {
+				converted["style"] = "form"
+			}
+		case parameterSchemaKeys[k]:
+			schema[k] = convertSchema(v, rename)
+		default:
+			converted[k] = v
+		}
+	}
+	converted["schema"] = schema
+
+	return converted
+}
+
+// conflictingDefinitions returns the new names of the definitions of the API
+// that conflict with the already merged schemas, the names are prefixed by the
+// ID of the API. A definition that references a renamed definition can
+// conflict in turn, the definitions are compared again until no new conflict
+// is found, in the order of their names.
+func conflictingDefinitions(id string, definitions, schemas map[string]interface{}) map[string]string {
+	var (
+		names  = sortedKeys(definitions)
+		rename = make(map[string]string)
+	)
+	for found := true; found; {
+		found = false
+		for _, name := range names {
+			if _, ok := rename[name]; ok {
+				continue
+			}
+			existing, ok := schemas[name]
+			if ok && !sameSchema(existing, convertSchema(definitions[name], rename)) {
+				rename[name] = id + "." + name
+				found = true
+			}
+		}
+	}
+	return rename
+}
+
+// convertSchema returns a copy of a Swagger 2.0 schema with the references to
+// definitions replaced by references to the OpenAPI 3.0 component schemas,
+// the renamed definitions are referenced by their new names.
+func convertSchema(v interface{}, rename map[string]string) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		converted := make(map[string]interface{}, len(v))
+		for k, value := range v {
+			if ref, ok := value.(string); k == "$ref" && ok && strings.HasPrefix(ref, definitionsRef) {
+				name := strings.TrimPrefix(ref, definitionsRef)
+				if n, ok := rename[name]; ok {
+					name = n
+				}
+				converted[k] = schemasRef + name
+				continue
+			}
+			converted[k] = convertSchema(value, rename)
+		}
+		return converted
+	case []interface{}:
+		converted := make([]interface{}, len(v))
+		for i, value := range v {
+			converted[i] = convertSchema(value, rename)
+		}
+		return converted
+	}
+	return v
+}
+
+// sameSchema checks if two schemas are the same regardless of their docs,
+// the comments of a proto message can change between versions of a module.
+func sameSchema(a, b interface{}) bool {
+	return reflect.DeepEqual(withoutDocs(a), withoutDocs(b))
+}
+
+func withoutDocs(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		stripped := make(map[string]interface{}, len(v))
+		for k, value := range v {
+			// Skip the docs but not the properties named description or title
+			if _, ok := value.(string); ok && (k == "description" || k == "title") {
+				continue
+			}
+			stripped[k] = withoutDocs(value)
+		}
+		return stripped
+	case []interface{}:
+		stripped := make([]interface{}, len(v))
+		for i, value := range v {
+			stripped[i] = withoutDocs(value)
+		}
+		return stripped
+	}
+	return v
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,138 @@
+package openapi_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/openapi"
+)
+
+func TestCombine(t *testing.T) {
+	var conf openapi.Config
+	conf.Title = "HTTP API Console"
+	require.NoError(t, conf.AddSpec("Mars", "testdata/mars.swagger.json"))
+	require.NoError(t, conf.AddSpec("Venus", "testdata/venus.swagger.json"))
+
+	out := filepath.Join(t.TempDir(), "openapi.yml")
+	require.NoError(t, openapi.Combine(conf, out))
+
+	content, err := os.ReadFile(out)
+	require.NoError(t, err)
+
+	var spec struct {
+		OpenAPI string                                       `json:"openapi"`
+		Tags    []map[string]string                          `json:"tags"`
+		Paths   map[string]map[string]map[string]interface{} `json:"paths"`
+		Comps   struct {
+			Schemas map[string]interface{} `json:"schemas"`
+		} `json:"components"`
+	}
+	require.NoError(t, yaml.Unmarshal(content, &spec))
+
+	require.Equal(t, openapi.Version, spec.OpenAPI)
+	require.Equal(t, []map[string]string{{"name": "Mars"}, {"name": "Venus"}}, spec.Tags)
+
+	// The paths of the first spec have precedence
+	posts := spec.Paths["/mars/mars/posts"]["get"]
+	require.Equal(t, "MarsPosts", posts["operationId"])
+	require.Equal(t, []interface{}{"Mars"}, posts["tags"])
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			"name":     "ids",
+			"in":       "query",
+			"required": false,
+			"style":    "form",
+			"explode":  true,
+			"schema": map[string]interface{}{
+				"type":  "array",
+				"items": map[string]interface{}{"type": "string", "format": "uint64"},
+			},
+		},
+	}, posts["parameters"])
+	require.Equal(t, map[string]interface{}{
+		"description": "A successful response.",
+		"content": map[string]interface{}{
+			"application/json": map[string]interface{}{
+				"schema": map[string]interface{}{"$ref": "#/components/schemas/mars.mars.QueryPostsResponse"},
+			},
+		},
+	}, posts["responses"].(map[string]interface{})["200"])
+
+	// The body parameters are converted to request bodies
+	create := spec.Paths["/mars/mars/posts"]["post"]
+	require.NotContains(t, create, "parameters")
+	require.Equal(t, map[string]interface{}{
+		"required": true,
+		"content": map[string]interface{}{
+			"application/json": map[string]interface{}{
+				"schema": map[string]interface{}{"$ref": "#/components/schemas/mars.mars.Post"},
+			},
+		},
+	}, create["requestBody"])
+
+	// The shared definitions are deduped and the conflicting ones renamed
+	require.Len(t, spec.Comps.Schemas, 4)
+	require.Contains(t, spec.Comps.Schemas, "google.rpc.Status")
+	require.Contains(t, spec.Comps.Schemas, "Venus.mars.mars.Post")
+	venusPosts := spec.Paths["/venus/posts"]["get"]
+	require.Equal(t, "VenusPosts", venusPosts["operationId"])
+	require.Equal(t,
+		map[string]interface{}{"$ref": "#/components/schemas/Venus.mars.mars.Post"},
+		venusPosts["responses"].(map[string]interface{})["200"].(map[string]interface{})["content"].(map[string]interface{})["application/json"].(map[string]interface{})["schema"],
+	)
+	require.Equal(t,
+		map[string]interface{}{"$ref": "#/components/schemas/google.rpc.Status"},
+		venusPosts["responses"].(map[string]interface{})["default"].(map[string]interface{})["content"].(map[string]interface{})["application/json"].(map[string]interface{})["schema"],
+	)
+}
+
+func TestAddSpecInvalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "openapi.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"openapi":"3.0.0"}`), 0o644))
+
+	var conf openapi.Config
+	require.Error(t, conf.AddSpec("Mars", path))
+}
+
+func TestMergeRenamesReferencingDefinitions(t *testing.T) {
+	spec := func(userProperties map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"definitions": map[string]interface{}{
+				"Post": map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"author": map[string]interface{}{"$ref": "#/definitions/User"},
+					},
+				},
+				"User": map[string]interface{}{
+					"type":       "object",
+					"properties": userProperties,
+				},
+			},
+		}
+	}
+	conf := openapi.Config{
+		APIs: []openapi.API{
+			{ID: "Mars", Spec: spec(map[string]interface{}{"name": map[string]interface{}{"type": "string"}})},
+			{ID: "Venus", Spec: spec(map[string]interface{}{"id": map[string]interface{}{"type": "string"}})},
+		},
+	}
+
+	// the merge doesn't depend on the iteration order of the definitions.
+	merged := openapi.Merge(conf)
+	for i := 0; i < 20; i++ {
+		require.Equal(t, merged, openapi.Merge(conf))
+	}
+
+	// Post references the conflicting User, it conflicts too.
+	schemas := merged["components"].(map[string]interface{})["schemas"].(map[string]interface{})
+	require.Len(t, schemas, 4)
+	require.Equal(t,
+		map[string]interface{}{"$ref": "#/components/schemas/Venus.User"},
+		schemas["Venus.Post"].(map[string]interface{})["properties"].(map[string]interface{})["author"],
+	)
+}
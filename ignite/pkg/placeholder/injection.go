@@ -0,0 +1,39 @@
+package placeholder
+
+import (
+	"strings"
+	"sync"
+)
+
+// Injection injects a snippet in the content of a file without relying on a
+// placeholder, for example by resolving the injection point from the syntax
+// tree of the file. all is true when the snippet is injected at every point
+// that the placeholder would have matched.
+type Injection func(content, snippet string, all bool) (string, error)
+
+var (
+	injectionsMu sync.RWMutex
+	injections   = make(map[string]Injection)
+)
+
+// RegisterInjection registers the injection used by the tracers when the
+// placeholder is missing from the content of a file, so the scaffolding still
+// works once the placeholder is removed or the file is reorganized.
+func RegisterInjection(placeholder string, inject Injection) {
+	injectionsMu.Lock()
+	defer injectionsMu.Unlock()
+	injections[placeholder] = inject
+}
+
+func lookupInjection(placeholder string) (Injection, bool) {
+	injectionsMu.RLock()
+	defer injectionsMu.RUnlock()
+	inject, ok := injections[placeholder]
+	return inject, ok
+}
+
+// snippet returns the code of the replacement of a placeholder without the
+// placeholder itself.
+func snippet(placeholder, replacement string) string {
+	return strings.TrimSpace(strings.Replace(replacement, placeholder, "", 1))
+}
@@ -1,6 +1,8 @@
 package placeholder
 
 import (
+	"fmt"
+	"sort"
 	"strings"
 )
 
@@ -32,7 +34,7 @@ func WithAdditionalInfo(info string) Option {
 
 // New instantiates Session with provided options.
 func New(opts ...Option) *Tracer {
-	s := &Tracer{missing: iterableStringSet{}}
+	s := &Tracer{missing: iterableStringSet{}, used: iterableStringSet{}}
 	for _, opt := range opts {
 		opt(s)
 	}
@@ -49,6 +51,7 @@ type Replacer interface {
 // Tracer keeps track of missing placeholders or other issues related to file modification.
 type Tracer struct {
 	missing        iterableStringSet
+	used           iterableStringSet
 	miscErrors     []string
 	additionalInfo string
 }
@@ -56,9 +59,9 @@ type Tracer struct {
 // ReplaceAll replace all placeholders in content with replacement string.
 func (t *Tracer) ReplaceAll(content, placeholder, replacement string) string {
 	if strings.Count(content, placeholder) == 0 {
-		t.missing.Add(placeholder)
-		return content
+		return t.inject(content, placeholder, replacement, true)
 	}
+	t.used.Add(placeholder)
 	return strings.ReplaceAll(content, placeholder, replacement)
 }
 
@@ -67,9 +70,9 @@ func (t *Tracer) Replace(content, placeholder, replacement string) string {
 	// NOTE(dshulyak) we will count twice. once here and second time in strings.Replace
 	// if it turns out to be an issue, copy the code from strings.Replace.
 	if strings.Count(content, placeholder) == 0 {
-		t.missing.Add(placeholder)
-		return content
+		return t.inject(content, placeholder, replacement, false)
 	}
+	t.used.Add(placeholder)
 	return strings.Replace(content, placeholder, replacement, 1)
 }
 
@@ -81,11 +84,45 @@ func (t *Tracer) ReplaceOnce(content, placeholder, replacement string) string {
 	return content
 }
 
+// inject injects the replacement of a missing placeholder with the injection
+// registered for the placeholder. The placeholder is reported as missing when
+// there is no injection or when the injection point cannot be resolved.
+func (t *Tracer) inject(content, placeholder, replacement string, all bool) string {
+	inject, ok := lookupInjection(placeholder)
+	if !ok {
+		t.missing.Add(placeholder)
+		return content
+	}
+	code := snippet(placeholder, replacement)
+	if code == "" {
+		t.used.Add(placeholder)
+		return content
+	}
+	injected, err := inject(content, code, all)
+	if err != nil {
+		t.missing.Add(placeholder)
+		t.AppendMiscError(fmt.Sprintf("%s: %s", placeholder, err))
+		return content
+	}
+	t.used.Add(placeholder)
+	return injected
+}
+
 // AppendMiscError allows to track errors not related to missing placeholders during file modification
 func (t *Tracer) AppendMiscError(miscError string) {
 	t.miscErrors = append(t.miscErrors, miscError)
 }
 
+// Used returns the sorted placeholders that were replaced during execution.
+func (t *Tracer) Used() []string {
+	used := make([]string, 0, len(t.used))
+	for placeholder := range t.used {
+		used = append(used, placeholder)
+	}
+	sort.Strings(used)
+	return used
+}
+
 // Err if any of the placeholders were missing during execution.
 func (t *Tracer) Err() error {
 	// miscellaneous errors represent errors preventing source modification not related to missing placeholder
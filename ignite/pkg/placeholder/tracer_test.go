@@ -1,6 +1,8 @@
 package placeholder
 
 import (
+	"errors"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -95,3 +97,27 @@ func TestReplaceAll(t *testing.T) {
 		})
 	}
 }
+
+func TestReplaceInjection(t *testing.T) {
+	RegisterInjection("#injected", func(content, snippet string, all bool) (string, error) {
+		if strings.Contains(content, "unresolved") {
+			return content, errors.New("cannot resolve injection point")
+		}
+		return content + snippet, nil
+	})
+
+	tr := New()
+	content := tr.Replace("foo ", "#injected", "#injected\nbar")
+	require.Equal(t, "foo bar", content)
+	require.NoError(t, tr.Err())
+	require.Equal(t, []string{"#injected"}, tr.Used())
+
+	tr = New()
+	content = tr.Replace("unresolved", "#injected", "bar\n#injected")
+	require.Equal(t, "unresolved", content)
+	err := tr.Err()
+	require.ErrorIs(t, err, newErrMissingPlaceholder([]string{"#injected"}))
+	var validationErr *MissingPlaceholdersError
+	require.ErrorAs(t, err, &validationErr)
+	require.Contains(t, validationErr.ValidationInfo(), "#injected: cannot resolve injection point")
+}
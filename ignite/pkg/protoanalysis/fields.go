@@ -0,0 +1,70 @@
+package protoanalysis
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/emicklei/proto"
+)
+
+// Field is a field of a proto message.
+type Field struct {
+	// Name of the field.
+	Name string
+
+	// Type of the field.
+	Type string
+
+	// Repeated indicates that the field is a list.
+	Repeated bool
+}
+
+// MessageFields returns the fields of a top level message defined in the proto file
+// at path, in the order they are defined in the message.
+func MessageFields(path, messageName string) ([]Field, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	def, err := proto.NewParser(f).Parse()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		fields []Field
+		found  bool
+	)
+
+	proto.Walk(
+		def,
+		proto.WithMessage(func(m *proto.Message) {
+			if _, ok := m.Parent.(*proto.Proto); !ok || m.Name != messageName {
+				return
+			}
+
+			found = true
+
+			for _, elem := range m.Elements {
+				field, ok := elem.(*proto.NormalField)
+				if !ok {
+					continue
+				}
+
+				fields = append(fields, Field{
+					Name:     field.Name,
+					Type:     field.Type,
+					Repeated: field.Repeated,
+				})
+			}
+		}),
+	)
+
+	if !found {
+		return nil, fmt.Errorf("message %s not found in %s", messageName, path)
+	}
+
+	return fields, nil
+}
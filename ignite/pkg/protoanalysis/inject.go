@@ -0,0 +1,142 @@
+package protoanalysis
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/emicklei/proto"
+	"github.com/pkg/errors"
+)
+
+// ErrInjectionPoint is returned when the injection point of a snippet cannot
+// be resolved in a proto file.
+var ErrInjectionPoint = errors.New("cannot resolve injection point")
+
+// InjectImports adds the import statements of the snippet after the last import
+// of the proto file src, or after its package when it doesn't import any file.
+// The files that are already imported are skipped.
+func InjectImports(src, snippet string) (string, error) {
+	def, err := parseSource(src)
+	if err != nil {
+		return src, err
+	}
+
+	imported := make(map[string]struct{})
+	var anchor int
+	proto.Walk(def,
+		proto.WithPackage(func(p *proto.Package) {
+			if anchor == 0 {
+				anchor = p.Position.Offset
+			}
+		}),
+		proto.WithImport(func(i *proto.Import) {
+			imported[i.Filename] = struct{}{}
+			anchor = i.Position.Offset
+		}),
+	)
+	if anchor == 0 {
+		return src, fmt.Errorf("%w import: no package or import statement", ErrInjectionPoint)
+	}
+
+	imports, err := parseSource(snippet)
+	if err != nil {
+		return src, errors.Wrap(err, "invalid imports")
+	}
+	var b strings.Builder
+	proto.Walk(imports, proto.WithImport(func(i *proto.Import) {
+		if _, ok := imported[i.Filename]; !ok {
+			fmt.Fprintf(&b, "import %q;\n", i.Filename)
+		}
+	}))
+
+	offset := endOfLine(src, anchor)
+	return src[:offset] + b.String() + src[offset:], nil
+}
+
+// InjectRPCs adds the rpc declarations of the snippet at the end of the service
+// of the proto file src.
+func InjectRPCs(src, service, snippet string) (string, error) {
+	def, err := parseSource(src)
+	if err != nil {
+		return src, err
+	}
+
+	start := -1
+	proto.Walk(def, proto.WithService(func(s *proto.Service) {
+		if s.Name == service {
+			start = s.Position.Offset
+		}
+	}))
+	if start < 0 {
+		return src, fmt.Errorf("%w service %s: service not found", ErrInjectionPoint, service)
+	}
+	end := closingBrace(src, start)
+	if end < 0 {
+		return src, fmt.Errorf("%w service %s: end of service not found", ErrInjectionPoint, service)
+	}
+
+	// Insert the rpcs on their own lines, before the line of the closing brace
+	rpcs := "  " + strings.TrimSpace(snippet) + "\n"
+	lineStart := strings.LastIndexByte(src[:end], '\n') + 1
+	if strings.TrimSpace(src[lineStart:end]) == "" {
+		return src[:lineStart] + rpcs + src[lineStart:], nil
+	}
+	return src[:end] + "\n" + rpcs + src[end:], nil
+}
+
+// AppendMessages adds the messages of the snippet at the end of the proto file src.
+func AppendMessages(src, snippet string) (string, error) {
+	if _, err := parseSource(src); err != nil {
+		return src, err
+	}
+	return strings.TrimRight(src, "\n") + "\n\n" + strings.TrimSpace(snippet) + "\n", nil
+}
+
+func parseSource(src string) (*proto.Proto, error) {
+	def, err := proto.NewParser(strings.NewReader(src)).Parse()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot parse proto")
+	}
+	return def, nil
+}
+
+// endOfLine returns the offset following the end of the line at offset.
+func endOfLine(src string, offset int) int {
+	i := strings.IndexByte(src[offset:], '\n')
+	if i < 0 {
+		return len(src)
+	}
+	return offset + i + 1
+}
+
+// closingBrace returns the offset of the brace that closes the first block
+// after offset, the braces of the comments and the strings are skipped.
+func closingBrace(src string, offset int) int {
+	depth := 0
+	for i := offset; i < len(src); i++ {
+		switch {
+		case strings.HasPrefix(src[i:], "//"):
+			i = endOfLine(src, i) - 1
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				return -1
+			}
+			i += end + 3
+		case src[i] == '"' || src[i] == '\'':
+			end := strings.IndexByte(src[i+1:], src[i])
+			if end < 0 {
+				return -1
+			}
+			i += end + 1
+		case src[i] == '{':
+			depth++
+		case src[i] == '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
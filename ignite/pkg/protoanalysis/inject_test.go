@@ -0,0 +1,47 @@
+package protoanalysis_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/protoanalysis"
+)
+
+const txProto = `syntax = "proto3";
+package test.mars;
+
+import "gogoproto/gogo.proto";
+
+option go_package = "github.com/test/mars/x/mars/types";
+
+service Msg {
+  rpc Foo(MsgFoo) returns (MsgFooResponse); // {braces}
+}
+
+message MsgFoo {}
+message MsgFooResponse {}
+`
+
+func TestInjectImports(t *testing.T) {
+	got, err := protoanalysis.InjectImports(txProto, `import "gogoproto/gogo.proto";
+import "mars/bar.proto";`)
+	require.NoError(t, err)
+	require.Contains(t, got, "import \"gogoproto/gogo.proto\";\nimport \"mars/bar.proto\";\n\noption")
+}
+
+func TestInjectRPCs(t *testing.T) {
+	got, err := protoanalysis.InjectRPCs(txProto, "Msg", "rpc Bar(MsgBar) returns (MsgBarResponse);")
+	require.NoError(t, err)
+	require.Contains(t, got, "// {braces}\n  rpc Bar(MsgBar) returns (MsgBarResponse);\n}")
+
+	_, err = protoanalysis.InjectRPCs(txProto, "Query", "rpc Bar(MsgBar) returns (MsgBarResponse);")
+	require.ErrorIs(t, err, protoanalysis.ErrInjectionPoint)
+	require.EqualError(t, err, "cannot resolve injection point service Query: service not found")
+}
+
+func TestAppendMessages(t *testing.T) {
+	got, err := protoanalysis.AppendMessages(txProto, "message MsgBar {}\n")
+	require.NoError(t, err)
+	require.Contains(t, got, "message MsgFooResponse {}\n\nmessage MsgBar {}\n")
+}
@@ -117,6 +117,9 @@ type RPCFunc struct {
 
 // HTTPRule keeps info about a configured http rule of an RPC func.
 type HTTPRule struct {
+	// Endpoint is the path template of the http endpoint, e.g. "/foo/bar/{id}".
+	Endpoint string
+
 	// Params is a list of parameters defined in the http endpoint itself.
 	Params []string
 
@@ -23,6 +23,7 @@ type configs struct {
 	pluginPath             string
 	isGeneratedDepsEnabled bool
 	pluginOptions          []string
+	flags                  []string
 	env                    []string
 	command                Cmd
 }
@@ -59,6 +60,13 @@ func WithCommand(command Cmd) Option {
 	}
 }
 
+// flags adds flags to the protoc command.
+func flags(f ...string) Option {
+	return func(c *configs) {
+		c.flags = append(c.flags, f...)
+	}
+}
+
 // Cmd contains the information necessary to execute the protoc command.
 type Cmd struct {
 	command  []string
@@ -129,6 +137,8 @@ func Generate(ctx context.Context, outDir, protoPath string, includePaths, proto
 	if c.pluginPath != "" {
 		command = append(command, "--plugin", c.pluginPath)
 	}
+	command = append(command, c.flags...)
+
 	var existentIncludePaths []string
 
 	// skip if a third party proto source actually doesn't exist on the filesystem.
@@ -150,6 +160,10 @@ func Generate(ctx context.Context, outDir, protoPath string, includePaths, proto
 		return err
 	}
 
+	// the well-known types of the protoc include dirs are provided by the runtime
+	// libraries of the plugins so they are not generated with the dependencies.
+	files = excludeFiles(files, includes)
+
 	// run command for each protocOuts.
 	for _, out := range protocOuts {
 		command := append(command, out)
@@ -172,6 +186,20 @@ func Generate(ctx context.Context, outDir, protoPath string, includePaths, proto
 	return nil
 }
 
+// DescriptorSet writes to out the serialized FileDescriptorSet of the proto
+// files of protoPath and of the files they import, with their source info.
+func DescriptorSet(ctx context.Context, out, protoPath string, includePaths []string, options ...Option) error {
+	out, err := filepath.Abs(out)
+	if err != nil {
+		return err
+	}
+
+	options = append(options, flags("--include_imports", "--include_source_info"))
+	protocOuts := []string{"--descriptor_set_out=" + out}
+
+	return Generate(ctx, filepath.Dir(out), protoPath, includePaths, protocOuts, options...)
+}
+
 // discoverFiles discovers .proto files to do code generation for. .proto files of the app
 // (everything under protoPath) will always be a part of the discovered files.
 //
@@ -192,12 +220,23 @@ func discoverFiles(ctx context.Context, c configs, protoPath string, includePath
 		return discovered, nil
 	}
 
+	// the dependencies imported by several files are discovered only once.
+	seen := make(map[string]bool)
+	for _, path := range discovered {
+		seen[path] = true
+	}
+
 	for _, file := range packages.Files() {
 		d, err := searchFile(file, protoPath, includePaths)
 		if err != nil {
 			return nil, err
 		}
-		discovered = append(discovered, d...)
+		for _, path := range d {
+			if !seen[path] {
+				seen[path] = true
+				discovered = append(discovered, path)
+			}
+		}
 	}
 
 	return discovered, nil
@@ -256,3 +295,20 @@ func searchFile(file protoanalysis.File, protoPath string, includePaths []string
 
 	return discovered, nil
 }
+
+// excludeFiles returns the files that are not located in one of the dirs.
+func excludeFiles(files, dirs []string) (filtered []string) {
+	for _, file := range files {
+		excluded := false
+		for _, dir := range dirs {
+			if strings.HasPrefix(file, dir+string(filepath.Separator)) {
+				excluded = true
+				break
+			}
+		}
+		if !excluded {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
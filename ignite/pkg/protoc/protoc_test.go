@@ -0,0 +1,51 @@
+package protoc_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/ignite/cli/ignite/pkg/protoc"
+)
+
+func TestDescriptorSet(t *testing.T) {
+	protoPath := filepath.Join(t.TempDir(), "proto")
+	require.NoError(t, os.MkdirAll(filepath.Join(protoPath, "mars", "blog"), 0o755))
+
+	post := `syntax = "proto3";
+package mars.blog;
+
+import "google/protobuf/timestamp.proto";
+
+// Post is a post of the blog.
+message Post {
+  string title = 1;
+  google.protobuf.Timestamp created_at = 2;
+}
+`
+	require.NoError(t, os.WriteFile(filepath.Join(protoPath, "mars", "blog", "post.proto"), []byte(post), 0o644))
+
+	out := filepath.Join(t.TempDir(), "descriptor-set.bin")
+	require.NoError(t, protoc.DescriptorSet(context.Background(), out, protoPath, []string{protoPath}))
+
+	content, err := os.ReadFile(out)
+	require.NoError(t, err)
+
+	var set descriptorpb.FileDescriptorSet
+	require.NoError(t, proto.Unmarshal(content, &set))
+
+	var names []string
+	for _, f := range set.File {
+		names = append(names, f.GetName())
+	}
+	require.Equal(t, []string{"google/protobuf/timestamp.proto", "mars/blog/post.proto"}, names)
+
+	file := set.File[1]
+	require.Equal(t, "Post", file.MessageType[0].GetName())
+	require.NotNil(t, file.SourceCodeInfo, "the source info is included")
+}
@@ -0,0 +1,36 @@
+package relayer
+
+import (
+	"context"
+
+	relayerconf "github.com/ignite/cli/ignite/pkg/relayer/config"
+)
+
+// Backend creates the IBC channels of the paths of the relayer and relays
+// their packets.
+type Backend interface {
+	// Link creates the clients, the connection and the channel of the path,
+	// and returns the path with the IDs of its ends.
+	Link(ctx context.Context, conf relayerconf.Config, path relayerconf.Path) (relayerconf.Path, error)
+
+	// Start relays the packets of the linked paths until ctx is canceled.
+	// update is called with the paths updated while relaying, like their
+	// packet heights.
+	Start(
+		ctx context.Context,
+		conf relayerconf.Config,
+		paths []relayerconf.Path,
+		update func(relayerconf.Path) error,
+	) error
+}
+
+// RelayerOption configures the relayer.
+type RelayerOption func(*Relayer)
+
+// WithBackend sets the backend of the relayer, the paths are linked and
+// relayed by the TypeScript relayer by default.
+func WithBackend(backend Backend) RelayerOption {
+	return func(r *Relayer) {
+		r.backend = backend
+	}
+}
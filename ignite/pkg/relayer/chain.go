@@ -37,7 +37,8 @@ type Chain struct {
 	// faucetAddress is the faucet address to get tokens for relayer accounts.
 	faucetAddress string
 
-	// gasPrice is the gas price used when sending transactions to the chain
+	// gasPrice is the gas price used when sending transactions to the chain,
+	// it is discovered from the node when it is empty or "auto".
 	gasPrice string
 
 	// gasLimit is the gas limit used when sending transactions to the chain
@@ -49,6 +50,9 @@ type Chain struct {
 	// clientID is the client id of the chain for relayer connection.
 	clientID string
 
+	// grpcAddress is the gRPC address of the chain, it is used by the Hermes backend.
+	grpcAddress string
+
 	r Relayer
 }
 
@@ -70,6 +74,7 @@ func WithFaucet(address string) Option {
 }
 
 // WithGasPrice gives the gas price to use to send ibc transactions to the chain.
+// The gas price of the node is used when it is empty or "auto".
 func WithGasPrice(gasPrice string) Option {
 	return func(c *Chain) {
 		c.gasPrice = gasPrice
@@ -97,6 +102,13 @@ func WithClientID(clientID string) Option {
 	}
 }
 
+// WithGRPCAddress configures the gRPC address of the chain.
+func WithGRPCAddress(address string) Option {
+	return func(c *Chain) {
+		c.grpcAddress = address
+	}
+}
+
 // NewChain creates a new chain on relayer or uses the existing matching chain.
 func (r Relayer) NewChain(accountName, rpcAddress string, options ...Option) (
 	*Chain, cosmosaccount.Account, error,
@@ -147,16 +159,19 @@ func (c *Chain) Config() relayerconfig.Chain {
 		GasPrice:      c.gasPrice,
 		GasLimit:      c.gasLimit,
 		ClientID:      c.clientID,
+		GRPCAddress:   c.grpcAddress,
 	}
 }
 
 // channelOptions represents options for configuring the IBC channel between two chains
 type channelOptions struct {
-	sourcePort    string
-	sourceVersion string
-	targetPort    string
-	targetVersion string
-	ordering      string
+	sourcePort       string
+	sourceVersion    string
+	targetPort       string
+	targetVersion    string
+	ordering         string
+	sourceConnection string
+	targetConnection string
 }
 
 // newChannelOptions returns default channel options
@@ -208,6 +223,15 @@ func Ordered() ChannelOption {
 	}
 }
 
+// ReuseConnection links the new channel on the existing connection between
+// the chains instead of creating new clients and a new connection.
+func ReuseConnection(connection Connection) ChannelOption {
+	return func(c *channelOptions) {
+		c.sourceConnection = connection.Src.ConnectionID
+		c.targetConnection = connection.Dst.ConnectionID
+	}
+}
+
 // Connect connects dst chain to c chain and creates a path in between in offline mode.
 // it returns the path id on success otherwise, returns with a non-nil error.
 func (c *Chain) Connect(dst *Chain, options ...ChannelOption) (id string, err error) {
@@ -238,22 +262,7 @@ func (c *Chain) Connect(dst *Chain, options ...ChannelOption) (id string, err er
 		i++
 	}
 
-	confPath := relayerconfig.Path{
-		ID:       pathID,
-		Ordering: channelOptions.ordering,
-		Src: relayerconfig.PathEnd{
-			ChainID: c.ID,
-			PortID:  channelOptions.sourcePort,
-			Version: channelOptions.sourceVersion,
-		},
-		Dst: relayerconfig.PathEnd{
-			ChainID: dst.ID,
-			PortID:  channelOptions.targetPort,
-			Version: channelOptions.targetVersion,
-		},
-	}
-
-	conf.Paths = append(conf.Paths, confPath)
+	conf.Paths = append(conf.Paths, c.newPath(dst, pathID, channelOptions))
 
 	if err := relayerconfig.Save(conf); err != nil {
 		return "", err
@@ -262,6 +271,62 @@ func (c *Chain) Connect(dst *Chain, options ...ChannelOption) (id string, err er
 	return pathID, nil
 }
 
+// ConnectPath connects dst chain to c chain with the path id, the path is
+// created when it doesn't exist. The link of an existing path is kept so it
+// is not linked again, unless its channel options changed or reset is true.
+func (c *Chain) ConnectPath(dst *Chain, id string, reset bool, options ...ChannelOption) error {
+	channelOptions := newChannelOptions()
+
+	for _, apply := range options {
+		apply(&channelOptions)
+	}
+
+	conf, err := relayerconfig.Get()
+	if err != nil {
+		return err
+	}
+
+	path := c.newPath(dst, id, channelOptions)
+
+	existing, err := conf.PathByID(id)
+	if err != nil {
+		conf.Paths = append(conf.Paths, path)
+		return relayerconfig.Save(conf)
+	}
+
+	// the policy of the path is kept, it doesn't change the link of the path.
+	path.Policy = existing.Policy
+
+	if !reset && unlink(existing) == path {
+		return nil
+	}
+
+	if err := conf.UpdatePath(path); err != nil {
+		return err
+	}
+	return relayerconfig.Save(conf)
+}
+
+// newPath returns the path between c and dst chains with the channel options.
+func (c *Chain) newPath(dst *Chain, id string, channelOptions channelOptions) relayerconfig.Path {
+	return relayerconfig.Path{
+		ID:       id,
+		Ordering: channelOptions.ordering,
+		Src: relayerconfig.PathEnd{
+			ChainID:      c.ID,
+			ConnectionID: channelOptions.sourceConnection,
+			PortID:       channelOptions.sourcePort,
+			Version:      channelOptions.sourceVersion,
+		},
+		Dst: relayerconfig.PathEnd{
+			ChainID:      dst.ID,
+			ConnectionID: channelOptions.targetConnection,
+			PortID:       channelOptions.targetPort,
+			Version:      channelOptions.targetVersion,
+		},
+	}
+}
+
 // EnsureChainSetup sets up the new or existing chain.
 func (c *Chain) EnsureChainSetup(ctx context.Context) error {
 	client, err := cosmosclient.New(ctx, cosmosclient.WithNodeAddress(c.rpcAddress))
@@ -274,6 +339,14 @@ func (c *Chain) EnsureChainSetup(ctx context.Context) error {
 	}
 	c.ID = status.NodeInfo.Network
 
+	if c.gasPrice == "" || c.gasPrice == cosmosclient.GasPricesAuto {
+		gasPrice, err := client.GasPrice(ctx)
+		if err != nil {
+			return err
+		}
+		c.gasPrice = gasPrice.String()
+	}
+
 	confChain := c.Config()
 	conf, err := relayerconfig.Get()
 	if err != nil {
@@ -0,0 +1,200 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	feetypes "github.com/cosmos/ibc-go/v5/modules/apps/29-fee/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	relayerconf "github.com/ignite/cli/ignite/pkg/relayer/config"
+)
+
+// ErrChannelLifecycleNotSupported is returned when the backend of the relayer
+// can't close or upgrade the channels.
+var ErrChannelLifecycleNotSupported = errors.New("the relayer backend can't close or upgrade channels, use the hermes backend")
+
+// ChannelBackend is a backend that manages the lifecycle of the channels of
+// the paths after they are linked.
+type ChannelBackend interface {
+	// CloseChannel closes both ends of the channel of the path.
+	CloseChannel(ctx context.Context, conf relayerconf.Config, path relayerconf.Path) error
+
+	// UpgradeChannel initiates the upgrade of the channel of the path on its
+	// source end, the upgrade handshake is completed while the packets are
+	// relayed.
+	UpgradeChannel(ctx context.Context, conf relayerconf.Config, path relayerconf.Path, upgrade ChannelUpgrade) error
+}
+
+// ChannelUpgrade holds the parameters of a channel after its upgrade, the
+// empty parameters are not upgraded.
+type ChannelUpgrade struct {
+	// Version is the version of the channel.
+	Version string
+
+	// Ordering is the ordering of the channel, OrderingOrdered or OrderingUnordered.
+	Ordering string
+
+	// ConnectionID is the connection of the channel on the source chain.
+	ConnectionID string
+}
+
+// ClosePath closes the channel of a linked path, the path is unlinked so it
+// can be linked again with a new channel.
+func (r Relayer) ClosePath(ctx context.Context, pathID string) error {
+	conf, path, err := r.linkedPath(pathID)
+	if err != nil {
+		return err
+	}
+
+	backend, ok := r.backend.(ChannelBackend)
+	if !ok {
+		return ErrChannelLifecycleNotSupported
+	}
+
+	if err := backend.CloseChannel(ctx, conf, path); err != nil {
+		return err
+	}
+
+	if err := conf.UpdatePath(unlink(path)); err != nil {
+		return err
+	}
+	return relayerconf.Save(conf)
+}
+
+// UpgradePath initiates the upgrade of the channel of a linked path.
+func (r Relayer) UpgradePath(ctx context.Context, pathID string, upgrade ChannelUpgrade) error {
+	conf, path, err := r.linkedPath(pathID)
+	if err != nil {
+		return err
+	}
+
+	backend, ok := r.backend.(ChannelBackend)
+	if !ok {
+		return ErrChannelLifecycleNotSupported
+	}
+
+	switch upgrade.Ordering {
+	case "", OrderingOrdered, OrderingUnordered:
+	default:
+		return fmt.Errorf("invalid channel ordering %s", upgrade.Ordering)
+	}
+
+	return backend.UpgradeChannel(ctx, conf, path, upgrade)
+}
+
+// RegisterPayees registers the counterparty payees of the relayer account on
+// both ends of the channel of a linked path for the ICS-29 fee middleware.
+// srcPayee is the address on the source chain paid for the packets relayed to
+// the target chain, and dstPayee the address on the target chain paid for the
+// packets relayed to the source chain. The address of the relayer account is
+// the payee by default.
+func (r Relayer) RegisterPayees(ctx context.Context, pathID, srcPayee, dstPayee string) error {
+	conf, path, err := r.linkedPath(pathID)
+	if err != nil {
+		return err
+	}
+
+	src, err := conf.ChainByID(path.Src.ChainID)
+	if err != nil {
+		return err
+	}
+	dst, err := conf.ChainByID(path.Dst.ChainID)
+	if err != nil {
+		return err
+	}
+
+	if srcPayee == "" {
+		if srcPayee, err = r.address(src); err != nil {
+			return err
+		}
+	}
+	if dstPayee == "" {
+		if dstPayee, err = r.address(dst); err != nil {
+			return err
+		}
+	}
+
+	// the packets are received on the target chain and their fees are paid
+	// on the source chain, and the other way around.
+	if err := r.registerPayee(ctx, dst, path.Dst, srcPayee); err != nil {
+		return err
+	}
+	return r.registerPayee(ctx, src, path.Src, dstPayee)
+}
+
+// registerPayee registers the counterparty payee of the relayer account on
+// the end of a channel.
+func (r Relayer) registerPayee(ctx context.Context, chain relayerconf.Chain, end relayerconf.PathEnd, payee string) error {
+	addr, err := r.address(chain)
+	if err != nil {
+		return err
+	}
+
+	msg := feetypes.NewMsgRegisterCounterpartyPayee(end.PortID, end.ChannelID, addr, payee)
+	if _, err := r.broadcast(ctx, chain, msg); err != nil {
+		return fmt.Errorf("register counterparty payee on chain %s: %w", chain.ID, err)
+	}
+	return nil
+}
+
+// broadcast broadcasts the messages signed by the relayer account on the chain.
+func (r Relayer) broadcast(ctx context.Context, chain relayerconf.Chain, msgs ...sdk.Msg) (cosmosclient.Response, error) {
+	client, err := cosmosclient.New(
+		ctx,
+		cosmosclient.WithNodeAddress(chain.RPCAddress),
+		cosmosclient.WithAddressPrefix(chain.AddressPrefix),
+		cosmosclient.WithGasPrices(chain.GasPrice),
+		cosmosclient.WithAccountRegistry(r.ca),
+	)
+	if err != nil {
+		return cosmosclient.Response{}, err
+	}
+
+	account, err := r.ca.GetByName(chain.Account)
+	if err != nil {
+		return cosmosclient.Response{}, err
+	}
+	return client.BroadcastTx(ctx, account, msgs...)
+}
+
+// address returns the address of the relayer account on the chain.
+func (r Relayer) address(chain relayerconf.Chain) (string, error) {
+	account, err := r.ca.GetByName(chain.Account)
+	if err != nil {
+		return "", err
+	}
+	return account.Address(chain.AddressPrefix)
+}
+
+// unlink returns the path without the IDs of the connections and channels of
+// its ends, so it is linked again.
+func unlink(path relayerconf.Path) relayerconf.Path {
+	for _, end := range []*relayerconf.PathEnd{&path.Src, &path.Dst} {
+		end.ConnectionID = ""
+		end.ChannelID = ""
+		end.PacketHeight = 0
+		end.AckHeight = 0
+	}
+	return path
+}
+
+// linkedPath returns the relayer config and the path, the path must be linked.
+func (r Relayer) linkedPath(pathID string) (relayerconf.Config, relayerconf.Path, error) {
+	conf, err := relayerconf.Get()
+	if err != nil {
+		return relayerconf.Config{}, relayerconf.Path{}, err
+	}
+
+	path, err := conf.PathByID(pathID)
+	if err != nil {
+		return relayerconf.Config{}, relayerconf.Path{}, err
+	}
+
+	if path.Src.ChannelID == "" || path.Dst.ChannelID == "" {
+		return relayerconf.Config{}, relayerconf.Path{}, fmt.Errorf("path %s is not linked", path.ID)
+	}
+	return conf, path, nil
+}
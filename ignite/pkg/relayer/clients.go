@@ -0,0 +1,241 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ignite/cli/ignite/pkg/ctxticker"
+	relayerconf "github.com/ignite/cli/ignite/pkg/relayer/config"
+)
+
+const (
+	// ClientActive is the status of a client that can be updated and used.
+	ClientActive = "Active"
+
+	// ClientExpired is the status of a client that was not updated during its
+	// trusting period, it can only be recovered by a governance proposal.
+	ClientExpired = "Expired"
+
+	// ClientFrozen is the status of a client frozen after a misbehaviour of
+	// its counterparty chain, it can only be recovered by a governance proposal.
+	ClientFrozen = "Frozen"
+
+	// DefaultClientsCheckInterval is the default interval between the checks
+	// of the clients of the paths by the monitor.
+	DefaultClientsCheckInterval = time.Minute
+)
+
+// ErrClientUpdateNotSupported is returned when the backend of the relayer
+// can't update the clients.
+var ErrClientUpdateNotSupported = errors.New("the relayer backend can't update clients, use the hermes backend")
+
+// ClientBackend is a backend that updates the clients of the paths.
+type ClientBackend interface {
+	// UpdateClient updates the client hosted on the chain with the latest
+	// header of the chain tracked by the client.
+	UpdateClient(ctx context.Context, conf relayerconf.Config, chainID, clientID string) error
+}
+
+// Client is the light client of a chain hosted on the chain at an end of a path.
+type Client struct {
+	// PathID is the ID of the path.
+	PathID string `json:"path_id"`
+
+	// ChainID is the ID of the chain hosting the client.
+	ChainID string `json:"chain_id"`
+
+	// ClientID is the ID of the client.
+	ClientID string `json:"client_id"`
+
+	// CounterpartyChainID is the ID of the chain tracked by the client.
+	CounterpartyChainID string `json:"counterparty_chain_id"`
+
+	// Status is the status of the client, ClientActive, ClientExpired or
+	// ClientFrozen.
+	Status string `json:"status"`
+
+	// LastUpdate is the time of the latest header of the client.
+	LastUpdate time.Time `json:"last_update"`
+
+	// TrustingPeriod is the period after the last update when the client expires.
+	TrustingPeriod time.Duration `json:"trusting_period"`
+
+	// ExpiresAt is the time when the client expires if it's not updated.
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// ExpiresSoon returns true when the active client expires within the warn
+// duration, or within a third of its trusting period when warn is zero.
+func (c Client) ExpiresSoon(now time.Time, warn time.Duration) bool {
+	if c.Status != ClientActive {
+		return false
+	}
+	if warn <= 0 {
+		warn = c.TrustingPeriod / 3
+	}
+	return c.ExpiresAt.Sub(now) <= warn
+}
+
+// PathClients returns the clients of both ends of the linked path.
+func (r Relayer) PathClients(ctx context.Context, pathID string) ([]Client, error) {
+	conf, path, err := r.linkedPath(pathID)
+	if err != nil {
+		return nil, err
+	}
+
+	var clients []Client
+	for _, end := range []relayerconf.PathEnd{path.Src, path.Dst} {
+		client, err := r.pathClient(ctx, conf, path, end)
+		if err != nil {
+			return nil, err
+		}
+		clients = append(clients, client)
+	}
+	return clients, nil
+}
+
+// pathClient returns the client of the end of the path.
+func (r Relayer) pathClient(
+	ctx context.Context,
+	conf relayerconf.Config,
+	path relayerconf.Path,
+	end relayerconf.PathEnd,
+) (Client, error) {
+	q, err := r.chainQuerier(ctx, conf, end.ChainID)
+	if err != nil {
+		return Client{}, err
+	}
+
+	clientID, err := q.connectionClient(ctx, end.ConnectionID)
+	if err != nil {
+		return Client{}, fmt.Errorf("connection %s on chain %s: %w", end.ConnectionID, end.ChainID, err)
+	}
+
+	state, consensus, status, err := q.tendermintClient(ctx, clientID)
+	if err != nil {
+		return Client{}, fmt.Errorf("client %s on chain %s: %w", clientID, end.ChainID, err)
+	}
+
+	return Client{
+		PathID:              path.ID,
+		ChainID:             end.ChainID,
+		ClientID:            clientID,
+		CounterpartyChainID: state.ChainId,
+		Status:              status,
+		LastUpdate:          consensus.Timestamp,
+		TrustingPeriod:      state.TrustingPeriod,
+		ExpiresAt:           consensus.Timestamp.Add(state.TrustingPeriod),
+	}, nil
+}
+
+// UpdateClient updates the client hosted on the chain at an end of the linked
+// path.
+func (r Relayer) UpdateClient(ctx context.Context, pathID, chainID string) error {
+	conf, path, err := r.linkedPath(pathID)
+	if err != nil {
+		return err
+	}
+
+	var end relayerconf.PathEnd
+	switch chainID {
+	case path.Src.ChainID:
+		end = path.Src
+	case path.Dst.ChainID:
+		end = path.Dst
+	default:
+		return fmt.Errorf("chain %s is not an end of path %s", chainID, path.ID)
+	}
+
+	client, err := r.pathClient(ctx, conf, path, end)
+	if err != nil {
+		return err
+	}
+	return r.updateClient(ctx, conf, client)
+}
+
+// updateClient updates the active client with the backend of the relayer.
+func (r Relayer) updateClient(ctx context.Context, conf relayerconf.Config, client Client) error {
+	backend, ok := r.backend.(ClientBackend)
+	if !ok {
+		return ErrClientUpdateNotSupported
+	}
+
+	if client.Status != ClientActive {
+		return fmt.Errorf(
+			"client %s on chain %s is %s, it can only be recovered by a governance proposal",
+			client.ClientID,
+			client.ChainID,
+			client.Status,
+		)
+	}
+	return backend.UpdateClient(ctx, conf, client.ChainID, client.ClientID)
+}
+
+// ClientsMonitor monitors the clients of the paths.
+type ClientsMonitor struct {
+	// Interval is the interval between the checks of the clients,
+	// DefaultClientsCheckInterval by default.
+	Interval time.Duration
+
+	// Warn is the duration before the expiry of the clients when they are
+	// reported, a third of their trusting period by default.
+	Warn time.Duration
+
+	// AutoUpdate updates the clients that expire soon.
+	AutoUpdate bool
+
+	// OnClient is called with the clients that expire soon, that are expired
+	// or frozen, and with the error of their update when they are updated.
+	OnClient func(client Client, updateErr error)
+
+	// OnError is called with the errors of the checks of the clients of the
+	// paths, the checks continue after the errors.
+	OnError func(pathID string, err error)
+}
+
+// MonitorClients checks the clients of the linked paths until ctx is canceled.
+func (r Relayer) MonitorClients(ctx context.Context, m ClientsMonitor, pathIDs ...string) error {
+	interval := m.Interval
+	if interval <= 0 {
+		interval = DefaultClientsCheckInterval
+	}
+
+	err := ctxticker.DoNow(ctx, interval, func() error {
+		conf, err := relayerconf.Get()
+		if err != nil {
+			return err
+		}
+
+		for _, id := range pathIDs {
+			clients, err := r.PathClients(ctx, id)
+			if err != nil {
+				if m.OnError != nil && ctx.Err() == nil {
+					m.OnError(id, err)
+				}
+				continue
+			}
+
+			now := time.Now()
+			for _, c := range clients {
+				if c.Status == ClientActive && !c.ExpiresSoon(now, m.Warn) {
+					continue
+				}
+
+				var updateErr error
+				if m.AutoUpdate && c.Status == ClientActive {
+					updateErr = r.updateClient(ctx, conf, c)
+				}
+				if m.OnClient != nil {
+					m.OnClient(c, updateErr)
+				}
+			}
+		}
+		return nil
+	})
+	if ctx.Err() != nil {
+		return nil
+	}
+	return err
+}
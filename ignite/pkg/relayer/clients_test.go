@@ -0,0 +1,45 @@
+package relayer
+
+import (
+	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	ibctm "github.com/cosmos/ibc-go/v5/modules/light-clients/07-tendermint/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientExpiresSoon(t *testing.T) {
+	var (
+		now    = time.Date(2023, 1, 10, 0, 0, 0, 0, time.UTC)
+		client = Client{
+			Status:         ClientActive,
+			TrustingPeriod: 9 * 24 * time.Hour,
+			ExpiresAt:      now.Add(4 * 24 * time.Hour),
+		}
+	)
+
+	require.False(t, client.ExpiresSoon(now, 0))
+	require.True(t, client.ExpiresSoon(now.Add(24*time.Hour), 0))
+	require.True(t, client.ExpiresSoon(now, 5*24*time.Hour))
+	require.False(t, client.ExpiresSoon(now, time.Hour))
+
+	client.Status = ClientExpired
+	require.False(t, client.ExpiresSoon(now.Add(24*time.Hour), 0))
+}
+
+func TestUnpackAny(t *testing.T) {
+	state := ibctm.ConsensusState{Timestamp: time.Date(2023, 1, 10, 0, 0, 0, 0, time.UTC)}
+	value, err := state.Marshal()
+	require.NoError(t, err)
+
+	var got ibctm.ConsensusState
+	require.NoError(t, unpackAny(&codectypes.Any{
+		TypeUrl: "/ibc.lightclients.tendermint.v1.ConsensusState",
+		Value:   value,
+	}, &got))
+	require.True(t, state.Timestamp.Equal(got.Timestamp))
+
+	require.Error(t, unpackAny(&codectypes.Any{TypeUrl: "/ibc.lightclients.solomachine.v2.ConsensusState"}, &got))
+	require.Error(t, unpackAny(nil, &got))
+}
@@ -61,6 +61,7 @@ type Chain struct {
 	GasPrice      string `json:"gas_price" yaml:"gas_price,omitempty"`
 	GasLimit      int64  `json:"gas_limit" yaml:"gas_limit,omitempty"`
 	ClientID      string `json:"client_id" yaml:"client_id,omitempty"`
+	GRPCAddress   string `json:"grpc_address" yaml:"grpc_address,omitempty"`
 }
 
 type Path struct {
@@ -68,6 +69,13 @@ type Path struct {
 	Ordering string  `json:"ordering" yaml:"ordering,omitempty"`
 	Src      PathEnd `json:"src" yaml:"src"`
 	Dst      PathEnd `json:"dst" yaml:"dst"`
+	Policy   Policy  `json:"policy" yaml:"policy,omitempty"`
+}
+
+type Policy struct {
+	Interval string `json:"interval" yaml:"interval,omitempty"`
+	MaxGas   int64  `json:"max_gas" yaml:"max_gas,omitempty"`
+	Memo     string `json:"memo" yaml:"memo,omitempty"`
 }
 
 type PathEnd struct {
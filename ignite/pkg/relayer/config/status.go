@@ -0,0 +1,28 @@
+package relayerconf
+
+import (
+	"os"
+	"time"
+
+	"github.com/ignite/cli/ignite/pkg/confile"
+)
+
+var statusPath = os.ExpandEnv("$HOME/.ignite/relayer/status.json")
+
+type PathStatus struct {
+	State     string     `json:"state"`
+	LastRelay *time.Time `json:"last_relay,omitempty"`
+	Error     string     `json:"error,omitempty"`
+}
+
+func GetStatus() (map[string]PathStatus, error) {
+	s := make(map[string]PathStatus)
+	if err := confile.New(confile.DefaultJSONEncodingCreator, statusPath).Load(&s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func SaveStatus(s map[string]PathStatus) error {
+	return confile.New(confile.DefaultJSONEncodingCreator, statusPath).Save(s)
+}
@@ -0,0 +1,99 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+
+	connectiontypes "github.com/cosmos/ibc-go/v5/modules/core/03-connection/types"
+
+	relayerconf "github.com/ignite/cli/ignite/pkg/relayer/config"
+)
+
+// Connection is an open connection between two chains that can be reused by
+// the paths between the chains, instead of creating new clients and a new
+// connection when the paths are linked.
+type Connection struct {
+	// Src is the end of the connection on the source chain, its channel is empty.
+	Src relayerconf.PathEnd `json:"src"`
+
+	// SrcClientID is the ID of the client of the connection on the source chain.
+	SrcClientID string `json:"src_client_id"`
+
+	// Dst is the end of the connection on the target chain, its channel is empty.
+	Dst relayerconf.PathEnd `json:"dst"`
+
+	// DstClientID is the ID of the client of the connection on the target chain.
+	DstClientID string `json:"dst_client_id"`
+}
+
+// Connections returns the open connections between the chains of the relayer
+// with active clients, the chains must be configured.
+func (r Relayer) Connections(ctx context.Context, srcChainID, dstChainID string) ([]Connection, error) {
+	conf, err := relayerconf.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	src, err := r.chainQuerier(ctx, conf, srcChainID)
+	if err != nil {
+		return nil, err
+	}
+	dst, err := r.chainQuerier(ctx, conf, dstChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	srcConnections, err := src.connections(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("connections of chain %s: %w", srcChainID, err)
+	}
+
+	var connections []Connection
+	for _, c := range srcConnections {
+		if c.State != connectiontypes.OPEN {
+			continue
+		}
+
+		ok, err := isActiveClient(ctx, src, c.ClientId, dstChainID)
+		if err != nil || !ok {
+			continue
+		}
+
+		res, err := dst.connection.Connection(ctx, &connectiontypes.QueryConnectionRequest{
+			ConnectionId: c.Counterparty.ConnectionId,
+		})
+		if err != nil {
+			continue
+		}
+		counterparty := res.Connection
+		if counterparty.State != connectiontypes.OPEN ||
+			counterparty.ClientId != c.Counterparty.ClientId ||
+			counterparty.Counterparty.ConnectionId != c.Id {
+			continue
+		}
+
+		ok, err = isActiveClient(ctx, dst, counterparty.ClientId, srcChainID)
+		if err != nil || !ok {
+			continue
+		}
+
+		connections = append(connections, Connection{
+			Src:         relayerconf.PathEnd{ChainID: srcChainID, ConnectionID: c.Id},
+			SrcClientID: c.ClientId,
+			Dst:         relayerconf.PathEnd{ChainID: dstChainID, ConnectionID: c.Counterparty.ConnectionId},
+			DstClientID: counterparty.ClientId,
+		})
+	}
+	return connections, nil
+}
+
+// isActiveClient returns true when the client hosted on the chain of q tracks
+// the chain with the ID and is active, the clients that are not Tendermint
+// light clients are never reused.
+func isActiveClient(ctx context.Context, q ibcQuerier, clientID, chainID string) (bool, error) {
+	client, _, status, err := q.tendermintClient(ctx, clientID)
+	if err != nil {
+		return false, err
+	}
+	return client.ChainId == chainID && status == ClientActive, nil
+}
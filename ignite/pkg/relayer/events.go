@@ -0,0 +1,213 @@
+package relayer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	jsonrpcclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
+	"golang.org/x/sync/errgroup"
+
+	relayerconf "github.com/ignite/cli/ignite/pkg/relayer/config"
+)
+
+const (
+	// eventsPollInterval is the interval between the relays of a path when the
+	// packet events of its chains are subscribed, the packets are relayed when
+	// the events are received and the relays only update the stale clients and
+	// catch up the events missed during the subscription.
+	eventsPollInterval = time.Minute
+
+	// eventsRetryInterval is the interval between the subscriptions to the
+	// packet events of a chain after a disconnection, the packets of the path
+	// are polled in the meantime.
+	eventsRetryInterval = 30 * time.Second
+
+	// wsPingPeriod is the period of the pings of the WebSocket connections, the
+	// connections are closed when the nodes don't answer within wsReadWait.
+	wsPingPeriod = 10 * time.Second
+	wsReadWait   = 30 * time.Second
+)
+
+var errEventsDisconnected = errors.New("disconnected from the events of the chain")
+
+// pathEvents notifies the packet events of the chains of a path, the events
+// are received from the WebSocket subscriptions of the nodes of the chains.
+type pathEvents struct {
+	// notified receives a value when packets are sent or acknowledged on the
+	// channel of the path.
+	notified chan struct{}
+
+	// subscribed is the number of chains with an active subscription.
+	subscribed int32
+}
+
+// newPathEvents returns the notifier of the packet events of the path.
+func newPathEvents() *pathEvents {
+	return &pathEvents{notified: make(chan struct{}, 1)}
+}
+
+// isSubscribed returns true when the events of both chains of the path are
+// subscribed, the packets of the path must be polled otherwise.
+func (e *pathEvents) isSubscribed() bool {
+	return atomic.LoadInt32(&e.subscribed) == 2
+}
+
+// watch subscribes to the packet events of the chains of the linked path until
+// ctx is canceled, the chains are subscribed again after their disconnection.
+func (e *pathEvents) watch(ctx context.Context, conf relayerconf.Config, path relayerconf.Path) error {
+	ends := []relayerconf.PathEnd{path.Src, path.Dst}
+	chains := make([]relayerconf.Chain, len(ends))
+	for i, end := range ends {
+		chain, err := conf.ChainByID(end.ChainID)
+		if err != nil {
+			return err
+		}
+		chains[i] = chain
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	for i := range ends {
+		end, chain := ends[i], chains[i]
+		g.Go(func() error {
+			for {
+				_ = e.watchChain(ctx, chain.RPCAddress, packetEventQueries(end))
+
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-time.After(eventsRetryInterval):
+				}
+			}
+		})
+	}
+	return g.Wait()
+}
+
+// watchChain subscribes to the events of the queries on the chain with the RPC
+// address until ctx is canceled or the node disconnects.
+func (e *pathEvents) watchChain(ctx context.Context, rpcAddress string, queries []string) error {
+	ws, err := jsonrpcclient.NewWS(
+		rpcAddress,
+		"/websocket",
+		jsonrpcclient.MaxReconnectAttempts(0),
+		jsonrpcclient.PingPeriod(wsPingPeriod),
+		jsonrpcclient.ReadWait(wsReadWait),
+	)
+	if err != nil {
+		return err
+	}
+	if err := ws.Start(); err != nil {
+		return err
+	}
+	defer ws.Stop() //nolint:errcheck
+
+	for _, q := range queries {
+		if err := ws.Subscribe(ctx, q); err != nil {
+			return err
+		}
+	}
+
+	// the subscriptions are active once the node acknowledged all of them.
+	var (
+		acks       int
+		subscribed bool
+	)
+	defer func() {
+		if subscribed {
+			atomic.AddInt32(&e.subscribed, -1)
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case res, ok := <-ws.ResponsesCh:
+			if !ok {
+				return errEventsDisconnected
+			}
+			if res.Error != nil {
+				return fmt.Errorf("subscribe to the events of %s: %w", rpcAddress, res.Error)
+			}
+
+			if !isPacketEvent(res.Result) {
+				if acks++; acks == len(queries) && !subscribed {
+					subscribed = true
+					atomic.AddInt32(&e.subscribed, 1)
+					// catch up the events sent before the subscription.
+					e.notify()
+				}
+				continue
+			}
+			e.notify()
+		}
+	}
+}
+
+// relay calls fn now, when packet events are notified, and every d until ctx
+// is canceled or fn returns with a non-nil error. The calls every d are
+// skipped while the events are subscribed, until eventsPollInterval elapsed
+// since the last call.
+func (e *pathEvents) relay(ctx context.Context, d time.Duration, fn func() error) error {
+	if err := fn(); err != nil {
+		return err
+	}
+	last := time.Now()
+
+	ticker := time.NewTicker(d)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case <-e.notified:
+
+		case <-ticker.C:
+			if e.isSubscribed() && time.Since(last) < eventsPollInterval {
+				continue
+			}
+		}
+
+		if err := fn(); err != nil {
+			return err
+		}
+		last = time.Now()
+	}
+}
+
+// notify notifies an event without blocking, the events received before the
+// last notification is handled are merged.
+func (e *pathEvents) notify() {
+	select {
+	case e.notified <- struct{}{}:
+	default:
+	}
+}
+
+// packetEventQueries returns the queries of the events of the packets sent on
+// the end of the path, and of the acknowledgements written on the end for the
+// packets received from the other end.
+func packetEventQueries(end relayerconf.PathEnd) []string {
+	return []string{
+		fmt.Sprintf("tm.event='Tx' AND %s.packet_src_channel='%s'", eventSendPacket, end.ChannelID),
+		fmt.Sprintf("tm.event='Tx' AND %s.packet_dst_channel='%s'", eventWriteAck, end.ChannelID),
+	}
+}
+
+// isPacketEvent returns true when the result of a subscription is an event,
+// the node acknowledges the subscriptions with an empty result.
+func isPacketEvent(result json.RawMessage) bool {
+	var event struct {
+		Query string `json:"query"`
+	}
+	if err := json.Unmarshal(result, &event); err != nil {
+		return false
+	}
+	return event.Query != ""
+}
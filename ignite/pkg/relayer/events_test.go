@@ -0,0 +1,74 @@
+package relayer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	relayerconf "github.com/ignite/cli/ignite/pkg/relayer/config"
+)
+
+func TestPacketEventQueries(t *testing.T) {
+	require.Equal(t, []string{
+		"tm.event='Tx' AND send_packet.packet_src_channel='channel-3'",
+		"tm.event='Tx' AND write_acknowledgement.packet_dst_channel='channel-3'",
+	}, packetEventQueries(relayerconf.PathEnd{ChainID: "mars", PortID: "transfer", ChannelID: "channel-3"}))
+}
+
+func TestIsPacketEvent(t *testing.T) {
+	require.False(t, isPacketEvent(json.RawMessage(`{}`)))
+	require.False(t, isPacketEvent(json.RawMessage(`null`)))
+	require.True(t, isPacketEvent(json.RawMessage(
+		`{"query":"tm.event='Tx' AND send_packet.packet_src_channel='channel-3'","data":{},"events":{}}`,
+	)))
+}
+
+func TestPathEventsRelay(t *testing.T) {
+	errStop := errors.New("stop")
+
+	t.Run("notified", func(t *testing.T) {
+		e := newPathEvents()
+		var calls int
+		err := e.relay(context.Background(), time.Hour, func() error {
+			if calls++; calls == 3 {
+				return errStop
+			}
+			e.notify()
+			return nil
+		})
+		require.Equal(t, errStop, err)
+		require.Equal(t, 3, calls)
+	})
+
+	t.Run("polled while not subscribed", func(t *testing.T) {
+		e := newPathEvents()
+		var calls int
+		err := e.relay(context.Background(), time.Millisecond, func() error {
+			if calls++; calls == 3 {
+				return errStop
+			}
+			return nil
+		})
+		require.Equal(t, errStop, err)
+		require.Equal(t, 3, calls)
+	})
+
+	t.Run("not polled while subscribed", func(t *testing.T) {
+		e := newPathEvents()
+		e.subscribed = 2
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		var calls int
+		err := e.relay(ctx, time.Millisecond, func() error {
+			calls++
+			return nil
+		})
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.Equal(t, 1, calls)
+	})
+}
@@ -0,0 +1,254 @@
+package hermes
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pelletier/go-toml"
+
+	relayerconf "github.com/ignite/cli/ignite/pkg/relayer/config"
+)
+
+const (
+	// defaultGRPCPort is the port of the gRPC server of a chain when its
+	// gRPC address is not configured.
+	defaultGRPCPort = "9090"
+
+	// defaultMaxGas is the max gas of the transactions of a chain when its
+	// gas limit is not configured.
+	defaultMaxGas = 3000000
+)
+
+// Config is the config of Hermes.
+type Config struct {
+	Global    Global  `toml:"global"`
+	Mode      Mode    `toml:"mode"`
+	REST      Server  `toml:"rest"`
+	Telemetry Server  `toml:"telemetry"`
+	Chains    []Chain `toml:"chains"`
+}
+
+// Global is the global config of Hermes.
+type Global struct {
+	LogLevel string `toml:"log_level"`
+}
+
+// Mode configures the IBC objects relayed by Hermes.
+type Mode struct {
+	Clients     ModeClients `toml:"clients"`
+	Connections Enabled     `toml:"connections"`
+	Channels    Enabled     `toml:"channels"`
+	Packets     ModePackets `toml:"packets"`
+}
+
+// Enabled enables a mode of Hermes.
+type Enabled struct {
+	Enabled bool `toml:"enabled"`
+}
+
+// ModeClients configures the refresh of the clients.
+type ModeClients struct {
+	Enabled      bool `toml:"enabled"`
+	Refresh      bool `toml:"refresh"`
+	Misbehaviour bool `toml:"misbehaviour"`
+}
+
+// ModePackets configures the relay of the packets.
+type ModePackets struct {
+	Enabled        bool `toml:"enabled"`
+	ClearInterval  int  `toml:"clear_interval"`
+	ClearOnStart   bool `toml:"clear_on_start"`
+	TxConfirmation bool `toml:"tx_confirmation"`
+}
+
+// Server is a server of Hermes.
+type Server struct {
+	Enabled bool   `toml:"enabled"`
+	Host    string `toml:"host"`
+	Port    int    `toml:"port"`
+}
+
+// Chain is a chain relayed by Hermes.
+type Chain struct {
+	ID             string         `toml:"id"`
+	RPCAddr        string         `toml:"rpc_addr"`
+	GRPCAddr       string         `toml:"grpc_addr"`
+	WebsocketAddr  string         `toml:"websocket_addr"`
+	RPCTimeout     string         `toml:"rpc_timeout"`
+	AccountPrefix  string         `toml:"account_prefix"`
+	KeyName        string         `toml:"key_name"`
+	KeyStoreFolder string         `toml:"key_store_folder,omitempty"`
+	StorePrefix    string         `toml:"store_prefix"`
+	DefaultGas     int64          `toml:"default_gas"`
+	MaxGas         int64          `toml:"max_gas"`
+	GasPrice       GasPrice       `toml:"gas_price"`
+	GasMultiplier  float64        `toml:"gas_multiplier"`
+	MaxMsgNum      int            `toml:"max_msg_num"`
+	MaxTxSize      int            `toml:"max_tx_size"`
+	ClockDrift     string         `toml:"clock_drift"`
+	MaxBlockTime   string         `toml:"max_block_time"`
+	TrustingPeriod string         `toml:"trusting_period"`
+	TrustThreshold TrustThreshold `toml:"trust_threshold"`
+	AddressType    AddressType    `toml:"address_type"`
+	MemoPrefix     string         `toml:"memo_prefix,omitempty"`
+	PacketFilter   *PacketFilter  `toml:"packet_filter,omitempty"`
+}
+
+// GasPrice is the gas price of a chain.
+type GasPrice struct {
+	Price float64 `toml:"price"`
+	Denom string  `toml:"denom"`
+}
+
+// TrustThreshold is the trust threshold of the light clients of a chain.
+type TrustThreshold struct {
+	Numerator   string `toml:"numerator"`
+	Denominator string `toml:"denominator"`
+}
+
+// AddressType is the derivation of the addresses of a chain.
+type AddressType struct {
+	Derivation string `toml:"derivation"`
+}
+
+// PacketFilter filters the channels relayed on a chain.
+type PacketFilter struct {
+	Policy string      `toml:"policy"`
+	List   [][2]string `toml:"list"`
+}
+
+// NewConfig returns the Hermes config of the chains of the relayer config.
+// The packets are only relayed on the channels of the paths, the chains
+// without path are not relayed when paths is not empty.
+func NewConfig(conf relayerconf.Config, paths []relayerconf.Path) (Config, error) {
+	c := Config{
+		Global: Global{LogLevel: "info"},
+		Mode: Mode{
+			Clients:     ModeClients{Enabled: true, Refresh: true},
+			Connections: Enabled{Enabled: true},
+			Channels:    Enabled{Enabled: true},
+			Packets: ModePackets{
+				Enabled:        true,
+				ClearInterval:  100,
+				ClearOnStart:   true,
+				TxConfirmation: true,
+			},
+		},
+		REST:      Server{Host: "127.0.0.1", Port: 3000},
+		Telemetry: Server{Host: "127.0.0.1", Port: 3001},
+	}
+
+	// the channels and the policies of the paths by chain, Hermes configures
+	// the gas and the memo of the transactions by chain so the chains of
+	// several paths use the highest max gas and the first memo of their paths.
+	var (
+		channels = make(map[string][][2]string)
+		maxGas   = make(map[string]int64)
+		memos    = make(map[string]string)
+	)
+	for _, path := range paths {
+		for _, end := range []relayerconf.PathEnd{path.Src, path.Dst} {
+			if end.ChannelID != "" {
+				channels[end.ChainID] = append(channels[end.ChainID], [2]string{end.PortID, end.ChannelID})
+			}
+			if path.Policy.MaxGas > maxGas[end.ChainID] {
+				maxGas[end.ChainID] = path.Policy.MaxGas
+			}
+			if memos[end.ChainID] == "" {
+				memos[end.ChainID] = path.Policy.Memo
+			}
+		}
+	}
+
+	for _, chain := range conf.Chains {
+		hc, err := newChain(chain)
+		if err != nil {
+			return Config{}, err
+		}
+		if len(paths) > 0 {
+			list, ok := channels[chain.ID]
+			if !ok {
+				continue
+			}
+			hc.PacketFilter = &PacketFilter{Policy: "allow", List: list}
+		}
+		if gas, ok := maxGas[chain.ID]; ok && gas > 0 {
+			hc.MaxGas = gas
+		}
+		hc.MemoPrefix = memos[chain.ID]
+		c.Chains = append(c.Chains, hc)
+	}
+
+	return c, nil
+}
+
+// newChain returns the Hermes config of a chain of the relayer.
+func newChain(chain relayerconf.Chain) (Chain, error) {
+	rpc, err := url.Parse(chain.RPCAddress)
+	if err != nil {
+		return Chain{}, fmt.Errorf("chain %s rpc address: %w", chain.ID, err)
+	}
+
+	ws := *rpc
+	ws.Scheme = "ws"
+	if rpc.Scheme == "https" {
+		ws.Scheme = "wss"
+	}
+	ws.Path = "/websocket"
+
+	grpcAddr := chain.GRPCAddress
+	if grpcAddr == "" {
+		grpcAddr = (&url.URL{Scheme: "http", Host: rpc.Hostname() + ":" + defaultGRPCPort}).String()
+	}
+
+	gasPrice, err := sdk.ParseDecCoin(chain.GasPrice)
+	if err != nil {
+		return Chain{}, fmt.Errorf("chain %s gas price: %w", chain.ID, err)
+	}
+	price, err := gasPrice.Amount.Float64()
+	if err != nil {
+		return Chain{}, fmt.Errorf("chain %s gas price: %w", chain.ID, err)
+	}
+
+	maxGas := chain.GasLimit
+	if maxGas <= 0 {
+		maxGas = defaultMaxGas
+	}
+
+	return Chain{
+		ID:             chain.ID,
+		RPCAddr:        rpc.String(),
+		GRPCAddr:       grpcAddr,
+		WebsocketAddr:  ws.String(),
+		RPCTimeout:     "10s",
+		AccountPrefix:  chain.AddressPrefix,
+		KeyName:        chain.Account,
+		StorePrefix:    "ibc",
+		DefaultGas:     100000,
+		MaxGas:         maxGas,
+		GasPrice:       GasPrice{Price: price, Denom: gasPrice.Denom},
+		GasMultiplier:  1.2,
+		MaxMsgNum:      30,
+		MaxTxSize:      2097152,
+		ClockDrift:     "5s",
+		MaxBlockTime:   "30s",
+		TrustingPeriod: "14days",
+		TrustThreshold: TrustThreshold{Numerator: "1", Denominator: "3"},
+		AddressType:    AddressType{Derivation: "cosmos"},
+	}, nil
+}
+
+// Save writes the config to path.
+func (c Config) Save(path string) error {
+	data, err := toml.Marshal(c)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
@@ -0,0 +1,348 @@
+// Package hermes is a relayer backend that drives the Hermes IBC relayer.
+package hermes
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/exec"
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/step"
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/pkg/relayer"
+	relayerconf "github.com/ignite/cli/ignite/pkg/relayer/config"
+)
+
+// DefaultBinary is the default binary of Hermes.
+const DefaultBinary = "hermes"
+
+var defaultConfigPath = os.ExpandEnv("$HOME/.ignite/relayer/hermes/config.toml")
+
+// Hermes links and relays the paths of the relayer with the Hermes binary.
+type Hermes struct {
+	binary     string
+	configPath string
+	ca         cosmosaccount.Registry
+	logs       io.Writer
+}
+
+var (
+	_ relayer.Backend        = Hermes{}
+	_ relayer.ChannelBackend = Hermes{}
+	_ relayer.ClientBackend  = Hermes{}
+)
+
+// Option configures Hermes.
+type Option func(*Hermes)
+
+// WithBinary sets the binary of Hermes.
+func WithBinary(binary string) Option {
+	return func(h *Hermes) {
+		h.binary = binary
+	}
+}
+
+// WithConfigPath sets the path of the config generated for Hermes.
+func WithConfigPath(path string) Option {
+	return func(h *Hermes) {
+		h.configPath = path
+	}
+}
+
+// WithAccountRegistry sets the keyring of the relayer accounts, Hermes signs
+// the transactions with the keys of the accounts of the keyring.
+func WithAccountRegistry(ca cosmosaccount.Registry) Option {
+	return func(h *Hermes) {
+		h.ca = ca
+	}
+}
+
+// WithLogs writes the logs of Hermes to w while it relays the packets.
+func WithLogs(w io.Writer) Option {
+	return func(h *Hermes) {
+		h.logs = w
+	}
+}
+
+// New returns the Hermes relayer backend.
+func New(options ...Option) Hermes {
+	h := Hermes{
+		binary:     DefaultBinary,
+		configPath: defaultConfigPath,
+		logs:       io.Discard,
+	}
+	for _, apply := range options {
+		apply(&h)
+	}
+	return h
+}
+
+// Link implements relayer.Backend, it creates the channel of the path with
+// "hermes create channel", on the connection of the path when it's set or
+// on new clients and a new connection.
+func (h Hermes) Link(ctx context.Context, conf relayerconf.Config, path relayerconf.Path) (relayerconf.Path, error) {
+	if err := h.prepare(conf, nil, path.Src.ChainID, path.Dst.ChainID); err != nil {
+		return relayerconf.Path{}, err
+	}
+
+	var out bytes.Buffer
+	if err := h.run(ctx, createChannelArgs(path), step.Stdout(&out)); err != nil {
+		return relayerconf.Path{}, err
+	}
+
+	channel, err := parseChannel(&out)
+	if err != nil {
+		return relayerconf.Path{}, err
+	}
+
+	path.Src.ConnectionID = channel.ASide.ConnectionID
+	path.Src.ChannelID = channel.ASide.ChannelID
+	path.Dst.ConnectionID = channel.BSide.ConnectionID
+	path.Dst.ChannelID = channel.BSide.ChannelID
+	return path, nil
+}
+
+// Start implements relayer.Backend, it relays the packets of the channels of
+// the paths with "hermes start" until ctx is canceled. Hermes keeps track of
+// the packets, so the paths are not updated.
+func (h Hermes) Start(
+	ctx context.Context,
+	conf relayerconf.Config,
+	paths []relayerconf.Path,
+	_ func(relayerconf.Path) error,
+) error {
+	var chainIDs []string
+	for _, path := range paths {
+		chainIDs = append(chainIDs, path.Src.ChainID, path.Dst.ChainID)
+	}
+	if err := h.prepare(conf, paths, chainIDs...); err != nil {
+		return err
+	}
+
+	err := h.run(ctx, []string{"start"}, step.Stdout(h.logs), step.Stderr(h.logs))
+	if ctx.Err() != nil {
+		return nil
+	}
+	return err
+}
+
+// CloseChannel implements relayer.ChannelBackend, it closes the channel of the
+// path on its source end with "hermes tx chan-close-init" and on its target
+// end with "hermes tx chan-close-confirm".
+func (h Hermes) CloseChannel(ctx context.Context, conf relayerconf.Config, path relayerconf.Path) error {
+	if err := h.prepare(conf, nil, path.Src.ChainID, path.Dst.ChainID); err != nil {
+		return err
+	}
+
+	if err := h.run(ctx, channelArgs("chan-close-init", path.Dst, path.Src)); err != nil {
+		return err
+	}
+	return h.run(ctx, channelArgs("chan-close-confirm", path.Src, path.Dst))
+}
+
+// UpgradeChannel implements relayer.ChannelBackend, it initiates the upgrade
+// of the channel of the path on its source end with "hermes tx
+// chan-upgrade-init", Hermes completes the upgrade handshake when it relays
+// the packets of the channel.
+func (h Hermes) UpgradeChannel(
+	ctx context.Context,
+	conf relayerconf.Config,
+	path relayerconf.Path,
+	upgrade relayer.ChannelUpgrade,
+) error {
+	if err := h.prepare(conf, nil, path.Src.ChainID, path.Dst.ChainID); err != nil {
+		return err
+	}
+
+	args := []string{
+		"tx", "chan-upgrade-init",
+		"--src-chain", path.Src.ChainID,
+		"--dst-chain", path.Dst.ChainID,
+		"--src-port", path.Src.PortID,
+		"--dst-port", path.Dst.PortID,
+		"--src-channel", path.Src.ChannelID,
+		"--dst-channel", path.Dst.ChannelID,
+	}
+	if upgrade.Version != "" {
+		args = append(args, "--version", upgrade.Version)
+	}
+	switch upgrade.Ordering {
+	case relayer.OrderingOrdered:
+		args = append(args, "--ordering", "ordered")
+	case relayer.OrderingUnordered:
+		args = append(args, "--ordering", "unordered")
+	}
+	if upgrade.ConnectionID != "" {
+		args = append(args, "--connection-hops", upgrade.ConnectionID)
+	}
+	return h.run(ctx, args)
+}
+
+// UpdateClient implements relayer.ClientBackend, it updates the client hosted
+// on the chain with "hermes update client".
+func (h Hermes) UpdateClient(ctx context.Context, conf relayerconf.Config, chainID, clientID string) error {
+	if err := h.prepare(conf, nil, chainID); err != nil {
+		return err
+	}
+	return h.run(ctx, []string{
+		"update", "client",
+		"--host-chain", chainID,
+		"--client", clientID,
+	})
+}
+
+// createChannelArgs returns the arguments of the Hermes command that creates
+// the channel of the path, on the connection of the path when it's set or
+// on a new connection between new clients.
+func createChannelArgs(path relayerconf.Path) []string {
+	args := []string{"create", "channel", "--a-chain", path.Src.ChainID}
+	if path.Src.ConnectionID != "" {
+		args = append(args, "--a-connection", path.Src.ConnectionID)
+	} else {
+		args = append(args, "--b-chain", path.Dst.ChainID, "--new-client-connection", "--yes")
+	}
+	args = append(args, "--a-port", path.Src.PortID, "--b-port", path.Dst.PortID)
+	if path.Ordering == "ORDER_ORDERED" {
+		args = append(args, "--order", "ordered")
+	}
+	if path.Src.Version != "" {
+		args = append(args, "--channel-version", path.Src.Version)
+	}
+	return args
+}
+
+// channelArgs returns the arguments of a "hermes tx" channel command that
+// sends the messages to the dst end of the channel with the proofs of its src
+// end.
+func channelArgs(command string, src, dst relayerconf.PathEnd) []string {
+	return []string{
+		"tx", command,
+		"--src-chain", src.ChainID,
+		"--dst-chain", dst.ChainID,
+		"--dst-connection", dst.ConnectionID,
+		"--src-port", src.PortID,
+		"--dst-port", dst.PortID,
+		"--src-channel", src.ChannelID,
+		"--dst-channel", dst.ChannelID,
+	}
+}
+
+// prepare writes the config of Hermes and the keys of the relayer accounts of
+// the chains in the key store of the config.
+func (h Hermes) prepare(conf relayerconf.Config, paths []relayerconf.Path, chainIDs ...string) error {
+	hc, err := NewConfig(conf, paths)
+	if err != nil {
+		return err
+	}
+
+	keyStore := filepath.Join(filepath.Dir(h.configPath), keyStoreDir)
+	for i := range hc.Chains {
+		hc.Chains[i].KeyStoreFolder = keyStore
+	}
+	if err := hc.Save(h.configPath); err != nil {
+		return err
+	}
+
+	written := make(map[string]bool)
+	for _, id := range chainIDs {
+		if written[id] {
+			continue
+		}
+		written[id] = true
+
+		chain, err := conf.ChainByID(id)
+		if err != nil {
+			return err
+		}
+		if err := h.writeKey(keyStore, chain); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeKey writes the key of the relayer account of the chain in the key
+// store of Hermes, the key is exported from the keyring of the relayer
+// accounts.
+func (h Hermes) writeKey(keyStore string, chain relayerconf.Chain) error {
+	priv, err := relayer.PrivKey(h.ca, chain.Account)
+	if err != nil {
+		return err
+	}
+
+	key, err := newKeyFile(priv, chain.AddressPrefix)
+	if err != nil {
+		return fmt.Errorf("key of chain %s: %w", chain.ID, err)
+	}
+	data, err := json.Marshal(key)
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(keyStore, chain.ID, keyringTestDir, chain.Account+".json")
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+// run runs Hermes with the generated config.
+func (h Hermes) run(ctx context.Context, args []string, options ...step.Option) error {
+	command := append([]string{h.binary, "--config", h.configPath}, args...)
+
+	var execOptions []exec.Option
+	for _, o := range options {
+		execOptions = append(execOptions, exec.StepOption(o))
+	}
+	return exec.Exec(ctx, command, execOptions...)
+}
+
+// channelSide is an end of a channel created by Hermes.
+type channelSide struct {
+	ConnectionID string `json:"connection_id"`
+	ChannelID    string `json:"channel_id"`
+}
+
+// channel is a channel created by Hermes.
+type channel struct {
+	ASide channelSide `json:"a_side"`
+	BSide channelSide `json:"b_side"`
+}
+
+// parseChannel parses the channel created by "hermes create channel", the
+// output has a JSON line with the status of the command and its result.
+func parseChannel(r io.Reader) (channel, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		var res struct {
+			Status string          `json:"status"`
+			Result json.RawMessage `json:"result"`
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &res); err != nil || res.Status == "" {
+			continue
+		}
+		if res.Status != "success" {
+			return channel{}, fmt.Errorf("hermes create channel: %s", res.Result)
+		}
+
+		var c channel
+		if err := json.Unmarshal(res.Result, &c); err != nil {
+			return channel{}, err
+		}
+		if c.ASide.ChannelID == "" || c.BSide.ChannelID == "" {
+			return channel{}, errors.New("hermes create channel: the channel IDs are missing")
+		}
+		return c, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return channel{}, err
+	}
+	return channel{}, errors.New("hermes create channel: the result is missing")
+}
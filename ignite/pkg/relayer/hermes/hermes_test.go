@@ -0,0 +1,203 @@
+package hermes
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/pkg/relayer"
+	relayerconf "github.com/ignite/cli/ignite/pkg/relayer/config"
+)
+
+func TestNewConfig(t *testing.T) {
+	conf := relayerconf.Config{
+		Chains: []relayerconf.Chain{
+			{
+				ID:            "mars",
+				Account:       "alice",
+				AddressPrefix: "cosmos",
+				RPCAddress:    "http://localhost:26657",
+				GasPrice:      "0.025stake",
+			},
+			{
+				ID:            "venus",
+				Account:       "bob",
+				AddressPrefix: "venus",
+				RPCAddress:    "https://rpc.venus.com:443",
+				GRPCAddress:   "https://grpc.venus.com:443",
+				GasPrice:      "0.1uvenus",
+				GasLimit:      400000,
+			},
+			{
+				ID:         "earth",
+				RPCAddress: "http://localhost:36657",
+				GasPrice:   "1stake",
+			},
+		},
+	}
+	paths := []relayerconf.Path{
+		{
+			ID:     "mars-venus",
+			Src:    relayerconf.PathEnd{ChainID: "mars", PortID: "transfer", ChannelID: "channel-0"},
+			Dst:    relayerconf.PathEnd{ChainID: "venus", PortID: "transfer", ChannelID: "channel-3"},
+			Policy: relayerconf.Policy{MaxGas: 5000000, Memo: "ignite"},
+		},
+	}
+
+	c, err := NewConfig(conf, paths)
+	require.NoError(t, err)
+	require.Len(t, c.Chains, 2)
+
+	mars := c.Chains[0]
+	require.Equal(t, "mars", mars.ID)
+	require.Equal(t, "alice", mars.KeyName)
+	require.Equal(t, "http://localhost:26657", mars.RPCAddr)
+	require.Equal(t, "http://localhost:9090", mars.GRPCAddr)
+	require.Equal(t, "ws://localhost:26657/websocket", mars.WebsocketAddr)
+	require.Equal(t, GasPrice{Price: 0.025, Denom: "stake"}, mars.GasPrice)
+	require.EqualValues(t, 5000000, mars.MaxGas)
+	require.Equal(t, "ignite", mars.MemoPrefix)
+	require.Equal(t, &PacketFilter{Policy: "allow", List: [][2]string{{"transfer", "channel-0"}}}, mars.PacketFilter)
+
+	venus := c.Chains[1]
+	require.Equal(t, "https://grpc.venus.com:443", venus.GRPCAddr)
+	require.Equal(t, "wss://rpc.venus.com:443/websocket", venus.WebsocketAddr)
+	require.EqualValues(t, 5000000, venus.MaxGas)
+	require.Equal(t, &PacketFilter{Policy: "allow", List: [][2]string{{"transfer", "channel-3"}}}, venus.PacketFilter)
+
+	// all the chains are relayed without paths.
+	c, err = NewConfig(conf, nil)
+	require.NoError(t, err)
+	require.Len(t, c.Chains, 3)
+	require.Nil(t, c.Chains[0].PacketFilter)
+	require.EqualValues(t, defaultMaxGas, c.Chains[0].MaxGas)
+	require.Empty(t, c.Chains[0].MemoPrefix)
+}
+
+func TestParseChannel(t *testing.T) {
+	tests := []struct {
+		name    string
+		output  string
+		want    channel
+		wantErr string
+	}{
+		{
+			name: "success",
+			output: `2023-01-01T00:00:00Z  INFO ThreadId(01) creating channel
+{"result":{"a_side":{"channel_id":"channel-0","connection_id":"connection-1"},"b_side":{"channel_id":"channel-2","connection_id":"connection-3"}},"status":"success"}`,
+			want: channel{
+				ASide: channelSide{ConnectionID: "connection-1", ChannelID: "channel-0"},
+				BSide: channelSide{ConnectionID: "connection-3", ChannelID: "channel-2"},
+			},
+		},
+		{
+			name:    "error",
+			output:  `{"result":"chain mars not found","status":"error"}`,
+			wantErr: `hermes create channel: "chain mars not found"`,
+		},
+		{
+			name:    "missing result",
+			output:  "creating channel",
+			wantErr: "hermes create channel: the result is missing",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseChannel(strings.NewReader(tt.output))
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWriteKey(t *testing.T) {
+	ca, err := cosmosaccount.NewInMemory()
+	require.NoError(t, err)
+	account, _, err := ca.Create("alice")
+	require.NoError(t, err)
+	addr, err := account.Address("mars")
+	require.NoError(t, err)
+
+	var (
+		dir = t.TempDir()
+		h   = New(WithConfigPath(filepath.Join(dir, "config.toml")), WithAccountRegistry(ca))
+	)
+	require.NoError(t, h.writeKey(filepath.Join(dir, keyStoreDir), relayerconf.Chain{
+		ID:            "mars",
+		Account:       "alice",
+		AddressPrefix: "mars",
+	}))
+
+	data, err := os.ReadFile(filepath.Join(dir, "keys", "mars", "keyring-test", "alice.json"))
+	require.NoError(t, err)
+
+	var key keyFile
+	require.NoError(t, json.Unmarshal(data, &key))
+	require.Equal(t, addr, key.Account)
+	require.Equal(t, "Cosmos", key.AddressType)
+
+	priv, err := relayer.PrivKey(ca, "alice")
+	require.NoError(t, err)
+	require.Equal(t, hex.EncodeToString(priv.Bytes()), key.PrivateKey)
+	require.Equal(t, hex.EncodeToString(priv.PubKey().Bytes()), key.PublicKey)
+	require.Equal(t, []byte(priv.PubKey().Address()), key.Address[:])
+}
+
+func TestChannelArgs(t *testing.T) {
+	src := relayerconf.PathEnd{ChainID: "mars", ConnectionID: "connection-0", PortID: "transfer", ChannelID: "channel-0"}
+	dst := relayerconf.PathEnd{ChainID: "venus", ConnectionID: "connection-1", PortID: "blog", ChannelID: "channel-2"}
+
+	require.Equal(t, []string{
+		"tx", "chan-close-init",
+		"--src-chain", "venus",
+		"--dst-chain", "mars",
+		"--dst-connection", "connection-0",
+		"--src-port", "blog",
+		"--dst-port", "transfer",
+		"--src-channel", "channel-2",
+		"--dst-channel", "channel-0",
+	}, channelArgs("chan-close-init", dst, src))
+}
+
+func TestCreateChannelArgs(t *testing.T) {
+	path := relayerconf.Path{
+		ID:       "mars-venus",
+		Ordering: "ORDER_ORDERED",
+		Src:      relayerconf.PathEnd{ChainID: "mars", PortID: "transfer", Version: "ics20-1"},
+		Dst:      relayerconf.PathEnd{ChainID: "venus", PortID: "transfer", Version: "ics20-1"},
+	}
+
+	require.Equal(t, []string{
+		"create", "channel",
+		"--a-chain", "mars",
+		"--b-chain", "venus",
+		"--new-client-connection",
+		"--yes",
+		"--a-port", "transfer",
+		"--b-port", "transfer",
+		"--order", "ordered",
+		"--channel-version", "ics20-1",
+	}, createChannelArgs(path))
+
+	path.Src.ConnectionID = "connection-0"
+	path.Dst.ConnectionID = "connection-3"
+	require.Equal(t, []string{
+		"create", "channel",
+		"--a-chain", "mars",
+		"--a-connection", "connection-0",
+		"--a-port", "transfer",
+		"--b-port", "transfer",
+		"--order", "ordered",
+		"--channel-version", "ics20-1",
+	}, createChannelArgs(path))
+}
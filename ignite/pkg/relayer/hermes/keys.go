@@ -0,0 +1,55 @@
+package hermes
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+const (
+	// keyStoreDir is the directory of the key store of Hermes next to its config.
+	keyStoreDir = "keys"
+
+	// keyringTestDir is the directory of the keys of a chain in the key store
+	// of Hermes for the test keyring, the keys are not encrypted.
+	keyringTestDir = "keyring-test"
+
+	// addressTypeCosmos is the type of the addresses derived from the keys
+	// like the addresses of the Cosmos SDK.
+	addressTypeCosmos = "Cosmos"
+)
+
+// keyFile is a key of the key store of Hermes.
+type keyFile struct {
+	PrivateKey  string   `json:"private_key"`
+	PublicKey   string   `json:"public_key"`
+	Address     [20]byte `json:"address"`
+	AddressType string   `json:"address_type"`
+	Account     string   `json:"account"`
+}
+
+// newKeyFile returns the key of the key store of Hermes of the secp256k1
+// private key, the account of the key is the address with the prefix.
+func newKeyFile(priv cryptotypes.PrivKey, addressPrefix string) (keyFile, error) {
+	if _, ok := priv.(*secp256k1.PrivKey); !ok {
+		return keyFile{}, fmt.Errorf("unsupported private key %s, only secp256k1 keys are supported", priv.Type())
+	}
+
+	pub := priv.PubKey()
+	account, err := bech32.ConvertAndEncode(addressPrefix, pub.Address())
+	if err != nil {
+		return keyFile{}, err
+	}
+
+	key := keyFile{
+		PrivateKey:  hex.EncodeToString(priv.Bytes()),
+		PublicKey:   hex.EncodeToString(pub.Bytes()),
+		AddressType: addressTypeCosmos,
+		Account:     account,
+	}
+	copy(key.Address[:], pub.Address())
+	return key, nil
+}
@@ -0,0 +1,362 @@
+package relayer
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v5/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	ibctm "github.com/cosmos/ibc-go/v5/modules/light-clients/07-tendermint/types"
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+)
+
+const (
+	eventSendPacket = "send_packet"
+	eventWriteAck   = "write_acknowledgement"
+)
+
+// ibcQuerier queries the IBC state of a chain.
+type ibcQuerier struct {
+	client     cosmosclient.Client
+	ibcClient  clienttypes.QueryClient
+	connection connectiontypes.QueryClient
+	channel    channeltypes.QueryClient
+}
+
+// newIBCQuerier returns a querier of the IBC state of the chain with the RPC address.
+func newIBCQuerier(ctx context.Context, rpcAddress string) (ibcQuerier, error) {
+	client, err := cosmosclient.New(ctx, cosmosclient.WithNodeAddress(rpcAddress))
+	if err != nil {
+		return ibcQuerier{}, err
+	}
+
+	return ibcQuerier{
+		client:     client,
+		ibcClient:  clienttypes.NewQueryClient(client.Context()),
+		connection: connectiontypes.NewQueryClient(client.Context()),
+		channel:    channeltypes.NewQueryClient(client.Context()),
+	}, nil
+}
+
+// latest returns the latest height and block time of the chain.
+func (q ibcQuerier) latest(ctx context.Context) (clienttypes.Height, time.Time, error) {
+	status, err := q.client.Status(ctx)
+	if err != nil {
+		return clienttypes.Height{}, time.Time{}, err
+	}
+
+	height := clienttypes.NewHeight(
+		clienttypes.ParseChainID(status.NodeInfo.Network),
+		uint64(status.SyncInfo.LatestBlockHeight),
+	)
+	return height, status.SyncInfo.LatestBlockTime, nil
+}
+
+// connectionClient returns the ID of the client of the connection.
+func (q ibcQuerier) connectionClient(ctx context.Context, connectionID string) (string, error) {
+	res, err := q.connection.Connection(ctx, &connectiontypes.QueryConnectionRequest{ConnectionId: connectionID})
+	if err != nil {
+		return "", err
+	}
+	return res.Connection.ClientId, nil
+}
+
+// tendermintClient returns the state of the Tendermint light client, the
+// state of its latest consensus and its status.
+func (q ibcQuerier) tendermintClient(ctx context.Context, clientID string) (
+	client ibctm.ClientState, consensus ibctm.ConsensusState, status string, err error,
+) {
+	clientRes, err := q.ibcClient.ClientState(ctx, &clienttypes.QueryClientStateRequest{ClientId: clientID})
+	if err != nil {
+		return client, consensus, "", err
+	}
+	if err := unpackAny(clientRes.ClientState, &client); err != nil {
+		return client, consensus, "", fmt.Errorf("client %s: %w", clientID, err)
+	}
+
+	consensusRes, err := q.ibcClient.ConsensusState(ctx, &clienttypes.QueryConsensusStateRequest{
+		ClientId:     clientID,
+		LatestHeight: true,
+	})
+	if err != nil {
+		return client, consensus, "", err
+	}
+	if err := unpackAny(consensusRes.ConsensusState, &consensus); err != nil {
+		return client, consensus, "", fmt.Errorf("client %s: %w", clientID, err)
+	}
+
+	statusRes, err := q.ibcClient.ClientStatus(ctx, &clienttypes.QueryClientStatusRequest{ClientId: clientID})
+	if err != nil {
+		return client, consensus, "", err
+	}
+	return client, consensus, statusRes.Status, nil
+}
+
+// unpackAny unmarshals the value of any into msg, the type of any must be the
+// type of msg.
+func unpackAny(any *codectypes.Any, msg codec.ProtoMarshaler) error {
+	if any == nil {
+		return errors.New("missing state")
+	}
+	if typeURL := "/" + proto.MessageName(msg); any.TypeUrl != typeURL {
+		return fmt.Errorf("unsupported state %s, only Tendermint light clients are supported", any.TypeUrl)
+	}
+	return msg.Unmarshal(any.Value)
+}
+
+// connections returns the connections of the chain.
+func (q ibcQuerier) connections(ctx context.Context) ([]*connectiontypes.IdentifiedConnection, error) {
+	var (
+		connections []*connectiontypes.IdentifiedConnection
+		page        = &query.PageRequest{}
+	)
+	for {
+		res, err := q.connection.Connections(ctx, &connectiontypes.QueryConnectionsRequest{Pagination: page})
+		if err != nil {
+			return nil, err
+		}
+		connections = append(connections, res.Connections...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return connections, nil
+		}
+		page = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+}
+
+// commitments returns the sequences of the packets sent on the channel that
+// are not acknowledged yet.
+func (q ibcQuerier) commitments(ctx context.Context, portID, channelID string) ([]uint64, error) {
+	var (
+		sequences []uint64
+		page      = &query.PageRequest{}
+	)
+	for {
+		res, err := q.channel.PacketCommitments(ctx, &channeltypes.QueryPacketCommitmentsRequest{
+			PortId:     portID,
+			ChannelId:  channelID,
+			Pagination: page,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range res.Commitments {
+			sequences = append(sequences, c.Sequence)
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return sequences, nil
+		}
+		page = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+}
+
+// acknowledgements returns the sequences of the latest packets received on
+// the channel, limit is the max number of sequences returned.
+func (q ibcQuerier) acknowledgements(ctx context.Context, portID, channelID string, limit uint64) ([]uint64, error) {
+	res, err := q.channel.PacketAcknowledgements(ctx, &channeltypes.QueryPacketAcknowledgementsRequest{
+		PortId:     portID,
+		ChannelId:  channelID,
+		Pagination: &query.PageRequest{Limit: limit, Reverse: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sequences := make([]uint64, 0, len(res.Acknowledgements))
+	for _, a := range res.Acknowledgements {
+		sequences = append(sequences, a.Sequence)
+	}
+	return sequences, nil
+}
+
+// unreceived returns the sequences of the packets that are not received on
+// the channel.
+func (q ibcQuerier) unreceived(ctx context.Context, portID, channelID string, sequences []uint64) ([]uint64, error) {
+	if len(sequences) == 0 {
+		return nil, nil
+	}
+
+	res, err := q.channel.UnreceivedPackets(ctx, &channeltypes.QueryUnreceivedPacketsRequest{
+		PortId:                    portID,
+		ChannelId:                 channelID,
+		PacketCommitmentSequences: sequences,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Sequences, nil
+}
+
+// sentPacket returns the timeout of the packet sent on the channel with the
+// sequence, found is false when the transaction of the packet is not indexed
+// by the node.
+func (q ibcQuerier) sentPacket(ctx context.Context, portID, channelID string, sequence uint64) (
+	timeout packetTimeout, found bool, err error,
+) {
+	events, err := q.searchEvents(ctx, eventSendPacket, fmt.Sprintf(
+		"%[1]s.packet_src_port='%[2]s' AND %[1]s.packet_src_channel='%[3]s' AND %[1]s.packet_sequence='%[4]d'",
+		eventSendPacket,
+		portID,
+		channelID,
+		sequence,
+	), 1)
+	if err != nil || len(events) == 0 {
+		return packetTimeout{}, false, err
+	}
+
+	timeout, err = parsePacketTimeout(events[0])
+	return timeout, err == nil, err
+}
+
+// acknowledgementErrors returns the errors of the acknowledgements written
+// for the latest packets received on the channel by sequence, the
+// successful acknowledgements have an empty error.
+func (q ibcQuerier) acknowledgementErrors(ctx context.Context, portID, channelID string, limit int) (map[uint64]string, error) {
+	events, err := q.searchEvents(ctx, eventWriteAck, fmt.Sprintf(
+		"%[1]s.packet_dst_port='%[2]s' AND %[1]s.packet_dst_channel='%[3]s'",
+		eventWriteAck,
+		portID,
+		channelID,
+	), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := make(map[uint64]string)
+	for _, attrs := range events {
+		sequence, err := parseSequence(attrs)
+		if err != nil {
+			continue
+		}
+		_, errs[sequence] = parseAcknowledgement(attrs[channeltypes.AttributeKeyAckHex])
+	}
+	return errs, nil
+}
+
+// acknowledgement returns the hex encoded acknowledgement written for the
+// packet received on the channel with the sequence, found is false when the
+// packet is not acknowledged or when its transaction is not indexed by the
+// node.
+func (q ibcQuerier) acknowledgement(ctx context.Context, portID, channelID string, sequence uint64) (
+	ackHex string, found bool, err error,
+) {
+	events, err := q.searchEvents(ctx, eventWriteAck, fmt.Sprintf(
+		"%[1]s.packet_dst_port='%[2]s' AND %[1]s.packet_dst_channel='%[3]s' AND %[1]s.packet_sequence='%[4]d'",
+		eventWriteAck,
+		portID,
+		channelID,
+		sequence,
+	), 1)
+	if err != nil || len(events) == 0 {
+		return "", false, err
+	}
+	return events[0][channeltypes.AttributeKeyAckHex], true, nil
+}
+
+// searchEvents returns the attributes of the events of the latest
+// transactions that match the query, limit is the max number of transactions.
+func (q ibcQuerier) searchEvents(ctx context.Context, eventType, search string, limit int) ([]map[string]string, error) {
+	var (
+		page    = 1
+		perPage = limit
+	)
+	res, err := q.client.RPC.TxSearch(ctx, search, false, &page, &perPage, "desc")
+	if err != nil {
+		return nil, err
+	}
+
+	var events []map[string]string
+	for _, tx := range res.Txs {
+		events = append(events, eventAttributes(tx.TxResult.Events, eventType)...)
+	}
+	return events, nil
+}
+
+// eventAttributes returns the attributes of the events with the type.
+func eventAttributes(events []abci.Event, eventType string) []map[string]string {
+	var attrs []map[string]string
+	for _, e := range events {
+		if e.Type != eventType {
+			continue
+		}
+		m := make(map[string]string)
+		for _, a := range e.Attributes {
+			m[string(a.Key)] = string(a.Value)
+		}
+		attrs = append(attrs, m)
+	}
+	return attrs
+}
+
+// packetTimeout is the timeout of a packet.
+type packetTimeout struct {
+	height    clienttypes.Height
+	timestamp uint64
+}
+
+// isExpired returns true when the timeout is reached on the destination
+// chain at the height and block time.
+func (t packetTimeout) isExpired(height clienttypes.Height, blockTime time.Time) bool {
+	if !t.height.IsZero() && height.GTE(t.height) {
+		return true
+	}
+	return t.timestamp != 0 && uint64(blockTime.UnixNano()) >= t.timestamp
+}
+
+// parsePacketTimeout parses the timeout of the attributes of a send_packet event.
+func parsePacketTimeout(attrs map[string]string) (packetTimeout, error) {
+	var (
+		t   packetTimeout
+		err error
+	)
+	if h := attrs[channeltypes.AttributeKeyTimeoutHeight]; h != "" {
+		if t.height, err = clienttypes.ParseHeight(h); err != nil {
+			return packetTimeout{}, err
+		}
+	}
+	if ts := attrs[channeltypes.AttributeKeyTimeoutTimestamp]; ts != "" {
+		if t.timestamp, err = strconv.ParseUint(ts, 10, 64); err != nil {
+			return packetTimeout{}, err
+		}
+	}
+	return t, nil
+}
+
+// parseSequence parses the sequence of the attributes of a packet event.
+func parseSequence(attrs map[string]string) (uint64, error) {
+	sequence, err := strconv.ParseUint(attrs[channeltypes.AttributeKeySequence], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid packet sequence: %w", err)
+	}
+	return sequence, nil
+}
+
+// parseAcknowledgement returns the result or the error of the hex encoded
+// JSON acknowledgement, the error is empty when the acknowledgement is
+// successful.
+func parseAcknowledgement(ackHex string) (result []byte, ackErr string) {
+	data, err := hex.DecodeString(ackHex)
+	if err != nil {
+		return nil, ""
+	}
+
+	var ack struct {
+		Result []byte `json:"result"`
+		Error  string `json:"error"`
+	}
+	if err := json.Unmarshal(data, &ack); err != nil {
+		return nil, ""
+	}
+	return ack.Result, ack.Error
+}
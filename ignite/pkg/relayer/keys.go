@@ -0,0 +1,36 @@
+package relayer
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+)
+
+const algoSecp256k1 = "secp256k1"
+
+// PrivKey returns the private key of the account of the Ignite CLI keyring,
+// the relayer backends sign the transactions of the relayer accounts with the
+// keys of the keyring so they don't have keyrings of their own.
+// The IBC relayers expect secp256k1 private keys.
+func PrivKey(ca cosmosaccount.Registry, name string) (cryptotypes.PrivKey, error) {
+	// Get the key in ASCII armored format
+	passphrase := ""
+	key, err := ca.Export(name, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unarmor the key to be able to read it as bytes
+	priv, algo, err := crypto.UnarmorDecryptPrivKey(key, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	if algo != algoSecp256k1 {
+		return nil, fmt.Errorf("private key algorithm of account %s must be secp256k1 instead of %s", name, algo)
+	}
+	return priv, nil
+}
@@ -0,0 +1,215 @@
+package relayer
+
+import (
+	"context"
+	"sort"
+
+	relayerconf "github.com/ignite/cli/ignite/pkg/relayer/config"
+)
+
+// DefaultPacketsLimit is the default max number of received packets listed
+// for each end of a channel.
+const DefaultPacketsLimit = 50
+
+// PacketStatus is the status of a packet.
+type PacketStatus string
+
+const (
+	// PacketPending is a packet sent and not received yet.
+	PacketPending PacketStatus = "pending"
+
+	// PacketTimedOut is a packet not received before its timeout, it must be
+	// timed out on its source chain to refund its sender.
+	PacketTimedOut PacketStatus = "timed-out"
+
+	// PacketRelayed is a packet received by the destination chain.
+	PacketRelayed PacketStatus = "relayed"
+)
+
+// AckStatus is the status of the acknowledgement of a packet.
+type AckStatus string
+
+const (
+	// AckNone is the status of the packets that are not received.
+	AckNone AckStatus = ""
+
+	// AckPending is the acknowledgement of a received packet that is not
+	// relayed back to the source chain yet.
+	AckPending AckStatus = "pending"
+
+	// AckSucceeded is a successful acknowledgement relayed back to the source chain.
+	AckSucceeded AckStatus = "success"
+
+	// AckFailed is an error acknowledgement relayed back to the source chain.
+	AckFailed AckStatus = "error"
+)
+
+// Packet is a packet sent on a channel.
+type Packet struct {
+	// Sequence is the sequence of the packet on the channel.
+	Sequence uint64
+
+	// Status is the status of the packet.
+	Status PacketStatus
+
+	// AckStatus is the status of the acknowledgement of the packet.
+	AckStatus AckStatus
+
+	// AckError is the error of the acknowledgement when it failed.
+	AckError string
+}
+
+// ChannelPackets are the packets sent from the source end of a channel to
+// its destination end.
+type ChannelPackets struct {
+	Src relayerconf.PathEnd
+	Dst relayerconf.PathEnd
+
+	// Packets are the pending packets and the latest relayed packets sorted
+	// by sequence.
+	Packets []Packet
+}
+
+// ListPackets returns the packets sent in both directions on the channel of
+// a linked path. All the packets that are not acknowledged are listed, and
+// at most limit of the latest acknowledged packets for each direction.
+// The packets that are not received are timed out when their transaction is
+// indexed by the source chain and their timeout is reached, and the errors of
+// the acknowledgements are known when their transactions are indexed by the
+// destination chain.
+func (r Relayer) ListPackets(ctx context.Context, pathID string, limit uint64) ([]ChannelPackets, error) {
+	conf, path, err := r.linkedPath(pathID)
+	if err != nil {
+		return nil, err
+	}
+
+	src, err := r.chainQuerier(ctx, conf, path.Src.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	dst, err := r.chainQuerier(ctx, conf, path.Dst.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	srcToDst, err := listChannelPackets(ctx, src, dst, path.Src, path.Dst, limit)
+	if err != nil {
+		return nil, err
+	}
+	dstToSrc, err := listChannelPackets(ctx, dst, src, path.Dst, path.Src, limit)
+	if err != nil {
+		return nil, err
+	}
+	return []ChannelPackets{srcToDst, dstToSrc}, nil
+}
+
+// chainQuerier returns the querier of the IBC state of the chain.
+func (r Relayer) chainQuerier(ctx context.Context, conf relayerconf.Config, chainID string) (ibcQuerier, error) {
+	chain, err := conf.ChainByID(chainID)
+	if err != nil {
+		return ibcQuerier{}, err
+	}
+	return newIBCQuerier(ctx, chain.RPCAddress)
+}
+
+// listChannelPackets returns the packets sent from the src end of a channel
+// to its dst end.
+func listChannelPackets(
+	ctx context.Context,
+	src, dst ibcQuerier,
+	srcEnd, dstEnd relayerconf.PathEnd,
+	limit uint64,
+) (ChannelPackets, error) {
+	cp := ChannelPackets{Src: srcEnd, Dst: dstEnd}
+
+	// the commitments of the packets are removed from the source chain when
+	// their acknowledgements are relayed back.
+	commitments, err := src.commitments(ctx, srcEnd.PortID, srcEnd.ChannelID)
+	if err != nil {
+		return cp, err
+	}
+	unreceived, err := dst.unreceived(ctx, dstEnd.PortID, dstEnd.ChannelID, commitments)
+	if err != nil {
+		return cp, err
+	}
+
+	// the acknowledgements are kept by the destination chain.
+	acks, err := dst.acknowledgements(ctx, dstEnd.PortID, dstEnd.ChannelID, limit)
+	if err != nil {
+		return cp, err
+	}
+
+	// the errors of the acknowledgements and the timeouts of the packets are
+	// read from the transactions, they are unknown when the nodes don't index
+	// the transactions.
+	ackErrs, _ := dst.acknowledgementErrors(ctx, dstEnd.PortID, dstEnd.ChannelID, int(limit))
+
+	timedOut := make(map[uint64]bool)
+	if len(unreceived) > 0 {
+		height, blockTime, err := dst.latest(ctx)
+		if err != nil {
+			return cp, err
+		}
+		for _, sequence := range unreceived {
+			timeout, found, _ := src.sentPacket(ctx, srcEnd.PortID, srcEnd.ChannelID, sequence)
+			timedOut[sequence] = found && timeout.isExpired(height, blockTime)
+		}
+	}
+
+	cp.Packets = packetsStatus(commitments, unreceived, acks, ackErrs, timedOut)
+	return cp, nil
+}
+
+// packetsStatus returns the status of the packets of a channel from the
+// sequences of the packets committed on the source chain, of the committed
+// packets that are not received, and of the packets acknowledged on the
+// destination chain.
+func packetsStatus(
+	commitments, unreceived, acks []uint64,
+	ackErrs map[uint64]string,
+	timedOut map[uint64]bool,
+) []Packet {
+	var (
+		packets   = make(map[uint64]Packet)
+		committed = make(map[uint64]bool)
+		pending   = make(map[uint64]bool)
+	)
+	for _, sequence := range unreceived {
+		pending[sequence] = true
+	}
+	for _, sequence := range commitments {
+		committed[sequence] = true
+
+		p := Packet{Sequence: sequence}
+		switch {
+		case pending[sequence] && timedOut[sequence]:
+			p.Status = PacketTimedOut
+		case pending[sequence]:
+			p.Status = PacketPending
+		default:
+			p.Status = PacketRelayed
+			p.AckStatus = AckPending
+			p.AckError = ackErrs[sequence]
+		}
+		packets[sequence] = p
+	}
+	for _, sequence := range acks {
+		if committed[sequence] {
+			continue
+		}
+
+		p := Packet{Sequence: sequence, Status: PacketRelayed, AckStatus: AckSucceeded}
+		if err := ackErrs[sequence]; err != "" {
+			p.AckStatus = AckFailed
+			p.AckError = err
+		}
+		packets[sequence] = p
+	}
+
+	list := make([]Packet, 0, len(packets))
+	for _, p := range packets {
+		list = append(list, p)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Sequence < list[j].Sequence })
+	return list
+}
@@ -0,0 +1,63 @@
+package relayer
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPacketsStatus(t *testing.T) {
+	packets := packetsStatus(
+		[]uint64{4, 5, 6},
+		[]uint64{5, 6},
+		[]uint64{4, 3, 2, 1},
+		map[uint64]string{2: "insufficient funds"},
+		map[uint64]bool{6: true},
+	)
+
+	require.Equal(t, []Packet{
+		{Sequence: 1, Status: PacketRelayed, AckStatus: AckSucceeded},
+		{Sequence: 2, Status: PacketRelayed, AckStatus: AckFailed, AckError: "insufficient funds"},
+		{Sequence: 3, Status: PacketRelayed, AckStatus: AckSucceeded},
+		{Sequence: 4, Status: PacketRelayed, AckStatus: AckPending},
+		{Sequence: 5, Status: PacketPending},
+		{Sequence: 6, Status: PacketTimedOut},
+	}, packets)
+}
+
+func TestPacketTimeout(t *testing.T) {
+	timeout, err := parsePacketTimeout(map[string]string{
+		"packet_timeout_height":    "1-100",
+		"packet_timeout_timestamp": "1700000000000000000",
+	})
+	require.NoError(t, err)
+	require.Equal(t, packetTimeout{height: clienttypes.NewHeight(1, 100), timestamp: 1700000000000000000}, timeout)
+
+	before := time.Unix(0, 1699999999000000000)
+	require.False(t, timeout.isExpired(clienttypes.NewHeight(1, 99), before))
+	require.True(t, timeout.isExpired(clienttypes.NewHeight(1, 100), before))
+	require.True(t, timeout.isExpired(clienttypes.NewHeight(1, 99), time.Unix(0, 1700000000000000000)))
+
+	timeout, err = parsePacketTimeout(map[string]string{"packet_timeout_height": "0-0"})
+	require.NoError(t, err)
+	require.False(t, timeout.isExpired(clienttypes.NewHeight(1, 1000), time.Now()))
+
+	_, err = parsePacketTimeout(map[string]string{"packet_timeout_timestamp": "soon"})
+	require.Error(t, err)
+}
+
+func TestParseAcknowledgement(t *testing.T) {
+	result, ackErr := parseAcknowledgement(hex.EncodeToString([]byte(`{"result":"AQ=="}`)))
+	require.Equal(t, []byte{1}, result)
+	require.Empty(t, ackErr)
+
+	result, ackErr = parseAcknowledgement(hex.EncodeToString([]byte(`{"error":"ABCI code: 5"}`)))
+	require.Empty(t, result)
+	require.Equal(t, "ABCI code: 5", ackErr)
+
+	_, ackErr = parseAcknowledgement("not hex")
+	require.Empty(t, ackErr)
+}
@@ -0,0 +1,62 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	relayerconf "github.com/ignite/cli/ignite/pkg/relayer/config"
+)
+
+// SetPolicy sets the relay policy of the path, the policy is applied the next
+// time the path is relayed.
+func (r Relayer) SetPolicy(_ context.Context, pathID string, policy relayerconf.Policy) error {
+	if _, err := relayInterval(policy); err != nil {
+		return err
+	}
+	if policy.MaxGas < 0 {
+		return fmt.Errorf("invalid max gas %d, it must be positive", policy.MaxGas)
+	}
+
+	conf, err := relayerconf.Get()
+	if err != nil {
+		return err
+	}
+
+	path, err := conf.PathByID(pathID)
+	if err != nil {
+		return err
+	}
+
+	path.Policy = policy
+	if err := conf.UpdatePath(path); err != nil {
+		return err
+	}
+	return relayerconf.Save(conf)
+}
+
+// relayInterval returns the interval between the relays of the packets of a
+// path with the policy, relayDuration by default.
+func relayInterval(policy relayerconf.Policy) (time.Duration, error) {
+	if policy.Interval == "" {
+		return relayDuration, nil
+	}
+
+	d, err := time.ParseDuration(policy.Interval)
+	if err != nil {
+		return 0, fmt.Errorf("invalid relay interval %q: %w", policy.Interval, err)
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("invalid relay interval %q, it must be positive", policy.Interval)
+	}
+	return d, nil
+}
+
+// setupGas returns the gas of the transactions that the relayer account must
+// be able to pay to relay a path with the policy.
+func setupGas(policy relayerconf.Policy) int64 {
+	if policy.MaxGas > 0 {
+		return policy.MaxGas
+	}
+	return ibcSetupGas
+}
@@ -0,0 +1,40 @@
+package relayer
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	relayerconf "github.com/ignite/cli/ignite/pkg/relayer/config"
+)
+
+func TestRelayInterval(t *testing.T) {
+	d, err := relayInterval(relayerconf.Policy{})
+	require.NoError(t, err)
+	require.Equal(t, relayDuration, d)
+
+	d, err = relayInterval(relayerconf.Policy{Interval: "30s"})
+	require.NoError(t, err)
+	require.Equal(t, 30*time.Second, d)
+
+	_, err = relayInterval(relayerconf.Policy{Interval: "soon"})
+	require.Error(t, err)
+
+	_, err = relayInterval(relayerconf.Policy{Interval: "-1s"})
+	require.Error(t, err)
+}
+
+func TestStoppedStatus(t *testing.T) {
+	require.Equal(t, relayerconf.PathStatus{State: string(PathStopped)}, stoppedStatus("mars-venus", nil))
+
+	err := PathError{PathID: "mars-venus", Err: errors.New("out of gas")}
+	require.Equal(t, relayerconf.PathStatus{State: string(PathFailed), Error: "out of gas"}, stoppedStatus("mars-venus", err))
+	require.Equal(t, relayerconf.PathStatus{State: string(PathStopped)}, stoppedStatus("mars-earth", err))
+
+	require.Equal(t,
+		relayerconf.PathStatus{State: string(PathFailed), Error: "no config"},
+		stoppedStatus("mars-earth", errors.New("no config")),
+	)
+}
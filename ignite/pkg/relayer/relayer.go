@@ -8,34 +8,43 @@ import (
 	"sync"
 	"time"
 
-	"github.com/cosmos/cosmos-sdk/crypto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
 	"github.com/ignite/cli/ignite/pkg/cosmosclient"
-	"github.com/ignite/cli/ignite/pkg/ctxticker"
 	tsrelayer "github.com/ignite/cli/ignite/pkg/nodetime/programs/ts-relayer"
 	relayerconf "github.com/ignite/cli/ignite/pkg/relayer/config"
 	"github.com/ignite/cli/ignite/pkg/xurl"
 )
 
 const (
-	algoSecp256k1       = "secp256k1"
 	ibcSetupGas   int64 = 2256000
 	relayDuration       = time.Second * 5
 )
 
 // Relayer is an IBC relayer.
 type Relayer struct {
-	ca cosmosaccount.Registry
+	ca      cosmosaccount.Registry
+	backend Backend
 }
 
 // New creates a new IBC relayer and uses ca to access accounts.
-func New(ca cosmosaccount.Registry) Relayer {
-	return Relayer{
+func New(ca cosmosaccount.Registry, options ...RelayerOption) Relayer {
+	r := Relayer{
 		ca: ca,
 	}
+
+	for _, apply := range options {
+		apply(&r)
+	}
+
+	if r.backend == nil {
+		r.backend = tsRelayer{r}
+	}
+
+	return r
 }
 
 // LinkPaths links all chains that has a path from config file to each other.
@@ -77,7 +86,7 @@ func (r Relayer) Link(
 		return conf, fmt.Errorf("path %s already linked", path.ID)
 	}
 
-	if path, err = r.call(ctx, conf, path, "link"); err != nil {
+	if path, err = r.backend.Link(ctx, conf, path); err != nil {
 		return conf, err
 	}
 
@@ -91,19 +100,55 @@ func (r Relayer) StartPaths(ctx context.Context, pathIDs ...string) error {
 		return err
 	}
 
-	var m sync.Mutex // protects relayerconf.Path.
+	var paths []relayerconf.Path
 	for _, id := range pathIDs {
-		id := id
-		err := r.Start(ctx, conf, id, func(path relayerconf.Config) error {
-			m.Lock()
-			defer m.Unlock()
-			return relayerconf.Save(conf)
-		})
+		path, err := conf.PathByID(id)
 		if err != nil {
 			return err
 		}
+		paths = append(paths, path)
 	}
-	return nil
+
+	var m sync.Mutex // protects relayerconf.Path and the status of the paths.
+	for _, path := range paths {
+		if err := updateStatus(path.ID, func(s *relayerconf.PathStatus) {
+			*s = relayerconf.PathStatus{State: string(PathRelaying), LastRelay: s.LastRelay}
+		}); err != nil {
+			return err
+		}
+	}
+
+	err = r.backend.Start(ctx, conf, paths, func(path relayerconf.Path) error {
+		m.Lock()
+		defer m.Unlock()
+
+		if err := conf.UpdatePath(path); err != nil {
+			return err
+		}
+		if err := relayerconf.Save(conf); err != nil {
+			return err
+		}
+		return updateStatus(path.ID, func(s *relayerconf.PathStatus) {
+			now := time.Now()
+			s.LastRelay = &now
+		})
+	})
+
+	// the paths are not failed when the relayer is stopped.
+	stopErr := err
+	if ctx.Err() != nil {
+		stopErr = nil
+	}
+	for _, path := range paths {
+		if err := updateStatus(path.ID, func(s *relayerconf.PathStatus) {
+			status := stoppedStatus(path.ID, stopErr)
+			status.LastRelay = s.LastRelay
+			*s = status
+		}); err != nil {
+			return err
+		}
+	}
+	return err
 }
 
 // Start relays packets for linked path until ctx is canceled.
@@ -113,15 +158,12 @@ func (r Relayer) Start(
 	pathID string,
 	postExecute func(path relayerconf.Config) error,
 ) error {
-	return ctxticker.DoNow(ctx, relayDuration, func() error {
-		path, err := conf.PathByID(pathID)
-		if err != nil {
-			return err
-		}
-		path, err = r.call(ctx, conf, path, "start")
-		if err != nil {
-			return err
-		}
+	path, err := conf.PathByID(pathID)
+	if err != nil {
+		return err
+	}
+
+	return r.backend.Start(ctx, conf, []relayerconf.Path{path}, func(path relayerconf.Path) error {
 		if err := conf.UpdatePath(path); err != nil {
 			return err
 		}
@@ -132,6 +174,59 @@ func (r Relayer) Start(
 	})
 }
 
+// tsRelayer is the backend of the TypeScript relayer.
+type tsRelayer struct {
+	r Relayer
+}
+
+// Link implements Backend.
+func (t tsRelayer) Link(ctx context.Context, conf relayerconf.Config, path relayerconf.Path) (relayerconf.Path, error) {
+	return t.r.call(ctx, conf, path, "link")
+}
+
+// Start implements Backend, the paths are relayed concurrently. The packets of
+// each path are relayed when the WebSocket events of its chains notify new
+// packets or acknowledgements, and at the interval of its policy while the
+// events are not subscribed.
+func (t tsRelayer) Start(
+	ctx context.Context,
+	conf relayerconf.Config,
+	paths []relayerconf.Path,
+	update func(relayerconf.Path) error,
+) error {
+	g, ctx := errgroup.WithContext(ctx)
+	for _, path := range paths {
+		path := path
+
+		interval, err := relayInterval(path.Policy)
+		if err != nil {
+			return PathError{PathID: path.ID, Err: err}
+		}
+
+		events := newPathEvents()
+		g.Go(func() error {
+			if err := events.watch(ctx, conf, path); err != nil {
+				return PathError{PathID: path.ID, Err: err}
+			}
+			return nil
+		})
+		g.Go(func() error {
+			err := events.relay(ctx, interval, func() error {
+				var err error
+				if path, err = t.r.call(ctx, conf, path, "start"); err != nil {
+					return err
+				}
+				return update(path)
+			})
+			if err != nil && ctx.Err() == nil {
+				return PathError{PathID: path.ID, Err: err}
+			}
+			return err
+		})
+	}
+	return g.Wait()
+}
+
 func (r Relayer) call(
 	ctx context.Context,
 	conf relayerconf.Config,
@@ -140,12 +235,14 @@ func (r Relayer) call(
 ) (
 	reply relayerconf.Path, err error,
 ) {
-	srcChain, srcKey, err := r.prepare(ctx, conf, path.Src.ChainID)
+	gas := setupGas(path.Policy)
+
+	srcChain, srcKey, err := r.prepare(ctx, conf, path.Src.ChainID, gas)
 	if err != nil {
 		return relayerconf.Path{}, err
 	}
 
-	dstChain, dstKey, err := r.prepare(ctx, conf, path.Dst.ChainID)
+	dstChain, dstKey, err := r.prepare(ctx, conf, path.Dst.ChainID, gas)
 	if err != nil {
 		return relayerconf.Path{}, err
 	}
@@ -160,7 +257,7 @@ func (r Relayer) call(
 	return reply, tsrelayer.Call(ctx, action, args, &reply)
 }
 
-func (r Relayer) prepare(ctx context.Context, conf relayerconf.Config, chainID string) (
+func (r Relayer) prepare(ctx context.Context, conf relayerconf.Config, chainID string, gas int64) (
 	chain relayerconf.Chain, privKey string, err error,
 ) {
 	chain, err = conf.ChainByID(chainID)
@@ -203,29 +300,16 @@ func (r Relayer) prepare(ctx context.Context, conf relayerconf.Config, chainID s
 			continue
 		}
 
-		if gasPrice.Amount.Int64()*ibcSetupGas > coin.Amount.Int64() {
+		if gasPrice.Amount.Int64()*gas > coin.Amount.Int64() {
 			return relayerconf.Chain{}, "", errMissingBalance
 		}
 	}
 
-	// Get the key in ASCII armored format
-	passphrase := ""
-	key, err := r.ca.Export(chain.Account, passphrase)
+	priv, err := PrivKey(r.ca, chain.Account)
 	if err != nil {
 		return relayerconf.Chain{}, "", err
 	}
 
-	// Unarmor the key to be able to read it as bytes
-	priv, algo, err := crypto.UnarmorDecryptPrivKey(key, passphrase)
-	if err != nil {
-		return relayerconf.Chain{}, "", err
-	}
-
-	// Check the algorithm because the TS relayer expects a secp256k1 private key
-	if algo != algoSecp256k1 {
-		return relayerconf.Chain{}, "", fmt.Errorf("private key algorithm must be secp256k1 instead of %s", algo)
-	}
-
 	return chain, hex.EncodeToString(priv.Bytes()), nil
 }
 
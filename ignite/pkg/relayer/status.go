@@ -0,0 +1,124 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	relayerconf "github.com/ignite/cli/ignite/pkg/relayer/config"
+)
+
+// PathState is the state of the relay of a path.
+type PathState string
+
+const (
+	// PathIdle is a path that was never relayed.
+	PathIdle PathState = "idle"
+
+	// PathRelaying is a path relayed by a running relayer.
+	PathRelaying PathState = "relaying"
+
+	// PathStopped is a path that was relayed until its relayer stopped.
+	PathStopped PathState = "stopped"
+
+	// PathFailed is a path that stopped being relayed because of an error.
+	PathFailed PathState = "failed"
+)
+
+// PathStatus is the status of a path of the relayer.
+type PathStatus struct {
+	ID     string              `json:"id"`
+	Src    relayerconf.PathEnd `json:"src"`
+	Dst    relayerconf.PathEnd `json:"dst"`
+	Linked bool                `json:"linked"`
+	Policy relayerconf.Policy  `json:"policy"`
+	State  PathState           `json:"state"`
+
+	// LastRelay is the time when the packets of the path were last relayed,
+	// it's not known when the path is relayed by Hermes.
+	LastRelay *time.Time `json:"last_relay,omitempty"`
+
+	// Error is the error that stopped the relay of the path.
+	Error string `json:"error,omitempty"`
+}
+
+// PathError is an error of the relay of a path.
+type PathError struct {
+	PathID string
+	Err    error
+}
+
+// Error implements error.
+func (e PathError) Error() string {
+	return fmt.Sprintf("path %s: %s", e.PathID, e.Err)
+}
+
+// Unwrap returns the error of the path.
+func (e PathError) Unwrap() error {
+	return e.Err
+}
+
+// Status returns the status of the paths of the relayer, the state of the
+// paths is recorded by the relayers started with StartPaths.
+func (r Relayer) Status(_ context.Context) ([]PathStatus, error) {
+	conf, err := relayerconf.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	states, err := relayerconf.GetStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]PathStatus, 0, len(conf.Paths))
+	for _, path := range conf.Paths {
+		s := PathStatus{
+			ID:     path.ID,
+			Src:    path.Src,
+			Dst:    path.Dst,
+			Linked: path.Src.ChannelID != "" && path.Dst.ChannelID != "",
+			Policy: path.Policy,
+			State:  PathIdle,
+		}
+		if state, ok := states[path.ID]; ok {
+			s.State = PathState(state.State)
+			s.LastRelay = state.LastRelay
+			s.Error = state.Error
+		}
+		statuses = append(statuses, s)
+	}
+	return statuses, nil
+}
+
+// updateStatus updates the recorded status of the path with apply. The status
+// of the other paths is read again because it's updated by the other
+// relayers.
+func updateStatus(pathID string, apply func(*relayerconf.PathStatus)) error {
+	states, err := relayerconf.GetStatus()
+	if err != nil {
+		return err
+	}
+
+	state := states[pathID]
+	apply(&state)
+	states[pathID] = state
+	return relayerconf.SaveStatus(states)
+}
+
+// stoppedStatus returns the status of a path when its relayer stopped with err.
+func stoppedStatus(pathID string, err error) relayerconf.PathStatus {
+	if err == nil {
+		return relayerconf.PathStatus{State: string(PathStopped)}
+	}
+
+	var pathErr PathError
+	if errors.As(err, &pathErr) {
+		if pathErr.PathID != pathID {
+			return relayerconf.PathStatus{State: string(PathStopped)}
+		}
+		err = pathErr.Err
+	}
+	return relayerconf.PathStatus{State: string(PathFailed), Error: err.Error()}
+}
@@ -0,0 +1,282 @@
+package relayer
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/ignite/cli/ignite/pkg/ctxticker"
+	relayerconf "github.com/ignite/cli/ignite/pkg/relayer/config"
+)
+
+const (
+	// DefaultTransferTimeout is the default timeout of the transfers relative
+	// to the block time of the destination chain.
+	DefaultTransferTimeout = 10 * time.Minute
+
+	// transferMemoField is the field of the memo of MsgTransfer.
+	transferMemoField = 8
+
+	// ackCheckInterval is the interval between the checks of the
+	// acknowledgement of a transfer.
+	ackCheckInterval = 2 * time.Second
+)
+
+// Transfer is an ICS-20 transfer of tokens on the channel of a path.
+type Transfer struct {
+	// PathID is the ID of the path.
+	PathID string
+
+	// Reverse sends the tokens from the target chain of the path to its
+	// source chain.
+	Reverse bool
+
+	// Amount is the amount of tokens sent.
+	Amount string
+
+	// Receiver is the address of the receiver on the destination chain, the
+	// address of the relayer account by default.
+	Receiver string
+
+	// Memo is the ICS-20 memo of the packet, it's read by middlewares like the
+	// packet forward middleware and the contract callbacks.
+	Memo string
+
+	// TimeoutHeight is the number of blocks of the destination chain after
+	// which the packet times out, the timeout height is disabled when it's zero.
+	TimeoutHeight uint64
+
+	// Timeout is the duration after the block time of the destination chain
+	// after which the packet times out, the timeout timestamp is disabled
+	// when it's zero.
+	Timeout time.Duration
+}
+
+// SentTransfer is a transfer sent on a channel.
+type SentTransfer struct {
+	// Src is the end of the channel that sent the packet of the transfer.
+	Src relayerconf.PathEnd
+
+	// Dst is the end of the channel that receives the packet of the transfer.
+	Dst relayerconf.PathEnd
+
+	// Sequence is the sequence of the packet of the transfer.
+	Sequence uint64
+
+	// TxHash is the hash of the transaction of the transfer.
+	TxHash string
+
+	// Sender is the address of the sender on the source chain.
+	Sender string
+
+	// Receiver is the address of the receiver on the destination chain.
+	Receiver string
+
+	timeout packetTimeout
+}
+
+// TransferAck is the acknowledgement of a transfer.
+type TransferAck struct {
+	// TimedOut is true when the packet of the transfer was not received
+	// before its timeout, the packet must be timed out on its source chain
+	// to refund its sender.
+	TimedOut bool
+
+	// Result is the result of the successful acknowledgement.
+	Result []byte
+
+	// Error is the error of the acknowledgement when it failed.
+	Error string
+}
+
+// Transfer sends an ICS-20 transfer on the channel of a linked path with the
+// relayer account of the source chain.
+func (r Relayer) Transfer(ctx context.Context, t Transfer) (SentTransfer, error) {
+	conf, path, err := r.linkedPath(t.PathID)
+	if err != nil {
+		return SentTransfer{}, err
+	}
+
+	srcEnd, dstEnd := path.Src, path.Dst
+	if t.Reverse {
+		srcEnd, dstEnd = dstEnd, srcEnd
+	}
+
+	token, err := sdk.ParseCoinNormalized(t.Amount)
+	if err != nil {
+		return SentTransfer{}, fmt.Errorf("invalid amount %q: %w", t.Amount, err)
+	}
+
+	src, err := conf.ChainByID(srcEnd.ChainID)
+	if err != nil {
+		return SentTransfer{}, err
+	}
+	dst, err := conf.ChainByID(dstEnd.ChainID)
+	if err != nil {
+		return SentTransfer{}, err
+	}
+
+	sender, err := r.address(src)
+	if err != nil {
+		return SentTransfer{}, err
+	}
+	receiver := t.Receiver
+	if receiver == "" {
+		if receiver, err = r.address(dst); err != nil {
+			return SentTransfer{}, err
+		}
+	}
+
+	// the timeouts are relative to the latest block of the destination chain.
+	dstQuerier, err := newIBCQuerier(ctx, dst.RPCAddress)
+	if err != nil {
+		return SentTransfer{}, err
+	}
+	height, blockTime, err := dstQuerier.latest(ctx)
+	if err != nil {
+		return SentTransfer{}, err
+	}
+
+	var timeout packetTimeout
+	if t.TimeoutHeight > 0 {
+		timeout.height = clienttypes.NewHeight(height.RevisionNumber, height.RevisionHeight+t.TimeoutHeight)
+	}
+	if t.Timeout > 0 {
+		timeout.timestamp = uint64(blockTime.Add(t.Timeout).UnixNano())
+	}
+	if timeout.height.IsZero() && timeout.timestamp == 0 {
+		return SentTransfer{}, errors.New("the transfer must have a timeout height or a timeout")
+	}
+
+	msg := msgTransfer{
+		MsgTransfer: transfertypes.NewMsgTransfer(
+			srcEnd.PortID,
+			srcEnd.ChannelID,
+			token,
+			sender,
+			receiver,
+			timeout.height,
+			timeout.timestamp,
+		),
+		memo: t.Memo,
+	}
+	res, err := r.broadcast(ctx, src, msg)
+	if err != nil {
+		return SentTransfer{}, fmt.Errorf("transfer on chain %s: %w", src.ID, err)
+	}
+
+	sent := SentTransfer{
+		Src:      srcEnd,
+		Dst:      dstEnd,
+		TxHash:   res.TxHash,
+		Sender:   sender,
+		Receiver: receiver,
+		timeout:  timeout,
+	}
+	events := eventAttributes(res.Events, eventSendPacket)
+	if len(events) == 0 {
+		return SentTransfer{}, fmt.Errorf("the packet of transfer %s is missing", res.TxHash)
+	}
+	if sent.Sequence, err = parseSequence(events[0]); err != nil {
+		return SentTransfer{}, err
+	}
+	return sent, nil
+}
+
+// WaitAcknowledgement waits until the packet of the transfer is acknowledged
+// on its destination chain, or until it times out. The packet must be relayed
+// by a running relayer and the transactions must be indexed by the node of the
+// destination chain.
+func (r Relayer) WaitAcknowledgement(ctx context.Context, sent SentTransfer) (TransferAck, error) {
+	conf, err := relayerconf.Get()
+	if err != nil {
+		return TransferAck{}, err
+	}
+
+	dst, err := r.chainQuerier(ctx, conf, sent.Dst.ChainID)
+	if err != nil {
+		return TransferAck{}, err
+	}
+
+	var ack TransferAck
+	err = ctxticker.DoNow(ctx, ackCheckInterval, func() error {
+		ackHex, found, err := dst.acknowledgement(ctx, sent.Dst.PortID, sent.Dst.ChannelID, sent.Sequence)
+		if err != nil {
+			return err
+		}
+		if found {
+			ack.Result, ack.Error = parseAcknowledgement(ackHex)
+			return errAckFound
+		}
+
+		height, blockTime, err := dst.latest(ctx)
+		if err != nil {
+			return err
+		}
+		if sent.timeout.isExpired(height, blockTime) {
+			ack.TimedOut = true
+			return errAckFound
+		}
+		return nil
+	})
+	if err == errAckFound {
+		return ack, nil
+	}
+	return TransferAck{}, err
+}
+
+// errAckFound stops the checks of the acknowledgement of a transfer.
+var errAckFound = errors.New("acknowledgement found")
+
+// msgTransfer is an ICS-20 MsgTransfer with the memo of the transfers of
+// ibc-go v5.1 and later, the memo is encoded as an additional field of the
+// message of the ibc-go version of Ignite CLI.
+type msgTransfer struct {
+	*transfertypes.MsgTransfer
+	memo string
+}
+
+// XXX_MessageName returns the name of MsgTransfer.
+func (m msgTransfer) XXX_MessageName() string {
+	return proto.MessageName(m.MsgTransfer)
+}
+
+// Marshal returns the encoding of MsgTransfer with the memo.
+func (m msgTransfer) Marshal() ([]byte, error) {
+	bz, err := m.MsgTransfer.Marshal()
+	if err != nil || m.memo == "" {
+		return bz, err
+	}
+
+	var buf [binary.MaxVarintLen64]byte
+	bz = append(bz, transferMemoField<<3|2) // the memo is a length-delimited field.
+	bz = append(bz, buf[:binary.PutUvarint(buf[:], uint64(len(m.memo)))]...)
+	return append(bz, m.memo...), nil
+}
+
+// Size returns the size of the encoding of MsgTransfer with the memo.
+func (m msgTransfer) Size() int {
+	bz, _ := m.Marshal()
+	return len(bz)
+}
+
+// XXX_Size returns the size of the encoding of MsgTransfer with the memo.
+func (m msgTransfer) XXX_Size() int {
+	return m.Size()
+}
+
+// XXX_Marshal appends the encoding of MsgTransfer with the memo to b.
+func (m msgTransfer) XXX_Marshal(b []byte, _ bool) ([]byte, error) {
+	bz, err := m.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return append(b, bz...), nil
+}
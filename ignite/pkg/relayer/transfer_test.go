@@ -0,0 +1,49 @@
+package relayer
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgTransferMemo(t *testing.T) {
+	var (
+		memo = `{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-1"}}`
+		msg  = transfertypes.NewMsgTransfer(
+			"transfer",
+			"channel-0",
+			sdk.NewInt64Coin("stake", 10),
+			"cosmos1sender",
+			"cosmos1receiver",
+			clienttypes.NewHeight(1, 100),
+			0,
+		)
+	)
+
+	bz, err := msgTransfer{MsgTransfer: msg, memo: memo}.Marshal()
+	require.NoError(t, err)
+
+	// the memo is an additional field of the message.
+	want, err := msg.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, want, bz[:len(want)])
+	require.Equal(t, append([]byte{0x42, byte(len(memo))}, memo...), bz[len(want):])
+
+	var decoded transfertypes.MsgTransfer
+	require.NoError(t, decoded.Unmarshal(bz))
+	require.Equal(t, *msg, decoded)
+
+	any, err := codectypes.NewAnyWithValue(msgTransfer{MsgTransfer: msg, memo: memo})
+	require.NoError(t, err)
+	require.Equal(t, "/ibc.applications.transfer.v1.MsgTransfer", any.TypeUrl)
+	require.Equal(t, bz, any.Value)
+
+	// the message is not changed without memo.
+	bz, err = msgTransfer{MsgTransfer: msg}.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, want, bz)
+}
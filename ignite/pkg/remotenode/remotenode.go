@@ -0,0 +1,187 @@
+// Package remotenode deploys nodes to remote hosts with the ssh and scp
+// commands available in the system, and runs them as systemd services.
+package remotenode
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/ignite/cli/ignite/pkg/cmdrunner"
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/step"
+	"github.com/ignite/cli/ignite/pkg/xexec"
+)
+
+const (
+	// SSHCommand is the name of the SSH client command.
+	SSHCommand = "ssh"
+
+	// SCPCommand is the name of the file copy command.
+	SCPCommand = "scp"
+
+	unitDir = "/etc/systemd/system"
+)
+
+// ErrCommandNotFound is returned when the ssh or the scp command is not
+// available in the system.
+var ErrCommandNotFound = errors.New("ssh or scp command not found, please install an OpenSSH client")
+
+// Host is a remote host reached over SSH, the SSH configuration, keys and
+// agent of the user are used to connect to the host.
+type Host struct {
+	// Target is the SSH destination, e.g. "user@host".
+	Target string
+
+	// Port is the SSH port of the host, the default SSH port is used when zero.
+	Port int
+}
+
+// Name returns the host name of the host.
+func (h Host) Name() string {
+	name := h.Target
+	if i := strings.LastIndex(name, "@"); i != -1 {
+		name = name[i+1:]
+	}
+	return name
+}
+
+// Validate checks that the host can be reached.
+func (h Host) Validate() error {
+	if h.Target == "" || strings.HasPrefix(h.Target, "-") {
+		return fmt.Errorf("invalid SSH target %q", h.Target)
+	}
+	if h.Port < 0 || h.Port > 65535 {
+		return fmt.Errorf("invalid SSH port %d", h.Port)
+	}
+	if !xexec.IsCommandAvailable(SSHCommand) || !xexec.IsCommandAvailable(SCPCommand) {
+		return ErrCommandNotFound
+	}
+	return nil
+}
+
+// Run runs the shell script on the host with the input and returns its
+// output.
+func (h Host) Run(ctx context.Context, script string, input []byte) (string, error) {
+	args := []string{"-o", "BatchMode=yes"}
+	if input == nil {
+		args = append(args, "-n")
+	}
+	if h.Port != 0 {
+		args = append(args, "-p", strconv.Itoa(h.Port))
+	}
+	args = append(args, h.Target, script)
+
+	var outb, errb bytes.Buffer
+	options := []step.Option{
+		step.Exec(SSHCommand, args...),
+		step.Stdout(&outb),
+		step.Stderr(&errb),
+	}
+	if input != nil {
+		options = append(options, step.Write(input))
+	}
+	if err := cmdrunner.New().Run(ctx, step.New(options...)); err != nil {
+		return "", fmt.Errorf("ssh %s: %w: %s", h.Target, err, strings.TrimSpace(errb.String()))
+	}
+	return strings.TrimSpace(outb.String()), nil
+}
+
+// Copy copies the local file or directory at src to the path dst of the host.
+func (h Host) Copy(ctx context.Context, src, dst string) error {
+	args := []string{"-q", "-r", "-o", "BatchMode=yes"}
+	if h.Port != 0 {
+		args = append(args, "-P", strconv.Itoa(h.Port))
+	}
+	args = append(args, src, fmt.Sprintf("%s:%s", h.Target, dst))
+
+	var errb bytes.Buffer
+	err := cmdrunner.New().Run(ctx, step.New(
+		step.Exec(SCPCommand, args...),
+		step.Stderr(&errb),
+	))
+	if err != nil {
+		return fmt.Errorf("scp %s to %s: %w: %s", src, h.Target, err, strings.TrimSpace(errb.String()))
+	}
+	return nil
+}
+
+// Service is a systemd service that runs a node.
+type Service struct {
+	// Name is the name of the unit of the service.
+	Name string
+
+	// Description is the description of the unit.
+	Description string
+
+	// User is the user that runs the node.
+	User string
+
+	// Command is the command that runs the node.
+	Command []string
+
+	// Environment are the environment variables of the node.
+	Environment []string
+}
+
+var unitTemplate = template.Must(template.New("unit").Parse(`[Unit]
+Description={{ .Description }}
+After=network-online.target
+Wants=network-online.target
+
+[Service]
+User={{ .User }}
+ExecStart={{ .ExecStart }}
+{{- range .Environment }}
+Environment="{{ . }}"
+{{- end }}
+Restart=on-failure
+RestartSec=3
+LimitNOFILE=65535
+
+[Install]
+WantedBy=multi-user.target
+`))
+
+// Unit returns the systemd unit of the service.
+func (s Service) Unit() ([]byte, error) {
+	var buf bytes.Buffer
+	err := unitTemplate.Execute(&buf, map[string]interface{}{
+		"Description": s.Description,
+		"User":        s.User,
+		"ExecStart":   strings.Join(s.Command, " "),
+		"Environment": s.Environment,
+	})
+	return buf.Bytes(), err
+}
+
+// Install installs the unit of the service on the host and starts the service,
+// the service is restarted when it is running. The user of the host must run
+// sudo without password.
+func (h Host) Install(ctx context.Context, s Service) error {
+	unit, err := s.Unit()
+	if err != nil {
+		return err
+	}
+	path := fmt.Sprintf("%s/%s.service", unitDir, s.Name)
+	script := fmt.Sprintf(
+		"sudo -n tee %s > /dev/null && sudo -n systemctl daemon-reload && sudo -n systemctl enable %s && sudo -n systemctl restart %s",
+		Quote(path), Quote(s.Name), Quote(s.Name),
+	)
+	_, err = h.Run(ctx, script, unit)
+	return err
+}
+
+// Restart restarts the service with the name on the host.
+func (h Host) Restart(ctx context.Context, name string) error {
+	_, err := h.Run(ctx, "sudo -n systemctl restart "+Quote(name), nil)
+	return err
+}
+
+// Quote quotes the string for the shell of the host.
+func Quote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,51 @@
+package remotenode_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/remotenode"
+)
+
+func TestServiceUnit(t *testing.T) {
+	s := remotenode.Service{
+		Name:        "mars-validator-1",
+		Description: "mars validator-1",
+		User:        "alice",
+		Command:     []string{"/home/alice/mars/bin/cosmovisor", "run", "start", "--home", "/home/alice/mars/validator-1"},
+		Environment: []string{"DAEMON_NAME=marsd", "DAEMON_HOME=/home/alice/mars/validator-1"},
+	}
+
+	unit, err := s.Unit()
+	require.NoError(t, err)
+	require.Equal(t, `[Unit]
+Description=mars validator-1
+After=network-online.target
+Wants=network-online.target
+
+[Service]
+User=alice
+ExecStart=/home/alice/mars/bin/cosmovisor run start --home /home/alice/mars/validator-1
+Environment="DAEMON_NAME=marsd"
+Environment="DAEMON_HOME=/home/alice/mars/validator-1"
+Restart=on-failure
+RestartSec=3
+LimitNOFILE=65535
+
+[Install]
+WantedBy=multi-user.target
+`, string(unit))
+}
+
+func TestHost(t *testing.T) {
+	require.Equal(t, "example.com", remotenode.Host{Target: "alice@example.com"}.Name())
+	require.Equal(t, "example.com", remotenode.Host{Target: "example.com"}.Name())
+	require.Error(t, remotenode.Host{Target: "-oProxyCommand=sh"}.Validate())
+	require.Error(t, remotenode.Host{Target: "alice@example.com", Port: 70000}.Validate())
+}
+
+func TestQuote(t *testing.T) {
+	require.Equal(t, `'/home/alice/mars'`, remotenode.Quote("/home/alice/mars"))
+	require.Equal(t, `'it'\''s'`, remotenode.Quote("it's"))
+}
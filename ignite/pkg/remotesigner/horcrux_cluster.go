@@ -0,0 +1,260 @@
+package remotesigner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/lineprefixer"
+	"github.com/ignite/cli/ignite/pkg/cmdrunner"
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/exec"
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/step"
+	"github.com/ignite/cli/ignite/pkg/xexec"
+)
+
+const (
+	// DefaultClusterThreshold is the default number of cosigners needed to sign.
+	DefaultClusterThreshold = 2
+
+	// DefaultClusterShards is the default number of cosigners of a cluster.
+	DefaultClusterShards = 3
+
+	// DefaultCosignerPort is the default P2P port of the first cosigner of a
+	// cluster, the next cosigners use the next ports.
+	DefaultCosignerPort = 2222
+
+	horcruxBinary = "horcrux"
+)
+
+var (
+	cosignerDir = regexp.MustCompile(`^cosigner_(\d+)$`)
+
+	errHorcruxNotFound = errors.New("horcrux not found, install it from https://github.com/strangelove-ventures/horcrux")
+)
+
+// ClusterOptions configures a local cluster of Horcrux cosigners.
+type ClusterOptions struct {
+	// Threshold is the number of cosigners needed to sign.
+	Threshold int
+
+	// Shards is the number of cosigners, the key of the validator is split in
+	// one shard for each cosigner.
+	Shards int
+
+	// Port is the P2P port of the first cosigner.
+	Port int
+}
+
+func (o ClusterOptions) validate() error {
+	if o.Shards < 2 {
+		return fmt.Errorf("a cluster needs at least 2 cosigners, got %d", o.Shards)
+	}
+	// The cosigners elect a leader with Raft, a majority of them is needed
+	if o.Threshold <= o.Shards/2 || o.Threshold > o.Shards {
+		return fmt.Errorf("the threshold must be a majority of the %d cosigners, got %d", o.Shards, o.Threshold)
+	}
+	return nil
+}
+
+// CosignerHome returns the home of the cosigner with the shard id in the dir
+// of a cluster.
+func CosignerHome(dir string, id int) string {
+	return filepath.Join(dir, fmt.Sprintf("cosigner_%d", id))
+}
+
+// Cosigners returns the shard IDs of the cosigners of the cluster in dir.
+func Cosigners(dir string) ([]int, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []int
+	for _, e := range entries {
+		if m := cosignerDir.FindStringSubmatch(e.Name()); e.IsDir() && m != nil {
+			id, _ := strconv.Atoi(m[1])
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+
+	return ids, nil
+}
+
+var horcruxClusterConfig = template.Must(template.New(HorcruxConfigFile).Parse(`# Horcrux config of cosigner {{ .ID }} of the {{ .ChainID }} validator.
+# Run the cosigner with: horcrux start --home {{ .Dir }}
+signMode: threshold
+thresholdMode:
+  threshold: {{ .Threshold }}
+  cosigners:
+{{- range .Cosigners }}
+    - shardID: {{ .ID }}
+      p2pAddr: tcp://127.0.0.1:{{ .Port }}
+{{- end }}
+  grpcTimeout: 1000ms
+  raftTimeout: 1000ms
+chainNodes:
+  - privValAddr: {{ .Addr }}
+debugAddr: ""
+`))
+
+type cosigner struct {
+	ID   int
+	Port int
+}
+
+// WriteHorcruxCluster writes in dir the homes of a cluster of Horcrux cosigners
+// in threshold mode, each cosigner signs with a shard of the key of the
+// validator. The shards and the communication keys of the cosigners are
+// created with the horcrux binary.
+//
+// The cluster can be written again with other options to deal new shards of
+// the same key, the sign states of the cosigners are kept.
+func WriteHorcruxCluster(ctx context.Context, dir, validatorKeyPath string, o Options, co ClusterOptions) error {
+	if err := co.validate(); err != nil {
+		return err
+	}
+
+	horcrux, err := xexec.ResolveAbsPath(horcruxBinary)
+	if err != nil {
+		return errHorcruxNotFound
+	}
+
+	dir, err = filepath.Abs(dir)
+	if err != nil {
+		return err
+	}
+	validatorKeyPath, err = filepath.Abs(validatorKeyPath)
+	if err != nil {
+		return err
+	}
+	addr, err := o.signerAddress()
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(addr, "tcp://") {
+		return fmt.Errorf("horcrux only connects to TCP node addresses, got %s", addr)
+	}
+
+	// Remove the cosigners that are not part of the cluster anymore
+	ids, err := Cosigners(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for _, id := range ids {
+		if id > co.Shards {
+			if err := os.RemoveAll(CosignerHome(dir, id)); err != nil {
+				return err
+			}
+		}
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+
+	// horcrux writes the shards in the cosigner_{id} dirs of its workdir
+	shards := strconv.Itoa(co.Shards)
+	for _, args := range [][]string{
+		{
+			"create-ed25519-shards",
+			"--chain-id", o.ChainID,
+			"--key-file", validatorKeyPath,
+			"--threshold", strconv.Itoa(co.Threshold),
+			"--shards", shards,
+		},
+		{"create-ecies-shards", "--shards", shards},
+	} {
+		err := exec.Exec(
+			ctx,
+			append([]string{horcrux}, args...),
+			exec.StepOption(step.Workdir(dir)),
+			exec.IncludeStdLogsToError(),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	cosigners := make([]cosigner, co.Shards)
+	for i := range cosigners {
+		cosigners[i] = cosigner{ID: i + 1, Port: co.Port + i}
+	}
+
+	for _, c := range cosigners {
+		home := CosignerHome(dir, c.ID)
+		if err := os.MkdirAll(filepath.Join(home, "state"), 0o700); err != nil {
+			return err
+		}
+
+		// The Raft cluster of the cosigners is created again with the new peers
+		if err := os.RemoveAll(filepath.Join(home, "raft")); err != nil {
+			return err
+		}
+
+		f, err := os.OpenFile(filepath.Join(home, HorcruxConfigFile), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+		if err != nil {
+			return err
+		}
+
+		err = horcruxClusterConfig.Execute(f, struct {
+			Options
+			ClusterOptions
+			ID        int
+			Dir       string
+			Addr      string
+			Cosigners []cosigner
+		}{o, co, c.ID, filepath.ToSlash(home), addr, cosigners})
+		f.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// StartHorcruxCluster starts the cosigners of the cluster in dir with the
+// shard IDs, or all of them when no ID is given, until ctx is canceled.
+// The logs of the cosigners are prefixed with their shard ID.
+func StartHorcruxCluster(ctx context.Context, dir string, ids []int, stdout, stderr io.Writer) error {
+	horcrux, err := xexec.ResolveAbsPath(horcruxBinary)
+	if err != nil {
+		return errHorcruxNotFound
+	}
+
+	all, err := Cosigners(dir)
+	if err != nil {
+		return err
+	}
+	if len(all) == 0 {
+		return fmt.Errorf("no cosigner found in %s", dir)
+	}
+	if len(ids) == 0 {
+		ids = all
+	}
+
+	steps := make([]*step.Step, len(ids))
+	for i, id := range ids {
+		home := CosignerHome(dir, id)
+		if _, err := os.Stat(home); err != nil {
+			return fmt.Errorf("cosigner %d not found in %s", id, dir)
+		}
+
+		prefix := fmt.Sprintf("[cosigner %d] ", id)
+		steps[i] = step.New(
+			step.Exec(horcrux, "start", "--home", home),
+			step.Stdout(lineprefixer.NewWriter(stdout, func() string { return prefix })),
+			step.Stderr(lineprefixer.NewWriter(stderr, func() string { return prefix })),
+		)
+	}
+
+	return cmdrunner.New(cmdrunner.RunParallel()).Run(ctx, steps...)
+}
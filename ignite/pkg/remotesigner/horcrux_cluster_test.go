@@ -0,0 +1,103 @@
+package remotesigner_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/remotesigner"
+)
+
+// fakeHorcrux installs in the PATH a horcrux script that creates the cosigner
+// dirs like the shard commands of horcrux and prints its args on start.
+func fakeHorcrux(t *testing.T) {
+	bin := t.TempDir()
+	script := `#!/bin/sh
+case "$1" in
+create-*)
+  while [ $# -gt 0 ]; do
+    if [ "$1" = "--shards" ]; then n=$2; fi
+    shift
+  done
+  i=1
+  while [ $i -le $n ]; do mkdir -p cosigner_$i; touch cosigner_$i/shard; i=$((i+1)); done
+  ;;
+start)
+  echo "started $3"
+  ;;
+esac
+`
+	require.NoError(t, os.WriteFile(filepath.Join(bin, "horcrux"), []byte(script), 0o755))
+	t.Setenv("PATH", bin+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+func TestWriteHorcruxCluster(t *testing.T) {
+	fakeHorcrux(t)
+
+	var (
+		ctx     = context.Background()
+		dir     = t.TempDir()
+		options = remotesigner.Options{
+			ChainID:     "mars",
+			NodeAddress: "tcp://0.0.0.0:26659",
+		}
+	)
+
+	err := remotesigner.WriteHorcruxCluster(ctx, dir, writeKey(t), options, remotesigner.ClusterOptions{
+		Threshold: 3,
+		Shards:    4,
+		Port:      2222,
+	})
+	require.NoError(t, err)
+
+	ids, err := remotesigner.Cosigners(dir)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3, 4}, ids)
+
+	config, err := os.ReadFile(filepath.Join(remotesigner.CosignerHome(dir, 2), remotesigner.HorcruxConfigFile))
+	require.NoError(t, err)
+	require.Contains(t, string(config), "threshold: 3\n")
+	require.Contains(t, string(config), "    - shardID: 4\n      p2pAddr: tcp://127.0.0.1:2225\n")
+	require.Contains(t, string(config), "privValAddr: tcp://127.0.0.1:26659")
+
+	// Deal the shards again to less cosigners, the state is kept
+	state := filepath.Join(remotesigner.CosignerHome(dir, 1), "state", "mars_priv_validator_state.json")
+	require.NoError(t, os.WriteFile(state, []byte("{}"), 0o600))
+
+	err = remotesigner.WriteHorcruxCluster(ctx, dir, writeKey(t), options, remotesigner.ClusterOptions{
+		Threshold: 2,
+		Shards:    3,
+		Port:      2222,
+	})
+	require.NoError(t, err)
+
+	ids, err = remotesigner.Cosigners(dir)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3}, ids)
+	require.FileExists(t, state)
+
+	var stdout bytes.Buffer
+	err = remotesigner.StartHorcruxCluster(ctx, dir, []int{3}, &stdout, &stdout)
+	require.NoError(t, err)
+	require.Equal(t, "[cosigner 3] started "+remotesigner.CosignerHome(dir, 3)+"\n", stdout.String())
+}
+
+func TestWriteHorcruxClusterInvalidThreshold(t *testing.T) {
+	fakeHorcrux(t)
+
+	for _, co := range []remotesigner.ClusterOptions{
+		{Threshold: 1, Shards: 3},
+		{Threshold: 4, Shards: 3},
+		{Threshold: 1, Shards: 1},
+	} {
+		err := remotesigner.WriteHorcruxCluster(context.Background(), t.TempDir(), writeKey(t), remotesigner.Options{
+			ChainID:     "mars",
+			NodeAddress: "tcp://localhost:26659",
+		}, co)
+		require.Error(t, err)
+	}
+}
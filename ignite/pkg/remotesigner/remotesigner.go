@@ -0,0 +1,260 @@
+// Package remotesigner writes the configs of the remote signers of validators,
+// Tendermint KMS (tmkms) and Horcrux, from the keys of a node, so the signing of
+// blocks by a remote signer can be tested with a development chain.
+package remotesigner
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+const (
+	// TMKMSConfigFile is the name of the config file of tmkms.
+	TMKMSConfigFile = "tmkms.toml"
+
+	// HorcruxConfigFile is the name of the config file of Horcrux.
+	HorcruxConfigFile = "config.yaml"
+
+	keyTypeEd25519 = "tendermint/PrivKeyEd25519"
+)
+
+// Key is an ed25519 key of a node, the key of the validator in
+// priv_validator_key.json or the key of the node in node_key.json.
+type Key struct {
+	// Address is the address of the key, only set for validator keys.
+	Address string `json:"address,omitempty"`
+
+	// PubKey is the public key, only set for validator keys.
+	PubKey *keyValue `json:"pub_key,omitempty"`
+
+	// PrivKey is the private key.
+	PrivKey keyValue `json:"priv_key"`
+}
+
+type keyValue struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// ReadKey reads an ed25519 key file of a node.
+func ReadKey(path string) (Key, error) {
+	var k Key
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return k, err
+	}
+	if err := json.Unmarshal(data, &k); err != nil {
+		return k, fmt.Errorf("invalid key file %s: %w", path, err)
+	}
+	if k.PrivKey.Type != keyTypeEd25519 {
+		return k, fmt.Errorf("unsupported key type %q in %s, only ed25519 keys are supported", k.PrivKey.Type, path)
+	}
+	if _, err := k.privKey(); err != nil {
+		return k, fmt.Errorf("invalid key file %s: %w", path, err)
+	}
+	return k, nil
+}
+
+func (k Key) privKey() (ed25519.PrivateKey, error) {
+	priv, err := base64.StdEncoding.DecodeString(k.PrivKey.Value)
+	if err != nil {
+		return nil, err
+	}
+	if len(priv) != ed25519.PrivateKeySize {
+		return nil, errors.New("invalid ed25519 private key size")
+	}
+	return priv, nil
+}
+
+// PublicKey returns the public key of the key.
+func (k Key) PublicKey() (ed25519.PublicKey, error) {
+	priv, err := k.privKey()
+	if err != nil {
+		return nil, err
+	}
+	return priv.Public().(ed25519.PublicKey), nil
+}
+
+// NodeID returns the ID of the node of the key, the hex encoded first 20 bytes
+// of the SHA256 hash of its public key.
+func (k Key) NodeID() (string, error) {
+	priv, err := k.privKey()
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(priv.Public().(ed25519.PublicKey))
+	return hex.EncodeToString(hash[:20]), nil
+}
+
+// Options configures the remote signer of a validator.
+type Options struct {
+	// ChainID is the ID of the chain.
+	ChainID string
+
+	// NodeAddress is the priv_validator_laddr of the node, the address where
+	// the remote signer connects to the node.
+	NodeAddress string
+
+	// NodeID is the ID of the node, tmkms authenticates the node with its ID
+	// when the node address is a TCP address.
+	NodeID string
+}
+
+// signerAddress returns the address where the remote signer connects to the
+// node, the unspecified host of the address of the node is replaced by localhost.
+func (o Options) signerAddress() (string, error) {
+	if strings.HasPrefix(o.NodeAddress, "unix://") {
+		return o.NodeAddress, nil
+	}
+	host, port, err := net.SplitHostPort(strings.TrimPrefix(o.NodeAddress, "tcp://"))
+	if err != nil {
+		return "", fmt.Errorf("invalid node address %s: %w", o.NodeAddress, err)
+	}
+	if ip := net.ParseIP(host); host == "" || (ip != nil && ip.IsUnspecified()) {
+		host = "127.0.0.1"
+	}
+	return "tcp://" + net.JoinHostPort(host, port), nil
+}
+
+var tmkmsConfig = template.Must(template.New(TMKMSConfigFile).Parse(`# Tendermint KMS config of the {{ .ChainID }} validator.
+# Run the signer with: tmkms start -c {{ .Dir }}/tmkms.toml
+
+[[chain]]
+id = "{{ .ChainID }}"
+key_format = { type = "hex" }
+state_file = "{{ .Dir }}/state/{{ .ChainID }}-consensus.json"
+
+[[providers.softsign]]
+chain_ids = ["{{ .ChainID }}"]
+key_type = "consensus"
+path = "{{ .Dir }}/secrets/{{ .ChainID }}-consensus.key"
+
+[[validator]]
+chain_id = "{{ .ChainID }}"
+addr = "{{ .Addr }}"
+secret_key = "{{ .Dir }}/secrets/kms-identity.key"
+# CometBFT v0.37 uses the signing protocol of Tendermint v0.34
+protocol_version = "v0.34"
+reconnect = true
+`))
+
+// WriteTMKMS writes in dir the config of a tmkms signer that signs with the
+// softsign provider and the key of the validator. The config is meant for
+// development only, the key of the validator is not encrypted.
+func WriteTMKMS(dir string, validatorKey Key, o Options) error {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return err
+	}
+	addr, err := o.signerAddress()
+	if err != nil {
+		return err
+	}
+	if strings.HasPrefix(addr, "tcp://") && o.NodeID != "" {
+		addr = "tcp://" + o.NodeID + "@" + strings.TrimPrefix(addr, "tcp://")
+	}
+
+	priv, err := validatorKey.privKey()
+	if err != nil {
+		return err
+	}
+	_, identity, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range []string{"secrets", "state"} {
+		if err := os.MkdirAll(filepath.Join(dir, d), 0o700); err != nil {
+			return err
+		}
+	}
+
+	// The softsign keys are the base64 encoded seeds of the ed25519 keys
+	if err := writeSecret(
+		filepath.Join(dir, "secrets", o.ChainID+"-consensus.key"),
+		base64.StdEncoding.EncodeToString(priv.Seed()),
+	); err != nil {
+		return err
+	}
+	if err := writeSecret(
+		filepath.Join(dir, "secrets", "kms-identity.key"),
+		base64.StdEncoding.EncodeToString(identity.Seed()),
+	); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(filepath.Join(dir, TMKMSConfigFile), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return tmkmsConfig.Execute(f, struct {
+		Options
+		Dir  string
+		Addr string
+	}{o, filepath.ToSlash(dir), addr})
+}
+
+var horcruxConfig = template.Must(template.New(HorcruxConfigFile).Parse(`# Horcrux config of the {{ .ChainID }} validator.
+# Run the signer with: horcrux start --home {{ .Dir }}
+signMode: single
+chainNodes:
+  - privValAddr: {{ .Addr }}
+debugAddr: ""
+`))
+
+// WriteHorcrux writes in dir the config of a Horcrux signer in single sign
+// mode that signs with the key of the validator.
+func WriteHorcrux(dir string, validatorKey Key, o Options) error {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return err
+	}
+	addr, err := o.signerAddress()
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(addr, "tcp://") {
+		return fmt.Errorf("horcrux only connects to TCP node addresses, got %s", addr)
+	}
+
+	if err := os.MkdirAll(filepath.Join(dir, "state"), 0o700); err != nil {
+		return err
+	}
+
+	key, err := json.MarshalIndent(validatorKey, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeSecret(filepath.Join(dir, o.ChainID+"_priv_validator_key.json"), string(key)); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(filepath.Join(dir, HorcruxConfigFile), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return horcruxConfig.Execute(f, struct {
+		Options
+		Dir  string
+		Addr string
+	}{o, filepath.ToSlash(dir), addr})
+}
+
+func writeSecret(path, content string) error {
+	return os.WriteFile(path, []byte(content+"\n"), 0o600)
+}
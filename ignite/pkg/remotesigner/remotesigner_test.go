@@ -0,0 +1,96 @@
+package remotesigner_test
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/remotesigner"
+)
+
+// seed is the seed of the key of the tests.
+var seed = make([]byte, ed25519.SeedSize)
+
+func writeKey(t *testing.T) string {
+	priv := ed25519.NewKeyFromSeed(seed)
+	path := filepath.Join(t.TempDir(), "priv_validator_key.json")
+	content := fmt.Sprintf(`{
+  "address": "ABCD",
+  "pub_key": {"type": "tendermint/PubKeyEd25519", "value": %q},
+  "priv_key": {"type": "tendermint/PrivKeyEd25519", "value": %q}
+}`,
+		base64.StdEncoding.EncodeToString(priv.Public().(ed25519.PublicKey)),
+		base64.StdEncoding.EncodeToString(priv),
+	)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestReadKey(t *testing.T) {
+	key, err := remotesigner.ReadKey(writeKey(t))
+	require.NoError(t, err)
+	require.Equal(t, "ABCD", key.Address)
+
+	id, err := key.NodeID()
+	require.NoError(t, err)
+	require.Equal(t, "139e3940e64b5491722088d9a0d741628fc826e0", id)
+
+	invalid := filepath.Join(t.TempDir(), "key.json")
+	require.NoError(t, os.WriteFile(invalid, []byte(`{"priv_key":{"type":"tendermint/PrivKeySecp256k1","value":""}}`), 0o600))
+	_, err = remotesigner.ReadKey(invalid)
+	require.Error(t, err)
+}
+
+func TestWriteTMKMS(t *testing.T) {
+	key, err := remotesigner.ReadKey(writeKey(t))
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	err = remotesigner.WriteTMKMS(dir, key, remotesigner.Options{
+		ChainID:     "mars",
+		NodeAddress: "tcp://0.0.0.0:26659",
+		NodeID:      "abcd",
+	})
+	require.NoError(t, err)
+
+	config, err := os.ReadFile(filepath.Join(dir, remotesigner.TMKMSConfigFile))
+	require.NoError(t, err)
+	require.Contains(t, string(config), `addr = "tcp://abcd@127.0.0.1:26659"`)
+	require.Contains(t, string(config), fmt.Sprintf(`path = "%s/secrets/mars-consensus.key"`, dir))
+
+	consensusKey, err := os.ReadFile(filepath.Join(dir, "secrets", "mars-consensus.key"))
+	require.NoError(t, err)
+	require.Equal(t, base64.StdEncoding.EncodeToString(seed)+"\n", string(consensusKey))
+	require.FileExists(t, filepath.Join(dir, "secrets", "kms-identity.key"))
+}
+
+func TestWriteHorcrux(t *testing.T) {
+	key, err := remotesigner.ReadKey(writeKey(t))
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	err = remotesigner.WriteHorcrux(dir, key, remotesigner.Options{
+		ChainID:     "mars",
+		NodeAddress: "tcp://localhost:26659",
+	})
+	require.NoError(t, err)
+
+	config, err := os.ReadFile(filepath.Join(dir, remotesigner.HorcruxConfigFile))
+	require.NoError(t, err)
+	require.Contains(t, string(config), "privValAddr: tcp://localhost:26659")
+
+	written, err := remotesigner.ReadKey(filepath.Join(dir, "mars_priv_validator_key.json"))
+	require.NoError(t, err)
+	require.Equal(t, key, written)
+
+	err = remotesigner.WriteHorcrux(t.TempDir(), key, remotesigner.Options{
+		ChainID:     "mars",
+		NodeAddress: "unix:///tmp/signer.sock",
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,145 @@
+// Package sshtunnel creates SSH tunnels using the ssh command available in the system.
+package sshtunnel
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ignite/cli/ignite/pkg/cmdrunner"
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/step"
+	"github.com/ignite/cli/ignite/pkg/xexec"
+)
+
+const (
+	// Command is the name of the SSH client command.
+	Command = "ssh"
+
+	// DefaultBindAddress is the default address where the forwarded ports are listening.
+	DefaultBindAddress = "0.0.0.0"
+
+	// keepAliveInterval is the interval in seconds between the keep alive messages
+	// sent to the remote host.
+	keepAliveInterval = 30
+)
+
+// ErrCommandNotFound is returned when the ssh command is not available in the system.
+var ErrCommandNotFound = errors.New("ssh command not found, please install an OpenSSH client")
+
+// Forward defines a port to forward through the tunnel.
+type Forward struct {
+	// Name of the forwarded service.
+	Name string
+
+	// Port is the port of the service, the same port is used on both ends of the tunnel.
+	Port int
+}
+
+// Tunnel defines an SSH tunnel between the local machine and a remote host.
+type Tunnel struct {
+	// Target is the SSH destination, e.g. "user@host".
+	Target string
+
+	// Port is the SSH port of the remote host, the default SSH port is used when zero.
+	Port int
+
+	// BindAddress is the address where the forwarded ports are listening.
+	BindAddress string
+
+	// FromRemote forwards the ports of the remote host to the local machine
+	// instead of exposing the local ports in the remote host.
+	FromRemote bool
+
+	// Forwards are the ports forwarded through the tunnel.
+	Forwards []Forward
+}
+
+// Host returns the host name of the remote machine.
+func (t Tunnel) Host() string {
+	host := t.Target
+	if i := strings.LastIndex(host, "@"); i != -1 {
+		host = host[i+1:]
+	}
+	return host
+}
+
+// URL returns the URL where a forwarded service can be reached.
+func (t Tunnel) URL(f Forward) string {
+	host := t.Host()
+	if t.FromRemote {
+		host = "localhost"
+	}
+	if t.BindAddress != "" && t.BindAddress != DefaultBindAddress {
+		host = t.BindAddress
+	}
+	return fmt.Sprintf("http://%s:%d", host, f.Port)
+}
+
+// Args returns the ssh command arguments to create the tunnel.
+func (t Tunnel) Args() []string {
+	bindAddress := t.BindAddress
+	if bindAddress == "" {
+		bindAddress = DefaultBindAddress
+	}
+
+	args := []string{
+		"-N",
+		"-o", "ExitOnForwardFailure=yes",
+		"-o", "ServerAliveInterval=" + strconv.Itoa(keepAliveInterval),
+	}
+	if t.Port != 0 {
+		args = append(args, "-p", strconv.Itoa(t.Port))
+	}
+
+	flag := "-R"
+	if t.FromRemote {
+		flag = "-L"
+	}
+	for _, f := range t.Forwards {
+		args = append(args, flag, fmt.Sprintf("%s:%d:localhost:%d", bindAddress, f.Port, f.Port))
+	}
+
+	return append(args, t.Target)
+}
+
+// Validate checks that the tunnel can be created.
+func (t Tunnel) Validate() error {
+	if t.Target == "" || strings.HasPrefix(t.Target, "-") {
+		return fmt.Errorf("invalid SSH target %q", t.Target)
+	}
+	if len(t.Forwards) == 0 {
+		return errors.New("no ports to forward")
+	}
+	for _, f := range t.Forwards {
+		if f.Port <= 0 || f.Port > 65535 {
+			return fmt.Errorf("invalid %s port %d", f.Name, f.Port)
+		}
+	}
+	return nil
+}
+
+// Run creates the tunnel and keeps it open until the context is canceled.
+func (t Tunnel) Run(ctx context.Context) error {
+	if err := t.Validate(); err != nil {
+		return err
+	}
+	if !xexec.IsCommandAvailable(Command) {
+		return ErrCommandNotFound
+	}
+
+	errb := &bytes.Buffer{}
+	err := cmdrunner.New().Run(ctx, step.New(
+		step.Exec(Command, t.Args()...),
+		step.Stderr(errb),
+	))
+	if errors.Is(ctx.Err(), context.Canceled) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("ssh tunnel to %s: %w: %s", t.Target, err, strings.TrimSpace(errb.String()))
+	}
+	return nil
+}
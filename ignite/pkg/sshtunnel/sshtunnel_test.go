@@ -0,0 +1,80 @@
+package sshtunnel_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/sshtunnel"
+)
+
+func TestTunnelArgs(t *testing.T) {
+	forwards := []sshtunnel.Forward{
+		{Name: "rpc", Port: 26657},
+		{Name: "api", Port: 1317},
+	}
+
+	tests := []struct {
+		name   string
+		tunnel sshtunnel.Tunnel
+		want   []string
+	}{
+		{
+			name: "reverse",
+			tunnel: sshtunnel.Tunnel{
+				Target:   "alice@example.com",
+				Forwards: forwards,
+			},
+			want: []string{
+				"-N", "-o", "ExitOnForwardFailure=yes", "-o", "ServerAliveInterval=30",
+				"-R", "0.0.0.0:26657:localhost:26657",
+				"-R", "0.0.0.0:1317:localhost:1317",
+				"alice@example.com",
+			},
+		},
+		{
+			name: "from remote with custom port and bind address",
+			tunnel: sshtunnel.Tunnel{
+				Target:      "alice@example.com",
+				Port:        2222,
+				BindAddress: "127.0.0.1",
+				FromRemote:  true,
+				Forwards:    forwards,
+			},
+			want: []string{
+				"-N", "-o", "ExitOnForwardFailure=yes", "-o", "ServerAliveInterval=30",
+				"-p", "2222",
+				"-L", "127.0.0.1:26657:localhost:26657",
+				"-L", "127.0.0.1:1317:localhost:1317",
+				"alice@example.com",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.tunnel.Args())
+		})
+	}
+}
+
+func TestTunnelURL(t *testing.T) {
+	f := sshtunnel.Forward{Name: "rpc", Port: 26657}
+
+	require.Equal(t, "http://example.com:26657", sshtunnel.Tunnel{Target: "alice@example.com"}.URL(f))
+	require.Equal(t, "http://example.com:26657", sshtunnel.Tunnel{Target: "example.com"}.URL(f))
+	require.Equal(t, "http://localhost:26657", sshtunnel.Tunnel{Target: "alice@example.com", FromRemote: true}.URL(f))
+	require.Equal(t, "http://10.0.0.1:26657", sshtunnel.Tunnel{Target: "alice@example.com", BindAddress: "10.0.0.1"}.URL(f))
+}
+
+func TestTunnelValidate(t *testing.T) {
+	forwards := []sshtunnel.Forward{{Name: "rpc", Port: 26657}}
+
+	require.NoError(t, sshtunnel.Tunnel{Target: "alice@example.com", Forwards: forwards}.Validate())
+	require.Error(t, sshtunnel.Tunnel{Forwards: forwards}.Validate())
+	require.Error(t, sshtunnel.Tunnel{Target: "-oProxyCommand=x", Forwards: forwards}.Validate())
+	require.Error(t, sshtunnel.Tunnel{Target: "alice@example.com"}.Validate())
+	require.Error(t, sshtunnel.Tunnel{
+		Target:   "alice@example.com",
+		Forwards: []sshtunnel.Forward{{Name: "rpc", Port: 70000}},
+	}.Validate())
+}
@@ -0,0 +1,162 @@
+// Package tendermintconfig provides a typed representation of the Tendermint
+// and CometBFT node configuration file (config/config.toml).
+package tendermintconfig
+
+// Duration is a duration value written as a string, e.g. "1s" or "500ms".
+type Duration string
+
+// Config overwrites the values of a node's config/config.toml.
+// Only non nil values are written to the configuration file.
+type Config struct {
+	ProxyApp                *string `mapstructure:"proxy_app"`
+	Moniker                 *string `mapstructure:"moniker"`
+	FastSyncMode            *bool   `mapstructure:"fast_sync"`
+	BlockSyncMode           *bool   `mapstructure:"block_sync"`
+	DBBackend               *string `mapstructure:"db_backend"`
+	DBDir                   *string `mapstructure:"db_dir"`
+	LogLevel                *string `mapstructure:"log_level"`
+	LogFormat               *string `mapstructure:"log_format"`
+	GenesisFile             *string `mapstructure:"genesis_file"`
+	PrivValidatorKeyFile    *string `mapstructure:"priv_validator_key_file"`
+	PrivValidatorStateFile  *string `mapstructure:"priv_validator_state_file"`
+	PrivValidatorListenAddr *string `mapstructure:"priv_validator_laddr"`
+	NodeKeyFile             *string `mapstructure:"node_key_file"`
+	ABCI                    *string `mapstructure:"abci"`
+	FilterPeers             *bool   `mapstructure:"filter_peers"`
+
+	RPC             *RPC             `mapstructure:"rpc"`
+	P2P             *P2P             `mapstructure:"p2p"`
+	Mempool         *Mempool         `mapstructure:"mempool"`
+	StateSync       *StateSync       `mapstructure:"statesync"`
+	FastSync        *SyncReactor     `mapstructure:"fastsync"`
+	BlockSync       *SyncReactor     `mapstructure:"blocksync"`
+	Consensus       *Consensus       `mapstructure:"consensus"`
+	Storage         *Storage         `mapstructure:"storage"`
+	TxIndex         *TxIndex         `mapstructure:"tx_index"`
+	Instrumentation *Instrumentation `mapstructure:"instrumentation"`
+
+	// Extra contains values that are written to the configuration file as they are,
+	// without any validation. It is meant for advanced keys that are not part of
+	// the typed configuration.
+	Extra map[string]interface{} `mapstructure:"extra"`
+}
+
+// RPC overwrites the values of the "rpc" section.
+type RPC struct {
+	ListenAddress                        *string   `mapstructure:"laddr"`
+	CORSAllowedOrigins                   *[]string `mapstructure:"cors_allowed_origins"`
+	CORSAllowedMethods                   *[]string `mapstructure:"cors_allowed_methods"`
+	CORSAllowedHeaders                   *[]string `mapstructure:"cors_allowed_headers"`
+	GRPCListenAddress                    *string   `mapstructure:"grpc_laddr"`
+	GRPCMaxOpenConnections               *int64    `mapstructure:"grpc_max_open_connections"`
+	Unsafe                               *bool     `mapstructure:"unsafe"`
+	MaxOpenConnections                   *int64    `mapstructure:"max_open_connections"`
+	MaxSubscriptionClients               *int64    `mapstructure:"max_subscription_clients"`
+	MaxSubscriptionsPerClient            *int64    `mapstructure:"max_subscriptions_per_client"`
+	ExperimentalSubscriptionBufferSize   *int64    `mapstructure:"experimental_subscription_buffer_size"`
+	ExperimentalWebSocketWriteBufferSize *int64    `mapstructure:"experimental_websocket_write_buffer_size"`
+	ExperimentalCloseOnSlowClient        *bool     `mapstructure:"experimental_close_on_slow_client"`
+	TimeoutBroadcastTxCommit             *Duration `mapstructure:"timeout_broadcast_tx_commit"`
+	MaxBodyBytes                         *int64    `mapstructure:"max_body_bytes"`
+	MaxHeaderBytes                       *int64    `mapstructure:"max_header_bytes"`
+	TLSCertFile                          *string   `mapstructure:"tls_cert_file"`
+	TLSKeyFile                           *string   `mapstructure:"tls_key_file"`
+	PProfListenAddress                   *string   `mapstructure:"pprof_laddr"`
+}
+
+// P2P overwrites the values of the "p2p" section.
+type P2P struct {
+	ListenAddress                *string   `mapstructure:"laddr"`
+	ExternalAddress              *string   `mapstructure:"external_address"`
+	Seeds                        *string   `mapstructure:"seeds"`
+	PersistentPeers              *string   `mapstructure:"persistent_peers"`
+	UPNP                         *bool     `mapstructure:"upnp"`
+	AddrBookFile                 *string   `mapstructure:"addr_book_file"`
+	AddrBookStrict               *bool     `mapstructure:"addr_book_strict"`
+	MaxNumInboundPeers           *int64    `mapstructure:"max_num_inbound_peers"`
+	MaxNumOutboundPeers          *int64    `mapstructure:"max_num_outbound_peers"`
+	UnconditionalPeerIDs         *string   `mapstructure:"unconditional_peer_ids"`
+	PersistentPeersMaxDialPeriod *Duration `mapstructure:"persistent_peers_max_dial_period"`
+	FlushThrottleTimeout         *Duration `mapstructure:"flush_throttle_timeout"`
+	MaxPacketMsgPayloadSize      *int64    `mapstructure:"max_packet_msg_payload_size"`
+	SendRate                     *int64    `mapstructure:"send_rate"`
+	RecvRate                     *int64    `mapstructure:"recv_rate"`
+	PEX                          *bool     `mapstructure:"pex"`
+	SeedMode                     *bool     `mapstructure:"seed_mode"`
+	PrivatePeerIDs               *string   `mapstructure:"private_peer_ids"`
+	AllowDuplicateIP             *bool     `mapstructure:"allow_duplicate_ip"`
+	HandshakeTimeout             *Duration `mapstructure:"handshake_timeout"`
+	DialTimeout                  *Duration `mapstructure:"dial_timeout"`
+}
+
+// Mempool overwrites the values of the "mempool" section.
+type Mempool struct {
+	Version               *string   `mapstructure:"version"`
+	Recheck               *bool     `mapstructure:"recheck"`
+	Broadcast             *bool     `mapstructure:"broadcast"`
+	WalDir                *string   `mapstructure:"wal_dir"`
+	Size                  *int64    `mapstructure:"size"`
+	MaxTxsBytes           *int64    `mapstructure:"max_txs_bytes"`
+	CacheSize             *int64    `mapstructure:"cache_size"`
+	KeepInvalidTxsInCache *bool     `mapstructure:"keep-invalid-txs-in-cache"`
+	MaxTxBytes            *int64    `mapstructure:"max_tx_bytes"`
+	MaxBatchBytes         *int64    `mapstructure:"max_batch_bytes"`
+	TTLDuration           *Duration `mapstructure:"ttl-duration"`
+	TTLNumBlocks          *int64    `mapstructure:"ttl-num-blocks"`
+}
+
+// StateSync overwrites the values of the "statesync" section.
+type StateSync struct {
+	Enable              *bool     `mapstructure:"enable"`
+	RPCServers          *string   `mapstructure:"rpc_servers"`
+	TrustHeight         *int64    `mapstructure:"trust_height"`
+	TrustHash           *string   `mapstructure:"trust_hash"`
+	TrustPeriod         *Duration `mapstructure:"trust_period"`
+	DiscoveryTime       *Duration `mapstructure:"discovery_time"`
+	TempDir             *string   `mapstructure:"temp_dir"`
+	ChunkRequestTimeout *Duration `mapstructure:"chunk_request_timeout"`
+	ChunkFetchers       *string   `mapstructure:"chunk_fetchers"`
+}
+
+// SyncReactor overwrites the values of the "fastsync" section in Tendermint
+// or the "blocksync" section in CometBFT.
+type SyncReactor struct {
+	Version *string `mapstructure:"version"`
+}
+
+// Consensus overwrites the values of the "consensus" section.
+type Consensus struct {
+	WalFile                     *string   `mapstructure:"wal_file"`
+	TimeoutPropose              *Duration `mapstructure:"timeout_propose"`
+	TimeoutProposeDelta         *Duration `mapstructure:"timeout_propose_delta"`
+	TimeoutPrevote              *Duration `mapstructure:"timeout_prevote"`
+	TimeoutPrevoteDelta         *Duration `mapstructure:"timeout_prevote_delta"`
+	TimeoutPrecommit            *Duration `mapstructure:"timeout_precommit"`
+	TimeoutPrecommitDelta       *Duration `mapstructure:"timeout_precommit_delta"`
+	TimeoutCommit               *Duration `mapstructure:"timeout_commit"`
+	DoubleSignCheckHeight       *int64    `mapstructure:"double_sign_check_height"`
+	SkipTimeoutCommit           *bool     `mapstructure:"skip_timeout_commit"`
+	CreateEmptyBlocks           *bool     `mapstructure:"create_empty_blocks"`
+	CreateEmptyBlocksInterval   *Duration `mapstructure:"create_empty_blocks_interval"`
+	PeerGossipSleepDuration     *Duration `mapstructure:"peer_gossip_sleep_duration"`
+	PeerQueryMaj23SleepDuration *Duration `mapstructure:"peer_query_maj23_sleep_duration"`
+}
+
+// Storage overwrites the values of the "storage" section.
+type Storage struct {
+	DiscardABCIResponses *bool `mapstructure:"discard_abci_responses"`
+}
+
+// TxIndex overwrites the values of the "tx_index" section.
+type TxIndex struct {
+	Indexer  *string `mapstructure:"indexer"`
+	PsqlConn *string `mapstructure:"psql-conn"`
+}
+
+// Instrumentation overwrites the values of the "instrumentation" section.
+type Instrumentation struct {
+	Prometheus           *bool   `mapstructure:"prometheus"`
+	PrometheusListenAddr *string `mapstructure:"prometheus_listen_addr"`
+	MaxOpenConnections   *int64  `mapstructure:"max_open_connections"`
+	Namespace            *string `mapstructure:"namespace"`
+}
@@ -0,0 +1,45 @@
+package tendermintconfig
+
+import (
+	"fmt"
+
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+
+	"github.com/ignite/cli/ignite/pkg/gomodule"
+)
+
+const (
+	tendermintModulePath = "github.com/tendermint/tendermint"
+	cometbftModulePath   = "github.com/cometbft/cometbft"
+)
+
+// Detect detects the consensus engine config version used by the app.
+// CometBFT is preferred over Tendermint when the app requires both.
+func Detect(appPath string) (Version, error) {
+	parsed, err := gomodule.ParseAt(appPath)
+	if err != nil {
+		return "", err
+	}
+
+	var requires []module.Version
+	for _, r := range parsed.Require {
+		requires = append(requires, r.Mod)
+	}
+
+	for _, path := range []string{cometbftModulePath, tendermintModulePath} {
+		deps := gomodule.FilterVersions(requires, path)
+		if len(deps) == 0 {
+			continue
+		}
+
+		switch version := Version(semver.MajorMinor(deps[0].Version)); version {
+		case V034, V037:
+			return version, nil
+		default:
+			return "", fmt.Errorf("unsupported consensus engine version %s %s", path, deps[0].Version)
+		}
+	}
+
+	return "", fmt.Errorf("consensus engine dependency not found in %s go.mod", appPath)
+}
@@ -0,0 +1,133 @@
+package tendermintconfig
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+// Version is the consensus engine version of the config file format.
+type Version string
+
+const (
+	// V034 is the config format of Tendermint v0.34.
+	V034 Version = "v0.34"
+
+	// V037 is the config format of CometBFT v0.37.
+	V037 Version = "v0.37"
+)
+
+func (v Version) String() string {
+	if v == V034 {
+		return "Tendermint " + string(v)
+	}
+	return "CometBFT " + string(v)
+}
+
+// unsupportedKeys are the keys that are not part of the config format of a version,
+// mapped to the key that replaces them.
+var unsupportedKeys = map[Version]map[string]string{
+	V034: {
+		"block_sync": "fast_sync",
+		"blocksync":  "fastsync",
+	},
+	V037: {
+		"fast_sync": "block_sync",
+		"fastsync":  "blocksync",
+	},
+}
+
+// Parse parses the config values defined for a validator for a consensus engine version.
+// An error is returned when a value has an invalid type or when a key is not part of
+// the config format of the version, unless it is defined inside the "extra" key.
+func Parse(values map[string]interface{}, version Version) (c Config, err error) {
+	replacements, ok := unsupportedKeys[version]
+	if !ok {
+		return c, fmt.Errorf("unsupported consensus version %q", string(version))
+	}
+
+	var md mapstructure.Metadata
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		Metadata: &md,
+		Result:   &c,
+	})
+	if err != nil {
+		return c, err
+	}
+	if err := decoder.Decode(values); err != nil {
+		return c, fmt.Errorf("invalid %s config: %w", version, err)
+	}
+
+	if len(md.Unused) > 0 {
+		sort.Strings(md.Unused)
+		return c, fmt.Errorf(
+			"unknown %s config key %q, use the %q key to set values that are not validated",
+			version,
+			md.Unused[0],
+			"extra",
+		)
+	}
+
+	for key := range values {
+		if replacement, ok := replacements[key]; ok {
+			return c, fmt.Errorf("config key %q is not supported by %s, use %q instead", key, version, replacement)
+		}
+	}
+
+	return c, c.validate()
+}
+
+// Values returns the non nil config values indexed by their TOML key path, e.g. "consensus.timeout_commit".
+// The extra values are not included.
+func (c Config) Values() map[string]interface{} {
+	values := make(map[string]interface{})
+	walk(reflect.ValueOf(c), "", func(key string, v reflect.Value) {
+		if d, ok := v.Interface().(Duration); ok {
+			values[key] = string(d)
+			return
+		}
+		values[key] = v.Interface()
+	})
+	return values
+}
+
+func (c Config) validate() (err error) {
+	walk(reflect.ValueOf(c), "", func(key string, v reflect.Value) {
+		d, ok := v.Interface().(Duration)
+		if !ok || err != nil {
+			return
+		}
+		if _, parseErr := time.ParseDuration(string(d)); parseErr != nil {
+			err = fmt.Errorf("invalid duration %q for config key %q: %w", d, key, parseErr)
+		}
+	})
+	return err
+}
+
+// walk calls fn for each non nil config value using its TOML key path.
+// Durations are passed to fn as Duration and the other values as their
+// underlying type.
+func walk(v reflect.Value, prefix string, fn func(key string, v reflect.Value)) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := v.Field(i)
+		if field.Kind() != reflect.Ptr || field.IsNil() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("mapstructure"), ",")
+		key := prefix + name
+
+		value := field.Elem()
+		if value.Kind() == reflect.Struct {
+			walk(value, key+".", fn)
+			continue
+		}
+
+		fn(key, value)
+	}
+}
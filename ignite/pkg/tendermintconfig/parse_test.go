@@ -0,0 +1,166 @@
+package tendermintconfig_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/tendermintconfig"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  map[string]interface{}
+		version tendermintconfig.Version
+		want    map[string]interface{}
+		extra   map[string]interface{}
+		err     string
+	}{
+		{
+			name: "typed values",
+			values: map[string]interface{}{
+				"moniker": "alice",
+				"consensus": map[string]interface{}{
+					"timeout_commit":      "5s",
+					"create_empty_blocks": false,
+				},
+				"rpc": map[string]interface{}{
+					"cors_allowed_origins": []string{"*"},
+					"max_body_bytes":       1000,
+				},
+			},
+			version: tendermintconfig.V034,
+			want: map[string]interface{}{
+				"moniker":                       "alice",
+				"consensus.timeout_commit":      "5s",
+				"consensus.create_empty_blocks": false,
+				"rpc.cors_allowed_origins":      []string{"*"},
+				"rpc.max_body_bytes":            int64(1000),
+			},
+		},
+		{
+			name: "extra values",
+			values: map[string]interface{}{
+				"extra": map[string]interface{}{
+					"custom": map[string]interface{}{"key": "value"},
+				},
+			},
+			version: tendermintconfig.V037,
+			want:    map[string]interface{}{},
+			extra: map[string]interface{}{
+				"custom": map[string]interface{}{"key": "value"},
+			},
+		},
+		{
+			name: "version specific values",
+			values: map[string]interface{}{
+				"block_sync": true,
+				"blocksync":  map[string]interface{}{"version": "v0"},
+			},
+			version: tendermintconfig.V037,
+			want: map[string]interface{}{
+				"block_sync":        true,
+				"blocksync.version": "v0",
+			},
+		},
+		{
+			name: "unknown key",
+			values: map[string]interface{}{
+				"consensus": map[string]interface{}{"timeout": "5s"},
+			},
+			version: tendermintconfig.V034,
+			err:     `unknown Tendermint v0.34 config key "consensus.timeout", use the "extra" key to set values that are not validated`,
+		},
+		{
+			name:    "key not supported by the version",
+			values:  map[string]interface{}{"fastsync": map[string]interface{}{"version": "v0"}},
+			version: tendermintconfig.V037,
+			err:     `config key "fastsync" is not supported by CometBFT v0.37, use "blocksync" instead`,
+		},
+		{
+			name: "invalid duration",
+			values: map[string]interface{}{
+				"consensus": map[string]interface{}{"timeout_commit": "5"},
+			},
+			version: tendermintconfig.V034,
+			err:     `invalid duration "5" for config key "consensus.timeout_commit": time: missing unit in duration "5"`,
+		},
+		{
+			name:    "invalid type",
+			values:  map[string]interface{}{"filter_peers": "yes"},
+			version: tendermintconfig.V034,
+			err:     "invalid Tendermint v0.34 config: 1 error(s) decoding:\n\n* 'filter_peers' expected type 'bool', got unconvertible type 'string', value: 'yes'",
+		},
+		{
+			name:    "unsupported version",
+			version: tendermintconfig.Version("v0.38"),
+			err:     `unsupported consensus version "v0.38"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := tendermintconfig.Parse(tt.values, tt.version)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, c.Values())
+			require.Equal(t, tt.extra, c.Extra)
+		})
+	}
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name  string
+		gomod string
+		want  tendermintconfig.Version
+		err   bool
+	}{
+		{
+			name:  "tendermint",
+			gomod: "module foo\n\nrequire github.com/tendermint/tendermint v0.34.22\n",
+			want:  tendermintconfig.V034,
+		},
+		{
+			name: "cometbft",
+			gomod: `module foo
+
+require (
+	github.com/cometbft/cometbft v0.37.0
+	github.com/tendermint/tendermint v0.34.22
+)
+`,
+			want: tendermintconfig.V037,
+		},
+		{
+			name:  "unsupported version",
+			gomod: "module foo\n\nrequire github.com/tendermint/tendermint v0.35.9\n",
+			err:   true,
+		},
+		{
+			name:  "no consensus engine",
+			gomod: "module foo\n",
+			err:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			appPath := t.TempDir()
+			err := os.WriteFile(filepath.Join(appPath, "go.mod"), []byte(tt.gomod), 0o644)
+			require.NoError(t, err)
+
+			version, err := tendermintconfig.Detect(appPath)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, version)
+		})
+	}
+}
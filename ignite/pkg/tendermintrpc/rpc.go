@@ -7,13 +7,17 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strconv"
 )
 
 const (
-	endpointNetInfo = "/net_info"
-	endpointGenesis = "/genesis"
-	endpointStatus  = "/status"
+	endpointNetInfo        = "/net_info"
+	endpointGenesis        = "/genesis"
+	endpointGenesisChunked = "/genesis_chunked"
+	endpointStatus         = "/status"
+	endpointBlock          = "/block"
+	endpointABCI           = "/abci_query"
 )
 
 // Client is a Tendermint RPC client.
@@ -101,9 +105,47 @@ func (c Client) GetGenesis(ctx context.Context) (Genesis, error) {
 	return out.Result.Genesis, nil
 }
 
+// GenesisDocument retrieves the whole genesis document of the node, the
+// genesis is retrieved in chunks when it's too large to be served at once.
+func (c Client) GenesisDocument(ctx context.Context) (json.RawMessage, error) {
+	var out struct {
+		Result struct {
+			Genesis json.RawMessage `json:"genesis"`
+		} `json:"result"`
+	}
+	if err := c.get(ctx, endpointGenesis, nil, &out); err == nil && len(out.Result.Genesis) > 0 {
+		return out.Result.Genesis, nil
+	}
+
+	var genesis []byte
+	for chunk, total := 0, 1; chunk < total; chunk++ {
+		var out struct {
+			Result struct {
+				Total string `json:"total"`
+				Data  []byte `json:"data"`
+			} `json:"result"`
+		}
+		params := url.Values{}
+		params.Set("chunk", strconv.Itoa(chunk))
+		if err := c.get(ctx, endpointGenesisChunked, params, &out); err != nil {
+			return nil, fmt.Errorf("genesis chunk %d: %w", chunk, err)
+		}
+
+		t, err := strconv.Atoi(out.Result.Total)
+		if err != nil {
+			return nil, err
+		}
+		total = t
+		genesis = append(genesis, out.Result.Data...)
+	}
+	return genesis, nil
+}
+
 // NodeInfo holds node info.
 type NodeInfo struct {
-	Network string
+	ID         string `json:"id"`
+	ListenAddr string `json:"listen_addr"`
+	Network    string
 }
 
 // Status retrieves node Status.
@@ -158,3 +200,150 @@ func (c Client) Status(ctx context.Context) (NodeInfo, error) {
 
 	return info, nil
 }
+
+// LatestHeight retrieves the height of the latest block of the node.
+func (c Client) LatestHeight(ctx context.Context) (int64, error) {
+	var out struct {
+		Result struct {
+			SyncInfo struct {
+				LatestBlockHeight string `json:"latest_block_height"`
+			} `json:"sync_info"`
+		} `json:"result"`
+	}
+	if err := c.get(ctx, endpointStatus, nil, &out); err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseInt(out.Result.SyncInfo.LatestBlockHeight, 10, 64)
+}
+
+// SyncInfo holds the sync info of a node.
+type SyncInfo struct {
+	LatestBlockHeight int64
+
+	// CatchingUp is true while the node syncs the blocks of the network.
+	CatchingUp bool
+}
+
+// SyncInfo retrieves the sync info of the node.
+func (c Client) SyncInfo(ctx context.Context) (SyncInfo, error) {
+	var out struct {
+		Result struct {
+			SyncInfo struct {
+				LatestBlockHeight string `json:"latest_block_height"`
+				CatchingUp        bool   `json:"catching_up"`
+			} `json:"sync_info"`
+		} `json:"result"`
+	}
+	if err := c.get(ctx, endpointStatus, nil, &out); err != nil {
+		return SyncInfo{}, err
+	}
+
+	height, err := strconv.ParseInt(out.Result.SyncInfo.LatestBlockHeight, 10, 64)
+	if err != nil {
+		return SyncInfo{}, err
+	}
+	return SyncInfo{
+		LatestBlockHeight: height,
+		CatchingUp:        out.Result.SyncInfo.CatchingUp,
+	}, nil
+}
+
+// BlockHash retrieves the hash of the block at the height.
+func (c Client) BlockHash(ctx context.Context, height int64) (string, error) {
+	var out struct {
+		Result struct {
+			BlockID struct {
+				Hash string `json:"hash"`
+			} `json:"block_id"`
+		} `json:"result"`
+	}
+	params := url.Values{}
+	params.Set("height", strconv.FormatInt(height, 10))
+	if err := c.get(ctx, endpointBlock, params, &out); err != nil {
+		return "", err
+	}
+	if out.Result.BlockID.Hash == "" {
+		return "", fmt.Errorf("block %d not found", height)
+	}
+	return out.Result.BlockID.Hash, nil
+}
+
+// ABCIQueryResponse is the response of an ABCI query.
+type ABCIQueryResponse struct {
+	// Code is the code of the response, zero when the query succeeds.
+	Code uint32
+
+	// Log is the error of the query when it fails.
+	Log string
+
+	// Value is the value of the response.
+	Value []byte
+
+	// Height is the height of the state queried.
+	Height int64
+}
+
+// ABCIQuery queries the app of the node with the data at path, e.g. the
+// serialized request of a gRPC method at the full name of the method. The
+// latest state is queried when height is zero.
+func (c Client) ABCIQuery(ctx context.Context, path string, data []byte, height int64) (ABCIQueryResponse, error) {
+	params := url.Values{}
+	params.Set("path", strconv.Quote(path))
+	params.Set("data", fmt.Sprintf("0x%x", data))
+	if height > 0 {
+		params.Set("height", strconv.FormatInt(height, 10))
+	}
+
+	var out struct {
+		Result struct {
+			Response struct {
+				Code   uint32 `json:"code"`
+				Log    string `json:"log"`
+				Value  []byte `json:"value"`
+				Height string `json:"height"`
+			} `json:"response"`
+		} `json:"result"`
+	}
+	if err := c.get(ctx, endpointABCI, params, &out); err != nil {
+		return ABCIQueryResponse{}, err
+	}
+
+	res := out.Result.Response
+	h, err := strconv.ParseInt(res.Height, 10, 64)
+	if err != nil {
+		return ABCIQueryResponse{}, err
+	}
+
+	return ABCIQueryResponse{
+		Code:   res.Code,
+		Log:    res.Log,
+		Value:  res.Value,
+		Height: h,
+	}, nil
+}
+
+// get decodes the response of the endpoint queried with the params into out.
+func (c Client) get(ctx context.Context, endpoint string, params url.Values, out interface{}) error {
+	u := c.url(endpoint)
+	if len(params) > 0 {
+		u += "?" + params.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%d", resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
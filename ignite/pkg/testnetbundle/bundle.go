@@ -0,0 +1,253 @@
+// Package testnetbundle writes the deployable bundle of a testnet: the Docker
+// Compose and Kubernetes definitions of a public RPC node, a faucet and a block
+// explorer configured for the chain.
+package testnetbundle
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+const (
+	// P2PPort, RPCPort, APIPort and GRPCPort are the ports of the servers of
+	// the node.
+	P2PPort  = 26656
+	RPCPort  = 26657
+	APIPort  = 1317
+	GRPCPort = 9090
+
+	// FaucetPort is the port of the faucet.
+	FaucetPort = 4500
+
+	// ExplorerPort is the host port of the explorer in the Docker Compose
+	// definition.
+	ExplorerPort = 8080
+
+	// DefaultNodeImage is the base image of the node, the binary of the chain
+	// is copied in the image.
+	DefaultNodeImage = "debian:bookworm-slim"
+
+	// DefaultIgniteImage is the image of Ignite CLI that serves the faucet.
+	DefaultIgniteImage = "ignitehq/cli:latest"
+
+	faucetEnvFile     = "faucet.env"
+	faucetMnemonicEnv = "FAUCET_MNEMONIC"
+)
+
+//go:embed templates
+var templates embed.FS
+
+// Faucet configures the faucet of the bundle.
+type Faucet struct {
+	// Account is the name of the account of the faucet.
+	Account string
+
+	// Mnemonic is the mnemonic of the account of the faucet, it is written in
+	// the environment file of the faucet to fill in when empty.
+	Mnemonic string
+
+	// Coins are the coins sent per request and CoinsMax the max amounts of
+	// coins sent to an address.
+	Coins    []string
+	CoinsMax []string
+}
+
+// Options configures the bundle of a testnet.
+type Options struct {
+	// ChainID is the chain ID of the testnet.
+	ChainID string
+
+	// Binary is the path of the binary of the chain, the binary is copied in
+	// the images and must be built for their platform.
+	Binary string
+
+	// Genesis is the final genesis of the testnet.
+	Genesis []byte
+
+	// Peers are the persistent peers of the node.
+	Peers []string
+
+	// Image is the name of the images of the bundle, the chain ID by default.
+	// Prefix it with a registry to push the images for Kubernetes.
+	Image string
+
+	// IgniteImage is the image of Ignite CLI that serves the faucet.
+	IgniteImage string
+
+	// Faucet configures the faucet, the bundle has no faucet when nil.
+	Faucet *Faucet
+}
+
+// Write writes the bundle of the testnet in dir.
+func Write(dir string, o Options) error {
+	if o.ChainID == "" {
+		return errors.New("the chain ID is required")
+	}
+	if o.Image == "" {
+		o.Image = strings.ToLower(o.ChainID)
+	}
+	if o.IgniteImage == "" {
+		o.IgniteImage = DefaultIgniteImage
+	}
+	minimumGasPrices, err := minimumGasPrices(o.Genesis)
+	if err != nil {
+		return err
+	}
+
+	binaryName := filepath.Base(o.Binary)
+	data := map[string]interface{}{
+		"ChainID":          o.ChainID,
+		"Namespace":        strings.ToLower(o.ChainID),
+		"BinaryName":       binaryName,
+		"Image":            o.Image,
+		"NodeImage":        DefaultNodeImage,
+		"IgniteImage":      o.IgniteImage,
+		"PersistentPeers":  strings.Join(o.Peers, ","),
+		"MinimumGasPrices": minimumGasPrices,
+		"P2PPort":          P2PPort,
+		"RPCPort":          RPCPort,
+		"APIPort":          APIPort,
+		"GRPCPort":         GRPCPort,
+		"FaucetPort":       FaucetPort,
+		"ExplorerPort":     ExplorerPort,
+		"Faucet":           o.Faucet,
+		"FaucetEnvFile":    faucetEnvFile,
+		"FaucetArgs":       faucetArgs(o.ChainID, binaryName, o.Faucet),
+	}
+
+	paths, err := templatePaths()
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		name := strings.TrimSuffix(strings.TrimPrefix(path, "templates/"), ".tpl")
+		if o.Faucet == nil && name == "k8s/faucet.yaml" {
+			continue
+		}
+		if err := writeTemplate(filepath.Join(dir, name), path, data); err != nil {
+			return err
+		}
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "genesis.json"), o.Genesis, 0o644); err != nil {
+		return err
+	}
+	if err := copyBinary(o.Binary, filepath.Join(dir, "bin", binaryName)); err != nil {
+		return err
+	}
+	if o.Faucet != nil {
+		// the file is kept when the mnemonic is filled in after a previous write.
+		envPath := filepath.Join(dir, faucetEnvFile)
+		if _, err := os.Stat(envPath); o.Faucet.Mnemonic != "" || errors.Is(err, os.ErrNotExist) {
+			env := fmt.Sprintf("%s=%s\n", faucetMnemonicEnv, o.Faucet.Mnemonic)
+			if err := os.WriteFile(envPath, []byte(env), 0o600); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// faucetArgs returns the arguments of the Ignite CLI command of the faucet.
+func faucetArgs(chainID, binaryName string, f *Faucet) []string {
+	if f == nil {
+		return nil
+	}
+	args := []string{
+		"faucet", "serve",
+		"--binary", binaryName,
+		"--node", fmt.Sprintf("tcp://node:%d", RPCPort),
+		"--chain-id", chainID,
+		"--account", f.Account,
+		"--mnemonic-env", faucetMnemonicEnv,
+		"--host", fmt.Sprintf(":%d", FaucetPort),
+		"--api-address", fmt.Sprintf("http://node:%d", APIPort),
+	}
+	if len(f.Coins) > 0 {
+		args = append(args, "--coins", strings.Join(f.Coins, ","))
+	}
+	if len(f.CoinsMax) > 0 {
+		args = append(args, "--coins-max", strings.Join(f.CoinsMax, ","))
+	}
+	return args
+}
+
+// minimumGasPrices returns the minimum gas prices of the node, the bond denom
+// of the genesis is free.
+func minimumGasPrices(genesis []byte) (string, error) {
+	var g struct {
+		AppState struct {
+			Staking struct {
+				Params struct {
+					BondDenom string `json:"bond_denom"`
+				} `json:"params"`
+			} `json:"staking"`
+		} `json:"app_state"`
+	}
+	if err := json.Unmarshal(genesis, &g); err != nil {
+		return "", fmt.Errorf("invalid genesis: %w", err)
+	}
+	denom := g.AppState.Staking.Params.BondDenom
+	if denom == "" {
+		return "", errors.New("the genesis has no bond denom")
+	}
+	return "0" + denom, nil
+}
+
+func templatePaths() ([]string, error) {
+	var paths []string
+	for _, pattern := range []string{"templates/*.tpl", "templates/*/*.tpl"} {
+		matches, err := fs.Glob(templates, pattern)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, matches...)
+	}
+	return paths, nil
+}
+
+func writeTemplate(path, name string, data interface{}) error {
+	t, err := template.ParseFS(templates, name)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return t.Execute(f, data)
+}
+
+func copyBinary(src, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return err
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o755)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, in)
+	return err
+}
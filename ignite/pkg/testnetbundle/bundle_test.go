@@ -0,0 +1,72 @@
+package testnetbundle_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/testnetbundle"
+)
+
+func TestWrite(t *testing.T) {
+	binary := filepath.Join(t.TempDir(), "marsd")
+	require.NoError(t, os.WriteFile(binary, []byte("marsd"), 0o755))
+
+	dir := t.TempDir()
+	o := testnetbundle.Options{
+		ChainID: "Mars-1",
+		Binary:  binary,
+		Genesis: []byte(`{"chain_id":"Mars-1","app_state":{"staking":{"params":{"bond_denom":"umars"}}}}`),
+		Peers:   []string{"a1@203.0.113.1:26656", "b2@203.0.113.2:26656"},
+		Faucet: &testnetbundle.Faucet{
+			Account: "faucet",
+			Coins:   []string{"5umars", "10token"},
+		},
+	}
+	require.NoError(t, testnetbundle.Write(dir, o))
+
+	for _, path := range []string{
+		"Dockerfile",
+		"kustomization.yaml",
+		"k8s/namespace.yaml",
+		"k8s/node.yaml",
+		"k8s/faucet.yaml",
+		"k8s/explorer.yaml",
+		"explorer/index.html",
+		"explorer/nginx.conf",
+		"genesis.json",
+		"bin/marsd",
+	} {
+		require.FileExists(t, filepath.Join(dir, path))
+	}
+
+	node, err := os.ReadFile(filepath.Join(dir, "node.sh"))
+	require.NoError(t, err)
+	require.Contains(t, string(node), `--p2p.persistent_peers "a1@203.0.113.1:26656,b2@203.0.113.2:26656"`)
+	require.Contains(t, string(node), `--minimum-gas-prices "0umars"`)
+
+	compose, err := os.ReadFile(filepath.Join(dir, "docker-compose.yml"))
+	require.NoError(t, err)
+	require.Contains(t, string(compose), "image: mars-1:faucet")
+	require.Contains(t, string(compose), `- "5umars,10token"`)
+
+	// the mnemonic filled in the environment file is kept.
+	envPath := filepath.Join(dir, "faucet.env")
+	require.NoError(t, os.WriteFile(envPath, []byte("FAUCET_MNEMONIC=mars\n"), 0o600))
+	require.NoError(t, testnetbundle.Write(dir, o))
+	env, err := os.ReadFile(envPath)
+	require.NoError(t, err)
+	require.Equal(t, "FAUCET_MNEMONIC=mars\n", string(env))
+
+	// the bundle without faucet has no faucet service.
+	dir = t.TempDir()
+	o.Faucet = nil
+	require.NoError(t, testnetbundle.Write(dir, o))
+	require.NoFileExists(t, filepath.Join(dir, "k8s", "faucet.yaml"))
+	require.NoFileExists(t, filepath.Join(dir, "faucet.env"))
+	nginx, err := os.ReadFile(filepath.Join(dir, "explorer", "nginx.conf"))
+	require.NoError(t, err)
+	require.NotContains(t, string(nginx), "faucet")
+}
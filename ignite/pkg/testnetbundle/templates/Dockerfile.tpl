@@ -0,0 +1,23 @@
+# Images of the {{ .ChainID }} testnet, built by docker compose or with:
+#   docker build --target node -t {{ .Image }}:node .
+#   docker build --target explorer -t {{ .Image }}:explorer .
+{{- if .Faucet }}
+#   docker build --target faucet -t {{ .Image }}:faucet .
+{{- end }}
+
+FROM {{ .NodeImage }} AS node
+RUN apt-get update && apt-get install -y ca-certificates && rm -rf /var/lib/apt/lists/*
+COPY bin/{{ .BinaryName }} /usr/local/bin/{{ .BinaryName }}
+COPY genesis.json node.sh /bundle/
+EXPOSE {{ .P2PPort }} {{ .RPCPort }} {{ .APIPort }} {{ .GRPCPort }}
+ENTRYPOINT ["sh", "/bundle/node.sh"]
+{{- if .Faucet }}
+
+FROM {{ .IgniteImage }} AS faucet
+COPY bin/{{ .BinaryName }} /usr/local/bin/{{ .BinaryName }}
+EXPOSE {{ .FaucetPort }}
+{{- end }}
+
+FROM nginx:alpine AS explorer
+COPY explorer/nginx.conf /etc/nginx/conf.d/default.conf
+COPY explorer/index.html /usr/share/nginx/html/index.html
@@ -0,0 +1,54 @@
+# Public RPC node, faucet and block explorer of the {{ .ChainID }} testnet.
+# Start the services with: docker compose up -d --build
+# Explorer: http://localhost:{{ .ExplorerPort }}
+# RPC: http://localhost:{{ .RPCPort }}
+# API: http://localhost:{{ .APIPort }}
+{{- if .Faucet }}
+# Faucet: http://localhost:{{ .FaucetPort }}
+{{- end }}
+services:
+  node:
+    image: {{ .Image }}:node
+    build:
+      context: .
+      target: node
+    restart: unless-stopped
+    ports:
+      - "{{ .P2PPort }}:{{ .P2PPort }}"
+      - "{{ .RPCPort }}:{{ .RPCPort }}"
+      - "{{ .APIPort }}:{{ .APIPort }}"
+      - "{{ .GRPCPort }}:{{ .GRPCPort }}"
+    volumes:
+      - node:/node
+{{- if .Faucet }}
+  faucet:
+    image: {{ .Image }}:faucet
+    build:
+      context: .
+      target: faucet
+    restart: unless-stopped
+    env_file: {{ .FaucetEnvFile }}
+    command:
+{{- range .FaucetArgs }}
+      - "{{ . }}"
+{{- end }}
+    ports:
+      - "{{ .FaucetPort }}:{{ .FaucetPort }}"
+    depends_on:
+      - node
+{{- end }}
+  explorer:
+    image: {{ .Image }}:explorer
+    build:
+      context: .
+      target: explorer
+    restart: unless-stopped
+    ports:
+      - "{{ .ExplorerPort }}:80"
+    depends_on:
+      - node
+{{- if .Faucet }}
+      - faucet
+{{- end }}
+volumes:
+  node:
@@ -0,0 +1,104 @@
+<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1">
+  <title>{{ .ChainID }} explorer</title>
+  <style>
+    body { font-family: system-ui, sans-serif; margin: 2rem auto; max-width: 960px; padding: 0 1rem; color: #1f2328; }
+    h1 { font-size: 1.5rem; }
+    form { display: flex; gap: .5rem; margin: 1rem 0; }
+    input { flex: 1; padding: .5rem; font-family: monospace; }
+    button { padding: .5rem 1rem; }
+    table { border-collapse: collapse; width: 100%; }
+    th, td { border-bottom: 1px solid #d0d7de; padding: .4rem; text-align: left; font-family: monospace; }
+    pre { background: #f6f8fa; padding: 1rem; overflow: auto; }
+    .status { color: #57606a; }
+  </style>
+</head>
+<body>
+  <h1>{{ .ChainID }}</h1>
+  <p class="status" id="status">Connecting to the node...</p>
+
+  <form id="search">
+    <input id="query" placeholder="Block height, transaction hash or address">
+    <button>Search</button>
+  </form>
+  <pre id="result" hidden></pre>
+{{- if .Faucet }}
+
+  <form id="faucet">
+    <input id="address" placeholder="Address to receive tokens from the faucet">
+    <button>Request tokens</button>
+  </form>
+{{- end }}
+
+  <h2>Latest blocks</h2>
+  <table>
+    <thead><tr><th>Height</th><th>Time</th><th>Txs</th><th>Proposer</th></tr></thead>
+    <tbody id="blocks"></tbody>
+  </table>
+
+  <script>
+    const get = (path) => fetch(path).then((r) => r.json());
+    const show = (data) => {
+      const result = document.getElementById("result");
+      result.textContent = JSON.stringify(data, null, 2);
+      result.hidden = false;
+    };
+
+    async function refresh() {
+      try {
+        const status = await get("rpc/status");
+        const info = status.result.sync_info;
+        document.getElementById("status").textContent =
+          `Height ${info.latest_block_height} at ${info.latest_block_time}` +
+          (info.catching_up ? " (syncing)" : "");
+
+        const chain = await get(`rpc/blockchain?maxHeight=${info.latest_block_height}`);
+        document.getElementById("blocks").innerHTML = chain.result.block_metas.map((b) =>
+          `<tr><td><a href="#" data-height="${b.header.height}">${b.header.height}</a></td>` +
+          `<td>${b.header.time}</td><td>${b.num_txs}</td><td>${b.header.proposer_address}</td></tr>`
+        ).join("");
+      } catch (e) {
+        document.getElementById("status").textContent = `The node is not reachable: ${e}`;
+      }
+    }
+
+    async function search(query) {
+      query = query.trim();
+      if (/^\d+$/.test(query)) {
+        show(await get(`rpc/block?height=${query}`));
+      } else if (/^[0-9a-fA-F]{64}$/.test(query)) {
+        show(await get(`rpc/tx?hash=0x${query}`));
+      } else {
+        show(await get(`api/cosmos/bank/v1beta1/balances/${query}`));
+      }
+    }
+
+    document.getElementById("search").addEventListener("submit", (e) => {
+      e.preventDefault();
+      search(document.getElementById("query").value).catch(show);
+    });
+    document.getElementById("blocks").addEventListener("click", (e) => {
+      if (e.target.dataset.height) {
+        e.preventDefault();
+        search(e.target.dataset.height).catch(show);
+      }
+    });
+{{- if .Faucet }}
+    document.getElementById("faucet").addEventListener("submit", (e) => {
+      e.preventDefault();
+      fetch("faucet/", {
+        method: "POST",
+        headers: { "Content-Type": "application/json" },
+        body: JSON.stringify({ address: document.getElementById("address").value.trim() }),
+      }).then((r) => r.json()).then(show).catch(show);
+    });
+{{- end }}
+
+    refresh();
+    setInterval(refresh, 5000);
+  </script>
+</body>
+</html>
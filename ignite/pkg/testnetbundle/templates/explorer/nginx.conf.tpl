@@ -0,0 +1,22 @@
+server {
+  listen 80;
+
+  location / {
+    root /usr/share/nginx/html;
+    index index.html;
+  }
+
+  location /rpc/ {
+    proxy_pass http://node:{{ .RPCPort }}/;
+  }
+
+  location /api/ {
+    proxy_pass http://node:{{ .APIPort }}/;
+  }
+{{- if .Faucet }}
+
+  location /faucet/ {
+    proxy_pass http://faucet:{{ .FaucetPort }}/;
+  }
+{{- end }}
+}
@@ -0,0 +1,38 @@
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: faucet
+spec:
+  replicas: 1
+  selector:
+    matchLabels:
+      app: faucet
+  template:
+    metadata:
+      labels:
+        app: faucet
+    spec:
+      containers:
+        - name: faucet
+          image: {{ .Image }}:faucet
+          args:
+{{- range .FaucetArgs }}
+            - "{{ . }}"
+{{- end }}
+          envFrom:
+            - secretRef:
+                name: faucet
+          ports:
+            - name: http
+              containerPort: {{ .FaucetPort }}
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: faucet
+spec:
+  selector:
+    app: faucet
+  ports:
+    - name: http
+      port: {{ .FaucetPort }}
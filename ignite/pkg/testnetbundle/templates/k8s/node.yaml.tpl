@@ -0,0 +1,56 @@
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: node
+spec:
+  serviceName: node
+  replicas: 1
+  selector:
+    matchLabels:
+      app: node
+  template:
+    metadata:
+      labels:
+        app: node
+    spec:
+      containers:
+        - name: node
+          image: {{ .Image }}:node
+          ports:
+            - name: p2p
+              containerPort: {{ .P2PPort }}
+            - name: rpc
+              containerPort: {{ .RPCPort }}
+            - name: api
+              containerPort: {{ .APIPort }}
+            - name: grpc
+              containerPort: {{ .GRPCPort }}
+          volumeMounts:
+            - name: node
+              mountPath: /node
+  volumeClaimTemplates:
+    - metadata:
+        name: node
+      spec:
+        accessModes: ["ReadWriteOnce"]
+        resources:
+          requests:
+            storage: 10Gi
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: node
+spec:
+  type: LoadBalancer
+  selector:
+    app: node
+  ports:
+    - name: p2p
+      port: {{ .P2PPort }}
+    - name: rpc
+      port: {{ .RPCPort }}
+    - name: api
+      port: {{ .APIPort }}
+    - name: grpc
+      port: {{ .GRPCPort }}
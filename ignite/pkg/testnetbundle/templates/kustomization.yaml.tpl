@@ -0,0 +1,20 @@
+# Kubernetes manifests of the public RPC node, faucet and block explorer of the
+# {{ .ChainID }} testnet.
+# Build and push the images of the Dockerfile, then apply the manifests with:
+#   kubectl apply -k .
+apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+namespace: {{ .Namespace }}
+resources:
+  - k8s/namespace.yaml
+  - k8s/node.yaml
+{{- if .Faucet }}
+  - k8s/faucet.yaml
+{{- end }}
+  - k8s/explorer.yaml
+{{- if .Faucet }}
+secretGenerator:
+  - name: faucet
+    envs:
+      - {{ .FaucetEnvFile }}
+{{- end }}
@@ -0,0 +1,19 @@
+#!/bin/sh
+# Starts the public RPC node of the {{ .ChainID }} testnet, the home of the
+# node is initialized on the first start.
+set -e
+
+HOME_DIR=/node
+
+if [ ! -f "$HOME_DIR/config/node_key.json" ]; then
+  {{ .BinaryName }} init rpc --chain-id {{ .ChainID }} --home "$HOME_DIR"
+fi
+cp /bundle/genesis.json "$HOME_DIR/config/genesis.json"
+
+exec {{ .BinaryName }} start --home "$HOME_DIR" \
+  --p2p.persistent_peers "{{ .PersistentPeers }}" \
+  --rpc.laddr tcp://0.0.0.0:{{ .RPCPort }} \
+  --api.enable \
+  --api.address tcp://0.0.0.0:{{ .APIPort }} \
+  --grpc.address 0.0.0.0:{{ .GRPCPort }} \
+  --minimum-gas-prices "{{ .MinimumGasPrices }}"
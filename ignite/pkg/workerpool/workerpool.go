@@ -0,0 +1,79 @@
+// Package workerpool runs tasks concurrently with a limited number of workers.
+//
+// The tasks of a pool share a context that is canceled when one of the tasks
+// fails or when the parent context is canceled, for example with Ctrl-C. The
+// tasks that are not started yet are then skipped and the running ones are
+// expected to return as soon as possible.
+package workerpool
+
+import (
+	"context"
+	"runtime"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// DefaultWorkers is the default number of tasks run concurrently by a pool.
+var DefaultWorkers = runtime.NumCPU()
+
+// Task is a task run by a pool. ctx is canceled when the pool is stopped.
+type Task func(ctx context.Context) error
+
+// Pool runs tasks concurrently with a limited number of workers.
+type Pool struct {
+	workers int
+	parent  context.Context
+	ctx     context.Context
+	group   *errgroup.Group
+}
+
+// Option configures the pool.
+type Option func(*Pool)
+
+// Workers sets the maximum number of tasks run concurrently.
+// A number lower than one means no limit.
+func Workers(n int) Option {
+	return func(p *Pool) {
+		p.workers = n
+	}
+}
+
+// New returns a new pool whose tasks are canceled when ctx is canceled.
+func New(ctx context.Context, options ...Option) *Pool {
+	p := &Pool{
+		workers: DefaultWorkers,
+		parent:  ctx,
+	}
+	for _, apply := range options {
+		apply(p)
+	}
+
+	p.group, p.ctx = errgroup.WithContext(ctx)
+	if p.workers > 0 {
+		p.group.SetLimit(p.workers)
+	}
+
+	return p
+}
+
+// Go runs the task once a worker is available.
+// Go blocks while all the workers are busy and the task is skipped when the
+// pool is stopped before it starts.
+func (p *Pool) Go(task Task) {
+	p.group.Go(func() error {
+		if err := p.ctx.Err(); err != nil {
+			return err
+		}
+		return task(p.ctx)
+	})
+}
+
+// Wait waits for all the tasks to complete and returns the first error.
+// The error of the parent context is returned when it's canceled.
+func (p *Pool) Wait() error {
+	err := p.group.Wait()
+	if ctxErr := p.parent.Err(); ctxErr != nil {
+		return ctxErr
+	}
+	return err
+}
@@ -0,0 +1,73 @@
+package workerpool_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/workerpool"
+)
+
+func TestPoolWorkers(t *testing.T) {
+	var running, maxRunning, done int32
+
+	p := workerpool.New(context.Background(), workerpool.Workers(2))
+	for i := 0; i < 10; i++ {
+		p.Go(func(ctx context.Context) error {
+			n := atomic.AddInt32(&running, 1)
+			for {
+				max := atomic.LoadInt32(&maxRunning)
+				if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond * 10)
+			atomic.AddInt32(&running, -1)
+			atomic.AddInt32(&done, 1)
+			return nil
+		})
+	}
+
+	require.NoError(t, p.Wait())
+	require.EqualValues(t, 10, done)
+	require.EqualValues(t, 2, maxRunning)
+}
+
+func TestPoolError(t *testing.T) {
+	var (
+		errTask = errors.New("task failed")
+		started int32
+	)
+
+	p := workerpool.New(context.Background(), workerpool.Workers(1))
+	p.Go(func(ctx context.Context) error {
+		return errTask
+	})
+	for i := 0; i < 5; i++ {
+		p.Go(func(ctx context.Context) error {
+			atomic.AddInt32(&started, 1)
+			return nil
+		})
+	}
+
+	require.ErrorIs(t, p.Wait(), errTask)
+	require.Zero(t, started, "tasks must be skipped once a task failed")
+}
+
+func TestPoolCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	p := workerpool.New(ctx)
+	p.Go(func(ctx context.Context) error {
+		cancel()
+		<-ctx.Done()
+		// The error of the task is replaced by the cancellation error
+		return errors.New("interrupted")
+	})
+
+	require.ErrorIs(t, p.Wait(), context.Canceled)
+}
@@ -0,0 +1,394 @@
+package xast
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ErrInjectionPoint is returned when the injection point of a snippet cannot
+// be resolved in a Go source file.
+var ErrInjectionPoint = errors.New("cannot resolve injection point")
+
+// InjectionPoint is a location of a Go source file where a snippet of code is
+// injected. The location is resolved from the syntax tree of the file, so it
+// doesn't depend on the formatting or the comments of the file.
+type InjectionPoint struct {
+	desc    string
+	resolve func(s source, snippet string) (insertion, error)
+}
+
+// String returns the description of the injection point.
+func (p InjectionPoint) String() string {
+	return p.desc
+}
+
+// source is a parsed Go source file.
+type source struct {
+	file *ast.File
+	src  []byte
+	base int
+}
+
+// insertion is a snippet of code to insert at an offset of a source file.
+type insertion struct {
+	offset int
+	text   string
+}
+
+// Inject injects the snippet at each injection point of the Go source code src.
+// An error wrapping ErrInjectionPoint is returned when one of the points cannot
+// be resolved, in which case src is not modified.
+func Inject(src, snippet string, points ...InjectionPoint) (string, error) {
+	fileSet := token.NewFileSet()
+	f, err := parser.ParseFile(fileSet, "", src, parser.ParseComments)
+	if err != nil {
+		return src, errors.Wrap(err, "cannot parse source")
+	}
+	s := source{file: f, src: []byte(src), base: fileSet.File(f.Pos()).Base()}
+
+	var insertions []insertion
+	for _, p := range points {
+		ins, err := p.resolve(s, snippet)
+		if err != nil {
+			return src, fmt.Errorf("%w %s: %s", ErrInjectionPoint, p.desc, err)
+		}
+		ins.offset -= s.base
+		insertions = append(insertions, ins)
+	}
+
+	// Insert from the end of the file so the offsets of the next insertions stay valid
+	sort.SliceStable(insertions, func(i, j int) bool {
+		return insertions[i].offset > insertions[j].offset
+	})
+	for _, ins := range insertions {
+		src = src[:ins.offset] + ins.text + src[ins.offset:]
+	}
+	return src, nil
+}
+
+// ImportBlock is the end of the grouped import declaration of a file.
+// The imports of the snippet that are already imported by the file are skipped.
+func ImportBlock() InjectionPoint {
+	return InjectionPoint{
+		desc: "import block",
+		resolve: func(s source, snippet string) (insertion, error) {
+			f := s.file
+			imports, err := parser.ParseFile(token.NewFileSet(), "", "package p\nimport (\n"+snippet+"\n)", parser.ImportsOnly)
+			if err != nil {
+				return insertion{}, errors.Wrap(err, "invalid imports")
+			}
+
+			var decl *ast.GenDecl
+			for _, d := range f.Decls {
+				if gd, ok := d.(*ast.GenDecl); ok && gd.Tok == token.IMPORT && gd.Lparen.IsValid() {
+					decl = gd
+					break
+				}
+			}
+			if decl == nil {
+				return insertion{}, errors.New("no grouped import declaration")
+			}
+
+			var b strings.Builder
+			for _, spec := range imports.Imports {
+				if hasImport(f, spec) {
+					continue
+				}
+				if spec.Name != nil {
+					b.WriteString(spec.Name.Name + " ")
+				}
+				b.WriteString(spec.Path.Value + "\n")
+			}
+			if b.Len() == 0 {
+				return insertion{offset: int(decl.Rparen)}, nil
+			}
+			return closingInsertion(s, decl.Rparen, strings.TrimSuffix(b.String(), "\n")), nil
+		},
+	}
+}
+
+func hasImport(f *ast.File, spec *ast.ImportSpec) bool {
+	for _, imp := range f.Imports {
+		if imp.Path.Value != spec.Path.Value {
+			continue
+		}
+		if (imp.Name == nil) == (spec.Name == nil) && (imp.Name == nil || imp.Name.Name == spec.Name.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// CallArgs is the end of the arguments of the calls to call in the function
+// funcName, or in the whole file when funcName is empty. call is a function name
+// optionally qualified by its receiver like "module.NewManager". index selects
+// the call when there are several calls to call, a negative index counts from
+// the last call.
+func CallArgs(funcName, call string, index int) InjectionPoint {
+	desc := "arguments of " + call
+	if funcName != "" {
+		desc += " in " + funcName
+	}
+	return InjectionPoint{
+		desc: desc,
+		resolve: func(s source, snippet string) (insertion, error) {
+			var scope ast.Node = s.file
+			if funcName != "" {
+				fn, err := findFunc(s.file, funcName)
+				if err != nil {
+					return insertion{}, err
+				}
+				scope = fn.Body
+			}
+
+			var calls []*ast.CallExpr
+			ast.Inspect(scope, func(n ast.Node) bool {
+				if c, ok := n.(*ast.CallExpr); ok && isCall(c, call) {
+					calls = append(calls, c)
+				}
+				return true
+			})
+			c, err := selectNode(calls, index)
+			if err != nil {
+				return insertion{}, errors.Errorf("call to %s not found", call)
+			}
+
+			var last ast.Node
+			if len(c.Args) > 0 {
+				last = c.Args[len(c.Args)-1]
+			}
+			return listInsertion(s, snippet, last, c.Rparen), nil
+		},
+	}
+}
+
+// CompositeLit is the end of the elements of the composite literal that is
+// the value of the package level variable varName.
+func CompositeLit(varName string) InjectionPoint {
+	return InjectionPoint{
+		desc: "elements of " + varName,
+		resolve: func(s source, snippet string) (insertion, error) {
+			f := s.file
+			for _, d := range f.Decls {
+				gd, ok := d.(*ast.GenDecl)
+				if !ok || gd.Tok != token.VAR {
+					continue
+				}
+				for _, spec := range gd.Specs {
+					vs := spec.(*ast.ValueSpec)
+					for i, name := range vs.Names {
+						if name.Name != varName || i >= len(vs.Values) {
+							continue
+						}
+						lit, ok := vs.Values[i].(*ast.CompositeLit)
+						if !ok {
+							return insertion{}, errors.Errorf("%s is not a composite literal", varName)
+						}
+						var last ast.Node
+						if len(lit.Elts) > 0 {
+							last = lit.Elts[len(lit.Elts)-1]
+						}
+						return listInsertion(s, snippet, last, lit.Rbrace), nil
+					}
+				}
+			}
+			return insertion{}, errors.Errorf("variable %s not found", varName)
+		},
+	}
+}
+
+// StructFields is the end of the fields of the struct type typeName.
+func StructFields(typeName string) InjectionPoint {
+	return InjectionPoint{
+		desc: "fields of " + typeName,
+		resolve: func(s source, snippet string) (insertion, error) {
+			f := s.file
+			obj := f.Scope.Lookup(typeName)
+			if obj == nil || obj.Kind != ast.Typ {
+				return insertion{}, errors.Errorf("type %s not found", typeName)
+			}
+			st, ok := obj.Decl.(*ast.TypeSpec).Type.(*ast.StructType)
+			if !ok {
+				return insertion{}, errors.Errorf("%s is not a struct", typeName)
+			}
+			return closingInsertion(s, st.Fields.Closing, snippet), nil
+		},
+	}
+}
+
+// BeforeCall is before the statement of the body of the function funcName that
+// contains the first call to call.
+func BeforeCall(funcName, call string) InjectionPoint {
+	return InjectionPoint{
+		desc: "before " + call + " in " + funcName,
+		resolve: func(s source, snippet string) (insertion, error) {
+			f := s.file
+			stmt, err := findStmt(f, funcName, call, 0)
+			if err != nil {
+				return insertion{}, err
+			}
+			return insertion{offset: int(stmt.Pos()), text: snippet + "\n\n"}, nil
+		},
+	}
+}
+
+// AfterCall is after the statement of the body of the function funcName that
+// contains the last call to call.
+func AfterCall(funcName, call string) InjectionPoint {
+	return InjectionPoint{
+		desc: "after " + call + " in " + funcName,
+		resolve: func(s source, snippet string) (insertion, error) {
+			f := s.file
+			stmt, err := findStmt(f, funcName, call, -1)
+			if err != nil {
+				return insertion{}, err
+			}
+			return insertion{offset: int(stmt.End()), text: "\n" + snippet}, nil
+		},
+	}
+}
+
+// BeforeReturn is before the last return statement of the body of the
+// function funcName.
+func BeforeReturn(funcName string) InjectionPoint {
+	return InjectionPoint{
+		desc: "before the return of " + funcName,
+		resolve: func(s source, snippet string) (insertion, error) {
+			f := s.file
+			fn, err := findFunc(f, funcName)
+			if err != nil {
+				return insertion{}, err
+			}
+			var returns []ast.Stmt
+			for _, stmt := range fn.Body.List {
+				if _, ok := stmt.(*ast.ReturnStmt); ok {
+					returns = append(returns, stmt)
+				}
+			}
+			stmt, err := selectNode(returns, -1)
+			if err != nil {
+				return insertion{}, errors.Errorf("no return statement in %s", funcName)
+			}
+			return insertion{offset: int(stmt.Pos()), text: snippet + "\n\n"}, nil
+		},
+	}
+}
+
+// BeforeFunc is before the declaration of the function funcName.
+func BeforeFunc(funcName string) InjectionPoint {
+	return InjectionPoint{
+		desc: "before " + funcName,
+		resolve: func(s source, snippet string) (insertion, error) {
+			f := s.file
+			fn, err := findFunc(f, funcName)
+			if err != nil {
+				return insertion{}, err
+			}
+			pos := fn.Pos()
+			if fn.Doc != nil {
+				pos = fn.Doc.Pos()
+			}
+			return insertion{offset: int(pos), text: snippet + "\n\n"}, nil
+		},
+	}
+}
+
+// findFunc returns the declaration of the function or the method funcName.
+func findFunc(f *ast.File, funcName string) (*ast.FuncDecl, error) {
+	for _, d := range f.Decls {
+		if fn, ok := d.(*ast.FuncDecl); ok && fn.Name.Name == funcName && fn.Body != nil {
+			return fn, nil
+		}
+	}
+	return nil, errors.Errorf("function %s not found", funcName)
+}
+
+// findStmt returns the statement of the body of the function funcName that
+// contains the call to call selected by index.
+func findStmt(f *ast.File, funcName, call string, index int) (ast.Stmt, error) {
+	fn, err := findFunc(f, funcName)
+	if err != nil {
+		return nil, err
+	}
+	var stmts []ast.Stmt
+	for _, stmt := range fn.Body.List {
+		found := false
+		ast.Inspect(stmt, func(n ast.Node) bool {
+			if c, ok := n.(*ast.CallExpr); ok && isCall(c, call) {
+				found = true
+			}
+			return !found
+		})
+		if found {
+			stmts = append(stmts, stmt)
+		}
+	}
+	stmt, err := selectNode(stmts, index)
+	if err != nil {
+		return nil, errors.Errorf("call to %s not found in %s", call, funcName)
+	}
+	return stmt, nil
+}
+
+// isCall returns true when c is a call to call, call matches the end of the
+// called expression like "NewManager", "module.NewManager" or "mm.SetOrderBeginBlockers".
+func isCall(c *ast.CallExpr, call string) bool {
+	fun := types.ExprString(c.Fun)
+	return fun == call || strings.HasSuffix(fun, "."+call)
+}
+
+func selectNode[T any](nodes []T, index int) (T, error) {
+	var zero T
+	if index < 0 {
+		index += len(nodes)
+	}
+	if index < 0 || index >= len(nodes) {
+		return zero, errors.New("not found")
+	}
+	return nodes[index], nil
+}
+
+// listInsertion returns the insertion of elements at the end of a list closed
+// by end, a comma is added after the last element of the list when missing.
+func listInsertion(s source, snippet string, last ast.Node, end token.Pos) insertion {
+	snippet = strings.TrimSuffix(snippet, ",")
+	if last == nil {
+		return closingInsertion(s, end, snippet+",")
+	}
+	between := s.src[int(last.End())-s.base : int(end)-s.base]
+	if bytes.Contains(stripComments(between), []byte(",")) {
+		return closingInsertion(s, end, snippet+",")
+	}
+	return insertion{offset: int(last.End()), text: ",\n" + snippet}
+}
+
+// stripComments removes the line comments of src.
+func stripComments(src []byte) []byte {
+	var out []byte
+	for _, line := range bytes.Split(src, []byte("\n")) {
+		if i := bytes.Index(line, []byte("//")); i >= 0 {
+			line = line[:i]
+		}
+		out = append(out, line...)
+	}
+	return out
+}
+
+// closingInsertion returns the insertion of a snippet before the closing token
+// of a block at end, on its own line.
+func closingInsertion(s source, end token.Pos, snippet string) insertion {
+	offset := int(end) - s.base
+	lineStart := bytes.LastIndexByte(s.src[:offset], '\n') + 1
+	if len(bytes.TrimSpace(s.src[lineStart:offset])) == 0 {
+		return insertion{offset: lineStart + s.base, text: snippet + "\n"}
+	}
+	return insertion{offset: int(end), text: "\n" + snippet + "\n"}
+}
@@ -0,0 +1,148 @@
+package xast_test
+
+import (
+	"go/format"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/xast"
+)
+
+func TestInject(t *testing.T) {
+	src, err := os.ReadFile("testdata/inject/app.go")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		snippet  string
+		points   []xast.InjectionPoint
+		expected []string
+	}{
+		{
+			name: "imports",
+			snippet: `marsmodule "github.com/test/mars/x/mars"
+"github.com/cosmos/cosmos-sdk/types/module"`,
+			points: []xast.InjectionPoint{xast.ImportBlock()},
+			expected: []string{`import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+	marsmodule "github.com/test/mars/x/mars"
+)`},
+		},
+		{
+			name:    "call arguments with a trailing comma",
+			snippet: "marsmodule.AppModuleBasic{},",
+			points:  []xast.InjectionPoint{xast.CallArgs("", "module.NewBasicManager", 0)},
+			expected: []string{`		bank.AppModuleBasic{}, // bank
+		marsmodule.AppModuleBasic{},
+	)`},
+		},
+		{
+			name:     "call arguments without a trailing comma",
+			snippet:  "marsmoduletypes.StoreKey,",
+			points:   []xast.InjectionPoint{xast.CallArgs("New", "sdk.NewKVStoreKeys", 0)},
+			expected: []string{"keys := sdk.NewKVStoreKeys(authtypes.StoreKey, banktypes.StoreKey,\n\t\tmarsmoduletypes.StoreKey)"},
+		},
+		{
+			name:    "several calls",
+			snippet: "marsModule,",
+			points: []xast.InjectionPoint{
+				xast.CallArgs("New", "module.NewManager", 0),
+				xast.CallArgs("New", "module.NewSimulationManager", 0),
+			},
+			expected: []string{
+				"app.mm = module.NewManager(auth.NewAppModule(app.AccountKeeper),\n\t\tmarsModule)",
+				"app.sm = module.NewSimulationManager(\n\t\tmarsModule,\n\t)",
+			},
+		},
+		{
+			name:    "composite literal",
+			snippet: "marsmoduletypes.ModuleName: {authtypes.Minter},",
+			points:  []xast.InjectionPoint{xast.CompositeLit("maccPerms")},
+			expected: []string{`		authtypes.FeeCollectorName: nil,
+		marsmoduletypes.ModuleName: {authtypes.Minter},
+	}`},
+		},
+		{
+			name:    "struct fields",
+			snippet: "MarsKeeper marsmodulekeeper.Keeper",
+			points:  []xast.InjectionPoint{xast.StructFields("App")},
+			expected: []string{`type App struct {
+	AccountKeeper authkeeper.AccountKeeper
+	MarsKeeper    marsmodulekeeper.Keeper
+}`},
+		},
+		{
+			name:    "before and after calls",
+			snippet: "app.MarsKeeper = marsmodulekeeper.NewKeeper()",
+			points: []xast.InjectionPoint{
+				xast.BeforeCall("New", "CapabilityKeeper.Seal"),
+				xast.AfterCall("New", "ScopeToModule"),
+			},
+			expected: []string{
+				"app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)\n\tapp.MarsKeeper = marsmodulekeeper.NewKeeper()\n",
+				"app.MarsKeeper = marsmodulekeeper.NewKeeper()\n\n\tapp.CapabilityKeeper.Seal()",
+			},
+		},
+		{
+			name:     "before return",
+			snippet:  "app.ScopedMarsKeeper = scopedMarsKeeper",
+			points:   []xast.InjectionPoint{xast.BeforeReturn("New")},
+			expected: []string{"\tapp.ScopedMarsKeeper = scopedMarsKeeper\n\n\treturn app\n"},
+		},
+		{
+			name:     "before function",
+			snippet:  "var EnableSpecificProposals = \"\"",
+			points:   []xast.InjectionPoint{xast.BeforeFunc("New")},
+			expected: []string{"var EnableSpecificProposals = \"\"\n\nfunc New() *App {"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := xast.Inject(string(src), tt.snippet, tt.points...)
+			require.NoError(t, err)
+
+			formatted, err := format.Source([]byte(got))
+			require.NoError(t, err)
+			for _, expected := range tt.expected {
+				require.Contains(t, string(formatted), expected)
+			}
+		})
+	}
+}
+
+func TestInjectUnresolved(t *testing.T) {
+	src, err := os.ReadFile("testdata/inject/app.go")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		point         xast.InjectionPoint
+		expectedError string
+	}{
+		{
+			name:          "missing call",
+			point:         xast.CallArgs("New", "SetOrderBeginBlockers", 0),
+			expectedError: "cannot resolve injection point arguments of SetOrderBeginBlockers in New: call to SetOrderBeginBlockers not found",
+		},
+		{
+			name:          "missing function",
+			point:         xast.BeforeReturn("initParamsKeeper"),
+			expectedError: "cannot resolve injection point before the return of initParamsKeeper: function initParamsKeeper not found",
+		},
+		{
+			name:          "missing type",
+			point:         xast.StructFields("Keeper"),
+			expectedError: "cannot resolve injection point fields of Keeper: type Keeper not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := xast.Inject(string(src), "foo", tt.point)
+			require.ErrorIs(t, err, xast.ErrInjectionPoint)
+			require.EqualError(t, err, tt.expectedError)
+			require.Equal(t, string(src), got)
+		})
+	}
+}
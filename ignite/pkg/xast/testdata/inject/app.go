@@ -0,0 +1,39 @@
+package app
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+var (
+	ModuleBasics = module.NewBasicManager(
+		auth.AppModuleBasic{},
+		bank.AppModuleBasic{}, // bank
+	)
+
+	maccPerms = map[string][]string{
+		authtypes.FeeCollectorName: nil,
+	}
+)
+
+type App struct {
+	AccountKeeper authkeeper.AccountKeeper
+}
+
+func New() *App {
+	app := &App{}
+	keys := sdk.NewKVStoreKeys(authtypes.StoreKey, banktypes.StoreKey)
+	scopedIBCKeeper := app.CapabilityKeeper.ScopeToModule(ibchost.ModuleName)
+	scopedTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
+
+	app.CapabilityKeeper.Seal()
+
+	app.mm = module.NewManager(auth.NewAppModule(app.AccountKeeper))
+	app.sm = module.NewSimulationManager()
+
+	if loadLatest {
+		if err := app.LoadLatestVersion(); err != nil {
+			panic(err)
+		}
+	}
+	return app
+}
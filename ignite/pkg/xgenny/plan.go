@@ -0,0 +1,116 @@
+package xgenny
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// Actions of the files of a plan.
+const (
+	PlanActionCreate = "create"
+	PlanActionModify = "modify"
+)
+
+// Plan describes the source code changes of a preview in a machine-readable form.
+type Plan struct {
+	Files []PlanFile `json:"files"`
+}
+
+// PlanFile describes the changes of a file created or modified by the generators.
+type PlanFile struct {
+	// Path of the file, relative to the root of the plan.
+	Path string `json:"path"`
+
+	// Action is either PlanActionCreate or PlanActionModify.
+	Action string `json:"action"`
+
+	// Hunks are the contiguous changes of the file.
+	Hunks []PlanHunk `json:"hunks"`
+}
+
+// PlanHunk summarizes a contiguous change of a file.
+type PlanHunk struct {
+	// Header is the unified diff header of the hunk, e.g. "@@ -1,3 +1,4 @@".
+	Header string `json:"header"`
+
+	// Added and Removed are the number of lines added and removed by the hunk.
+	Added   int `json:"added"`
+	Removed int `json:"removed"`
+
+	// Placeholders are the placeholders of the original file replaced by the hunk.
+	Placeholders []string `json:"placeholders,omitempty"`
+}
+
+// Plan returns the plan of the changes between the files on disk and the files
+// of the preview, the file paths of the plan are relative to root.
+func (p *Preview) Plan(root string) (Plan, error) {
+	plan := Plan{Files: make([]PlanFile, 0, len(p.files))}
+	for _, path := range p.Files() {
+		original, exists, err := readOriginal(path)
+		if err != nil {
+			return Plan{}, err
+		}
+
+		file := PlanFile{
+			Path:   relPath(root, path),
+			Action: PlanActionModify,
+			Hunks:  make([]PlanHunk, 0),
+		}
+		if !exists {
+			file.Action = PlanActionCreate
+		}
+
+		a, b := splitLines(original), splitLines(p.files[path])
+		for _, group := range difflib.NewMatcher(a, b).GetGroupedOpCodes(3) {
+			file.Hunks = append(file.Hunks, p.planHunk(a, group))
+		}
+		plan.Files = append(plan.Files, file)
+	}
+	return plan, nil
+}
+
+// planHunk summarizes a group of operations of a diff from the original lines a.
+func (p *Preview) planHunk(a []string, group []difflib.OpCode) PlanHunk {
+	first, last := group[0], group[len(group)-1]
+	hunk := PlanHunk{
+		Header: fmt.Sprintf("@@ -%s +%s @@", formatRange(first.I1, last.I2), formatRange(first.J1, last.J2)),
+	}
+
+	placeholders := make(map[string]struct{})
+	for _, c := range group {
+		if c.Tag == 'r' || c.Tag == 'd' {
+			hunk.Removed += c.I2 - c.I1
+		}
+		if c.Tag == 'r' || c.Tag == 'i' {
+			hunk.Added += c.J2 - c.J1
+		}
+	}
+	for _, line := range a[first.I1:last.I2] {
+		for placeholder := range p.placeholders {
+			if strings.Contains(line, placeholder) {
+				placeholders[placeholder] = struct{}{}
+			}
+		}
+	}
+	for placeholder := range placeholders {
+		hunk.Placeholders = append(hunk.Placeholders, placeholder)
+	}
+	sort.Strings(hunk.Placeholders)
+
+	return hunk
+}
+
+// formatRange formats a range of lines like the unified diff format.
+func formatRange(start, stop int) string {
+	beginning, length := start+1, stop-start
+	if length == 1 {
+		return fmt.Sprintf("%d", beginning)
+	}
+	if length == 0 {
+		beginning--
+	}
+	return fmt.Sprintf("%d,%d", beginning, length)
+}
@@ -0,0 +1,178 @@
+package xgenny
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/pmezard/go-difflib/difflib"
+
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+)
+
+// Preview holds the content of the files created or modified by dry runs of
+// generators so the modifications can be reviewed before they are applied.
+type Preview struct {
+	files map[string]string
+
+	// placeholders are the placeholders replaced by the generators.
+	placeholders map[string]struct{}
+}
+
+// NewPreview returns a new empty preview.
+func NewPreview() *Preview {
+	return &Preview{
+		files:        make(map[string]string),
+		placeholders: make(map[string]struct{}),
+	}
+}
+
+// Add adds a file with its content to the preview.
+func (p *Preview) Add(path, content string) {
+	p.files[path] = content
+}
+
+// AddFS adds the files of fsys to the preview, their paths are joined to root.
+func (p *Preview) AddFS(fsys fs.FS, root string) error {
+	return fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		content, err := fs.ReadFile(fsys, path)
+		if err != nil {
+			return err
+		}
+		p.Add(filepath.Join(root, path), string(content))
+		return nil
+	})
+}
+
+// Files returns the sorted paths of the files of the preview.
+func (p *Preview) Files() []string {
+	files := make([]string, 0, len(p.files))
+	for path := range p.files {
+		files = append(files, path)
+	}
+	sort.Strings(files)
+	return files
+}
+
+// Diff returns the unified diff between the files on disk and the files of the preview.
+// The file paths of the diff are relative to root.
+func (p *Preview) Diff(root string) (string, error) {
+	var b strings.Builder
+	for _, path := range p.Files() {
+		original, exists, err := readOriginal(path)
+		if err != nil {
+			return "", err
+		}
+
+		name := relPath(root, path)
+		fromFile := "a/" + name
+		if !exists {
+			fromFile = "/dev/null"
+		}
+
+		diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        splitLines(original),
+			B:        splitLines(p.files[path]),
+			FromFile: fromFile,
+			ToFile:   "b/" + name,
+			Context:  3,
+		})
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(diff)
+	}
+	return b.String(), nil
+}
+
+// readOriginal returns the content of a file on disk, if it exists.
+func readOriginal(path string) (content string, exists bool, err error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return string(data), true, nil
+}
+
+// relPath returns the slash separated path relative to root, or path if it is not under root.
+func relPath(root, path string) string {
+	if rel, err := filepath.Rel(root, path); err == nil {
+		return filepath.ToSlash(rel)
+	}
+	return path
+}
+
+// splitLines splits a file content in lines ending with a newline for a diff.
+func splitLines(content string) []string {
+	lines := strings.SplitAfter(content, "\n")
+	if last := len(lines) - 1; lines[last] == "" {
+		lines = lines[:last]
+	} else {
+		lines[last] += "\n"
+	}
+	return lines
+}
+
+// DryRunWithValidation runs the generators with a dry runner and adds the files
+// they create or modify to the preview instead of writing them.
+// The files already in the preview are used by the generators in place of the
+// files on disk, so a preview can be shared by consecutive dry runs.
+// The files are added to the preview even when placeholders are missing.
+func DryRunWithValidation(
+	preview *Preview,
+	tracer *placeholder.Tracer,
+	gens ...*genny.Generator,
+) (sm SourceModification, err error) {
+	runner := DryRunner(context.Background())
+	for path, content := range preview.files {
+		runner.Disk.Add(genny.NewFileS(path, content))
+	}
+
+	for _, gen := range gens {
+		if err := runner.With(gen); err != nil {
+			return sm, err
+		}
+	}
+	if err := runner.Run(); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return sm, &dryRunError{err}
+		}
+		return sm, err
+	}
+
+	sm = NewSourceModification()
+	for _, file := range runner.Results().Files {
+		path := file.Name()
+		content := file.String()
+
+		original, err := os.ReadFile(path)
+		switch {
+		case os.IsNotExist(err):
+			sm.AppendCreatedFiles(path)
+		case err != nil:
+			return sm, err
+		case string(original) == content:
+			// the file was only read by the generators
+			continue
+		default:
+			sm.AppendModifiedFiles(path)
+		}
+		preview.Add(path, content)
+	}
+	for _, placeholder := range tracer.Used() {
+		preview.placeholders[placeholder] = struct{}{}
+	}
+
+	return sm, tracer.Err()
+}
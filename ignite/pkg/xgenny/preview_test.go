@@ -0,0 +1,128 @@
+package xgenny_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gobuffalo/genny"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+)
+
+func TestPreviewDiff(t *testing.T) {
+	dir := t.TempDir()
+	modified := filepath.Join(dir, "modified.go")
+	created := filepath.Join(dir, "created.go")
+	require.NoError(t, os.WriteFile(modified, []byte("a\nb\nc\n"), 0o644))
+
+	p := xgenny.NewPreview()
+	p.Add(modified, "a\nB\nc\n")
+	p.Add(created, "x\ny")
+
+	diff, err := p.Diff(dir)
+	require.NoError(t, err)
+	require.Equal(t, `--- /dev/null
++++ b/created.go
+@@ -0,0 +1,2 @@
++x
++y
+--- a/modified.go
++++ b/modified.go
+@@ -1,3 +1,3 @@
+ a
+-b
++B
+ c
+`, diff)
+}
+
+func TestDryRunWithValidation(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app.go")
+	require.NoError(t, os.WriteFile(path, []byte("// #1\n// #2\n"), 0o644))
+
+	tracer := placeholder.New()
+	modify := func(name, placeholder string) *genny.Generator {
+		g := genny.New()
+		g.RunFn(func(r *genny.Runner) error {
+			f, err := r.Disk.Find(path)
+			if err != nil {
+				return err
+			}
+			content := tracer.Replace(f.String(), placeholder, name+"\n"+placeholder)
+			return r.File(genny.NewFileS(path, content))
+		})
+		return g
+	}
+
+	p := xgenny.NewPreview()
+
+	// The modifications of consecutive dry runs are chained in the preview
+	sm, err := xgenny.DryRunWithValidation(p, tracer, modify("foo", "// #1"))
+	require.NoError(t, err)
+	require.Equal(t, []string{path}, sm.ModifiedFiles())
+	_, err = xgenny.DryRunWithValidation(p, tracer, modify("bar", "// #2"))
+	require.NoError(t, err)
+
+	diff, err := p.Diff(dir)
+	require.NoError(t, err)
+	require.Contains(t, diff, "+foo\n")
+	require.Contains(t, diff, "+bar\n")
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "// #1\n// #2\n", string(content), "the file must not be written")
+
+	// Missing placeholders fail the dry run
+	_, err = xgenny.DryRunWithValidation(p, tracer, modify("baz", "// #3"))
+	require.Error(t, err)
+}
+
+func TestPreviewPlan(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app.go")
+	created := filepath.Join(dir, "created.go")
+	require.NoError(t, os.WriteFile(path, []byte("a\n// #1\nb\nc\nd\ne\nf\ng\n// #2\n"), 0o644))
+
+	tracer := placeholder.New()
+	g := genny.New()
+	g.RunFn(func(r *genny.Runner) error {
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		content := tracer.Replace(f.String(), "// #1", "foo\n// #1")
+		content = tracer.Replace(content, "// #2", "bar\nbaz\n// #2")
+		if err := r.File(genny.NewFileS(path, content)); err != nil {
+			return err
+		}
+		return r.File(genny.NewFileS(created, "x\ny\n"))
+	})
+
+	p := xgenny.NewPreview()
+	_, err := xgenny.DryRunWithValidation(p, tracer, g)
+	require.NoError(t, err)
+
+	plan, err := p.Plan(dir)
+	require.NoError(t, err)
+	require.Equal(t, xgenny.Plan{
+		Files: []xgenny.PlanFile{
+			{
+				Path:   "app.go",
+				Action: xgenny.PlanActionModify,
+				Hunks: []xgenny.PlanHunk{
+					{Header: "@@ -1,4 +1,5 @@", Added: 1, Placeholders: []string{"// #1"}},
+					{Header: "@@ -6,4 +7,6 @@", Added: 2, Placeholders: []string{"// #2"}},
+				},
+			},
+			{
+				Path:   "created.go",
+				Action: xgenny.PlanActionCreate,
+				Hunks:  []xgenny.PlanHunk{{Header: "@@ -0,0 +1,2 @@", Added: 2}},
+			},
+		},
+	}, plan)
+}
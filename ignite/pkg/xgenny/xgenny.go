@@ -3,6 +3,8 @@ package xgenny
 import (
 	"bytes"
 	"embed"
+	"io/fs"
+	"os"
 	"path/filepath"
 	"strings"
 
@@ -15,7 +17,7 @@ import (
 
 // Walker implements packd.Walker for Go embed's fs.FS.
 type Walker struct {
-	fs         embed.FS
+	fs         fs.FS
 	trimPrefix string
 	path       string
 }
@@ -26,13 +28,19 @@ func NewEmbedWalker(fs embed.FS, trimPrefix, path string) Walker {
 	return Walker{fs: fs, trimPrefix: trimPrefix, path: path}
 }
 
+// NewDirWalker returns a new Walker for the files of a directory.
+// The paths of found files are relative to the directory.
+func NewDirWalker(dir, path string) Walker {
+	return Walker{fs: os.DirFS(dir), path: path}
+}
+
 // Walk implements packd.Walker.
 func (w Walker) Walk(wl packd.WalkFunc) error {
 	return w.walkDir(wl, ".")
 }
 
 func (w Walker) walkDir(wl packd.WalkFunc, path string) error {
-	entries, err := w.fs.ReadDir(path)
+	entries, err := fs.ReadDir(w.fs, path)
 	if err != nil {
 		return err
 	}
@@ -45,7 +53,7 @@ func (w Walker) walkDir(wl packd.WalkFunc, path string) error {
 
 		path := filepath.Join(path, entry.Name())
 
-		data, err := w.fs.ReadFile(path)
+		data, err := fs.ReadFile(w.fs, path)
 		if err != nil {
 			return err
 		}
@@ -1,11 +1,15 @@
 package xgenny_test
 
 import (
+	"context"
 	"io"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/packd"
 	"github.com/gobuffalo/plush/v4"
 	"github.com/stretchr/testify/require"
 
@@ -45,3 +49,36 @@ func Test_Transformer_No_Ext(t *testing.T) {
 	r.NoError(err)
 	r.Equal("Hello <%= name %>", string(b))
 }
+
+func Test_DirWalker(t *testing.T) {
+	r := require.New(t)
+
+	dir := t.TempDir()
+	r.NoError(os.MkdirAll(filepath.Join(dir, "x"), 0o755))
+	r.NoError(os.WriteFile(filepath.Join(dir, "x/foo.txt.plush"), []byte("Hello <%= name %>"), 0o644))
+	r.NoError(os.WriteFile(filepath.Join(dir, "bar.txt"), []byte("bar"), 0o644))
+
+	var paths []string
+	err := xgenny.NewDirWalker(dir, "app").Walk(func(path string, _ packd.File) error {
+		paths = append(paths, path)
+		return nil
+	})
+	r.NoError(err)
+	r.Equal([]string{"app/bar.txt", "app/x/foo.txt.plush"}, paths)
+
+	// Files boxed last override the files with the same path
+	ctx := plush.NewContext()
+	ctx.Set("name", "mark")
+	g := genny.New()
+	g.Transformer(xgenny.Transformer(ctx))
+	g.File(genny.NewFileS("app/x/foo.txt", "Hello"))
+	r.NoError(xgenny.Box(g, xgenny.NewDirWalker(dir, "app")))
+
+	runner := genny.DryRunner(context.Background())
+	r.NoError(runner.With(g))
+	r.NoError(runner.Run())
+
+	f, err := runner.Disk.Find("app/x/foo.txt")
+	r.NoError(err)
+	r.Equal("Hello mark", f.String())
+}
@@ -0,0 +1,64 @@
+package xhttp
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+)
+
+const (
+	headerAuthorization   = "Authorization"
+	headerWWWAuthenticate = "WWW-Authenticate"
+	bearerPrefix          = "Bearer "
+)
+
+// Credentials are the credentials accepted by an authenticated handler.
+type Credentials struct {
+	// Tokens are the accepted bearer tokens.
+	Tokens []string
+
+	// Users maps the accepted basic authentication user names to their password.
+	Users map[string]string
+}
+
+// AuthHandler returns a handler that serves a request with h only when it is
+// authenticated with a bearer token or a basic authentication user from c.
+// The authorization header is removed from the request before it is served.
+func AuthHandler(h http.Handler, c Credentials, realm string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !c.authenticate(r) {
+			w.Header().Set(headerWWWAuthenticate, `Basic realm="`+realm+`"`)
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		r.Header.Del(headerAuthorization)
+		h.ServeHTTP(w, r)
+	})
+}
+
+func (c Credentials) authenticate(r *http.Request) bool {
+	if name, password, ok := r.BasicAuth(); ok {
+		expected, found := c.Users[name]
+		return found && secureCompare(password, expected)
+	}
+
+	header := r.Header.Get(headerAuthorization)
+	if !strings.HasPrefix(header, bearerPrefix) {
+		return false
+	}
+
+	token := strings.TrimPrefix(header, bearerPrefix)
+	for _, t := range c.Tokens {
+		if secureCompare(token, t) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// secureCompare compares two strings in constant time.
+func secureCompare(a, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
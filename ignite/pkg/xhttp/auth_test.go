@@ -0,0 +1,75 @@
+package xhttp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthHandler(t *testing.T) {
+	credentials := Credentials{
+		Tokens: []string{"token"},
+		Users:  map[string]string{"alice": "secret"},
+	}
+
+	var authorization string
+	handler := AuthHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+	}), credentials, "test")
+
+	tests := []struct {
+		name    string
+		setAuth func(r *http.Request)
+		status  int
+	}{
+		{
+			name:    "valid bearer token",
+			setAuth: func(r *http.Request) { r.Header.Set("Authorization", "Bearer token") },
+			status:  http.StatusOK,
+		},
+		{
+			name:    "valid basic auth",
+			setAuth: func(r *http.Request) { r.SetBasicAuth("alice", "secret") },
+			status:  http.StatusOK,
+		},
+		{
+			name:    "invalid bearer token",
+			setAuth: func(r *http.Request) { r.Header.Set("Authorization", "Bearer invalid") },
+			status:  http.StatusUnauthorized,
+		},
+		{
+			name:    "invalid basic auth password",
+			setAuth: func(r *http.Request) { r.SetBasicAuth("alice", "invalid") },
+			status:  http.StatusUnauthorized,
+		},
+		{
+			name:    "unknown basic auth user",
+			setAuth: func(r *http.Request) { r.SetBasicAuth("bob", "secret") },
+			status:  http.StatusUnauthorized,
+		},
+		{
+			name:    "no credentials",
+			setAuth: func(r *http.Request) {},
+			status:  http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authorization = ""
+
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			tt.setAuth(r)
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, r)
+
+			require.Equal(t, tt.status, w.Code)
+			require.Empty(t, authorization)
+			if tt.status == http.StatusUnauthorized {
+				require.Equal(t, `Basic realm="test"`, w.Header().Get("WWW-Authenticate"))
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package xhttp
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	headerRetryAfter = "Retry-After"
+
+	// rateLimitSweepInterval is the interval between the removals of the idle clients.
+	rateLimitSweepInterval = time.Minute
+)
+
+// RateLimit configures the requests accepted from each client IP.
+type RateLimit struct {
+	// RPS is the number of requests per second accepted from a client IP.
+	RPS float64
+
+	// Burst is the maximum number of requests accepted at once from a client IP.
+	// It defaults to RPS rounded up.
+	Burst int
+
+	// ExemptPaths are the URL path prefixes of the requests that are not rate limited.
+	ExemptPaths []string
+}
+
+// RateLimitHandler returns a handler that serves the requests with h as long as
+// their client IP doesn't exceed the rate limit l. The requests exceeding the
+// limit are rejected with a "Too Many Requests" response.
+func RateLimitHandler(h http.Handler, l RateLimit) http.Handler {
+	limiter := newRateLimiter(l, time.Now)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if l.isExempt(r.URL.Path) {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		if ok, retryAfter := limiter.allow(ClientIP(r)); !ok {
+			seconds := int(math.Ceil(retryAfter.Seconds()))
+			w.Header().Set(headerRetryAfter, strconv.Itoa(seconds))
+			http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
+			return
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
+
+func (l RateLimit) isExempt(path string) bool {
+	for _, p := range l.ExemptPaths {
+		if strings.HasPrefix(path, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientIP returns the IP of the client that sent the request.
+func ClientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// rateLimiter limits the requests of each client with a token bucket.
+type rateLimiter struct {
+	rps       float64
+	burst     float64
+	now       func() time.Time
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(l RateLimit, now func() time.Time) *rateLimiter {
+	burst := l.Burst
+	if burst < 1 {
+		burst = int(math.Max(1, math.Ceil(l.RPS)))
+	}
+
+	return &rateLimiter{
+		rps:       l.RPS,
+		burst:     float64(burst),
+		now:       now,
+		buckets:   make(map[string]*bucket),
+		lastSweep: now(),
+	}
+}
+
+// allow consumes a token of the client bucket.
+// When the bucket is empty it returns the time until a token is available.
+func (l *rateLimiter) allow(client string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	b, ok := l.buckets[client]
+	if !ok {
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[client] = b
+	}
+
+	b.tokens = math.Min(l.burst, b.tokens+now.Sub(b.last).Seconds()*l.rps)
+	b.last = now
+
+	if b.tokens < 1 {
+		wait := time.Duration((1 - b.tokens) / l.rps * float64(time.Second))
+		return false, wait
+	}
+
+	b.tokens--
+	return true, 0
+}
+
+// sweep removes the buckets of the clients that are idle long enough to have
+// their buckets full again, so the memory doesn't grow with the number of clients.
+func (l *rateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < rateLimitSweepInterval {
+		return
+	}
+	l.lastSweep = now
+
+	refill := time.Duration(l.burst / l.rps * float64(time.Second))
+	for client, b := range l.buckets {
+		if now.Sub(b.last) >= refill {
+			delete(l.buckets, client)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package xhttp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Now()
+	limiter := newRateLimiter(RateLimit{RPS: 2, Burst: 3}, func() time.Time { return now })
+
+	// The burst is accepted at once
+	for i := 0; i < 3; i++ {
+		ok, _ := limiter.allow("alice")
+		require.True(t, ok)
+	}
+
+	ok, retryAfter := limiter.allow("alice")
+	require.False(t, ok)
+	require.Equal(t, 500*time.Millisecond, retryAfter)
+
+	// Other clients have their own limit
+	ok, _ = limiter.allow("bob")
+	require.True(t, ok)
+
+	// Tokens are refilled over time
+	now = now.Add(500 * time.Millisecond)
+	ok, _ = limiter.allow("alice")
+	require.True(t, ok)
+	ok, _ = limiter.allow("alice")
+	require.False(t, ok)
+
+	// Idle clients are removed
+	now = now.Add(rateLimitSweepInterval)
+	limiter.allow("alice")
+	require.NotContains(t, limiter.buckets, "bob")
+}
+
+func TestRateLimitHandler(t *testing.T) {
+	handler := RateLimitHandler(
+		http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}),
+		RateLimit{RPS: 1, ExemptPaths: []string{"/health"}},
+	)
+
+	serve := func(path, remoteAddr string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, path, nil)
+		r.RemoteAddr = remoteAddr
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	require.Equal(t, http.StatusOK, serve("/blocks", "10.0.0.1:1000").Code)
+
+	// The limit applies to the client IP whatever the port is
+	w := serve("/blocks", "10.0.0.1:2000")
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Equal(t, "1", w.Header().Get("Retry-After"))
+
+	require.Equal(t, http.StatusOK, serve("/blocks", "10.0.0.2:1000").Code)
+	require.Equal(t, http.StatusOK, serve("/health", "10.0.0.1:1000").Code)
+}
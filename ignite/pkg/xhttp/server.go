@@ -11,6 +11,7 @@ import (
 const ShutdownTimeout = time.Minute
 
 // Serve starts s server and shutdowns it once the ctx is cancelled.
+// The server is served with TLS when its TLS config has certificates.
 func Serve(ctx context.Context, s *http.Server) error {
 	go func() {
 		<-ctx.Done()
@@ -21,7 +22,12 @@ func Serve(ctx context.Context, s *http.Server) error {
 		s.Shutdown(shutdownCtx)
 	}()
 
-	err := s.ListenAndServe()
+	var err error
+	if s.TLSConfig != nil && len(s.TLSConfig.Certificates) > 0 {
+		err = s.ListenAndServeTLS("", "")
+	} else {
+		err = s.ListenAndServe()
+	}
 	if errors.Is(err, http.ErrServerClosed) {
 		return nil
 	}
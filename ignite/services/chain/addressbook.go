@@ -0,0 +1,134 @@
+package chain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// addressBookFile is the name of the file where the address book of a chain is saved.
+const addressBookFile = "addresses.yml"
+
+// AddressBook keeps track of the addresses generated for the accounts of a chain.
+// It allows to detect when a chain reset regenerates the keys of an account
+// and to re-derive the previous keys from the stored mnemonics.
+type AddressBook struct {
+	Accounts map[string]AddressBookEntry `yaml:"accounts"`
+}
+
+// AddressBookEntry contains the last known address of an account.
+type AddressBookEntry struct {
+	// Address is the last known address of the account.
+	Address string `yaml:"address"`
+
+	// Fingerprint identifies the mnemonic used to derive the account keys.
+	Fingerprint string `yaml:"fingerprint,omitempty"`
+
+	// Mnemonic is the mnemonic used to derive the account keys.
+	Mnemonic string `yaml:"mnemonic,omitempty"`
+
+	// UpdatedAt is the last time the account address was recorded.
+	UpdatedAt time.Time `yaml:"updated_at"`
+}
+
+// AddressChange describes an account whose address changed.
+type AddressChange struct {
+	Name, PrevAddress, Address string
+}
+
+// MnemonicFingerprint returns a short fingerprint that identifies a mnemonic
+// without revealing it.
+func MnemonicFingerprint(mnemonic string) string {
+	if mnemonic == "" {
+		return ""
+	}
+
+	words := strings.Fields(mnemonic)
+	h := sha256.Sum256([]byte(strings.Join(words, " ")))
+	return hex.EncodeToString(h[:8])
+}
+
+// LoadAddressBook loads an address book from a file.
+// An empty address book is returned when the file doesn't exist.
+func LoadAddressBook(path string) (*AddressBook, error) {
+	book := &AddressBook{Accounts: make(map[string]AddressBookEntry)}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return book, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := yaml.Unmarshal(data, book); err != nil {
+		return nil, err
+	}
+	if book.Accounts == nil {
+		book.Accounts = make(map[string]AddressBookEntry)
+	}
+
+	return book, nil
+}
+
+// Save writes the address book to a file.
+// The file is only readable by the current user because it contains mnemonics.
+func (b *AddressBook) Save(path string) error {
+	data, err := yaml.Marshal(b)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o600)
+}
+
+// Mnemonic returns the stored mnemonic of an account.
+func (b *AddressBook) Mnemonic(name string) (string, bool) {
+	entry, ok := b.Accounts[name]
+	if !ok || entry.Mnemonic == "" {
+		return "", false
+	}
+	return entry.Mnemonic, true
+}
+
+// Record saves the address and the mnemonic of an account.
+// A change is returned when the account was previously recorded with a different address.
+func (b *AddressBook) Record(name, address, mnemonic string) (change AddressChange, changed bool) {
+	prev, ok := b.Accounts[name]
+	if ok && prev.Address != address {
+		change = AddressChange{
+			Name:        name,
+			PrevAddress: prev.Address,
+			Address:     address,
+		}
+		changed = true
+	}
+
+	b.Accounts[name] = AddressBookEntry{
+		Address:     address,
+		Fingerprint: MnemonicFingerprint(mnemonic),
+		Mnemonic:    mnemonic,
+		UpdatedAt:   time.Now().UTC(),
+	}
+
+	return change, changed
+}
+
+// addressBookPath returns the path of the chain's address book.
+func (c *Chain) addressBookPath() (string, error) {
+	savePath, err := c.chainSavePath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(savePath, addressBookFile), nil
+}
@@ -0,0 +1,51 @@
+package chain
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddressBook(t *testing.T) {
+	const mnemonic = "slide moment original seven milk crawl help text kick fluid boring awkward"
+
+	path := filepath.Join(t.TempDir(), "chain", "addresses.yml")
+
+	book, err := LoadAddressBook(path)
+	require.NoError(t, err)
+	require.Empty(t, book.Accounts)
+
+	_, changed := book.Record("alice", "cosmos1alice", mnemonic)
+	require.False(t, changed)
+	require.NoError(t, book.Save(path))
+
+	book, err = LoadAddressBook(path)
+	require.NoError(t, err)
+
+	got, ok := book.Mnemonic("alice")
+	require.True(t, ok)
+	require.Equal(t, mnemonic, got)
+	require.Equal(t, MnemonicFingerprint(mnemonic), book.Accounts["alice"].Fingerprint)
+
+	_, ok = book.Mnemonic("bob")
+	require.False(t, ok)
+
+	_, changed = book.Record("alice", "cosmos1alice", mnemonic)
+	require.False(t, changed)
+
+	change, changed := book.Record("alice", "cosmos1other", "")
+	require.True(t, changed)
+	require.Equal(t, AddressChange{
+		Name:        "alice",
+		PrevAddress: "cosmos1alice",
+		Address:     "cosmos1other",
+	}, change)
+}
+
+func TestMnemonicFingerprint(t *testing.T) {
+	require.Empty(t, MnemonicFingerprint(""))
+	require.Len(t, MnemonicFingerprint("foo bar"), 16)
+	require.Equal(t, MnemonicFingerprint("foo bar"), MnemonicFingerprint(" foo  bar\n"))
+	require.NotEqual(t, MnemonicFingerprint("foo bar"), MnemonicFingerprint("bar foo"))
+}
@@ -7,15 +7,19 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
+	"text/template"
 
 	"github.com/moby/moby/pkg/archive"
 	"github.com/pkg/errors"
 
+	"github.com/ignite/cli/ignite/chainconfig"
 	"github.com/ignite/cli/ignite/pkg/cache"
 	"github.com/ignite/cli/ignite/pkg/checksum"
 	"github.com/ignite/cli/ignite/pkg/cmdrunner"
 	"github.com/ignite/cli/ignite/pkg/cmdrunner/exec"
 	"github.com/ignite/cli/ignite/pkg/cmdrunner/step"
+	"github.com/ignite/cli/ignite/pkg/ctxreader"
 	"github.com/ignite/cli/ignite/pkg/dirchange"
 	"github.com/ignite/cli/ignite/pkg/events"
 	"github.com/ignite/cli/ignite/pkg/goanalysis"
@@ -64,12 +68,12 @@ func (c *Chain) build(
 
 	// generate from proto files
 	if !skipProto {
-		if err := c.generateFromConfig(ctx, cacheStorage); err != nil {
+		if err := c.GenerateFromConfig(ctx, cacheStorage); err != nil {
 			return err
 		}
 	}
 
-	buildFlags, err := c.preBuild(ctx, cacheStorage)
+	buildFlags, buildEnv, err := c.preBuild(ctx, cacheStorage)
 	if err != nil {
 		return err
 	}
@@ -84,7 +88,7 @@ func (c *Chain) build(
 		return err
 	}
 
-	return gocmd.BuildPath(ctx, output, binary, path, buildFlags)
+	return gocmd.BuildPath(ctx, output, binary, path, buildFlags, exec.StepOption(step.Env(buildEnv...)))
 }
 
 // BuildRelease builds binaries for a release. targets is a list
@@ -103,7 +107,7 @@ func (c *Chain) BuildRelease(ctx context.Context, cacheStorage cache.Storage, ou
 		return "", err
 	}
 
-	buildFlags, err := c.preBuild(ctx, cacheStorage)
+	buildFlags, buildEnv, err := c.preBuild(ctx, cacheStorage)
 	if err != nil {
 		return "", err
 	}
@@ -144,35 +148,24 @@ func (c *Chain) BuildRelease(ctx context.Context, cacheStorage cache.Storage, ou
 		}
 		defer os.RemoveAll(out)
 
+		env := append([]string{
+			cmdrunner.Env(gocmd.EnvGOOS, goos),
+			cmdrunner.Env(gocmd.EnvGOARCH, goarch),
+		}, buildEnv...)
 		buildOptions := []exec.Option{
-			exec.StepOption(step.Env(
-				cmdrunner.Env(gocmd.EnvGOOS, goos),
-				cmdrunner.Env(gocmd.EnvGOARCH, goarch),
-			)),
+			exec.StepOption(step.Env(env...)),
 		}
 
 		if err := gocmd.BuildPath(ctx, out, binary, mainPath, buildFlags, buildOptions...); err != nil {
 			return "", err
 		}
 
-		tarr, err := archive.Tar(out, archive.Gzip)
-		if err != nil {
-			return "", err
-		}
-
 		tarName := fmt.Sprintf("%s_%s_%s.tar.gz", prefix, goos, goarch)
 		tarPath := filepath.Join(releasePath, tarName)
 
-		tarf, err := os.Create(tarPath)
-		if err != nil {
-			return "", err
-		}
-		defer tarf.Close()
-
-		if _, err := io.Copy(tarf, tarr); err != nil {
+		if err := writeTarball(ctx, out, tarPath); err != nil {
 			return "", err
 		}
-		tarf.Close()
 	}
 
 	checksumPath := filepath.Join(releasePath, releaseChecksumKey)
@@ -181,18 +174,64 @@ func (c *Chain) BuildRelease(ctx context.Context, cacheStorage cache.Storage, ou
 	return releasePath, checksum.Sum(releasePath, checksumPath)
 }
 
-func (c *Chain) preBuild(ctx context.Context, cacheStorage cache.Storage) (buildFlags []string, err error) {
+// writeTarball archives the dir in a gzipped tarball created at path.
+// The tarball is written to a temporary file first so an interrupted
+// release doesn't leave a partial tarball in the release dir.
+func writeTarball(ctx context.Context, dir, path string) error {
+	tarr, err := archive.Tar(dir, archive.Gzip)
+	if err != nil {
+		return err
+	}
+	defer tarr.Close()
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	if _, err := io.Copy(tmp, ctxreader.New(ctx, tarr)); err != nil {
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (c *Chain) preBuild(
+	ctx context.Context,
+	cacheStorage cache.Storage,
+) (buildFlags []string, buildEnv []string, err error) {
 	config, err := c.Config()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	chainID, err := c.ID()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	binary, err := c.Binary()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ldFlags, err := renderLDFlags(config.Build.LDFlags, ldFlagsData{
+		Name:       c.app.Name,
+		ImportPath: c.app.ImportPath,
+		Binary:     binary,
+		ChainID:    chainID,
+		Version:    c.sourceVersion.tag,
+		Commit:     c.sourceVersion.hash,
+	})
+	if err != nil {
+		return nil, nil, err
 	}
 
-	ldFlags := config.Build.LDFlags
 	ldFlags = append(ldFlags,
 		fmt.Sprintf("-X github.com/cosmos/cosmos-sdk/version.Name=%s", xstrings.Title(c.app.Name)),
 		fmt.Sprintf("-X github.com/cosmos/cosmos-sdk/version.AppName=%sd", c.app.Name),
@@ -205,18 +244,24 @@ func (c *Chain) preBuild(ctx context.Context, cacheStorage cache.Storage) (build
 		gocmd.FlagLdflags, gocmd.Ldflags(ldFlags...),
 	}
 
+	if len(config.Build.Tags) > 0 {
+		buildFlags = append(buildFlags, gocmd.FlagTags, gocmd.Tags(config.Build.Tags...))
+	}
+
+	buildEnv = cgoEnv(config)
+
 	c.ev.Send("Installing dependencies...", events.ProgressUpdate())
 
 	// We do mod tidy before checking for checksum changes, because go.mod gets modified often
 	// and the mod verify command is the expensive one anyway
 	if err := gocmd.ModTidy(ctx, c.app.Path); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	dirCache := cache.New[[]byte](cacheStorage, buildDirchangeCacheNamespace)
 	modChanged, err := dirchange.HasDirChecksumChanged(dirCache, modChecksumKey, c.app.Path, "go.mod")
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if modChanged {
@@ -224,18 +269,18 @@ func (c *Chain) preBuild(ctx context.Context, cacheStorage cache.Storage) (build
 		// ziphash files in case a Go workspace is being used.
 		if c.options.checkDependencies {
 			if err := gocmd.ModVerify(ctx, c.app.Path); err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 		}
 
 		if err := dirchange.SaveDirChecksum(dirCache, modChecksumKey, c.app.Path, "go.mod"); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
 	c.ev.Send("Building the blockchain...", events.ProgressUpdate())
 
-	return buildFlags, nil
+	return buildFlags, buildEnv, nil
 }
 
 func (c *Chain) discoverMain(path string) (pkgPath string, err error) {
@@ -254,3 +299,57 @@ func (c *Chain) discoverMain(path string) (pkgPath string, err error) {
 	}
 	return path, err
 }
+
+// ldFlagsData holds the values that can be referenced by the ldflags
+// templates defined in the chain config.
+type ldFlagsData struct {
+	Name       string
+	ImportPath string
+	Binary     string
+	ChainID    string
+	Version    string
+	Commit     string
+}
+
+// renderLDFlags executes each ldflag as a template using data as the value source.
+func renderLDFlags(flags []string, data ldFlagsData) ([]string, error) {
+	rendered := make([]string, len(flags))
+	for i, f := range flags {
+		tpl, err := template.New("ldflags").Option("missingkey=error").Parse(f)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid ldflags template %q", f)
+		}
+
+		var b strings.Builder
+		if err := tpl.Execute(&b, data); err != nil {
+			return nil, errors.Wrapf(err, "invalid ldflags template %q", f)
+		}
+
+		rendered[i] = b.String()
+	}
+
+	return rendered, nil
+}
+
+// cgoEnv returns the environment variables required to apply the cgo build configs.
+func cgoEnv(conf *chainconfig.Config) (env []string) {
+	cgo := conf.Build.CGO
+	if cgo.Enabled != nil {
+		enabled := "0"
+		if *cgo.Enabled {
+			enabled = "1"
+		}
+
+		env = append(env, cmdrunner.Env(gocmd.EnvCGOEnabled, enabled))
+	}
+
+	if len(cgo.CFlags) > 0 {
+		env = append(env, cmdrunner.Env(gocmd.EnvCGOCFlags, strings.Join(cgo.CFlags, " ")))
+	}
+
+	if len(cgo.LDFlags) > 0 {
+		env = append(env, cmdrunner.Env(gocmd.EnvCGOLDFlags, strings.Join(cgo.LDFlags, " ")))
+	}
+
+	return env
+}
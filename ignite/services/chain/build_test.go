@@ -0,0 +1,81 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+)
+
+func TestRenderLDFlags(t *testing.T) {
+	data := ldFlagsData{
+		Name:    "mars",
+		Binary:  "marsd",
+		ChainID: "mars-1",
+		Version: "0.2",
+		Commit:  "503123b",
+	}
+
+	cases := []struct {
+		name    string
+		flags   []string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:  "plain flags",
+			flags: []string{"-s", "-w"},
+			want:  []string{"-s", "-w"},
+		},
+		{
+			name: "template flags",
+			flags: []string{
+				"-X main.Version={{.Version}}",
+				"-X main.Commit={{.Commit}} -X main.Chain={{.ChainID}}",
+			},
+			want: []string{
+				"-X main.Version=0.2",
+				"-X main.Commit=503123b -X main.Chain=mars-1",
+			},
+		},
+		{
+			name:    "unknown value",
+			flags:   []string{"-X main.Foo={{.Foo}}"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid template",
+			flags:   []string{"-X main.Foo={{.Foo"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := renderLDFlags(tt.flags, data)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCGOEnv(t *testing.T) {
+	disabled := false
+	conf := chainconfig.DefaultConfig()
+	require.Empty(t, cgoEnv(conf))
+
+	conf.Build.CGO.Enabled = &disabled
+	conf.Build.CGO.CFlags = []string{"-O2", "-g"}
+	conf.Build.CGO.LDFlags = []string{"-lrocksdb"}
+	require.Equal(t, []string{
+		"CGO_ENABLED=0",
+		"CGO_CFLAGS=-O2 -g",
+		"CGO_LDFLAGS=-lrocksdb",
+	}, cgoEnv(conf))
+}
@@ -0,0 +1,218 @@
+package chain
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/certs"
+)
+
+const (
+	certsDirName = "certs"
+
+	// caValidity is the validity of the project certificate authority.
+	caValidity = 10 * 365 * 24 * time.Hour
+
+	// certValidity is the validity of the node and client certificates.
+	certValidity = 365 * 24 * time.Hour
+
+	// certRenewBefore is the time before the expiration of the node certificate
+	// when it's issued again on serve.
+	certRenewBefore = 7 * 24 * time.Hour
+)
+
+// CertsPaths are the paths of the PEM encoded certificates and keys of a chain.
+type CertsPaths struct {
+	// CA and CAKey are the project certificate authority that issues the other certificates.
+	CA, CAKey string
+
+	// Node and NodeKey are the certificate served by the development proxy.
+	Node, NodeKey string
+
+	// Client and ClientKey are the certificate used by the clients to authenticate
+	// to the development proxy when the client authentication is enabled.
+	Client, ClientKey string
+}
+
+// CertsPaths returns the paths of the certificates of the chain, they are kept in
+// Ignite's config directory so they aren't removed when the chain home is reset.
+func (c *Chain) CertsPaths() (CertsPaths, error) {
+	configDir, err := chainconfig.ConfigDirPath()
+	if err != nil {
+		return CertsPaths{}, err
+	}
+
+	dir := filepath.Join(configDir, certsDirName, c.Name())
+	return CertsPaths{
+		CA:        filepath.Join(dir, "ca.pem"),
+		CAKey:     filepath.Join(dir, "ca-key.pem"),
+		Node:      filepath.Join(dir, "node.pem"),
+		NodeKey:   filepath.Join(dir, "node-key.pem"),
+		Client:    filepath.Join(dir, "client.pem"),
+		ClientKey: filepath.Join(dir, "client-key.pem"),
+	}, nil
+}
+
+// RotateCerts issues new node and client certificates. The certificate authority
+// is created again when rotateCA is true or when it doesn't exist yet, the clients
+// must then trust the new certificate authority.
+func (c *Chain) RotateCerts(rotateCA bool) (CertsPaths, error) {
+	conf, err := c.Config()
+	if err != nil {
+		return CertsPaths{}, err
+	}
+
+	paths, err := c.CertsPaths()
+	if err != nil {
+		return CertsPaths{}, err
+	}
+
+	ca, err := certs.Load(paths.CA, paths.CAKey)
+	if errors.Is(err, os.ErrNotExist) || rotateCA {
+		if ca, err = certs.NewCA(fmt.Sprintf("%s CA", c.Name()), caValidity); err != nil {
+			return CertsPaths{}, err
+		}
+		if err := ca.Save(paths.CA, paths.CAKey); err != nil {
+			return CertsPaths{}, err
+		}
+	} else if err != nil {
+		return CertsPaths{}, err
+	}
+
+	node, err := certs.Issue(ca, fmt.Sprintf("%s node", c.Name()), certHosts(conf), certValidity)
+	if err != nil {
+		return CertsPaths{}, err
+	}
+	if err := node.Save(paths.Node, paths.NodeKey); err != nil {
+		return CertsPaths{}, err
+	}
+
+	client, err := certs.Issue(ca, fmt.Sprintf("%s client", c.Name()), nil, certValidity)
+	if err != nil {
+		return CertsPaths{}, err
+	}
+	if err := client.Save(paths.Client, paths.ClientKey); err != nil {
+		return CertsPaths{}, err
+	}
+
+	return paths, nil
+}
+
+// ensureCerts issues the certificates of the chain when they don't exist, when
+// the node certificate expires soon or when its hosts don't match the config.
+func (c *Chain) ensureCerts(conf *chainconfig.Config) (CertsPaths, error) {
+	paths, err := c.CertsPaths()
+	if err != nil {
+		return CertsPaths{}, err
+	}
+
+	if _, err := certs.Load(paths.CA, paths.CAKey); err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return CertsPaths{}, err
+		}
+		return c.RotateCerts(true)
+	}
+
+	node, err := certs.Load(paths.Node, paths.NodeKey)
+	if errors.Is(err, os.ErrNotExist) {
+		return c.RotateCerts(false)
+	}
+	if err != nil {
+		return CertsPaths{}, err
+	}
+
+	notAfter, err := node.NotAfter()
+	if err != nil {
+		return CertsPaths{}, err
+	}
+	hosts, err := node.Hosts()
+	if err != nil {
+		return CertsPaths{}, err
+	}
+	if time.Until(notAfter) < certRenewBefore || !sameHosts(hosts, certHosts(conf)) {
+		return c.RotateCerts(false)
+	}
+
+	return paths, nil
+}
+
+// proxyTLSConfig returns the TLS config of the development proxy.
+func (c *Chain) proxyTLSConfig(paths CertsPaths, conf *chainconfig.Config) (*tls.Config, error) {
+	node, err := certs.Load(paths.Node, paths.NodeKey)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := node.TLSCertificate()
+	if err != nil {
+		return nil, err
+	}
+
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if conf.Proxy.TLS.ClientAuth {
+		ca, err := certs.Load(paths.CA, paths.CAKey)
+		if err != nil {
+			return nil, err
+		}
+		if config.ClientCAs, err = ca.CertPool(); err != nil {
+			return nil, err
+		}
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return config, nil
+}
+
+// certHosts returns the hosts of the node certificate: localhost, the loopback IPs,
+// the host of the proxy address and the hosts of the config.
+func certHosts(conf *chainconfig.Config) []string {
+	hosts := []string{"localhost", "127.0.0.1", "::1"}
+	if host, _, err := net.SplitHostPort(conf.Proxy.Address); err == nil && host != "" {
+		if ip := net.ParseIP(host); ip == nil || !ip.IsUnspecified() {
+			hosts = append(hosts, host)
+		}
+	}
+	hosts = append(hosts, conf.Proxy.TLS.Hosts...)
+
+	unique := make(map[string]struct{})
+	for _, h := range hosts {
+		// Normalize the IPs to match the hosts read from the certificates
+		if ip := net.ParseIP(h); ip != nil {
+			h = ip.String()
+		}
+		unique[h] = struct{}{}
+	}
+	hosts = hosts[:0]
+	for h := range unique {
+		hosts = append(hosts, h)
+	}
+	sort.Strings(hosts)
+	return hosts
+}
+
+// sameHosts returns true if a and b contain the same hosts.
+func sameHosts(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	hosts := make(map[string]struct{}, len(a))
+	for _, h := range a {
+		hosts[h] = struct{}{}
+	}
+	for _, h := range b {
+		if _, ok := hosts[h]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,50 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+)
+
+func TestCertHosts(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+		hosts   []string
+		want    []string
+	}{
+		{
+			name:    "unspecified address",
+			address: "0.0.0.0:8080",
+			want:    []string{"127.0.0.1", "::1", "localhost"},
+		},
+		{
+			name:    "host address",
+			address: "devnet.example.com:8080",
+			want:    []string{"127.0.0.1", "::1", "devnet.example.com", "localhost"},
+		},
+		{
+			name:    "config hosts",
+			address: ":8080",
+			hosts:   []string{"10.0.0.1", "localhost", "0:0:0:0:0:0:0:1"},
+			want:    []string{"10.0.0.1", "127.0.0.1", "::1", "localhost"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := &chainconfig.Config{}
+			conf.Proxy.Address = tt.address
+			conf.Proxy.TLS.Hosts = tt.hosts
+
+			require.Equal(t, tt.want, certHosts(conf))
+		})
+	}
+}
+
+func TestSameHosts(t *testing.T) {
+	require.True(t, sameHosts([]string{"localhost", "::1"}, []string{"::1", "localhost"}))
+	require.False(t, sameHosts([]string{"localhost"}, []string{"localhost", "::1"}))
+	require.False(t, sameHosts([]string{"localhost", "::1"}, []string{"localhost", "127.0.0.1"}))
+}
@@ -50,6 +50,9 @@ type Chain struct {
 	serveRefresher chan struct{}
 	served         bool
 
+	// serving indicates that the chain is served.
+	serving bool
+
 	// protoBuiltAtLeastOnce indicates that app's proto generation at least made once.
 	protoBuiltAtLeastOnce bool
 
@@ -79,6 +82,14 @@ type chainOptions struct {
 	// printGeneratedPaths prints the output paths of the generated code
 	printGeneratedPaths bool
 
+	// reuseAccountKeys re-derives the account keys from the mnemonics saved in
+	// the address book when the chain is initialized.
+	reuseAccountKeys bool
+
+	// generateTargets are the code generation targets added to the targets of
+	// the config, like the targets of plugins.
+	generateTargets []GenerateTarget
+
 	// path of a custom config file
 	ConfigFile string
 }
@@ -122,6 +133,14 @@ func EnableThirdPartyModuleCodegen() Option {
 	}
 }
 
+// WithGenerateTargets adds code generation targets to the targets of the config
+// generated when the chain is built or served.
+func WithGenerateTargets(targets ...GenerateTarget) Option {
+	return func(c *Chain) {
+		c.options.generateTargets = append(c.options.generateTargets, targets...)
+	}
+}
+
 // WithOutputer sets the CLI outputer for the chain.
 func WithOutputer(s uilog.Outputer) Option {
 	return func(c *Chain) {
@@ -152,6 +171,15 @@ func PrintGeneratedPaths() Option {
 	}
 }
 
+// ReuseAccountKeys re-derives the keys of the accounts that don't define a mnemonic
+// from the mnemonics saved in the address book, so a chain reset keeps the same
+// account addresses.
+func ReuseAccountKeys() Option {
+	return func(c *Chain) {
+		c.options.reuseAccountKeys = true
+	}
+}
+
 // New initializes a new Chain with options that its source lives at path.
 func New(path string, options ...Option) (*Chain, error) {
 	app, err := NewAppAt(path)
@@ -0,0 +1,87 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/ignite/cli/ignite/pkg/apicompat"
+	"github.com/ignite/cli/ignite/pkg/cosmosanalysis/module"
+	"github.com/ignite/cli/ignite/pkg/xurl"
+)
+
+// DefaultCompatDir is the directory of the app where the golden files of the
+// API compatibility checks are written by default.
+const DefaultCompatDir = "testdata/compat"
+
+// CompatDir returns the absolute path of a directory of golden files, a relative
+// directory is relative to the app directory.
+func (c *Chain) CompatDir(dir string) string {
+	if dir == "" {
+		dir = DefaultCompatDir
+	}
+	if filepath.IsAbs(dir) {
+		return dir
+	}
+	return filepath.Join(c.app.Path, dir)
+}
+
+// CompatQueries returns the queries of the modules of the app that can be locked
+// in by golden files. The queries with params in the path of their HTTP endpoint
+// are skipped because their responses depend on the params.
+func (c *Chain) CompatQueries(ctx context.Context) ([]apicompat.Query, error) {
+	conf, err := c.Config()
+	if err != nil {
+		return nil, err
+	}
+
+	modules, err := module.Discover(ctx, c.app.Path, c.app.Path, conf.Build.Proto.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	var queries []apicompat.Query
+	for _, m := range modules {
+		for _, q := range m.HTTPQueries {
+			for _, rule := range q.Rules {
+				if len(rule.Params) > 0 || rule.Endpoint == "" {
+					continue
+				}
+				queries = append(queries, apicompat.Query{
+					Module: m.Name,
+					Name:   q.Name,
+					Path:   rule.Endpoint,
+				})
+				break
+			}
+		}
+	}
+
+	return queries, nil
+}
+
+// APIClient returns a client to record and replay the queries of the API served
+// by the validator of the chain.
+func (c *Chain) APIClient() (apicompat.Client, error) {
+	conf, err := c.Config()
+	if err != nil {
+		return apicompat.Client{}, err
+	}
+
+	servers, err := conf.Validators[0].GetServers()
+	if err != nil {
+		return apicompat.Client{}, err
+	}
+
+	apiAddress := servers.API.Address
+	if envAPIAddress != "" {
+		apiAddress = envAPIAddress
+	}
+
+	apiAddress, err = xurl.HTTP(apiAddress)
+	if err != nil {
+		return apicompat.Client{}, fmt.Errorf("invalid api address format: %w", err)
+	}
+
+	return apicompat.New(apiAddress), nil
+}
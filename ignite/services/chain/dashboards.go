@@ -0,0 +1,78 @@
+package chain
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosmetrics"
+)
+
+// DashboardsInfo describes the dashboards written for the chain.
+type DashboardsInfo struct {
+	// Modules are the names of the custom modules that have metrics.
+	Modules []string
+
+	// InstrumentationDisabled is true when the node doesn't serve the
+	// Tendermint metrics.
+	InstrumentationDisabled bool
+
+	// TelemetryDisabled is true when the app doesn't serve the Cosmos SDK
+	// telemetry.
+	TelemetryDisabled bool
+}
+
+// WriteDashboards writes in dir the Grafana dashboards of the chain with a
+// Prometheus and Grafana stack that scrapes the metrics of the first validator.
+// The custom modules of the app are scanned for the metrics they emit.
+func (c *Chain) WriteDashboards(dir string) (DashboardsInfo, error) {
+	var info DashboardsInfo
+
+	conf, err := c.Config()
+	if err != nil {
+		return info, err
+	}
+	chainID, err := c.ID()
+	if err != nil {
+		return info, err
+	}
+
+	validator := conf.Validators[0]
+	servers, err := validator.GetServers()
+	if err != nil {
+		return info, err
+	}
+	telemetry, err := validator.GetTelemetry()
+	if err != nil {
+		return info, err
+	}
+	info.InstrumentationDisabled = !telemetry.Instrumentation.Prometheus
+	info.TelemetryDisabled = !telemetry.App.Enabled
+
+	modules := make(map[string][]cosmosmetrics.Metric)
+	entries, err := os.ReadDir(filepath.Join(c.app.Path, "x"))
+	if err != nil && !os.IsNotExist(err) {
+		return info, err
+	}
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+
+		metrics, err := cosmosmetrics.FindModuleMetrics(filepath.Join(c.app.Path, "x", e.Name()), e.Name())
+		if err != nil {
+			return info, err
+		}
+		if len(metrics) > 0 {
+			modules[e.Name()] = metrics
+			info.Modules = append(info.Modules, e.Name())
+		}
+	}
+
+	return info, cosmosmetrics.WriteStack(dir, cosmosmetrics.StackOptions{
+		ChainID:           chainID,
+		Namespace:         telemetry.Instrumentation.Namespace,
+		PrometheusAddress: telemetry.Instrumentation.PrometheusAddress,
+		APIAddress:        servers.API.Address,
+		Modules:           modules,
+	})
+}
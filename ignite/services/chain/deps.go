@@ -0,0 +1,116 @@
+package chain
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosdeps"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/gocmd"
+	"github.com/ignite/cli/ignite/pkg/gomodule"
+)
+
+// DependenciesUpgrade describes the changes applied to the chain while
+// upgrading its dependencies to a new Cosmos SDK version.
+type DependenciesUpgrade struct {
+	// From is the Cosmos SDK version used before the upgrade.
+	From string
+
+	// To is the Cosmos SDK version used after the upgrade.
+	To string
+
+	// GoModChanges describes each change applied to the go.mod file.
+	GoModChanges []string
+
+	// ModifiedFiles contains the paths of the source files modified during the upgrade.
+	ModifiedFiles []string
+
+	// ManualSteps contains the changes that must be done manually to complete the upgrade.
+	ManualSteps []string
+}
+
+// UpgradeDependencies upgrades the chain's go.mod to the set of dependencies
+// compatible with the Cosmos SDK version, applies the known source code
+// modifications for each release line in between and returns the steps
+// that must be completed manually.
+func (c *Chain) UpgradeDependencies(ctx context.Context, sdkVersion string) (u DependenciesUpgrade, err error) {
+	target, err := cosmosdeps.Find(sdkVersion)
+	if err != nil {
+		return u, err
+	}
+
+	sets, err := cosmosdeps.Path(c.Version.Version, target)
+	if err != nil {
+		return u, err
+	}
+
+	modFile, err := gomodule.ParseAt(c.app.Path)
+	if err != nil {
+		return u, err
+	}
+
+	u.From = c.Version.Version
+	u.To = target.SDKVersion()
+
+	c.ev.Send("Updating dependencies...", events.ProgressUpdate())
+
+	for _, s := range sets {
+		changes, err := s.UpdateGoMod(modFile)
+		if err != nil {
+			return u, err
+		}
+
+		files, err := cosmosdeps.RewriteImports(c.app.Path, s.ImportRewrites())
+		if err != nil {
+			return u, err
+		}
+
+		u.GoModChanges = append(u.GoModChanges, changes...)
+		u.ModifiedFiles = appendUnique(u.ModifiedFiles, files...)
+		u.ManualSteps = append(u.ManualSteps, s.ManualSteps...)
+	}
+
+	content, err := modFile.Format()
+	if err != nil {
+		return u, err
+	}
+
+	if err := os.WriteFile(filepath.Join(c.app.Path, "go.mod"), content, 0o644); err != nil {
+		return u, err
+	}
+
+	c.ev.Send("Installing dependencies...", events.ProgressUpdate())
+
+	// A failure to tidy the dependencies is expected when the source code still
+	// requires manual changes so it is reported as a step instead of an error.
+	if err := gocmd.ModTidy(ctx, c.app.Path); err != nil {
+		if errors.Is(err, context.Canceled) {
+			return u, err
+		}
+
+		u.ManualSteps = append(u.ManualSteps, `Run "go mod tidy" and fix the reported errors`)
+	}
+
+	return u, nil
+}
+
+func appendUnique(s []string, items ...string) []string {
+	for _, item := range items {
+		var found bool
+		for _, v := range s {
+			if v == item {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			s = append(s, item)
+		}
+	}
+
+	return s
+}
@@ -3,14 +3,21 @@ package chain
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
 
+	"github.com/ignite/cli/ignite/chainconfig/config"
 	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
 	"github.com/ignite/cli/ignite/pkg/cosmosfaucet"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+	"github.com/ignite/cli/ignite/pkg/xhttp"
 	"github.com/ignite/cli/ignite/pkg/xurl"
 )
 
@@ -22,6 +29,10 @@ var (
 	ErrFaucetAccountDoesNotExist = errors.New("specified account (faucet.name) does not exist")
 )
 
+// faucetLimitsFile is the name of the file where the requests and the
+// transfers of the faucet are saved to enforce its limits.
+const faucetLimitsFile = "faucet-limits.yml"
+
 var envAPIAddress = os.Getenv("API_ADDRESS")
 
 // Faucet returns the faucet for the chain or an error if the faucet
@@ -47,13 +58,20 @@ func (c *Chain) Faucet(ctx context.Context) (cosmosfaucet.Faucet, error) {
 		return cosmosfaucet.Faucet{}, ErrFaucetIsNotEnabled
 	}
 
-	if _, err := commands.ShowAccount(ctx, *conf.Faucet.Name); err != nil {
+	account, err := commands.ShowAccount(ctx, *conf.Faucet.Name)
+	if err != nil {
 		if err == chaincmdrunner.ErrAccountDoesNotExist {
 			return cosmosfaucet.Faucet{}, ErrFaucetAccountDoesNotExist
 		}
 		return cosmosfaucet.Faucet{}, err
 	}
 
+	// the addresses of the requests must have the prefix of the chain.
+	prefix, err := cosmosutil.GetAddressPrefix(account.Address)
+	if err != nil {
+		return cosmosfaucet.Faucet{}, err
+	}
+
 	// construct faucet options.
 	validator := conf.Validators[0]
 	servers, err := validator.GetServers()
@@ -75,41 +93,238 @@ func (c *Chain) Faucet(ctx context.Context) (cosmosfaucet.Faucet, error) {
 		cosmosfaucet.Account(*conf.Faucet.Name, "", ""),
 		cosmosfaucet.ChainID(id),
 		cosmosfaucet.OpenAPI(apiAddress),
+		cosmosfaucet.AddressPrefix(prefix),
 	}
 
-	// parse coins to pass to the faucet as coins.
-	for _, coin := range conf.Faucet.Coins {
-		parsedCoin, err := sdk.ParseCoinNormalized(coin)
+	savePath, err := c.chainSavePath()
+	if err != nil {
+		return cosmosfaucet.Faucet{}, err
+	}
+
+	configOptions, err := FaucetOptions(conf.Faucet, c.app.Path, filepath.Join(savePath, faucetLimitsFile))
+	if err != nil {
+		return cosmosfaucet.Faucet{}, err
+	}
+	faucetOptions = append(faucetOptions, configOptions...)
+
+	// init the faucet with options and return.
+	return cosmosfaucet.New(ctx, commands, faucetOptions...)
+}
+
+// FaucetOptions returns the options of a faucet from its config, the requests
+// and the transfers of the faucet are saved to the limits file. The relative
+// files of the config are relative to dir.
+func FaucetOptions(conf config.Faucet, dir, limitsPath string) ([]cosmosfaucet.Option, error) {
+	options, err := faucetCoins(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	allowlist, err := faucetAccessList(conf.Allowlist, dir)
+	if err != nil {
+		return nil, err
+	}
+	denylist, err := faucetAccessList(conf.Denylist, dir)
+	if err != nil {
+		return nil, err
+	}
+	options = append(options, cosmosfaucet.WithAccessLists(allowlist, denylist))
+
+	if conf.RateLimitWindow != "" {
+		rateLimitWindow, err := time.ParseDuration(conf.RateLimitWindow)
 		if err != nil {
-			return cosmosfaucet.Faucet{}, fmt.Errorf("%s: %s", err, coin)
+			return nil, fmt.Errorf("%s: %s", err, conf.RateLimitWindow)
 		}
 
-		var amountMax uint64
-
-		// find out the max amount for this coin.
-		for _, coinMax := range conf.Faucet.CoinsMax {
-			parsedMax, err := sdk.ParseCoinNormalized(coinMax)
-			if err != nil {
-				return cosmosfaucet.Faucet{}, fmt.Errorf("%s: %s", err, coin)
-			}
-			if parsedMax.Denom == parsedCoin.Denom {
-				amountMax = parsedMax.Amount.Uint64()
-				break
-			}
+		options = append(options, cosmosfaucet.RefreshWindow(rateLimitWindow))
+	}
+
+	if conf.Batch.Interval != "" {
+		interval, err := time.ParseDuration(conf.Batch.Interval)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", err, conf.Batch.Interval)
 		}
 
-		faucetOptions = append(faucetOptions, cosmosfaucet.Coin(parsedCoin.Amount.Uint64(), amountMax, parsedCoin.Denom))
+		options = append(options, cosmosfaucet.WithBatching(interval, conf.Batch.MaxSize))
+	}
+
+	alerts, err := faucetAlerts(conf.Alerts)
+	if err != nil {
+		return nil, err
+	}
+	options = append(options, cosmosfaucet.WithAlerts(alerts))
+
+	limits, err := faucetLimits(conf.Limits)
+	if err != nil {
+		return nil, err
 	}
 
-	if conf.Faucet.RateLimitWindow != "" {
-		rateLimitWindow, err := time.ParseDuration(conf.Faucet.RateLimitWindow)
+	options = append(
+		options,
+		cosmosfaucet.WithLimits(limits, limitsPath),
+		cosmosfaucet.WithChallenge(faucetChallenge(conf.Challenge)),
+	)
+
+	if conf.IsUIEnabled() {
+		options = append(options, cosmosfaucet.WithUI())
+	}
+
+	return options, nil
+}
+
+// ServeFaucet serves the faucet at its host until the context is canceled,
+// with its gRPC service when the config has a gRPC address. The requests are logged to the log file of the faucet, a relative log file
+// is relative to dir.
+func ServeFaucet(ctx context.Context, faucet cosmosfaucet.Faucet, conf config.Faucet, host, dir string) error {
+	// append the structured logs of the requests to the log file.
+	if logFile := conf.LogFile; logFile != "" {
+		if !filepath.IsAbs(logFile) {
+			logFile = filepath.Join(dir, logFile)
+		}
+
+		f, err := os.OpenFile(logFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
 		if err != nil {
-			return cosmosfaucet.Faucet{}, fmt.Errorf("%s: %s", err, conf.Faucet.RateLimitWindow)
+			return err
 		}
+		defer f.Close()
 
-		faucetOptions = append(faucetOptions, cosmosfaucet.RefreshWindow(rateLimitWindow))
+		cosmosfaucet.WithLogger(f)(&faucet)
 	}
 
-	// init the faucet with options and return.
-	return cosmosfaucet.New(ctx, commands, faucetOptions...)
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		return xhttp.Serve(ctx, &http.Server{
+			Addr:    host,
+			Handler: faucet,
+		})
+	})
+	if conf.GRPCAddress != "" {
+		g.Go(func() error {
+			return faucet.ServeGRPC(ctx, conf.GRPCAddress)
+		})
+	}
+	g.Go(func() error {
+		return faucet.MonitorAlerts(ctx)
+	})
+	return g.Wait()
+}
+
+// faucetCoins returns the options of the coins distributed by the faucet, like
+// native or "ibc/..." denoms, with their max amounts per address.
+func faucetCoins(conf config.Faucet) ([]cosmosfaucet.Option, error) {
+	maxAmounts := make(map[string]uint64)
+	for _, coinMax := range conf.CoinsMax {
+		parsedMax, err := sdk.ParseCoinNormalized(coinMax)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", err, coinMax)
+		}
+		maxAmounts[parsedMax.Denom] = parsedMax.Amount.Uint64()
+	}
+
+	var (
+		options []cosmosfaucet.Option
+		denoms  = make(map[string]bool)
+	)
+	for _, coin := range conf.Coins {
+		parsedCoin, err := sdk.ParseCoinNormalized(coin)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", err, coin)
+		}
+		if denoms[parsedCoin.Denom] {
+			return nil, fmt.Errorf("faucet coin denom %s is duplicated", parsedCoin.Denom)
+		}
+		denoms[parsedCoin.Denom] = true
+
+		options = append(options, cosmosfaucet.Coin(parsedCoin.Amount.Uint64(), maxAmounts[parsedCoin.Denom], parsedCoin.Denom))
+	}
+
+	for denom := range maxAmounts {
+		if !denoms[denom] {
+			return nil, fmt.Errorf("faucet coins_max denom %s is not in the faucet coins", denom)
+		}
+	}
+
+	return options, nil
+}
+
+// faucetAccessList returns the access list of the file at path, the list is
+// empty without file.
+func faucetAccessList(path, dir string) (cosmosfaucet.AccessList, error) {
+	if path == "" {
+		return cosmosfaucet.AccessList{}, nil
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(dir, path)
+	}
+	return cosmosfaucet.LoadAccessList(path)
+}
+
+// faucetLimits returns the limits of the faucet from their config.
+func faucetLimits(conf config.FaucetLimits) (limits cosmosfaucet.Limits, err error) {
+	limits.IPRequests = conf.IPRequests
+
+	if conf.IPWindow != "" {
+		if limits.IPWindow, err = time.ParseDuration(conf.IPWindow); err != nil {
+			return cosmosfaucet.Limits{}, fmt.Errorf("%s: %s", err, conf.IPWindow)
+		}
+	}
+
+	if limits.AddressDailyCap, err = sdk.ParseCoinsNormalized(strings.Join(conf.AddressDailyCap, ",")); err != nil {
+		return cosmosfaucet.Limits{}, fmt.Errorf("faucet address_daily_cap: %w", err)
+	}
+
+	if limits.DailyCap, err = sdk.ParseCoinsNormalized(strings.Join(conf.DailyCap, ",")); err != nil {
+		return cosmosfaucet.Limits{}, fmt.Errorf("faucet daily_cap: %w", err)
+	}
+
+	return limits, nil
+}
+
+// faucetAlerts returns the alerts of the faucet from their config, the URLs of
+// the webhooks can reference environment variables.
+func faucetAlerts(conf config.FaucetAlerts) (alerts cosmosfaucet.Alerts, err error) {
+	if conf.Interval != "" {
+		if alerts.Interval, err = time.ParseDuration(conf.Interval); err != nil {
+			return cosmosfaucet.Alerts{}, fmt.Errorf("%s: %s", err, conf.Interval)
+		}
+	}
+
+	if alerts.MinBalances, err = sdk.ParseCoinsNormalized(strings.Join(conf.MinBalances, ",")); err != nil {
+		return cosmosfaucet.Alerts{}, fmt.Errorf("faucet alerts min_balances: %w", err)
+	}
+
+	if conf.MaxErrorRate < 0 || conf.MaxErrorRate > 1 {
+		return cosmosfaucet.Alerts{}, fmt.Errorf("faucet alerts max_error_rate must be between 0 and 1: %v", conf.MaxErrorRate)
+	}
+	alerts.MaxErrorRate = conf.MaxErrorRate
+
+	for _, w := range conf.Webhooks {
+		kind := cosmosfaucet.WebhookKind(w.Type)
+		switch kind {
+		case "":
+			kind = cosmosfaucet.WebhookGeneric
+		case cosmosfaucet.WebhookGeneric, cosmosfaucet.WebhookSlack, cosmosfaucet.WebhookDiscord:
+		default:
+			return cosmosfaucet.Alerts{}, fmt.Errorf("faucet alerts webhook type %q is not supported", w.Type)
+		}
+
+		url := os.ExpandEnv(w.URL)
+		if url == "" {
+			return cosmosfaucet.Alerts{}, errors.New("faucet alerts webhook url is required")
+		}
+		alerts.Webhooks = append(alerts.Webhooks, cosmosfaucet.Webhook{URL: url, Kind: kind})
+	}
+
+	return alerts, nil
+}
+
+// faucetChallenge returns the challenge of the faucet from its config, the
+// CAPTCHA secret can reference environment variables.
+func faucetChallenge(conf config.FaucetChallenge) cosmosfaucet.Challenge {
+	return cosmosfaucet.Challenge{
+		Captcha:        cosmosfaucet.CaptchaProvider(conf.Captcha),
+		CaptchaSiteKey: conf.CaptchaSiteKey,
+		CaptchaSecret:  os.ExpandEnv(conf.CaptchaSecret),
+		PoWDifficulty:  conf.PoWDifficulty,
+	}
 }
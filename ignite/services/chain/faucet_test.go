@@ -0,0 +1,82 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig/config"
+	"github.com/ignite/cli/ignite/pkg/cosmosfaucet"
+)
+
+func TestFaucetCoins(t *testing.T) {
+	const ibcDenom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+	options, err := faucetCoins(config.Faucet{
+		Coins:    []string{"10token", "5" + ibcDenom},
+		CoinsMax: []string{"100" + ibcDenom},
+	})
+	require.NoError(t, err)
+	require.Len(t, options, 2)
+
+	cases := []struct {
+		name string
+		conf config.Faucet
+		err  string
+	}{
+		{
+			name: "duplicated denom",
+			conf: config.Faucet{Coins: []string{"10token", "5token"}},
+			err:  "faucet coin denom token is duplicated",
+		},
+		{
+			name: "max of a denom not distributed",
+			conf: config.Faucet{Coins: []string{"10token"}, CoinsMax: []string{"100" + ibcDenom}},
+			err:  "faucet coins_max denom " + ibcDenom + " is not in the faucet coins",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := faucetCoins(tt.conf)
+			require.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestFaucetOptions(t *testing.T) {
+	options, err := FaucetOptions(config.Faucet{
+		Coins: []string{"10token"},
+		Batch: config.FaucetBatch{Interval: "5s"},
+	}, "", "")
+	require.NoError(t, err)
+	// the coin, the access lists, the batches, the alerts, the limits, the challenge and the web UI
+	require.Len(t, options, 7)
+
+	_, err = FaucetOptions(config.Faucet{Batch: config.FaucetBatch{Interval: "5"}}, "", "")
+	require.EqualError(t, err, `time: missing unit in duration "5": 5`)
+}
+
+func TestFaucetAlerts(t *testing.T) {
+	t.Setenv("SLACK_WEBHOOK", "https://hooks.slack.com/services/T000/B000/XXX")
+
+	alerts, err := faucetAlerts(config.FaucetAlerts{
+		MinBalances: []string{"1000token"},
+		Webhooks: []config.FaucetWebhook{
+			{URL: "$SLACK_WEBHOOK", Type: "slack"},
+			{URL: "https://example.com/alerts"},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "1000token", alerts.MinBalances.String())
+	require.Equal(t, []cosmosfaucet.Webhook{
+		{URL: "https://hooks.slack.com/services/T000/B000/XXX", Kind: cosmosfaucet.WebhookSlack},
+		{URL: "https://example.com/alerts", Kind: cosmosfaucet.WebhookGeneric},
+	}, alerts.Webhooks)
+
+	_, err = faucetAlerts(config.FaucetAlerts{Webhooks: []config.FaucetWebhook{{URL: "https://example.com", Type: "teams"}}})
+	require.EqualError(t, err, `faucet alerts webhook type "teams" is not supported`)
+
+	_, err = faucetAlerts(config.FaucetAlerts{MaxErrorRate: 2})
+	require.EqualError(t, err, "faucet alerts max_error_rate must be between 0 and 1: 2")
+}
@@ -0,0 +1,315 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/chaincmd"
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/ignite/pkg/checksum"
+	"github.com/ignite/cli/ignite/pkg/cmdrunner"
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/step"
+	"github.com/ignite/cli/ignite/pkg/fixture"
+	"github.com/ignite/cli/ignite/pkg/xexec"
+	"github.com/ignite/cli/ignite/pkg/xurl"
+)
+
+// defaultScenario is the scenario script of the fixtures exported without one.
+const defaultScenario = `#!/bin/sh
+# Scenario of the fixture, run once the node is ready.
+#
+# FIXTURE_BINARY, FIXTURE_HOME, FIXTURE_CHAIN_ID and FIXTURE_NODE are set to the
+# binary, the home, the chain ID and the RPC address of the node. Replace these
+# commands with the txs and the queries that reproduce the issue.
+set -e
+
+"$FIXTURE_BINARY" status --node "$FIXTURE_NODE"
+`
+
+// privValidatorState is the state of the signatures of the validator of a chain
+// that has not produced any block yet.
+const privValidatorState = `{
+  "height": "0",
+  "round": 0,
+  "step": 0
+}
+`
+
+// fixtureSkippedFiles are the files of the home of a chain that are specific to
+// a machine or to a run, they are not part of the fixtures.
+var fixtureSkippedFiles = map[string]struct{}{
+	"config/addrbook.json":           {},
+	"config/write-file-atomic-nonce": {},
+}
+
+// ExportFixture writes the archive of the fixture of the chain: the genesis, the
+// keys and the config of its home, its config file, the hash of its binary and
+// the scenario script, the default scenario is used when scenarioPath is empty.
+// The chain must be initialized and use the test keyring backend. The state of
+// the blocks is not exported, the fixture starts the chain from its genesis.
+func (c *Chain) ExportFixture(w io.Writer, scenarioPath string) error {
+	backend, err := c.KeyringBackend()
+	if err != nil {
+		return err
+	}
+	if backend != chaincmd.KeyringBackendTest {
+		return fmt.Errorf("the keys of the %s keyring backend cannot be exported, use the %s backend", backend, chaincmd.KeyringBackendTest)
+	}
+
+	home, err := c.Home()
+	if err != nil {
+		return err
+	}
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(genesisPath); errors.Is(err, os.ErrNotExist) {
+		return errors.New("the chain is not initialized, run \"ignite chain init\" first")
+	}
+
+	id, err := c.ID()
+	if err != nil {
+		return err
+	}
+	binary, err := c.Binary()
+	if err != nil {
+		return err
+	}
+	binaryHash, err := checksum.Binary(xexec.TryResolveAbsPath(binary))
+	if err != nil {
+		return fmt.Errorf("the binary of the chain is not built: %w", err)
+	}
+
+	var files []fixture.File
+	for _, dir := range []string{"config", "keyring-test"} {
+		dirFiles, err := homeFixtureFiles(home, dir)
+		if err != nil {
+			return err
+		}
+		files = append(files, dirFiles...)
+	}
+	files = append(files, fixture.File{
+		Name:    fixture.HomeDir + "/data/priv_validator_state.json",
+		Content: []byte(privValidatorState),
+	})
+
+	conf, err := os.ReadFile(c.ConfigPath())
+	if err != nil {
+		return err
+	}
+	files = append(files, fixture.File{Name: fixture.ConfigFile, Content: conf})
+
+	scenario := []byte(defaultScenario)
+	if scenarioPath != "" {
+		if scenario, err = os.ReadFile(scenarioPath); err != nil {
+			return err
+		}
+	}
+	files = append(files, fixture.File{Name: fixture.ScenarioFile, Content: scenario, Executable: true})
+
+	return fixture.Write(w, fixture.Manifest{
+		ChainID:    id,
+		Binary:     binary,
+		BinaryHash: binaryHash,
+	}, files)
+}
+
+// homeFixtureFiles returns the files of a directory of the home of a chain.
+func homeFixtureFiles(home, dir string) ([]fixture.File, error) {
+	var files []fixture.File
+	err := filepath.WalkDir(filepath.Join(home, dir), func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		name, err := filepath.Rel(home, path)
+		if err != nil {
+			return err
+		}
+		name = filepath.ToSlash(name)
+		if _, ok := fixtureSkippedFiles[name]; ok {
+			return nil
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		files = append(files, fixture.File{
+			Name:    fixture.HomeDir + "/" + name,
+			Content: content,
+		})
+		return nil
+	})
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	return files, err
+}
+
+type fixtureRunOptions struct {
+	binary        string
+	skipHashCheck bool
+	keepRunning   bool
+	nodeStdout    io.Writer
+	nodeStderr    io.Writer
+	stdout        io.Writer
+	stderr        io.Writer
+}
+
+// FixtureRunOption configures the run of a fixture.
+type FixtureRunOption func(*fixtureRunOptions)
+
+// FixtureWithBinary runs the fixture with the binary at path instead of the
+// binary of the fixture found in PATH.
+func FixtureWithBinary(path string) FixtureRunOption {
+	return func(o *fixtureRunOptions) {
+		o.binary = path
+	}
+}
+
+// FixtureSkipHashCheck runs the fixture with a binary that has a different hash
+// than the binary of the fixture.
+func FixtureSkipHashCheck() FixtureRunOption {
+	return func(o *fixtureRunOptions) {
+		o.skipHashCheck = true
+	}
+}
+
+// FixtureKeepRunning keeps the node running once the scenario is run, until the
+// context is canceled.
+func FixtureKeepRunning() FixtureRunOption {
+	return func(o *fixtureRunOptions) {
+		o.keepRunning = true
+	}
+}
+
+// FixtureWithNodeOutput sets the outputs of the node, they are discarded by default.
+func FixtureWithNodeOutput(stdout, stderr io.Writer) FixtureRunOption {
+	return func(o *fixtureRunOptions) {
+		o.nodeStdout = stdout
+		o.nodeStderr = stderr
+	}
+}
+
+// FixtureWithScenarioOutput sets the outputs of the scenario, they are discarded by default.
+func FixtureWithScenarioOutput(stdout, stderr io.Writer) FixtureRunOption {
+	return func(o *fixtureRunOptions) {
+		o.stdout = stdout
+		o.stderr = stderr
+	}
+}
+
+// RunFixture starts the node of a fixture extracted in dir and runs the scenario
+// of the fixture once the node is ready. The node is stopped when the scenario
+// ends, an error is returned when the scenario fails. The hash of the binary
+// must match the hash of the binary of the fixture.
+func RunFixture(ctx context.Context, dir string, options ...FixtureRunOption) error {
+	o := fixtureRunOptions{
+		nodeStdout: io.Discard,
+		nodeStderr: io.Discard,
+		stdout:     io.Discard,
+		stderr:     io.Discard,
+	}
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	m, err := fixture.ReadManifest(dir)
+	if err != nil {
+		return err
+	}
+	if o.binary == "" {
+		o.binary = m.Binary
+	}
+	binary, err := xexec.ResolveAbsPath(o.binary)
+	if err != nil {
+		return fmt.Errorf("binary %s of the fixture not found: %w", o.binary, err)
+	}
+	if !o.skipHashCheck {
+		hash, err := checksum.Binary(binary)
+		if err != nil {
+			return err
+		}
+		if hash != m.BinaryHash {
+			return fmt.Errorf("the hash of %s is %s, the fixture was exported with a binary of hash %s", binary, hash, m.BinaryHash)
+		}
+	}
+
+	conf, err := chainconfig.ParseFile(filepath.Join(dir, fixture.ConfigFile))
+	if err != nil {
+		return err
+	}
+	servers, err := conf.Validators[0].GetServers()
+	if err != nil {
+		return err
+	}
+	nodeAddr, err := xurl.TCP(servers.RPC.Address)
+	if err != nil {
+		return err
+	}
+
+	home := filepath.Join(dir, fixture.HomeDir)
+	runner, err := chaincmdrunner.New(ctx, chaincmd.New(
+		binary,
+		chaincmd.WithHome(home),
+		chaincmd.WithChainID(m.ChainID),
+	), chaincmdrunner.Stdout(o.nodeStdout), chaincmdrunner.Stderr(o.nodeStderr))
+	if err != nil {
+		return err
+	}
+
+	nodeCtx, stopNode := context.WithCancel(ctx)
+	defer stopNode()
+
+	g, gCtx := errgroup.WithContext(nodeCtx)
+	g.Go(func() error {
+		err := runner.Start(gCtx)
+		if nodeCtx.Err() != nil {
+			// The node is stopped once the scenario is run
+			return nil
+		}
+		if err == nil {
+			err = errors.New("the node stopped")
+		}
+		return err
+	})
+	g.Go(func() error {
+		if err := waitUntilNodeIsReady(gCtx, servers.RPC.Address, servers.GRPC.Address); err != nil {
+			return err
+		}
+
+		err := cmdrunner.New().Run(gCtx, step.New(
+			step.Exec("sh", fixture.ScenarioFile),
+			step.Workdir(dir),
+			step.Env(
+				cmdrunner.Env("FIXTURE_BINARY", binary),
+				cmdrunner.Env("FIXTURE_HOME", home),
+				cmdrunner.Env("FIXTURE_CHAIN_ID", m.ChainID),
+				cmdrunner.Env("FIXTURE_NODE", nodeAddr),
+			),
+			step.Stdout(o.stdout),
+			step.Stderr(o.stderr),
+		))
+		if err != nil {
+			return fmt.Errorf("scenario failed: %w", err)
+		}
+
+		if o.keepRunning {
+			<-gCtx.Done()
+		}
+		stopNode()
+		return nil
+	})
+
+	return g.Wait()
+}
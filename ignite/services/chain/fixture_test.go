@@ -0,0 +1,36 @@
+package chain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHomeFixtureFiles(t *testing.T) {
+	home := t.TempDir()
+	for name, content := range map[string]string{
+		"config/genesis.json":  `{"chain_id":"mars"}`,
+		"config/addrbook.json": `{"addrs":[]}`,
+		"config/gentx/a.json":  `{}`,
+	} {
+		path := filepath.Join(home, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+
+	files, err := homeFixtureFiles(home, "config")
+	require.NoError(t, err)
+
+	var names []string
+	for _, f := range files {
+		names = append(names, f.Name)
+	}
+	require.ElementsMatch(t, []string{"home/config/genesis.json", "home/config/gentx/a.json"}, names)
+
+	// The missing directories of the home are skipped
+	files, err = homeFixtureFiles(home, "keyring-test")
+	require.NoError(t, err)
+	require.Empty(t, files)
+}
@@ -19,11 +19,25 @@ const (
 )
 
 type generateOptions struct {
-	isGoEnabled       bool
-	isTSClientEnabled bool
-	isVuexEnabled     bool
-	isOpenAPIEnabled  bool
-	tsClientPath      string
+	isGoEnabled          bool
+	isTSClientEnabled    bool
+	isVuexEnabled        bool
+	isComposablesEnabled bool
+	isHooksEnabled       bool
+	isOpenAPIEnabled     bool
+	isPythonEnabled      bool
+	isRustEnabled        bool
+	isDartEnabled        bool
+	isDescriptorsEnabled bool
+	tsClientPath         string
+	composablesPath      string
+	hooksPath            string
+	pythonClientPath     string
+	rustClientPath       string
+	dartClientPath       string
+	descriptorsPath      string
+
+	descriptorSetGenerators []cosmosgen.DescriptorSetGenerator
 }
 
 // GenerateTarget is a target to generate code for from proto files.
@@ -54,6 +68,28 @@ func GenerateVuex() GenerateTarget {
 	}
 }
 
+// GenerateComposables enables generating Vue 3 composables over the Typescript Client.
+// The path assigns the output path to use for the generated composables
+// overriding the configured or default path. Path can be an empty string.
+func GenerateComposables(path string) GenerateTarget {
+	return func(o *generateOptions) {
+		o.isTSClientEnabled = true
+		o.isComposablesEnabled = true
+		o.composablesPath = path
+	}
+}
+
+// GenerateHooks enables generating React hooks over the Typescript Client.
+// The path assigns the output path to use for the generated hooks
+// overriding the configured or default path. Path can be an empty string.
+func GenerateHooks(path string) GenerateTarget {
+	return func(o *generateOptions) {
+		o.isTSClientEnabled = true
+		o.isHooksEnabled = true
+		o.hooksPath = path
+	}
+}
+
 // GenerateOpenAPI enables generating OpenAPI spec for your chain.
 func GenerateOpenAPI() GenerateTarget {
 	return func(o *generateOptions) {
@@ -61,8 +97,58 @@ func GenerateOpenAPI() GenerateTarget {
 	}
 }
 
-// generateFromConfig makes code generation from proto files from the given config
-func (c *Chain) generateFromConfig(ctx context.Context, cacheStorage cache.Storage) error {
+// GeneratePythonClient enables generating proto based Python Client.
+// The path assigns the output path to use for the generated Python client
+// overriding the configured or default path. Path can be an empty string.
+func GeneratePythonClient(path string) GenerateTarget {
+	return func(o *generateOptions) {
+		o.isPythonEnabled = true
+		o.pythonClientPath = path
+	}
+}
+
+// GenerateRustClient enables generating proto based Rust Client.
+// The path assigns the output path to use for the generated Rust client
+// overriding the configured or default path. Path can be an empty string.
+func GenerateRustClient(path string) GenerateTarget {
+	return func(o *generateOptions) {
+		o.isRustEnabled = true
+		o.rustClientPath = path
+	}
+}
+
+// GenerateDartClient enables generating proto based Dart Client.
+// The path assigns the output path to use for the generated Dart client
+// overriding the configured or default path. Path can be an empty string.
+func GenerateDartClient(path string) GenerateTarget {
+	return func(o *generateOptions) {
+		o.isDartEnabled = true
+		o.dartClientPath = path
+	}
+}
+
+// GenerateDescriptors enables generating the FileDescriptorSet of the proto
+// files of the chain modules and of the modules of its dependencies, like the
+// Cosmos SDK modules. Non absolute paths are relative to the app directory.
+func GenerateDescriptors(path string) GenerateTarget {
+	return func(o *generateOptions) {
+		o.isDescriptorsEnabled = true
+		o.descriptorsPath = path
+	}
+}
+
+// GenerateFromDescriptorSet enables generating code with a generator that
+// receives the descriptor set of the proto files, like the code generation
+// targets of plugins.
+func GenerateFromDescriptorSet(generator cosmosgen.DescriptorSetGenerator) GenerateTarget {
+	return func(o *generateOptions) {
+		o.descriptorSetGenerators = append(o.descriptorSetGenerators, generator)
+	}
+}
+
+// GenerateFromConfig makes code generation from proto files for the Go code and
+// the targets of the config of the chain.
+func (c *Chain) GenerateFromConfig(ctx context.Context, cacheStorage cache.Storage) error {
 	conf, err := c.Config()
 	if err != nil {
 		return err
@@ -70,19 +156,45 @@ func (c *Chain) generateFromConfig(ctx context.Context, cacheStorage cache.Stora
 
 	var additionalTargets []GenerateTarget
 
+	// the Typescript client and the frontend code that uses it are not
+	// generated when the chain is served without watching them
+	isTSClientEnabled := !c.serving || conf.Services.IsTSClientWatchEnabled()
+
 	// parse config for additional target
-	if p := conf.Client.Typescript.Path; p != "" {
+	if p := conf.Client.Typescript.Path; p != "" && isTSClientEnabled {
 		additionalTargets = append(additionalTargets, GenerateTSClient(p))
 	}
 
-	if conf.Client.Vuex.Path != "" {
+	if conf.Client.Vuex.Path != "" && isTSClientEnabled {
 		additionalTargets = append(additionalTargets, GenerateVuex())
 	}
 
+	if p := conf.Client.Composables.Path; p != "" && isTSClientEnabled {
+		additionalTargets = append(additionalTargets, GenerateComposables(p))
+	}
+
+	if p := conf.Client.Hooks.Path; p != "" && isTSClientEnabled {
+		additionalTargets = append(additionalTargets, GenerateHooks(p))
+	}
+
 	if conf.Client.OpenAPI.Path != "" {
 		additionalTargets = append(additionalTargets, GenerateOpenAPI())
 	}
 
+	if p := conf.Client.Python.Path; p != "" {
+		additionalTargets = append(additionalTargets, GeneratePythonClient(p))
+	}
+
+	if p := conf.Client.Rust.Path; p != "" {
+		additionalTargets = append(additionalTargets, GenerateRustClient(p))
+	}
+
+	if p := conf.Client.Dart.Path; p != "" {
+		additionalTargets = append(additionalTargets, GenerateDartClient(p))
+	}
+
+	additionalTargets = append(additionalTargets, c.options.generateTargets...)
+
 	return c.Generate(ctx, cacheStorage, GenerateGo(), additionalTargets...)
 }
 
@@ -114,13 +226,31 @@ func (c *Chain) Generate(
 		cosmosgen.IncludeDirs(conf.Build.Proto.ThirdPartyPaths),
 	}
 
+	if len(conf.Build.Proto.Modules) > 0 {
+		moduleOptions := make(map[string]cosmosgen.ModuleOptions)
+		for _, m := range conf.Build.Proto.Modules {
+			moduleOptions[m.Package] = cosmosgen.ModuleOptions{
+				IncludeDirs:    m.IncludePaths,
+				GoOptions:      m.GoOptions,
+				OpenAPIOptions: m.OpenAPIOptions,
+				TSOptions:      m.TSOptions,
+			}
+		}
+		options = append(options, cosmosgen.WithModuleOptions(moduleOptions))
+	}
+
 	if targetOptions.isGoEnabled {
 		options = append(options, cosmosgen.WithGoGeneration(c.app.ImportPath))
 	}
 
 	enableThirdPartyModuleCodegen := !c.protoBuiltAtLeastOnce && c.options.isThirdPartyModuleCodegenEnabled
 
-	var openAPIPath, tsClientPath, vuexPath string
+	var (
+		openAPIPath, tsClientPath, vuexPath string
+		composablesPath, hooksPath          string
+		pythonClientPath, rustClientPath    string
+		dartClientPath, descriptorsPath     string
+	)
 
 	if targetOptions.isTSClientEnabled {
 		tsClientPath = targetOptions.tsClientPath
@@ -165,20 +295,99 @@ func (c *Chain) Generate(
 		)
 	}
 
-	if targetOptions.isOpenAPIEnabled {
-		openAPIPath = conf.Client.OpenAPI.Path
-		if openAPIPath == "" {
-			openAPIPath = defaultOpenAPIPath
+	if targetOptions.isComposablesEnabled {
+		composablesPath = targetOptions.composablesPath
+		if composablesPath == "" {
+			composablesPath = chainconfig.ComposablesPath(conf)
+		}
+
+		if !filepath.IsAbs(composablesPath) {
+			composablesPath = filepath.Join(c.app.Path, composablesPath)
+		}
+
+		options = append(options,
+			cosmosgen.WithComposablesGeneration(
+				cosmosgen.ComposableModulePath(composablesPath),
+				composablesPath,
+			),
+		)
+	}
+
+	if targetOptions.isHooksEnabled {
+		hooksPath = targetOptions.hooksPath
+		if hooksPath == "" {
+			hooksPath = chainconfig.HooksPath(conf)
 		}
 
-		// Non absolute OpenAPI paths must be treated as relative to the app directory
-		if !filepath.IsAbs(openAPIPath) {
-			openAPIPath = filepath.Join(c.app.Path, openAPIPath)
+		if !filepath.IsAbs(hooksPath) {
+			hooksPath = filepath.Join(c.app.Path, hooksPath)
 		}
 
+		options = append(options,
+			cosmosgen.WithHooksGeneration(
+				cosmosgen.ComposableModulePath(hooksPath),
+				hooksPath,
+			),
+		)
+	}
+
+	if targetOptions.isOpenAPIEnabled {
+		openAPIPath = c.openAPIPath(conf)
 		options = append(options, cosmosgen.WithOpenAPIGeneration(openAPIPath))
 	}
 
+	if targetOptions.isPythonEnabled {
+		pythonClientPath = targetOptions.pythonClientPath
+		if pythonClientPath == "" {
+			pythonClientPath = chainconfig.PythonClientPath(conf)
+		}
+
+		if !filepath.IsAbs(pythonClientPath) {
+			pythonClientPath = filepath.Join(c.app.Path, pythonClientPath)
+		}
+
+		options = append(options, cosmosgen.WithPythonClientGeneration(pythonClientPath))
+	}
+
+	if targetOptions.isRustEnabled {
+		rustClientPath = targetOptions.rustClientPath
+		if rustClientPath == "" {
+			rustClientPath = chainconfig.RustClientPath(conf)
+		}
+
+		if !filepath.IsAbs(rustClientPath) {
+			rustClientPath = filepath.Join(c.app.Path, rustClientPath)
+		}
+
+		options = append(options, cosmosgen.WithRustClientGeneration(rustClientPath))
+	}
+
+	if targetOptions.isDartEnabled {
+		dartClientPath = targetOptions.dartClientPath
+		if dartClientPath == "" {
+			dartClientPath = chainconfig.DartClientPath(conf)
+		}
+
+		if !filepath.IsAbs(dartClientPath) {
+			dartClientPath = filepath.Join(c.app.Path, dartClientPath)
+		}
+
+		options = append(options, cosmosgen.WithDartClientGeneration(dartClientPath))
+	}
+
+	if targetOptions.isDescriptorsEnabled {
+		descriptorsPath = targetOptions.descriptorsPath
+		if !filepath.IsAbs(descriptorsPath) {
+			descriptorsPath = filepath.Join(c.app.Path, descriptorsPath)
+		}
+
+		options = append(options, cosmosgen.WithDescriptorsGeneration(descriptorsPath))
+	}
+
+	if len(targetOptions.descriptorSetGenerators) > 0 {
+		options = append(options, cosmosgen.WithDescriptorSetGeneration(targetOptions.descriptorSetGenerators...))
+	}
+
 	if err := cosmosgen.Generate(ctx, cacheStorage, c.app.Path, conf.Build.Proto.Path, options...); err != nil {
 		return &CannotBuildAppError{err}
 	}
@@ -202,6 +411,22 @@ func (c *Chain) Generate(
 			)
 		}
 
+		if targetOptions.isComposablesEnabled {
+			c.ev.Send(
+				fmt.Sprintf("Vue composables path: %s", composablesPath),
+				events.Icon(icons.Bullet),
+				events.ProgressFinish(),
+			)
+		}
+
+		if targetOptions.isHooksEnabled {
+			c.ev.Send(
+				fmt.Sprintf("React hooks path: %s", hooksPath),
+				events.Icon(icons.Bullet),
+				events.ProgressFinish(),
+			)
+		}
+
 		if targetOptions.isOpenAPIEnabled {
 			c.ev.Send(
 				fmt.Sprintf("OpenAPI path: %s", openAPIPath),
@@ -209,6 +434,38 @@ func (c *Chain) Generate(
 				events.ProgressFinish(),
 			)
 		}
+
+		if targetOptions.isPythonEnabled {
+			c.ev.Send(
+				fmt.Sprintf("Python client path: %s", pythonClientPath),
+				events.Icon(icons.Bullet),
+				events.ProgressFinish(),
+			)
+		}
+
+		if targetOptions.isRustEnabled {
+			c.ev.Send(
+				fmt.Sprintf("Rust client path: %s", rustClientPath),
+				events.Icon(icons.Bullet),
+				events.ProgressFinish(),
+			)
+		}
+
+		if targetOptions.isDartEnabled {
+			c.ev.Send(
+				fmt.Sprintf("Dart client path: %s", dartClientPath),
+				events.Icon(icons.Bullet),
+				events.ProgressFinish(),
+			)
+		}
+
+		if targetOptions.isDescriptorsEnabled {
+			c.ev.Send(
+				fmt.Sprintf("Descriptor set path: %s", descriptorsPath),
+				events.Icon(icons.Bullet),
+				events.ProgressFinish(),
+			)
+		}
 	}
 
 	return nil
@@ -221,3 +478,18 @@ func (c Chain) joinGeneratedPath(rootPath string) string {
 
 	return filepath.Join(c.app.Path, rootPath, "generated")
 }
+
+// openAPIPath returns the path of the OpenAPI spec generated for the chain.
+func (c *Chain) openAPIPath(conf *chainconfig.Config) string {
+	path := conf.Client.OpenAPI.Path
+	if path == "" {
+		path = defaultOpenAPIPath
+	}
+
+	// Non absolute OpenAPI paths must be treated as relative to the app directory
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(c.app.Path, path)
+	}
+
+	return path
+}
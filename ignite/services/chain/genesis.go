@@ -0,0 +1,354 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ignite/cli/ignite/pkg/chaincmd"
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/ignite/pkg/workerpool"
+	"github.com/ignite/cli/ignite/pkg/xexec"
+)
+
+// genesisAppStateField is the field of the genesis with the state of the modules.
+const genesisAppStateField = "app_state"
+
+var (
+	// genesisErrorRe matches the error printed by the validate-genesis command.
+	genesisErrorRe = regexp.MustCompile(`(?m)^Error: (.+)$`)
+
+	// genesisFileErrorRe matches the prefix of the validation errors with the
+	// path of the genesis file, it's removed because the file is temporary.
+	genesisFileErrorRe = regexp.MustCompile(`^error (?:validating genesis file|unmarshalling genesis doc) \S+: `)
+)
+
+// GenesisError is an invalid section of a genesis.
+type GenesisError struct {
+	// Module is the name of the module of the section, it's empty when the
+	// error is in the genesis document and not in the state of a module.
+	Module string
+
+	// Fields are the top level fields of the section of the module that are
+	// invalid, it's empty when the section is invalid as a whole.
+	Fields []string
+
+	// Message is the error of the validation.
+	Message string
+}
+
+func (e GenesisError) Error() string {
+	switch {
+	case e.Module == "":
+		return e.Message
+	case len(e.Fields) > 0:
+		return fmt.Sprintf("%s.%s: %s", e.Module, strings.Join(e.Fields, ", "), e.Message)
+	default:
+		return fmt.Sprintf("%s: %s", e.Module, e.Message)
+	}
+}
+
+// ValidateGenesis validates the genesis of the chain, or the genesis at path
+// when it's not empty, with the ValidateGenesis of the modules of the binary of
+// the chain. The binary must be built.
+//
+// The section of each module is validated with the default sections of the
+// other modules when the genesis is invalid, the errors of the modules are then
+// reported separately with the top level fields of their invalid sections.
+func (c *Chain) ValidateGenesis(ctx context.Context, path string) ([]GenesisError, error) {
+	if path == "" {
+		genesisPath, err := c.GenesisPath()
+		if err != nil {
+			return nil, err
+		}
+		path = genesisPath
+	}
+
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("genesis %s not found, run \"ignite chain init\" first", path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	binary, err := c.Binary()
+	if err != nil {
+		return nil, err
+	}
+	if !xexec.IsCommandAvailable(xexec.TryResolveAbsPath(binary)) {
+		return nil, fmt.Errorf("the binary %s of the chain is not built, run \"ignite chain build\" first", binary)
+	}
+
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = commands.ValidateGenesisFile(ctx, path)
+	if err == nil {
+		return nil, nil
+	}
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	genesisMessage := genesisErrorMessage(err)
+
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(content, &doc); err != nil {
+		return []GenesisError{{Message: err.Error()}}, nil
+	}
+	appState, err := genesisSections(doc[genesisAppStateField])
+	if err != nil {
+		return []GenesisError{{Module: genesisAppStateField, Message: err.Error()}}, nil
+	}
+
+	tmp, err := os.MkdirTemp("", "ignite-genesis-validate")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmp)
+
+	defaults, err := defaultAppState(ctx, commands, filepath.Join(tmp, "home"))
+	if err != nil {
+		return nil, err
+	}
+
+	v := genesisValidator{
+		commands: commands,
+		doc:      doc,
+		dir:      tmp,
+	}
+
+	// The genesis document is validated first with the default state of the
+	// modules, the modules can't be validated when the document is invalid
+	message, err := v.validate(ctx, defaults)
+	if err != nil {
+		return nil, err
+	}
+	if message != "" {
+		return []GenesisError{{Message: message}}, nil
+	}
+
+	var (
+		genesisErrs []GenesisError
+		mu          sync.Mutex
+		pool        = workerpool.New(ctx)
+	)
+	for module := range defaults {
+		module := module
+
+		pool.Go(func(ctx context.Context) error {
+			genesisErr, err := v.validateModule(ctx, defaults, appState, module)
+			if err != nil || genesisErr == nil {
+				return err
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			genesisErrs = append(genesisErrs, *genesisErr)
+			return nil
+		})
+	}
+	if err := pool.Wait(); err != nil {
+		return nil, err
+	}
+
+	// The modules can be valid separately but not together, like the
+	// validators of the staking module and the gentxs of the genutil module
+	if len(genesisErrs) == 0 {
+		genesisErrs = append(genesisErrs, GenesisError{Message: genesisMessage})
+	}
+
+	sort.Slice(genesisErrs, func(i, j int) bool {
+		return genesisErrs[i].Module < genesisErrs[j].Module
+	})
+
+	return genesisErrs, nil
+}
+
+// genesisValidator validates genesis documents with different module states.
+type genesisValidator struct {
+	commands chaincmdrunner.Runner
+	doc      map[string]json.RawMessage
+	dir      string
+}
+
+// validate validates the genesis document with appState and returns the error
+// message of the validation, it's empty when the genesis is valid.
+func (v genesisValidator) validate(ctx context.Context, appState map[string]json.RawMessage) (string, error) {
+	doc := make(map[string]json.RawMessage, len(v.doc))
+	for name, value := range v.doc {
+		doc[name] = value
+	}
+
+	state, err := json.Marshal(appState)
+	if err != nil {
+		return "", err
+	}
+	doc[genesisAppStateField] = state
+
+	content, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+
+	// Each validation has its own file because the modules are validated concurrently
+	f, err := os.CreateTemp(v.dir, "genesis-*.json")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(content); err != nil {
+		f.Close()
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+
+	err = v.commands.ValidateGenesisFile(ctx, f.Name())
+	if err == nil {
+		return "", nil
+	}
+	if ctx.Err() != nil {
+		return "", ctx.Err()
+	}
+
+	return genesisErrorMessage(err), nil
+}
+
+// validateModule validates the section of module in appState with the default
+// sections of the other modules. The top level fields of an invalid section
+// are replaced one by one with their default values to find the invalid ones.
+func (v genesisValidator) validateModule(
+	ctx context.Context,
+	defaults, appState map[string]json.RawMessage,
+	module string,
+) (*GenesisError, error) {
+	section, ok := appState[module]
+	state := withGenesisSection(defaults, module, section, ok)
+
+	message, err := v.validate(ctx, state)
+	if err != nil || message == "" {
+		return nil, err
+	}
+
+	genesisErr := &GenesisError{
+		Module:  module,
+		Message: message,
+	}
+	if !ok {
+		genesisErr.Message = "section is missing: " + message
+		return genesisErr, nil
+	}
+
+	fields, err := genesisSections(section)
+	if err != nil {
+		return genesisErr, nil
+	}
+	defaultFields, err := genesisSections(defaults[module])
+	if err != nil {
+		return genesisErr, nil
+	}
+
+	names := make(map[string]struct{})
+	for name := range fields {
+		names[name] = struct{}{}
+	}
+	for name := range defaultFields {
+		names[name] = struct{}{}
+	}
+
+	for name := range names {
+		defaultField, ok := defaultFields[name]
+		fixed, err := json.Marshal(withGenesisSection(fields, name, defaultField, ok))
+		if err != nil {
+			return nil, err
+		}
+
+		message, err := v.validate(ctx, withGenesisSection(defaults, module, fixed, true))
+		if err != nil {
+			return nil, err
+		}
+		if message == "" {
+			genesisErr.Fields = append(genesisErr.Fields, name)
+		}
+	}
+	sort.Strings(genesisErr.Fields)
+
+	return genesisErr, nil
+}
+
+// defaultAppState returns the default state of the modules of the chain from
+// the genesis created by the init command of its binary in home.
+func defaultAppState(ctx context.Context, commands chaincmdrunner.Runner, home string) (map[string]json.RawMessage, error) {
+	runner, err := chaincmdrunner.New(ctx, commands.Cmd().Copy(chaincmd.WithHome(home)))
+	if err != nil {
+		return nil, err
+	}
+	if err := runner.Init(ctx, "validator"); err != nil {
+		return nil, err
+	}
+
+	content, err := os.ReadFile(filepath.Join(home, "config", "genesis.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(content, &doc); err != nil {
+		return nil, err
+	}
+
+	return genesisSections(doc[genesisAppStateField])
+}
+
+// genesisSections decodes a JSON object by field name.
+func genesisSections(value json.RawMessage) (map[string]json.RawMessage, error) {
+	sections := make(map[string]json.RawMessage)
+	if len(value) == 0 {
+		return sections, nil
+	}
+	if err := json.Unmarshal(value, &sections); err != nil {
+		return nil, err
+	}
+
+	return sections, nil
+}
+
+// withGenesisSection returns a copy of sections with the section of name set
+// to value, the section is removed when ok is false.
+func withGenesisSection(sections map[string]json.RawMessage, name string, value json.RawMessage, ok bool) map[string]json.RawMessage {
+	s := make(map[string]json.RawMessage, len(sections))
+	for n, v := range sections {
+		s[n] = v
+	}
+
+	if ok {
+		s[name] = value
+	} else {
+		delete(s, name)
+	}
+
+	return s
+}
+
+// genesisErrorMessage returns the message of the error of the validate-genesis
+// command without the path of the genesis file.
+func genesisErrorMessage(err error) string {
+	message := strings.TrimSpace(err.Error())
+	if m := genesisErrorRe.FindStringSubmatch(message); m != nil {
+		message = m[1]
+	}
+
+	return genesisFileErrorRe.ReplaceAllString(message, "")
+}
@@ -0,0 +1,64 @@
+package chain
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisErrorMessage(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "validation error",
+			err: errors.New(`Error: error validating genesis file /tmp/genesis-123.json: invalid denom: 1stake
+: exit status 1`),
+			want: "invalid denom: 1stake",
+		},
+		{
+			name: "unmarshal error",
+			err:  errors.New("Error: error unmarshalling genesis doc /tmp/genesis.json: unexpected end of JSON input\n: exit status 1"),
+			want: "unexpected end of JSON input",
+		},
+		{
+			name: "unknown output",
+			err:  errors.New("signal: killed"),
+			want: "signal: killed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, genesisErrorMessage(tt.err))
+		})
+	}
+}
+
+func TestWithGenesisSection(t *testing.T) {
+	sections := map[string]json.RawMessage{
+		"bank":    json.RawMessage(`{"balances":[]}`),
+		"staking": json.RawMessage(`{}`),
+	}
+
+	s := withGenesisSection(sections, "bank", json.RawMessage(`{}`), true)
+	require.Equal(t, json.RawMessage(`{}`), s["bank"])
+	require.Equal(t, json.RawMessage(`{"balances":[]}`), sections["bank"], "sections must not change")
+
+	s = withGenesisSection(sections, "staking", nil, false)
+	require.NotContains(t, s, "staking")
+	require.Contains(t, sections, "staking", "sections must not change")
+}
+
+func TestGenesisError(t *testing.T) {
+	require.Equal(t, "invalid chain id", GenesisError{Message: "invalid chain id"}.Error())
+	require.Equal(t, "bank: invalid", GenesisError{Module: "bank", Message: "invalid"}.Error())
+	require.Equal(
+		t,
+		"bank.balances, supply: invalid",
+		GenesisError{Module: "bank", Fields: []string{"balances", "supply"}, Message: "invalid"}.Error(),
+	)
+}
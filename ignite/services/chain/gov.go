@@ -0,0 +1,71 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+)
+
+// ParamChange is a change of a param of a module.
+type ParamChange struct {
+	Subspace string      `json:"subspace"`
+	Key      string      `json:"key"`
+	Value    interface{} `json:"value"`
+}
+
+// paramChangeProposal is the content of a param change proposal file.
+type paramChangeProposal struct {
+	Title       string        `json:"title"`
+	Description string        `json:"description"`
+	Changes     []ParamChange `json:"changes"`
+	Deposit     string        `json:"deposit"`
+}
+
+// ChangeParams submits a param change proposal from the validator account of the
+// running chain and votes yes on it. It returns the ID of the proposal, the params
+// are changed when the voting period of the proposal ends.
+func (c *Chain) ChangeParams(ctx context.Context, title, deposit string, changes ...ParamChange) (uint64, error) {
+	conf, err := c.Config()
+	if err != nil {
+		return 0, err
+	}
+
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	proposal, err := json.Marshal(paramChangeProposal{
+		Title:       title,
+		Description: title,
+		Changes:     changes,
+		Deposit:     deposit,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	f, err := os.CreateTemp("", "proposal-*.json")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(proposal); err != nil {
+		f.Close()
+		return 0, err
+	}
+	if err := f.Close(); err != nil {
+		return 0, err
+	}
+
+	// The validator of the development chain holds the voting power
+	validator := conf.Validators[0].Name
+	id, err := commands.SubmitParamChangeProposal(ctx, validator, f.Name())
+	if err != nil {
+		return 0, err
+	}
+
+	return id, commands.GovVote(ctx, validator, id, chaincmdrunner.VoteYes)
+}
@@ -2,17 +2,23 @@ package chain
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 
+	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/imdario/mergo"
 
 	"github.com/ignite/cli/ignite/chainconfig"
 	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
 	"github.com/ignite/cli/ignite/pkg/cliui/view/accountview"
 	"github.com/ignite/cli/ignite/pkg/confile"
+	"github.com/ignite/cli/ignite/pkg/ethsecp256k1"
 	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/randstr"
 )
 
 const (
@@ -94,20 +100,51 @@ func (c *Chain) InitAccounts(ctx context.Context, conf *chainconfig.Config) erro
 
 	c.ev.Send("Initializing accounts...", events.ProgressUpdate())
 
-	var accounts accountview.Accounts
+	bookPath, err := c.addressBookPath()
+	if err != nil {
+		return err
+	}
+
+	book, err := LoadAddressBook(bookPath)
+	if err != nil {
+		return err
+	}
+
+	var (
+		accounts accountview.Accounts
+		changes  []AddressChange
+	)
 
 	// add accounts from config into genesis
 	for _, account := range conf.Accounts {
 		var generatedAccount chaincmdrunner.Account
 		accountAddress := account.Address
 
-		// If the account doesn't provide an address, we create one
-		if accountAddress == "" {
-			generatedAccount, err = commands.AddAccount(ctx, account.Name, account.Mnemonic, account.CoinType)
+		// If the account doesn't provide an address, we import its Ethereum
+		// key or we create one
+		if accountAddress == "" && account.EthKey != "" {
+			generatedAccount, err = importEthKey(ctx, commands, account.Name, account.EthKey)
+			if err != nil {
+				return err
+			}
+			accountAddress = generatedAccount.Address
+		} else if accountAddress == "" {
+			mnemonic := account.Mnemonic
+
+			// use the previous mnemonic to derive the same keys when the account doesn't define one
+			if mnemonic == "" && c.options.reuseAccountKeys {
+				mnemonic, _ = book.Mnemonic(account.Name)
+			}
+
+			generatedAccount, err = commands.AddAccount(ctx, account.Name, mnemonic, account.CoinType)
 			if err != nil {
 				return err
 			}
 			accountAddress = generatedAccount.Address
+
+			if change, ok := book.Record(account.Name, accountAddress, generatedAccount.Mnemonic); ok {
+				changes = append(changes, change)
+			}
 		}
 
 		coins := strings.Join(account.Coins, ",")
@@ -115,7 +152,7 @@ func (c *Chain) InitAccounts(ctx context.Context, conf *chainconfig.Config) erro
 			return err
 		}
 
-		if account.Address == "" {
+		if account.Address == "" && account.EthKey == "" {
 			accounts = append(accounts, accountview.NewAccount(
 				generatedAccount.Name,
 				accountAddress,
@@ -128,6 +165,23 @@ func (c *Chain) InitAccounts(ctx context.Context, conf *chainconfig.Config) erro
 
 	c.ev.SendView(accounts)
 
+	if err := book.Save(bookPath); err != nil {
+		return err
+	}
+
+	for _, change := range changes {
+		c.ev.Send(
+			fmt.Sprintf(
+				"Account %s address changed from %s to %s, update the frontends and tests that reference the old address\n"+
+					"or use --reuse-keys to keep the same account keys after a reset",
+				colors.Info(change.Name),
+				change.PrevAddress,
+				change.Address,
+			),
+			events.Icon(icons.NotOK),
+		)
+	}
+
 	_, err = c.IssueGentx(ctx, createValidatorFromConfig(conf))
 
 	return err
@@ -175,7 +229,11 @@ func (c Chain) updateGenesisFile(data map[string]interface{}) error {
 	if err != nil {
 		return err
 	}
+	return mergeGenesisFile(path, data)
+}
 
+// mergeGenesisFile merges data in the genesis file at path.
+func mergeGenesisFile(path string, data map[string]interface{}) error {
 	genesis := make(map[string]interface{})
 	cf := confile.New(confile.DefaultJSONEncodingCreator, path)
 	if err := cf.Load(&genesis); err != nil {
@@ -186,11 +244,7 @@ func (c Chain) updateGenesisFile(data map[string]interface{}) error {
 		return err
 	}
 
-	if err = cf.Save(genesis); err != nil {
-		return err
-	}
-
-	return nil
+	return cf.Save(genesis)
 }
 
 type Validator struct {
@@ -206,6 +260,10 @@ type Validator struct {
 	Identity                string
 	Website                 string
 	SecurityContact         string
+
+	// Memo is the memo of the gentx, the peer address of the node of the
+	// validator.
+	Memo string
 }
 
 // Account represents an account in the chain.
@@ -260,3 +318,31 @@ func createValidatorFromConfig(conf *chainconfig.Config) (validator Validator) {
 	}
 	return validator
 }
+
+// importEthKey imports the hex encoded private key of an Ethereum account into
+// the keyring of the chain as an eth_secp256k1 key.
+func importEthKey(ctx context.Context, commands chaincmdrunner.Runner, name, key string) (chaincmdrunner.Account, error) {
+	privKey, err := ethsecp256k1.PrivKeyFromHex(key)
+	if err != nil {
+		return chaincmdrunner.Account{}, fmt.Errorf("account %s: %w", name, err)
+	}
+
+	// keys import command of chain CLI requires that the key file is encrypted with a passphrase of at least 8 characters
+	passphrase := randstr.Runes(32)
+	armored := crypto.EncryptArmorPrivKey(privKey, passphrase, ethsecp256k1.KeyType)
+
+	keyFile, err := os.CreateTemp("", "")
+	if err != nil {
+		return chaincmdrunner.Account{}, err
+	}
+	defer os.Remove(keyFile.Name())
+
+	if _, err := keyFile.WriteString(armored); err != nil {
+		return chaincmdrunner.Account{}, err
+	}
+	if err := keyFile.Close(); err != nil {
+		return chaincmdrunner.Account{}, err
+	}
+
+	return commands.ImportAccount(ctx, name, keyFile.Name(), passphrase)
+}
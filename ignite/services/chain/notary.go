@@ -0,0 +1,106 @@
+package chain
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/notary"
+)
+
+const notaryDirName = "notary"
+
+// NotaryKeyPaths are the paths of the PEM encoded project key that signs the
+// artifacts of a chain.
+type NotaryKeyPaths struct {
+	Key, PublicKey string
+}
+
+// NotaryKeyPaths returns the paths of the project key of the chain, it's kept in
+// Ignite's config directory so it isn't removed when the chain home is reset.
+func (c *Chain) NotaryKeyPaths() (NotaryKeyPaths, error) {
+	configDir, err := chainconfig.ConfigDirPath()
+	if err != nil {
+		return NotaryKeyPaths{}, err
+	}
+
+	dir := filepath.Join(configDir, notaryDirName, c.Name())
+	return NotaryKeyPaths{
+		Key:       filepath.Join(dir, "key.pem"),
+		PublicKey: filepath.Join(dir, "key.pub.pem"),
+	}, nil
+}
+
+// NotaryKey returns the project key of the chain, the key is created when it
+// doesn't exist yet.
+func (c *Chain) NotaryKey() (notary.Key, error) {
+	paths, err := c.NotaryKeyPaths()
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := notary.LoadKey(paths.Key)
+	if errors.Is(err, os.ErrNotExist) {
+		if key, err = notary.NewKey(); err != nil {
+			return nil, err
+		}
+		if err := key.Save(paths.Key, paths.PublicKey); err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// NotaryPublicKey returns the public key of the project key of the chain.
+func (c *Chain) NotaryPublicKey() (ed25519.PublicKey, error) {
+	paths, err := c.NotaryKeyPaths()
+	if err != nil {
+		return nil, err
+	}
+
+	return notary.LoadPublicKey(paths.PublicKey)
+}
+
+// SignArtifacts signs the artifacts with the project key of the chain and
+// returns the paths of the signature files written next to them.
+func (c *Chain) SignArtifacts(paths ...string) ([]string, error) {
+	key, err := c.NotaryKey()
+	if err != nil {
+		return nil, err
+	}
+
+	var sigPaths []string
+	for _, path := range paths {
+		sigPath, err := notary.Sign(key, path)
+		if err != nil {
+			return nil, err
+		}
+		sigPaths = append(sigPaths, sigPath)
+	}
+
+	return sigPaths, nil
+}
+
+// ReleaseArtifacts returns the paths of the tarballs and of the checksum file
+// of a release.
+func ReleaseArtifacts(releasePath string) ([]string, error) {
+	entries, err := os.ReadDir(releasePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) == notary.SignatureExt {
+			continue
+		}
+		paths = append(paths, filepath.Join(releasePath, e.Name()))
+	}
+
+	return paths, nil
+}
@@ -0,0 +1,57 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/openapiconsole"
+	"github.com/ignite/cli/ignite/pkg/xhttp"
+)
+
+const openAPISpecRoute = "/openapi.yml"
+
+// openAPIHandler serves the OpenAPI console and the spec of the chain, the
+// other requests are sent to the API so the console can try the routes of
+// the spec without cross-origin requests.
+type openAPIHandler struct {
+	console  http.Handler
+	specPath string
+	api      http.Handler
+}
+
+func (h openAPIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/":
+		h.console.ServeHTTP(w, r)
+	case openAPISpecRoute:
+		// The spec is read on each request to serve the spec generated again
+		// after changes of the proto files.
+		http.ServeFile(w, r, h.specPath)
+	default:
+		h.api.ServeHTTP(w, r)
+	}
+}
+
+// runOpenAPIServer serves the OpenAPI console of the spec generated for the chain.
+func (c *Chain) runOpenAPIServer(ctx context.Context, config *chainconfig.Config) error {
+	servers, err := config.Validators[0].GetServers()
+	if err != nil {
+		return err
+	}
+
+	api, err := newReverseProxy(servers.API.Address, nil)
+	if err != nil {
+		return fmt.Errorf("invalid api address format %s: %w", servers.API.Address, err)
+	}
+
+	return xhttp.Serve(ctx, &http.Server{
+		Addr: chainconfig.OpenAPIAddress(config),
+		Handler: openAPIHandler{
+			console:  openapiconsole.Handler(c.Name(), openAPISpecRoute),
+			specPath: c.openAPIPath(config),
+			api:      api,
+		},
+	})
+}
@@ -0,0 +1,44 @@
+package chain
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenAPIHandler(t *testing.T) {
+	specPath := filepath.Join(t.TempDir(), "openapi.yml")
+	require.NoError(t, os.WriteFile(specPath, []byte("openapi: 3.0.0\n"), 0o644))
+
+	handler := openAPIHandler{
+		console: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.Write([]byte("console"))
+		}),
+		specPath: specPath,
+		api: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("api " + r.URL.Path))
+		}),
+	}
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/", "console"},
+		{"/openapi.yml", "openapi: 3.0.0\n"},
+		{"/cosmos/bank/v1beta1/balances/cosmos1", "api /cosmos/bank/v1beta1/balances/cosmos1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			require.Equal(t, tt.want, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ignite/cli/ignite/pkg/apiparity"
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/cosmosgen"
+	"github.com/ignite/cli/ignite/pkg/xurl"
+)
+
+// ParitySet returns the queries of the modules of the app that can be checked
+// across the transports of the API, from the descriptor set of the proto files
+// of the app.
+func (c *Chain) ParitySet(ctx context.Context, cacheStorage cache.Storage) (apiparity.Set, error) {
+	var set apiparity.Set
+	err := c.Generate(ctx, cacheStorage, GenerateFromDescriptorSet(func(_ context.Context, ds cosmosgen.DescriptorSet) (err error) {
+		set, err = apiparity.NewSet(ds.Content, ds.Files)
+		return err
+	}))
+
+	return set, err
+}
+
+// ParityEndpoints returns the addresses of the gRPC server, the Tendermint RPC
+// and the REST API served by the validator of the chain.
+func (c *Chain) ParityEndpoints() (apiparity.Endpoints, error) {
+	conf, err := c.Config()
+	if err != nil {
+		return apiparity.Endpoints{}, err
+	}
+
+	servers, err := conf.Validators[0].GetServers()
+	if err != nil {
+		return apiparity.Endpoints{}, err
+	}
+
+	apiAddress := servers.API.Address
+	if envAPIAddress != "" {
+		apiAddress = envAPIAddress
+	}
+
+	apiAddress, err = xurl.HTTP(apiAddress)
+	if err != nil {
+		return apiparity.Endpoints{}, fmt.Errorf("invalid api address format: %w", err)
+	}
+
+	rpcAddress, err := xurl.HTTP(servers.RPC.Address)
+	if err != nil {
+		return apiparity.Endpoints{}, fmt.Errorf("invalid rpc address format: %w", err)
+	}
+
+	return apiparity.Endpoints{
+		GRPC: xurl.Address(servers.GRPC.Address),
+		RPC:  rpcAddress,
+		API:  apiAddress,
+	}, nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/ignite/cli/ignite/pkg/chaincmd"
 	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
 	"github.com/ignite/cli/ignite/pkg/cosmosver"
+	"github.com/ignite/cli/ignite/pkg/tendermintconfig"
 	"github.com/ignite/cli/ignite/pkg/xurl"
 )
 
@@ -45,6 +46,7 @@ func (p *stargatePlugin) Gentx(ctx context.Context, runner chaincmdrunner.Runner
 		chaincmd.GentxWithIdentity(v.Identity),
 		chaincmd.GentxWithWebsite(v.Website),
 		chaincmd.GentxWithSecurityContact(v.SecurityContact),
+		chaincmd.GentxWithMemo(v.Memo),
 	)
 }
 
@@ -78,8 +80,10 @@ func (p *stargatePlugin) appTOML(homePath string, cfg *chainconfig.Config) error
 	}
 
 	// Set default config values
-	config.Set("api.enable", true)
+	config.Set("api.enable", cfg.Services.IsAPIEnabled())
 	config.Set("api.enabled-unsafe-cors", true)
+	config.Set("grpc-web.enable", true)
+	config.Set("grpc-web.enable-unsafe-cors", true)
 	config.Set("rpc.cors_allowed_origins", []string{"*"})
 
 	// Update config values with the validator's Cosmos SDK app config
@@ -95,6 +99,12 @@ func (p *stargatePlugin) appTOML(homePath string, cfg *chainconfig.Config) error
 	gas := sdktypes.NewInt64Coin(staked.Denom, 0)
 	config.Set("minimum-gas-prices", gas.String())
 
+	// The node takes the snapshots of the state served to the nodes that join with state sync
+	if validator.StateSync != nil {
+		config.Set("state-sync.snapshot-interval", int64(validator.StateSync.SnapshotInterval))
+		config.Set("state-sync.snapshot-keep-recent", int64(validator.StateSync.GetSnapshotKeepRecent()))
+	}
+
 	file, err := os.OpenFile(path, os.O_RDWR|os.O_TRUNC, 0o644)
 	if err != nil {
 		return err
@@ -136,7 +146,23 @@ func (p *stargatePlugin) configTOML(homePath string, cfg *chainconfig.Config) er
 	config.Set("consensus.timeout_propose", "1s")
 
 	// Update config values with the validator's Tendermint config
-	updateTomlTreeValues(config, validator.Config)
+	version, err := tendermintconfig.Detect(p.app.Path)
+	if err != nil {
+		return err
+	}
+	tmConfig, err := tendermintconfig.Parse(validator.Config, version)
+	if err != nil {
+		return fmt.Errorf("invalid validator %s config: %w", validator.Name, err)
+	}
+	for key, value := range tmConfig.Values() {
+		config.Set(key, value)
+	}
+	updateTomlTreeValues(config, tmConfig.Extra)
+
+	// The node waits for the connection of the remote signer instead of signing with its key
+	if validator.Signer != nil {
+		config.Set("priv_validator_laddr", validator.Signer.GetAddress())
+	}
 
 	// Make sure the addresses have the protocol prefix
 	config.Set("rpc.laddr", rpcAddr)
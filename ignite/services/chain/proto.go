@@ -0,0 +1,129 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+
+	"github.com/ignite/cli/ignite/pkg/buf"
+)
+
+// defaultBranches are the branches of the proto files to check the breaking
+// changes against by default, the first one that exists is used.
+var defaultBranches = []string{"main", "master"}
+
+// ProtoPath returns the absolute path of the proto files of the chain.
+func (c *Chain) ProtoPath() (string, error) {
+	conf, err := c.Config()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(c.app.Path, conf.Build.Proto.Path), nil
+}
+
+// LintProto runs the buf linters on the proto files of the chain.
+func (c *Chain) LintProto(ctx context.Context) error {
+	path, err := c.bufModulePath()
+	if err != nil {
+		return err
+	}
+
+	return buf.Lint(ctx, path)
+}
+
+// CheckProtoBreaking checks the breaking changes of the proto files of the chain
+// against the buf input of against. The proto files of the default branch of
+// the git repository of the chain are used when against is empty.
+func (c *Chain) CheckProtoBreaking(ctx context.Context, against string) error {
+	path, err := c.bufModulePath()
+	if err != nil {
+		return err
+	}
+
+	if against == "" {
+		if against, err = defaultBreakingAgainst(path); err != nil {
+			return err
+		}
+	}
+
+	return buf.Breaking(ctx, path, against)
+}
+
+// UpdateProtoDependencies pins the dependencies of the proto files of the
+// chain in the buf lock file when they are not pinned yet, the proto files of
+// the pinned dependencies are then resolved from the Buf Schema Registry.
+// Nothing is done when the proto files of the chain are not a buf module.
+func (c *Chain) UpdateProtoDependencies(ctx context.Context) error {
+	path, err := c.ProtoPath()
+	if err != nil || !buf.HasConfig(path) {
+		return err
+	}
+
+	deps, err := buf.Dependencies(path)
+	if err != nil || len(deps) > 0 {
+		return err
+	}
+
+	return buf.ModUpdate(ctx, path)
+}
+
+// bufModulePath returns the path of the proto files of the chain when they
+// are a buf module.
+func (c *Chain) bufModulePath() (string, error) {
+	path, err := c.ProtoPath()
+	if err != nil {
+		return "", err
+	}
+
+	if !buf.HasConfig(path) {
+		return "", fmt.Errorf(
+			"the proto files of the chain have no %s, add it to manage them with buf",
+			filepath.Join(path, buf.ConfigFile),
+		)
+	}
+
+	return path, nil
+}
+
+// defaultBreakingAgainst returns the buf input of the proto files in path in
+// the default branch of their git repository.
+func defaultBreakingAgainst(path string) (string, error) {
+	repo, err := git.PlainOpenWithOptions(path, &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return "", fmt.Errorf("cannot find the git repository of the proto files: %w", err)
+	}
+
+	wt, err := repo.Worktree()
+	if err != nil {
+		return "", err
+	}
+
+	root := wt.Filesystem.Root()
+	gitDir, err := filepath.Rel(path, filepath.Join(root, git.GitDirName))
+	if err != nil {
+		return "", err
+	}
+	subdir, err := filepath.Rel(root, path)
+	if err != nil {
+		return "", err
+	}
+
+	for _, branch := range defaultBranches {
+		_, err := repo.Reference(plumbing.NewBranchReferenceName(branch), false)
+		if errors.Is(err, plumbing.ErrReferenceNotFound) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%s#branch=%s,subdir=%s", filepath.ToSlash(gitDir), branch, filepath.ToSlash(subdir)), nil
+	}
+
+	return "", errors.New("the git repository has no main branch, set the input to check the breaking changes against")
+}
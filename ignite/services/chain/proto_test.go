@@ -0,0 +1,27 @@
+package chain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/xgit"
+)
+
+func TestDefaultBreakingAgainst(t *testing.T) {
+	root := t.TempDir()
+	protoPath := filepath.Join(root, "proto")
+	require.NoError(t, os.MkdirAll(protoPath, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(protoPath, "buf.yaml"), []byte("version: v1\n"), 0o644))
+
+	_, err := defaultBreakingAgainst(protoPath)
+	require.Error(t, err, "the proto files are not in a git repository")
+
+	require.NoError(t, xgit.InitAndCommit(root))
+
+	against, err := defaultBreakingAgainst(protoPath)
+	require.NoError(t, err)
+	require.Equal(t, "../.git#branch=master,subdir=proto", against)
+}
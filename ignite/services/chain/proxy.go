@@ -0,0 +1,164 @@
+package chain
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"strings"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/xhttp"
+	"github.com/ignite/cli/ignite/pkg/xurl"
+)
+
+const (
+	proxyAuthRealm = "ignite"
+
+	contentTypeGRPC    = "application/grpc"
+	contentTypeGRPCWeb = "application/grpc-web"
+)
+
+// proxyHandler routes the requests to the API, gRPC and gRPC-Web servers of the chain
+// depending on their content type.
+type proxyHandler struct {
+	api, grpc, grpcWeb http.Handler
+}
+
+func (p proxyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	contentType := r.Header.Get("Content-Type")
+
+	switch {
+	case strings.HasPrefix(contentType, contentTypeGRPCWeb):
+		p.grpcWeb.ServeHTTP(w, r)
+	case strings.HasPrefix(contentType, contentTypeGRPC):
+		p.grpc.ServeHTTP(w, r)
+	default:
+		p.api.ServeHTTP(w, r)
+	}
+}
+
+// runProxyServer serves the development proxy, with TLS when tlsConfig is not nil.
+func (c *Chain) runProxyServer(ctx context.Context, config *chainconfig.Config, tlsConfig *tls.Config) error {
+	handler, err := newProxyHandler(config)
+	if err != nil {
+		return err
+	}
+
+	return xhttp.Serve(ctx, &http.Server{
+		Addr:      config.Proxy.Address,
+		Handler:   h2c.NewHandler(handler, &http2.Server{}),
+		TLSConfig: tlsConfig,
+	})
+}
+
+func newProxyHandler(config *chainconfig.Config) (http.Handler, error) {
+	servers, err := config.Validators[0].GetServers()
+	if err != nil {
+		return nil, err
+	}
+
+	api, err := newReverseProxy(servers.API.Address, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid api address format %s: %w", servers.API.Address, err)
+	}
+
+	grpcWeb, err := newReverseProxy(servers.GRPCWeb.Address, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid grpc-web address format %s: %w", servers.GRPCWeb.Address, err)
+	}
+
+	// gRPC requires HTTP/2, the gRPC server doesn't use TLS
+	grpc, err := newReverseProxy(servers.GRPC.Address, &http2.Transport{
+		AllowHTTP: true,
+		DialTLS: func(network, addr string, _ *tls.Config) (net.Conn, error) {
+			return net.Dial(network, addr)
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid grpc address format %s: %w", servers.GRPC.Address, err)
+	}
+
+	var handler http.Handler = proxyHandler{
+		api:     api,
+		grpc:    grpc,
+		grpcWeb: grpcWeb,
+	}
+
+	if config.Proxy.Auth.IsEnabled() {
+		credentials, err := proxyCredentials(config)
+		if err != nil {
+			return nil, err
+		}
+
+		handler = xhttp.AuthHandler(handler, credentials, proxyAuthRealm)
+	}
+
+	// The rate limit applies before the authentication to protect the proxy
+	// from clients guessing the credentials too.
+	if rl := config.Proxy.RateLimit; rl.IsEnabled() {
+		handler = xhttp.RateLimitHandler(handler, xhttp.RateLimit{
+			RPS:         rl.RPS,
+			Burst:       rl.Burst,
+			ExemptPaths: rl.Exempt,
+		})
+	}
+
+	return handler, nil
+}
+
+func newReverseProxy(address string, transport http.RoundTripper) (*httputil.ReverseProxy, error) {
+	addr, err := xurl.HTTP(address)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := url.Parse(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	proxy.Transport = transport
+
+	// Flush immediately to support streaming responses
+	proxy.FlushInterval = -1
+
+	return proxy, nil
+}
+
+// proxyCredentials returns the proxy credentials with the environment variables expanded.
+func proxyCredentials(config *chainconfig.Config) (xhttp.Credentials, error) {
+	auth := config.Proxy.Auth
+	credentials := xhttp.Credentials{
+		Users: make(map[string]string),
+	}
+
+	for _, t := range auth.Tokens {
+		token := os.ExpandEnv(t)
+		if token == "" {
+			return credentials, fmt.Errorf("proxy token %q is empty, make sure the environment variable is set", t)
+		}
+
+		credentials.Tokens = append(credentials.Tokens, token)
+	}
+
+	for _, u := range auth.Users {
+		name, password := os.ExpandEnv(u.Name), os.ExpandEnv(u.Password)
+		if name == "" || password == "" {
+			return credentials, errors.New("proxy user name and password can't be empty, make sure the environment variables are set")
+		}
+
+		credentials.Users[name] = password
+	}
+
+	return credentials, nil
+}
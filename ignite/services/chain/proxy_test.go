@@ -0,0 +1,66 @@
+package chain
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/chainconfig/config"
+)
+
+func TestProxyHandler(t *testing.T) {
+	var served string
+	serve := func(name string) http.Handler {
+		return http.HandlerFunc(func(http.ResponseWriter, *http.Request) { served = name })
+	}
+	handler := proxyHandler{
+		api:     serve("api"),
+		grpc:    serve("grpc"),
+		grpcWeb: serve("grpc-web"),
+	}
+
+	tests := []struct {
+		contentType string
+		want        string
+	}{
+		{"application/json", "api"},
+		{"", "api"},
+		{"application/grpc", "grpc"},
+		{"application/grpc+proto", "grpc"},
+		{"application/grpc-web", "grpc-web"},
+		{"application/grpc-web-text", "grpc-web"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.contentType, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/", nil)
+			r.Header.Set("Content-Type", tt.contentType)
+
+			handler.ServeHTTP(httptest.NewRecorder(), r)
+
+			require.Equal(t, tt.want, served)
+		})
+	}
+}
+
+func TestProxyCredentials(t *testing.T) {
+	t.Setenv("PROXY_TOKEN", "secret-token")
+	t.Setenv("PROXY_PASSWORD", "secret-password")
+
+	conf := &chainconfig.Config{}
+	conf.Proxy.Auth = config.ProxyAuth{
+		Tokens: []string{"token", "${PROXY_TOKEN}"},
+		Users:  []config.ProxyUser{{Name: "alice", Password: "${PROXY_PASSWORD}"}},
+	}
+
+	credentials, err := proxyCredentials(conf)
+	require.NoError(t, err)
+	require.Equal(t, []string{"token", "secret-token"}, credentials.Tokens)
+	require.Equal(t, map[string]string{"alice": "secret-password"}, credentials.Users)
+
+	conf.Proxy.Auth.Tokens = []string{"${PROXY_UNDEFINED_TOKEN}"}
+	_, err = proxyCredentials(conf)
+	require.Error(t, err)
+}
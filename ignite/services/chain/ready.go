@@ -0,0 +1,68 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/pkg/httpstatuschecker"
+	"github.com/ignite/cli/ignite/pkg/xurl"
+)
+
+// nodeReadyMaxInterval is the maximum time to wait between node readiness checks.
+const nodeReadyMaxInterval = 3 * time.Second
+
+// ErrNodeNotReady is returned when a node readiness check doesn't pass.
+var ErrNodeNotReady = errors.New("blockchain node is not ready")
+
+// waitUntilNodeIsReady blocks until the node RPC and gRPC servers accept requests.
+// The checks are retried with an exponential backoff until the context is done.
+func waitUntilNodeIsReady(ctx context.Context, rpcAddr, grpcAddr string) error {
+	b := backoff.NewExponentialBackOff()
+	b.MaxInterval = nodeReadyMaxInterval
+	b.MaxElapsedTime = 0
+
+	check := func() error {
+		if err := checkNodeReady(ctx, rpcAddr, grpcAddr); err != nil {
+			if errors.Is(err, ErrNodeNotReady) {
+				return err
+			}
+			return backoff.Permanent(err)
+		}
+		return nil
+	}
+
+	err := backoff.Retry(check, backoff.WithContext(b, ctx))
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+// checkNodeReady checks once that the node RPC is healthy and that its gRPC server is listening.
+func checkNodeReady(ctx context.Context, rpcAddr, grpcAddr string) error {
+	addr, err := xurl.HTTP(rpcAddr)
+	if err != nil {
+		return fmt.Errorf("invalid rpc address format %s: %w", rpcAddr, err)
+	}
+
+	ok, err := httpstatuschecker.Check(ctx, fmt.Sprintf("%s/health", addr))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.Wrap(ErrNodeNotReady, "rpc is not responding")
+	}
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", xurl.Address(grpcAddr))
+	if err != nil {
+		return errors.Wrap(ErrNodeNotReady, "grpc is not listening")
+	}
+
+	return conn.Close()
+}
@@ -0,0 +1,54 @@
+package chain
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitUntilNodeIsReady(t *testing.T) {
+	grpc, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer grpc.Close()
+
+	var healthy atomic.Bool
+	rpc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/health" || !healthy.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer rpc.Close()
+
+	t.Run("node not ready", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		time.AfterFunc(200*time.Millisecond, cancel)
+
+		err := waitUntilNodeIsReady(ctx, rpc.URL, grpc.Addr().String())
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("node becomes ready", func(t *testing.T) {
+		time.AfterFunc(100*time.Millisecond, func() { healthy.Store(true) })
+
+		err := waitUntilNodeIsReady(context.Background(), rpc.URL, grpc.Addr().String())
+		require.NoError(t, err)
+	})
+
+	t.Run("grpc not listening", func(t *testing.T) {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		addr := l.Addr().String()
+		l.Close()
+
+		err = checkNodeReady(context.Background(), rpc.URL, addr)
+		require.ErrorIs(t, err, ErrNodeNotReady)
+	})
+}
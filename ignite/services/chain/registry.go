@@ -0,0 +1,316 @@
+package chain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pelletier/go-toml"
+
+	"github.com/ignite/cli/ignite/pkg/chainregistry"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+)
+
+// defaultSlip44 is the coin type of the accounts of the Cosmos SDK chains.
+const defaultSlip44 = 118
+
+// RegistryOptions are the fields of the chain in the registry that can't be
+// read from the chain.
+type RegistryOptions struct {
+	// NetworkType is the network type of the chain, mainnet, testnet or devnet.
+	NetworkType string
+
+	// Status is the status of the chain, live by default.
+	Status string
+
+	// PrettyName is the name of the chain displayed by the wallets and the
+	// explorers.
+	PrettyName string
+
+	// Version is the recommended version of the binary of the chain.
+	Version string
+
+	// GenesisURL is the URL of the genesis of the chain.
+	GenesisURL string
+
+	// RPC, REST and GRPC are the addresses of the public endpoints of the chain.
+	RPC, REST, GRPC []string
+}
+
+// registryGenesis is the part of a genesis read for the registry.
+type registryGenesis struct {
+	ChainID  string `json:"chain_id"`
+	AppState struct {
+		Bank struct {
+			Balances []struct {
+				Address string    `json:"address"`
+				Coins   sdk.Coins `json:"coins"`
+			} `json:"balances"`
+			DenomMetadata []struct {
+				Description string `json:"description"`
+				DenomUnits  []struct {
+					Denom    string   `json:"denom"`
+					Exponent uint32   `json:"exponent"`
+					Aliases  []string `json:"aliases"`
+				} `json:"denom_units"`
+				Base    string `json:"base"`
+				Display string `json:"display"`
+				Name    string `json:"name"`
+				Symbol  string `json:"symbol"`
+			} `json:"denom_metadata"`
+		} `json:"bank"`
+		Staking struct {
+			Params struct {
+				BondDenom string `json:"bond_denom"`
+			} `json:"params"`
+		} `json:"staking"`
+	} `json:"app_state"`
+}
+
+// Registry returns the chain.json and the assetlist.json of the chain for the
+// Cosmos chain registry. The chain ID, the bech32 prefix and the denoms are
+// read from the genesis of the chain and the fee tokens from the minimum gas
+// prices of its app.toml, the chain must be initialized.
+func (c *Chain) Registry(o RegistryOptions) (chainregistry.Chain, chainregistry.AssetList, error) {
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return chainregistry.Chain{}, chainregistry.AssetList{}, err
+	}
+	genesis, err := os.ReadFile(genesisPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return chainregistry.Chain{}, chainregistry.AssetList{}, fmt.Errorf("genesis %s not found, run \"ignite chain init\" first", genesisPath)
+	}
+	if err != nil {
+		return chainregistry.Chain{}, chainregistry.AssetList{}, err
+	}
+	appTOMLPath, err := c.AppTOMLPath()
+	if err != nil {
+		return chainregistry.Chain{}, chainregistry.AssetList{}, err
+	}
+	gasPrices, err := minimumGasPrices(appTOMLPath)
+	if err != nil {
+		return chainregistry.Chain{}, chainregistry.AssetList{}, err
+	}
+	binary, err := c.Binary()
+	if err != nil {
+		return chainregistry.Chain{}, chainregistry.AssetList{}, err
+	}
+	home, err := c.Home()
+	if err != nil {
+		return chainregistry.Chain{}, chainregistry.AssetList{}, err
+	}
+	slip44, err := c.slip44()
+	if err != nil {
+		return chainregistry.Chain{}, chainregistry.AssetList{}, err
+	}
+
+	chain, assets, err := registryFromGenesis(genesis, gasPrices)
+	if err != nil {
+		return chainregistry.Chain{}, chainregistry.AssetList{}, err
+	}
+
+	chain.ChainName = registryChainName(c.Name(), o.NetworkType)
+	chain.NetworkType = o.NetworkType
+	chain.Status = o.Status
+	if chain.Status == "" {
+		chain.Status = chainregistry.StatusLive
+	}
+	chain.PrettyName = o.PrettyName
+	chain.DaemonName = binary
+	chain.NodeHome = registryNodeHome(home)
+	chain.Slip44 = slip44
+	chain.Codebase = chainregistry.Codebase{
+		GitRepo:            registryGitRepo(c.app.ImportPath),
+		RecommendedVersion: o.Version,
+	}
+	if o.Version != "" {
+		chain.Codebase.CompatibleVersions = []string{o.Version}
+	}
+	if o.GenesisURL != "" {
+		chain.Codebase.Genesis = &chainregistry.Genesis{GenesisURL: o.GenesisURL}
+	}
+	chain.APIs = chainregistry.APIs{
+		RPC:  registryEndpoints(o.RPC),
+		REST: registryEndpoints(o.REST),
+		GRPC: registryEndpoints(o.GRPC),
+	}
+	assets.ChainName = chain.ChainName
+
+	return chain, assets, chain.Validate()
+}
+
+// registryFromGenesis returns the chain and the assets of the genesis, the
+// fee tokens are the tokens of the gas prices or the bond denom when there
+// are no gas prices.
+func registryFromGenesis(genesis []byte, gasPrices sdk.DecCoins) (chainregistry.Chain, chainregistry.AssetList, error) {
+	var g registryGenesis
+	if err := json.Unmarshal(genesis, &g); err != nil {
+		return chainregistry.Chain{}, chainregistry.AssetList{}, fmt.Errorf("invalid genesis: %w", err)
+	}
+	bondDenom := g.AppState.Staking.Params.BondDenom
+	if bondDenom == "" {
+		return chainregistry.Chain{}, chainregistry.AssetList{}, errors.New("the genesis has no bond denom")
+	}
+	if len(g.AppState.Bank.Balances) == 0 {
+		return chainregistry.Chain{}, chainregistry.AssetList{}, errors.New("the genesis has no accounts to read the bech32 prefix from")
+	}
+	prefix, err := cosmosutil.GetAddressPrefix(g.AppState.Bank.Balances[0].Address)
+	if err != nil {
+		return chainregistry.Chain{}, chainregistry.AssetList{}, err
+	}
+
+	chain := chainregistry.Chain{
+		ChainID:      g.ChainID,
+		Bech32Prefix: prefix,
+		Staking: chainregistry.Staking{
+			StakingTokens: []chainregistry.StakingToken{{Denom: bondDenom}},
+		},
+	}
+	for _, price := range gasPrices {
+		chain.Fees.FeeTokens = append(chain.Fees.FeeTokens, chainregistry.FeeToken{
+			Denom:            price.Denom,
+			FixedMinGasPrice: price.Amount.MustFloat64(),
+		})
+	}
+	if len(chain.Fees.FeeTokens) == 0 {
+		chain.Fees.FeeTokens = []chainregistry.FeeToken{{Denom: bondDenom}}
+	}
+
+	// The denoms with a metadata are described by their metadata, the other
+	// denoms of the genesis have a single unit.
+	var assets chainregistry.AssetList
+	described := make(map[string]bool)
+	for _, m := range g.AppState.Bank.DenomMetadata {
+		asset := chainregistry.Asset{
+			Description: m.Description,
+			Base:        m.Base,
+			Name:        m.Name,
+			Display:     m.Display,
+			Symbol:      m.Symbol,
+			TypeAsset:   chainregistry.TypeAssetSDKCoin,
+		}
+		for _, u := range m.DenomUnits {
+			asset.DenomUnits = append(asset.DenomUnits, chainregistry.DenomUnit{
+				Denom:    u.Denom,
+				Exponent: u.Exponent,
+				Aliases:  u.Aliases,
+			})
+		}
+		assets.Assets = append(assets.Assets, asset)
+		described[m.Base] = true
+	}
+
+	denoms := map[string]bool{bondDenom: true}
+	for _, b := range g.AppState.Bank.Balances {
+		for _, coin := range b.Coins {
+			denoms[coin.Denom] = true
+		}
+	}
+	for _, token := range chain.Fees.FeeTokens {
+		denoms[token.Denom] = true
+	}
+	var undescribed []string
+	for denom := range denoms {
+		// The IBC denoms are registered by the assets of their chain.
+		if !described[denom] && !strings.HasPrefix(denom, "ibc/") {
+			undescribed = append(undescribed, denom)
+		}
+	}
+	sort.Strings(undescribed)
+	for _, denom := range undescribed {
+		assets.Assets = append(assets.Assets, chainregistry.Asset{
+			DenomUnits: []chainregistry.DenomUnit{{Denom: denom}},
+			Base:       denom,
+			Name:       denom,
+			Display:    denom,
+			Symbol:     strings.ToUpper(denom),
+			TypeAsset:  chainregistry.TypeAssetSDKCoin,
+		})
+	}
+
+	return chain, assets, nil
+}
+
+// slip44 returns the coin type of the accounts of the config.
+func (c *Chain) slip44() (uint32, error) {
+	conf, err := c.Config()
+	if err != nil {
+		return 0, err
+	}
+	for _, account := range conf.Accounts {
+		if account.CoinType == "" {
+			continue
+		}
+		coinType, err := strconv.ParseUint(account.CoinType, 10, 32)
+		if err != nil {
+			return 0, fmt.Errorf("invalid coin type of account %s: %w", account.Name, err)
+		}
+		return uint32(coinType), nil
+	}
+	return defaultSlip44, nil
+}
+
+// minimumGasPrices returns the minimum gas prices of the app.toml at path.
+func minimumGasPrices(path string) (sdk.DecCoins, error) {
+	config, err := toml.LoadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	value, _ := config.Get("minimum-gas-prices").(string)
+	if value == "" {
+		return nil, nil
+	}
+	prices, err := sdk.ParseDecCoins(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid minimum gas prices in %s: %w", path, err)
+	}
+	return prices, nil
+}
+
+// registryChainName returns the name of the chain in the registry, the names
+// of the testnets and the devnets end with their network type.
+func registryChainName(name, networkType string) string {
+	name = strings.ToLower(name)
+	if networkType == chainregistry.NetworkTypeMainnet || strings.HasSuffix(name, networkType) {
+		return name
+	}
+	return name + networkType
+}
+
+// registryNodeHome returns the home of the node relative to the home of the
+// user when it's in the home of the user.
+func registryNodeHome(home string) string {
+	userHome, err := os.UserHomeDir()
+	if err != nil {
+		return home
+	}
+	rel, err := filepath.Rel(userHome, home)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return home
+	}
+	return "$HOME/" + filepath.ToSlash(rel)
+}
+
+// registryGitRepo returns the URL of the repository of the Go module path of
+// the chain, it's empty when the module path is not a repository URL.
+func registryGitRepo(importPath string) string {
+	host := strings.SplitN(importPath, "/", 2)[0]
+	if !strings.Contains(host, ".") {
+		return ""
+	}
+	return "https://" + importPath
+}
+
+func registryEndpoints(addresses []string) []chainregistry.Endpoint {
+	var endpoints []chainregistry.Endpoint
+	for _, address := range addresses {
+		endpoints = append(endpoints, chainregistry.Endpoint{Address: address})
+	}
+	return endpoints
+}
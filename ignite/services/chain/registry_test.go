@@ -0,0 +1,63 @@
+package chain
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/chainregistry"
+)
+
+func TestRegistryFromGenesis(t *testing.T) {
+	genesis := []byte(`{
+  "chain_id": "mars-1",
+  "app_state": {
+    "bank": {
+      "balances": [
+        {
+          "address": "mars1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqw7exjx",
+          "coins": [{"denom": "token", "amount": "10"}, {"denom": "umars", "amount": "5"}]
+        }
+      ],
+      "denom_metadata": [
+        {
+          "description": "The staking token of Mars",
+          "denom_units": [{"denom": "umars", "exponent": 0}, {"denom": "mars", "exponent": 6}],
+          "base": "umars",
+          "display": "mars",
+          "name": "Mars",
+          "symbol": "MARS"
+        }
+      ]
+    },
+    "staking": {"params": {"bond_denom": "umars"}}
+  }
+}`)
+
+	chain, assets, err := registryFromGenesis(genesis, sdk.NewDecCoins(sdk.NewDecCoinFromDec("umars", sdk.NewDecWithPrec(25, 3))))
+	require.NoError(t, err)
+	require.Equal(t, "mars-1", chain.ChainID)
+	require.Equal(t, "mars", chain.Bech32Prefix)
+	require.Equal(t, []chainregistry.StakingToken{{Denom: "umars"}}, chain.Staking.StakingTokens)
+	require.Equal(t, []chainregistry.FeeToken{{Denom: "umars", FixedMinGasPrice: 0.025}}, chain.Fees.FeeTokens)
+	require.Len(t, assets.Assets, 2)
+	require.Equal(t, "MARS", assets.Assets[0].Symbol)
+	require.Len(t, assets.Assets[0].DenomUnits, 2)
+	require.Equal(t, "token", assets.Assets[1].Base)
+	require.Equal(t, "TOKEN", assets.Assets[1].Symbol)
+
+	chain, _, err = registryFromGenesis(genesis, nil)
+	require.NoError(t, err)
+	require.Equal(t, []chainregistry.FeeToken{{Denom: "umars"}}, chain.Fees.FeeTokens)
+
+	_, _, err = registryFromGenesis([]byte(`{"app_state":{}}`), nil)
+	require.EqualError(t, err, "the genesis has no bond denom")
+}
+
+func TestRegistryChainName(t *testing.T) {
+	require.Equal(t, "mars", registryChainName("Mars", chainregistry.NetworkTypeMainnet))
+	require.Equal(t, "marstestnet", registryChainName("mars", chainregistry.NetworkTypeTestnet))
+	require.Equal(t, "marstestnet", registryChainName("marstestnet", chainregistry.NetworkTypeTestnet))
+	require.Equal(t, "marsdevnet", registryChainName("mars", chainregistry.NetworkTypeDevnet))
+}
@@ -0,0 +1,198 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/chainconfig/config"
+	"github.com/ignite/cli/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/relayer"
+	"github.com/ignite/cli/ignite/pkg/xurl"
+)
+
+const (
+	// relayerDefaultAddressPrefix is the address prefix of a chain of a path
+	// when it's not configured.
+	relayerDefaultAddressPrefix = "cosmos"
+)
+
+// runRelayer links the IBC paths declared in the config between the chain and
+// the counterparty chains and relays their packets until ctx is canceled.
+// The paths with a counterparty chain that is not reachable are skipped. The
+// links are created again when the chain state is reset.
+// The relayer errors are reported but they don't stop the chain, nil is always
+// returned.
+func (c *Chain) runRelayer(ctx context.Context, config *chainconfig.Config, stateReset bool) error {
+	if err := c.relay(ctx, config, stateReset); err != nil && ctx.Err() == nil {
+		c.ev.Send(fmt.Sprintf("IBC relayer stopped: %s", err), events.Icon(icons.NotOK))
+	}
+	return nil
+}
+
+// relay links the IBC paths and relays their packets until ctx is canceled.
+func (c *Chain) relay(ctx context.Context, config *chainconfig.Config, stateReset bool) error {
+	servers, err := config.Validators[0].GetServers()
+	if err != nil {
+		return err
+	}
+
+	// the relayer sends transactions to the node so it must
+	// wait until the node is ready to accept requests.
+	if err := waitUntilNodeIsReady(ctx, servers.RPC.Address, servers.GRPC.Address); err != nil {
+		return err
+	}
+
+	ca, err := cosmosaccount.New()
+	if err != nil {
+		return err
+	}
+
+	account := config.Relayer.Account
+	if account == "" {
+		account = cosmosaccount.DefaultAccount
+		if err := ca.EnsureDefaultAccount(); err != nil {
+			return err
+		}
+	}
+
+	r := relayer.New(ca)
+
+	var pathIDs []string
+	for _, path := range config.Relayer.Paths {
+		id, err := c.setupRelayerPath(ctx, r, config, path, account, stateReset)
+		if err != nil {
+			c.ev.Send(
+				fmt.Sprintf("IBC path to %s is not relayed: %s", path.Counterparty.RPC, err),
+				events.Icon(icons.NotOK),
+			)
+			continue
+		}
+		pathIDs = append(pathIDs, id)
+	}
+
+	if len(pathIDs) == 0 {
+		return nil
+	}
+
+	c.ev.Send(
+		fmt.Sprintf("Relaying IBC packets on paths: %s", colors.Info(strings.Join(pathIDs, ", "))),
+		events.Icon(icons.OK),
+	)
+
+	return r.StartPaths(ctx, pathIDs...)
+}
+
+// setupRelayerPath configures the chains of the path in the relayer and links
+// them, it returns the ID of the path.
+func (c *Chain) setupRelayerPath(
+	ctx context.Context,
+	r relayer.Relayer,
+	config *chainconfig.Config,
+	path config.RelayerPath,
+	account string,
+	stateReset bool,
+) (string, error) {
+	servers, err := config.Validators[0].GetServers()
+	if err != nil {
+		return "", err
+	}
+
+	// note: address format errors are caught when the node readiness is checked.
+	rpcAddr, _ := xurl.HTTP(servers.RPC.Address)
+	grpcAddr, _ := xurl.HTTP(servers.GRPC.Address)
+
+	var faucetAddr string
+	if config.Services.IsFaucetEnabled() && config.Faucet.Name != nil {
+		faucetAddr, _ = xurl.HTTP(chainconfig.FaucetHost(config))
+	}
+
+	src, _, err := r.NewChain(
+		account,
+		rpcAddr,
+		relayer.WithFaucet(faucetAddr),
+		relayer.WithGasPrice(path.GasPrice),
+		relayer.WithAddressPrefix(valueOrDefault(path.AddressPrefix, relayerDefaultAddressPrefix)),
+		relayer.WithGRPCAddress(grpcAddr),
+	)
+	if err != nil {
+		return "", err
+	}
+	if err := src.EnsureChainSetup(ctx); err != nil {
+		return "", err
+	}
+
+	counterparty := path.Counterparty
+	dst, _, err := r.NewChain(
+		account,
+		counterparty.RPC,
+		relayer.WithFaucet(counterparty.Faucet),
+		relayer.WithGasPrice(counterparty.GasPrice),
+		relayer.WithAddressPrefix(valueOrDefault(counterparty.AddressPrefix, relayerDefaultAddressPrefix)),
+		relayer.WithGRPCAddress(counterparty.GRPC),
+	)
+	if err != nil {
+		return "", err
+	}
+	if err := dst.EnsureChainSetup(ctx); err != nil {
+		return "", fmt.Errorf("counterparty chain is not reachable: %w", err)
+	}
+
+	// the relayer account is funded by the faucets when they are available,
+	// the balances are checked when the path is linked.
+	_, _ = src.TryRetrieve(ctx)
+	_, _ = dst.TryRetrieve(ctx)
+
+	id := path.ID
+	if id == "" {
+		id = relayer.PathID(src.ID, dst.ID)
+	}
+
+	if err := src.ConnectPath(dst, id, stateReset, relayerChannelOptions(path)...); err != nil {
+		return "", err
+	}
+
+	linked, err := r.GetPath(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if linked.Src.ChannelID == "" {
+		c.ev.Send(fmt.Sprintf("Linking IBC path %s...", colors.Info(id)), events.ProgressUpdate())
+
+		if err := r.LinkPaths(ctx, id); err != nil {
+			return "", err
+		}
+	}
+
+	return id, nil
+}
+
+// relayerChannelOptions returns the options of the channel of the path.
+func relayerChannelOptions(path config.RelayerPath) []relayer.ChannelOption {
+	var (
+		port    = valueOrDefault(path.Port, relayer.TransferPort)
+		version = valueOrDefault(path.Version, relayer.TransferVersion)
+		options = []relayer.ChannelOption{
+			relayer.SourcePort(port),
+			relayer.SourceVersion(version),
+			relayer.TargetPort(valueOrDefault(path.Counterparty.Port, port)),
+			relayer.TargetVersion(valueOrDefault(path.Counterparty.Version, version)),
+		}
+	)
+	if path.Ordering == config.OrderingOrdered {
+		options = append(options, relayer.Ordered())
+	}
+	return options
+}
+
+// valueOrDefault returns value or defaultValue when value is empty.
+func valueOrDefault(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
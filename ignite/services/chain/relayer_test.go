@@ -0,0 +1,34 @@
+package chain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/chainconfig/config"
+	v1 "github.com/ignite/cli/ignite/chainconfig/v1"
+	"github.com/ignite/cli/ignite/pkg/events"
+	xyaml "github.com/ignite/cli/ignite/pkg/yaml"
+)
+
+func TestRunRelayerReportsErrors(t *testing.T) {
+	ev := events.NewBus()
+	c := &Chain{ev: ev}
+	conf := &chainconfig.Config{
+		Validators: []v1.Validator{{
+			Name: "alice",
+			// the servers of the validator can't be read.
+			App: xyaml.Map{"grpc": "invalid"},
+		}},
+	}
+	conf.Relayer.Paths = []config.RelayerPath{{
+		Counterparty: config.RelayerCounterparty{RPC: "http://localhost:26659"},
+	}}
+
+	// the error is reported and the chain keeps running.
+	require.NoError(t, c.runRelayer(context.Background(), conf, false))
+	event := <-ev.Events()
+	require.Contains(t, event.Message, "IBC relayer stopped: error reading validator app servers")
+}
@@ -2,8 +2,8 @@ package chain
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
-	"net/http"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -15,6 +15,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/chainconfig/config"
 	"github.com/ignite/cli/ignite/pkg/cache"
 	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
 	"github.com/ignite/cli/ignite/pkg/cliui/colors"
@@ -26,7 +27,6 @@ import (
 	"github.com/ignite/cli/ignite/pkg/localfs"
 	"github.com/ignite/cli/ignite/pkg/xexec"
 	"github.com/ignite/cli/ignite/pkg/xfilepath"
-	"github.com/ignite/cli/ignite/pkg/xhttp"
 	"github.com/ignite/cli/ignite/pkg/xurl"
 )
 
@@ -117,6 +117,9 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 		return err
 	}
 
+	// the code generated from the config depends on the services of the config
+	c.serving = true
+
 	// make sure that config.yml exists
 	if c.options.ConfigFile != "" {
 		if _, err := os.Stat(c.options.ConfigFile); err != nil {
@@ -264,15 +267,55 @@ func (c *Chain) watchAppBackend(ctx context.Context) error {
 		watchPaths = append(watchPaths, c.ConfigPath())
 	}
 
-	return localfs.Watch(
-		ctx,
-		watchPaths,
+	options := []localfs.WatcherOption{
 		localfs.WatcherWorkdir(c.app.Path),
 		localfs.WatcherOnChange(c.refreshServe),
 		localfs.WatcherIgnoreHidden(),
 		localfs.WatcherIgnoreFolders(),
 		localfs.WatcherIgnoreExt(ignoredExts...),
-	)
+	}
+
+	conf, err := c.Config()
+	if err != nil {
+		return err
+	}
+
+	watchOptions, err := c.watcherOptions(conf.Watch)
+	if err != nil {
+		return err
+	}
+
+	return localfs.Watch(ctx, watchPaths, append(options, watchOptions...)...)
+}
+
+// watcherOptions returns the watcher options that configure how the source code changes are detected.
+func (c *Chain) watcherOptions(w config.Watch) ([]localfs.WatcherOption, error) {
+	var options []localfs.WatcherOption
+
+	interval, err := w.IntervalDuration()
+	if err != nil {
+		return nil, err
+	}
+	if interval > 0 {
+		options = append(options, localfs.WatcherPollingInterval(interval))
+	}
+
+	switch w.Mode {
+	case config.WatchModeHash:
+		options = append(options, localfs.WatcherHashPolling())
+	case config.WatchModeAuto, "":
+		// The modification times are not reliable in network or virtual file systems
+		fs, err := localfs.DetectFileSystem(c.app.Path)
+		if err != nil {
+			return nil, err
+		}
+		if fs.Remote {
+			c.ev.Sendf("%s file system detected, using content hashes to detect source code changes\n", fs.Type)
+			options = append(options, localfs.WatcherHashPolling())
+		}
+	}
+
+	return options, nil
 }
 
 // serve performs the operations to serve the blockchain: build, init and start
@@ -364,9 +407,11 @@ func (c *Chain) serve(ctx context.Context, cacheStorage cache.Storage, forceRese
 		}
 	}
 
-	// init phase
+	// init phase, the state is reset when the app is initialized.
+	stateReset := !isInit || (appModified && !exportGenesisExists)
+
 	// nolint:gocritic
-	if !isInit || (appModified && !exportGenesisExists) {
+	if stateReset {
 		c.ev.Send("Initializing the app...", events.ProgressUpdate())
 
 		if err := c.Init(ctx, true); err != nil {
@@ -406,23 +451,52 @@ func (c *Chain) serve(ctx context.Context, cacheStorage cache.Storage, forceRese
 	}
 
 	// start the blockchain
-	return c.start(ctx, conf)
+	return c.start(ctx, conf, stateReset)
 }
 
-func (c *Chain) start(ctx context.Context, config *chainconfig.Config) error {
+func (c *Chain) start(ctx context.Context, config *chainconfig.Config, stateReset bool) error {
 	commands, err := c.Commands(ctx)
 	if err != nil {
 		return err
 	}
 
+	// Get the first validator
+	validator := config.Validators[0]
+	servers, err := validator.GetServers()
+	if err != nil {
+		return err
+	}
+
+	// issue the certificates of the development proxy if it's served with TLS.
+	var (
+		isProxyEnabled = config.Proxy.Address != ""
+		isProxyTLS     = isProxyEnabled && config.Proxy.TLS.Enabled
+		certsPaths     CertsPaths
+		proxyTLS       *tls.Config
+	)
+	if isProxyTLS {
+		if certsPaths, err = c.ensureCerts(config); err != nil {
+			return err
+		}
+		if proxyTLS, err = c.proxyTLSConfig(certsPaths, config); err != nil {
+			return err
+		}
+	}
+
 	g, ctx := errgroup.WithContext(ctx)
 
 	// start the blockchain.
 	g.Go(func() error { return c.plugin.Start(ctx, commands, config) })
 
 	// start the faucet if enabled.
-	faucet, err := c.Faucet(ctx)
-	isFaucetEnabled := err != ErrFaucetIsNotEnabled
+	var (
+		faucet          cosmosfaucet.Faucet
+		isFaucetEnabled = config.Services.IsFaucetEnabled()
+	)
+	if isFaucetEnabled {
+		faucet, err = c.Faucet(ctx)
+		isFaucetEnabled = err != ErrFaucetIsNotEnabled
+	}
 
 	if isFaucetEnabled {
 		if err == ErrFaucetAccountDoesNotExist {
@@ -433,6 +507,12 @@ func (c *Chain) start(ctx context.Context, config *chainconfig.Config) error {
 		}
 
 		g.Go(func() (err error) {
+			// the faucet sends transactions to the node so it must
+			// wait until the node is ready to accept requests.
+			if err := waitUntilNodeIsReady(ctx, servers.RPC.Address, servers.GRPC.Address); err != nil {
+				return err
+			}
+
 			if err := c.runFaucetServer(ctx, faucet); err != nil {
 				return &CannotBuildAppError{err}
 			}
@@ -440,16 +520,25 @@ func (c *Chain) start(ctx context.Context, config *chainconfig.Config) error {
 		})
 	}
 
-	// set the app as being served
-	c.served = true
+	// start the development proxy if enabled.
+	if isProxyEnabled {
+		g.Go(func() error { return c.runProxyServer(ctx, config, proxyTLS) })
+	}
 
-	// Get the first validator
-	validator := config.Validators[0]
-	servers, err := validator.GetServers()
-	if err != nil {
-		return err
+	// relay the IBC paths declared in the config.
+	if len(config.Relayer.Paths) > 0 {
+		g.Go(func() error { return c.runRelayer(ctx, config, stateReset) })
+	}
+
+	// serve the OpenAPI console if the spec is generated.
+	isOpenAPIEnabled := config.Client.OpenAPI.Path != ""
+	if isOpenAPIEnabled {
+		g.Go(func() error { return c.runOpenAPIServer(ctx, config) })
 	}
 
+	// set the app as being served
+	c.served = true
+
 	// note: address format errors are handled by the
 	// error group, so they can be safely ignored here
 
@@ -461,10 +550,43 @@ func (c *Chain) start(ctx context.Context, config *chainconfig.Config) error {
 		events.Icon(icons.Earth),
 		events.ProgressFinish(),
 	)
-	c.ev.Send(
-		fmt.Sprintf("Blockchain API: %s", apiAddr),
-		events.Icon(icons.Earth),
-	)
+	if config.Services.IsAPIEnabled() {
+		c.ev.Send(
+			fmt.Sprintf("Blockchain API: %s", apiAddr),
+			events.Icon(icons.Earth),
+		)
+	}
+
+	if isProxyEnabled {
+		proxyAddr, _ := xurl.HTTP(config.Proxy.Address)
+		if isProxyTLS {
+			proxyAddr, _ = xurl.HTTPS(config.Proxy.Address)
+		}
+		msg := fmt.Sprintf("Blockchain proxy: %s", proxyAddr)
+		if isProxyTLS {
+			msg += fmt.Sprintf(" (TLS, CA certificate: %s)", certsPaths.CA)
+		}
+		if config.Proxy.TLS.ClientAuth {
+			msg += fmt.Sprintf(" (client certificate required: %s)", certsPaths.Client)
+		}
+		if config.Proxy.Auth.IsEnabled() {
+			msg += " (authentication required)"
+		}
+		if rl := config.Proxy.RateLimit; rl.IsEnabled() {
+			msg += fmt.Sprintf(" (rate limited to %g requests per second per IP)", rl.RPS)
+		}
+
+		c.ev.Send(msg, events.Icon(icons.Earth))
+	}
+
+	if isOpenAPIEnabled {
+		openAPIAddr, _ := xurl.HTTP(chainconfig.OpenAPIAddress(config))
+
+		c.ev.Send(
+			fmt.Sprintf("OpenAPI console: %s", openAPIAddr),
+			events.Icon(icons.Earth),
+		)
+	}
 
 	if isFaucetEnabled {
 		faucetAddr, _ := xurl.HTTP(chainconfig.FaucetHost(config))
@@ -473,6 +595,13 @@ func (c *Chain) start(ctx context.Context, config *chainconfig.Config) error {
 			fmt.Sprintf("Token faucet: %s", faucetAddr),
 			events.Icon(icons.Earth),
 		)
+
+		if grpcAddr := config.Faucet.GRPCAddress; grpcAddr != "" {
+			c.ev.Send(
+				fmt.Sprintf("Token faucet gRPC: %s", grpcAddr),
+				events.Icon(icons.Earth),
+			)
+		}
 	}
 
 	return g.Wait()
@@ -484,10 +613,7 @@ func (c *Chain) runFaucetServer(ctx context.Context, faucet cosmosfaucet.Faucet)
 		return err
 	}
 
-	return xhttp.Serve(ctx, &http.Server{
-		Addr:    chainconfig.FaucetHost(config),
-		Handler: faucet,
-	})
+	return ServeFaucet(ctx, faucet, config.Faucet, chainconfig.FaucetHost(config), c.app.Path)
 }
 
 // saveChainState runs the export command of the chain and store the exported genesis in the chain saved config
@@ -0,0 +1,106 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	v1 "github.com/ignite/cli/ignite/chainconfig/v1"
+	"github.com/ignite/cli/ignite/pkg/remotesigner"
+)
+
+// WriteSignerConfig writes in dir the config of the remote signer of the first
+// validator of the chain, from the key of the validator and the key of the node.
+// The signer type of the validator config is used when signerType is empty.
+// It returns the type of the written config.
+func (c *Chain) WriteSignerConfig(dir, signerType string) (string, error) {
+	signer, validatorKeyPath, options, err := c.signerOptions()
+	if err != nil {
+		return "", err
+	}
+	if signerType == "" {
+		signerType = signer.Type
+	}
+
+	validatorKey, err := remotesigner.ReadKey(validatorKeyPath)
+	if err != nil {
+		return "", err
+	}
+
+	switch signerType {
+	case v1.SignerTypeTMKMS:
+		err = remotesigner.WriteTMKMS(dir, validatorKey, options)
+	case v1.SignerTypeHorcrux:
+		err = remotesigner.WriteHorcrux(dir, validatorKey, options)
+	default:
+		err = fmt.Errorf("unsupported signer type %q", signerType)
+	}
+	return signerType, err
+}
+
+// WriteSignerCluster writes in dir the homes of a cluster of Horcrux cosigners
+// that sign with the shards of the key of the first validator of the chain.
+func (c *Chain) WriteSignerCluster(ctx context.Context, dir string, co remotesigner.ClusterOptions) error {
+	signer, validatorKeyPath, options, err := c.signerOptions()
+	if err != nil {
+		return err
+	}
+	if signer.Type != v1.SignerTypeHorcrux {
+		return fmt.Errorf("the signer of the validator is %s, set its type to %s to sign with a cluster", signer.Type, v1.SignerTypeHorcrux)
+	}
+
+	return remotesigner.WriteHorcruxCluster(ctx, dir, validatorKeyPath, options, co)
+}
+
+// signerOptions returns the remote signer config of the first validator of
+// the chain, the path of its key and the options of the signer configs.
+func (c *Chain) signerOptions() (*v1.Signer, string, remotesigner.Options, error) {
+	var options remotesigner.Options
+
+	conf, err := c.Config()
+	if err != nil {
+		return nil, "", options, err
+	}
+	validator := conf.Validators[0]
+	if validator.Signer == nil {
+		return nil, "", options, fmt.Errorf(
+			"validator %s has no remote signer, add a \"signer\" to the validator in %s so the node connects to it",
+			validator.Name,
+			c.ConfigPath(),
+		)
+	}
+
+	home, err := c.Home()
+	if err != nil {
+		return nil, "", options, err
+	}
+	validatorKeyPath := filepath.Join(home, "config", "priv_validator_key.json")
+	nodeKeyPath := filepath.Join(home, "config", "node_key.json")
+	for _, path := range []string{validatorKeyPath, nodeKeyPath} {
+		if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+			return nil, "", options, errors.New("the chain is not initialized, run \"ignite chain init\" first")
+		}
+	}
+
+	nodeKey, err := remotesigner.ReadKey(nodeKeyPath)
+	if err != nil {
+		return nil, "", options, err
+	}
+	nodeID, err := nodeKey.NodeID()
+	if err != nil {
+		return nil, "", options, err
+	}
+	chainID, err := c.ID()
+	if err != nil {
+		return nil, "", options, err
+	}
+
+	options = remotesigner.Options{
+		ChainID:     chainID,
+		NodeAddress: validator.Signer.GetAddress(),
+		NodeID:      nodeID,
+	}
+	return validator.Signer, validatorKeyPath, options, nil
+}
@@ -0,0 +1,111 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ignite/cli/ignite/pkg/tendermintrpc"
+	"github.com/ignite/cli/ignite/pkg/xurl"
+)
+
+// DefaultStateSyncTrustPeriod is the default trust period of the state sync of
+// the nodes, the default trust period of Tendermint.
+const DefaultStateSyncTrustPeriod = 168 * time.Hour
+
+// StateSyncConfig is the statesync section of the config.toml of a node that
+// joins a network with state sync.
+type StateSyncConfig struct {
+	// RPCServers are the RPC servers that verify the light blocks of the
+	// snapshots.
+	RPCServers []string
+
+	// TrustHeight and TrustHash are the height and the hash of a trusted block
+	// of the network.
+	TrustHeight int64
+	TrustHash   string
+
+	// TrustPeriod is the period the validators of the trusted block are trusted.
+	TrustPeriod time.Duration
+}
+
+// Write sets the statesync section of the config.toml at path.
+func (s StateSyncConfig) Write(path string) error {
+	return setTOMLValues(path, map[string]interface{}{
+		"statesync.enable":       true,
+		"statesync.rpc_servers":  strings.Join(s.RPCServers, ","),
+		"statesync.trust_height": s.TrustHeight,
+		"statesync.trust_hash":   s.TrustHash,
+		"statesync.trust_period": s.TrustPeriod.String(),
+	})
+}
+
+// TOML returns the statesync section of the config.toml.
+func (s StateSyncConfig) TOML() string {
+	var b bytes.Buffer
+	fmt.Fprintln(&b, "[statesync]")
+	fmt.Fprintln(&b, "enable = true")
+	fmt.Fprintf(&b, "rpc_servers = %q\n", strings.Join(s.RPCServers, ","))
+	fmt.Fprintf(&b, "trust_height = %d\n", s.TrustHeight)
+	fmt.Fprintf(&b, "trust_hash = %q\n", s.TrustHash)
+	fmt.Fprintf(&b, "trust_period = %q\n", s.TrustPeriod.String())
+	return b.String()
+}
+
+// NewStateSyncConfig returns the state sync config of a node that joins the
+// network of the RPC servers. The trusted block is the block trustOffset
+// blocks below the latest block of the first RPC server, it must be below the
+// height of the snapshots served by the network.
+//
+// Tendermint requires two RPC servers, the server is listed twice when there
+// is a single server.
+func NewStateSyncConfig(ctx context.Context, rpcServers []string, trustOffset int64, trustPeriod time.Duration) (StateSyncConfig, error) {
+	if len(rpcServers) == 0 {
+		return StateSyncConfig{}, errors.New("an RPC server is required")
+	}
+	if trustOffset < 0 {
+		return StateSyncConfig{}, fmt.Errorf("invalid trust offset %d", trustOffset)
+	}
+
+	s := StateSyncConfig{TrustPeriod: trustPeriod}
+	for _, server := range rpcServers {
+		address, err := xurl.HTTP(xurl.Address(server))
+		if err != nil {
+			return StateSyncConfig{}, fmt.Errorf("invalid rpc address %s: %w", server, err)
+		}
+		s.RPCServers = append(s.RPCServers, address)
+	}
+	if len(s.RPCServers) == 1 {
+		s.RPCServers = append(s.RPCServers, s.RPCServers[0])
+	}
+
+	rpc := tendermintrpc.New(s.RPCServers[0])
+	latest, err := rpc.LatestHeight(ctx)
+	if err != nil {
+		return StateSyncConfig{}, fmt.Errorf("latest height of %s: %w", s.RPCServers[0], err)
+	}
+	s.TrustHeight = latest - trustOffset
+	if s.TrustHeight < 1 {
+		s.TrustHeight = 1
+	}
+	if s.TrustHash, err = rpc.BlockHash(ctx, s.TrustHeight); err != nil {
+		return StateSyncConfig{}, fmt.Errorf("hash of the block %d of %s: %w", s.TrustHeight, s.RPCServers[0], err)
+	}
+	return s, nil
+}
+
+// StateSyncConfig returns the state sync config of a node that joins the
+// network of the RPC servers, the RPC server of the chain by default.
+func (c *Chain) StateSyncConfig(ctx context.Context, rpcServers []string, trustOffset int64, trustPeriod time.Duration) (StateSyncConfig, error) {
+	if len(rpcServers) == 0 {
+		rpcAddress, err := c.RPCPublicAddress()
+		if err != nil {
+			return StateSyncConfig{}, err
+		}
+		rpcServers = []string{rpcAddress}
+	}
+	return NewStateSyncConfig(ctx, rpcServers, trustOffset, trustPeriod)
+}
@@ -0,0 +1,74 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pelletier/go-toml"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStateSyncConfig(t *testing.T) {
+	rpc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/status":
+			fmt.Fprint(w, `{"result":{"sync_info":{"latest_block_height":"5000"}}}`)
+		case "/block":
+			fmt.Fprintf(w, `{"result":{"block_id":{"hash":"HASH%s"}}}`, r.URL.Query().Get("height"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer rpc.Close()
+
+	s, err := NewStateSyncConfig(context.Background(), []string{rpc.URL}, 2000, DefaultStateSyncTrustPeriod)
+	require.NoError(t, err)
+	require.Equal(t, StateSyncConfig{
+		RPCServers:  []string{rpc.URL, rpc.URL},
+		TrustHeight: 3000,
+		TrustHash:   "HASH3000",
+		TrustPeriod: 168 * time.Hour,
+	}, s)
+	require.Equal(t, fmt.Sprintf(`[statesync]
+enable = true
+rpc_servers = "%[1]s,%[1]s"
+trust_height = 3000
+trust_hash = "HASH3000"
+trust_period = "168h0m0s"
+`, rpc.URL), s.TOML())
+
+	s, err = NewStateSyncConfig(context.Background(), []string{rpc.URL}, 10000, DefaultStateSyncTrustPeriod)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, s.TrustHeight)
+
+	_, err = NewStateSyncConfig(context.Background(), nil, 0, DefaultStateSyncTrustPeriod)
+	require.EqualError(t, err, "an RPC server is required")
+}
+
+func TestStateSyncConfigWrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	require.NoError(t, os.WriteFile(path, []byte("[statesync]\nenable = false\nchunk_fetchers = \"4\"\n"), 0o644))
+
+	s := StateSyncConfig{
+		RPCServers:  []string{"http://203.0.113.1:26657", "http://203.0.113.2:26657"},
+		TrustHeight: 3000,
+		TrustHash:   "HASH3000",
+		TrustPeriod: DefaultStateSyncTrustPeriod,
+	}
+	require.NoError(t, s.Write(path))
+
+	config, err := toml.LoadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, true, config.Get("statesync.enable"))
+	require.Equal(t, "http://203.0.113.1:26657,http://203.0.113.2:26657", config.Get("statesync.rpc_servers"))
+	require.EqualValues(t, 3000, config.Get("statesync.trust_height"))
+	require.Equal(t, "HASH3000", config.Get("statesync.trust_hash"))
+	require.Equal(t, "168h0m0s", config.Get("statesync.trust_period"))
+	require.Equal(t, "4", config.Get("statesync.chunk_fetchers"))
+}
@@ -0,0 +1,372 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pelletier/go-toml"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/chaincmd"
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/localnet"
+	"github.com/ignite/cli/ignite/pkg/xexec"
+	"github.com/ignite/cli/ignite/pkg/xnet"
+	"github.com/ignite/cli/ignite/pkg/xurl"
+)
+
+// TestnetOptions configures a local network of the nodes of the chain.
+type TestnetOptions struct {
+	// Validators is the number of validator nodes, at least one.
+	Validators int
+
+	// FullNodes is the number of nodes that don't validate blocks.
+	FullNodes int
+
+	// Cosmovisor is the path of the cosmovisor binary, the nodes run with
+	// cosmovisor when it's set so the binary of an upgrade can be staged.
+	Cosmovisor string
+}
+
+// testnetNode is a node of a local network with the runner of the chain
+// commands in its home.
+type testnetNode struct {
+	localnet.Node
+	grpcWebAddress string
+	pprofAddress   string
+	runner         chaincmdrunner.Runner
+}
+
+// WriteTestnet writes in dir the homes of the nodes of a local network of the
+// chain, and the Docker Compose and process supervisor definitions that start
+// them. The validators share the genesis of the accounts of the chain config,
+// the first validator is the validator of the config and the other validators
+// self-delegate the same amount with new keys.
+//
+// The nodes listen on the ports of the config of the validator increased by
+// localnet.PortStep times their index and are persistent peers of each other.
+func (c *Chain) WriteTestnet(ctx context.Context, dir string, o TestnetOptions) ([]localnet.Node, error) {
+	if o.Validators < 1 {
+		return nil, fmt.Errorf("a network needs at least 1 validator, got %d", o.Validators)
+	}
+	if o.FullNodes < 0 {
+		return nil, fmt.Errorf("invalid number of full nodes %d", o.FullNodes)
+	}
+
+	conf, err := c.Config()
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := c.ID()
+	if err != nil {
+		return nil, err
+	}
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if dir, err = filepath.Abs(dir); err != nil {
+		return nil, err
+	}
+
+	c.ev.Send("Initializing the nodes...", events.ProgressUpdate())
+
+	var nodes []testnetNode
+	for i := 0; i < o.Validators+o.FullNodes; i++ {
+		name := fmt.Sprintf("validator-%d", i+1)
+		if i >= o.Validators {
+			name = fmt.Sprintf("full-node-%d", i-o.Validators+1)
+		}
+
+		n, err := c.initTestnetNode(ctx, commands, conf, filepath.Join(dir, name), i)
+		if err != nil {
+			return nil, fmt.Errorf("node %s: %w", name, err)
+		}
+		n.Name = name
+		n.Validator = i < o.Validators
+		nodes = append(nodes, n)
+	}
+
+	c.ev.Send("Generating the genesis...", events.ProgressUpdate())
+
+	if err := c.writeTestnetGenesis(ctx, conf, chainID, nodes[:o.Validators], nodes); err != nil {
+		return nil, err
+	}
+
+	all := make([]localnet.Node, len(nodes))
+	for i, n := range nodes {
+		all[i] = n.Node
+	}
+	for _, n := range nodes {
+		peers, err := localnet.PersistentPeers(all, n.Name)
+		if err != nil {
+			return nil, err
+		}
+		if err := configureTestnetNode(n, peers); err != nil {
+			return nil, fmt.Errorf("node %s: %w", n.Name, err)
+		}
+	}
+
+	binary, err := c.Binary()
+	if err != nil {
+		return nil, err
+	}
+	binary = xexec.TryResolveAbsPath(binary)
+	cosmovisor := o.Cosmovisor
+	if cosmovisor != "" {
+		cosmovisor = xexec.TryResolveAbsPath(cosmovisor)
+		for _, n := range all {
+			if err := stageCosmovisorBinary(n.Home, "", binary, filepath.Base(binary)); err != nil {
+				return nil, fmt.Errorf("node %s: %w", n.Name, err)
+			}
+		}
+	}
+	if err := localnet.WriteDefinitions(dir, chainID, binary, cosmovisor, all); err != nil {
+		return nil, err
+	}
+	return all, nil
+}
+
+// stageCosmovisorBinary copies the binary to the cosmovisor directory of the
+// home with the name of the binary run by cosmovisor, as the binary of the
+// upgrade or as the genesis binary when the upgrade is empty.
+func stageCosmovisorBinary(home, upgrade, binary, binaryName string) error {
+	dir := filepath.Join(home, localnet.CosmovisorDir, "genesis", "bin")
+	if upgrade != "" {
+		dir = filepath.Join(home, localnet.CosmovisorDir, "upgrades", upgrade, "bin")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	content, err := os.ReadFile(binary)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, binaryName), content, 0o755)
+}
+
+// initTestnetNode initializes the home of the node with the index, the
+// servers of the node listen on the ports of the config of the validator
+// increased by the index.
+func (c *Chain) initTestnetNode(
+	ctx context.Context,
+	commands chaincmdrunner.Runner,
+	conf *chainconfig.Config,
+	home string,
+	index int,
+) (testnetNode, error) {
+	if err := os.RemoveAll(home); err != nil {
+		return testnetNode{}, err
+	}
+
+	runner, err := chaincmdrunner.New(ctx, commands.Cmd().Copy(chaincmd.WithHome(home)))
+	if err != nil {
+		return testnetNode{}, err
+	}
+	if err := runner.Init(ctx, filepath.Base(home)); err != nil {
+		return testnetNode{}, err
+	}
+	if err := c.plugin.Configure(home, conf); err != nil {
+		return testnetNode{}, err
+	}
+
+	id, err := runner.ShowNodeID(ctx)
+	if err != nil {
+		return testnetNode{}, err
+	}
+
+	servers, err := conf.Validators[0].GetServers()
+	if err != nil {
+		return testnetNode{}, err
+	}
+	inc := uint64(index * localnet.PortStep)
+	n := testnetNode{
+		Node:   localnet.Node{Home: home, ID: id},
+		runner: runner,
+	}
+	for _, addr := range []struct {
+		dst *string
+		src string
+	}{
+		{&n.P2PAddress, servers.P2P.Address},
+		{&n.RPCAddress, servers.RPC.Address},
+		{&n.GRPCAddress, servers.GRPC.Address},
+		{&n.APIAddress, servers.API.Address},
+		{&n.grpcWebAddress, servers.GRPCWeb.Address},
+		{&n.pprofAddress, servers.RPC.PProfAddress},
+	} {
+		if *addr.dst, err = xnet.IncreasePortBy(addr.src, inc); err != nil {
+			return testnetNode{}, err
+		}
+	}
+	return n, nil
+}
+
+// writeTestnetGenesis writes the genesis of the accounts of the config and of
+// the gentxs of the validators in the homes of the nodes.
+func (c *Chain) writeTestnetGenesis(
+	ctx context.Context,
+	conf *chainconfig.Config,
+	chainID string,
+	validators, nodes []testnetNode,
+) error {
+	// the genesis is generated in the home of the first validator.
+	first := validators[0]
+	genesisPath := filepath.Join(first.Home, "config", "genesis.json")
+
+	if conf.Genesis != nil {
+		conf.Genesis["chain_id"] = chainID
+	}
+	if err := mergeGenesisFile(genesisPath, conf.Genesis); err != nil {
+		return err
+	}
+
+	if err := addGenesisAccounts(ctx, first.runner, conf); err != nil {
+		return err
+	}
+
+	// the other validators self-delegate the amount of the validator of the
+	// config with new keys.
+	validator := createValidatorFromConfig(conf)
+	for _, v := range validators[1:] {
+		account, err := v.runner.AddAccount(ctx, v.Name, "", "")
+		if err != nil {
+			return err
+		}
+		if err := first.runner.AddGenesisAccount(ctx, account.Address, validator.StakingAmount); err != nil {
+			return err
+		}
+	}
+
+	genesis, err := os.ReadFile(genesisPath)
+	if err != nil {
+		return err
+	}
+
+	gentxDir := filepath.Join(first.Home, "config", "gentx")
+	for i, v := range validators {
+		gentxValidator := validator
+		gentxValidator.Moniker = v.Name
+		if i > 0 {
+			gentxValidator.Name = v.Name
+			if err := os.WriteFile(filepath.Join(v.Home, "config", "genesis.json"), genesis, 0o644); err != nil {
+				return err
+			}
+		}
+
+		gentxPath, err := c.plugin.Gentx(ctx, v.runner, gentxValidator)
+		if err != nil {
+			return fmt.Errorf("gentx of validator %s: %w", v.Name, err)
+		}
+		if i > 0 {
+			if err := copyFile(gentxPath, filepath.Join(gentxDir, filepath.Base(gentxPath))); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := first.runner.CollectGentxs(ctx); err != nil {
+		return err
+	}
+
+	// all the nodes share the genesis of the first validator.
+	for _, n := range nodes[1:] {
+		if err := copyFile(genesisPath, filepath.Join(n.Home, "config", "genesis.json")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addGenesisAccounts adds the accounts of the config to the genesis of the
+// runner, the keys of the accounts without address are added to its keyring.
+func addGenesisAccounts(ctx context.Context, runner chaincmdrunner.Runner, conf *chainconfig.Config) error {
+	for _, account := range conf.Accounts {
+		address := account.Address
+		if address == "" && account.EthKey != "" {
+			generated, err := importEthKey(ctx, runner, account.Name, account.EthKey)
+			if err != nil {
+				return err
+			}
+			address = generated.Address
+		} else if address == "" {
+			generated, err := runner.AddAccount(ctx, account.Name, account.Mnemonic, account.CoinType)
+			if err != nil {
+				return err
+			}
+			address = generated.Address
+		}
+
+		if err := runner.AddGenesisAccount(ctx, address, strings.Join(account.Coins, ",")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// configureTestnetNode sets the addresses of the servers and the persistent
+// peers of the node in its config files.
+func configureTestnetNode(n testnetNode, peers string) error {
+	rpcAddr, err := xurl.TCP(n.RPCAddress)
+	if err != nil {
+		return err
+	}
+	p2pAddr, err := xurl.TCP(n.P2PAddress)
+	if err != nil {
+		return err
+	}
+	apiAddr, err := xurl.TCP(n.APIAddress)
+	if err != nil {
+		return err
+	}
+
+	err = setTOMLValues(filepath.Join(n.Home, "config", "config.toml"), map[string]interface{}{
+		"rpc.laddr":              rpcAddr,
+		"rpc.pprof_laddr":        n.pprofAddress,
+		"p2p.laddr":              p2pAddr,
+		"p2p.persistent_peers":   peers,
+		"p2p.allow_duplicate_ip": true,
+		"p2p.addr_book_strict":   false,
+	})
+	if err != nil {
+		return err
+	}
+
+	return setTOMLValues(filepath.Join(n.Home, "config", "app.toml"), map[string]interface{}{
+		"api.address":      apiAddr,
+		"grpc.address":     n.GRPCAddress,
+		"grpc-web.address": n.grpcWebAddress,
+	})
+}
+
+// setTOMLValues sets the values of the keys of the TOML file.
+func setTOMLValues(path string, values map[string]interface{}) error {
+	config, err := toml.LoadFile(path)
+	if err != nil {
+		return err
+	}
+	for key, value := range values {
+		config.Set(key, value)
+	}
+
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = config.WriteTo(file)
+	return err
+}
+
+func copyFile(src, dst string) error {
+	b, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(dst, b, 0o644)
+}
@@ -0,0 +1,362 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/remotenode"
+	"github.com/ignite/cli/ignite/pkg/xexec"
+)
+
+// TestnetHosts are the hosts of the nodes of a local network deployed to
+// remote hosts, read from a hosts file.
+type TestnetHosts struct {
+	// Dir is the directory of the nodes on the hosts, relative to the home of
+	// the user of the hosts. It's the chain ID by default.
+	Dir string `yaml:"dir"`
+
+	// Cosmovisor is the path of the cosmovisor binary on the hosts, the nodes
+	// run with cosmovisor when it's set.
+	Cosmovisor string `yaml:"cosmovisor"`
+
+	// Nodes are the nodes of the network deployed to the hosts.
+	Nodes []TestnetHost `yaml:"nodes"`
+}
+
+// TestnetHost is a node of a local network deployed to a remote host.
+type TestnetHost struct {
+	// Name is the name of the node in the directory of the network.
+	Name string `yaml:"name"`
+
+	// Host is the SSH destination of the host, e.g. "alice@203.0.113.1".
+	Host string `yaml:"host"`
+
+	// Port is the SSH port of the host.
+	Port int `yaml:"port"`
+
+	// Address is the public address of the node reached by the other nodes,
+	// the host name of the SSH destination by default.
+	Address string `yaml:"address"`
+
+	// Seed runs the node as a seed node, it's a seed of the other nodes
+	// instead of a persistent peer.
+	Seed bool `yaml:"seed"`
+}
+
+// ParseTestnetHosts reads the hosts file at the path.
+func ParseTestnetHosts(path string) (TestnetHosts, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return TestnetHosts{}, err
+	}
+
+	var hosts TestnetHosts
+	if err := yaml.UnmarshalStrict(data, &hosts); err != nil {
+		return TestnetHosts{}, fmt.Errorf("invalid hosts file %s: %w", path, err)
+	}
+	if len(hosts.Nodes) == 0 {
+		return TestnetHosts{}, fmt.Errorf("the hosts file %s has no nodes", path)
+	}
+	names := make(map[string]bool)
+	for _, n := range hosts.Nodes {
+		if n.Name == "" || n.Name != filepath.Base(n.Name) || strings.HasPrefix(n.Name, ".") {
+			return TestnetHosts{}, fmt.Errorf("invalid node name %q", n.Name)
+		}
+		if names[n.Name] {
+			return TestnetHosts{}, fmt.Errorf("the node %s is deployed twice", n.Name)
+		}
+		names[n.Name] = true
+	}
+	return hosts, nil
+}
+
+// TestnetDeployOptions configures the deployment of a local network.
+type TestnetDeployOptions struct {
+	// Dir is the directory of the network written by WriteTestnet.
+	Dir string
+
+	// Hosts are the hosts of the nodes.
+	Hosts TestnetHosts
+
+	// Upgrade is the name of an upgrade to roll out the binary of the chain
+	// to the deployed nodes instead of deploying them. The binary is the binary
+	// of the upgrade of cosmovisor, or replaces the binary of the nodes that
+	// restart one by one without cosmovisor.
+	Upgrade string
+}
+
+// deployedNode is a node of a local network deployed to a remote host.
+type deployedNode struct {
+	TestnetHost
+	host remotenode.Host
+	home string
+	peer TestnetPeer
+
+	// dir and user are the directory of the nodes and the user on the host.
+	dir, user string
+}
+
+func (n deployedNode) remoteHome() string {
+	return path.Join(n.dir, n.Name)
+}
+
+func (n deployedNode) binaryPath(binaryName string) string {
+	return path.Join(n.dir, "bin", binaryName)
+}
+
+// DeployTestnet deploys the nodes of a local network written by WriteTestnet
+// to remote hosts over SSH: the binary of the chain and the home of each node
+// are copied to its host and the node runs as a systemd service. The homes of
+// the nodes are copied once, the homes already deployed are kept. The nodes
+// are persistent peers of each other at their public address, the seed nodes
+// are the seeds of the other nodes.
+func (c *Chain) DeployTestnet(ctx context.Context, o TestnetDeployOptions) error {
+	chainID, err := c.ID()
+	if err != nil {
+		return err
+	}
+	binary, err := c.Binary()
+	if err != nil {
+		return err
+	}
+	binary = xexec.TryResolveAbsPath(binary)
+	binaryName := filepath.Base(binary)
+
+	dir := o.Hosts.Dir
+	if dir == "" {
+		dir = chainID
+	}
+
+	nodes, err := loadDeployedNodes(ctx, o.Dir, dir, o.Hosts)
+	if err != nil {
+		return err
+	}
+
+	if o.Upgrade != "" {
+		return c.upgradeTestnet(ctx, nodes, binary, o.Upgrade, o.Hosts.Cosmovisor != "")
+	}
+
+	stage, err := os.MkdirTemp("", "deploy")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(stage)
+
+	for _, n := range nodes {
+		c.ev.Send(fmt.Sprintf("Deploying %s to %s...", n.Name, n.Host), events.ProgressUpdate())
+
+		if err := copyBinaryToHost(ctx, n, binary); err != nil {
+			return fmt.Errorf("node %s: %w", n.Name, err)
+		}
+
+		deployed, err := n.host.Run(ctx, fmt.Sprintf("test -f %s && echo deployed || true", remotenode.Quote(path.Join(n.remoteHome(), "config", "node_key.json"))), nil)
+		if err != nil {
+			return fmt.Errorf("node %s: %w", n.Name, err)
+		}
+		if deployed == "" {
+			if err := deployTestnetHome(ctx, stage, n, deployedPeers(nodes)); err != nil {
+				return fmt.Errorf("node %s: %w", n.Name, err)
+			}
+		} else {
+			c.ev.Send(fmt.Sprintf("The home of %s is already deployed, it's kept", n.Name), events.ProgressUpdate())
+		}
+
+		s := remotenode.Service{
+			Name:        fmt.Sprintf("%s-%s", chainID, n.Name),
+			Description: fmt.Sprintf("%s %s", chainID, n.Name),
+			User:        n.user,
+			Command:     []string{n.binaryPath(binaryName), "start", "--home", n.remoteHome()},
+		}
+		if o.Hosts.Cosmovisor != "" {
+			genesisBin := path.Join(n.remoteHome(), "cosmovisor", "genesis", "bin")
+			script := fmt.Sprintf(
+				"mkdir -p %s && cp %s %s",
+				remotenode.Quote(genesisBin), remotenode.Quote(n.binaryPath(binaryName)), remotenode.Quote(genesisBin),
+			)
+			if _, err := n.host.Run(ctx, script, nil); err != nil {
+				return fmt.Errorf("node %s: %w", n.Name, err)
+			}
+			s.Command = []string{o.Hosts.Cosmovisor, "run", "start", "--home", n.remoteHome()}
+			s.Environment = []string{
+				"DAEMON_NAME=" + binaryName,
+				"DAEMON_HOME=" + n.remoteHome(),
+				"DAEMON_ALLOW_DOWNLOAD_BINARIES=false",
+				"DAEMON_RESTART_AFTER_UPGRADE=true",
+			}
+		}
+		if err := n.host.Install(ctx, s); err != nil {
+			return fmt.Errorf("node %s: %w", n.Name, err)
+		}
+	}
+	return nil
+}
+
+// upgradeTestnet rolls out the binary to the deployed nodes.
+func (c *Chain) upgradeTestnet(ctx context.Context, nodes []deployedNode, binary, upgrade string, cosmovisor bool) error {
+	chainID, err := c.ID()
+	if err != nil {
+		return err
+	}
+	binaryName := filepath.Base(binary)
+
+	for _, n := range nodes {
+		c.ev.Send(fmt.Sprintf("Upgrading %s on %s...", n.Name, n.Host), events.ProgressUpdate())
+
+		if !cosmovisor {
+			if err := copyBinaryToHost(ctx, n, binary); err != nil {
+				return fmt.Errorf("node %s: %w", n.Name, err)
+			}
+			if err := n.host.Restart(ctx, fmt.Sprintf("%s-%s", chainID, n.Name)); err != nil {
+				return fmt.Errorf("node %s: %w", n.Name, err)
+			}
+			continue
+		}
+
+		// cosmovisor switches to the binary of the upgrade at the upgrade height.
+		upgradeBin := path.Join(n.remoteHome(), "cosmovisor", "upgrades", upgrade, "bin")
+		if _, err := n.host.Run(ctx, "mkdir -p "+remotenode.Quote(upgradeBin), nil); err != nil {
+			return fmt.Errorf("node %s: %w", n.Name, err)
+		}
+		if err := n.host.Copy(ctx, binary, path.Join(upgradeBin, binaryName)); err != nil {
+			return fmt.Errorf("node %s: %w", n.Name, err)
+		}
+	}
+	return nil
+}
+
+// loadDeployedNodes loads the nodes of the hosts deployed in the directory dir
+// of the hosts.
+func loadDeployedNodes(ctx context.Context, networkDir, dir string, hosts TestnetHosts) ([]deployedNode, error) {
+	var nodes []deployedNode
+	for _, h := range hosts.Nodes {
+		n, err := loadDeployedNode(ctx, networkDir, dir, h)
+		if err != nil {
+			return nil, fmt.Errorf("node %s: %w", h.Name, err)
+		}
+		nodes = append(nodes, n)
+	}
+	return nodes, nil
+}
+
+// loadDeployedNode reads the peer of the home of the node in the directory of
+// the network, and the directory of the nodes and the user on its host.
+func loadDeployedNode(ctx context.Context, networkDir, dir string, h TestnetHost) (deployedNode, error) {
+	n := deployedNode{
+		TestnetHost: h,
+		host:        remotenode.Host{Target: h.Host, Port: h.Port},
+		home:        filepath.Join(networkDir, h.Name),
+	}
+	if err := n.host.Validate(); err != nil {
+		return deployedNode{}, err
+	}
+
+	peer, err := loadTestnetPeer(n.home, h)
+	if err != nil {
+		return deployedNode{}, err
+	}
+	n.peer = peer
+
+	// the directory is relative to the home of the user on the host.
+	out, err := n.host.Run(ctx, "echo $HOME && id -un", nil)
+	if err != nil {
+		return deployedNode{}, err
+	}
+	home, user, ok := strings.Cut(out, "\n")
+	if !ok {
+		return deployedNode{}, fmt.Errorf("cannot read the home of the user of %s", n.Host)
+	}
+	n.dir = path.Join(strings.TrimSpace(home), dir)
+	n.user = strings.TrimSpace(user)
+	return n, nil
+}
+
+func deployedPeers(nodes []deployedNode) []TestnetPeer {
+	var peers []TestnetPeer
+	for _, n := range nodes {
+		peers = append(peers, n.peer)
+	}
+	return peers
+}
+
+// copyBinaryToHost copies the binary to the directory of the nodes on the
+// host, the binary is replaced once copied so the running nodes keep running.
+func copyBinaryToHost(ctx context.Context, n deployedNode, binary string) error {
+	dst := n.binaryPath(filepath.Base(binary))
+	if _, err := n.host.Run(ctx, "mkdir -p "+remotenode.Quote(path.Dir(dst)), nil); err != nil {
+		return err
+	}
+	if err := n.host.Copy(ctx, binary, dst+".new"); err != nil {
+		return err
+	}
+	_, err := n.host.Run(ctx, fmt.Sprintf("mv %s %s", remotenode.Quote(dst+".new"), remotenode.Quote(dst)), nil)
+	return err
+}
+
+// deployTestnetHome copies the home of the node to its host, the servers of
+// the node listen on all the interfaces and the other nodes are its peers at
+// their public address.
+func deployTestnetHome(ctx context.Context, stage string, n deployedNode, peers []TestnetPeer) error {
+	home := filepath.Join(stage, n.Name)
+	if err := copyDir(n.home, home); err != nil {
+		return err
+	}
+
+	values := testnetPeersConfig(n.peer, peers)
+	values["p2p.laddr"] = "tcp://" + net.JoinHostPort("0.0.0.0", n.peer.Port)
+	if err := setTOMLValues(filepath.Join(home, "config", "config.toml"), values); err != nil {
+		return err
+	}
+
+	if _, err := n.host.Run(ctx, "mkdir -p "+remotenode.Quote(n.dir), nil); err != nil {
+		return err
+	}
+	return n.host.Copy(ctx, home, n.remoteHome())
+}
+
+// copyDir copies the files of the directory src to dst.
+func copyDir(src, dst string) error {
+	return filepath.WalkDir(src, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, p)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if d.IsDir() {
+			return os.MkdirAll(target, 0o755)
+		}
+		if !d.Type().IsRegular() {
+			return errors.New("cannot copy the non-regular file " + p)
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		in, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+		out, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, info.Mode().Perm())
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+		_, err = io.Copy(out, in)
+		return err
+	})
+}
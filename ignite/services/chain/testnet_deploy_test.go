@@ -0,0 +1,46 @@
+package chain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTestnetHosts(t *testing.T) {
+	write := func(t *testing.T, content string) string {
+		path := filepath.Join(t.TempDir(), "hosts.yml")
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+		return path
+	}
+
+	hosts, err := ParseTestnetHosts(write(t, `
+cosmovisor: /usr/local/bin/cosmovisor
+nodes:
+  - name: validator-1
+    host: alice@203.0.113.1
+  - name: validator-2
+    host: alice@203.0.113.2
+    port: 2222
+    address: validator-2.mars.network
+`))
+	require.NoError(t, err)
+	require.Equal(t, TestnetHosts{
+		Cosmovisor: "/usr/local/bin/cosmovisor",
+		Nodes: []TestnetHost{
+			{Name: "validator-1", Host: "alice@203.0.113.1"},
+			{Name: "validator-2", Host: "alice@203.0.113.2", Port: 2222, Address: "validator-2.mars.network"},
+		},
+	}, hosts)
+
+	for name, content := range map[string]string{
+		"no nodes":      `dir: mars`,
+		"unknown field": "nodes:\n  - name: validator-1\n    hostname: mars",
+		"invalid name":  "nodes:\n  - name: ../validator-1\n    host: mars",
+		"twice":         "nodes:\n  - name: validator-1\n    host: mars\n  - name: validator-1\n    host: venus",
+	} {
+		_, err := ParseTestnetHosts(write(t, content))
+		require.Error(t, err, name)
+	}
+}
@@ -0,0 +1,382 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/cli/ignite/pkg/chaincmd"
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil/genesis"
+	"github.com/ignite/cli/ignite/pkg/downloader"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/remotesigner"
+	"github.com/ignite/cli/ignite/pkg/tendermintrpc"
+	"github.com/ignite/cli/ignite/pkg/xurl"
+)
+
+const (
+	// forkOperatorName is the name of the key of the operator in the keyring
+	// of the home of the fork.
+	forkOperatorName = "operator"
+
+	// stateSyncTrustOffset is the number of blocks between the latest block of
+	// the network and the block trusted by the state sync, the snapshots of
+	// the network are taken after the trusted block.
+	stateSyncTrustOffset = 2000
+
+	stateSyncTrustPeriod   = "168h0m0s"
+	stateSyncCheckInterval = 5 * time.Second
+)
+
+// TestnetForkOptions configures the fork of a live network.
+type TestnetForkOptions struct {
+	// RPCAddress is the address of the RPC of a node of the network.
+	RPCAddress string
+
+	// State is the path or the URL of the state of the network exported with
+	// the export command of the binary of the network.
+	State string
+
+	// NodeHome is the home of a synced node of the network, the state is
+	// exported from the home when State is empty.
+	NodeHome string
+
+	// Peers are the peers of the network the state is synced from when State
+	// and NodeHome are empty, the node of the RPC by default.
+	Peers string
+
+	// ChainID is the chain ID of the fork, the chain ID of the network is kept
+	// when empty.
+	ChainID string
+
+	// OperatorCoins are added to the balance of the operator of the fork.
+	OperatorCoins sdk.Coins
+
+	// VotingPeriod is the voting period of the governance proposals of the
+	// fork, the voting period of the network is kept when zero.
+	VotingPeriod time.Duration
+}
+
+// TestnetFork is the fork of a live network.
+type TestnetFork struct {
+	// Home is the home of the node of the fork.
+	Home string
+
+	// ChainID is the chain ID of the fork.
+	ChainID string
+
+	// Operator is the account of the operator in the keyring of the home.
+	Operator chaincmdrunner.Account
+
+	// Validator is the validator of the network taken over by the node.
+	Validator genesis.ForkedValidator
+}
+
+// WriteTestnetFork writes in home the home of a node that forks the live
+// network of the RPC from its latest state. The state is read from the
+// options or exported from a node of the network: from a synced home or from
+// a new home synced with the snapshots of the network.
+//
+// The validator of the network with the most voting power is taken over by
+// the validator key of the node and gets more than 2/3 of the voting power
+// from the delegation of a new operator key, so the node produces the blocks
+// of the fork alone.
+func (c *Chain) WriteTestnetFork(ctx context.Context, home string, o TestnetForkOptions) (TestnetFork, error) {
+	rpcAddress, err := xurl.HTTP(o.RPCAddress)
+	if err != nil {
+		return TestnetFork{}, fmt.Errorf("invalid rpc address %s: %w", o.RPCAddress, err)
+	}
+	network := tendermintrpc.New(rpcAddress)
+	info, err := network.Status(ctx)
+	if err != nil {
+		return TestnetFork{}, fmt.Errorf("status of the network: %w", err)
+	}
+
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return TestnetFork{}, err
+	}
+	if home, err = filepath.Abs(home); err != nil {
+		return TestnetFork{}, err
+	}
+
+	var state []byte
+	switch {
+	case o.State != "":
+		c.ev.Send("Reading the state of the network...", events.ProgressUpdate())
+		state, err = readState(ctx, o.State)
+	case o.NodeHome != "":
+		c.ev.Send("Exporting the state of the network...", events.ProgressUpdate())
+		state, err = exportState(ctx, commands, o.NodeHome)
+	default:
+		state, err = c.syncState(ctx, commands, rpcAddress, info, home+"-statesync", o.Peers)
+	}
+	if err != nil {
+		return TestnetFork{}, err
+	}
+
+	var exported struct {
+		ChainID string `json:"chain_id"`
+	}
+	if err := json.Unmarshal(state, &exported); err != nil {
+		return TestnetFork{}, fmt.Errorf("invalid state: %w", err)
+	}
+	if exported.ChainID != info.Network {
+		return TestnetFork{}, fmt.Errorf("the state of chain %s is not the state of the network %s", exported.ChainID, info.Network)
+	}
+
+	c.ev.Send("Initializing the node of the fork...", events.ProgressUpdate())
+
+	runner, err := c.initNodeHome(ctx, commands, home)
+	if err != nil {
+		return TestnetFork{}, err
+	}
+	operator, err := runner.AddAccount(ctx, forkOperatorName, "", "")
+	if err != nil {
+		return TestnetFork{}, err
+	}
+	key, err := remotesigner.ReadKey(filepath.Join(home, "config", "priv_validator_key.json"))
+	if err != nil {
+		return TestnetFork{}, err
+	}
+	consensusKey, err := key.PublicKey()
+	if err != nil {
+		return TestnetFork{}, err
+	}
+
+	c.ev.Send("Forking the state of the network...", events.ProgressUpdate())
+
+	fork := TestnetFork{
+		Home:     home,
+		ChainID:  o.ChainID,
+		Operator: operator,
+	}
+	if fork.ChainID == "" {
+		fork.ChainID = info.Network
+	}
+	forked, validator, err := genesis.Fork(state, genesis.ForkOptions{
+		ChainID:       fork.ChainID,
+		ConsensusKey:  consensusKey,
+		Operator:      operator.Address,
+		OperatorCoins: o.OperatorCoins,
+		VotingPeriod:  o.VotingPeriod,
+	})
+	if err != nil {
+		return TestnetFork{}, err
+	}
+	fork.Validator = validator
+
+	if err := os.WriteFile(filepath.Join(home, "config", "genesis.json"), forked, 0o644); err != nil {
+		return TestnetFork{}, err
+	}
+	return fork, nil
+}
+
+// StartTestnetFork starts the node of the fork in home.
+func (c *Chain) StartTestnetFork(ctx context.Context, home string) error {
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return err
+	}
+	runner, err := chaincmdrunner.New(ctx, commands.Cmd().Copy(chaincmd.WithHome(home)))
+	if err != nil {
+		return err
+	}
+
+	// the invariants of the state of a live network are too long to check.
+	return runner.Start(ctx, "--x-crisis-skip-assert-invariants")
+}
+
+// initNodeHome initializes the home of a node configured like the validator
+// of the chain config.
+func (c *Chain) initNodeHome(ctx context.Context, commands chaincmdrunner.Runner, home string) (chaincmdrunner.Runner, error) {
+	conf, err := c.Config()
+	if err != nil {
+		return chaincmdrunner.Runner{}, err
+	}
+	if err := os.RemoveAll(home); err != nil {
+		return chaincmdrunner.Runner{}, err
+	}
+
+	runner, err := chaincmdrunner.New(ctx, commands.Cmd().Copy(chaincmd.WithHome(home)))
+	if err != nil {
+		return chaincmdrunner.Runner{}, err
+	}
+	if err := runner.Init(ctx, filepath.Base(home)); err != nil {
+		return chaincmdrunner.Runner{}, err
+	}
+	return runner, c.plugin.Configure(home, conf)
+}
+
+// syncState syncs a new node in home with the snapshots of the network and
+// exports its state, the home is removed once the state is exported.
+func (c *Chain) syncState(
+	ctx context.Context,
+	commands chaincmdrunner.Runner,
+	rpcAddress string,
+	info tendermintrpc.NodeInfo,
+	home, peers string,
+) ([]byte, error) {
+	c.ev.Send("Syncing the state of the network...", events.ProgressUpdate())
+
+	network := tendermintrpc.New(rpcAddress)
+
+	runner, err := c.initNodeHome(ctx, commands, home)
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(home)
+
+	gen, err := network.GenesisDocument(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("genesis of the network: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(home, "config", "genesis.json"), gen, 0o644); err != nil {
+		return nil, err
+	}
+
+	sync, err := network.SyncInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	trustHeight := sync.LatestBlockHeight - stateSyncTrustOffset
+	if trustHeight < 1 {
+		trustHeight = 1
+	}
+	trustHash, err := network.BlockHash(ctx, trustHeight)
+	if err != nil {
+		return nil, err
+	}
+	if peers == "" {
+		if peers, err = rpcNodePeer(rpcAddress, info); err != nil {
+			return nil, err
+		}
+	}
+	err = setTOMLValues(filepath.Join(home, "config", "config.toml"), map[string]interface{}{
+		"statesync.enable":       true,
+		"statesync.rpc_servers":  rpcAddress + "," + rpcAddress,
+		"statesync.trust_height": trustHeight,
+		"statesync.trust_hash":   trustHash,
+		"statesync.trust_period": stateSyncTrustPeriod,
+		"p2p.persistent_peers":   peers,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	conf, err := c.Config()
+	if err != nil {
+		return nil, err
+	}
+	servers, err := conf.Validators[0].GetServers()
+	if err != nil {
+		return nil, err
+	}
+	nodeAddress, err := xurl.HTTP(servers.RPC.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	// the node runs until it caught up with the network.
+	syncCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	exited := make(chan error, 1)
+	go func() {
+		exited <- runner.Start(syncCtx)
+	}()
+	if err := waitUntilSynced(syncCtx, tendermintrpc.New(nodeAddress), exited); err != nil {
+		return nil, err
+	}
+	cancel()
+	<-exited
+
+	c.ev.Send("Exporting the state of the network...", events.ProgressUpdate())
+	return exportState(ctx, commands, home)
+}
+
+// waitUntilSynced blocks until the node is synced with the network or exited.
+func waitUntilSynced(ctx context.Context, node tendermintrpc.Client, exited <-chan error) error {
+	ticker := time.NewTicker(stateSyncCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-exited:
+			if err == nil {
+				err = errors.New("the node exited")
+			}
+			return fmt.Errorf("sync the state of the network: %w", err)
+		case <-ticker.C:
+		}
+
+		// the node doesn't answer while it restores the snapshot.
+		sync, err := node.SyncInfo(ctx)
+		if err == nil && !sync.CatchingUp && sync.LatestBlockHeight > 0 {
+			return nil
+		}
+	}
+}
+
+// rpcNodePeer returns the peer address of the node of the RPC, the node
+// listens on the host of the RPC.
+func rpcNodePeer(rpcAddress string, info tendermintrpc.NodeInfo) (string, error) {
+	u, err := url.Parse(rpcAddress)
+	if err != nil {
+		return "", err
+	}
+	listen, err := url.Parse(info.ListenAddr)
+	if err != nil || listen.Port() == "" {
+		return "", fmt.Errorf("invalid listen address %q of the node of the rpc, set the peers of the network", info.ListenAddr)
+	}
+	return fmt.Sprintf("%s@%s", info.ID, net.JoinHostPort(u.Hostname(), listen.Port())), nil
+}
+
+// exportState exports the state of the chain in home.
+func exportState(ctx context.Context, commands chaincmdrunner.Runner, home string) ([]byte, error) {
+	runner, err := chaincmdrunner.New(ctx, commands.Cmd().Copy(chaincmd.WithHome(home)))
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := os.MkdirTemp("", "state")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "state.json")
+	if err := runner.Export(ctx, path); err != nil {
+		return nil, err
+	}
+	return os.ReadFile(path)
+}
+
+// readState reads the exported state at the path or the URL.
+func readState(ctx context.Context, location string) ([]byte, error) {
+	if !xurl.IsHTTP(location) {
+		return os.ReadFile(location)
+	}
+
+	dir, err := os.MkdirTemp("", "state")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "state.json")
+	if err := downloader.New().File(ctx, location, path); err != nil {
+		return nil, err
+	}
+	return os.ReadFile(path)
+}
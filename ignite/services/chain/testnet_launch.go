@@ -0,0 +1,518 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/ignite/pkg/checksum"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil/genesis"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/launchstore"
+	"github.com/ignite/cli/ignite/pkg/testnetbundle"
+	"github.com/ignite/cli/ignite/pkg/xexec"
+)
+
+// TestnetJoinOptions configures the request of the validator of the chain
+// config to join a testnet.
+type TestnetJoinOptions struct {
+	// PeerAddress is the public address of the P2P server of the node of the
+	// validator, the other validators connect to the node at the address.
+	PeerAddress string
+
+	// Coins are the coins of the account of the validator in the genesis, the
+	// self-delegation of the validator by default.
+	Coins string
+}
+
+// TestnetJoin is a join of a testnet.
+type TestnetJoin struct {
+	Launch launchstore.Launch
+
+	// Request is the request of the validator, empty once the testnet is
+	// launched.
+	Request launchstore.JoinRequest
+
+	// Account is the account of the validator, with its mnemonic when the
+	// account is created by the join.
+	Account chaincmdrunner.Account
+}
+
+// PublishTestnetLaunch publishes in the store the launch of a testnet of the
+// chain at the launch time, with the genesis of the accounts of the chain
+// config as genesis template and the hash of the binary of the chain.
+func (c *Chain) PublishTestnetLaunch(ctx context.Context, s launchstore.Store, launchTime time.Time) (launchstore.Launch, error) {
+	conf, err := c.Config()
+	if err != nil {
+		return launchstore.Launch{}, err
+	}
+	chainID, err := c.ID()
+	if err != nil {
+		return launchstore.Launch{}, err
+	}
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return launchstore.Launch{}, err
+	}
+	binary, err := c.Binary()
+	if err != nil {
+		return launchstore.Launch{}, err
+	}
+	binaryHash, err := checksum.Binary(binary)
+	if err != nil {
+		return launchstore.Launch{}, err
+	}
+
+	c.ev.Send("Generating the genesis template...", events.ProgressUpdate())
+
+	home, err := os.MkdirTemp("", "launch")
+	if err != nil {
+		return launchstore.Launch{}, err
+	}
+	defer os.RemoveAll(home)
+
+	runner, err := c.initNodeHome(ctx, commands, home)
+	if err != nil {
+		return launchstore.Launch{}, err
+	}
+	genesisPath := filepath.Join(home, "config", "genesis.json")
+	values := map[string]interface{}{}
+	for k, v := range conf.Genesis {
+		values[k] = v
+	}
+	values["chain_id"] = chainID
+	if err := mergeGenesisFile(genesisPath, values); err != nil {
+		return launchstore.Launch{}, err
+	}
+	if err := addGenesisAccounts(ctx, runner, conf); err != nil {
+		return launchstore.Launch{}, err
+	}
+	template, err := os.ReadFile(genesisPath)
+	if err != nil {
+		return launchstore.Launch{}, err
+	}
+
+	c.ev.Send("Publishing the launch...", events.ProgressUpdate())
+
+	l := launchstore.Launch{
+		ChainID:    chainID,
+		BinaryHash: binaryHash,
+		LaunchTime: launchTime.UTC(),
+	}
+	if err := launchstore.Publish(ctx, s, l, template); err != nil {
+		return launchstore.Launch{}, err
+	}
+	return launchstore.ReadLaunch(ctx, s, chainID)
+}
+
+// JoinTestnetLaunch joins the testnet of the chain published in the store.
+// Before the launch, the validator of the chain config generates its gentx
+// from the genesis template in the home of the chain and submits it with its
+// account. Once launched, the final genesis and the peers of the validators
+// are written in the home, the home of a full node is initialized when it
+// doesn't exist.
+func (c *Chain) JoinTestnetLaunch(ctx context.Context, s launchstore.Store, o TestnetJoinOptions) (TestnetJoin, error) {
+	chainID, err := c.ID()
+	if err != nil {
+		return TestnetJoin{}, err
+	}
+	l, err := launchstore.ReadLaunch(ctx, s, chainID)
+	if err != nil {
+		return TestnetJoin{}, err
+	}
+	join := TestnetJoin{Launch: l}
+
+	if err := c.initTestnetHome(ctx); err != nil {
+		return TestnetJoin{}, err
+	}
+	if l.Launched {
+		return join, c.syncTestnetLaunch(ctx, s, l)
+	}
+
+	conf, err := c.Config()
+	if err != nil {
+		return TestnetJoin{}, err
+	}
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return TestnetJoin{}, err
+	}
+	binary, err := c.Binary()
+	if err != nil {
+		return TestnetJoin{}, err
+	}
+	if binaryHash, err := checksum.Binary(binary); err != nil {
+		return TestnetJoin{}, err
+	} else if binaryHash != l.BinaryHash {
+		c.ev.Send(
+			fmt.Sprintf("The hash of %s is not the hash of the binary of the coordinator, make sure it runs the same version", binary),
+			events.Icon(icons.NotOK),
+		)
+	}
+
+	c.ev.Send("Generating the gentx...", events.ProgressUpdate())
+
+	template, err := launchstore.GenesisTemplate(ctx, s, l)
+	if err != nil {
+		return TestnetJoin{}, err
+	}
+	home, err := c.Home()
+	if err != nil {
+		return TestnetJoin{}, err
+	}
+	genesisPath := filepath.Join(home, "config", "genesis.json")
+	if err := os.WriteFile(genesisPath, template, 0o644); err != nil {
+		return TestnetJoin{}, err
+	}
+	if err := os.RemoveAll(filepath.Join(home, "config", "gentx")); err != nil {
+		return TestnetJoin{}, err
+	}
+
+	validator := createValidatorFromConfig(conf)
+	join.Account, err = commands.ShowAccount(ctx, validator.Name)
+	if errors.Is(err, chaincmdrunner.ErrAccountDoesNotExist) {
+		join.Account, err = commands.AddAccount(ctx, validator.Name, "", "")
+	}
+	if err != nil {
+		return TestnetJoin{}, err
+	}
+
+	coins := o.Coins
+	if coins == "" {
+		coins = validator.StakingAmount
+	}
+	if err := addGenesisAccount(ctx, commands, genesisPath, join.Account.Address, coins); err != nil {
+		return TestnetJoin{}, err
+	}
+
+	nodeID, err := commands.ShowNodeID(ctx)
+	if err != nil {
+		return TestnetJoin{}, err
+	}
+	if o.PeerAddress != "" {
+		validator.Memo = fmt.Sprintf("%s@%s", nodeID, o.PeerAddress)
+	}
+	gentxPath, err := c.plugin.Gentx(ctx, commands, validator)
+	if err != nil {
+		return TestnetJoin{}, err
+	}
+	gentx, err := os.ReadFile(gentxPath)
+	if err != nil {
+		return TestnetJoin{}, err
+	}
+
+	c.ev.Send("Submitting the request...", events.ProgressUpdate())
+
+	join.Request = launchstore.JoinRequest{
+		Name:    nodeID,
+		Address: join.Account.Address,
+		Coins:   coins,
+		Gentx:   gentx,
+	}
+	return join, launchstore.Join(ctx, s, l, join.Request)
+}
+
+// LaunchTestnet finalizes the genesis of the testnet of the chain published in
+// the store with the requests of the validators, the gentxs are collected in
+// the genesis template with the accounts of the validators and the genesis
+// time is the launch time.
+func (c *Chain) LaunchTestnet(ctx context.Context, s launchstore.Store) (launchstore.Launch, error) {
+	chainID, err := c.ID()
+	if err != nil {
+		return launchstore.Launch{}, err
+	}
+	l, err := launchstore.ReadLaunch(ctx, s, chainID)
+	if err != nil {
+		return launchstore.Launch{}, err
+	}
+	if l.Launched {
+		return launchstore.Launch{}, launchstore.ErrLaunched
+	}
+	requests, err := launchstore.Requests(ctx, s, l)
+	if err != nil {
+		return launchstore.Launch{}, err
+	}
+	if len(requests) == 0 {
+		return launchstore.Launch{}, fmt.Errorf("no validator joined %s", chainID)
+	}
+	template, err := launchstore.GenesisTemplate(ctx, s, l)
+	if err != nil {
+		return launchstore.Launch{}, err
+	}
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return launchstore.Launch{}, err
+	}
+
+	c.ev.Send("Generating the genesis...", events.ProgressUpdate())
+
+	home, err := os.MkdirTemp("", "launch")
+	if err != nil {
+		return launchstore.Launch{}, err
+	}
+	defer os.RemoveAll(home)
+
+	runner, err := c.initNodeHome(ctx, commands, home)
+	if err != nil {
+		return launchstore.Launch{}, err
+	}
+	genesisPath := filepath.Join(home, "config", "genesis.json")
+	if err := os.WriteFile(genesisPath, template, 0o644); err != nil {
+		return launchstore.Launch{}, err
+	}
+	gentxDir := filepath.Join(home, "config", "gentx")
+	if err := os.MkdirAll(gentxDir, 0o755); err != nil {
+		return launchstore.Launch{}, err
+	}
+
+	var peers []string
+	for _, r := range requests {
+		if err := verifyJoinRequest(r); err != nil {
+			return launchstore.Launch{}, fmt.Errorf("request %s: %w", r.Name, err)
+		}
+		if err := addGenesisAccount(ctx, runner, genesisPath, r.Address, r.Coins); err != nil {
+			return launchstore.Launch{}, fmt.Errorf("request %s: %w", r.Name, err)
+		}
+		if err := os.WriteFile(filepath.Join(gentxDir, r.Name+".json"), r.Gentx, 0o644); err != nil {
+			return launchstore.Launch{}, err
+		}
+
+		info, _, _ := cosmosutil.ParseGentx(r.Gentx)
+		if info.Memo != "" {
+			peers = append(peers, info.Memo)
+		}
+	}
+
+	if err := runner.CollectGentxs(ctx); err != nil {
+		return launchstore.Launch{}, err
+	}
+	if err := mergeGenesisFile(genesisPath, map[string]interface{}{
+		"genesis_time": l.LaunchTime.UTC().Format(time.RFC3339Nano),
+	}); err != nil {
+		return launchstore.Launch{}, err
+	}
+	if err := runner.ValidateGenesis(ctx); err != nil {
+		return launchstore.Launch{}, err
+	}
+	final, err := os.ReadFile(genesisPath)
+	if err != nil {
+		return launchstore.Launch{}, err
+	}
+
+	c.ev.Send("Publishing the genesis...", events.ProgressUpdate())
+
+	return launchstore.Finalize(ctx, s, l, final, peers)
+}
+
+// VerifyTestnetGenesis verifies the final genesis of the launched testnet of
+// the chain published in the store, or the genesis at genesisPath when it's
+// not empty. The report has the checks of the genesis against the launch, the
+// check of the hash of the binary of the chain and of the validation of the
+// genesis by the binary.
+func (c *Chain) VerifyTestnetGenesis(ctx context.Context, s launchstore.Store, genesisPath string) (launchstore.Report, error) {
+	chainID, err := c.ID()
+	if err != nil {
+		return launchstore.Report{}, err
+	}
+	l, err := launchstore.ReadLaunch(ctx, s, chainID)
+	if err != nil {
+		return launchstore.Report{}, err
+	}
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return launchstore.Report{}, err
+	}
+	binary, err := c.Binary()
+	if err != nil {
+		return launchstore.Report{}, err
+	}
+
+	c.ev.Send("Verifying the genesis...", events.ProgressUpdate())
+
+	var final []byte
+	if genesisPath != "" {
+		final, err = os.ReadFile(genesisPath)
+	} else {
+		final, err = launchstore.Genesis(ctx, s, l)
+	}
+	if err != nil {
+		return launchstore.Report{}, err
+	}
+	r, err := launchstore.Verify(ctx, s, l, final)
+	if err != nil {
+		return launchstore.Report{}, err
+	}
+
+	binaryHash, err := checksum.Binary(binary)
+	if err != nil {
+		return launchstore.Report{}, err
+	}
+	if binaryHash != l.BinaryHash {
+		r.AddCheck("binary hash", fmt.Errorf("the hash of %s is %s instead of the hash of the launch", binary, binaryHash))
+	} else {
+		r.AddCheck("binary hash", nil)
+	}
+
+	home, err := os.MkdirTemp("", "launch")
+	if err != nil {
+		return launchstore.Report{}, err
+	}
+	defer os.RemoveAll(home)
+
+	runner, err := c.initNodeHome(ctx, commands, home)
+	if err != nil {
+		return launchstore.Report{}, err
+	}
+	if err := os.WriteFile(filepath.Join(home, "config", "genesis.json"), final, 0o644); err != nil {
+		return launchstore.Report{}, err
+	}
+	r.AddCheck("validate-genesis", runner.ValidateGenesis(ctx))
+
+	return r, nil
+}
+
+// WriteTestnetBundle writes in dir the bundle of the launched testnet of the
+// chain: a public RPC node, the faucet of the chain config and a block explorer
+// deployable with Docker Compose or Kubernetes.
+func (c *Chain) WriteTestnetBundle(ctx context.Context, s launchstore.Store, l launchstore.Launch, dir string) error {
+	final, err := launchstore.Genesis(ctx, s, l)
+	if err != nil {
+		return err
+	}
+	conf, err := c.Config()
+	if err != nil {
+		return err
+	}
+	binary, err := c.Binary()
+	if err != nil {
+		return err
+	}
+
+	// the binary runs in the containers of the bundle.
+	if runtime.GOOS != "linux" {
+		c.ev.Send(
+			fmt.Sprintf("%s is built for %s, build it for linux to run the images of the bundle", binary, runtime.GOOS),
+			events.Icon(icons.NotOK),
+		)
+	}
+
+	o := testnetbundle.Options{
+		ChainID: l.ChainID,
+		Binary:  xexec.TryResolveAbsPath(binary),
+		Genesis: final,
+		Peers:   l.Peers,
+	}
+	if conf.Faucet.Name != nil {
+		o.Faucet = &testnetbundle.Faucet{
+			Account:  *conf.Faucet.Name,
+			Coins:    conf.Faucet.Coins,
+			CoinsMax: conf.Faucet.CoinsMax,
+		}
+		for _, account := range conf.Accounts {
+			if account.Name == *conf.Faucet.Name {
+				o.Faucet.Mnemonic = account.Mnemonic
+			}
+		}
+		if o.Faucet.Mnemonic == "" {
+			c.ev.Send(
+				fmt.Sprintf("The mnemonic of the faucet account %s is not in the config, fill it in the faucet.env file of the bundle", *conf.Faucet.Name),
+				events.Icon(icons.NotOK),
+			)
+		}
+	}
+
+	c.ev.Send("Writing the bundle...", events.ProgressUpdate())
+
+	return testnetbundle.Write(dir, o)
+}
+
+// initTestnetHome initializes the home of the chain when it has no node key,
+// the node key is kept when the testnet is joined again.
+func (c *Chain) initTestnetHome(ctx context.Context) error {
+	home, err := c.Home()
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(filepath.Join(home, "config", "node_key.json")); err == nil {
+		return nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return c.InitChain(ctx)
+}
+
+// syncTestnetLaunch writes the final genesis of the launch in the home of the
+// chain and sets the validators of the launch as persistent peers.
+func (c *Chain) syncTestnetLaunch(ctx context.Context, s launchstore.Store, l launchstore.Launch) error {
+	c.ev.Send("Downloading the genesis...", events.ProgressUpdate())
+
+	final, err := launchstore.Genesis(ctx, s, l)
+	if err != nil {
+		return err
+	}
+	home, err := c.Home()
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(home, "config", "genesis.json"), final, 0o644); err != nil {
+		return err
+	}
+
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return err
+	}
+	nodeID, err := commands.ShowNodeID(ctx)
+	if err != nil {
+		return err
+	}
+	var peers []string
+	for _, p := range l.Peers {
+		if !strings.HasPrefix(p, nodeID+"@") {
+			peers = append(peers, p)
+		}
+	}
+	return setTOMLValues(filepath.Join(home, "config", "config.toml"), map[string]interface{}{
+		"p2p.persistent_peers": strings.Join(peers, ","),
+	})
+}
+
+// verifyJoinRequest verifies that the gentx of the request delegates from the
+// account of the request at most its coins.
+func verifyJoinRequest(r launchstore.JoinRequest) error {
+	info, _, err := cosmosutil.ParseGentx(r.Gentx)
+	if err != nil {
+		return fmt.Errorf("invalid gentx: %w", err)
+	}
+	if info.DelegatorAddress != r.Address {
+		return fmt.Errorf("the gentx delegates from %s instead of %s", info.DelegatorAddress, r.Address)
+	}
+	coins, err := sdk.ParseCoinsNormalized(r.Coins)
+	if err != nil {
+		return err
+	}
+	if !coins.IsAllGTE(sdk.NewCoins(info.SelfDelegation)) {
+		return fmt.Errorf("the self-delegation %s exceeds the coins %s", info.SelfDelegation, coins)
+	}
+	return nil
+}
+
+// addGenesisAccount adds the account to the genesis at the path unless the
+// genesis already has the account.
+func addGenesisAccount(ctx context.Context, runner chaincmdrunner.Runner, genesisPath, address, coins string) error {
+	found, err := genesis.CheckGenesisContainsAddress(genesisPath, address)
+	if err != nil || found {
+		return err
+	}
+	return runner.AddGenesisAccount(ctx, address, coins)
+}
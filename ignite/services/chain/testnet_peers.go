@@ -0,0 +1,186 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/pelletier/go-toml"
+	"github.com/tendermint/tendermint/p2p"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/remotenode"
+)
+
+// TestnetPeer is a node of a local network reached by the other nodes at its
+// public address.
+type TestnetPeer struct {
+	// Name is the name of the node in the directory of the network.
+	Name string
+
+	// ID is the node ID of the node.
+	ID string
+
+	// Host is the public address of the node.
+	Host string
+
+	// Port is the port of the P2P server of the node.
+	Port string
+
+	// Seed is true when the node is a seed node.
+	Seed bool
+}
+
+// String returns the peer address of the node, as listed in the peers of the
+// config.toml of the nodes.
+func (p TestnetPeer) String() string {
+	return fmt.Sprintf("%s@%s", p.ID, net.JoinHostPort(p.Host, p.Port))
+}
+
+// TestnetPeers returns the peers of the nodes of the hosts, their node ID and
+// P2P port are read from the homes of the nodes in the directory of the
+// network.
+func TestnetPeers(dir string, hosts TestnetHosts) ([]TestnetPeer, error) {
+	var peers []TestnetPeer
+	for _, h := range hosts.Nodes {
+		p, err := loadTestnetPeer(filepath.Join(dir, h.Name), h)
+		if err != nil {
+			return nil, fmt.Errorf("node %s: %w", h.Name, err)
+		}
+		peers = append(peers, p)
+	}
+	return peers, nil
+}
+
+// PersistentPeers returns the persistent_peers of the config.toml of a node
+// with the peers, the seed nodes and the node with the name are excluded.
+func PersistentPeers(peers []TestnetPeer, name string) string {
+	return joinPeers(peers, name, false)
+}
+
+// Seeds returns the seeds of the config.toml of a node with the seed nodes of
+// the peers, the node with the name is excluded.
+func Seeds(peers []TestnetPeer, name string) string {
+	return joinPeers(peers, name, true)
+}
+
+func joinPeers(peers []TestnetPeer, name string, seed bool) string {
+	var addresses []string
+	for _, p := range peers {
+		if p.Name != name && p.Seed == seed {
+			addresses = append(addresses, p.String())
+		}
+	}
+	return strings.Join(addresses, ",")
+}
+
+// SetTestnetPeers sets the peers of the config.toml of the homes of the nodes
+// in the directory of the network: the seed nodes are the seeds of the other
+// nodes and the other nodes their persistent peers.
+func SetTestnetPeers(dir string, peers []TestnetPeer) error {
+	for _, p := range peers {
+		path := filepath.Join(dir, p.Name, "config", "config.toml")
+		if err := setTOMLValues(path, testnetPeersConfig(p, peers)); err != nil {
+			return fmt.Errorf("node %s: %w", p.Name, err)
+		}
+	}
+	return nil
+}
+
+// SetDeployedTestnetPeers sets the peers of the config.toml of the nodes
+// deployed to the hosts by DeployTestnet and restarts the nodes.
+func (c *Chain) SetDeployedTestnetPeers(ctx context.Context, dir string, hosts TestnetHosts) error {
+	chainID, err := c.ID()
+	if err != nil {
+		return err
+	}
+
+	remoteDir := hosts.Dir
+	if remoteDir == "" {
+		remoteDir = chainID
+	}
+	nodes, err := loadDeployedNodes(ctx, dir, remoteDir, hosts)
+	if err != nil {
+		return err
+	}
+
+	peers := deployedPeers(nodes)
+	for _, n := range nodes {
+		c.ev.Send(fmt.Sprintf("Setting the peers of %s on %s...", n.Name, n.Host), events.ProgressUpdate())
+
+		configPath := path.Join(n.remoteHome(), "config", "config.toml")
+		content, err := n.host.Run(ctx, "cat "+remotenode.Quote(configPath), nil)
+		if err != nil {
+			return fmt.Errorf("node %s: %w", n.Name, err)
+		}
+		config, err := toml.Load(content)
+		if err != nil {
+			return fmt.Errorf("node %s: invalid config.toml: %w", n.Name, err)
+		}
+		for key, value := range testnetPeersConfig(n.peer, peers) {
+			config.Set(key, value)
+		}
+		updated, err := config.ToTomlString()
+		if err != nil {
+			return err
+		}
+
+		// the config is replaced once written so the node never reads a partial config.
+		script := fmt.Sprintf("cat > %[1]s.new && mv %[1]s.new %[1]s", remotenode.Quote(configPath))
+		if _, err := n.host.Run(ctx, script, []byte(updated)); err != nil {
+			return fmt.Errorf("node %s: %w", n.Name, err)
+		}
+		if err := n.host.Restart(ctx, fmt.Sprintf("%s-%s", chainID, n.Name)); err != nil {
+			return fmt.Errorf("node %s: %w", n.Name, err)
+		}
+	}
+	return nil
+}
+
+// testnetPeersConfig returns the values of the config.toml of the node with
+// its peers.
+func testnetPeersConfig(node TestnetPeer, peers []TestnetPeer) map[string]interface{} {
+	return map[string]interface{}{
+		"p2p.external_address": net.JoinHostPort(node.Host, node.Port),
+		"p2p.persistent_peers": PersistentPeers(peers, node.Name),
+		"p2p.seeds":            Seeds(peers, node.Name),
+		"p2p.seed_mode":        node.Seed,
+	}
+}
+
+// loadTestnetPeer reads the node ID and the P2P port of the home of the node.
+func loadTestnetPeer(home string, h TestnetHost) (TestnetPeer, error) {
+	p := TestnetPeer{
+		Name: h.Name,
+		Host: h.Address,
+		Seed: h.Seed,
+	}
+	if p.Host == "" {
+		p.Host = remotenode.Host{Target: h.Host}.Name()
+	}
+	if p.Host == "" {
+		return TestnetPeer{}, fmt.Errorf("the node %s has no address", h.Name)
+	}
+
+	key, err := p2p.LoadNodeKey(filepath.Join(home, "config", "node_key.json"))
+	if err != nil {
+		return TestnetPeer{}, err
+	}
+	p.ID = string(key.ID())
+
+	config, err := toml.LoadFile(filepath.Join(home, "config", "config.toml"))
+	if err != nil {
+		return TestnetPeer{}, err
+	}
+	laddr, _ := config.Get("p2p.laddr").(string)
+	if _, a, ok := strings.Cut(laddr, "://"); ok {
+		laddr = a
+	}
+	if _, p.Port, err = net.SplitHostPort(laddr); err != nil {
+		return TestnetPeer{}, fmt.Errorf("invalid p2p address %q: %w", laddr, err)
+	}
+	return p, nil
+}
@@ -0,0 +1,65 @@
+package chain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pelletier/go-toml"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/p2p"
+)
+
+func TestSetTestnetPeers(t *testing.T) {
+	dir := t.TempDir()
+	hosts := TestnetHosts{
+		Nodes: []TestnetHost{
+			{Name: "validator-1", Host: "alice@203.0.113.1"},
+			{Name: "validator-2", Host: "alice@203.0.113.2", Address: "validator-2.mars.network"},
+			{Name: "seed-1", Host: "203.0.113.3", Seed: true},
+		},
+	}
+	ids := make(map[string]string)
+	for i, h := range hosts.Nodes {
+		config := filepath.Join(dir, h.Name, "config")
+		require.NoError(t, os.MkdirAll(config, 0o755))
+		key, err := p2p.LoadOrGenNodeKey(filepath.Join(config, "node_key.json"))
+		require.NoError(t, err)
+		ids[h.Name] = string(key.ID())
+		laddr := []string{"tcp://0.0.0.0:26656", "tcp://0.0.0.0:26666", "tcp://127.0.0.1:26676"}[i]
+		content := "[p2p]\nladdr = \"" + laddr + "\"\npersistent_peers = \"\"\n"
+		require.NoError(t, os.WriteFile(filepath.Join(config, "config.toml"), []byte(content), 0o644))
+	}
+
+	peers, err := TestnetPeers(dir, hosts)
+	require.NoError(t, err)
+	require.Equal(t, []TestnetPeer{
+		{Name: "validator-1", ID: ids["validator-1"], Host: "203.0.113.1", Port: "26656"},
+		{Name: "validator-2", ID: ids["validator-2"], Host: "validator-2.mars.network", Port: "26666"},
+		{Name: "seed-1", ID: ids["seed-1"], Host: "203.0.113.3", Port: "26676", Seed: true},
+	}, peers)
+
+	var (
+		validator1 = ids["validator-1"] + "@203.0.113.1:26656"
+		validator2 = ids["validator-2"] + "@validator-2.mars.network:26666"
+		seed1      = ids["seed-1"] + "@203.0.113.3:26676"
+	)
+	require.Equal(t, validator1+","+validator2, PersistentPeers(peers, ""))
+	require.Equal(t, validator2, PersistentPeers(peers, "validator-1"))
+	require.Equal(t, seed1, Seeds(peers, "validator-1"))
+	require.Equal(t, "", Seeds(peers, "seed-1"))
+
+	require.NoError(t, SetTestnetPeers(dir, peers))
+
+	config, err := toml.LoadFile(filepath.Join(dir, "validator-1", "config", "config.toml"))
+	require.NoError(t, err)
+	require.Equal(t, validator2, config.Get("p2p.persistent_peers"))
+	require.Equal(t, seed1, config.Get("p2p.seeds"))
+	require.Equal(t, "203.0.113.1:26656", config.Get("p2p.external_address"))
+	require.Equal(t, false, config.Get("p2p.seed_mode"))
+
+	config, err = toml.LoadFile(filepath.Join(dir, "seed-1", "config", "config.toml"))
+	require.NoError(t, err)
+	require.Equal(t, validator1+","+validator2, config.Get("p2p.persistent_peers"))
+	require.Equal(t, true, config.Get("p2p.seed_mode"))
+}
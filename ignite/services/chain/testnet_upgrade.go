@@ -0,0 +1,305 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pelletier/go-toml"
+
+	"github.com/ignite/cli/ignite/pkg/chaincmd"
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/localnet"
+	"github.com/ignite/cli/ignite/pkg/tendermintrpc"
+	"github.com/ignite/cli/ignite/pkg/xurl"
+)
+
+// DefaultTestnetUpgradeTimeout is the default time the nodes of a local
+// network can go without a new block before the network is considered halted.
+const DefaultTestnetUpgradeTimeout = time.Minute
+
+// testnetUpgradePollInterval is the interval between the checks of the heights
+// of the nodes during the rehearsal of an upgrade.
+const testnetUpgradePollInterval = time.Second
+
+// TestnetUpgradeOptions configures the rehearsal of an upgrade on a local
+// network.
+type TestnetUpgradeOptions struct {
+	// Dir is the directory of the network written by WriteTestnet with
+	// cosmovisor.
+	Dir string
+
+	// Name is the name of the upgrade.
+	Name string
+
+	// Height is the height of the upgrade.
+	Height int64
+
+	// Binary is the binary of the upgrade.
+	Binary string
+
+	// Timeout is the time the nodes can go without a new block before the
+	// network is considered halted.
+	Timeout time.Duration
+}
+
+// TestnetUpgrade is the result of the rehearsal of an upgrade.
+type TestnetUpgrade struct {
+	// ProposalID is the ID of the software upgrade proposal.
+	ProposalID uint64
+
+	// Nodes are the nodes of the network once they resumed after the upgrade.
+	Nodes []TestnetUpgradeNode
+}
+
+// TestnetUpgradeNode is a node of a local network upgraded by a rehearsal.
+type TestnetUpgradeNode struct {
+	// Name is the name of the node.
+	Name string
+
+	// Height is the height of the latest block of the node.
+	Height int64
+}
+
+// upgradeNode is a node of a running local network.
+type upgradeNode struct {
+	name      string
+	home      string
+	rpc       string
+	validator bool
+}
+
+// RehearseTestnetUpgrade rehearses an upgrade on a running local network
+// written by WriteTestnet with cosmovisor. The software upgrade proposal is
+// submitted by the first validator and all the validators vote yes on it once
+// the binary of the upgrade is staged for cosmovisor in the home of each node,
+// then the rehearsal waits until every node commits blocks above the upgrade
+// height with the binary of the upgrade.
+//
+// The voting period of the proposal must end before the upgrade height.
+func (c *Chain) RehearseTestnetUpgrade(ctx context.Context, o TestnetUpgradeOptions) (TestnetUpgrade, error) {
+	if o.Name == "" {
+		return TestnetUpgrade{}, errors.New("the name of the upgrade is required")
+	}
+	if o.Timeout <= 0 {
+		o.Timeout = DefaultTestnetUpgradeTimeout
+	}
+
+	conf, err := c.Config()
+	if err != nil {
+		return TestnetUpgrade{}, err
+	}
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return TestnetUpgrade{}, err
+	}
+	binary, err := c.Binary()
+	if err != nil {
+		return TestnetUpgrade{}, err
+	}
+	if _, err := os.Stat(o.Binary); err != nil {
+		return TestnetUpgrade{}, fmt.Errorf("binary of the upgrade: %w", err)
+	}
+
+	nodes, err := loadUpgradeNodes(o.Dir)
+	if err != nil {
+		return TestnetUpgrade{}, err
+	}
+	first := nodes[0]
+
+	latest, err := tendermintrpc.New(first.rpc).LatestHeight(ctx)
+	if err != nil {
+		return TestnetUpgrade{}, fmt.Errorf("the network is not running: %w", err)
+	}
+	if o.Height <= latest {
+		return TestnetUpgrade{}, fmt.Errorf("the upgrade height %d is not above the latest height %d", o.Height, latest)
+	}
+
+	deposit, err := minDeposit(filepath.Join(first.home, "config", "genesis.json"))
+	if err != nil {
+		return TestnetUpgrade{}, err
+	}
+
+	// cosmovisor switches to the binary of the upgrade at the upgrade height.
+	for _, n := range nodes {
+		if err := stageCosmovisorBinary(n.home, o.Name, o.Binary, filepath.Base(binary)); err != nil {
+			return TestnetUpgrade{}, fmt.Errorf("node %s: %w", n.name, err)
+		}
+	}
+
+	u := TestnetUpgrade{}
+	for _, n := range nodes {
+		if !n.validator {
+			continue
+		}
+		runner, err := chaincmdrunner.New(ctx, commands.Cmd().Copy(
+			chaincmd.WithHome(n.home),
+			chaincmd.WithNodeAddress(n.rpc),
+		))
+		if err != nil {
+			return TestnetUpgrade{}, err
+		}
+
+		// the first validator is the validator of the config, the other
+		// validators have the key of their name.
+		account := n.name
+		if n.name == first.name {
+			account = conf.Validators[0].Name
+		}
+
+		if u.ProposalID == 0 {
+			c.ev.Send(fmt.Sprintf("Submitting the proposal of the upgrade %s at height %d...", o.Name, o.Height), events.ProgressUpdate())
+			if u.ProposalID, err = runner.SubmitSoftwareUpgradeProposal(ctx, account, o.Name, o.Height, deposit); err != nil {
+				return TestnetUpgrade{}, err
+			}
+		}
+
+		c.ev.Send(fmt.Sprintf("Voting yes on proposal %d with %s...", u.ProposalID, n.name), events.ProgressUpdate())
+		if err := runner.GovVote(ctx, account, u.ProposalID, chaincmdrunner.VoteYes); err != nil {
+			return TestnetUpgrade{}, fmt.Errorf("node %s: %w", n.name, err)
+		}
+	}
+
+	if u.Nodes, err = c.waitTestnetUpgrade(ctx, nodes, o); err != nil {
+		return TestnetUpgrade{}, err
+	}
+	return u, nil
+}
+
+// waitTestnetUpgrade waits until the nodes commit blocks above the upgrade
+// height with the binary of the upgrade, it fails when the nodes don't commit
+// a new block within the timeout.
+func (c *Chain) waitTestnetUpgrade(ctx context.Context, nodes []upgradeNode, o TestnetUpgradeOptions) ([]TestnetUpgradeNode, error) {
+	var (
+		heights      = make(map[string]int64)
+		lastProgress = time.Now()
+		ticker       = time.NewTicker(testnetUpgradePollInterval)
+	)
+	defer ticker.Stop()
+
+	for {
+		resumed := true
+		for _, n := range nodes {
+			// the node doesn't answer while cosmovisor switches the binary.
+			height, err := tendermintrpc.New(n.rpc).LatestHeight(ctx)
+			if err == nil && height > heights[n.name] {
+				heights[n.name] = height
+				lastProgress = time.Now()
+			}
+			if heights[n.name] <= o.Height {
+				resumed = false
+			}
+		}
+		if resumed {
+			break
+		}
+
+		if time.Since(lastProgress) > o.Timeout {
+			var max int64
+			for _, h := range heights {
+				if h > max {
+					max = h
+				}
+			}
+			if max >= o.Height-1 {
+				return nil, fmt.Errorf("the network halted at the upgrade height %d and did not resume within %s", o.Height, o.Timeout)
+			}
+			return nil, fmt.Errorf("the network halted at height %d before the upgrade height %d", max, o.Height)
+		}
+		c.ev.Send(fmt.Sprintf("Waiting for the network to resume after the upgrade height %d, height %d...", o.Height, heights[nodes[0].name]), events.ProgressUpdate())
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+
+	var upgraded []TestnetUpgradeNode
+	for _, n := range nodes {
+		// cosmovisor links the current binary to the binary of the upgrade.
+		current, err := os.Readlink(filepath.Join(n.home, localnet.CosmovisorDir, "current"))
+		if err != nil || filepath.Base(current) != o.Name {
+			return nil, fmt.Errorf("the node %s did not switch to the binary of the upgrade %s, the proposal must pass before the upgrade height", n.name, o.Name)
+		}
+		upgraded = append(upgraded, TestnetUpgradeNode{Name: n.name, Height: heights[n.name]})
+	}
+	return upgraded, nil
+}
+
+// loadUpgradeNodes reads the nodes of the network in the directory, the
+// validators come first.
+func loadUpgradeNodes(dir string) ([]upgradeNode, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var validators, fullNodes []upgradeNode
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		home := filepath.Join(dir, e.Name())
+		config, err := toml.LoadFile(filepath.Join(home, "config", "config.toml"))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if _, err := os.Stat(filepath.Join(home, localnet.CosmovisorDir)); err != nil {
+			return nil, fmt.Errorf("the node %s doesn't run with cosmovisor, write the network with \"ignite testnet multi-node --cosmovisor\"", e.Name())
+		}
+
+		laddr, _ := config.Get("rpc.laddr").(string)
+		rpc, err := xurl.HTTP(laddr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rpc address %q of node %s: %w", laddr, e.Name(), err)
+		}
+
+		n := upgradeNode{name: e.Name(), home: home, rpc: rpc}
+		if strings.HasPrefix(n.name, "validator-") {
+			n.validator = true
+			validators = append(validators, n)
+		} else {
+			fullNodes = append(fullNodes, n)
+		}
+	}
+	if len(validators) == 0 {
+		return nil, fmt.Errorf("no validator nodes in %s", dir)
+	}
+	return append(validators, fullNodes...), nil
+}
+
+// minDeposit returns the min deposit of the proposals of the genesis.
+func minDeposit(genesisPath string) (string, error) {
+	content, err := os.ReadFile(genesisPath)
+	if err != nil {
+		return "", err
+	}
+	var genesis struct {
+		AppState struct {
+			Gov struct {
+				DepositParams struct {
+					MinDeposit sdk.Coins `json:"min_deposit"`
+				} `json:"deposit_params"`
+			} `json:"gov"`
+		} `json:"app_state"`
+	}
+	if err := json.Unmarshal(content, &genesis); err != nil {
+		return "", fmt.Errorf("invalid genesis %s: %w", genesisPath, err)
+	}
+	deposit := genesis.AppState.Gov.DepositParams.MinDeposit
+	if deposit.Empty() {
+		return "", fmt.Errorf("the genesis %s has no min deposit of the proposals", genesisPath)
+	}
+	return deposit.String(), nil
+}
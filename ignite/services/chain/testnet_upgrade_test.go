@@ -0,0 +1,67 @@
+package chain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadUpgradeNodes(t *testing.T) {
+	dir := t.TempDir()
+	for name, laddr := range map[string]string{
+		"full-node-1": "tcp://0.0.0.0:26677",
+		"validator-1": "tcp://0.0.0.0:26657",
+		"validator-2": "tcp://0.0.0.0:26667",
+	} {
+		home := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0o755))
+		require.NoError(t, os.MkdirAll(filepath.Join(home, "cosmovisor", "genesis", "bin"), 0o755))
+		content := "[rpc]\nladdr = \"" + laddr + "\"\n"
+		require.NoError(t, os.WriteFile(filepath.Join(home, "config", "config.toml"), []byte(content), 0o644))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Procfile"), nil, 0o644))
+
+	nodes, err := loadUpgradeNodes(dir)
+	require.NoError(t, err)
+	require.Equal(t, []upgradeNode{
+		{name: "validator-1", home: filepath.Join(dir, "validator-1"), rpc: "http://0.0.0.0:26657", validator: true},
+		{name: "validator-2", home: filepath.Join(dir, "validator-2"), rpc: "http://0.0.0.0:26667", validator: true},
+		{name: "full-node-1", home: filepath.Join(dir, "full-node-1"), rpc: "http://0.0.0.0:26677"},
+	}, nodes)
+
+	require.NoError(t, os.RemoveAll(filepath.Join(dir, "validator-2", "cosmovisor")))
+	_, err = loadUpgradeNodes(dir)
+	require.EqualError(t, err, `the node validator-2 doesn't run with cosmovisor, write the network with "ignite testnet multi-node --cosmovisor"`)
+}
+
+func TestStageCosmovisorBinary(t *testing.T) {
+	home := t.TempDir()
+	binary := filepath.Join(t.TempDir(), "marsd-v2")
+	require.NoError(t, os.WriteFile(binary, []byte("v2"), 0o755))
+
+	require.NoError(t, stageCosmovisorBinary(home, "v2", binary, "marsd"))
+
+	path := filepath.Join(home, "cosmovisor", "upgrades", "v2", "bin", "marsd")
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "v2", string(content))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.NotZero(t, info.Mode()&0o100)
+}
+
+func TestMinDeposit(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "genesis.json")
+	content := `{"app_state":{"gov":{"deposit_params":{"min_deposit":[{"denom":"stake","amount":"10000000"}]}}}}`
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+
+	deposit, err := minDeposit(path)
+	require.NoError(t, err)
+	require.Equal(t, "10000000stake", deposit)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"app_state":{}}`), 0o644))
+	_, err = minDeposit(path)
+	require.Error(t, err)
+}
@@ -0,0 +1,67 @@
+package chain
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/sshtunnel"
+)
+
+// TunnelForwards returns the ports of the chain servers that can be shared through an SSH tunnel.
+// The faucet port is only included when the faucet is enabled.
+func (c *Chain) TunnelForwards() ([]sshtunnel.Forward, error) {
+	config, err := c.Config()
+	if err != nil {
+		return nil, err
+	}
+
+	return tunnelForwards(config)
+}
+
+// tunnelAddress is the address of a chain server shared through a tunnel.
+type tunnelAddress struct {
+	name, address string
+}
+
+func tunnelForwards(config *chainconfig.Config) ([]sshtunnel.Forward, error) {
+	servers, err := config.Validators[0].GetServers()
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := []tunnelAddress{
+		{"Tendermint node", servers.RPC.Address},
+		{"Blockchain API", servers.API.Address},
+	}
+	if config.Faucet.Name != nil {
+		addresses = append(addresses, tunnelAddress{"Token faucet", chainconfig.FaucetHost(config)})
+	}
+
+	forwards := make([]sshtunnel.Forward, 0, len(addresses))
+	for _, a := range addresses {
+		port, err := addressPort(a.address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s address %q: %w", strings.ToLower(a.name), a.address, err)
+		}
+		forwards = append(forwards, sshtunnel.Forward{Name: a.name, Port: port})
+	}
+
+	return forwards, nil
+}
+
+// addressPort returns the port of an address with an optional scheme, e.g. "tcp://0.0.0.0:26657".
+func addressPort(address string) (int, error) {
+	if i := strings.Index(address, "://"); i != -1 {
+		address = address[i+3:]
+	}
+
+	_, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.Atoi(port)
+}
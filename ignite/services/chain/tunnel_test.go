@@ -0,0 +1,59 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	v1 "github.com/ignite/cli/ignite/chainconfig/v1"
+	"github.com/ignite/cli/ignite/pkg/sshtunnel"
+)
+
+func TestTunnelForwards(t *testing.T) {
+	faucetName := "bob"
+	conf := &chainconfig.Config{Validators: []v1.Validator{{Name: "alice"}}}
+
+	forwards, err := tunnelForwards(conf)
+	require.NoError(t, err)
+	require.Equal(t, []sshtunnel.Forward{
+		{Name: "Tendermint node", Port: 26657},
+		{Name: "Blockchain API", Port: 1317},
+	}, forwards)
+
+	conf.Faucet.Name = &faucetName
+	conf.Faucet.Host = "0.0.0.0:4500"
+
+	forwards, err = tunnelForwards(conf)
+	require.NoError(t, err)
+	require.Equal(t, []sshtunnel.Forward{
+		{Name: "Tendermint node", Port: 26657},
+		{Name: "Blockchain API", Port: 1317},
+		{Name: "Token faucet", Port: 4500},
+	}, forwards)
+}
+
+func TestAddressPort(t *testing.T) {
+	tests := []struct {
+		address string
+		want    int
+		wantErr bool
+	}{
+		{address: "0.0.0.0:26657", want: 26657},
+		{address: "tcp://0.0.0.0:26657", want: 26657},
+		{address: ":4500", want: 4500},
+		{address: "localhost", wantErr: true},
+		{address: "localhost:port", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			port, err := addressPort(tt.address)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, port)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Package doctor diagnoses the development environment of a blockchain app.
+package doctor
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+
+	"github.com/ignite/cli/ignite/chainconfig/config"
+	"github.com/ignite/cli/ignite/pkg/localfs"
+)
+
+const (
+	wslReleaseFile  = "/proc/sys/kernel/osrelease"
+	dockerEnvFile   = "/.dockerenv"
+	wslReleaseToken = "microsoft"
+)
+
+// FileSystemReport describes the file system where the app source code is stored.
+type FileSystemReport struct {
+	// FileSystem is the file system of the app path.
+	FileSystem localfs.FileSystem
+
+	// WSL is true when running in the Windows Subsystem for Linux.
+	WSL bool
+
+	// Docker is true when running in a Docker container.
+	Docker bool
+
+	// Watch is the source code change detection configured for the app.
+	Watch config.Watch
+}
+
+// CheckFileSystem diagnoses the file system where the app is stored.
+func CheckFileSystem(appPath string, watch config.Watch) (FileSystemReport, error) {
+	fs, err := localfs.DetectFileSystem(appPath)
+	if err != nil {
+		return FileSystemReport{}, err
+	}
+
+	wsl, err := isWSL()
+	if err != nil {
+		return FileSystemReport{}, err
+	}
+
+	return FileSystemReport{
+		FileSystem: fs,
+		WSL:        wsl,
+		Docker:     isDocker(),
+		Watch:      watch,
+	}, nil
+}
+
+// Recommendations returns the config changes recommended to reliably detect source code changes.
+func (r FileSystemReport) Recommendations() []string {
+	if !r.FileSystem.Remote {
+		return nil
+	}
+
+	var recommendations []string
+	if r.Watch.Mode == config.WatchModeModTime {
+		recommendations = append(recommendations,
+			`the file modification times might not be reliable, use the "hash" watch mode instead`,
+		)
+	}
+	if r.Watch.Interval == "" {
+		recommendations = append(recommendations,
+			`set a watch interval of at least one second to reduce the file system load, e.g. "1s"`,
+		)
+	}
+	if r.WSL {
+		recommendations = append(recommendations,
+			"move the app to the Linux file system of WSL, e.g. your home directory, for faster builds and change detection",
+		)
+	}
+	return recommendations
+}
+
+func isWSL() (bool, error) {
+	release, err := os.ReadFile(wslReleaseFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return bytes.Contains(bytes.ToLower(release), []byte(wslReleaseToken)), nil
+}
+
+func isDocker() bool {
+	if _, err := os.Stat(dockerEnvFile); err == nil {
+		return true
+	}
+	cgroup, err := os.ReadFile("/proc/1/cgroup")
+	return err == nil && strings.Contains(string(cgroup), "docker")
+}
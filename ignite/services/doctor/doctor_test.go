@@ -0,0 +1,62 @@
+package doctor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig/config"
+	"github.com/ignite/cli/ignite/pkg/localfs"
+)
+
+func TestFileSystemReportRecommendations(t *testing.T) {
+	var (
+		local  = localfs.FileSystem{Type: "ext4"}
+		remote = localfs.FileSystem{Type: "9p", Remote: true}
+	)
+
+	tests := []struct {
+		name   string
+		report FileSystemReport
+		want   int
+	}{
+		{
+			name:   "local file system",
+			report: FileSystemReport{FileSystem: local},
+		},
+		{
+			name: "remote file system with hash polling",
+			report: FileSystemReport{
+				FileSystem: remote,
+				Watch:      config.Watch{Mode: config.WatchModeHash, Interval: "1s"},
+			},
+		},
+		{
+			name:   "remote file system with default settings",
+			report: FileSystemReport{FileSystem: remote},
+			want:   1,
+		},
+		{
+			name: "remote file system with modification times",
+			report: FileSystemReport{
+				FileSystem: remote,
+				Watch:      config.Watch{Mode: config.WatchModeModTime},
+			},
+			want: 2,
+		},
+		{
+			name: "remote file system in WSL",
+			report: FileSystemReport{
+				FileSystem: remote,
+				WSL:        true,
+				Watch:      config.Watch{Interval: "1s"},
+			},
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Len(t, tt.report.Recommendations(), tt.want)
+		})
+	}
+}
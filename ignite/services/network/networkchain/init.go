@@ -8,6 +8,7 @@ import (
 
 	"github.com/ignite/cli/ignite/pkg/cache"
 	cosmosgenesis "github.com/ignite/cli/ignite/pkg/cosmosutil/genesis"
+	"github.com/ignite/cli/ignite/pkg/downloader"
 	"github.com/ignite/cli/ignite/pkg/events"
 )
 
@@ -65,7 +66,9 @@ func (c *Chain) initGenesis(ctx context.Context) error {
 	// otherwise, the default genesis is used, which requires no action since the default genesis is generated from the init command
 	if c.genesisURL != "" {
 		c.ev.Send("Fetching custom Genesis from URL", events.ProgressUpdate())
-		genesis, err := cosmosgenesis.FromURL(ctx, c.genesisURL, genesisPath)
+		genesis, err := cosmosgenesis.FromURL(ctx, c.genesisURL, genesisPath, downloader.WithProgress(func(p downloader.Progress) {
+			c.ev.Send(fmt.Sprintf("Fetching custom Genesis from URL %s", p), events.ProgressUpdate())
+		}))
 		if err != nil {
 			return err
 		}
@@ -18,6 +18,7 @@ import (
 	"github.com/ignite/cli/ignite/pkg/checksum"
 	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
 	"github.com/ignite/cli/ignite/pkg/cosmosver"
+	"github.com/ignite/cli/ignite/pkg/downloader"
 	"github.com/ignite/cli/ignite/pkg/events"
 	"github.com/ignite/cli/ignite/pkg/gitpod"
 	"github.com/ignite/cli/ignite/services/chain"
@@ -382,7 +383,7 @@ func fetchSource(
 		gitoptions.ReferenceName = ref
 		gitoptions.SingleBranch = true
 	}
-	if repo, err = git.PlainCloneContext(ctx, path, false, gitoptions); err != nil {
+	if repo, err = downloader.New().Clone(ctx, path, gitoptions); err != nil {
 		return "", "", err
 	}
 
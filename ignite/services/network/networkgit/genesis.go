@@ -0,0 +1,316 @@
+package networkgit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pelletier/go-toml"
+
+	"github.com/ignite/cli/ignite/pkg/chaincmd"
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+)
+
+// Validator is the validator of a node of the network.
+type Validator struct {
+	// Name is the name of the validator, it's the name of its gentx and of
+	// its account in the keyring of the node.
+	Name string
+
+	// SelfDelegation is the self-delegation of the validator.
+	SelfDelegation string
+
+	// PeerAddress is the public address of the node, the address detected by
+	// the binary of the chain is used when it's empty.
+	PeerAddress string
+}
+
+// DefaultGenesis returns the default genesis of the chain with chainID from
+// the init command of its binary. The commands are the commands of the chain.
+func DefaultGenesis(ctx context.Context, commands chaincmdrunner.Runner, chainID string) ([]byte, error) {
+	home, err := os.MkdirTemp("", "ignite-network-git")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(home)
+
+	runner, err := chaincmdrunner.New(ctx, commands.Cmd().Copy(
+		chaincmd.WithChainID(chainID),
+		chaincmd.WithHome(home),
+	))
+	if err != nil {
+		return nil, err
+	}
+	if err := runner.Init(ctx, "coordinator"); err != nil {
+		return nil, err
+	}
+
+	return os.ReadFile(filepath.Join(home, "config", "genesis.json"))
+}
+
+// CheckGentxs checks that the self-delegations of the gentxs are covered by
+// the validator balance of the network and that the validators are unique.
+func (r Repository) CheckGentxs(gentxs []Gentx) error {
+	balance, err := sdk.ParseCoinsNormalized(r.config.ValidatorBalance)
+	if err != nil {
+		return err
+	}
+
+	var (
+		delegators = make(map[string]string)
+		pubKeys    = make(map[string]string)
+	)
+	for _, gentx := range gentxs {
+		delegation := gentx.Info.SelfDelegation
+		if delegation.Amount.GT(balance.AmountOf(delegation.Denom)) {
+			return fmt.Errorf("the self-delegation %s of the gentx %s is greater than the validator balance %s", delegation, gentx.Name, balance)
+		}
+
+		if name, ok := delegators[gentx.Info.DelegatorAddress]; ok {
+			return fmt.Errorf("the gentxs %s and %s have the same delegator", name, gentx.Name)
+		}
+		delegators[gentx.Info.DelegatorAddress] = gentx.Name
+
+		pubKey := gentx.Info.PubKey.String()
+		if name, ok := pubKeys[pubKey]; ok {
+			return fmt.Errorf("the gentxs %s and %s have the same validator key", name, gentx.Name)
+		}
+		pubKeys[pubKey] = gentx.Name
+	}
+
+	return nil
+}
+
+// BuildGenesis builds the genesis of the chain from the initial genesis and
+// the gentxs of the repository with the binary of the chain, the genesis is
+// validated with the binary and returned with the peer addresses of the
+// validators. The commands must be the commands of the chain of the network.
+func (r Repository) BuildGenesis(ctx context.Context, commands chaincmdrunner.Runner) (genesis []byte, peers []string, err error) {
+	gentxs, err := r.Gentxs()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(gentxs) == 0 {
+		return nil, nil, errors.New("the network has no gentx")
+	}
+	if err := r.CheckGentxs(gentxs); err != nil {
+		return nil, nil, err
+	}
+
+	initialGenesis, err := os.ReadFile(r.GenesisPath())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	home, err := os.MkdirTemp("", "ignite-network-git")
+	if err != nil {
+		return nil, nil, err
+	}
+	defer os.RemoveAll(home)
+
+	runner, err := r.runner(ctx, commands, home)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := runner.Init(ctx, "coordinator"); err != nil {
+		return nil, nil, err
+	}
+
+	genesisPath := filepath.Join(home, "config", "genesis.json")
+	if err := os.WriteFile(genesisPath, initialGenesis, 0o644); err != nil {
+		return nil, nil, err
+	}
+
+	gentxsPath := filepath.Join(home, "config", "gentx")
+	if err := os.MkdirAll(gentxsPath, 0o755); err != nil {
+		return nil, nil, err
+	}
+
+	for _, gentx := range gentxs {
+		if err := runner.AddGenesisAccount(ctx, gentx.Info.DelegatorAddress, r.config.ValidatorBalance); err != nil {
+			return nil, nil, fmt.Errorf("cannot add the account of the gentx %s: %w", gentx.Name, err)
+		}
+		if err := os.WriteFile(filepath.Join(gentxsPath, gentx.Name+".json"), gentx.Content, 0o644); err != nil {
+			return nil, nil, err
+		}
+		if gentx.Info.Memo != "" {
+			peers = append(peers, gentx.Info.Memo)
+		}
+	}
+
+	if err := runner.CollectGentxs(ctx); err != nil {
+		return nil, nil, err
+	}
+	if err := runner.ValidateGenesis(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	genesis, err = os.ReadFile(genesisPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return genesis, peers, nil
+}
+
+// CreateGentx creates the gentx of the validator of the node of the chain in
+// home from the initial genesis of the network. The genesis of the node is
+// replaced by the initial genesis and the account of the validator is created
+// when it doesn't exist. The commands must be the commands of the chain of the
+// node and the account is returned with its mnemonic when it's created.
+func (r Repository) CreateGentx(
+	ctx context.Context,
+	commands chaincmdrunner.Runner,
+	home string,
+	validator Validator,
+) (account chaincmdrunner.Account, gentx []byte, err error) {
+	runner, err := r.runner(ctx, commands, home)
+	if err != nil {
+		return account, nil, err
+	}
+
+	genesisPath := filepath.Join(home, "config", "genesis.json")
+	if _, err := os.Stat(genesisPath); errors.Is(err, os.ErrNotExist) {
+		if err := runner.Init(ctx, validator.Name); err != nil {
+			return account, nil, err
+		}
+	}
+
+	account, err = runner.ShowAccount(ctx, validator.Name)
+	if errors.Is(err, chaincmdrunner.ErrAccountDoesNotExist) {
+		account, err = runner.AddAccount(ctx, validator.Name, "", "")
+	}
+	if err != nil {
+		return account, nil, err
+	}
+
+	// The gentxs of the previous networks of the node must not be collected
+	if err := os.RemoveAll(filepath.Join(home, "config", "gentx")); err != nil {
+		return account, nil, err
+	}
+
+	initialGenesis, err := os.ReadFile(r.GenesisPath())
+	if err != nil {
+		return account, nil, err
+	}
+	if err := os.WriteFile(genesisPath, initialGenesis, 0o644); err != nil {
+		return account, nil, err
+	}
+
+	// The gentx command checks the balance of the account of the validator
+	if err := runner.AddGenesisAccount(ctx, account.Address, r.config.ValidatorBalance); err != nil {
+		return account, nil, err
+	}
+
+	var memo string
+	if validator.PeerAddress != "" {
+		nodeID, err := runner.ShowNodeID(ctx)
+		if err != nil {
+			return account, nil, err
+		}
+		memo = fmt.Sprintf("%s@%s", nodeID, validator.PeerAddress)
+	}
+
+	gentxPath, err := runner.Gentx(
+		ctx,
+		validator.Name,
+		validator.SelfDelegation,
+		chaincmd.GentxWithMoniker(validator.Name),
+		chaincmd.GentxWithMemo(memo),
+	)
+	if err != nil {
+		return account, nil, err
+	}
+
+	_, gentx, err = cosmosutil.GentxFromPath(gentxPath)
+	if err != nil {
+		return account, nil, err
+	}
+
+	// The initial genesis with the account of the validator is not the genesis
+	// of the network, the node is started with the finalized genesis
+	return account, gentx, os.WriteFile(genesisPath, initialGenesis, 0o644)
+}
+
+// Prepare prepares the node of the chain in home to start with the finalized
+// genesis of the network: the genesis of the node is replaced, the validators
+// are added to the persistent peers of the node and the state of the node is
+// reset. The commands must be the commands of the chain of the node.
+func (r Repository) Prepare(ctx context.Context, commands chaincmdrunner.Runner, home string) error {
+	if !r.IsFinalized() {
+		return errors.New("the genesis of the network is not finalized yet")
+	}
+
+	runner, err := r.runner(ctx, commands, home)
+	if err != nil {
+		return err
+	}
+
+	genesis, err := os.ReadFile(filepath.Join(r.path, filepath.FromSlash(LaunchGenesisFile)))
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(home, "config", "genesis.json"), genesis, 0o644); err != nil {
+		return err
+	}
+
+	peers, err := r.Peers()
+	if err != nil {
+		return err
+	}
+	nodeID, err := runner.ShowNodeID(ctx)
+	if err != nil {
+		return err
+	}
+
+	// The node is not a peer of itself
+	var persistentPeers []string
+	for _, peer := range peers {
+		if !strings.HasPrefix(peer, nodeID+"@") {
+			persistentPeers = append(persistentPeers, peer)
+		}
+	}
+
+	if err := setPersistentPeers(filepath.Join(home, "config", "config.toml"), persistentPeers); err != nil {
+		return err
+	}
+
+	if err := runner.ValidateGenesis(ctx); err != nil {
+		return err
+	}
+
+	return runner.UnsafeReset(ctx)
+}
+
+// runner returns the runner of the commands of the chain of the network with
+// home as home of the chain.
+func (r Repository) runner(ctx context.Context, commands chaincmdrunner.Runner, home string) (chaincmdrunner.Runner, error) {
+	return chaincmdrunner.New(ctx, commands.Cmd().Copy(
+		chaincmd.WithChainID(r.config.ChainID),
+		chaincmd.WithHome(home),
+	))
+}
+
+// setPersistentPeers sets the persistent peers of the node in its config file.
+func setPersistentPeers(configPath string, peers []string) error {
+	config, err := toml.LoadFile(configPath)
+	if err != nil {
+		return err
+	}
+
+	config.Set("p2p.persistent_peers", strings.Join(peers, ","))
+
+	f, err := os.OpenFile(configPath, os.O_RDWR|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = config.WriteTo(f)
+	return err
+}
@@ -0,0 +1,422 @@
+// Package networkgit coordinates the launch of a chain with a git repository
+// instead of a coordination chain.
+//
+// The coordinator publishes the initial genesis of the chain in a repository,
+// the validators open pull requests that add their gentx to the repository, the
+// pull requests are verified and merged by a workflow, and the coordinator
+// finalizes the genesis of the chain from the merged gentxs.
+package networkgit
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v2"
+
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/exec"
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/step"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+	"github.com/ignite/cli/ignite/pkg/xexec"
+)
+
+const (
+	// ConfigFile is the file of the config of the network.
+	ConfigFile = "network.yml"
+
+	// GenesisFile is the file of the initial genesis of the chain.
+	GenesisFile = "genesis.json"
+
+	// GentxsDir is the directory of the gentxs of the validators.
+	GentxsDir = "gentxs"
+
+	// LaunchGenesisFile is the file of the finalized genesis of the chain.
+	LaunchGenesisFile = "launch/genesis.json"
+
+	// LaunchPeersFile is the file of the peer addresses of the validators.
+	LaunchPeersFile = "launch/peers.txt"
+
+	// WorkflowFile is the file of the workflow that verifies and merges the
+	// pull requests of the validators.
+	WorkflowFile = ".github/workflows/gentx.yml"
+
+	gentxBranchPrefix = "gentx/"
+)
+
+var (
+	// ErrFinalized is returned when the gentxs are changed once the genesis
+	// of the network is finalized.
+	ErrFinalized = errors.New("the genesis of the network is finalized")
+
+	// ErrGitHubCLINotInstalled is returned when the pull request of a gentx
+	// can't be opened because the GitHub CLI is not installed.
+	ErrGitHubCLINotInstalled = errors.New(`the "gh" command is not installed, see https://cli.github.com`)
+
+	gentxNameRe = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+)
+
+// Config is the config of a network coordinated with a git repository.
+type Config struct {
+	// ChainID is the ID of the chain.
+	ChainID string `yaml:"chain_id"`
+
+	// Source is the Go module path of the source code of the chain.
+	Source string `yaml:"source,omitempty"`
+
+	// Hash is the git commit of the source code of the chain.
+	Hash string `yaml:"hash,omitempty"`
+
+	// ValidatorBalance is the balance of the account of each validator in the
+	// genesis, the self-delegation of the gentxs can't be greater.
+	ValidatorBalance string `yaml:"validator_balance"`
+}
+
+// Validate checks the config.
+func (c Config) Validate() error {
+	if c.ChainID == "" {
+		return errors.New("the chain ID is empty")
+	}
+
+	coins, err := sdk.ParseCoinsNormalized(c.ValidatorBalance)
+	if err != nil {
+		return fmt.Errorf("invalid validator balance: %w", err)
+	}
+	if coins.IsZero() {
+		return errors.New("the validator balance is empty")
+	}
+
+	return nil
+}
+
+// Gentx is the gentx of a validator.
+type Gentx struct {
+	// Name is the name of the gentx file, without extension.
+	Name string
+
+	// Content is the content of the gentx file.
+	Content []byte
+
+	// Info is the info of the gentx.
+	Info cosmosutil.GentxInfo
+}
+
+// Repository is the local clone of the repository of a network.
+type Repository struct {
+	path   string
+	config Config
+}
+
+// Open opens the repository of a network cloned in path.
+func Open(path string) (Repository, error) {
+	content, err := os.ReadFile(filepath.Join(path, ConfigFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return Repository{}, fmt.Errorf("%s is not the repository of a network, %s not found", path, ConfigFile)
+	}
+	if err != nil {
+		return Repository{}, err
+	}
+
+	var config Config
+	if err := yaml.Unmarshal(content, &config); err != nil {
+		return Repository{}, fmt.Errorf("invalid %s: %w", ConfigFile, err)
+	}
+	if err := config.Validate(); err != nil {
+		return Repository{}, fmt.Errorf("invalid %s: %w", ConfigFile, err)
+	}
+
+	return Repository{
+		path:   path,
+		config: config,
+	}, nil
+}
+
+// Create creates the repository of a network in path with the config of the
+// network and the initial genesis of the chain, and commits them. The git
+// repository is initialized when path is not a git repository yet.
+func Create(ctx context.Context, path string, config Config, genesis []byte) (Repository, error) {
+	if err := config.Validate(); err != nil {
+		return Repository{}, err
+	}
+
+	if _, err := os.Stat(filepath.Join(path, ConfigFile)); err == nil {
+		return Repository{}, fmt.Errorf("the network is already published in %s", path)
+	}
+
+	if err := os.MkdirAll(path, 0o755); err != nil {
+		return Repository{}, err
+	}
+
+	r := Repository{
+		path:   path,
+		config: config,
+	}
+
+	if _, err := os.Stat(filepath.Join(path, ".git")); errors.Is(err, os.ErrNotExist) {
+		if err := r.git(ctx, "init"); err != nil {
+			return Repository{}, err
+		}
+	}
+
+	content, err := yaml.Marshal(config)
+	if err != nil {
+		return Repository{}, err
+	}
+
+	workflow, err := renderWorkflow(config)
+	if err != nil {
+		return Repository{}, err
+	}
+
+	files := map[string][]byte{
+		ConfigFile:                           content,
+		GenesisFile:                          genesis,
+		filepath.Join(GentxsDir, ".gitkeep"): nil,
+		filepath.FromSlash(WorkflowFile):     workflow,
+	}
+	for name, content := range files {
+		if err := r.writeFile(name, content); err != nil {
+			return Repository{}, err
+		}
+	}
+
+	var paths []string
+	for name := range files {
+		paths = append(paths, name)
+	}
+	if err := r.commit(ctx, fmt.Sprintf("Publish the network of %s", config.ChainID), paths...); err != nil {
+		return Repository{}, err
+	}
+
+	return r, nil
+}
+
+// Path returns the path of the repository.
+func (r Repository) Path() string {
+	return r.path
+}
+
+// Config returns the config of the network.
+func (r Repository) Config() Config {
+	return r.config
+}
+
+// GenesisPath returns the path of the initial genesis of the chain.
+func (r Repository) GenesisPath() string {
+	return filepath.Join(r.path, GenesisFile)
+}
+
+// IsFinalized checks if the genesis of the network is finalized.
+func (r Repository) IsFinalized() bool {
+	_, err := os.Stat(filepath.Join(r.path, filepath.FromSlash(LaunchGenesisFile)))
+	return err == nil
+}
+
+// Gentxs returns the gentxs of the validators sorted by name.
+func (r Repository) Gentxs() ([]Gentx, error) {
+	paths, err := filepath.Glob(filepath.Join(r.path, GentxsDir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	gentxs := make([]Gentx, 0, len(paths))
+	for _, path := range paths {
+		name := strings.TrimSuffix(filepath.Base(path), ".json")
+		info, content, err := cosmosutil.GentxFromPath(path)
+		if err != nil {
+			return nil, fmt.Errorf("invalid gentx %s: %w", name, err)
+		}
+
+		gentxs = append(gentxs, Gentx{
+			Name:    name,
+			Content: content,
+			Info:    info,
+		})
+	}
+
+	return gentxs, nil
+}
+
+// AddGentx commits the gentx of a validator in a new branch and returns the
+// name of the branch. The name of the gentx is the name of the validator. The
+// repository stays on its current branch, which must not have uncommitted
+// changes.
+func (r Repository) AddGentx(ctx context.Context, name string, gentx []byte) (branch string, err error) {
+	if r.IsFinalized() {
+		return "", ErrFinalized
+	}
+	if !gentxNameRe.MatchString(name) {
+		return "", fmt.Errorf("invalid gentx name %q, use lower case letters, digits, - and _", name)
+	}
+	if _, _, err := cosmosutil.ParseGentx(gentx); err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(GentxsDir, name+".json")
+	if _, err := os.Stat(filepath.Join(r.path, path)); err == nil {
+		return "", fmt.Errorf("the network already has a gentx named %s", name)
+	}
+
+	status, err := r.status(ctx)
+	if err != nil {
+		return "", err
+	}
+	if status != "" {
+		return "", fmt.Errorf("the repository %s has uncommitted changes, commit or stash them first", r.path)
+	}
+
+	base, err := r.currentBranch(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	gentxBranch := gentxBranchPrefix + name
+	if err := r.git(ctx, "checkout", "-b", gentxBranch); err != nil {
+		return "", err
+	}
+
+	// the repository is switched back to the base branch, the gentx file and
+	// its branch are removed when the gentx is not committed.
+	defer func() {
+		if err != nil {
+			r.git(ctx, "rm", "--cached", "--quiet", "--ignore-unmatch", "--", path) //nolint:errcheck
+			os.Remove(filepath.Join(r.path, path))                                  //nolint:errcheck
+		}
+		if cerr := r.git(ctx, "checkout", base); cerr != nil && err == nil {
+			err = cerr
+		}
+		if err != nil {
+			r.git(ctx, "branch", "--delete", "--force", gentxBranch) //nolint:errcheck
+			branch = ""
+		}
+	}()
+
+	if err := r.writeFile(path, gentx); err != nil {
+		return "", err
+	}
+	if err := r.commit(ctx, fmt.Sprintf("Add the gentx of %s", name), path); err != nil {
+		return "", err
+	}
+
+	return gentxBranch, nil
+}
+
+// Finalize commits the finalized genesis of the chain and the peer addresses
+// of the validators.
+func (r Repository) Finalize(ctx context.Context, genesis []byte, peers []string) error {
+	if r.IsFinalized() {
+		return ErrFinalized
+	}
+
+	var peersContent bytes.Buffer
+	for _, peer := range peers {
+		fmt.Fprintln(&peersContent, peer)
+	}
+
+	if err := r.writeFile(filepath.FromSlash(LaunchGenesisFile), genesis); err != nil {
+		return err
+	}
+	if err := r.writeFile(filepath.FromSlash(LaunchPeersFile), peersContent.Bytes()); err != nil {
+		return err
+	}
+
+	return r.commit(
+		ctx,
+		fmt.Sprintf("Finalize the genesis of %s", r.config.ChainID),
+		filepath.FromSlash(LaunchGenesisFile),
+		filepath.FromSlash(LaunchPeersFile),
+	)
+}
+
+// Peers returns the peer addresses of the validators of the finalized genesis.
+func (r Repository) Peers() ([]string, error) {
+	content, err := os.ReadFile(filepath.Join(r.path, filepath.FromSlash(LaunchPeersFile)))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, errors.New("the genesis of the network is not finalized yet")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var peers []string
+	for _, line := range strings.Split(string(content), "\n") {
+		if peer := strings.TrimSpace(line); peer != "" {
+			peers = append(peers, peer)
+		}
+	}
+
+	return peers, nil
+}
+
+// Push pushes the branch to the origin remote of the repository.
+func (r Repository) Push(ctx context.Context, branch string) error {
+	return r.git(ctx, "push", "--set-upstream", "origin", branch)
+}
+
+// OpenPullRequest opens the pull request of a pushed branch with the GitHub CLI.
+func (r Repository) OpenPullRequest(ctx context.Context, branch, title string) error {
+	if !xexec.IsCommandAvailable("gh") {
+		return ErrGitHubCLINotInstalled
+	}
+
+	return exec.Exec(
+		ctx,
+		[]string{"gh", "pr", "create", "--head", branch, "--title", title, "--body", "Gentx added with `ignite network git join`."},
+		exec.StepOption(step.Workdir(r.path)),
+	)
+}
+
+func (r Repository) writeFile(name string, content []byte) error {
+	path := filepath.Join(r.path, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, content, 0o644)
+}
+
+// commit commits the files of the paths, the other changes of the repository
+// are left out of the commit.
+func (r Repository) commit(ctx context.Context, message string, paths ...string) error {
+	if err := r.git(ctx, append([]string{"add", "--"}, paths...)...); err != nil {
+		return err
+	}
+
+	return r.git(ctx, append([]string{"commit", "--message", message, "--"}, paths...)...)
+}
+
+// status returns the short status of the changes of the repository, it is
+// empty when there are no uncommitted changes.
+func (r Repository) status(ctx context.Context) (string, error) {
+	var out bytes.Buffer
+	err := exec.Exec(
+		ctx,
+		[]string{"git", "status", "--porcelain"},
+		exec.StepOption(step.Workdir(r.path)),
+		exec.StepOption(step.Stdout(&out)),
+	)
+	return strings.TrimSpace(out.String()), err
+}
+
+// currentBranch returns the name of the branch checked out in the repository.
+func (r Repository) currentBranch(ctx context.Context) (string, error) {
+	var out bytes.Buffer
+	err := exec.Exec(
+		ctx,
+		[]string{"git", "symbolic-ref", "--short", "HEAD"},
+		exec.StepOption(step.Workdir(r.path)),
+		exec.StepOption(step.Stdout(&out)),
+	)
+	return strings.TrimSpace(out.String()), err
+}
+
+func (r Repository) git(ctx context.Context, args ...string) error {
+	return exec.Exec(ctx, append([]string{"git"}, args...), exec.StepOption(step.Workdir(r.path)))
+}
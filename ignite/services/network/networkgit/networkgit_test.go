@@ -0,0 +1,250 @@
+package networkgit
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/exec"
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/step"
+)
+
+func newTestRepository(t *testing.T) Repository {
+	t.Helper()
+
+	// Commits must not depend on the git config of the machine
+	t.Setenv("GIT_AUTHOR_NAME", "ignite")
+	t.Setenv("GIT_AUTHOR_EMAIL", "ignite@example.com")
+	t.Setenv("GIT_COMMITTER_NAME", "ignite")
+	t.Setenv("GIT_COMMITTER_EMAIL", "ignite@example.com")
+
+	r, err := Create(context.Background(), t.TempDir(), Config{
+		ChainID:          "mars-1",
+		Source:           "github.com/ignite/mars",
+		Hash:             "a1b2c3",
+		ValidatorBalance: "100000000stake",
+	}, []byte(`{"chain_id":"mars-1"}`))
+	require.NoError(t, err)
+
+	return r
+}
+
+func readGentx(t *testing.T, name string) []byte {
+	t.Helper()
+
+	gentx, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+
+	return gentx
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+		err    bool
+	}{
+		{
+			name:   "valid config",
+			config: Config{ChainID: "mars-1", ValidatorBalance: "1000stake"},
+		},
+		{
+			name:   "no chain ID",
+			config: Config{ValidatorBalance: "1000stake"},
+			err:    true,
+		},
+		{
+			name:   "invalid validator balance",
+			config: Config{ChainID: "mars-1", ValidatorBalance: "stake"},
+			err:    true,
+		},
+		{
+			name:   "empty validator balance",
+			config: Config{ChainID: "mars-1"},
+			err:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCreateAndOpen(t *testing.T) {
+	r := newTestRepository(t)
+
+	opened, err := Open(r.Path())
+	require.NoError(t, err)
+	require.Equal(t, r.Config(), opened.Config())
+	require.False(t, opened.IsFinalized())
+	require.FileExists(t, filepath.Join(r.Path(), filepath.FromSlash(WorkflowFile)))
+
+	genesis, err := os.ReadFile(opened.GenesisPath())
+	require.NoError(t, err)
+	require.JSONEq(t, `{"chain_id":"mars-1"}`, string(genesis))
+
+	_, err = Create(context.Background(), r.Path(), r.Config(), genesis)
+	require.Error(t, err, "the network is already published")
+
+	_, err = Open(t.TempDir())
+	require.Error(t, err)
+}
+
+func TestAddGentx(t *testing.T) {
+	ctx := context.Background()
+	r := newTestRepository(t)
+
+	base, err := r.currentBranch(ctx)
+	require.NoError(t, err)
+
+	branch, err := r.AddGentx(ctx, "alice", readGentx(t, "gentx1.json"))
+	require.NoError(t, err)
+	require.Equal(t, "gentx/alice", branch)
+
+	// the gentx is only committed in its branch.
+	current, err := r.currentBranch(ctx)
+	require.NoError(t, err)
+	require.Equal(t, base, current)
+	gentxs, err := r.Gentxs()
+	require.NoError(t, err)
+	require.Empty(t, gentxs)
+
+	require.NoError(t, r.git(ctx, "checkout", branch))
+	gentxs, err = r.Gentxs()
+	require.NoError(t, err)
+	require.Len(t, gentxs, 1)
+	require.Equal(t, "alice", gentxs[0].Name)
+	require.Equal(t, "cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj", gentxs[0].Info.DelegatorAddress)
+
+	_, err = r.AddGentx(ctx, "alice", readGentx(t, "gentx2.json"))
+	require.Error(t, err, "the gentx already exists")
+
+	_, err = r.AddGentx(ctx, "Bob", readGentx(t, "gentx2.json"))
+	require.Error(t, err, "invalid name")
+
+	_, err = r.AddGentx(ctx, "bob", []byte("{}"))
+	require.Error(t, err, "invalid gentx")
+}
+
+func TestAddGentxFailure(t *testing.T) {
+	ctx := context.Background()
+	r := newTestRepository(t)
+
+	base, err := r.currentBranch(ctx)
+	require.NoError(t, err)
+
+	// the commit of the gentx is rejected by a hook.
+	hook := filepath.Join(r.path, ".git", "hooks", "pre-commit")
+	require.NoError(t, os.WriteFile(hook, []byte("#!/bin/sh\nexit 1\n"), 0o755))
+
+	_, err = r.AddGentx(ctx, "alice", readGentx(t, "gentx1.json"))
+	require.Error(t, err)
+
+	current, err := r.currentBranch(ctx)
+	require.NoError(t, err)
+	require.Equal(t, base, current)
+	require.Error(t, r.git(ctx, "rev-parse", "--verify", "gentx/alice"), "the branch is deleted")
+	require.NoFileExists(t, filepath.Join(r.path, GentxsDir, "alice.json"))
+
+	// the gentx can be added again.
+	require.NoError(t, os.Remove(hook))
+	_, err = r.AddGentx(ctx, "alice", readGentx(t, "gentx1.json"))
+	require.NoError(t, err)
+}
+
+func TestAddGentxUncommittedChanges(t *testing.T) {
+	ctx := context.Background()
+	r := newTestRepository(t)
+
+	notes := filepath.Join(r.path, "notes.txt")
+	require.NoError(t, os.WriteFile(notes, []byte("draft"), 0o644))
+
+	_, err := r.AddGentx(ctx, "alice", readGentx(t, "gentx1.json"))
+	require.Error(t, err)
+	require.Error(t, r.git(ctx, "rev-parse", "--verify", "gentx/alice"), "no branch is created")
+	require.FileExists(t, notes, "the changes are kept")
+
+	// the ignored files are not changes.
+	require.NoError(t, os.WriteFile(filepath.Join(r.path, ".git", "info", "exclude"), []byte("notes.txt\n"), 0o644))
+	branch, err := r.AddGentx(ctx, "alice", readGentx(t, "gentx1.json"))
+	require.NoError(t, err)
+	require.FileExists(t, notes)
+
+	// only the gentx is committed in its branch.
+	var out bytes.Buffer
+	require.NoError(t, exec.Exec(
+		ctx,
+		[]string{"git", "show", "--name-only", "--format=", branch},
+		exec.StepOption(step.Workdir(r.path)),
+		exec.StepOption(step.Stdout(&out)),
+	))
+	require.Equal(t, "gentxs/alice.json", strings.TrimSpace(out.String()))
+}
+
+func TestCheckGentxs(t *testing.T) {
+	ctx := context.Background()
+	r := newTestRepository(t)
+
+	// the branches of the gentxs are merged like their pull requests.
+	for name, file := range map[string]string{"alice": "gentx1.json", "bob": "gentx2.json"} {
+		branch, err := r.AddGentx(ctx, name, readGentx(t, file))
+		require.NoError(t, err)
+		require.NoError(t, r.git(ctx, "merge", branch))
+	}
+
+	gentxs, err := r.Gentxs()
+	require.NoError(t, err)
+	require.NoError(t, r.CheckGentxs(gentxs))
+
+	require.Error(t, r.CheckGentxs(append(gentxs, gentxs[0])), "duplicated validator")
+
+	r.config.ValidatorBalance = "1000stake"
+	require.Error(t, r.CheckGentxs(gentxs), "self-delegation greater than the balance")
+}
+
+func TestFinalize(t *testing.T) {
+	ctx := context.Background()
+	r := newTestRepository(t)
+
+	_, err := r.Peers()
+	require.Error(t, err)
+
+	peers := []string{
+		"9b1f4adbfb0c0b513040d914bfb717303c0eaa71@192.168.0.148:26656",
+		"a2c4f5dbfb0c0b513040d914bfb717303c0eaa72@192.168.0.149:26656",
+	}
+	require.NoError(t, r.Finalize(ctx, []byte(`{"chain_id":"mars-1"}`), peers))
+	require.True(t, r.IsFinalized())
+
+	got, err := r.Peers()
+	require.NoError(t, err)
+	require.Equal(t, peers, got)
+
+	require.ErrorIs(t, r.Finalize(ctx, nil, nil), ErrFinalized)
+
+	_, err = r.AddGentx(ctx, "alice", readGentx(t, "gentx1.json"))
+	require.ErrorIs(t, err, ErrFinalized)
+}
+
+func TestRenderWorkflow(t *testing.T) {
+	workflow, err := renderWorkflow(Config{
+		ChainID: "mars-1",
+		Source:  "github.com/ignite/mars",
+		Hash:    "a1b2c3",
+	})
+	require.NoError(t, err)
+	require.Contains(t, string(workflow), "repository: ignite/mars")
+	require.Contains(t, string(workflow), "ref: a1b2c3")
+	require.Contains(t, string(workflow), "${{ github.event.pull_request.number }}")
+}
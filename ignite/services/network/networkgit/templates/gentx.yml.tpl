@@ -0,0 +1,60 @@
+# Verifies the pull requests that add the gentx of a validator to the network
+# and merges them. The pull requests can only add files to the gentxs directory.
+name: Gentx
+
+on:
+  pull_request_target:
+    paths:
+      - "gentxs/**"
+
+permissions:
+  contents: write
+  pull-requests: write
+
+jobs:
+  verify:
+    runs-on: ubuntu-latest
+    steps:
+      - name: Checkout the network
+        uses: actions/checkout@v3
+        with:
+          ref: ${{ github.event.pull_request.head.sha }}
+          fetch-depth: 0
+          path: network
+
+      - name: Check the changed files
+        working-directory: network
+        run: |
+          changed=$(git diff --name-only --diff-filter=DMRT ${{ github.event.pull_request.base.sha }} HEAD)
+          added=$(git diff --name-only --diff-filter=A ${{ github.event.pull_request.base.sha }} HEAD | grep -v '^gentxs/[^/]*\.json$' || true)
+          if [ -n "$changed$added" ]; then
+            echo "The pull request can only add gentx files:"
+            echo "$changed$added"
+            exit 1
+          fi
+
+      - name: Checkout the chain
+        uses: actions/checkout@v3
+        with:
+          repository: <<.Repository>><<if .Ref>>
+          ref: <<.Ref>><<end>>
+          path: chain
+
+      - name: Setup Go
+        uses: actions/setup-go@v3
+        with:
+          go-version: "1.19"
+
+      - name: Install Ignite CLI
+        run: curl https://get.ignite.com/cli! | bash
+
+      - name: Build the chain
+        run: ignite chain build --path chain
+
+      - name: Verify the gentxs
+        run: ignite network git verify network --path chain
+
+      - name: Merge the pull request
+        run: gh pr merge ${{ github.event.pull_request.number }} --squash --repo ${{ github.repository }}
+        env:
+          GH_TOKEN: ${{ secrets.GITHUB_TOKEN }}
@@ -0,0 +1,35 @@
+package networkgit
+
+import (
+	"bytes"
+	_ "embed"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates/gentx.yml.tpl
+var workflowTemplate string
+
+// workflowTmpl uses custom delimiters because the workflow has expressions
+// with the default delimiters of the templates.
+var workflowTmpl = template.Must(template.New("workflow").Delims("<<", ">>").Parse(workflowTemplate))
+
+// renderWorkflow renders the workflow of the repository of the network, the
+// workflow builds the chain from its GitHub repository at the commit of the
+// network to verify the gentxs.
+func renderWorkflow(config Config) ([]byte, error) {
+	data := struct {
+		Repository string
+		Ref        string
+	}{
+		Repository: strings.TrimPrefix(config.Source, "github.com/"),
+		Ref:        config.Hash,
+	}
+
+	var buf bytes.Buffer
+	if err := workflowTmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -2,10 +2,12 @@ package network
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 
 	cosmosgenesis "github.com/ignite/cli/ignite/pkg/cosmosutil/genesis"
+	"github.com/ignite/cli/ignite/pkg/downloader"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	campaigntypes "github.com/tendermint/spn/x/campaign/types"
@@ -110,7 +112,9 @@ func (n Network) Publish(ctx context.Context, c Chain, options ...PublishOption)
 
 	// if the initial genesis is a genesis URL and no check are performed, we simply fetch it and get its hash.
 	if o.genesisURL != "" {
-		genesis, err = cosmosgenesis.FromURL(ctx, o.genesisURL, filepath.Join(os.TempDir(), "genesis.json"))
+		genesis, err = cosmosgenesis.FromURL(ctx, o.genesisURL, filepath.Join(os.TempDir(), "genesis.json"), downloader.WithProgress(func(p downloader.Progress) {
+			n.ev.Send(fmt.Sprintf("Fetching the genesis %s", p), events.ProgressUpdate())
+		}))
 		if err != nil {
 			return 0, 0, err
 		}
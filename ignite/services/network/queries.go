@@ -12,10 +12,10 @@ import (
 	campaigntypes "github.com/tendermint/spn/x/campaign/types"
 	launchtypes "github.com/tendermint/spn/x/launch/types"
 	rewardtypes "github.com/tendermint/spn/x/reward/types"
-	"golang.org/x/sync/errgroup"
 
 	"github.com/ignite/cli/ignite/pkg/cosmoserror"
 	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/workerpool"
 	"github.com/ignite/cli/ignite/services/network/networktypes"
 )
 
@@ -41,8 +41,6 @@ func (n Network) ChainLaunch(ctx context.Context, id uint64) (networktypes.Chain
 
 // ChainLaunchesWithReward fetches the chain launches with rewards from Network
 func (n Network) ChainLaunchesWithReward(ctx context.Context, pagination *query.PageRequest) ([]networktypes.ChainLaunch, error) {
-	g, ctx := errgroup.WithContext(ctx)
-
 	n.ev.Send("Fetching chains information", events.ProgressStart())
 	res, err := n.launchQuery.
 		ChainAll(ctx, &launchtypes.QueryAllChainRequest{
@@ -57,9 +55,10 @@ func (n Network) ChainLaunchesWithReward(ctx context.Context, pagination *query.
 	var mu sync.Mutex
 
 	// Parse fetched chains and fetch rewards
+	pool := workerpool.New(ctx)
 	for _, chain := range res.Chain {
 		chain := chain
-		g.Go(func() error {
+		pool.Go(func(ctx context.Context) error {
 			chainLaunch := networktypes.ToChainLaunch(chain)
 			reward, err := n.ChainReward(ctx, chain.LaunchID)
 			if err != nil && err != ErrObjectNotFound {
@@ -72,7 +71,7 @@ func (n Network) ChainLaunchesWithReward(ctx context.Context, pagination *query.
 			return nil
 		})
 	}
-	if err := g.Wait(); err != nil {
+	if err := pool.Wait(); err != nil {
 		return nil, err
 	}
 	// sort filenames by launch id
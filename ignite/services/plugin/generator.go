@@ -0,0 +1,180 @@
+package plugin
+
+import (
+	"context"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosgen"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+// Generator is implemented by the plugins that add code generation targets to
+// ignite. Implementing it is optional, a plugin that doesn't only adds its
+// commands.
+//
+// The targets are added as sub commands of `ignite generate`, and the targets
+// configured with the Generate field of the plugin config are generated with
+// the other targets of the config when the chain is built or served.
+type Generator interface {
+	// GenerateTargets returns the code generation targets of the plugin.
+	GenerateTargets() []GenerateTarget
+	// Generate generates the code of target from the proto files described by
+	// the request.
+	Generate(target GenerateTarget, req GenerateRequest) error
+}
+
+// GenerateTarget is a code generation target of a plugin.
+type GenerateTarget struct {
+	// Name is the name of the target, it's the name of its `ignite generate`
+	// sub command and its key in the Generate field of the plugin config.
+	Name string
+	// Same as cobra.Command.Short
+	Short string
+	// Same as cobra.Command.Long
+	Long string
+	// DefaultOutput is the output path of the generated code, relative to the
+	// chain, used when no output path is configured.
+	DefaultOutput string
+}
+
+// GenerateRequest describes the code to generate for a target.
+type GenerateRequest struct {
+	// DescriptorSet is the serialized FileDescriptorSet of the proto files of
+	// the chain and of the files they import, with their source info.
+	DescriptorSet []byte
+	// Files are the names of the proto files of the chain in DescriptorSet,
+	// the files to generate the code of.
+	Files []string
+	// AppPath is the absolute path of the chain.
+	AppPath string
+	// Output is the absolute path where the code is generated.
+	Output string
+	// Optional parameters populated by config at runtime via
+	// chainconfig.Plugin.With field.
+	With map[string]string
+}
+
+// GenerateArgs are the arguments of the Generate RPC call.
+type GenerateArgs struct {
+	Target  GenerateTarget
+	Request GenerateRequest
+}
+
+// errNoGenerator is returned when code is generated by a plugin that doesn't
+// implement Generator.
+var errNoGenerator = errors.New("the plugin has no code generation targets")
+
+// GenerateTargets implements Generator.GenerateTargets. No targets are
+// returned by the plugins built with a version of ignite without code
+// generation targets.
+func (g *InterfaceRPC) GenerateTargets() []GenerateTarget {
+	var resp []GenerateTarget
+	// The argument is not used but it must not be nil: gob doesn't send nil
+	// values, so the plugins without the method would wait for it forever
+	// instead of failing the call.
+	err := g.client.Call("Plugin.GenerateTargets", true, &resp)
+	if err != nil {
+		if strings.Contains(err.Error(), "can't find method") {
+			return nil
+		}
+		log.Fatalf("error while calling plugin %v", err)
+	}
+	return resp
+}
+
+// Generate implements Generator.Generate
+func (g *InterfaceRPC) Generate(target GenerateTarget, req GenerateRequest) error {
+	var resp interface{}
+	return g.client.Call("Plugin.Generate", GenerateArgs{
+		Target:  target,
+		Request: req,
+	}, &resp)
+}
+
+func (s *InterfaceRPCServer) GenerateTargets(args bool, resp *[]GenerateTarget) error {
+	if g, ok := s.Impl.(Generator); ok {
+		*resp = g.GenerateTargets()
+	}
+	return nil
+}
+
+func (s *InterfaceRPCServer) Generate(args GenerateArgs, resp *interface{}) error {
+	g, ok := s.Impl.(Generator)
+	if !ok {
+		return errNoGenerator
+	}
+	return g.Generate(args.Target, args.Request)
+}
+
+// ChainGenerateTarget returns the chain code generation target that generates
+// the code of target with the plugin. The code is generated in output, or in
+// the default output of the target when output is empty, relative outputs are
+// relative to the chain.
+func (p *Plugin) ChainGenerateTarget(target GenerateTarget, output string) chain.GenerateTarget {
+	return chain.GenerateFromDescriptorSet(func(_ context.Context, set cosmosgen.DescriptorSet) error {
+		g, ok := p.Interface.(Generator)
+		if !ok {
+			return errNoGenerator
+		}
+
+		out := output
+		if out == "" {
+			out = target.DefaultOutput
+		}
+		if !filepath.IsAbs(out) {
+			out = filepath.Join(set.AppPath, out)
+		}
+		if err := os.MkdirAll(out, 0o766); err != nil {
+			return err
+		}
+
+		return g.Generate(target, GenerateRequest{
+			DescriptorSet: set.Content,
+			Files:         set.Files,
+			AppPath:       set.AppPath,
+			Output:        out,
+			With:          p.With,
+		})
+	})
+}
+
+// ConfigGenerateTargets returns the chain code generation targets of the
+// Generate field of the plugin config.
+func (p *Plugin) ConfigGenerateTargets() ([]chain.GenerateTarget, error) {
+	if len(p.Generate) == 0 {
+		return nil, nil
+	}
+
+	g, ok := p.Interface.(Generator)
+	if !ok {
+		return nil, errors.Errorf("plugin %q has no code generation targets", p.Path)
+	}
+
+	byName := make(map[string]GenerateTarget)
+	for _, target := range g.GenerateTargets() {
+		byName[target.Name] = target
+	}
+
+	names := make([]string, 0, len(p.Generate))
+	for name := range p.Generate {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var targets []chain.GenerateTarget
+	for _, name := range names {
+		target, ok := byName[name]
+		if !ok {
+			return nil, errors.Errorf("plugin %q has no code generation target %q", p.Path, name)
+		}
+		targets = append(targets, p.ChainGenerateTarget(target, p.Generate[name]))
+	}
+
+	return targets, nil
+}
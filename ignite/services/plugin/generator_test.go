@@ -0,0 +1,109 @@
+package plugin
+
+import (
+	"net"
+	"net/rpc"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+)
+
+// commandsImpl implements Interface without code generation targets.
+type commandsImpl struct{}
+
+func (commandsImpl) Commands() []Command             { return nil }
+func (commandsImpl) Execute(Command, []string) error { return nil }
+
+// generatorImpl implements Interface and Generator.
+type generatorImpl struct {
+	commandsImpl
+
+	generated []GenerateRequest
+}
+
+func (generatorImpl) GenerateTargets() []GenerateTarget {
+	return []GenerateTarget{{Name: "kotlin", DefaultOutput: "kotlin"}}
+}
+
+func (g *generatorImpl) Generate(target GenerateTarget, req GenerateRequest) error {
+	g.generated = append(g.generated, req)
+	return nil
+}
+
+// legacyRPCServer is the RPC server of the plugins built with a version of
+// ignite without code generation targets.
+type legacyRPCServer struct{}
+
+func (legacyRPCServer) Commands(args interface{}, resp *[]Command) error { return nil }
+
+// rpcInterface serves rcvr over RPC and returns its client.
+func rpcInterface(t *testing.T, rcvr interface{}) Interface {
+	t.Helper()
+
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("Plugin", rcvr))
+
+	serverConn, clientConn := net.Pipe()
+	go server.ServeConn(serverConn)
+
+	client := rpc.NewClient(clientConn)
+	t.Cleanup(func() { client.Close() })
+
+	i, err := (InterfacePlugin{}).Client(nil, client)
+	require.NoError(t, err)
+	return i.(Interface)
+}
+
+func TestGeneratorRPC(t *testing.T) {
+	impl := &generatorImpl{}
+	g := rpcInterface(t, &InterfaceRPCServer{Impl: impl}).(Generator)
+
+	targets := g.GenerateTargets()
+	require.Equal(t, []GenerateTarget{{Name: "kotlin", DefaultOutput: "kotlin"}}, targets)
+
+	req := GenerateRequest{
+		DescriptorSet: []byte{1, 2, 3},
+		Files:         []string{"mars/blog/post.proto"},
+		AppPath:       "/mars",
+		Output:        "/mars/kotlin",
+		With:          map[string]string{"package": "mars"},
+	}
+	require.NoError(t, g.Generate(targets[0], req))
+	require.Equal(t, []GenerateRequest{req}, impl.generated)
+}
+
+func TestGeneratorRPCNoTargets(t *testing.T) {
+	g := rpcInterface(t, &InterfaceRPCServer{Impl: commandsImpl{}}).(Generator)
+
+	require.Empty(t, g.GenerateTargets())
+	require.EqualError(t, g.Generate(GenerateTarget{Name: "kotlin"}, GenerateRequest{}), errNoGenerator.Error())
+
+	g = rpcInterface(t, legacyRPCServer{}).(Generator)
+	require.Empty(t, g.GenerateTargets())
+}
+
+func TestConfigGenerateTargets(t *testing.T) {
+	p := &Plugin{
+		Plugin:    chainconfig.Plugin{Path: "foo"},
+		Interface: &generatorImpl{},
+	}
+
+	targets, err := p.ConfigGenerateTargets()
+	require.NoError(t, err)
+	require.Empty(t, targets)
+
+	p.Generate = map[string]string{"kotlin": ""}
+	targets, err = p.ConfigGenerateTargets()
+	require.NoError(t, err)
+	require.Len(t, targets, 1)
+
+	p.Generate = map[string]string{"graphql": "schema"}
+	_, err = p.ConfigGenerateTargets()
+	require.EqualError(t, err, `plugin "foo" has no code generation target "graphql"`)
+
+	p.Interface = commandsImpl{}
+	_, err = p.ConfigGenerateTargets()
+	require.EqualError(t, err, `plugin "foo" has no code generation targets`)
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/ignite/cli/ignite/chainconfig"
 	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/downloader"
 	"github.com/ignite/cli/ignite/pkg/gocmd"
 	"github.com/ignite/cli/ignite/pkg/xfilepath"
 	"github.com/ignite/cli/ignite/services/chain"
@@ -233,31 +234,25 @@ func (p *Plugin) fetch() {
 	}
 	defer cliui.New(cliui.StartSpinnerWithText(fmt.Sprintf("Fetching plugin %q...", p.cloneURL))).End()
 
-	var err error
-	if p.reference == "" {
-		// No reference provided, just clone
-		_, err = git.PlainClone(p.cloneDir, false, &git.CloneOptions{
-			URL: p.cloneURL,
-		})
-	} else {
+	// No reference provided, just clone
+	options := []*git.CloneOptions{{URL: p.cloneURL}}
+	if p.reference != "" {
 		// Reference provided, clone using tag or branch reference, one of the two
 		// should work. SHA-1 aren't supported.
+		options = nil
 		for _, ref := range []plumbing.ReferenceName{
 			plumbing.NewTagReferenceName(p.reference),
 			plumbing.NewBranchReferenceName(p.reference),
 		} {
-			_, err = git.PlainClone(p.cloneDir, false, &git.CloneOptions{
+			options = append(options, &git.CloneOptions{
 				URL:           p.cloneURL,
 				ReferenceName: ref,
 				// Try to limit number of commits but this option doesn't seem to work well
 				Depth: 1,
 			})
-			if err == nil {
-				break
-			}
 		}
 	}
-	if err != nil {
+	if _, err := downloader.New().Clone(context.Background(), p.cloneDir, options...); err != nil {
 		p.Error = errors.Wrapf(err, "cloning %q", p.cloneURL)
 	}
 }
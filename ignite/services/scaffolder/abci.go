@@ -0,0 +1,64 @@
+package scaffolder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/cosmosver"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/abci"
+)
+
+// AddProposalHandlers scaffolds an app-side mempool of the given type and the
+// skeletons of the ABCI++ PrepareProposal and ProcessProposal handlers of the app.
+func (s Scaffolder) AddProposalHandlers(
+	ctx context.Context,
+	cacheStorage cache.Storage,
+	tracer *placeholder.Tracer,
+	mempoolType string,
+) (sm xgenny.SourceModification, err error) {
+	// The proposal handlers and the app-side mempool come with Cosmos SDK v0.47
+	if s.Version.LT(cosmosver.StargateFortySevenVersion) {
+		return sm, fmt.Errorf("the proposal handlers of ABCI++ require Cosmos SDK v0.47 or newer, the app uses %s", s.Version.Version)
+	}
+
+	var valid bool
+	for _, t := range abci.MempoolTypes {
+		valid = valid || t == mempoolType
+	}
+	if !valid {
+		return sm, fmt.Errorf(
+			"invalid mempool type %s, it must be one of: %s",
+			mempoolType,
+			strings.Join(abci.MempoolTypes, ", "),
+		)
+	}
+
+	ok, err := pathExists(filepath.Join(s.path, abci.PathProposalHandlersGo))
+	if err != nil {
+		return sm, err
+	}
+	if ok {
+		return sm, errors.New("the proposal handlers already exist in " + abci.PathProposalHandlersGo)
+	}
+
+	g, err := abci.NewGenerator(&abci.Options{
+		AppPath:     s.path,
+		MempoolType: mempoolType,
+	})
+	if err != nil {
+		return sm, err
+	}
+
+	sm, err = s.run(tracer, g)
+	if err != nil {
+		return sm, err
+	}
+
+	return sm, s.finish(ctx, cacheStorage)
+}
@@ -0,0 +1,145 @@
+package scaffolder
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/feature"
+)
+
+// featureOptions represents configuration for the feature flag scaffolding.
+type featureOptions struct {
+	messages []string
+}
+
+// FeatureOption configures the feature flag scaffolding.
+type FeatureOption func(*featureOptions)
+
+// WithGatedMessages rejects the messages of the module while the feature is disabled.
+func WithGatedMessages(messages ...string) FeatureOption {
+	return func(o *featureOptions) {
+		o.messages = append(o.messages, messages...)
+	}
+}
+
+// AddFeature scaffolds a feature flag in a module: a boolean param disabled by
+// default, the keeper methods to check it and optionally the checks in the
+// handlers of the messages of the feature.
+func (s Scaffolder) AddFeature(
+	ctx context.Context,
+	cacheStorage cache.Storage,
+	tracer *placeholder.Tracer,
+	moduleName,
+	featureName string,
+	options ...FeatureOption,
+) (sm xgenny.SourceModification, err error) {
+	var o featureOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	// If no module is provided, we add the feature to the app's module
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return sm, err
+	}
+	moduleName = mfName.LowerCase
+
+	name, err := multiformatname.NewName(featureName)
+	if err != nil {
+		return sm, err
+	}
+
+	ok, err := moduleExists(s.path, moduleName)
+	if err != nil {
+		return sm, err
+	}
+	if !ok {
+		return sm, fmt.Errorf("the module %s doesn't exist", moduleName)
+	}
+
+	ok, err = pathExists(filepath.Join(s.path, moduleDir, moduleName, "keeper", fmt.Sprintf("feature_%s.go", name.Snake)))
+	if err != nil {
+		return sm, err
+	}
+	if ok {
+		return sm, fmt.Errorf("feature %s already exists in the module %s", name.Kebab, moduleName)
+	}
+
+	opts := &feature.Options{
+		AppName:     s.modpath.Package,
+		AppPath:     s.path,
+		ModuleName:  moduleName,
+		ModulePath:  s.modpath.RawPath,
+		FeatureName: name,
+	}
+
+	for _, msg := range o.messages {
+		m, err := s.msgHandler(moduleName, msg)
+		if err != nil {
+			return sm, err
+		}
+		opts.Messages = append(opts.Messages, m)
+	}
+
+	g, err := feature.NewGenerator(opts)
+	if err != nil {
+		return sm, err
+	}
+	sm, err = s.run(tracer, g)
+	if err != nil {
+		return sm, err
+	}
+
+	return sm, s.finish(ctx, cacheStorage)
+}
+
+// msgHandler returns the handler of a message of a module from the keeper files.
+func (s Scaffolder) msgHandler(moduleName, msgName string) (feature.Message, error) {
+	name, err := multiformatname.NewName(msgName)
+	if err != nil {
+		return feature.Message{}, err
+	}
+	handler := regexp.MustCompile(fmt.Sprintf(`func \(k msgServer\) %s\(`, name.UpperCamel))
+
+	dir := filepath.Join(s.path, moduleDir, moduleName, "keeper")
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return feature.Message{}, err
+	}
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), ".go") || strings.HasSuffix(f.Name(), "_test.go") {
+			continue
+		}
+		path := filepath.Join(dir, f.Name())
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return feature.Message{}, err
+		}
+		if handler.Match(content) {
+			return feature.Message{Name: name.UpperCamel, Path: path}, nil
+		}
+	}
+
+	return feature.Message{}, fmt.Errorf("the handler of the message %s doesn't exist in the module %s", name.UpperCamel, moduleName)
+}
+
+// FeatureParamName returns the name of the module param that enables a feature.
+func FeatureParamName(featureName string) (string, error) {
+	name, err := multiformatname.NewName(featureName)
+	if err != nil {
+		return "", err
+	}
+	return feature.ParamName(name), nil
+}
@@ -0,0 +1,163 @@
+package scaffolder
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/gobuffalo/genny"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/protoanalysis"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/field"
+	"github.com/ignite/cli/ignite/templates/typed"
+	"github.com/ignite/cli/ignite/templates/typed/addfield"
+	"github.com/ignite/cli/ignite/templates/upgrade"
+)
+
+// addFieldOptions represents configuration for the field scaffolding.
+type addFieldOptions struct {
+	moduleName  string
+	noMigration bool
+}
+
+// AddFieldOption configures the field scaffolding.
+type AddFieldOption func(*addFieldOptions)
+
+// FieldWithModule sets the module of the type of the fields.
+func FieldWithModule(name string) AddFieldOption {
+	return func(o *addFieldOptions) {
+		o.moduleName = name
+	}
+}
+
+// FieldWithoutMigration disables the consensus version bump and the migration
+// of the module, e.g. when the chain is not deployed yet.
+func FieldWithoutMigration() AddFieldOption {
+	return func(o *addFieldOptions) {
+		o.noMigration = true
+	}
+}
+
+// AddField adds fields to an existing type of a module. The fields are added to
+// the proto message of the type, to its messages, msg server and CLI commands.
+// The consensus version of the module is bumped and a migration of the module
+// state is registered to set the values of the fields of the existing values.
+func (s Scaffolder) AddField(
+	ctx context.Context,
+	cacheStorage cache.Storage,
+	tracer *placeholder.Tracer,
+	typeName string,
+	fields []string,
+	options ...AddFieldOption,
+) (sm xgenny.SourceModification, err error) {
+	o := addFieldOptions{moduleName: s.modpath.Package}
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	mfName, err := multiformatname.NewName(o.moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return sm, err
+	}
+	moduleName := mfName.LowerCase
+
+	name, err := multiformatname.NewName(typeName)
+	if err != nil {
+		return sm, err
+	}
+
+	ok, err := moduleExists(s.path, moduleName)
+	if err != nil {
+		return sm, err
+	}
+	if !ok {
+		return sm, fmt.Errorf("the module %s doesn't exist", moduleName)
+	}
+
+	// The fields of the type are read from its proto message
+	protoPath := filepath.Join(s.path, protoFolder, s.modpath.Package, moduleName, name.Snake+".proto")
+	ok, err = pathExists(protoPath)
+	if err != nil {
+		return sm, err
+	}
+	if !ok {
+		return sm, fmt.Errorf("the type %s doesn't exist in the module %s", name.Original, moduleName)
+	}
+	pkgs, err := protoanalysis.Parse(ctx, nil, protoPath)
+	if err != nil {
+		return sm, err
+	}
+	if len(pkgs) == 0 {
+		return sm, fmt.Errorf("%s is not a proto file", protoPath)
+	}
+	msg, err := pkgs[0].MessageByName(name.UpperCamel)
+	if err != nil {
+		return sm, fmt.Errorf("the type %s doesn't exist in the module %s", name.Original, moduleName)
+	}
+
+	existingFields := make([]string, 0, len(msg.Fields))
+	for f := range msg.Fields {
+		existingFields = append(existingFields, f)
+	}
+
+	if err := checkCustomTypes(ctx, s.path, s.modpath.Package, moduleName, fields); err != nil {
+		return sm, err
+	}
+	tFields, err := field.ParseFields(fields, checkForbiddenTypeField, existingFields...)
+	if err != nil {
+		return sm, err
+	}
+
+	// The messages of the type are created with the type unless --no-message is used
+	hasMessages, err := pathExists(filepath.Join(s.path, moduleDir, moduleName, "types", fmt.Sprintf("messages_%s.go", name.Snake)))
+	if err != nil {
+		return sm, err
+	}
+
+	opts := &typed.Options{
+		AppName:    s.modpath.Package,
+		AppPath:    s.path,
+		ModuleName: moduleName,
+		ModulePath: s.modpath.RawPath,
+		TypeName:   name,
+		Fields:     tFields,
+		NoMessage:  !hasMessages,
+	}
+
+	g, err := addfield.NewStargate(opts)
+	if err != nil {
+		return sm, err
+	}
+	gens := []*genny.Generator{g}
+
+	if !o.noMigration {
+		m, hasMigrator, err := s.moduleMigration(moduleName)
+		if err != nil {
+			return sm, err
+		}
+		for _, f := range tFields {
+			m.Changes = append(m.Changes, fmt.Sprintf(
+				"The %s field is added to %s, set its value in the existing values if its default value is not valid.",
+				f.Name.LowerCamel,
+				name.UpperCamel,
+			))
+		}
+
+		g, err := upgrade.NewMigration(tracer, s.path, m, hasMigrator)
+		if err != nil {
+			return sm, err
+		}
+		gens = append(gens, g)
+	}
+
+	sm, err = s.run(tracer, gens...)
+	if err != nil {
+		return sm, err
+	}
+
+	return sm, s.finish(ctx, cacheStorage)
+}
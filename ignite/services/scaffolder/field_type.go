@@ -0,0 +1,24 @@
+package scaffolder
+
+import (
+	"path/filepath"
+
+	"github.com/ignite/cli/ignite/templates/field/datatype"
+)
+
+// FieldTypesFile is the name of the file defining the custom field types of an app or a template pack.
+const FieldTypesFile = "field_types.yml"
+
+// registerFieldTypes registers the custom field types of the template pack and of the app,
+// the types of the app override the ones of the pack with the same name.
+func (s Scaffolder) registerFieldTypes() error {
+	for _, dir := range []string{s.templatePack, s.path} {
+		if dir == "" {
+			continue
+		}
+		if err := datatype.RegisterFile(filepath.Join(dir, FieldTypesFile)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+package scaffolder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/pkg/xstrings"
+	"github.com/ignite/cli/ignite/templates/ica"
+	"github.com/ignite/cli/ignite/templates/module"
+	modulecreate "github.com/ignite/cli/ignite/templates/module/create"
+)
+
+const (
+	// icaPathLocal is the directory of the config files of the local testing of the interchain accounts.
+	icaPathLocal = "ica"
+
+	// defaultAddressPrefix is the address prefix of the apps that don't define one.
+	defaultAddressPrefix = "cosmos"
+)
+
+// appAddressPrefix matches the account address prefix of an app.go file.
+var appAddressPrefix = regexp.MustCompile(`AccountAddressPrefix\s*=\s*"(\w+)"`)
+
+// icaOptions represents configuration for the interchain accounts scaffolding.
+type icaOptions struct {
+	controllerModule string
+	host             bool
+	allowMessages    []string
+}
+
+// ICAOption configures the interchain accounts scaffolding.
+type ICAOption func(*icaOptions)
+
+// ICAWithController scaffolds a module named name that registers interchain accounts
+// and sends txs to its accounts with the controller submodule.
+func ICAWithController(name string) ICAOption {
+	return func(o *icaOptions) {
+		o.controllerModule = name
+	}
+}
+
+// ICAWithHost allows the interchain accounts of the chain to execute messages with
+// the host submodule.
+func ICAWithHost() ICAOption {
+	return func(o *icaOptions) {
+		o.host = true
+	}
+}
+
+// ICAWithAllowMessages sets the messages the interchain accounts of the host chains
+// are allowed to execute.
+func ICAWithAllowMessages(msgs ...string) ICAOption {
+	return func(o *icaOptions) {
+		o.allowMessages = msgs
+	}
+}
+
+// AddICA wires the ICS-27 interchain accounts in the app. The controller
+// submodule is routed to a new module that registers interchain accounts and
+// sends txs to them, the config files to test the module with a local host chain
+// are created in the "ica" directory. The host submodule is enabled by allowing
+// the interchain accounts to execute messages in the genesis of the config file.
+func (s Scaffolder) AddICA(
+	ctx context.Context,
+	cacheStorage cache.Storage,
+	tracer *placeholder.Tracer,
+	options ...ICAOption,
+) (sm xgenny.SourceModification, err error) {
+	o := icaOptions{allowMessages: ica.DefaultAllowMessages}
+	for _, apply := range options {
+		apply(&o)
+	}
+	if o.controllerModule == "" && !o.host {
+		return sm, errors.New("the controller or the host submodule of interchain accounts must be wired")
+	}
+	if len(o.allowMessages) == 0 {
+		return sm, errors.New("the interchain accounts must be allowed to execute at least one message")
+	}
+
+	// The interchain accounts are wired by the ibc-go modules of the app.go of the apps
+	if s.isModernAppWiring() {
+		return sm, errors.New("interchain accounts are not supported by apps with modern wiring")
+	}
+	appGo, err := os.ReadFile(filepath.Join(s.path, module.PathAppGo))
+	if err != nil {
+		return sm, err
+	}
+	if !strings.Contains(string(appGo), "icaControllerKeeper") || !strings.Contains(string(appGo), "icahosttypes") {
+		return sm, fmt.Errorf("the interchain accounts module of ibc-go is not wired in %s", module.PathAppGo)
+	}
+
+	confPath, err := chainconfig.LocateDefault(s.path)
+	if err != nil && !errors.Is(err, chainconfig.ErrConfigNotFound) {
+		return sm, err
+	}
+
+	sm = xgenny.NewSourceModification()
+
+	var gens []*genny.Generator
+	if o.host {
+		if confPath == "" {
+			return sm, errors.New("the config file of the chain is required to allow the interchain accounts to execute messages")
+		}
+		gens = append(gens, ica.NewHost(&ica.HostOptions{
+			ConfigPath:    confPath,
+			AllowMessages: o.allowMessages,
+		}))
+	}
+
+	if o.controllerModule != "" {
+		if strings.Contains(string(appGo), "icacontroller.NewIBCMiddleware(") {
+			return sm, fmt.Errorf("the controller submodule of interchain accounts is already routed in %s", module.PathAppGo)
+		}
+
+		mfName, err := multiformatname.NewName(o.controllerModule, multiformatname.NoNumber)
+		if err != nil {
+			return sm, err
+		}
+		moduleName := mfName.LowerCase
+
+		if err := checkModuleName(s.path, moduleName); err != nil {
+			return sm, err
+		}
+		ok, err := moduleExists(s.path, moduleName)
+		if err != nil {
+			return sm, err
+		}
+		if ok {
+			return sm, fmt.Errorf("the module %v already exists", moduleName)
+		}
+		ok, err = pathExists(filepath.Join(s.path, icaPathLocal))
+		if err != nil {
+			return sm, err
+		}
+		if ok {
+			return sm, fmt.Errorf("the %s directory already exists", icaPathLocal)
+		}
+
+		// The chain ID of the chain served with the config file is the name of the app by default
+		controllerChainID := xstrings.NoDash(s.modpath.Root)
+		if confPath != "" {
+			conf, err := chainconfig.ParseFile(confPath)
+			if err != nil {
+				return sm, err
+			}
+			if id, ok := conf.Genesis["chain_id"].(string); ok {
+				controllerChainID = id
+			}
+		}
+
+		addressPrefix := defaultAddressPrefix
+		if m := appAddressPrefix.FindSubmatch(appGo); m != nil {
+			addressPrefix = string(m[1])
+		}
+
+		// The module is an IBC module, its IBC callbacks are replaced by the
+		// callbacks of an authentication module of interchain accounts
+		moduleSm, err := s.createModule(tracer, &modulecreate.CreateOptions{
+			ModuleName:  moduleName,
+			ModulePath:  s.modpath.RawPath,
+			AppName:     s.modpath.Package,
+			AppPath:     s.path,
+			IsIBC:       true,
+			IBCOrdering: "ORDERED",
+		})
+		sm.Merge(moduleSm)
+		if err != nil {
+			return sm, err
+		}
+
+		g, err := ica.NewController(tracer, &ica.Options{
+			AppName:           s.modpath.Package,
+			AppPath:           s.path,
+			ModuleName:        moduleName,
+			ModulePath:        s.modpath.RawPath,
+			ControllerChainID: controllerChainID,
+			HostChainID:       controllerChainID + "-host",
+			AddressPrefix:     addressPrefix,
+			AllowMessages:     o.allowMessages,
+		})
+		if err != nil {
+			return sm, err
+		}
+		gens = append(gens, g)
+	}
+
+	icaSm, err := s.run(tracer, gens...)
+	sm.Merge(icaSm)
+	if err != nil {
+		return sm, err
+	}
+
+	return sm, s.finish(ctx, cacheStorage)
+}
@@ -0,0 +1,305 @@
+package scaffolder
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/protoanalysis"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/field/datatype"
+)
+
+const (
+	protoServiceMsg   = "Msg"
+	protoServiceQuery = "Query"
+
+	protoTypeCoin         = "cosmos.base.v1beta1.Coin"
+	protoTypePageRequest  = "cosmos.base.query.v1beta1.PageRequest"
+	protoTypePageResponse = "cosmos.base.query.v1beta1.PageResponse"
+)
+
+// protoSignerFields are the message field names recognized as the message signer.
+var protoSignerFields = []string{"creator", "sender", "signer", "authority"}
+
+// protoRPC is an RPC of a Msg or Query service with its request and response
+// fields converted to scaffolder field arguments.
+type protoRPC struct {
+	// id identifies the RPC in the errors, e.g. blog.Msg/CreatePost.
+	id string
+
+	name      string
+	fields    []string
+	resFields []string
+
+	// signer is the field of the message signer, it is only set for messages.
+	signer string
+
+	// paginated is true when the request of a query has a pagination field.
+	paginated bool
+}
+
+// ImportProto scaffolds the messages and queries defined by the Msg and Query
+// services of the proto files found in protoPath into a module of the app.
+// All the RPCs are checked before the app is modified, so an RPC that can't be
+// imported leaves the app unchanged.
+func (s Scaffolder) ImportProto(
+	ctx context.Context,
+	cacheStorage cache.Storage,
+	tracer *placeholder.Tracer,
+	moduleName,
+	protoPath string,
+) (sm xgenny.SourceModification, err error) {
+	pkgs, err := protoanalysis.Parse(ctx, nil, protoPath)
+	if err != nil {
+		return sm, err
+	}
+	if len(pkgs) == 0 {
+		return sm, fmt.Errorf("no proto files found in %s", protoPath)
+	}
+
+	msgs, queries, err := importProtoRPCs(pkgs)
+	if err != nil {
+		return sm, err
+	}
+
+	var gens []*genny.Generator
+	for i, rpc := range msgs {
+		opts, err := s.messageTemplateOptions(ctx, moduleName, rpc.name, rpc.fields, rpc.resFields, WithSigner(rpc.signer))
+		if err != nil {
+			return sm, fmt.Errorf("%s: %w", rpc.id, err)
+		}
+
+		// The MsgServer convention and the simulation are added once for all
+		// the messages, before the messages modify them
+		if i == 0 {
+			gens, err = s.messageSupportGenerators(tracer, opts)
+			if err != nil {
+				return sm, err
+			}
+		}
+
+		g, err := s.messageGenerator(tracer, opts)
+		if err != nil {
+			return sm, fmt.Errorf("%s: %w", rpc.id, err)
+		}
+		gens = append(gens, g)
+	}
+	for _, rpc := range queries {
+		opts, err := s.queryTemplateOptions(
+			ctx,
+			moduleName,
+			rpc.name,
+			fmt.Sprintf("Query %s", rpc.name),
+			rpc.fields,
+			rpc.resFields,
+			rpc.paginated,
+		)
+		if err != nil {
+			return sm, fmt.Errorf("%s: %w", rpc.id, err)
+		}
+
+		g, err := s.queryGenerator(tracer, opts)
+		if err != nil {
+			return sm, fmt.Errorf("%s: %w", rpc.id, err)
+		}
+		gens = append(gens, g)
+	}
+	if len(gens) == 0 {
+		return sm, fmt.Errorf("no Msg or Query RPCs found in %s", protoPath)
+	}
+
+	sm, err = s.runAll(tracer, gens...)
+	if err != nil {
+		return sm, err
+	}
+	return sm, s.finish(ctx, cacheStorage)
+}
+
+// importProtoRPCs returns the RPCs of the Msg and Query services of the proto
+// packages, an error is returned when any RPC can't be imported.
+func importProtoRPCs(pkgs protoanalysis.Packages) (msgs, queries []protoRPC, err error) {
+	ids := make(map[string]string)
+	for _, pkg := range pkgs {
+		for _, service := range pkg.Services {
+			var (
+				importRPC func(name string, reqFields, resFields []protoanalysis.Field) (protoRPC, error)
+				rpcs      *[]protoRPC
+			)
+			switch service.Name {
+			case protoServiceMsg:
+				importRPC, rpcs = importProtoMessage, &msgs
+			case protoServiceQuery:
+				importRPC, rpcs = importProtoQuery, &queries
+			default:
+				return nil, nil, fmt.Errorf("service %s of package %s is not supported, only Msg and Query services can be imported", service.Name, pkg.Name)
+			}
+
+			for _, rpc := range service.RPCFuncs {
+				id := fmt.Sprintf("%s.%s/%s", pkg.Name, service.Name, rpc.Name)
+
+				// The messages and queries of all the packages are scaffolded
+				// into the same module so their names must be unique
+				if other, ok := ids[strings.ToLower(rpc.Name)]; ok {
+					return nil, nil, fmt.Errorf("%s: the name is already used by %s", id, other)
+				}
+				ids[strings.ToLower(rpc.Name)] = id
+
+				reqFields, err := protoMessageFields(pkg, rpc.RequestType)
+				if err != nil {
+					return nil, nil, fmt.Errorf("%s: %w", id, err)
+				}
+				resFields, err := protoMessageFields(pkg, rpc.ReturnsType)
+				if err != nil {
+					return nil, nil, fmt.Errorf("%s: %w", id, err)
+				}
+
+				r, err := importRPC(rpc.Name, reqFields, resFields)
+				if err != nil {
+					return nil, nil, fmt.Errorf("%s: %w", id, err)
+				}
+				r.id = id
+				*rpcs = append(*rpcs, r)
+			}
+		}
+	}
+	return msgs, queries, nil
+}
+
+// importProtoMessage returns the RPC of a Msg service. The signer is not part
+// of the message fields because it is always added by the message template.
+func importProtoMessage(name string, reqFields, resFields []protoanalysis.Field) (protoRPC, error) {
+	signer := newMessageOptions(name).signer
+	for i, f := range reqFields {
+		if f.Type == "string" && !f.Repeated && isProtoSignerField(f.Name) {
+			signer = f.Name
+			reqFields = append(reqFields[:i:i], reqFields[i+1:]...)
+			break
+		}
+	}
+
+	fields, err := importProtoFields(reqFields)
+	if err != nil {
+		return protoRPC{}, err
+	}
+	res, err := importProtoFields(resFields)
+	if err != nil {
+		return protoRPC{}, err
+	}
+
+	return protoRPC{
+		name:      name,
+		fields:    fields,
+		resFields: res,
+		signer:    signer,
+	}, nil
+}
+
+// importProtoQuery returns the RPC of a Query service. The pagination fields
+// are added by the query template when the query is paginated.
+func importProtoQuery(name string, reqFields, resFields []protoanalysis.Field) (protoRPC, error) {
+	reqFields, paginated := withoutProtoFieldType(reqFields, protoTypePageRequest)
+	resFields, _ = withoutProtoFieldType(resFields, protoTypePageResponse)
+
+	fields, err := importProtoFields(reqFields)
+	if err != nil {
+		return protoRPC{}, err
+	}
+	res, err := importProtoFields(resFields)
+	if err != nil {
+		return protoRPC{}, err
+	}
+
+	return protoRPC{
+		name:      name,
+		fields:    fields,
+		resFields: res,
+		paginated: paginated,
+	}, nil
+}
+
+// protoMessageFields returns the fields of a message defined in the proto package.
+func protoMessageFields(pkg protoanalysis.Package, messageName string) ([]protoanalysis.Field, error) {
+	name := strings.TrimPrefix(messageName, pkg.Name+".")
+	for _, msg := range pkg.Messages {
+		if msg.Name == name {
+			return protoanalysis.MessageFields(msg.Path, name)
+		}
+	}
+	return nil, fmt.Errorf("message %s is not defined in package %s", messageName, pkg.Name)
+}
+
+// withoutProtoFieldType removes the fields of a proto type and returns true when any was found.
+func withoutProtoFieldType(fields []protoanalysis.Field, protoType string) ([]protoanalysis.Field, bool) {
+	var (
+		filtered []protoanalysis.Field
+		found    bool
+	)
+	for _, f := range fields {
+		if f.Type == protoType {
+			found = true
+			continue
+		}
+		filtered = append(filtered, f)
+	}
+	return filtered, found
+}
+
+// importProtoFields converts proto message fields to scaffolder field arguments.
+func importProtoFields(fields []protoanalysis.Field) ([]string, error) {
+	args := make([]string, 0, len(fields))
+	for _, f := range fields {
+		name, err := importProtoFieldType(f)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, fmt.Sprintf("%s%s%s", f.Name, datatype.Separator, name))
+	}
+	return args, nil
+}
+
+// importProtoFieldType returns the scaffolder type name for a proto field.
+func importProtoFieldType(f protoanalysis.Field) (string, error) {
+	var name, slice datatype.Name
+	switch f.Type {
+	case "string":
+		name, slice = datatype.String, datatype.StringSlice
+	case "bool":
+		name = datatype.Bool
+	case "int32", "int64", "sint32", "sint64", "sfixed32", "sfixed64":
+		name, slice = datatype.Int, datatype.IntSlice
+	case "uint32", "uint64", "fixed32", "fixed64":
+		name, slice = datatype.Uint, datatype.UintSlice
+	case protoTypeCoin:
+		name, slice = datatype.Coin, datatype.Coins
+	case "bytes", "double", "float":
+		return "", fmt.Errorf("field %s has an unsupported type %s", f.Name, f.Type)
+	default:
+		// Messages defined in the module can be used as custom types
+		if !f.Repeated && !strings.Contains(f.Type, ".") {
+			return f.Type, nil
+		}
+		return "", fmt.Errorf("field %s has an unsupported type %s", f.Name, f.Type)
+	}
+
+	if !f.Repeated {
+		return string(name), nil
+	}
+	if slice == "" {
+		return "", fmt.Errorf("field %s has an unsupported repeated type %s", f.Name, f.Type)
+	}
+	return string(slice), nil
+}
+
+func isProtoSignerField(name string) bool {
+	for _, signer := range protoSignerFields {
+		if name == signer {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,379 @@
+package scaffolder
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/protoanalysis"
+)
+
+const testProtoTx = `syntax = "proto3";
+package foo.bar;
+
+import "cosmos/base/v1beta1/coin.proto";
+
+service Msg {
+  rpc CreatePost(MsgCreatePost) returns (MsgCreatePostResponse);
+  rpc Deposit(MsgDeposit) returns (MsgDepositResponse);
+}
+
+message MsgCreatePost {
+  string creator = 1;
+  string title = 2;
+  repeated string tags = 3;
+}
+
+message MsgCreatePostResponse {
+  uint64 id = 1;
+}
+
+message MsgDeposit {
+  string authority = 1;
+  repeated cosmos.base.v1beta1.Coin amount = 2;
+}
+
+message MsgDepositResponse {}
+`
+
+const testProtoQuery = `syntax = "proto3";
+package foo.bar;
+
+import "cosmos/base/query/v1beta1/pagination.proto";
+
+service Query {
+  rpc Posts(QueryPostsRequest) returns (QueryPostsResponse);
+}
+
+message QueryPostsRequest {
+  cosmos.base.query.v1beta1.PageRequest pagination = 1;
+  bool archived = 2;
+}
+
+message QueryPostsResponse {
+  repeated string titles = 1;
+  cosmos.base.query.v1beta1.PageResponse pagination = 2;
+}
+`
+
+func writeProtoFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+	return dir
+}
+
+func TestImportProtoMessage(t *testing.T) {
+	cases := []struct {
+		name      string
+		reqFields []protoanalysis.Field
+		resFields []protoanalysis.Field
+		want      protoRPC
+	}{
+		{
+			name: "creator signer",
+			reqFields: []protoanalysis.Field{
+				{Name: "creator", Type: "string"},
+				{Name: "title", Type: "string"},
+			},
+			resFields: []protoanalysis.Field{{Name: "id", Type: "uint64"}},
+			want: protoRPC{
+				name:      "CreatePost",
+				fields:    []string{"title:string"},
+				resFields: []string{"id:uint"},
+				signer:    "creator",
+			},
+		},
+		{
+			name: "custom signer",
+			reqFields: []protoanalysis.Field{
+				{Name: "title", Type: "string"},
+				{Name: "authority", Type: "string"},
+			},
+			want: protoRPC{
+				name:      "CreatePost",
+				fields:    []string{"title:string"},
+				resFields: []string{},
+				signer:    "authority",
+			},
+		},
+		{
+			name: "first signer field",
+			reqFields: []protoanalysis.Field{
+				{Name: "sender", Type: "string"},
+				{Name: "signer", Type: "string"},
+			},
+			want: protoRPC{
+				name:      "CreatePost",
+				fields:    []string{"signer:string"},
+				resFields: []string{},
+				signer:    "sender",
+			},
+		},
+		{
+			name: "no signer field",
+			reqFields: []protoanalysis.Field{
+				{Name: "title", Type: "string"},
+			},
+			want: protoRPC{
+				name:      "CreatePost",
+				fields:    []string{"title:string"},
+				resFields: []string{},
+				signer:    "creator",
+			},
+		},
+		{
+			name: "signer field that is not a string",
+			reqFields: []protoanalysis.Field{
+				{Name: "creator", Type: "string", Repeated: true},
+				{Name: "sender", Type: "uint64"},
+			},
+			want: protoRPC{
+				name:      "CreatePost",
+				fields:    []string{"creator:array.string", "sender:uint"},
+				resFields: []string{},
+				signer:    "creator",
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := importProtoMessage("CreatePost", tt.reqFields, tt.resFields)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestImportProtoQuery(t *testing.T) {
+	cases := []struct {
+		name      string
+		reqFields []protoanalysis.Field
+		resFields []protoanalysis.Field
+		want      protoRPC
+	}{
+		{
+			name: "paginated",
+			reqFields: []protoanalysis.Field{
+				{Name: "pagination", Type: protoTypePageRequest},
+				{Name: "archived", Type: "bool"},
+			},
+			resFields: []protoanalysis.Field{
+				{Name: "titles", Type: "string", Repeated: true},
+				{Name: "pagination", Type: protoTypePageResponse},
+			},
+			want: protoRPC{
+				name:      "Posts",
+				fields:    []string{"archived:bool"},
+				resFields: []string{"titles:array.string"},
+				paginated: true,
+			},
+		},
+		{
+			name: "not paginated",
+			reqFields: []protoanalysis.Field{
+				{Name: "id", Type: "uint64"},
+			},
+			resFields: []protoanalysis.Field{
+				{Name: "title", Type: "string"},
+			},
+			want: protoRPC{
+				name:      "Posts",
+				fields:    []string{"id:uint"},
+				resFields: []string{"title:string"},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := importProtoQuery("Posts", tt.reqFields, tt.resFields)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestImportProtoFieldType(t *testing.T) {
+	cases := []struct {
+		name  string
+		field protoanalysis.Field
+		want  string
+		err   bool
+	}{
+		{name: "string", field: protoanalysis.Field{Type: "string"}, want: "string"},
+		{name: "bool", field: protoanalysis.Field{Type: "bool"}, want: "bool"},
+		{name: "int32", field: protoanalysis.Field{Type: "int32"}, want: "int"},
+		{name: "sint64", field: protoanalysis.Field{Type: "sint64"}, want: "int"},
+		{name: "sfixed32", field: protoanalysis.Field{Type: "sfixed32"}, want: "int"},
+		{name: "uint64", field: protoanalysis.Field{Type: "uint64"}, want: "uint"},
+		{name: "fixed32", field: protoanalysis.Field{Type: "fixed32"}, want: "uint"},
+		{name: "coin", field: protoanalysis.Field{Type: protoTypeCoin}, want: "coin"},
+		{name: "repeated string", field: protoanalysis.Field{Type: "string", Repeated: true}, want: "array.string"},
+		{name: "repeated int", field: protoanalysis.Field{Type: "int64", Repeated: true}, want: "array.int"},
+		{name: "repeated uint", field: protoanalysis.Field{Type: "uint32", Repeated: true}, want: "array.uint"},
+		{name: "repeated coin", field: protoanalysis.Field{Type: protoTypeCoin, Repeated: true}, want: "array.coin"},
+		{name: "custom type", field: protoanalysis.Field{Type: "Post"}, want: "Post"},
+		{name: "repeated bool", field: protoanalysis.Field{Type: "bool", Repeated: true}, err: true},
+		{name: "repeated custom type", field: protoanalysis.Field{Type: "Post", Repeated: true}, err: true},
+		{name: "type of another package", field: protoanalysis.Field{Type: "google.protobuf.Timestamp"}, err: true},
+		{name: "bytes", field: protoanalysis.Field{Type: "bytes"}, err: true},
+		{name: "double", field: protoanalysis.Field{Type: "double"}, err: true},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := importProtoFieldType(tt.field)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestImportProtoRPCs(t *testing.T) {
+	dir := writeProtoFiles(t, map[string]string{
+		"tx.proto":    testProtoTx,
+		"query.proto": testProtoQuery,
+	})
+	pkgs, err := protoanalysis.Parse(context.Background(), nil, dir)
+	require.NoError(t, err)
+
+	msgs, queries, err := importProtoRPCs(pkgs)
+	require.NoError(t, err)
+	require.Equal(t, []protoRPC{
+		{
+			id:        "foo.bar.Msg/CreatePost",
+			name:      "CreatePost",
+			fields:    []string{"title:string", "tags:array.string"},
+			resFields: []string{"id:uint"},
+			signer:    "creator",
+		},
+		{
+			id:        "foo.bar.Msg/Deposit",
+			name:      "Deposit",
+			fields:    []string{"amount:array.coin"},
+			resFields: []string{},
+			signer:    "authority",
+		},
+	}, msgs)
+	require.Equal(t, []protoRPC{
+		{
+			id:        "foo.bar.Query/Posts",
+			name:      "Posts",
+			fields:    []string{"archived:bool"},
+			resFields: []string{"titles:array.string"},
+			paginated: true,
+		},
+	}, queries)
+}
+
+func TestImportProtoErrors(t *testing.T) {
+	cases := []struct {
+		name  string
+		proto string
+		err   string
+	}{
+		{
+			name: "unsupported service",
+			proto: `syntax = "proto3";
+package foo.bar;
+
+service Admin {
+  rpc Pause(MsgPause) returns (MsgPauseResponse);
+}
+
+message MsgPause {}
+message MsgPauseResponse {}
+`,
+			err: "service Admin of package foo.bar is not supported, only Msg and Query services can be imported",
+		},
+		{
+			name: "unsupported field type after a valid RPC",
+			proto: `syntax = "proto3";
+package foo.bar;
+
+service Msg {
+  rpc CreatePost(MsgCreatePost) returns (MsgCreatePostResponse);
+  rpc Upload(MsgUpload) returns (MsgUploadResponse);
+}
+
+message MsgCreatePost {
+  string creator = 1;
+  string title = 2;
+}
+message MsgCreatePostResponse {}
+
+message MsgUpload {
+  string creator = 1;
+  bytes data = 2;
+}
+message MsgUploadResponse {}
+`,
+			err: "foo.bar.Msg/Upload: field data has an unsupported type bytes",
+		},
+		{
+			name: "undefined message",
+			proto: `syntax = "proto3";
+package foo.bar;
+
+service Query {
+  rpc Post(QueryPostRequest) returns (QueryPostResponse);
+}
+
+message QueryPostRequest {}
+`,
+			err: "foo.bar.Query/Post: message QueryPostResponse is not defined in package foo.bar",
+		},
+		{
+			name: "name used by a message and a query",
+			proto: `syntax = "proto3";
+package foo.bar;
+
+service Msg {
+  rpc Post(MsgPost) returns (MsgPostResponse);
+}
+
+service Query {
+  rpc Post(QueryPostRequest) returns (QueryPostResponse);
+}
+
+message MsgPost {}
+message MsgPostResponse {}
+message QueryPostRequest {}
+message QueryPostResponse {}
+`,
+			err: "foo.bar.Query/Post: the name is already used by foo.bar.Msg/Post",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				protoDir = writeProtoFiles(t, map[string]string{"foo.proto": tt.proto})
+				appDir   = t.TempDir()
+			)
+
+			s := Scaffolder{path: appDir}
+			_, err := s.ImportProto(context.Background(), cache.Storage{}, placeholder.New(), "blog", protoDir)
+			require.EqualError(t, err, tt.err)
+
+			// The app is not modified when an RPC can't be imported
+			entries, err := os.ReadDir(appDir)
+			require.NoError(t, err)
+			require.Empty(t, entries)
+		})
+	}
+}
@@ -16,13 +16,44 @@ import (
 	"github.com/ignite/cli/ignite/pkg/gomodulepath"
 	"github.com/ignite/cli/ignite/pkg/localfs"
 	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
 	"github.com/ignite/cli/ignite/pkg/xgit"
 	"github.com/ignite/cli/ignite/templates/app"
 	modulecreate "github.com/ignite/cli/ignite/templates/module/create"
 )
 
+// initOptions holds the options to initialize a new app.
+type initOptions struct {
+	templatePack string
+	preview      *xgenny.Preview
+	appWiring    AppWiring
+}
+
+// InitOption configures the app initialization.
+type InitOption func(*initOptions)
+
+// InitWithPreview initializes the app in dry run mode, the files of the new app
+// are added to the preview instead of being written.
+func InitWithPreview(preview *xgenny.Preview) InitOption {
+	return func(o *initOptions) {
+		o.preview = preview
+	}
+}
+
 // Init initializes a new app with name and given options.
-func Init(ctx context.Context, cacheStorage cache.Storage, tracer *placeholder.Tracer, root, name, addressPrefix string, noDefaultModule bool) (path string, err error) {
+func Init(
+	ctx context.Context,
+	cacheStorage cache.Storage,
+	tracer *placeholder.Tracer,
+	root, name, addressPrefix string,
+	noDefaultModule bool,
+	options ...InitOption,
+) (path string, err error) {
+	var o initOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
 	if root, err = filepath.Abs(root); err != nil {
 		return "", err
 	}
@@ -35,10 +66,15 @@ func Init(ctx context.Context, cacheStorage cache.Storage, tracer *placeholder.T
 	path = filepath.Join(root, pathInfo.Root)
 
 	// create the project
-	if err := generate(ctx, tracer, pathInfo, addressPrefix, path, noDefaultModule); err != nil {
+	if err := generate(ctx, tracer, pathInfo, addressPrefix, path, noDefaultModule, o); err != nil {
 		return "", err
 	}
 
+	if o.preview != nil {
+		// The code is neither generated nor committed in dry run mode
+		return path, nil
+	}
+
 	if err := finish(ctx, cacheStorage, path, pathInfo.RawPath); err != nil {
 		return "", err
 	}
@@ -59,7 +95,14 @@ func generate(
 	addressPrefix,
 	absRoot string,
 	noDefaultModule bool,
+	o initOptions,
 ) error {
+	var (
+		templatePack = o.templatePack
+		preview      = o.preview
+		modern       = o.appWiring == AppWiringModern
+	)
+
 	githubPath := gomodulepath.ExtractAppPath(pathInfo.RawPath)
 	if !strings.Contains(githubPath, "/") {
 		// A username must be added when the app module path has a single element
@@ -74,12 +117,20 @@ func generate(
 		GitHubPath:       githubPath,
 		BinaryNamePrefix: pathInfo.Root,
 		AddressPrefix:    addressPrefix,
+		ModernAppWiring:  modern,
 	})
 	if err != nil {
 		return err
 	}
+	if err := boxTemplatePack(g, templatePack, TemplatePackChain, absRoot); err != nil {
+		return err
+	}
 
 	run := func(runner *genny.Runner, gen *genny.Generator) error {
+		if preview != nil {
+			_, err := xgenny.DryRunWithValidation(preview, tracer, gen)
+			return err
+		}
 		runner.With(gen)
 		runner.Root = absRoot
 		return runner.Run()
@@ -96,11 +147,16 @@ func generate(
 			AppName:    pathInfo.Package,
 			AppPath:    absRoot,
 			IsIBC:      false,
+
+			ModernAppWiring: modern,
 		}
 		g, err = modulecreate.NewStargate(opts)
 		if err != nil {
 			return err
 		}
+		if err := boxTemplatePack(g, templatePack, TemplatePackModule, absRoot); err != nil {
+			return err
+		}
 		if err := run(genny.WetRunner(context.Background()), g); err != nil {
 			return err
 		}
@@ -111,6 +167,10 @@ func generate(
 
 	}
 
+	if preview != nil {
+		return Vue(filepath.Join(absRoot, "vue"), WithPreview(preview))
+	}
+
 	// FIXME(tb) untagged version of ignite/cli triggers a 404 not found when go
 	// mod tidy requests the sumdb, until we understand why, we disable sumdb.
 	// related issue:  https://github.com/golang/go/issues/56174
@@ -124,6 +184,18 @@ func generate(
 }
 
 // Vue scaffolds a Vue.js app for a chain.
-func Vue(path string) error {
+func Vue(path string, options ...Option) error {
+	var s Scaffolder
+	for _, apply := range options {
+		apply(&s)
+	}
+
+	if s.preview != nil {
+		path, err := filepath.Abs(path)
+		if err != nil {
+			return err
+		}
+		return s.preview.AddFS(vue.Boilerplate(), path)
+	}
 	return localfs.Save(vue.Boilerplate(), path)
 }
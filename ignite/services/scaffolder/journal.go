@@ -0,0 +1,37 @@
+package scaffolder
+
+import (
+	"github.com/ignite/cli/ignite/pkg/journal"
+)
+
+// Record runs a scaffold operation and records the changes made to the app files
+// in the app journal, so the operation can be undone.
+// The changes are also recorded when the operation fails.
+// Nothing is recorded in dry run mode.
+func (s Scaffolder) Record(name string, scaffold func() error) error {
+	if s.preview != nil {
+		return scaffold()
+	}
+
+	j := journal.New(s.path)
+
+	before, err := j.Snapshot()
+	if err != nil {
+		return err
+	}
+
+	scaffoldErr := scaffold()
+
+	if _, err := j.Record(name, before); err != nil {
+		return err
+	}
+
+	return scaffoldErr
+}
+
+// Undo reverts the changes made by the last scaffold operation recorded in the app journal.
+// When force is false and the changed files were modified after the operation, a
+// journal.ConflictError is returned.
+func (s Scaffolder) Undo(force bool) (journal.Entry, error) {
+	return journal.New(s.path).Undo(force)
+}
@@ -21,6 +21,7 @@ type messageOptions struct {
 	description       string
 	signer            string
 	withoutSimulation bool
+	withoutEvents     bool
 }
 
 // newMessageOptions returns a messageOptions with default options
@@ -55,6 +56,13 @@ func WithoutSimulation() MessageOption {
 	}
 }
 
+// WithoutEvents disables emitting a typed event when the message is handled
+func WithoutEvents() MessageOption {
+	return func(m *messageOptions) {
+		m.withoutEvents = true
+	}
+}
+
 // AddMessage adds a new message to scaffolded app
 func (s Scaffolder) AddMessage(
 	ctx context.Context,
@@ -66,6 +74,39 @@ func (s Scaffolder) AddMessage(
 	resFields []string,
 	options ...MessageOption,
 ) (sm xgenny.SourceModification, err error) {
+	opts, err := s.messageTemplateOptions(ctx, moduleName, msgName, fields, resFields, options...)
+	if err != nil {
+		return sm, err
+	}
+
+	gens, err := s.messageSupportGenerators(tracer, opts)
+	if err != nil {
+		return sm, err
+	}
+
+	// Scaffold
+	g, err := s.messageGenerator(tracer, opts)
+	if err != nil {
+		return sm, err
+	}
+	gens = append(gens, g)
+	sm, err = s.run(tracer, gens...)
+	if err != nil {
+		return sm, err
+	}
+	return sm, s.finish(ctx, cacheStorage)
+}
+
+// messageTemplateOptions checks the message and its fields and returns the
+// options of the message template.
+func (s Scaffolder) messageTemplateOptions(
+	ctx context.Context,
+	moduleName,
+	msgName string,
+	fields,
+	resFields []string,
+	options ...MessageOption,
+) (*message.Options, error) {
 	// Create the options
 	scaffoldingOpts := newMessageOptions(msgName)
 	for _, apply := range options {
@@ -78,62 +119,70 @@ func (s Scaffolder) AddMessage(
 	}
 	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
 	if err != nil {
-		return sm, err
+		return nil, err
 	}
 	moduleName = mfName.LowerCase
 
 	name, err := multiformatname.NewName(msgName)
 	if err != nil {
-		return sm, err
+		return nil, err
 	}
 
 	if err := checkComponentValidity(s.path, moduleName, name, false); err != nil {
-		return sm, err
+		return nil, err
 	}
 
 	// Check and parse provided fields
 	if err := checkCustomTypes(ctx, s.path, s.modpath.Package, moduleName, fields); err != nil {
-		return sm, err
+		return nil, err
 	}
 	parsedMsgFields, err := field.ParseFields(fields, checkForbiddenMessageField, scaffoldingOpts.signer)
 	if err != nil {
-		return sm, err
+		return nil, err
 	}
 
 	// Check and parse provided response fields
 	if err := checkCustomTypes(ctx, s.path, s.modpath.Package, moduleName, resFields); err != nil {
-		return sm, err
+		return nil, err
 	}
 	parsedResFields, err := field.ParseFields(resFields, checkGoReservedWord, scaffoldingOpts.signer)
 	if err != nil {
-		return sm, err
+		return nil, err
 	}
 
 	mfSigner, err := multiformatname.NewName(scaffoldingOpts.signer)
 	if err != nil {
-		return sm, err
+		return nil, err
 	}
 
-	var (
-		g    *genny.Generator
-		opts = &message.Options{
-			AppName:      s.modpath.Package,
-			AppPath:      s.path,
-			ModulePath:   s.modpath.RawPath,
-			ModuleName:   moduleName,
-			MsgName:      name,
-			Fields:       parsedMsgFields,
-			ResFields:    parsedResFields,
-			MsgDesc:      scaffoldingOpts.description,
-			MsgSigner:    mfSigner,
-			NoSimulation: scaffoldingOpts.withoutSimulation,
-		}
-	)
+	withMetrics, err := hasModuleMetrics(s.path, moduleName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &message.Options{
+		AppName:      s.modpath.Package,
+		AppPath:      s.path,
+		ModulePath:   s.modpath.RawPath,
+		ModuleName:   moduleName,
+		MsgName:      name,
+		Fields:       parsedMsgFields,
+		ResFields:    parsedResFields,
+		MsgDesc:      scaffoldingOpts.description,
+		MsgSigner:    mfSigner,
+		NoSimulation: scaffoldingOpts.withoutSimulation,
+		NoEvents:     scaffoldingOpts.withoutEvents,
+		WithMetrics:  withMetrics,
+	}, nil
+}
 
+// messageSupportGenerators returns the generators that add the MsgServer
+// convention and the simulation to the module of the message when they are
+// missing, they are shared by all the messages of the module.
+func (s Scaffolder) messageSupportGenerators(tracer *placeholder.Tracer, opts *message.Options) ([]*genny.Generator, error) {
 	// Check and support MsgServer convention
-	var gens []*genny.Generator
-	gens, err = supportMsgServer(
-		gens,
+	gens, err := supportMsgServer(
+		nil,
 		tracer,
 		s.path,
 		&modulecreate.MsgServerOptions{
@@ -144,30 +193,27 @@ func (s Scaffolder) AddMessage(
 		},
 	)
 	if err != nil {
-		return sm, err
+		return nil, err
 	}
 
-	gens, err = supportSimulation(
+	return supportSimulation(
 		gens,
 		opts.AppPath,
 		opts.ModulePath,
 		opts.ModuleName,
 	)
-	if err != nil {
-		return sm, err
-	}
+}
 
-	// Scaffold
-	g, err = message.NewStargate(tracer, opts)
+// messageGenerator returns the generator of the message.
+func (s Scaffolder) messageGenerator(tracer *placeholder.Tracer, opts *message.Options) (*genny.Generator, error) {
+	g, err := message.NewStargate(tracer, opts)
 	if err != nil {
-		return sm, err
+		return nil, err
 	}
-	gens = append(gens, g)
-	sm, err = xgenny.RunWithValidation(tracer, gens...)
-	if err != nil {
-		return sm, err
+	if err := boxTemplatePack(g, s.templatePack, TemplatePackMessage, s.path); err != nil {
+		return nil, err
 	}
-	return sm, finish(ctx, cacheStorage, opts.AppPath, s.modpath.RawPath)
+	return g, nil
 }
 
 // checkForbiddenMessageField returns true if the name is forbidden as a message name
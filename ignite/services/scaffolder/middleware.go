@@ -0,0 +1,79 @@
+package scaffolder
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/ibc"
+)
+
+// AddIBCMiddleware scaffolds an IBC middleware inside a module and wraps the transfer stack of the app with it.
+func (s Scaffolder) AddIBCMiddleware(
+	ctx context.Context,
+	cacheStorage cache.Storage,
+	tracer *placeholder.Tracer,
+	moduleName,
+	middlewareName string,
+) (sm xgenny.SourceModification, err error) {
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return sm, err
+	}
+	moduleName = mfName.LowerCase
+
+	name, err := multiformatname.NewName(middlewareName)
+	if err != nil {
+		return sm, err
+	}
+
+	if err := checkComponentValidity(s.path, moduleName, name, true); err != nil {
+		return sm, err
+	}
+
+	// Check the middleware has not been scaffolded yet
+	ok, err := isMiddlewareCreated(s.path, moduleName, name)
+	if err != nil {
+		return sm, err
+	}
+	if ok {
+		return sm, fmt.Errorf("the middleware %s already exists in the module %s", name.Original, moduleName)
+	}
+
+	opts := &ibc.MiddlewareOptions{
+		AppName:        s.modpath.Package,
+		AppPath:        s.path,
+		ModulePath:     s.modpath.RawPath,
+		ModuleName:     moduleName,
+		MiddlewareName: name,
+	}
+	g, err := ibc.NewMiddleware(tracer, opts)
+	if err != nil {
+		return sm, err
+	}
+	sm, err = s.run(tracer, g)
+	if err != nil {
+		return sm, err
+	}
+	return sm, s.finish(ctx, cacheStorage)
+}
+
+// isMiddlewareCreated returns true if the middleware implementation file exists in the module
+func isMiddlewareCreated(appPath, moduleName string, name multiformatname.Name) (bool, error) {
+	absPath, err := filepath.Abs(filepath.Join(appPath, moduleDir, moduleName, fmt.Sprintf("middleware_%s.go", name.Snake)))
+	if err != nil {
+		return false, err
+	}
+
+	_, err = os.Stat(absPath)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
@@ -13,8 +13,6 @@ import (
 	"github.com/gobuffalo/genny"
 
 	"github.com/ignite/cli/ignite/pkg/cache"
-	"github.com/ignite/cli/ignite/pkg/cmdrunner"
-	"github.com/ignite/cli/ignite/pkg/cmdrunner/step"
 	appanalysis "github.com/ignite/cli/ignite/pkg/cosmosanalysis/app"
 	"github.com/ignite/cli/ignite/pkg/cosmosver"
 	"github.com/ignite/cli/ignite/pkg/gocmd"
@@ -29,15 +27,22 @@ import (
 )
 
 const (
-	wasmImport    = "github.com/CosmWasm/wasmd"
-	wasmVersion   = "v0.16.0"
-	extrasImport  = "github.com/tendermint/spm-extras"
-	extrasVersion = "v0.1.0"
-	appPkg        = "app"
-	moduleDir     = "x"
+	wasmImport  = "github.com/CosmWasm/wasmd"
+	wasmVersion = "v0.30.0"
+	appPkg      = "app"
+	moduleDir   = "x"
+
+	moduleMetricsImplementation = "keeper/metrics.go"
 )
 
 var (
+	// wasmMinSDKVersion and wasmMaxSDKVersion are the range of the Cosmos SDK
+	// versions supported by the imported CosmWasm version, the max version is
+	// excluded. wasmd v0.30.0 is built on Cosmos SDK v0.45 and ibc-go v4, and no
+	// wasmd release is built on Cosmos SDK v0.46 and ibc-go v5.
+	wasmMinSDKVersion = cosmosver.StargateFortyVersion
+	wasmMaxSDKVersion = cosmosver.StargateFortySixVersion
+
 	// reservedNames are either names from the default modules defined in a Cosmos-SDK app or names used in the default query and tx CLI namespace
 	// A new module's name can't be equal to a reserved name
 	// A map is used for direct comparing
@@ -60,6 +65,7 @@ var (
 		"ibc":          {},
 		"mint":         {},
 		"multisign":    {},
+		"nft":          {},
 		"params":       {},
 		"sign":         {},
 		"slashing":     {},
@@ -83,6 +89,7 @@ var (
 		"gov",
 		"group",
 		"mint",
+		"nft",
 		"slashing",
 		"staking",
 		"upgrade",
@@ -104,6 +111,12 @@ type moduleCreationOptions struct {
 
 	// dependencies list of module dependencies
 	dependencies []modulecreate.Dependency
+
+	// hooks true if the module should define hooks for other modules
+	hooks bool
+
+	// metrics true if the module should emit metrics of its handlers and keeper methods
+	metrics bool
 }
 
 // ModuleCreationOption configures Chain.
@@ -144,6 +157,21 @@ func WithDependencies(dependencies []modulecreate.Dependency) ModuleCreationOpti
 	}
 }
 
+// WithHooks scaffolds a module with a hooks interface that other modules can implement
+func WithHooks() ModuleCreationOption {
+	return func(m *moduleCreationOptions) {
+		m.hooks = true
+	}
+}
+
+// WithMetrics scaffolds a module that emits telemetry metrics of its message
+// handlers and keeper methods
+func WithMetrics() ModuleCreationOption {
+	return func(m *moduleCreationOptions) {
+		m.metrics = true
+	}
+}
+
 // CreateModule creates a new empty module in the scaffolded app
 func (s Scaffolder) CreateModule(
 	ctx context.Context,
@@ -198,13 +226,33 @@ func (s Scaffolder) CreateModule(
 		IsIBC:        creationOpts.ibc,
 		IBCOrdering:  creationOpts.ibcChannelOrdering,
 		Dependencies: creationOpts.dependencies,
+		WithHooks:    creationOpts.hooks,
+		WithMetrics:  creationOpts.metrics,
+
+		ModernAppWiring: s.isModernAppWiring(),
+	}
+	if opts.ModernAppWiring && opts.IsIBC {
+		return sm, errors.New("IBC modules are not supported by apps with modern wiring")
+	}
+
+	sm, err = s.createModule(tracer, opts)
+	if err != nil {
+		return sm, err
 	}
 
+	return sm, s.finish(ctx, cacheStorage)
+}
+
+// createModule runs the generators that create a module and register it in the app.
+func (s Scaffolder) createModule(tracer *placeholder.Tracer, opts *modulecreate.CreateOptions) (sm xgenny.SourceModification, err error) {
 	// Generator from Cosmos SDK version
 	g, err := modulecreate.NewStargate(opts)
 	if err != nil {
 		return sm, err
 	}
+	if err := boxTemplatePack(g, s.templatePack, TemplatePackModule, s.path); err != nil {
+		return sm, err
+	}
 	gens := []*genny.Generator{g}
 
 	// Scaffold IBC module
@@ -215,20 +263,20 @@ func (s Scaffolder) CreateModule(
 		}
 		gens = append(gens, g)
 	}
-	sm, err = xgenny.RunWithValidation(tracer, gens...)
+	sm, err = s.run(tracer, gens...)
 	if err != nil {
 		return sm, err
 	}
 
 	// Modify app.go to register the module
-	newSourceModification, runErr := xgenny.RunWithValidation(tracer, modulecreate.NewStargateAppModify(tracer, opts))
+	newSourceModification, runErr := s.run(tracer, modulecreate.NewStargateAppModify(tracer, opts))
 	sm.Merge(newSourceModification)
 	var validationErr validation.Error
 	if runErr != nil && !errors.As(runErr, &validationErr) {
 		return sm, runErr
 	}
 
-	return sm, finish(ctx, cacheStorage, opts.AppPath, s.modpath.RawPath)
+	return sm, nil
 }
 
 // ImportModule imports specified module with name to the scaffolded app.
@@ -251,6 +299,10 @@ func (s Scaffolder) ImportModule(
 		return sm, errors.New("wasm is already imported")
 	}
 
+	if err := checkWasmSupport(s.Version); err != nil {
+		return sm, err
+	}
+
 	// run generator
 	g, err := moduleimport.NewStargate(tracer, &moduleimport.ImportOptions{
 		AppPath:          s.path,
@@ -262,7 +314,7 @@ func (s Scaffolder) ImportModule(
 		return sm, err
 	}
 
-	sm, err = xgenny.RunWithValidation(tracer, g)
+	sm, err = s.run(tracer, g)
 	if err != nil {
 		var validationErr validation.Error
 		if errors.As(err, &validationErr) {
@@ -274,11 +326,15 @@ func (s Scaffolder) ImportModule(
 
 	// import a specific version of ComsWasm
 	// NOTE(dshulyak) it must be installed after validation
-	if err := s.installWasm(); err != nil {
+	if s.preview != nil {
+		// The dependencies are not installed in dry run mode
+		return sm, nil
+	}
+	if err := installWasm(ctx, s.path); err != nil {
 		return sm, err
 	}
 
-	return sm, finish(ctx, cacheStorage, s.path, s.modpath.RawPath)
+	return sm, s.finish(ctx, cacheStorage)
 }
 
 // moduleExists checks if the module exists in the app
@@ -297,6 +353,23 @@ func moduleExists(appPath string, moduleName string) (bool, error) {
 	return err == nil, err
 }
 
+// hasModuleMetrics checks if the module emits the metrics of its message
+// handlers and keeper methods
+func hasModuleMetrics(appPath string, moduleName string) (bool, error) {
+	absPath, err := filepath.Abs(filepath.Join(appPath, moduleDir, moduleName, moduleMetricsImplementation))
+	if err != nil {
+		return false, err
+	}
+
+	_, err = os.Stat(absPath)
+	if os.IsNotExist(err) {
+		// The module doesn't emit metrics
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
 // checkModuleName checks if the name can be used as a module name
 func checkModuleName(appPath, moduleName string) error {
 	// go keyword
@@ -345,6 +418,11 @@ func checkModuleName(appPath, moduleName string) error {
 }
 
 func isWasmImported(appPath string) (bool, error) {
+	return isImported(appPath, wasmImport)
+}
+
+// isImported checks if a package is imported by the app package
+func isImported(appPath, importPath string) (bool, error) {
 	abspath := filepath.Join(appPath, appPkg)
 	fset := token.NewFileSet()
 	all, err := parser.ParseDir(fset, abspath, func(os.FileInfo) bool { return true }, parser.ImportsOnly)
@@ -354,7 +432,7 @@ func isWasmImported(appPath string) (bool, error) {
 	for _, pkg := range all {
 		for _, f := range pkg.Files {
 			for _, imp := range f.Imports {
-				if strings.Contains(imp.Path.Value, wasmImport) {
+				if strings.Contains(imp.Path.Value, importPath) {
 					return true, nil
 				}
 			}
@@ -363,18 +441,26 @@ func isWasmImported(appPath string) (bool, error) {
 	return false, nil
 }
 
-func (s Scaffolder) installWasm() error {
-	switch {
-	case s.Version.GTE(cosmosver.StargateFortyVersion):
-		return cmdrunner.
-			New().
-			Run(context.Background(),
-				step.New(step.Exec(gocmd.Name(), "get", gocmd.PackageLiteral(wasmImport, wasmVersion))),
-				step.New(step.Exec(gocmd.Name(), "get", gocmd.PackageLiteral(extrasImport, extrasVersion))),
-			)
-	default:
+// checkWasmSupport returns an error when the imported CosmWasm version doesn't
+// support the Cosmos SDK version of the app.
+func checkWasmSupport(v cosmosver.Version) error {
+	if v.LT(wasmMinSDKVersion) {
 		return errors.New("version not supported")
 	}
+	if v.GTE(wasmMaxSDKVersion) {
+		return fmt.Errorf(
+			"CosmWasm is not supported by apps on Cosmos SDK v%d.%d: wasmd %s requires Cosmos SDK v0.45 and ibc-go v4",
+			v.Semantic.Major,
+			v.Semantic.Minor,
+			wasmVersion,
+		)
+	}
+	return nil
+}
+
+// installWasm adds the supported CosmWasm version to the app dependencies.
+func installWasm(ctx context.Context, appPath string) error {
+	return gocmd.Get(ctx, appPath, []string{gocmd.PackageLiteral(wasmImport, wasmVersion)})
 }
 
 // checkDependencies perform checks on the dependencies
@@ -0,0 +1,116 @@
+package scaffolder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	modulecreate "github.com/ignite/cli/ignite/templates/module/create"
+	moduleimport "github.com/ignite/cli/ignite/templates/module/import"
+	"github.com/ignite/cli/ignite/templates/nft"
+)
+
+// nftKeeperName is the name of the keeper of the nft module in app.go.
+const nftKeeperName = "NFTKeeper"
+
+// CreateNFTModule creates a new module that creates classes of NFTs with the
+// schema of their metadata, mints and transfers NFTs with the keeper of the nft
+// module of the Cosmos SDK. The nft module is enabled in the app when it's not
+// already.
+func (s Scaffolder) CreateNFTModule(
+	ctx context.Context,
+	cacheStorage cache.Storage,
+	tracer *placeholder.Tracer,
+	moduleName string,
+) (sm xgenny.SourceModification, err error) {
+	minVersion := sdkModules[moduleimport.SDKModuleNFT]
+	if s.Version.LT(minVersion) {
+		return sm, fmt.Errorf("the nft module requires Cosmos SDK %s or newer, the app uses %s", minVersion, s.Version)
+	}
+
+	// The nft module is enabled by modifying the app.go of the apps
+	if s.isModernAppWiring() {
+		return sm, errors.New("NFT modules are not supported by apps with modern wiring")
+	}
+
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return sm, err
+	}
+	moduleName = mfName.LowerCase
+
+	if err := checkModuleName(s.path, moduleName); err != nil {
+		return sm, err
+	}
+	ok, err := moduleExists(s.path, moduleName)
+	if err != nil {
+		return sm, err
+	}
+	if ok {
+		return sm, fmt.Errorf("the module %v already exists", moduleName)
+	}
+
+	dependencies := []modulecreate.Dependency{
+		modulecreate.NewDependency(moduleimport.SDKModuleNFT, nftKeeperName),
+	}
+
+	sm = xgenny.NewSourceModification()
+
+	// The keeper of the nft module must be defined before the keeper of the new module
+	enabled, err := isImported(s.path, sdkModuleImportPrefix+moduleimport.SDKModuleNFT)
+	if err != nil {
+		return sm, err
+	}
+	if enabled {
+		if err := checkDependencies(dependencies, s.path); err != nil {
+			return sm, err
+		}
+	} else {
+		g, err := moduleimport.NewSDKModule(tracer, &moduleimport.SDKModuleOptions{
+			AppPath:    s.path,
+			Module:     moduleimport.SDKModuleNFT,
+			SDKVersion: s.Version,
+		})
+		if err != nil {
+			return sm, err
+		}
+		nftSm, err := s.run(tracer, g)
+		sm.Merge(nftSm)
+		if err != nil {
+			return sm, err
+		}
+	}
+
+	moduleSm, err := s.createModule(tracer, &modulecreate.CreateOptions{
+		ModuleName:   moduleName,
+		ModulePath:   s.modpath.RawPath,
+		AppName:      s.modpath.Package,
+		AppPath:      s.path,
+		Dependencies: dependencies,
+	})
+	sm.Merge(moduleSm)
+	if err != nil {
+		return sm, err
+	}
+
+	g, err := nft.NewGenerator(tracer, &nft.Options{
+		AppName:    s.modpath.Package,
+		AppPath:    s.path,
+		ModuleName: moduleName,
+		ModulePath: s.modpath.RawPath,
+	})
+	if err != nil {
+		return sm, err
+	}
+	nftSm, err := s.run(tracer, g)
+	sm.Merge(nftSm)
+	if err != nil {
+		return sm, err
+	}
+
+	return sm, s.finish(ctx, cacheStorage)
+}
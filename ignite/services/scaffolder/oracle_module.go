@@ -0,0 +1,116 @@
+package scaffolder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/cosmosver"
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/field"
+	modulecreate "github.com/ignite/cli/ignite/templates/module/create"
+	"github.com/ignite/cli/ignite/templates/oracle"
+)
+
+// oracleParams are the params of an oracle module created with the module
+// templates, the list of feeders is added by the oracle templates.
+var oracleParams = []string{"window:uint", "minSubmissions:uint"}
+
+// oracleModuleOptions represents configuration for the oracle module scaffolding.
+type oracleModuleOptions struct {
+	voteExtensions bool
+}
+
+// OracleModuleOption configures the oracle module scaffolding.
+type OracleModuleOption func(*oracleModuleOptions)
+
+// WithVoteExtensions submits the prices with the vote extensions of the validators
+// instead of txs.
+func WithVoteExtensions() OracleModuleOption {
+	return func(o *oracleModuleOptions) {
+		o.voteExtensions = true
+	}
+}
+
+// CreateOracleModule creates a new module that aggregates the prices submitted by
+// a set of feeders, with a reference feeder daemon under the tools directory.
+func (s Scaffolder) CreateOracleModule(
+	ctx context.Context,
+	cacheStorage cache.Storage,
+	tracer *placeholder.Tracer,
+	moduleName string,
+	options ...OracleModuleOption,
+) (sm xgenny.SourceModification, err error) {
+	var o oracleModuleOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	// The vote extensions are part of ABCI++ that comes with Cosmos SDK v0.50
+	if o.voteExtensions {
+		if s.Version.LT(cosmosver.StargateFiftyVersion) {
+			return sm, fmt.Errorf(
+				"vote extensions require Cosmos SDK %s or newer, the app uses %s: submit the prices with txs instead",
+				cosmosver.StargateFiftyVersion,
+				s.Version,
+			)
+		}
+		return sm, errors.New("vote extensions are not supported yet by the oracle templates, submit the prices with txs instead")
+	}
+
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return sm, err
+	}
+	moduleName = mfName.LowerCase
+
+	if err := checkModuleName(s.path, moduleName); err != nil {
+		return sm, err
+	}
+	ok, err := moduleExists(s.path, moduleName)
+	if err != nil {
+		return sm, err
+	}
+	if ok {
+		return sm, fmt.Errorf("the module %v already exists", moduleName)
+	}
+
+	params, err := field.ParseFields(oracleParams, checkForbiddenTypeField)
+	if err != nil {
+		return sm, err
+	}
+
+	modernAppWiring := s.isModernAppWiring()
+	sm, err = s.createModule(tracer, &modulecreate.CreateOptions{
+		ModuleName:      moduleName,
+		ModulePath:      s.modpath.RawPath,
+		Params:          params,
+		AppName:         s.modpath.Package,
+		AppPath:         s.path,
+		ModernAppWiring: modernAppWiring,
+	})
+	if err != nil {
+		return sm, err
+	}
+
+	g, err := oracle.NewGenerator(tracer, &oracle.Options{
+		AppName:         s.modpath.Package,
+		AppPath:         s.path,
+		ModuleName:      moduleName,
+		ModulePath:      s.modpath.RawPath,
+		ModernAppWiring: modernAppWiring,
+	})
+	if err != nil {
+		return sm, err
+	}
+	oracleSm, err := s.run(tracer, g)
+	sm.Merge(oracleSm)
+	if err != nil {
+		return sm, err
+	}
+
+	return sm, s.finish(ctx, cacheStorage)
+}
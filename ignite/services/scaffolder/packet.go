@@ -139,11 +139,14 @@ func (s Scaffolder) AddPacket(
 	if err != nil {
 		return sm, err
 	}
-	sm, err = xgenny.RunWithValidation(tracer, g)
+	if err := boxTemplatePack(g, s.templatePack, TemplatePackPacket, s.path); err != nil {
+		return sm, err
+	}
+	sm, err = s.run(tracer, g)
 	if err != nil {
 		return sm, err
 	}
-	return sm, finish(ctx, cacheStorage, opts.AppPath, s.modpath.RawPath)
+	return sm, s.finish(ctx, cacheStorage)
 }
 
 // isIBCModule returns true if the provided module implements the IBC module interface
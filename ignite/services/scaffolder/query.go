@@ -14,6 +14,22 @@ import (
 	"github.com/ignite/cli/ignite/templates/query"
 )
 
+// queryOptions represents configuration for the query scaffolding
+type queryOptions struct {
+	route string
+}
+
+// QueryOption configures the query scaffolding
+type QueryOption func(*queryOptions)
+
+// QueryWithRoute provides a custom REST route for the query, e.g. "/blog/posts/{id}".
+// The path parameters of the route must be request fields.
+func QueryWithRoute(route string) QueryOption {
+	return func(o *queryOptions) {
+		o.route = route
+	}
+}
+
 // AddQuery adds a new query to scaffolded app
 func (s Scaffolder) AddQuery(
 	ctx context.Context,
@@ -25,67 +41,106 @@ func (s Scaffolder) AddQuery(
 	reqFields,
 	resFields []string,
 	paginated bool,
+	options ...QueryOption,
 ) (sm xgenny.SourceModification, err error) {
+	opts, err := s.queryTemplateOptions(ctx, moduleName, queryName, description, reqFields, resFields, paginated, options...)
+	if err != nil {
+		return sm, err
+	}
+
+	// Scaffold
+	g, err := s.queryGenerator(tracer, opts)
+	if err != nil {
+		return sm, err
+	}
+	sm, err = s.run(tracer, g)
+	if err != nil {
+		return sm, err
+	}
+	return sm, s.finish(ctx, cacheStorage)
+}
+
+// queryTemplateOptions checks the query and its fields and returns the options
+// of the query template.
+func (s Scaffolder) queryTemplateOptions(
+	ctx context.Context,
+	moduleName,
+	queryName,
+	description string,
+	reqFields,
+	resFields []string,
+	paginated bool,
+	options ...QueryOption,
+) (*query.Options, error) {
+	var scaffoldingOpts queryOptions
+	for _, apply := range options {
+		apply(&scaffoldingOpts)
+	}
+
 	// If no module is provided, we add the type to the app's module
 	if moduleName == "" {
 		moduleName = s.modpath.Package
 	}
 	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
 	if err != nil {
-		return sm, err
+		return nil, err
 	}
 	moduleName = mfName.LowerCase
 
 	name, err := multiformatname.NewName(queryName)
 	if err != nil {
-		return sm, err
+		return nil, err
 	}
 
 	if err := checkComponentValidity(s.path, moduleName, name, true); err != nil {
-		return sm, err
+		return nil, err
 	}
 
 	// Check and parse provided request fields
 	if ok := containCustomTypes(reqFields); ok {
-		return sm, errors.New("query request params can't contain custom type")
+		return nil, errors.New("query request params can't contain custom type")
 	}
 	parsedReqFields, err := field.ParseFields(reqFields, checkGoReservedWord)
 	if err != nil {
-		return sm, err
+		return nil, err
+	}
+	if scaffoldingOpts.route != "" {
+		if err := query.CheckRoute(scaffoldingOpts.route, parsedReqFields); err != nil {
+			return nil, err
+		}
 	}
 
 	// Check and parse provided response fields
 	if err := checkCustomTypes(ctx, s.path, s.modpath.Package, moduleName, resFields); err != nil {
-		return sm, err
+		return nil, err
 	}
 	parsedResFields, err := field.ParseFields(resFields, checkGoReservedWord)
 	if err != nil {
-		return sm, err
+		return nil, err
 	}
 
-	var (
-		g    *genny.Generator
-		opts = &query.Options{
-			AppName:     s.modpath.Package,
-			AppPath:     s.path,
-			ModulePath:  s.modpath.RawPath,
-			ModuleName:  moduleName,
-			QueryName:   name,
-			ReqFields:   parsedReqFields,
-			ResFields:   parsedResFields,
-			Description: description,
-			Paginated:   paginated,
-		}
-	)
+	return &query.Options{
+		AppName:     s.modpath.Package,
+		AppPath:     s.path,
+		ModulePath:  s.modpath.RawPath,
+		ModuleName:  moduleName,
+		QueryName:   name,
+		ReqFields:   parsedReqFields,
+		ResFields:   parsedResFields,
+		Description: description,
+		Paginated:   paginated,
+		Route:       scaffoldingOpts.route,
+	}, nil
+}
 
-	// Scaffold
-	g, err = query.NewStargate(tracer, opts)
+// queryGenerator returns the generator of the query.
+func (s Scaffolder) queryGenerator(tracer *placeholder.Tracer, opts *query.Options) (*genny.Generator, error) {
+	g, err := query.NewStargate(tracer, opts)
 	if err != nil {
-		return sm, err
+		return nil, err
 	}
-	sm, err = xgenny.RunWithValidation(tracer, g)
-	if err != nil {
-		return sm, err
+	if err := boxTemplatePack(g, s.templatePack, TemplatePackQuery, s.path); err != nil {
+		return nil, err
 	}
-	return sm, finish(ctx, cacheStorage, opts.AppPath, s.modpath.RawPath)
+	return g, nil
 }
@@ -7,6 +7,8 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/gobuffalo/genny"
+
 	"github.com/ignite/cli/ignite/chainconfig"
 	sperrors "github.com/ignite/cli/ignite/errors"
 	"github.com/ignite/cli/ignite/pkg/cache"
@@ -16,6 +18,8 @@ import (
 	"github.com/ignite/cli/ignite/pkg/gocmd"
 	"github.com/ignite/cli/ignite/pkg/gomodule"
 	"github.com/ignite/cli/ignite/pkg/gomodulepath"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
 )
 
 // Scaffolder is Ignite CLI app scaffolder.
@@ -28,10 +32,22 @@ type Scaffolder struct {
 
 	// modpath represents the go module path of the app.
 	modpath gomodulepath.Path
+
+	// templatePack is the directory of the template pack used to scaffold, it is optional.
+	templatePack string
+
+	// preview holds the modifications of the app when scaffolding in dry run mode.
+	preview *xgenny.Preview
+
+	// appWiring defines how the modules are wired into the app.
+	appWiring AppWiring
 }
 
+// Option configures the scaffolder.
+type Option func(*Scaffolder)
+
 // App creates a new scaffolder for an existent app.
-func App(path string) (Scaffolder, error) {
+func App(path string, options ...Option) (Scaffolder, error) {
 	path, err := filepath.Abs(path)
 	if err != nil {
 		return Scaffolder{}, err
@@ -63,10 +79,60 @@ func App(path string) (Scaffolder, error) {
 		path:    path,
 		modpath: modpath,
 	}
+	for _, apply := range options {
+		apply(&s)
+	}
+
+	if err := s.registerFieldTypes(); err != nil {
+		return Scaffolder{}, err
+	}
 
 	return s, nil
 }
 
+// WithPreview scaffolds in dry run mode, the modifications of the app are added
+// to the preview instead of being written.
+func WithPreview(preview *xgenny.Preview) Option {
+	return func(s *Scaffolder) {
+		s.preview = preview
+	}
+}
+
+// run runs the generators to modify the app, or adds the modifications to the
+// preview in dry run mode.
+func (s Scaffolder) run(tracer *placeholder.Tracer, gens ...*genny.Generator) (xgenny.SourceModification, error) {
+	if s.preview != nil {
+		return xgenny.DryRunWithValidation(s.preview, tracer, gens...)
+	}
+	return xgenny.RunWithValidation(tracer, gens...)
+}
+
+// runAll runs the generators like run, but they are all checked with a dry run
+// before the app is modified, so the app is left unchanged when one of them
+// fails.
+func (s Scaffolder) runAll(tracer *placeholder.Tracer, gens ...*genny.Generator) (xgenny.SourceModification, error) {
+	if s.preview != nil {
+		return xgenny.DryRunWithValidation(s.preview, tracer, gens...)
+	}
+	sm, err := xgenny.DryRunWithValidation(xgenny.NewPreview(), tracer, gens...)
+	if err != nil {
+		return sm, err
+	}
+	if _, err := xgenny.RunWithValidation(tracer, gens...); err != nil {
+		return sm, err
+	}
+	return sm, nil
+}
+
+// finish generates the code from the proto files and formats the app once it's
+// modified, nothing is done in dry run mode.
+func (s Scaffolder) finish(ctx context.Context, cacheStorage cache.Storage) error {
+	if s.preview != nil {
+		return nil
+	}
+	return finish(ctx, cacheStorage, s.path, s.modpath.RawPath)
+}
+
 func finish(ctx context.Context, cacheStorage cache.Storage, path, gomodPath string) error {
 	if err := protoc(ctx, cacheStorage, path, gomodPath); err != nil {
 		return err
@@ -96,6 +162,19 @@ func protoc(ctx context.Context, cacheStorage cache.Storage, projectPath, gomodP
 		cosmosgen.IncludeDirs(conf.Build.Proto.ThirdPartyPaths),
 	}
 
+	if len(conf.Build.Proto.Modules) > 0 {
+		moduleOptions := make(map[string]cosmosgen.ModuleOptions)
+		for _, m := range conf.Build.Proto.Modules {
+			moduleOptions[m.Package] = cosmosgen.ModuleOptions{
+				IncludeDirs:    m.IncludePaths,
+				GoOptions:      m.GoOptions,
+				OpenAPIOptions: m.OpenAPIOptions,
+				TSOptions:      m.TSOptions,
+			}
+		}
+		options = append(options, cosmosgen.WithModuleOptions(moduleOptions))
+	}
+
 	// Generate Typescript client code if it's enabled or when Vuex stores are generated
 	if conf.Client.Typescript.Path != "" || conf.Client.Vuex.Path != "" {
 		tsClientPath := chainconfig.TSClientPath(conf)
@@ -0,0 +1,75 @@
+package scaffolder
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/cosmosver"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	moduleimport "github.com/ignite/cli/ignite/templates/module/import"
+)
+
+const sdkModuleImportPrefix = "github.com/cosmos/cosmos-sdk/x/"
+
+// sdkModules are the optional Cosmos SDK modules that can be enabled
+// in an app with the minimum Cosmos SDK version that supports them.
+var sdkModules = map[string]cosmosver.Version{
+	moduleimport.SDKModuleAuthz:    cosmosver.StargateFortyFourVersion,
+	moduleimport.SDKModuleFeegrant: cosmosver.StargateFortyFourVersion,
+	moduleimport.SDKModuleGroup:    cosmosver.StargateFortySixVersion,
+	moduleimport.SDKModuleNFT:      cosmosver.StargateFortySixVersion,
+}
+
+// SDKModules returns the names of the optional Cosmos SDK modules that can be enabled.
+func SDKModules() []string {
+	names := make([]string, 0, len(sdkModules))
+	for name := range sdkModules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// EnableSDKModule wires an optional Cosmos SDK module in the app
+func (s Scaffolder) EnableSDKModule(
+	ctx context.Context,
+	cacheStorage cache.Storage,
+	tracer *placeholder.Tracer,
+	name string,
+) (sm xgenny.SourceModification, err error) {
+	minVersion, ok := sdkModules[name]
+	if !ok {
+		return sm, fmt.Errorf("module %s cannot be enabled. Supported modules: %s", name, strings.Join(SDKModules(), ", "))
+	}
+	if s.Version.LT(minVersion) {
+		return sm, fmt.Errorf("module %s requires Cosmos SDK %s or newer, the app uses %s", name, minVersion, s.Version)
+	}
+
+	ok, err = isImported(s.path, sdkModuleImportPrefix+name)
+	if err != nil {
+		return sm, err
+	}
+	if ok {
+		return sm, fmt.Errorf("module %s is already enabled", name)
+	}
+
+	g, err := moduleimport.NewSDKModule(tracer, &moduleimport.SDKModuleOptions{
+		AppPath:    s.path,
+		Module:     name,
+		SDKVersion: s.Version,
+	})
+	if err != nil {
+		return sm, err
+	}
+
+	sm, err = s.run(tracer, g)
+	if err != nil {
+		return sm, err
+	}
+
+	return sm, s.finish(ctx, cacheStorage)
+}
@@ -0,0 +1,57 @@
+package scaffolder
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/gobuffalo/genny"
+
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+)
+
+// Names of the template pack folders with the templates of each scaffolding command.
+const (
+	TemplatePackChain   = "chain"
+	TemplatePackModule  = "module"
+	TemplatePackMessage = "message"
+	TemplatePackQuery   = "query"
+	TemplatePackPacket  = "packet"
+	TemplatePackList    = "list"
+	TemplatePackMap     = "map"
+	TemplatePackSingle  = "single"
+	TemplatePackType    = "type"
+)
+
+// WithTemplatePack overrides or extends the built-in scaffolding templates with
+// the templates of the pack found in dir.
+func WithTemplatePack(dir string) Option {
+	return func(s *Scaffolder) {
+		s.templatePack = dir
+	}
+}
+
+// InitWithTemplatePack overrides or extends the built-in templates of the new app
+// and its default module with the templates of the pack found in dir.
+func InitWithTemplatePack(dir string) InitOption {
+	return func(o *initOptions) {
+		o.templatePack = dir
+	}
+}
+
+// boxTemplatePack adds the templates of a scaffolding command found in the pack to the
+// generator. The pack templates are added after the built-in ones to override them.
+func boxTemplatePack(g *genny.Generator, packDir, name, appPath string) error {
+	if packDir == "" {
+		return nil
+	}
+
+	dir := filepath.Join(packDir, name)
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		// The pack doesn't customize the templates of the command
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	return xgenny.Box(g, xgenny.NewDirWalker(dir, appPath))
+}
@@ -2,6 +2,7 @@ package scaffolder
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -35,10 +36,12 @@ type addTypeOptions struct {
 	isMap       bool
 	isSingleton bool
 
-	indexes []string
+	indexes          []string
+	secondaryIndexes []string
 
 	withoutMessage    bool
 	withoutSimulation bool
+	withoutEvents     bool
 	signer            string
 }
 
@@ -106,6 +109,22 @@ func TypeWithoutSimulation() AddTypeOption {
 	}
 }
 
+// TypeWithoutEvents disables emitting typed events when the values of a list are
+// created, updated and deleted.
+func TypeWithoutEvents() AddTypeOption {
+	return func(o *addTypeOptions) {
+		o.withoutEvents = true
+	}
+}
+
+// TypeWithSecondaryIndexes indexes the values of a list or map type by the given fields
+// and adds a paginated query for each index.
+func TypeWithSecondaryIndexes(fields ...string) AddTypeOption {
+	return func(o *addTypeOptions) {
+		o.secondaryIndexes = fields
+	}
+}
+
 // TypeWithSigner provides a custom signer name for the message
 func TypeWithSigner(signer string) AddTypeOption {
 	return func(o *addTypeOptions) {
@@ -160,6 +179,14 @@ func (s Scaffolder) AddType(
 		return sm, err
 	}
 
+	if len(o.secondaryIndexes) > 0 && !o.isList && !o.isMap {
+		return sm, errors.New("secondary indexes are only supported by list and map types")
+	}
+	secondaryIndexes, err := parseSecondaryIndexes(tFields, o.secondaryIndexes)
+	if err != nil {
+		return sm, err
+	}
+
 	mfSigner, err := multiformatname.NewName(o.signer)
 	if err != nil {
 		return sm, err
@@ -170,6 +197,11 @@ func (s Scaffolder) AddType(
 		return sm, err
 	}
 
+	withMetrics, err := hasModuleMetrics(s.path, moduleName)
+	if err != nil {
+		return sm, err
+	}
+
 	var (
 		g    *genny.Generator
 		opts = &typed.Options{
@@ -181,8 +213,12 @@ func (s Scaffolder) AddType(
 			Fields:       tFields,
 			NoMessage:    o.withoutMessage,
 			NoSimulation: o.withoutSimulation,
+			NoEvents:     o.withoutEvents,
 			MsgSigner:    mfSigner,
 			IsIBC:        isIBC,
+			WithMetrics:  withMetrics,
+
+			SecondaryIndexes: secondaryIndexes,
 		}
 		gens []*genny.Generator
 	)
@@ -224,28 +260,36 @@ func (s Scaffolder) AddType(
 	}
 
 	// create the type generator depending on the model
+	var templatePackName string
 	switch {
 	case o.isList:
 		g, err = list.NewStargate(tracer, opts)
+		templatePackName = TemplatePackList
 	case o.isMap:
 		g, err = mapGenerator(tracer, opts, o.indexes)
+		templatePackName = TemplatePackMap
 	case o.isSingleton:
 		g, err = singleton.NewStargate(tracer, opts)
+		templatePackName = TemplatePackSingle
 	default:
 		g, err = dry.NewStargate(opts)
+		templatePackName = TemplatePackType
 	}
 	if err != nil {
 		return sm, err
 	}
+	if err := boxTemplatePack(g, s.templatePack, templatePackName, s.path); err != nil {
+		return sm, err
+	}
 
 	// run the generation
 	gens = append(gens, g)
-	sm, err = xgenny.RunWithValidation(tracer, gens...)
+	sm, err = s.run(tracer, gens...)
 	if err != nil {
 		return sm, err
 	}
 
-	return sm, finish(ctx, cacheStorage, opts.AppPath, s.modpath.RawPath)
+	return sm, s.finish(ctx, cacheStorage)
 }
 
 // checkForbiddenTypeIndex returns true if the name is forbidden as a field name
@@ -280,6 +324,40 @@ func checkForbiddenTypeField(name string) error {
 	return checkGoReservedWord(name)
 }
 
+// parseSecondaryIndexes returns the fields of the type indexing its values.
+func parseSecondaryIndexes(fields field.Fields, names []string) (field.Fields, error) {
+	var (
+		indexes field.Fields
+		exists  = make(map[string]struct{})
+	)
+	for _, name := range names {
+		mfName, err := multiformatname.NewName(name)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := exists[mfName.LowerCamel]; ok {
+			return nil, fmt.Errorf("%s secondary index is used more than once", name)
+		}
+		exists[mfName.LowerCamel] = struct{}{}
+
+		var found bool
+		for _, f := range fields {
+			if f.Name.LowerCamel != mfName.LowerCamel {
+				continue
+			}
+			if dt, ok := datatype.SupportedTypes[f.DatatypeName]; !ok || dt.NonIndex {
+				return nil, fmt.Errorf("%s field of type %s can't be a secondary index", name, f.DatatypeName)
+			}
+			indexes = append(indexes, f)
+			found = true
+		}
+		if !found {
+			return nil, fmt.Errorf("%s secondary index is not a field of the type", name)
+		}
+	}
+	return indexes, nil
+}
+
 // mapGenerator returns the template generator for a map
 func mapGenerator(replacer placeholder.Replacer, opts *typed.Options, indexes []string) (*genny.Generator, error) {
 	// Parse indexes with the associated type
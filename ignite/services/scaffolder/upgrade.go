@@ -0,0 +1,201 @@
+package scaffolder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/upgrade"
+)
+
+var (
+	// upgradeNameRe matches the names that can be used as upgrade names.
+	upgradeNameRe = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9._-]*$`)
+
+	// upgradePackageRe matches the upgrade name characters removed from its package name.
+	upgradePackageRe = regexp.MustCompile(`[^a-zA-Z0-9]`)
+)
+
+// upgradeOptions represents configuration for the upgrade scaffolding.
+type upgradeOptions struct {
+	addedStores     []string
+	migratedModules []string
+}
+
+// UpgradeOption configures the upgrade scaffolding.
+type UpgradeOption func(*upgradeOptions)
+
+// WithAddedStores adds the stores of the modules added to the app by the upgrade.
+func WithAddedStores(stores ...string) UpgradeOption {
+	return func(o *upgradeOptions) {
+		o.addedStores = append(o.addedStores, stores...)
+	}
+}
+
+// WithMigratedModules bumps the consensus version of the modules of the app and
+// registers the migrations of their state run by the upgrade.
+func WithMigratedModules(modules ...string) UpgradeOption {
+	return func(o *upgradeOptions) {
+		o.migratedModules = append(o.migratedModules, modules...)
+	}
+}
+
+// UpgradePackage returns the name of the Go package of an upgrade.
+func UpgradePackage(name string) string {
+	return strings.ToLower(upgradePackageRe.ReplaceAllString(name, ""))
+}
+
+// AddUpgrade scaffolds a named on-chain upgrade of the app with its upgrade handler,
+// store upgrades, module migrations, upgrade test and config entry.
+func (s Scaffolder) AddUpgrade(
+	ctx context.Context,
+	cacheStorage cache.Storage,
+	tracer *placeholder.Tracer,
+	name string,
+	options ...UpgradeOption,
+) (sm xgenny.SourceModification, err error) {
+	var o upgradeOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	if !upgradeNameRe.MatchString(name) {
+		return sm, fmt.Errorf("%s is not a valid upgrade name, it must start with a letter and contain only letters, digits, '.', '_' or '-'", name)
+	}
+
+	opts := &upgrade.Options{
+		AppPath:        s.path,
+		ModulePath:     s.modpath.RawPath,
+		UpgradeName:    name,
+		UpgradePackage: UpgradePackage(name),
+		AddedStores:    o.addedStores,
+	}
+
+	ok, err := pathExists(filepath.Join(s.path, upgrade.PathUpgrades, opts.UpgradePackage))
+	if err != nil {
+		return sm, err
+	}
+	if ok {
+		return sm, fmt.Errorf("upgrade %s already exists", name)
+	}
+
+	// The config file is optional, the upgrade is only added when the file exists
+	opts.ConfigPath, err = chainconfig.LocateDefault(s.path)
+	if err != nil && !errors.Is(err, chainconfig.ErrConfigNotFound) {
+		return sm, err
+	}
+	if opts.ConfigPath != "" {
+		conf, err := chainconfig.ParseFile(opts.ConfigPath)
+		if err != nil {
+			return sm, err
+		}
+		for _, u := range conf.Upgrades {
+			if u.Name == name {
+				return sm, fmt.Errorf("upgrade %s is already defined in %s", name, opts.ConfigPath)
+			}
+		}
+	}
+
+	var migrationsHaveMigrator []bool
+	for _, moduleName := range o.migratedModules {
+		m, hasMigrator, err := s.moduleMigration(moduleName)
+		if err != nil {
+			return sm, err
+		}
+		opts.Migrations = append(opts.Migrations, m)
+		migrationsHaveMigrator = append(migrationsHaveMigrator, hasMigrator)
+	}
+
+	var gens []*genny.Generator
+
+	// The upgrades registry is created with the first upgrade of the app
+	ok, err = pathExists(filepath.Join(s.path, upgrade.PathUpgradesGo))
+	if err != nil {
+		return sm, err
+	}
+	if !ok {
+		g, err := upgrade.NewBase(tracer, opts)
+		if err != nil {
+			return sm, err
+		}
+		gens = append(gens, g)
+	}
+
+	g, err := upgrade.NewUpgrade(tracer, opts)
+	if err != nil {
+		return sm, err
+	}
+	gens = append(gens, g)
+
+	for i, m := range opts.Migrations {
+		g, err := upgrade.NewMigration(tracer, s.path, m, migrationsHaveMigrator[i])
+		if err != nil {
+			return sm, err
+		}
+		gens = append(gens, g)
+	}
+
+	// The generators are run one by one because they modify the files created by the previous ones
+	sm = xgenny.NewSourceModification()
+	for _, g := range gens {
+		genSm, err := s.run(tracer, g)
+		if err != nil {
+			return sm, err
+		}
+		sm.Merge(genSm)
+	}
+
+	return sm, s.finish(ctx, cacheStorage)
+}
+
+// moduleMigration returns the consensus version bump of a module of the app
+// and true when the module already has a migrator.
+func (s Scaffolder) moduleMigration(moduleName string) (upgrade.Migration, bool, error) {
+	ok, err := moduleExists(s.path, moduleName)
+	if err != nil {
+		return upgrade.Migration{}, false, err
+	}
+	if !ok {
+		return upgrade.Migration{}, false, fmt.Errorf("the module %s doesn't exist", moduleName)
+	}
+
+	path := filepath.Join(s.path, moduleDir, moduleName, "module.go")
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return upgrade.Migration{}, false, err
+	}
+
+	version, ok := upgrade.ConsensusVersion(string(content))
+	if !ok {
+		return upgrade.Migration{}, false, fmt.Errorf("consensus version not found in %s", path)
+	}
+
+	hasMigrator, err := pathExists(filepath.Join(s.path, moduleDir, moduleName, "keeper/migrations.go"))
+	if err != nil {
+		return upgrade.Migration{}, false, err
+	}
+
+	return upgrade.Migration{
+		ModuleName: moduleName,
+		From:       version,
+		To:         version + 1,
+	}, hasMigrator, nil
+}
+
+func pathExists(path string) (bool, error) {
+	_, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
@@ -0,0 +1,66 @@
+package scaffolder
+
+import (
+	"fmt"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosver"
+)
+
+// AppWiring defines how the modules are wired into the app.
+type AppWiring string
+
+const (
+	// AppWiringAuto selects the app wiring from the Cosmos SDK version of the app.
+	AppWiringAuto AppWiring = "auto"
+
+	// AppWiringLegacy wires the modules manually in app.go.
+	AppWiringLegacy AppWiring = "legacy"
+
+	// AppWiringModern wires the modules with dependency injection configured
+	// in app_config.go and registers their commands with AutoCLI.
+	AppWiringModern AppWiring = "modern"
+)
+
+// ParseAppWiring parses the name of an app wiring.
+// An empty name selects the app wiring automatically.
+func ParseAppWiring(name string) (AppWiring, error) {
+	switch w := AppWiring(name); w {
+	case "":
+		return AppWiringAuto, nil
+	case AppWiringAuto, AppWiringLegacy, AppWiringModern:
+		return w, nil
+	default:
+		return "", fmt.Errorf(
+			"invalid app wiring %q, must be one of %q, %q or %q",
+			name, AppWiringAuto, AppWiringLegacy, AppWiringModern,
+		)
+	}
+}
+
+// WithAppWiring overrides the app wiring detected from the Cosmos SDK version of the app.
+func WithAppWiring(w AppWiring) Option {
+	return func(s *Scaffolder) {
+		s.appWiring = w
+	}
+}
+
+// InitWithAppWiring selects the wiring of the new app.
+// The legacy wiring is used by default.
+func InitWithAppWiring(w AppWiring) InitOption {
+	return func(o *initOptions) {
+		o.appWiring = w
+	}
+}
+
+// isModernAppWiring checks if the modules of the app are wired with dependency injection.
+// The modern wiring is selected automatically for apps using Cosmos SDK v0.47 or newer.
+func (s Scaffolder) isModernAppWiring() bool {
+	switch s.appWiring {
+	case AppWiringModern:
+		return true
+	case AppWiringLegacy:
+		return false
+	default:
+		return s.Version.GTE(cosmosver.StargateFortySevenVersion)
+	}
+}
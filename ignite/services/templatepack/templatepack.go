@@ -0,0 +1,245 @@
+// Package templatepack manages the template packs that override or extend
+// the built-in scaffolding templates.
+//
+// A template pack is a directory, local or in a git repository, that contains
+// a folder for each scaffolding command customized by the pack, for example
+// "module" or "message". The files of these folders follow the layout of the
+// files generated in the app, "x/{{moduleName}}/keeper/keeper.go.plush" for
+// example, and are rendered with the same variables as the built-in templates.
+// Files with the same path as a built-in template override it, other files are
+// generated in addition to the built-in ones.
+package templatepack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"gopkg.in/yaml.v2"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/downloader"
+	"github.com/ignite/cli/ignite/pkg/xfilepath"
+)
+
+var (
+	// ErrNotFound is returned when a template pack is not registered.
+	ErrNotFound = errors.New("template pack not found")
+
+	// packsPath holds the directory where the template packs of git repositories are cloned.
+	packsPath = xfilepath.Join(
+		chainconfig.ConfigDirPath,
+		xfilepath.Path("templates"),
+	)
+
+	// registryPath holds the file where the template packs are registered.
+	registryPath = xfilepath.Join(
+		chainconfig.ConfigDirPath,
+		xfilepath.Path("templates.yml"),
+	)
+)
+
+// Pack is a registered template pack.
+type Pack struct {
+	// Name is the name used to select the pack, e.g. "mycompany/standard".
+	Name string `yaml:"name"`
+
+	// Source is the absolute path of a local directory or the URL of a git
+	// repository, the URL can end with "@" followed by a tag or branch name.
+	Source string `yaml:"source"`
+}
+
+// IsLocal returns true when the pack source is a local directory.
+func (p Pack) IsLocal() bool {
+	return filepath.IsAbs(p.Source)
+}
+
+// Dir returns the directory of the pack templates.
+func (p Pack) Dir() (string, error) {
+	if p.IsLocal() {
+		return p.Source, nil
+	}
+
+	dir, err := packsPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, filepath.FromSlash(p.Name)), nil
+}
+
+// List returns the registered template packs sorted by name.
+func List() ([]Pack, error) {
+	path, err := registryPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var packs []Pack
+	if err := yaml.Unmarshal(data, &packs); err != nil {
+		return nil, fmt.Errorf("invalid template packs file %s: %w", path, err)
+	}
+
+	sort.Slice(packs, func(i, j int) bool { return packs[i].Name < packs[j].Name })
+	return packs, nil
+}
+
+// Get returns a registered template pack.
+func Get(name string) (Pack, error) {
+	packs, err := List()
+	if err != nil {
+		return Pack{}, err
+	}
+	for _, p := range packs {
+		if p.Name == name {
+			return p, nil
+		}
+	}
+	return Pack{}, fmt.Errorf("%w: %s", ErrNotFound, name)
+}
+
+// Add registers a template pack from a local directory or a git repository.
+// The git repositories are cloned when the pack is registered.
+func Add(name, source string) (Pack, error) {
+	if err := validateName(name); err != nil {
+		return Pack{}, err
+	}
+	if _, err := Get(name); err == nil {
+		return Pack{}, fmt.Errorf("template pack %s is already registered", name)
+	} else if !errors.Is(err, ErrNotFound) {
+		return Pack{}, err
+	}
+
+	p := Pack{Name: name, Source: source}
+	if info, err := os.Stat(source); err == nil {
+		if !info.IsDir() {
+			return Pack{}, fmt.Errorf("template pack source %s is not a directory", source)
+		}
+		if p.Source, err = filepath.Abs(source); err != nil {
+			return Pack{}, err
+		}
+	} else if err := p.clone(); err != nil {
+		return Pack{}, err
+	}
+
+	packs, err := List()
+	if err != nil {
+		return Pack{}, err
+	}
+	return p, save(append(packs, p))
+}
+
+// Remove unregisters a template pack and removes its clone when it's a git repository.
+func Remove(name string) error {
+	packs, err := List()
+	if err != nil {
+		return err
+	}
+
+	for i, p := range packs {
+		if p.Name != name {
+			continue
+		}
+		if !p.IsLocal() {
+			dir, err := p.Dir()
+			if err != nil {
+				return err
+			}
+			if err := os.RemoveAll(dir); err != nil {
+				return err
+			}
+		}
+		return save(append(packs[:i], packs[i+1:]...))
+	}
+
+	return fmt.Errorf("%w: %s", ErrNotFound, name)
+}
+
+// Resolve returns the directory of a template pack selected by its registered
+// name or by the path of a local directory.
+func Resolve(nameOrPath string) (string, error) {
+	p, err := Get(nameOrPath)
+	if err == nil {
+		return p.Dir()
+	}
+	if !errors.Is(err, ErrNotFound) {
+		return "", err
+	}
+
+	// Unregistered packs can be used with the path of their directory
+	if info, statErr := os.Stat(nameOrPath); statErr == nil && info.IsDir() {
+		return filepath.Abs(nameOrPath)
+	}
+	return "", err
+}
+
+// clone clones the git repository of the pack at the reference of its source.
+func (p Pack) clone() error {
+	url, reference := p.Source, ""
+	if i := strings.LastIndex(url, "@"); i > strings.LastIndex(url, "/") {
+		url, reference = url[:i], url[i+1:]
+	}
+	if !strings.Contains(url, "://") && !strings.HasPrefix(url, "git@") {
+		url = "https://" + url
+	}
+
+	dir, err := p.Dir()
+	if err != nil {
+		return err
+	}
+
+	options := []*git.CloneOptions{{URL: url, Depth: 1}}
+	if reference != "" {
+		// The reference is either a tag or a branch
+		options = []*git.CloneOptions{
+			{URL: url, Depth: 1, ReferenceName: plumbing.NewTagReferenceName(reference)},
+			{URL: url, Depth: 1, ReferenceName: plumbing.NewBranchReferenceName(reference)},
+		}
+	}
+
+	if _, err = downloader.New().Clone(context.Background(), dir, options...); err == nil {
+		return nil
+	}
+	return fmt.Errorf("cannot clone template pack %s from %s: %w", p.Name, p.Source, err)
+}
+
+func validateName(name string) error {
+	if name == "" || strings.HasPrefix(name, "/") || strings.HasSuffix(name, "/") {
+		return fmt.Errorf("invalid template pack name %q", name)
+	}
+	for _, part := range strings.Split(name, "/") {
+		if part == "" || part == "." || part == ".." {
+			return fmt.Errorf("invalid template pack name %q", name)
+		}
+	}
+	return nil
+}
+
+func save(packs []Pack) error {
+	path, err := registryPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(packs)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
@@ -0,0 +1,53 @@
+package templatepack_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/services/templatepack"
+)
+
+func TestLocalPack(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "module"), 0o755))
+
+	packs, err := templatepack.List()
+	require.NoError(t, err)
+	require.Empty(t, packs)
+
+	p, err := templatepack.Add("mycompany/standard", dir)
+	require.NoError(t, err)
+	require.True(t, p.IsLocal())
+
+	_, err = templatepack.Add("mycompany/standard", dir)
+	require.Error(t, err)
+
+	_, err = templatepack.Add("mycompany/../other", dir)
+	require.Error(t, err)
+
+	packs, err = templatepack.List()
+	require.NoError(t, err)
+	require.Equal(t, []templatepack.Pack{p}, packs)
+
+	resolved, err := templatepack.Resolve("mycompany/standard")
+	require.NoError(t, err)
+	require.Equal(t, dir, resolved)
+
+	// Unregistered packs are resolved from their directory
+	resolved, err = templatepack.Resolve(filepath.Join(dir, "module"))
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dir, "module"), resolved)
+
+	require.NoError(t, templatepack.Remove("mycompany/standard"))
+	require.ErrorIs(t, templatepack.Remove("mycompany/standard"), templatepack.ErrNotFound)
+
+	_, err = templatepack.Resolve("mycompany/standard")
+	require.ErrorIs(t, err, templatepack.ErrNotFound)
+
+	// Local packs are not removed from the disk
+	require.DirExists(t, dir)
+}
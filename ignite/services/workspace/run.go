@@ -0,0 +1,165 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/workerpool"
+)
+
+// Task processes a chain of a workspace, the progress of the chain is sent
+// to ev.
+type Task func(ctx context.Context, m Member, ev events.Bus) error
+
+type runOptions struct {
+	workers int
+	ev      events.Bus
+}
+
+// RunOption configures the run of a task.
+type RunOption func(*runOptions)
+
+// Workers sets the maximum number of chains processed concurrently, the
+// number of CPUs is used when n is lower than one.
+func Workers(n int) RunOption {
+	return func(o *runOptions) {
+		o.workers = n
+	}
+}
+
+// CollectEvents collects the progress of the chains in a combined view.
+func CollectEvents(ev events.Bus) RunOption {
+	return func(o *runOptions) {
+		o.ev = ev
+	}
+}
+
+// Run runs the task for each chain of the workspace. The chains of a level are
+// processed concurrently once the chains they depend on are, the run stops on
+// the first failure.
+func (w Workspace) Run(ctx context.Context, task Task, options ...RunOption) error {
+	o := runOptions{workers: workerpool.DefaultWorkers}
+	for _, apply := range options {
+		apply(&o)
+	}
+	if o.workers < 1 {
+		o.workers = workerpool.DefaultWorkers
+	}
+
+	levels, err := w.Levels()
+	if err != nil {
+		return err
+	}
+
+	p := newProgress(o.ev)
+	for _, level := range levels {
+		pool := workerpool.New(ctx, workerpool.Workers(o.workers))
+		for _, m := range level {
+			m := m
+			pool.Go(func(ctx context.Context) error {
+				ev, done := p.track(m.Name)
+				defer done()
+
+				if err := task(ctx, m, ev); err != nil {
+					return fmt.Errorf("chain %s: %w", m.Name, err)
+				}
+				return nil
+			})
+		}
+
+		if err := pool.Wait(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// progress combines the progress of the chains processed concurrently.
+type progress struct {
+	ev events.Bus
+
+	mu       sync.Mutex
+	statuses map[string]string
+}
+
+func newProgress(ev events.Bus) *progress {
+	return &progress{
+		ev:       ev,
+		statuses: make(map[string]string),
+	}
+}
+
+// track returns the bus of the events of a chain and a func to call once the
+// chain is processed.
+func (p *progress) track(name string) (ev events.Bus, done func()) {
+	ev = events.NewBus()
+	evs := ev.Events()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for e := range evs {
+			p.update(name, e)
+		}
+	}()
+
+	return ev, func() {
+		ev.Stop()
+		wg.Wait()
+
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		delete(p.statuses, name)
+		p.send()
+	}
+}
+
+func (p *progress) update(name string, e events.Event) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// The progress of the chains is combined in a single event, the other
+	// events are sent prefixed by the name of their chain
+	if e.InProgress() {
+		p.statuses[name] = e.Message
+		p.send()
+		return
+	}
+
+	options := []events.Option{events.Icon(e.Icon)}
+	if e.Verbose {
+		options = append(options, events.Verbose())
+	}
+	if e.ProgressIndication == events.IndicationFinish {
+		options = append(options, events.ProgressFinish())
+	}
+
+	p.ev.Send(fmt.Sprintf("%s: %s", name, e.Message), options...)
+}
+
+// send sends the combined progress of the chains.
+func (p *progress) send() {
+	if len(p.statuses) == 0 {
+		return
+	}
+
+	names := make([]string, 0, len(p.statuses))
+	for name := range p.statuses {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	statuses := make([]string, len(names))
+	for i, name := range names {
+		statuses[i] = fmt.Sprintf("%s: %s", name, p.statuses[name])
+	}
+
+	p.ev.Send(strings.Join(statuses, " | "), events.ProgressUpdate())
+}
@@ -0,0 +1,259 @@
+// Package workspace builds and generates the code of the chains of a workspace,
+// a directory with several chains like a monorepo, concurrently.
+//
+// The chains of a workspace are the modules of its Go workspace file when it
+// has one, or its sub directories with a chain config file otherwise. A chain
+// depends on another chain of the workspace when it requires its Go module or
+// when it imports its proto files with the third party proto paths of its
+// config, the chains are then processed after the chains they depend on.
+package workspace
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/mod/modfile"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/gomodule"
+)
+
+// WorkFile is the name of the Go workspace file.
+const WorkFile = "go.work"
+
+// ErrNoChain is returned when a workspace has no chain.
+var ErrNoChain = errors.New("the workspace has no chain")
+
+// Member is a chain of a workspace.
+type Member struct {
+	// Name is the path of the chain relative to the workspace, with slashes.
+	Name string
+
+	// Path is the absolute path of the chain.
+	Path string
+
+	// ModulePath is the path of the Go module of the chain.
+	ModulePath string
+
+	// ConfigPath is the path of the config file of the chain.
+	ConfigPath string
+
+	// Deps are the names of the chains of the workspace the chain depends on.
+	Deps []string
+}
+
+// Workspace is a directory with several chains.
+type Workspace struct {
+	path    string
+	members []Member
+}
+
+// Discover discovers the chains of the workspace in path and their dependencies.
+func Discover(path string) (Workspace, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return Workspace{}, err
+	}
+
+	dirs, err := memberDirs(path)
+	if err != nil {
+		return Workspace{}, err
+	}
+
+	var members []Member
+	for _, dir := range dirs {
+		configPath, err := chainconfig.LocateDefault(dir)
+		if errors.Is(err, chainconfig.ErrConfigNotFound) {
+			continue
+		}
+		if err != nil {
+			return Workspace{}, err
+		}
+
+		name, err := filepath.Rel(path, dir)
+		if err != nil {
+			return Workspace{}, err
+		}
+
+		members = append(members, Member{
+			Name:       filepath.ToSlash(name),
+			Path:       dir,
+			ConfigPath: configPath,
+		})
+	}
+	if len(members) == 0 {
+		return Workspace{}, ErrNoChain
+	}
+
+	sort.Slice(members, func(i, j int) bool { return members[i].Name < members[j].Name })
+
+	if err := resolveDeps(members); err != nil {
+		return Workspace{}, err
+	}
+
+	return Workspace{
+		path:    path,
+		members: members,
+	}, nil
+}
+
+// Path returns the path of the workspace.
+func (w Workspace) Path() string {
+	return w.path
+}
+
+// Members returns the chains of the workspace sorted by name.
+func (w Workspace) Members() []Member {
+	return w.members
+}
+
+// Levels returns the chains of the workspace grouped by level: the chains of
+// a level only depend on the chains of the previous levels, so the chains of
+// a level can be processed concurrently once the previous levels are.
+func (w Workspace) Levels() ([][]Member, error) {
+	done := make(map[string]bool)
+
+	var levels [][]Member
+	for len(done) < len(w.members) {
+		var level []Member
+		for _, m := range w.members {
+			if done[m.Name] {
+				continue
+			}
+
+			ready := true
+			for _, dep := range m.Deps {
+				if !done[dep] {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				level = append(level, m)
+			}
+		}
+
+		if len(level) == 0 {
+			var cycle []string
+			for _, m := range w.members {
+				if !done[m.Name] {
+					cycle = append(cycle, m.Name)
+				}
+			}
+			return nil, fmt.Errorf("the chains %s depend on each other", strings.Join(cycle, ", "))
+		}
+
+		for _, m := range level {
+			done[m.Name] = true
+		}
+		levels = append(levels, level)
+	}
+
+	return levels, nil
+}
+
+// memberDirs returns the dirs of the modules of the Go workspace file in path,
+// or the sub directories of path when it has no Go workspace file.
+func memberDirs(path string) ([]string, error) {
+	content, err := os.ReadFile(filepath.Join(path, WorkFile))
+	if errors.Is(err, os.ErrNotExist) {
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+
+		var dirs []string
+		for _, e := range entries {
+			if e.IsDir() && !strings.HasPrefix(e.Name(), ".") {
+				dirs = append(dirs, filepath.Join(path, e.Name()))
+			}
+		}
+		return dirs, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	work, err := modfile.ParseWork(WorkFile, content, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	dirs := make([]string, len(work.Use))
+	for i, use := range work.Use {
+		dir := filepath.FromSlash(use.Path)
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(path, dir)
+		}
+		dirs[i] = dir
+	}
+
+	return dirs, nil
+}
+
+// resolveDeps sets the module paths of the members and their dependencies.
+func resolveDeps(members []Member) error {
+	byModule := make(map[string]string)
+	for i, m := range members {
+		modFile, err := gomodule.ParseAt(m.Path)
+		if err != nil {
+			return fmt.Errorf("chain %s: %w", m.Name, err)
+		}
+
+		members[i].ModulePath = modFile.Module.Mod.Path
+		byModule[members[i].ModulePath] = m.Name
+	}
+
+	for i, m := range members {
+		deps := make(map[string]bool)
+
+		modFile, err := gomodule.ParseAt(m.Path)
+		if err != nil {
+			return fmt.Errorf("chain %s: %w", m.Name, err)
+		}
+		for _, r := range modFile.Require {
+			if name, ok := byModule[r.Mod.Path]; ok {
+				deps[name] = true
+			}
+		}
+
+		conf, err := chainconfig.ParseFile(m.ConfigPath)
+		if err != nil {
+			return fmt.Errorf("chain %s: %w", m.Name, err)
+		}
+		for _, p := range conf.Build.Proto.ThirdPartyPaths {
+			if !filepath.IsAbs(p) {
+				p = filepath.Join(m.Path, p)
+			}
+			if name, ok := memberOf(members, p); ok {
+				deps[name] = true
+			}
+		}
+
+		// A chain can't depend on itself
+		delete(deps, m.Name)
+
+		for name := range deps {
+			members[i].Deps = append(members[i].Deps, name)
+		}
+		sort.Strings(members[i].Deps)
+	}
+
+	return nil
+}
+
+// memberOf returns the name of the member that contains path.
+func memberOf(members []Member, path string) (string, bool) {
+	path = filepath.Clean(path)
+	for _, m := range members {
+		if path == m.Path || strings.HasPrefix(path, m.Path+string(filepath.Separator)) {
+			return m.Name, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,148 @@
+package workspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+)
+
+const testConfig = `version: 1
+accounts:
+  - name: alice
+    coins: ["100stake"]
+validators:
+  - name: alice
+    bonded: 100stake
+`
+
+// writeChain writes a chain in dir with the module path and the requires of
+// its go.mod, and the extra lines of its config.
+func writeChain(t *testing.T, dir, modulePath string, requires []string, config string) {
+	t.Helper()
+
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+
+	goMod := fmt.Sprintf("module %s\n\ngo 1.19\n", modulePath)
+	for _, r := range requires {
+		goMod += fmt.Sprintf("\nrequire %s v0.0.0\n", r)
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte(goMod), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.yml"), []byte(testConfig+config), 0o644))
+}
+
+func names(members []Member) (names []string) {
+	for _, m := range members {
+		names = append(names, m.Name)
+	}
+	return names
+}
+
+func TestDiscover(t *testing.T) {
+	path := t.TempDir()
+	writeChain(t, filepath.Join(path, "mars"), "github.com/ignite/mars", []string{"github.com/ignite/venus"}, "")
+	writeChain(t, filepath.Join(path, "venus"), "github.com/ignite/venus", nil, "")
+	writeChain(t, filepath.Join(path, "earth"), "github.com/ignite/earth", nil, `build:
+  proto:
+    third_party_paths: ["../mars/proto"]
+`)
+	require.NoError(t, os.MkdirAll(filepath.Join(path, "docs"), 0o755))
+
+	w, err := Discover(path)
+	require.NoError(t, err)
+	require.Equal(t, []string{"earth", "mars", "venus"}, names(w.Members()))
+
+	members := w.Members()
+	require.Equal(t, "github.com/ignite/earth", members[0].ModulePath)
+	require.Equal(t, []string{"mars"}, members[0].Deps)
+	require.Equal(t, []string{"venus"}, members[1].Deps)
+	require.Empty(t, members[2].Deps)
+
+	levels, err := w.Levels()
+	require.NoError(t, err)
+	require.Len(t, levels, 3)
+	require.Equal(t, []string{"venus"}, names(levels[0]))
+	require.Equal(t, []string{"mars"}, names(levels[1]))
+	require.Equal(t, []string{"earth"}, names(levels[2]))
+}
+
+func TestDiscoverGoWork(t *testing.T) {
+	path := t.TempDir()
+	writeChain(t, filepath.Join(path, "chains", "mars"), "github.com/ignite/mars", nil, "")
+	writeChain(t, filepath.Join(path, "venus"), "github.com/ignite/venus", nil, "")
+
+	work := "go 1.19\n\nuse ./chains/mars\n"
+	require.NoError(t, os.WriteFile(filepath.Join(path, WorkFile), []byte(work), 0o644))
+
+	w, err := Discover(path)
+	require.NoError(t, err)
+	require.Equal(t, []string{"chains/mars"}, names(w.Members()))
+}
+
+func TestDiscoverNoChain(t *testing.T) {
+	_, err := Discover(t.TempDir())
+	require.ErrorIs(t, err, ErrNoChain)
+}
+
+func TestLevelsCycle(t *testing.T) {
+	path := t.TempDir()
+	writeChain(t, filepath.Join(path, "mars"), "github.com/ignite/mars", []string{"github.com/ignite/venus"}, "")
+	writeChain(t, filepath.Join(path, "venus"), "github.com/ignite/venus", []string{"github.com/ignite/mars"}, "")
+
+	w, err := Discover(path)
+	require.NoError(t, err)
+
+	_, err = w.Levels()
+	require.EqualError(t, err, "the chains mars, venus depend on each other")
+}
+
+func TestRun(t *testing.T) {
+	path := t.TempDir()
+	writeChain(t, filepath.Join(path, "mars"), "github.com/ignite/mars", []string{"github.com/ignite/venus"}, "")
+	writeChain(t, filepath.Join(path, "venus"), "github.com/ignite/venus", nil, "")
+	writeChain(t, filepath.Join(path, "earth"), "github.com/ignite/earth", nil, "")
+
+	w, err := Discover(path)
+	require.NoError(t, err)
+
+	var (
+		mu    sync.Mutex
+		order []string
+		bus   = events.NewBus(events.WithBufferSize(100))
+	)
+	err = w.Run(context.Background(), func(_ context.Context, m Member, ev events.Bus) error {
+		ev.Send("Building...", events.ProgressUpdate())
+
+		mu.Lock()
+		defer mu.Unlock()
+		order = append(order, m.Name)
+
+		return nil
+	}, CollectEvents(bus))
+	require.NoError(t, err)
+	bus.Stop()
+
+	require.Len(t, order, 3)
+	require.Equal(t, "mars", order[2], "mars is built after venus")
+
+	var messages []string
+	for e := range bus.Events() {
+		messages = append(messages, e.Message)
+	}
+	require.Contains(t, messages, "mars: Building...")
+
+	err = w.Run(context.Background(), func(_ context.Context, m Member, _ events.Bus) error {
+		if m.Name == "venus" {
+			return errors.New("failed")
+		}
+		return nil
+	})
+	require.EqualError(t, err, "chain venus: failed")
+}
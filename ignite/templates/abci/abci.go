@@ -0,0 +1,117 @@
+// Package abci scaffolds an app-side mempool and the ABCI++ proposal handlers of an app.
+package abci
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush/v4"
+
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/field/plushhelpers"
+	"github.com/ignite/cli/ignite/templates/module"
+)
+
+const (
+	// MempoolNoOp is the mempool type of the apps that don't have an app-side mempool.
+	// The txs of the proposals are the txs of the mempool of CometBFT in FIFO order.
+	MempoolNoOp = "nop"
+
+	// MempoolSenderNonce is the mempool type that orders the txs by sender and nonce
+	// and selects the senders randomly.
+	MempoolSenderNonce = "sender-nonce"
+
+	// MempoolPriority is the mempool type that orders the txs by priority, the
+	// priority of a tx is set by the ante handler from its fees by default.
+	MempoolPriority = "priority"
+
+	// PathProposalHandlersGo is the path of the file that defines the proposal handlers.
+	PathProposalHandlersGo = "app/proposal_handlers.go"
+)
+
+//go:embed files/* files/**/*
+var fsABCI embed.FS
+
+var (
+	// MempoolTypes are the types of app-side mempools that can be scaffolded.
+	MempoolTypes = []string{MempoolNoOp, MempoolSenderNonce, MempoolPriority}
+
+	// appBuild are the lines that create the base app in app.go, with the modern
+	// and the legacy app wiring.
+	appBuild = []string{
+		"app.App = appBuilder.Build(",
+		"bApp := baseapp.NewBaseApp(",
+	}
+)
+
+// Options are the options to scaffold the app-side mempool and the ABCI++
+// proposal handlers of an app.
+type Options struct {
+	AppPath     string
+	MempoolType string
+}
+
+// NewGenerator returns the generator to scaffold an app-side mempool configured
+// with the app options and the skeletons of the PrepareProposal and ProcessProposal
+// handlers of ABCI++, and to set them in the base app.
+func NewGenerator(opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+	g.RunFn(appModify(opts))
+
+	ctx := plush.NewContext()
+	ctx.Set("MempoolType", opts.MempoolType)
+	ctx.Set("MempoolNoOp", MempoolNoOp)
+	ctx.Set("MempoolSenderNonce", MempoolSenderNonce)
+	ctx.Set("MempoolPriority", MempoolPriority)
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(xgenny.Transformer(ctx))
+
+	return g, xgenny.Box(g, xgenny.NewEmbedWalker(fsABCI, "files/", opts.AppPath))
+}
+
+// appModify adds the options that set the mempool and the proposal handlers
+// to the options of the base app, before the base app is created.
+func appModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, module.PathAppGo)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content, err := addBaseAppOptions(f.String())
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// addBaseAppOptions adds the options that set the mempool and the proposal
+// handlers before the creation of the base app in the app.go content.
+func addBaseAppOptions(content string) (string, error) {
+	for _, line := range appBuild {
+		i := strings.Index(content, line)
+		if i < 0 {
+			continue
+		}
+
+		// Insert the options with the indentation of the line
+		start := strings.LastIndex(content[:i], "\n") + 1
+		indent := content[start:i]
+		template := `%[1]v// Set the app-side mempool and the ABCI++ proposal handlers
+%[1]vbaseAppOptions = append(baseAppOptions, proposalHandlersOptions(appOpts)...)
+
+`
+		return content[:start] + fmt.Sprintf(template, indent) + content[start:], nil
+	}
+
+	return "", errors.New("the creation of the base app is not found")
+}
@@ -0,0 +1,44 @@
+package abci
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddBaseAppOptions(t *testing.T) {
+	cases := []struct {
+		name    string
+		content string
+		want    string
+		err     bool
+	}{
+		{
+			name:    "modern app wiring",
+			content: "func New() {\n\tapp.App = appBuilder.Build(logger, db, traceStore, baseAppOptions...)\n}\n",
+			want:    "func New() {\n\t// Set the app-side mempool and the ABCI++ proposal handlers\n\tbaseAppOptions = append(baseAppOptions, proposalHandlersOptions(appOpts)...)\n\n\tapp.App = appBuilder.Build(logger, db, traceStore, baseAppOptions...)\n}\n",
+		},
+		{
+			name:    "legacy app wiring",
+			content: "func New() {\n\tbApp := baseapp.NewBaseApp(\n\t\tName,\n\t\tbaseAppOptions...,\n\t)\n}\n",
+			want:    "func New() {\n\t// Set the app-side mempool and the ABCI++ proposal handlers\n\tbaseAppOptions = append(baseAppOptions, proposalHandlersOptions(appOpts)...)\n\n\tbApp := baseapp.NewBaseApp(\n\t\tName,\n\t\tbaseAppOptions...,\n\t)\n}\n",
+		},
+		{
+			name:    "without base app",
+			content: "func New() {}\n",
+			err:     true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := addBaseAppOptions(tt.content)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -2,28 +2,63 @@ package app
 
 import (
 	"embed"
+	"io/fs"
+	"path/filepath"
+	"strings"
 
 	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/packd"
 	"github.com/gobuffalo/plush/v4"
 
 	"github.com/ignite/cli/ignite/pkg/cosmosgen"
 	"github.com/ignite/cli/ignite/pkg/xgenny"
 	"github.com/ignite/cli/ignite/templates/field/plushhelpers"
+	"github.com/ignite/cli/ignite/templates/module"
 	"github.com/ignite/cli/ignite/templates/testutil"
 )
 
-//go:embed stargate/* stargate/**/*
-var fsStargate embed.FS
+var (
+	//go:embed stargate/* stargate/**/*
+	fsStargate embed.FS
+
+	//go:embed modern/* modern/**/*
+	fsModern embed.FS
+)
+
+// legacyOnlyFiles are the files of the app template that are not generated
+// for apps with the modern app wiring.
+var legacyOnlyFiles = []string{
+	"app/simulation_test.go.plush",
+}
 
 // New returns the generator to scaffold a new Cosmos SDK app
 func New(opts *Options) (*genny.Generator, error) {
 	var (
-		g        = genny.New()
-		template = xgenny.NewEmbedWalker(fsStargate, "stargate/", opts.AppPath)
+		g                     = genny.New()
+		template packd.Walker = xgenny.NewEmbedWalker(fsStargate, "stargate/", opts.AppPath)
 	)
+	if opts.ModernAppWiring {
+		// The modern app wiring replaces some of the files of the app template
+		skip, err := modernSkipFiles()
+		if err != nil {
+			return g, err
+		}
+		template = skipWalker{
+			Walker: template,
+			skip: func(path string) bool {
+				rel, err := filepath.Rel(opts.AppPath, path)
+				return err == nil && skip[filepath.ToSlash(rel)]
+			},
+		}
+	}
 	if err := g.Box(template); err != nil {
 		return g, err
 	}
+	if opts.ModernAppWiring {
+		if err := g.Box(xgenny.NewEmbedWalker(fsModern, "modern/", opts.AppPath)); err != nil {
+			return g, err
+		}
+	}
 	ctx := plush.NewContext()
 	ctx.Set("ModulePath", opts.ModulePath)
 	ctx.Set("AppName", opts.AppName)
@@ -36,11 +71,53 @@ func New(opts *Options) (*genny.Generator, error) {
 	g.Transformer(xgenny.Transformer(ctx))
 	g.Transformer(genny.Replace("{{appName}}", opts.AppName))
 	g.Transformer(genny.Replace("{{binaryNamePrefix}}", opts.BinaryNamePrefix))
+	if opts.ModernAppWiring {
+		g.Transformer(module.ModernImportsTransformer())
+	}
 
 	// Create the 'testutil' package with the test helpers
-	if err := testutil.Register(g, opts.AppPath); err != nil {
+	register := testutil.Register
+	if opts.ModernAppWiring {
+		register = testutil.RegisterModern
+	}
+	if err := register(g, opts.AppPath); err != nil {
 		return g, err
 	}
 
 	return g, nil
 }
+
+// modernSkipFiles returns the files of the app template that must not be generated
+// for apps with the modern app wiring because they are replaced or unused.
+func modernSkipFiles() (map[string]bool, error) {
+	skip := make(map[string]bool)
+	for _, name := range legacyOnlyFiles {
+		skip[name] = true
+	}
+
+	err := fs.WalkDir(fsModern, "modern", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		skip[strings.TrimPrefix(path, "modern/")] = true
+		return nil
+	})
+
+	return skip, err
+}
+
+// skipWalker is a walker that ignores the files that must be skipped.
+type skipWalker struct {
+	packd.Walker
+	skip func(path string) bool
+}
+
+// Walk implements packd.Walker.
+func (w skipWalker) Walk(wl packd.WalkFunc) error {
+	return w.Walker.Walk(func(path string, f packd.File) error {
+		if w.skip(path) {
+			return nil
+		}
+		return wl(path, f)
+	})
+}
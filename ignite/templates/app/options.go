@@ -8,6 +8,10 @@ type Options struct {
 	BinaryNamePrefix string
 	ModulePath       string
 	AddressPrefix    string
+
+	// ModernAppWiring scaffolds an app that wires its modules with dependency
+	// injection and registers their commands with AutoCLI.
+	ModernAppWiring bool
 }
 
 // Validate that options are usuable
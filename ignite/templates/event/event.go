@@ -0,0 +1,126 @@
+// Package event scaffolds the typed events emitted by the message servers of the modules.
+package event
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+
+	"github.com/ignite/cli/ignite/pkg/gomodulepath"
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/templates/field"
+	"github.com/ignite/cli/ignite/templates/module"
+)
+
+const (
+	PlaceholderProtoEventsImport  = "// this line is used by starport scaffolding # proto/events/import"
+	PlaceholderProtoEventsMessage = "// this line is used by starport scaffolding # proto/events/message"
+)
+
+// Event is a typed event emitted by a message server.
+type Event struct {
+	// Name of the event, its proto message is named "Event<Name>".
+	Name multiformatname.Name
+
+	// Description says when the event is emitted, e.g. "a post is created".
+	Description string
+
+	// Fields are the attributes of the event following the signer.
+	Fields field.Fields
+}
+
+// Options ...
+type Options struct {
+	AppName    string
+	AppPath    string
+	ModuleName string
+	ModulePath string
+	MsgSigner  multiformatname.Name
+	Events     []Event
+}
+
+// ProtoPath returns the path of the proto file with the events of a module.
+func ProtoPath(appPath, appName, moduleName string) string {
+	return filepath.Join(appPath, "proto", appName, moduleName, "events.proto")
+}
+
+// ProtoModify adds the events to the events proto file of the module, the file
+// is created when the module doesn't have events yet.
+func ProtoModify(replacer placeholder.Replacer, opts Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		var (
+			path          = ProtoPath(opts.AppPath, opts.AppName, opts.ModuleName)
+			appModulePath = gomodulepath.ExtractAppPath(opts.ModulePath)
+			protoPkgName  = module.ProtoPackageName(appModulePath, opts.ModuleName)
+		)
+
+		var content string
+		if f, err := r.Disk.Find(path); err == nil {
+			content = f.String()
+		} else {
+			content = newProtoFile(protoPkgName, opts.ModulePath, opts.ModuleName)
+		}
+
+		var protoImports []string
+		for _, event := range opts.Events {
+			var fields string
+			for i, field := range event.Fields {
+				fields += fmt.Sprintf("  %s;\n", field.ProtoType(i+2))
+			}
+
+			template := `// Event%[2]v is emitted when %[3]v.
+// The transactions emitting it can be queried by attribute, for example:
+// query txs --events '%[4]v.Event%[2]v.%[5]v="<address>"'
+message Event%[2]v {
+  string %[5]v = 1;
+%[6]v}
+
+%[1]v`
+			replacement := fmt.Sprintf(template,
+				PlaceholderProtoEventsMessage,
+				event.Name.UpperCamel,
+				event.Description,
+				protoPkgName,
+				opts.MsgSigner.LowerCamel,
+				fields,
+			)
+			content = replacer.Replace(content, PlaceholderProtoEventsMessage, replacement)
+
+			protoImports = append(protoImports, event.Fields.ProtoImports()...)
+			for _, f := range event.Fields.Custom() {
+				protoImports = append(protoImports,
+					fmt.Sprintf("%[1]v/%[2]v/%[3]v.proto", opts.AppName, opts.ModuleName, f),
+				)
+			}
+		}
+
+		// Ensure the proto files of the fields are imported
+		for _, f := range protoImports {
+			importModule := fmt.Sprintf(`
+import "%[1]v";`, f)
+			content = strings.ReplaceAll(content, importModule, "")
+
+			replacementImport := fmt.Sprintf("%[1]v%[2]v", PlaceholderProtoEventsImport, importModule)
+			content = replacer.Replace(content, PlaceholderProtoEventsImport, replacementImport)
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// newProtoFile returns the content of the events proto file of a module without events.
+func newProtoFile(protoPkgName, modulePath, moduleName string) string {
+	return fmt.Sprintf(`syntax = "proto3";
+package %[1]v;
+
+%[4]v
+
+option go_package = "%[2]v/x/%[3]v/types";
+
+%[5]v
+`, protoPkgName, modulePath, moduleName, PlaceholderProtoEventsImport, PlaceholderProtoEventsMessage)
+}
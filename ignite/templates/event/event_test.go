@@ -0,0 +1,76 @@
+package event
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/gobuffalo/genny"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/templates/field"
+)
+
+func TestProtoModify(t *testing.T) {
+	appPath := t.TempDir()
+	signer, err := multiformatname.NewName("creator")
+	require.NoError(t, err)
+
+	addEvent := func(name string, fields ...string) {
+		eventName, err := multiformatname.NewName(name)
+		require.NoError(t, err)
+		eventFields, err := field.ParseFields(fields, func(string) error { return nil })
+		require.NoError(t, err)
+
+		g := genny.New()
+		g.RunFn(ProtoModify(placeholder.New(), Options{
+			AppName:    "mars",
+			AppPath:    appPath,
+			ModuleName: "mars",
+			ModulePath: "github.com/test/mars",
+			MsgSigner:  signer,
+			Events: []Event{
+				{Name: eventName, Description: "it happens", Fields: eventFields},
+			},
+		}))
+
+		r := genny.WetRunner(context.Background())
+		require.NoError(t, r.With(g))
+		require.NoError(t, r.Run())
+	}
+
+	addEvent("land", "rover", "amount:coin")
+	addEvent("take-off")
+
+	content, err := os.ReadFile(ProtoPath(appPath, "mars", "mars"))
+	require.NoError(t, err)
+	require.Equal(t, `syntax = "proto3";
+package test.mars.mars;
+
+// this line is used by starport scaffolding # proto/events/import
+import "cosmos/base/v1beta1/coin.proto";
+import "gogoproto/gogo.proto";
+
+option go_package = "github.com/test/mars/x/mars/types";
+
+// EventLand is emitted when it happens.
+// The transactions emitting it can be queried by attribute, for example:
+// query txs --events 'test.mars.mars.EventLand.creator="<address>"'
+message EventLand {
+  string creator = 1;
+  string rover = 2;
+  cosmos.base.v1beta1.Coin amount = 3 [(gogoproto.nullable) = false];
+}
+
+// EventTakeOff is emitted when it happens.
+// The transactions emitting it can be queried by attribute, for example:
+// query txs --events 'test.mars.mars.EventTakeOff.creator="<address>"'
+message EventTakeOff {
+  string creator = 1;
+}
+
+// this line is used by starport scaffolding # proto/events/message
+`, string(content))
+}
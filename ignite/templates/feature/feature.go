@@ -0,0 +1,261 @@
+package feature
+
+import (
+	"embed"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush/v4"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/field/plushhelpers"
+)
+
+var (
+	//go:embed files/* files/**/*
+	fsFeature embed.FS
+
+	// protoParamsMessage matches the params message of a proto file.
+	protoParamsMessage = regexp.MustCompile(`(?s)message Params \{.*?\n\}`)
+
+	// protoFieldNumber matches the numbers of the fields of a proto message.
+	protoFieldNumber = regexp.MustCompile(`=\s*(\d+)\s*[;\[]`)
+
+	// paramSetPairsFunc matches the function that returns the param set pairs of the params.
+	paramSetPairsFunc = regexp.MustCompile(`(?s)func \(p \*Params\) ParamSetPairs\(\) paramtypes\.ParamSetPairs \{.*?\n\}`)
+
+	// validateParamsFunc matches the function that validates the params.
+	validateParamsFunc = regexp.MustCompile(`(?s)func \(p Params\) Validate\(\) error \{.*?\n\}`)
+
+	// getParamsFunc matches the keeper function that returns the params.
+	getParamsFunc = regexp.MustCompile(`(?s)func \(k Keeper\) GetParams\(ctx sdk\.Context\) types\.Params \{.*?\n\}`)
+)
+
+// Options are the options to scaffold a feature flag.
+type Options struct {
+	AppName     string
+	AppPath     string
+	ModuleName  string
+	ModulePath  string
+	FeatureName multiformatname.Name
+
+	// Messages are the messages of the module gated by the feature.
+	Messages []Message
+}
+
+// Message is a message of the module gated by a feature.
+type Message struct {
+	// Name is the name of the message handler.
+	Name string
+
+	// Path is the path of the file that contains the message handler.
+	Path string
+}
+
+// ParamName returns the name of the param that enables a feature.
+func ParamName(featureName multiformatname.Name) string {
+	return featureName.UpperCamel + "Enabled"
+}
+
+// NewGenerator returns the generator to scaffold a feature flag of a module
+// backed by a boolean param.
+func NewGenerator(opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+	g.RunFn(protoParamsModify(opts))
+	g.RunFn(typesParamsModify(opts))
+	g.RunFn(keeperParamsModify(opts))
+	for _, m := range opts.Messages {
+		g.RunFn(msgServerModify(opts, m))
+	}
+
+	ctx := plush.NewContext()
+	ctx.Set("moduleName", opts.ModuleName)
+	ctx.Set("modulePath", opts.ModulePath)
+	ctx.Set("featureName", opts.FeatureName)
+	ctx.Set("paramName", ParamName(opts.FeatureName))
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(xgenny.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+	g.Transformer(genny.Replace("{{featureName}}", opts.FeatureName.Snake))
+
+	return g, xgenny.Box(g, xgenny.NewEmbedWalker(fsFeature, "files/", opts.AppPath))
+}
+
+// protoParamsModify adds the param of the feature to the params proto message.
+func protoParamsModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.AppName, opts.ModuleName, "params.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content, err := addProtoParam(f.String(), opts.FeatureName.Snake+"_enabled")
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// typesParamsModify registers and validates the param of the feature with the module params.
+func typesParamsModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/params.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content, err := addParamSetPair(f.String(), ParamName(opts.FeatureName))
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// keeperParamsModify returns the param of the feature with the params of the module.
+func keeperParamsModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "keeper/params.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content, err := addGetParam(f.String(), ParamName(opts.FeatureName))
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// msgServerModify rejects the message when the feature is disabled.
+func msgServerModify(opts *Options, m Message) genny.RunFn {
+	return func(r *genny.Runner) error {
+		f, err := r.Disk.Find(m.Path)
+		if err != nil {
+			return err
+		}
+
+		content, err := gateMessage(f.String(), m.Name, ParamName(opts.FeatureName))
+		if err != nil {
+			return fmt.Errorf("%s: %w", m.Path, err)
+		}
+
+		newFile := genny.NewFileS(m.Path, content)
+		return r.File(newFile)
+	}
+}
+
+// addProtoParam adds a bool field to the params message of a proto file content,
+// the field number follows the highest number of the message fields.
+func addProtoParam(content, name string) (string, error) {
+	loc := protoParamsMessage.FindStringIndex(content)
+	if loc == nil {
+		return "", fmt.Errorf("message Params not found")
+	}
+	message := content[loc[0]:loc[1]]
+	if regexp.MustCompile(`\b` + regexp.QuoteMeta(name) + `\s*=`).MatchString(message) {
+		return "", fmt.Errorf("param %s already exists", name)
+	}
+
+	var number int
+	for _, m := range protoFieldNumber.FindAllStringSubmatch(message, -1) {
+		var n int
+		if _, err := fmt.Sscan(m[1], &n); err == nil && n > number {
+			number = n
+		}
+	}
+
+	field := fmt.Sprintf("  bool %[1]v = %[2]d [(gogoproto.moretags) = \"yaml:\\\"%[1]v\\\"\"];\n", name, number+1)
+	end := loc[1] - 1
+	return strings.TrimRight(content[:end], " \t\n") + "\n" + field + content[end:], nil
+}
+
+// addParamSetPair registers a param in the param set pairs and validates it with
+// the params of a types/params.go file content.
+func addParamSetPair(content, name string) (string, error) {
+	loc := paramSetPairsFunc.FindStringIndex(content)
+	if loc == nil {
+		return "", fmt.Errorf("ParamSetPairs function not found")
+	}
+	fn := content[loc[0]:loc[1]]
+
+	// Add the pair before the closing brace of the returned composite literal
+	closing := strings.LastIndex(fn[:len(fn)-1], "}")
+	if closing == -1 {
+		return "", fmt.Errorf("ParamSetPairs function doesn't return a composite literal")
+	}
+	pair := fmt.Sprintf("\n\t\tparamtypes.NewParamSetPair(Key%[1]v, &p.%[1]v, validate%[1]v),\n\t", name)
+	fn = strings.TrimRight(fn[:closing], " \t\n") + pair + fn[closing:]
+	content = content[:loc[0]] + fn + content[loc[1]:]
+
+	loc = validateParamsFunc.FindStringIndex(content)
+	if loc == nil {
+		return "", fmt.Errorf("Validate function not found")
+	}
+	fn = content[loc[0]:loc[1]]
+
+	ret := strings.LastIndex(fn, "return nil")
+	if ret == -1 {
+		return "", fmt.Errorf("Validate function doesn't return nil")
+	}
+	validation := fmt.Sprintf("if err := validate%[1]v(p.%[1]v); err != nil {\n\t\treturn err\n\t}\n\n\t", name)
+	fn = fn[:ret] + validation + fn[ret:]
+
+	return content[:loc[0]] + fn + content[loc[1]:], nil
+}
+
+// addGetParam sets a param in the params returned by the GetParams function of
+// a keeper/params.go file content.
+func addGetParam(content, name string) (string, error) {
+	loc := getParamsFunc.FindStringIndex(content)
+	if loc == nil {
+		return "", fmt.Errorf("GetParams function not found")
+	}
+	fn := content[loc[0]:loc[1]]
+
+	set := fmt.Sprintf("params.%[1]v = k.%[1]v(ctx)\n\t", name)
+	switch {
+	case strings.Contains(fn, "return types.NewParams("):
+		// The params are returned by the first feature
+		fn = strings.Replace(fn, "return types.NewParams(", "params := types.NewParams(", 1)
+		fn = fn[:len(fn)-1] + "\t" + set + "return params\n}"
+	case strings.Contains(fn, "return params"):
+		ret := strings.LastIndex(fn, "return params")
+		fn = fn[:ret] + set + fn[ret:]
+	default:
+		return "", fmt.Errorf("GetParams function doesn't return the params")
+	}
+
+	return content[:loc[0]] + fn + content[loc[1]:], nil
+}
+
+// gateMessage returns an error from a message handler of a keeper file content
+// when the feature enabled by the param is disabled.
+func gateMessage(content, msgName, name string) (string, error) {
+	handler := regexp.MustCompile(fmt.Sprintf(
+		`(func \(k msgServer\) %s\(goCtx context\.Context,[^{]*\{\s*\n([ \t]*)ctx := sdk\.UnwrapSDKContext\(goCtx\)\n)`,
+		regexp.QuoteMeta(msgName),
+	))
+	if !handler.MatchString(content) {
+		return "", fmt.Errorf("the handler of the message %s doesn't unwrap the SDK context", msgName)
+	}
+
+	check := fmt.Sprintf("${1}\n${2}if err := k.Check%[1]v(ctx); err != nil {\n${2}\treturn nil, err\n${2}}\n", name)
+	return handler.ReplaceAllString(content, check), nil
+}
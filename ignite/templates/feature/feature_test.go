@@ -0,0 +1,147 @@
+package feature
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddProtoParam(t *testing.T) {
+	content := `// Params defines the parameters for the module.
+message Params {
+  option (gogoproto.goproto_stringer) = false;
+  uint64 max_posts = 1 [(gogoproto.moretags) = "yaml:\"max_posts\""];
+  bool posting_enabled = 3 [(gogoproto.moretags) = "yaml:\"posting_enabled\""];
+}
+
+message Other {
+  string name = 7;
+}
+`
+	got, err := addProtoParam(content, "payouts_enabled")
+	require.NoError(t, err)
+	require.Contains(t, got, `  bool posting_enabled = 3 [(gogoproto.moretags) = "yaml:\"posting_enabled\""];
+  bool payouts_enabled = 4 [(gogoproto.moretags) = "yaml:\"payouts_enabled\""];
+}
+
+message Other {`)
+
+	_, err = addProtoParam(content, "posting_enabled")
+	require.Error(t, err)
+
+	got, err = addProtoParam("message Params {\n  option (gogoproto.goproto_stringer) = false;\n  \n}\n", "payouts_enabled")
+	require.NoError(t, err)
+	require.Contains(t, got, "  bool payouts_enabled = 1 [")
+}
+
+func TestAddParamSetPair(t *testing.T) {
+	content := `// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	return nil
+}
+`
+	want := `// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyPayoutsEnabled, &p.PayoutsEnabled, validatePayoutsEnabled),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validatePayoutsEnabled(p.PayoutsEnabled); err != nil {
+		return err
+	}
+
+	return nil
+}
+`
+	got, err := addParamSetPair(content, "PayoutsEnabled")
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	got, err = addParamSetPair(got, "PostingEnabled")
+	require.NoError(t, err)
+	require.Contains(t, got, `		paramtypes.NewParamSetPair(KeyPayoutsEnabled, &p.PayoutsEnabled, validatePayoutsEnabled),
+		paramtypes.NewParamSetPair(KeyPostingEnabled, &p.PostingEnabled, validatePostingEnabled),
+	}`)
+	require.Contains(t, got, `	if err := validatePostingEnabled(p.PostingEnabled); err != nil {
+		return err
+	}
+
+	return nil`)
+}
+
+func TestAddGetParam(t *testing.T) {
+	content := `// GetParams get all parameters as types.Params
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.NewParams(
+		k.MaxPosts(ctx),
+	)
+}
+`
+	want := `// GetParams get all parameters as types.Params
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params := types.NewParams(
+		k.MaxPosts(ctx),
+	)
+	params.PayoutsEnabled = k.PayoutsEnabled(ctx)
+	return params
+}
+`
+	got, err := addGetParam(content, "PayoutsEnabled")
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	got, err = addGetParam(got, "PostingEnabled")
+	require.NoError(t, err)
+	require.Contains(t, got, `	params.PayoutsEnabled = k.PayoutsEnabled(ctx)
+	params.PostingEnabled = k.PostingEnabled(ctx)
+	return params
+}`)
+
+	_, err = addGetParam("func (k Keeper) GetParams(ctx sdk.Context) types.Params {\n\treturn p\n}\n", "PayoutsEnabled")
+	require.Error(t, err)
+}
+
+func TestGateMessage(t *testing.T) {
+	content := `func (k msgServer) CreatePost(goCtx context.Context, msg *types.MsgCreatePost) (*types.MsgCreatePostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.MsgCreatePostResponse{}, nil
+}
+
+func (k msgServer) CreatePostComment(goCtx context.Context, msg *types.MsgCreatePostComment) (*types.MsgCreatePostCommentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.MsgCreatePostCommentResponse{}, nil
+}
+`
+	want := `func (k msgServer) CreatePost(goCtx context.Context, msg *types.MsgCreatePost) (*types.MsgCreatePostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.CheckPostingEnabled(ctx); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreatePostResponse{}, nil
+}
+
+func (k msgServer) CreatePostComment(goCtx context.Context, msg *types.MsgCreatePostComment) (*types.MsgCreatePostCommentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.MsgCreatePostCommentResponse{}, nil
+}
+`
+	got, err := gateMessage(content, "CreatePost", "PostingEnabled")
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	_, err = gateMessage(content, "DeletePost", "PostingEnabled")
+	require.Error(t, err)
+}
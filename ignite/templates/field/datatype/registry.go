@@ -0,0 +1,44 @@
+package datatype
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+)
+
+// builtinTypes are the names of the data types that can't be overridden by a registered type.
+var builtinTypes = func() map[Name]struct{} {
+	names := make(map[Name]struct{}, len(SupportedTypes))
+	for name := range SupportedTypes {
+		names[name] = struct{}{}
+	}
+	return names
+}()
+
+// Register adds a custom data type to the supported types, it can then be used
+// in the fields of the scaffolded types, messages, queries and packets.
+// Built-in types can't be overridden, registering a custom type again replaces it.
+func Register(name Name, dt DataType) error {
+	switch {
+	case name == "":
+		return errors.New("data type name is empty")
+	case strings.Contains(string(name), Separator):
+		return fmt.Errorf("data type name %q can't contain %q", name, Separator)
+	case dt.DataType == nil || dt.ProtoType == nil || dt.CLIArgs == nil:
+		return fmt.Errorf("data type %s must define its Go type, proto type and CLI args", name)
+	}
+	if _, ok := builtinTypes[name]; ok {
+		return fmt.Errorf("data type %s is built-in and can't be overridden", name)
+	}
+	if dt.GenesisArgs == nil {
+		dt.GenesisArgs = func(multiformatname.Name, int) string { return "" }
+	}
+	if !dt.NonIndex && (dt.ToBytes == nil || dt.ToString == nil) {
+		return fmt.Errorf("data type %s must define its bytes and string casts to be used as an index", name)
+	}
+
+	SupportedTypes[name] = dt
+	return nil
+}
@@ -0,0 +1,147 @@
+package datatype
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+)
+
+// specArg is the placeholder of the CLI argument in the parse expression of a spec.
+const specArg = "$arg"
+
+// Spec defines a custom data type from its Go and proto mapping.
+type Spec struct {
+	// Name is the name of the type used in the fields, e.g. "dec" for "amount:dec".
+	Name string `yaml:"name"`
+
+	// GoType is the Go type of the field, e.g. "sdk.Dec".
+	GoType string `yaml:"go_type"`
+
+	// GoImports are the imports required by the Go type.
+	GoImports []GoImport `yaml:"go_imports"`
+
+	// ProtoType is the proto type of the field, e.g. "string".
+	ProtoType string `yaml:"proto_type"`
+
+	// ProtoOptions are the options of the proto field,
+	// e.g. "(gogoproto.nullable) = false".
+	ProtoOptions []string `yaml:"proto_options"`
+
+	// ProtoImports are the proto files imported by the proto type and options.
+	ProtoImports []string `yaml:"proto_imports"`
+
+	// CLIParse is a Go expression that parses the CLI argument "$arg" into the Go type
+	// and returns the value and an error, e.g. "sdk.NewDecFromStr($arg)".
+	CLIParse string `yaml:"cli_parse"`
+
+	// CLIImports are the imports required by the CLI parse expression.
+	CLIImports []GoImport `yaml:"cli_imports"`
+
+	// DefaultTestValue is a CLI argument used in the generated tests.
+	DefaultTestValue string `yaml:"default_test_value"`
+
+	// SimulationValue is a Go expression that generates a random value for the simulated
+	// messages, the random source is available as "r", it is optional.
+	SimulationValue string `yaml:"simulation_value"`
+
+	// SimulationImports are the imports required by the simulation value expression.
+	SimulationImports []GoImport `yaml:"simulation_imports"`
+}
+
+// specFile is the file format of the custom data type specs.
+type specFile struct {
+	Types []Spec `yaml:"types"`
+}
+
+// Validate checks the spec defines everything needed to scaffold the type.
+func (s Spec) Validate() error {
+	switch {
+	case s.Name == "":
+		return errors.New("data type name is required")
+	case s.GoType == "":
+		return fmt.Errorf("data type %s: go_type is required", s.Name)
+	case s.ProtoType == "":
+		return fmt.Errorf("data type %s: proto_type is required", s.Name)
+	case !strings.Contains(s.CLIParse, specArg):
+		return fmt.Errorf("data type %s: cli_parse must parse the %s argument", s.Name, specArg)
+	}
+	return nil
+}
+
+// DataType returns the data type definition of the spec.
+// The custom types can't be used as an index.
+func (s Spec) DataType() DataType {
+	dt := DataType{
+		DataType:         func(string) string { return s.GoType },
+		DefaultTestValue: s.DefaultTestValue,
+		ProtoType: func(_, name string, index int) string {
+			field := fmt.Sprintf("%s %s = %d", s.ProtoType, name, index)
+			if len(s.ProtoOptions) > 0 {
+				field += fmt.Sprintf(" [%s]", strings.Join(s.ProtoOptions, ", "))
+			}
+			return field
+		},
+		GenesisArgs: func(multiformatname.Name, int) string { return "" },
+		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+			parse := strings.ReplaceAll(s.CLIParse, specArg, fmt.Sprintf("args[%d]", argIndex))
+			return fmt.Sprintf(`%s%s, err := %s
+					if err != nil {
+						return err
+					}`, prefix, name.UpperCamel, parse)
+		},
+		ProtoImports:      s.ProtoImports,
+		GoCLIImports:      s.CLIImports,
+		GoImports:         s.GoImports,
+		SimulationImports: s.SimulationImports,
+		NonIndex:          true,
+	}
+	if s.SimulationValue != "" {
+		dt.SimulationArgs = func(name multiformatname.Name) string {
+			return fmt.Sprintf("%s: %s,\n", name.UpperCamel, s.SimulationValue)
+		}
+	}
+	return dt
+}
+
+// LoadSpecs reads the custom data type specs of a YAML file.
+// No specs are returned when the file doesn't exist.
+func LoadSpecs(path string) ([]Spec, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var f specFile
+	if err := yaml.UnmarshalStrict(data, &f); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	for _, s := range f.Types {
+		if err := s.Validate(); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	return f.Types, nil
+}
+
+// RegisterFile registers the custom data types defined in a YAML file, nothing
+// is registered when the file doesn't exist.
+func RegisterFile(path string) error {
+	specs, err := LoadSpecs(path)
+	if err != nil {
+		return err
+	}
+	for _, s := range specs {
+		if err := Register(Name(s.Name), s.DataType()); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	return nil
+}
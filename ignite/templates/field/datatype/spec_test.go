@@ -0,0 +1,101 @@
+package datatype
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+)
+
+const specs = `
+types:
+  - name: dec
+    go_type: sdk.Dec
+    go_imports:
+      - name: github.com/cosmos/cosmos-sdk/types
+        alias: sdk
+    proto_type: string
+    proto_options:
+      - (gogoproto.customtype) = "github.com/cosmos/cosmos-sdk/types.Dec"
+      - (gogoproto.nullable) = false
+    proto_imports:
+      - gogoproto/gogo.proto
+    cli_parse: sdk.NewDecFromStr($arg)
+    cli_imports:
+      - name: github.com/cosmos/cosmos-sdk/types
+        alias: sdk
+    default_test_value: "1.5"
+    simulation_value: sdk.NewDecWithPrec(r.Int63n(1000), 2)
+`
+
+func TestRegisterFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "field_types.yml")
+	require.NoError(t, os.WriteFile(path, []byte(specs), 0o644))
+	t.Cleanup(func() { delete(SupportedTypes, "dec") })
+
+	require.NoError(t, RegisterFile(path))
+
+	dt, ok := SupportedTypes["dec"]
+	require.True(t, ok)
+	require.True(t, dt.NonIndex)
+	require.Equal(t, "sdk.Dec", dt.DataType(""))
+	require.Equal(t,
+		`string amount = 3 [(gogoproto.customtype) = "github.com/cosmos/cosmos-sdk/types.Dec", (gogoproto.nullable) = false]`,
+		dt.ProtoType("", "amount", 3),
+	)
+	require.Equal(t, []string{"gogoproto/gogo.proto"}, dt.ProtoImports)
+	require.Equal(t, []GoImport{{Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"}}, dt.GoImports)
+
+	name, err := multiformatname.NewName("amount")
+	require.NoError(t, err)
+	require.Contains(t, dt.CLIArgs(name, "", "arg", 1), "argAmount, err := sdk.NewDecFromStr(args[1])")
+	require.Equal(t, "Amount: sdk.NewDecWithPrec(r.Int63n(1000), 2),\n", dt.SimulationArgs(name))
+	require.Empty(t, dt.GenesisArgs(name, 1))
+}
+
+func TestRegisterFileNotExist(t *testing.T) {
+	require.NoError(t, RegisterFile(filepath.Join(t.TempDir(), "field_types.yml")))
+}
+
+func TestLoadSpecsInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		spec string
+	}{
+		{
+			name: "missing go type",
+			spec: "types:\n  - name: dec\n    proto_type: string\n    cli_parse: parse($arg)\n",
+		},
+		{
+			name: "missing proto type",
+			spec: "types:\n  - name: dec\n    go_type: sdk.Dec\n    cli_parse: parse($arg)\n",
+		},
+		{
+			name: "cli parse without argument",
+			spec: "types:\n  - name: dec\n    go_type: sdk.Dec\n    proto_type: string\n    cli_parse: parse()\n",
+		},
+		{
+			name: "unknown key",
+			spec: "types:\n  - name: dec\n    go_typ: sdk.Dec\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "field_types.yml")
+			require.NoError(t, os.WriteFile(path, []byte(tt.spec), 0o644))
+
+			_, err := LoadSpecs(path)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestRegisterBuiltin(t *testing.T) {
+	dt := Spec{Name: "coin", GoType: "sdk.Coin", ProtoType: "string", CLIParse: "parse($arg)"}.DataType()
+	require.Error(t, Register(Coin, dt))
+	require.Error(t, Register("a:b", dt))
+	require.Error(t, Register("", dt))
+}
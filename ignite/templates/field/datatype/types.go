@@ -80,10 +80,16 @@ type DataType struct {
 	ToString          func(name string) string
 	CLIArgs           func(name multiformatname.Name, datatype, prefix string, argIndex int) string
 	NonIndex          bool
+
+	// GoImports are the imports required by the Go type in the types package.
+	GoImports []GoImport
+	// SimulationArgs returns the field of a simulated message with a random value, it is optional.
+	SimulationArgs    func(name multiformatname.Name) string
+	SimulationImports []GoImport
 }
 
 // GoImport represents the go import repo name with the alias
 type GoImport struct {
-	Name  string
-	Alias string
+	Name  string `yaml:"name"`
+	Alias string `yaml:"alias"`
 }
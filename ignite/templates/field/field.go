@@ -3,6 +3,7 @@ package field
 
 import (
 	"fmt"
+	"html/template"
 
 	"github.com/ignite/cli/ignite/pkg/multiformatname"
 	"github.com/ignite/cli/ignite/templates/field/datatype"
@@ -10,6 +11,8 @@ import (
 
 // Field represents a field inside a structure for a component
 // it can be a field contained in a type or inside the response of a query, etc...
+// The methods returning code return a template.HTML so the templates don't escape it,
+// the code of the custom data types can contain quotes.
 type Field struct {
 	Name         multiformatname.Name
 	DatatypeName datatype.Name
@@ -31,12 +34,12 @@ func (f Field) ProtoFieldName() string {
 }
 
 // ProtoType returns the field proto Datatype
-func (f Field) ProtoType(index int) string {
+func (f Field) ProtoType(index int) template.HTML {
 	dt, ok := datatype.SupportedTypes[f.DatatypeName]
 	if !ok {
 		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
 	}
-	return dt.ProtoType(f.Datatype, f.ProtoFieldName(), index)
+	return template.HTML(dt.ProtoType(f.Datatype, f.ProtoFieldName(), index))
 }
 
 // DefaultTestValue returns the Datatype value default
@@ -94,12 +97,12 @@ func (f Field) GenesisArgs(value int) string {
 }
 
 // CLIArgs returns the Datatype CLI args
-func (f Field) CLIArgs(prefix string, argIndex int) string {
+func (f Field) CLIArgs(prefix string, argIndex int) template.HTML {
 	dt, ok := datatype.SupportedTypes[f.DatatypeName]
 	if !ok {
 		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
 	}
-	return dt.CLIArgs(f.Name, f.Datatype, prefix, argIndex)
+	return template.HTML(dt.CLIArgs(f.Name, f.Datatype, prefix, argIndex))
 }
 
 // ToBytes returns the Datatype byte array cast
@@ -135,6 +138,37 @@ func (f Field) GoCLIImports() []datatype.GoImport {
 	return dt.GoCLIImports
 }
 
+// GoImports returns the Datatype imports required by the Go type
+func (f Field) GoImports() []datatype.GoImport {
+	dt, ok := datatype.SupportedTypes[f.DatatypeName]
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	return dt.GoImports
+}
+
+// SimulationArgs returns the Datatype args of a simulated message,
+// it is empty if the Datatype doesn't generate random values
+func (f Field) SimulationArgs() template.HTML {
+	dt, ok := datatype.SupportedTypes[f.DatatypeName]
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	if dt.SimulationArgs == nil {
+		return ""
+	}
+	return template.HTML(dt.SimulationArgs(f.Name))
+}
+
+// SimulationImports returns the Datatype imports required by the simulation args
+func (f Field) SimulationImports() []datatype.GoImport {
+	dt, ok := datatype.SupportedTypes[f.DatatypeName]
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	return dt.SimulationImports
+}
+
 // ProtoImports return the Datatype imports for proto files
 func (f Field) ProtoImports() []string {
 	dt, ok := datatype.SupportedTypes[f.DatatypeName]
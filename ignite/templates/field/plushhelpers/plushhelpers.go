@@ -12,6 +12,8 @@ import (
 func ExtendPlushContext(ctx *plush.Context) {
 	ctx.Set("mergeGoImports", mergeGoImports)
 	ctx.Set("mergeProtoImports", mergeProtoImports)
+	ctx.Set("mergeGoTypeImports", mergeGoTypeImports)
+	ctx.Set("mergeSimulationImports", mergeSimulationImports)
 	ctx.Set("mergeCustomImports", mergeCustomImports)
 	ctx.Set("title", xstrings.Title)
 }
@@ -46,6 +48,41 @@ func mergeGoImports(fields ...field.Fields) []datatype.GoImport {
 	return allImports
 }
 
+// templateImports are imported by all the templates using the Go types or the simulation args
+// of the fields, the fields requiring them must not import them again.
+var templateImports = map[string]struct{}{
+	"math/rand":                          {},
+	"github.com/cosmos/cosmos-sdk/types": {},
+}
+
+func mergeGoTypeImports(fields ...field.Fields) []datatype.GoImport {
+	return mergeFieldImports(field.Field.GoImports, fields...)
+}
+
+func mergeSimulationImports(fields ...field.Fields) []datatype.GoImport {
+	return mergeFieldImports(field.Field.SimulationImports, fields...)
+}
+
+func mergeFieldImports(imports func(field.Field) []datatype.GoImport, fields ...field.Fields) []datatype.GoImport {
+	allImports := make([]datatype.GoImport, 0)
+	exist := make(map[string]struct{})
+	for name := range templateImports {
+		exist[name] = struct{}{}
+	}
+	for _, fields := range fields {
+		for _, f := range fields {
+			for _, goImport := range imports(f) {
+				if _, ok := exist[goImport.Name]; ok {
+					continue
+				}
+				exist[goImport.Name] = struct{}{}
+				allImports = append(allImports, goImport)
+			}
+		}
+	}
+	return allImports
+}
+
 func mergeProtoImports(fields ...field.Fields) []string {
 	allImports := make([]string, 0)
 	exist := make(map[string]struct{})
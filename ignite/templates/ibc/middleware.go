@@ -0,0 +1,88 @@
+package ibc
+
+import (
+	"embed"
+	"fmt"
+	"path/filepath"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush/v4"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/pkg/xstrings"
+	"github.com/ignite/cli/ignite/templates/field/plushhelpers"
+	"github.com/ignite/cli/ignite/templates/module"
+	"github.com/ignite/cli/ignite/templates/testutil"
+)
+
+//go:embed middleware/* middleware/**/*
+var fsMiddleware embed.FS
+
+// MiddlewareOptions are options to scaffold an IBC middleware in a module
+type MiddlewareOptions struct {
+	AppName        string
+	AppPath        string
+	ModuleName     string
+	ModulePath     string
+	MiddlewareName multiformatname.Name
+}
+
+// NewMiddleware returns the generator to scaffold an IBC middleware wrapping the transfer stack of the app
+func NewMiddleware(replacer placeholder.Replacer, opts *MiddlewareOptions) (*genny.Generator, error) {
+	g := genny.New()
+
+	template := xgenny.NewEmbedWalker(fsMiddleware, "middleware/", opts.AppPath)
+
+	g.RunFn(appMiddlewareModify(replacer, opts))
+
+	ctx := plush.NewContext()
+	ctx.Set("moduleName", opts.ModuleName)
+	ctx.Set("modulePath", opts.ModulePath)
+	ctx.Set("appName", opts.AppName)
+	ctx.Set("middlewareName", opts.MiddlewareName)
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(xgenny.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+	g.Transformer(genny.Replace("{{middlewareName}}", opts.MiddlewareName.Snake))
+
+	// Create the 'testutil' package with the test helpers
+	if err := testutil.Register(g, opts.AppPath); err != nil {
+		return g, err
+	}
+
+	return g, xgenny.Box(g, template)
+}
+
+// appMiddlewareModify wraps the transfer IBC module of the app with the middleware.
+// Middlewares are applied in the order they are scaffolded, the last one being the
+// first to be called by core IBC.
+func appMiddlewareModify(replacer placeholder.Replacer, opts *MiddlewareOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, module.PathAppGo)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		template := `transferIBCModule = %[2]vmodule.New%[3]vMiddleware(
+	transferIBCModule,
+	app.IBCKeeper.ChannelKeeper,
+	app.%[4]vKeeper,
+)
+%[1]v`
+		replacement := fmt.Sprintf(
+			template,
+			module.PlaceholderIBCAppMiddleware,
+			opts.ModuleName,
+			opts.MiddlewareName.UpperCamel,
+			xstrings.Title(opts.ModuleName),
+		)
+		content := replacer.Replace(f.String(), module.PlaceholderIBCAppMiddleware, replacement)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
@@ -0,0 +1,118 @@
+package ica
+
+import (
+	"fmt"
+
+	"github.com/gobuffalo/genny"
+	"gopkg.in/yaml.v2"
+)
+
+// hostParamsKeys are the keys of the params of the host submodule in the genesis
+// of a config file.
+var hostParamsKeys = []string{"genesis", "app_state", "interchainaccounts", "host_genesis_state", "params"}
+
+// HostOptions are the options to enable the host submodule of interchain accounts.
+type HostOptions struct {
+	// ConfigPath is the path of the config file of the chain.
+	ConfigPath string
+
+	// AllowMessages are the messages the interchain accounts of the chain are
+	// allowed to execute.
+	AllowMessages []string
+}
+
+// NewHost returns the generator to allow the interchain accounts of the chain to
+// execute messages. The host submodule is wired in the apps but its interchain
+// accounts are not allowed to execute any message by default, the messages are
+// allowed in the genesis of the config file.
+func NewHost(opts *HostOptions) *genny.Generator {
+	g := genny.New()
+	g.RunFn(configModify(opts))
+	return g
+}
+
+func configModify(opts *HostOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		f, err := r.Disk.Find(opts.ConfigPath)
+		if err != nil {
+			return err
+		}
+
+		content, err := setHostParams([]byte(f.String()), opts.AllowMessages)
+		if err != nil {
+			return fmt.Errorf("%s: %w", opts.ConfigPath, err)
+		}
+
+		newFile := genny.NewFileB(opts.ConfigPath, content)
+		return r.File(newFile)
+	}
+}
+
+// setHostParams enables the host submodule and sets the allowed messages in the
+// genesis of a config file content.
+func setHostParams(content []byte, allowMessages []string) ([]byte, error) {
+	var conf yaml.MapSlice
+	if err := yaml.Unmarshal(content, &conf); err != nil {
+		return nil, err
+	}
+
+	params := yaml.MapSlice{
+		{Key: "host_enabled", Value: true},
+		{Key: "allow_messages", Value: allowMessages},
+	}
+	conf, err := setMapSliceValue(conf, hostParamsKeys, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(conf)
+}
+
+// setMapSliceValue sets the value of the nested keys, the items of the value
+// replace the items of the map of the keys when the map is already defined.
+func setMapSliceValue(m yaml.MapSlice, keys []string, value yaml.MapSlice) (yaml.MapSlice, error) {
+	for i, item := range m {
+		if item.Key != keys[0] {
+			continue
+		}
+
+		child, ok := item.Value.(yaml.MapSlice)
+		if !ok && item.Value != nil {
+			return nil, fmt.Errorf("%s must be a map", keys[0])
+		}
+
+		if len(keys) == 1 {
+			for _, v := range value {
+				child = setMapSliceItem(child, v)
+			}
+		} else {
+			var err error
+			if child, err = setMapSliceValue(child, keys[1:], value); err != nil {
+				return nil, err
+			}
+		}
+		m[i].Value = child
+		return m, nil
+	}
+
+	// The key is not defined yet
+	if len(keys) == 1 {
+		return append(m, yaml.MapItem{Key: keys[0], Value: value}), nil
+	}
+	child, err := setMapSliceValue(nil, keys[1:], value)
+	if err != nil {
+		return nil, err
+	}
+	return append(m, yaml.MapItem{Key: keys[0], Value: child}), nil
+}
+
+// setMapSliceItem replaces the value of the item key or adds the item.
+func setMapSliceItem(m yaml.MapSlice, item yaml.MapItem) yaml.MapSlice {
+	for i, v := range m {
+		if v.Key == item.Key {
+			m[i] = item
+			return m
+		}
+	}
+	return append(m, item)
+}
@@ -0,0 +1,74 @@
+package ica
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetHostParams(t *testing.T) {
+	cases := []struct {
+		name    string
+		content string
+		want    string
+		err     bool
+	}{
+		{
+			name:    "without genesis",
+			content: "version: 1\n",
+			want: `version: 1
+genesis:
+  app_state:
+    interchainaccounts:
+      host_genesis_state:
+        params:
+          host_enabled: true
+          allow_messages:
+          - /cosmos.bank.v1beta1.MsgSend
+`,
+		},
+		{
+			name: "with genesis",
+			content: `version: 1
+genesis:
+  chain_id: mars
+  app_state:
+    interchainaccounts:
+      host_genesis_state:
+        port: icahost
+        params:
+          allow_messages:
+          - /cosmos.gov.v1beta1.MsgVote
+`,
+			want: `version: 1
+genesis:
+  chain_id: mars
+  app_state:
+    interchainaccounts:
+      host_genesis_state:
+        port: icahost
+        params:
+          allow_messages:
+          - /cosmos.bank.v1beta1.MsgSend
+          host_enabled: true
+`,
+		},
+		{
+			name:    "with invalid genesis",
+			content: "version: 1\ngenesis: mars\n",
+			err:     true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := setHostParams([]byte(tt.content), []string{"/cosmos.bank.v1beta1.MsgSend"})
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, string(got))
+		})
+	}
+}
@@ -0,0 +1,307 @@
+package ica
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush/v4"
+
+	"github.com/ignite/cli/ignite/pkg/gomodulepath"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/pkg/xstrings"
+	"github.com/ignite/cli/ignite/templates/field/plushhelpers"
+	"github.com/ignite/cli/ignite/templates/module"
+	"github.com/ignite/cli/ignite/templates/typed"
+)
+
+var (
+	//go:embed files/* files/**/*
+	fsController embed.FS
+
+	//go:embed overrides/* overrides/**/*
+	fsOverrides embed.FS
+)
+
+// DefaultAllowMessages are the messages that the interchain accounts of the host
+// chain are allowed to execute by default.
+var DefaultAllowMessages = []string{
+	"/cosmos.bank.v1beta1.MsgSend",
+	"/cosmos.staking.v1beta1.MsgDelegate",
+	"/cosmos.staking.v1beta1.MsgUndelegate",
+}
+
+// Options are the options to scaffold an authentication module of the controller
+// submodule of interchain accounts.
+type Options struct {
+	AppName    string
+	AppPath    string
+	ModuleName string
+	ModulePath string
+
+	// ControllerChainID and HostChainID are the IDs of the chains of the local
+	// testing environment, the controller chain is the chain of the app.
+	ControllerChainID string
+	HostChainID       string
+
+	// AddressPrefix is the address prefix of the accounts of the app.
+	AddressPrefix string
+
+	// AllowMessages are the messages the interchain accounts of the local host chain
+	// are allowed to execute.
+	AllowMessages []string
+}
+
+// NewController returns the generator to scaffold the interchain accounts logic
+// in an IBC module: the module registers interchain accounts on host chains and
+// sends txs to its accounts through the controller middleware of ibc-go. The
+// config files to test the interchain accounts with a local host chain and a
+// relayer are created in the "ica" directory.
+func NewController(replacer placeholder.Replacer, opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+
+	ctx := plush.NewContext()
+	ctx.Set("moduleName", opts.ModuleName)
+	ctx.Set("modulePath", opts.ModulePath)
+	ctx.Set("appName", opts.AppName)
+	ctx.Set("controllerChainID", opts.ControllerChainID)
+	ctx.Set("hostChainID", opts.HostChainID)
+	ctx.Set("addressPrefix", opts.AddressPrefix)
+	ctx.Set("allowMessages", opts.AllowMessages)
+	plushhelpers.ExtendPlushContext(ctx)
+
+	g.RunFn(overridesRender(ctx, opts))
+	g.RunFn(protoTxModify(replacer, opts))
+	g.RunFn(protoQueryModify(replacer, opts))
+	g.RunFn(typesCodecModify(replacer, opts))
+	g.RunFn(clientCliTxModify(replacer, opts))
+	g.RunFn(clientCliQueryModify(replacer, opts))
+	g.RunFn(appModify(replacer, opts))
+
+	g.Transformer(xgenny.Transformer(ctx))
+	g.Transformer(genny.Replace("{{appName}}", opts.AppName))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+
+	return g, xgenny.Box(g, xgenny.NewEmbedWalker(fsController, "files/", opts.AppPath))
+}
+
+// overridesRender replaces the files of the IBC module created for the interchain
+// accounts, like the IBC callbacks, that are generic in a new IBC module.
+func overridesRender(ctx *plush.Context, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		return fs.WalkDir(fsOverrides, "overrides", func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+
+			template, err := fsOverrides.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			content, err := plush.Render(string(template), ctx)
+			if err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+
+			name := strings.TrimSuffix(strings.TrimPrefix(path, "overrides/"), ".plush")
+			name = strings.NewReplacer(
+				"{{appName}}", opts.AppName,
+				"{{moduleName}}", opts.ModuleName,
+			).Replace(name)
+			return r.File(genny.NewFileS(filepath.Join(opts.AppPath, name), content))
+		})
+	}
+}
+
+func protoTxModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.AppName, opts.ModuleName, "tx.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		templateImport := `import "google/protobuf/any.proto";
+%[1]v`
+		replacementImport := fmt.Sprintf(templateImport, typed.PlaceholderProtoTxImport)
+		content := replacer.Replace(f.String(), typed.PlaceholderProtoTxImport, replacementImport)
+
+		templateRPC := `  rpc RegisterAccount(MsgRegisterAccount) returns (MsgRegisterAccountResponse);
+  rpc SubmitTx(MsgSubmitTx) returns (MsgSubmitTxResponse);
+%[1]v`
+		replacementRPC := fmt.Sprintf(templateRPC, typed.PlaceholderProtoTxRPC)
+		content = replacer.Replace(content, typed.PlaceholderProtoTxRPC, replacementRPC)
+
+		templateMessage := `// MsgRegisterAccount registers an interchain account of the creator on the host
+// chain of the connection.
+message MsgRegisterAccount {
+  string creator = 1;
+  string connectionId = 2;
+  string version = 3;
+}
+
+message MsgRegisterAccountResponse {}
+
+// MsgSubmitTx executes a message with the interchain account of the creator on the
+// host chain of the connection.
+message MsgSubmitTx {
+  string creator = 1;
+  string connectionId = 2;
+  google.protobuf.Any msg = 3;
+}
+
+message MsgSubmitTxResponse {
+  uint64 sequence = 1;
+}
+
+%[1]v`
+		replacementMessage := fmt.Sprintf(templateMessage, typed.PlaceholderProtoTxMessage)
+		content = replacer.Replace(content, typed.PlaceholderProtoTxMessage, replacementMessage)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func protoQueryModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.AppName, opts.ModuleName, "query.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		templateService := `// Queries the address of the interchain account of an owner.
+	rpc InterchainAccount(QueryInterchainAccountRequest) returns (QueryInterchainAccountResponse) {
+		option (google.api.http).get = "/%[2]v/%[3]v/interchain_account/{owner}/{connectionId}";
+	}
+
+%[1]v`
+		replacementService := fmt.Sprintf(templateService,
+			typed.Placeholder2,
+			gomodulepath.ExtractAppPath(opts.ModulePath),
+			opts.ModuleName,
+		)
+		content := replacer.Replace(f.String(), typed.Placeholder2, replacementService)
+
+		templateMessage := `message QueryInterchainAccountRequest {
+  string owner = 1;
+  string connectionId = 2;
+}
+
+message QueryInterchainAccountResponse {
+  string address = 1;
+}
+
+%[1]v`
+		replacementMessage := fmt.Sprintf(templateMessage, typed.Placeholder3)
+		content = replacer.Replace(content, typed.Placeholder3, replacementMessage)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func typesCodecModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/codec.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		replacementImport := `sdk "github.com/cosmos/cosmos-sdk/types"`
+		content := replacer.ReplaceOnce(f.String(), typed.Placeholder, replacementImport)
+
+		templateRegisterConcrete := `cdc.RegisterConcrete(&MsgRegisterAccount{}, "%[2]v/RegisterAccount", nil)
+cdc.RegisterConcrete(&MsgSubmitTx{}, "%[2]v/SubmitTx", nil)
+%[1]v`
+		replacementRegisterConcrete := fmt.Sprintf(templateRegisterConcrete, typed.Placeholder2, opts.ModuleName)
+		content = replacer.Replace(content, typed.Placeholder2, replacementRegisterConcrete)
+
+		templateRegisterImplementations := `registry.RegisterImplementations((*sdk.Msg)(nil),
+	&MsgRegisterAccount{},
+	&MsgSubmitTx{},
+)
+%[1]v`
+		replacementRegisterImplementations := fmt.Sprintf(templateRegisterImplementations, typed.Placeholder3)
+		content = replacer.Replace(content, typed.Placeholder3, replacementRegisterImplementations)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func clientCliTxModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "client/cli/tx.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		template := `cmd.AddCommand(CmdRegisterAccount())
+	cmd.AddCommand(CmdSubmitTx())
+%[1]v`
+		replacement := fmt.Sprintf(template, typed.Placeholder)
+		content := replacer.Replace(f.String(), typed.Placeholder, replacement)
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func clientCliQueryModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "client/cli/query.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		template := `cmd.AddCommand(CmdShowInterchainAccount())
+%[1]v`
+		replacement := fmt.Sprintf(template, typed.Placeholder)
+		content := replacer.Replace(f.String(), typed.Placeholder, replacement)
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// appModify passes the controller keeper of interchain accounts to the keeper of
+// the module and routes the channels of the controller submodule to the module
+// wrapped by the controller middleware. The module doesn't have its own route.
+func appModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, module.PathAppGo)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		templateImport := `icacontroller "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/controller"
+%[1]v`
+		replacementImport := fmt.Sprintf(templateImport, module.PlaceholderSgAppModuleImport)
+		content := replacer.Replace(f.String(), module.PlaceholderSgAppModuleImport, replacementImport)
+
+		// The scoped keeper is the last argument of the keeper of an IBC module without dependencies
+		keeperArgument := fmt.Sprintf("scoped%sKeeper,", xstrings.Title(opts.ModuleName))
+		if !strings.Contains(content, keeperArgument) {
+			return fmt.Errorf("%s: the keeper of the module %s is not created", path, opts.ModuleName)
+		}
+		content = strings.Replace(content, keeperArgument, keeperArgument+"\nicaControllerKeeper,", 1)
+
+		route := fmt.Sprintf("ibcRouter.AddRoute(%[1]vmoduletypes.ModuleName, %[1]vIBCModule)", opts.ModuleName)
+		if !strings.Contains(content, route) {
+			return fmt.Errorf("%s: the IBC route of the module %s is not added", path, opts.ModuleName)
+		}
+		controllerRoute := fmt.Sprintf(
+			"ibcRouter.AddRoute(icacontrollertypes.SubModuleName, icacontroller.NewIBCMiddleware(%[1]vIBCModule, icaControllerKeeper))",
+			opts.ModuleName,
+		)
+		content = strings.Replace(content, route, controllerRoute, 1)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
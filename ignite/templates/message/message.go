@@ -33,6 +33,8 @@ func Box(box packd.Walker, opts *Options, g *genny.Generator) error {
 	ctx.Set("ModulePath", opts.ModulePath)
 	ctx.Set("Fields", opts.Fields)
 	ctx.Set("ResFields", opts.ResFields)
+	ctx.Set("NoEvents", opts.NoEvents)
+	ctx.Set("WithMetrics", opts.WithMetrics)
 
 	plushhelpers.ExtendPlushContext(ctx)
 	g.Transformer(xgenny.Transformer(ctx))
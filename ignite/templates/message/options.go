@@ -17,6 +17,8 @@ type Options struct {
 	Fields       field.Fields
 	ResFields    field.Fields
 	NoSimulation bool
+	NoEvents     bool
+	WithMetrics  bool
 }
 
 // Validate that options are usuable
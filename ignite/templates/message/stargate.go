@@ -9,6 +9,7 @@ import (
 
 	"github.com/ignite/cli/ignite/pkg/placeholder"
 	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/event"
 	"github.com/ignite/cli/ignite/templates/typed"
 )
 
@@ -21,6 +22,10 @@ func NewStargate(replacer placeholder.Replacer, opts *Options) (*genny.Generator
 	g.RunFn(typesCodecModify(replacer, opts))
 	g.RunFn(clientCliTxModify(replacer, opts))
 
+	if !opts.NoEvents {
+		g.RunFn(event.ProtoModify(replacer, events(opts)))
+	}
+
 	template := xgenny.NewEmbedWalker(
 		fsStargateMessage,
 		"stargate/message",
@@ -41,6 +46,24 @@ func NewStargate(replacer placeholder.Replacer, opts *Options) (*genny.Generator
 	return g, Box(template, opts, g)
 }
 
+// events returns the typed event emitted when the message is handled.
+func events(opts *Options) event.Options {
+	return event.Options{
+		AppName:    opts.AppName,
+		AppPath:    opts.AppPath,
+		ModuleName: opts.ModuleName,
+		ModulePath: opts.ModulePath,
+		MsgSigner:  opts.MsgSigner,
+		Events: []event.Event{
+			{
+				Name:        opts.MsgName,
+				Description: fmt.Sprintf("Msg%s is handled", opts.MsgName.UpperCamel),
+				Fields:      opts.Fields,
+			},
+		},
+	}
+}
+
 func protoTxRPCModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
 	return func(r *genny.Runner) error {
 		path := filepath.Join(opts.AppPath, "proto", opts.AppName, opts.ModuleName, "tx.proto")
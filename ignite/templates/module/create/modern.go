@@ -0,0 +1,130 @@
+package modulecreate
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/gobuffalo/genny"
+
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xstrings"
+	"github.com/ignite/cli/ignite/templates/module"
+)
+
+// pathAppConfigGo is the path of the app config of apps wired with dependency injection.
+const pathAppConfigGo = "app/app_config.go"
+
+// app.go modification when creating a module in an app wired with dependency injection
+func appModifyModern(replacer placeholder.Replacer, opts *CreateOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, module.PathAppGo)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		// Import
+		template := `%[2]vmodule "%[3]v/x/%[2]v"
+		%[2]vmodulekeeper "%[3]v/x/%[2]v/keeper"
+		%[2]vmoduletypes "%[3]v/x/%[2]v/types"
+%[1]v`
+		replacement := fmt.Sprintf(template, module.PlaceholderSgAppModuleImport, opts.ModuleName, opts.ModulePath)
+		content := replacer.Replace(f.String(), module.PlaceholderSgAppModuleImport, replacement)
+
+		// ModuleBasic
+		template = `%[2]vmodule.AppModuleBasic{},
+%[1]v`
+		replacement = fmt.Sprintf(template, module.PlaceholderSgAppModuleBasic, opts.ModuleName)
+		content = replacer.Replace(content, module.PlaceholderSgAppModuleBasic, replacement)
+
+		// Keeper declaration
+		template = `%[3]vKeeper %[2]vmodulekeeper.Keeper
+%[1]v`
+		replacement = fmt.Sprintf(
+			template,
+			module.PlaceholderSgAppKeeperDeclaration,
+			opts.ModuleName,
+			xstrings.Title(opts.ModuleName),
+		)
+		content = replacer.Replace(content, module.PlaceholderSgAppKeeperDeclaration, replacement)
+
+		// Store keys
+		template = `%[2]vmoduletypes.StoreKey,
+%[1]v`
+		replacement = fmt.Sprintf(template, module.PlaceholderSgAppStoreKey, opts.ModuleName)
+		content = replacer.Replace(content, module.PlaceholderSgAppStoreKey, replacement)
+		template = `%[2]vmoduletypes.MemStoreKey,
+%[1]v`
+		replacement = fmt.Sprintf(template, module.PlaceholderSgAppMemStoreKey, opts.ModuleName)
+		content = replacer.Replace(content, module.PlaceholderSgAppMemStoreKey, replacement)
+
+		// Module dependencies
+		var depArgs string
+		for _, dep := range opts.Dependencies {
+			depArgs = fmt.Sprintf("%sapp.%s,\n", depArgs, dep.KeeperName)
+		}
+
+		// Keeper definition and module registration
+		template = `app.%[3]vKeeper = *%[2]vmodulekeeper.NewKeeper(
+			app.appCodec,
+			keys[%[2]vmoduletypes.StoreKey],
+			memKeys[%[2]vmoduletypes.MemStoreKey],
+			app.GetSubspace(%[2]vmoduletypes.ModuleName),
+			%[4]v)
+		app.registerModules(%[2]vmodule.NewAppModule(app.appCodec, app.%[3]vKeeper, app.AccountKeeper, app.BankKeeper))
+
+		%[1]v`
+		replacement = fmt.Sprintf(
+			template,
+			module.PlaceholderSgAppKeeperDefinition,
+			opts.ModuleName,
+			xstrings.Title(opts.ModuleName),
+			depArgs,
+		)
+		content = replacer.Replace(content, module.PlaceholderSgAppKeeperDefinition, replacement)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// app_config.go modification when creating a module in an app wired with dependency injection
+func appConfigModifyModern(replacer placeholder.Replacer, opts *CreateOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, pathAppConfigGo)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		// Import
+		template := `%[2]vmoduletypes "%[3]v/x/%[2]v/types"
+%[1]v`
+		replacement := fmt.Sprintf(template, module.PlaceholderSgAppModuleImport, opts.ModuleName, opts.ModulePath)
+		content := replacer.Replace(f.String(), module.PlaceholderSgAppModuleImport, replacement)
+
+		// Module orders
+		template = `%[2]vmoduletypes.ModuleName,
+%[1]v`
+		replacement = fmt.Sprintf(template, module.PlaceholderSgAppInitGenesis, opts.ModuleName)
+		content = replacer.Replace(content, module.PlaceholderSgAppInitGenesis, replacement)
+		replacement = fmt.Sprintf(template, module.PlaceholderSgAppBeginBlockers, opts.ModuleName)
+		content = replacer.Replace(content, module.PlaceholderSgAppBeginBlockers, replacement)
+		replacement = fmt.Sprintf(template, module.PlaceholderSgAppEndBlockers, opts.ModuleName)
+		content = replacer.Replace(content, module.PlaceholderSgAppEndBlockers, replacement)
+
+		// If bank is a dependency, add account permissions to the module
+		for _, dep := range opts.Dependencies {
+			if dep.Name != "bank" {
+				continue
+			}
+			template = `{Account: %[2]vmoduletypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner, authtypes.Staking}},
+%[1]v`
+			replacement = fmt.Sprintf(template, module.PlaceholderSgAppMaccPerms, opts.ModuleName)
+			content = replacer.Replace(content, module.PlaceholderSgAppMaccPerms, replacement)
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
@@ -22,7 +22,18 @@ type CreateOptions struct {
 	IBCOrdering string
 
 	// Dependencies of the module
-	Dependencies []Dependency
+	Dependencies Dependencies
+
+	// True if the module should define hooks for other modules
+	WithHooks bool
+
+	// True if the module should emit metrics of its message handlers and
+	// keeper methods
+	WithMetrics bool
+
+	// True if the app wires its modules with dependency injection and
+	// registers their commands with AutoCLI
+	ModernAppWiring bool
 }
 
 // MsgServerOptions defines options to add MsgServer
@@ -44,6 +55,19 @@ type Dependency struct {
 	KeeperName string // KeeperName represents the name of the keeper for the module in app.go
 }
 
+// Dependencies represents a list of module dependencies
+type Dependencies []Dependency
+
+// Contains returns true if the module named name is part of the dependencies
+func (d Dependencies) Contains(name string) bool {
+	for _, dep := range d {
+		if dep.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 // NewDependency returns a new dependency object
 func NewDependency(name, keeperName string) Dependency {
 	// Default keeper name
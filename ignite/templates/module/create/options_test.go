@@ -0,0 +1,59 @@
+package modulecreate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDependenciesContains(t *testing.T) {
+	deps := Dependencies{
+		NewDependency("bank", ""),
+		NewDependency("staking", "StakingKeeper"),
+	}
+
+	cases := []struct {
+		name string
+		deps Dependencies
+		dep  string
+		want bool
+	}{
+		{
+			name: "first dependency",
+			deps: deps,
+			dep:  "bank",
+			want: true,
+		},
+		{
+			name: "last dependency",
+			deps: deps,
+			dep:  "staking",
+			want: true,
+		},
+		{
+			name: "missing dependency",
+			deps: deps,
+			dep:  "slashing",
+		},
+		{
+			name: "keeper name",
+			deps: deps,
+			dep:  "BankKeeper",
+		},
+		{
+			name: "case sensitive",
+			deps: deps,
+			dep:  "Bank",
+		},
+		{
+			name: "no dependencies",
+			dep:  "bank",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.deps.Contains(tt.dep))
+		})
+	}
+}
@@ -46,6 +46,36 @@ func NewStargate(opts *CreateOptions) (*genny.Generator, error) {
 	if err := g.Box(stargateTemplate); err != nil {
 		return g, err
 	}
+	if opts.WithHooks {
+		hooksTemplate := xgenny.NewEmbedWalker(
+			fsHooks,
+			"hooks/",
+			opts.AppPath,
+		)
+		if err := g.Box(hooksTemplate); err != nil {
+			return g, err
+		}
+	}
+	if opts.WithMetrics {
+		metricsTemplate := xgenny.NewEmbedWalker(
+			fsMetrics,
+			"metrics/",
+			opts.AppPath,
+		)
+		if err := g.Box(metricsTemplate); err != nil {
+			return g, err
+		}
+	}
+	if opts.ModernAppWiring {
+		modernTemplate := xgenny.NewEmbedWalker(
+			fsModern,
+			"modern/",
+			opts.AppPath,
+		)
+		if err := g.Box(modernTemplate); err != nil {
+			return g, err
+		}
+	}
 
 	appModulePath := gomodulepath.ExtractAppPath(opts.ModulePath)
 
@@ -56,6 +86,8 @@ func NewStargate(opts *CreateOptions) (*genny.Generator, error) {
 	ctx.Set("dependencies", opts.Dependencies)
 	ctx.Set("params", opts.Params)
 	ctx.Set("isIBC", opts.IsIBC)
+	ctx.Set("withHooks", opts.WithHooks)
+	ctx.Set("modernAppWiring", opts.ModernAppWiring)
 	ctx.Set("apiPath", fmt.Sprintf("/%s/%s", appModulePath, opts.ModuleName))
 	ctx.Set("protoPkgName", module.ProtoPackageName(appModulePath, opts.ModuleName))
 
@@ -63,6 +95,9 @@ func NewStargate(opts *CreateOptions) (*genny.Generator, error) {
 	g.Transformer(xgenny.Transformer(ctx))
 	g.Transformer(genny.Replace("{{appName}}", opts.AppName))
 	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+	if opts.ModernAppWiring {
+		g.Transformer(module.ModernImportsTransformer())
+	}
 
 	gSimapp, err := AddSimulation(opts.AppPath, opts.ModulePath, opts.ModuleName, opts.Params...)
 	if err != nil {
@@ -76,6 +111,11 @@ func NewStargate(opts *CreateOptions) (*genny.Generator, error) {
 // NewStargateAppModify returns generator with modifications required to register a module in the app.
 func NewStargateAppModify(replacer placeholder.Replacer, opts *CreateOptions) *genny.Generator {
 	g := genny.New()
+	if opts.ModernAppWiring {
+		g.RunFn(appModifyModern(replacer, opts))
+		g.RunFn(appConfigModifyModern(replacer, opts))
+		return g
+	}
 	g.RunFn(appModifyStargate(replacer, opts))
 	if opts.IsIBC {
 		g.RunFn(appIBCModify(replacer, opts))
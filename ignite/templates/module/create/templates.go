@@ -19,4 +19,13 @@ var (
 
 	//go:embed simapp/* simapp/**/*
 	fsSimapp embed.FS
+
+	//go:embed hooks/* hooks/**/*
+	fsHooks embed.FS
+
+	//go:embed metrics/* metrics/**/*
+	fsMetrics embed.FS
+
+	//go:embed modern/* modern/**/*
+	fsModern embed.FS
 )
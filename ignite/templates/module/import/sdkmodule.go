@@ -0,0 +1,223 @@
+package moduleimport
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosver"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/templates/module"
+)
+
+const (
+	// SDKModuleAuthz is the name of the Cosmos SDK authz module.
+	SDKModuleAuthz = "authz"
+
+	// SDKModuleFeegrant is the name of the Cosmos SDK feegrant module.
+	SDKModuleFeegrant = "feegrant"
+
+	// SDKModuleGroup is the name of the Cosmos SDK group module.
+	SDKModuleGroup = "group"
+
+	// SDKModuleNFT is the name of the Cosmos SDK nft module.
+	SDKModuleNFT = "nft"
+)
+
+// SDKModuleOptions are the options to enable a Cosmos SDK module.
+type SDKModuleOptions struct {
+	AppPath    string
+	Module     string
+	SDKVersion cosmosver.Version
+}
+
+// sdkModule defines the app.go code required to wire a Cosmos SDK module.
+type sdkModule struct {
+	imports          string
+	storeKey         string
+	keeperDeclared   string
+	keeperDefinition string
+	appModule        string
+	moduleName       string
+
+	// maccPerms is the module account of the module with its permissions, if any.
+	maccPerms string
+}
+
+var (
+	// feegrantAnteOption matches the fee grant keeper option of the ante handler when it's not set.
+	feegrantAnteOption = regexp.MustCompile(`(FeegrantKeeper:\s*)nil,`)
+
+	// signModeAnteOption matches the sign mode handler option of the ante handler.
+	signModeAnteOption = regexp.MustCompile(`(?m)^(\s*)SignModeHandler:(.*)$`)
+)
+
+func newSDKModule(name string, version cosmosver.Version) (sdkModule, error) {
+	switch name {
+	case SDKModuleAuthz:
+		// The account keeper argument was added to the authz keeper in v0.46
+		keeperArgs := "keys[authz.ModuleName],\n\t\tappCodec,\n\t\tapp.MsgServiceRouter(),"
+		if version.GTE(cosmosver.StargateFortySixVersion) {
+			keeperArgs += "\n\t\tapp.AccountKeeper,"
+		}
+
+		return sdkModule{
+			imports: `"github.com/cosmos/cosmos-sdk/x/authz"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"`,
+			storeKey:         "authz.ModuleName",
+			keeperDeclared:   "AuthzKeeper authzkeeper.Keeper",
+			keeperDefinition: fmt.Sprintf("app.AuthzKeeper = authzkeeper.NewKeeper(\n\t\t%s\n\t)", keeperArgs),
+			appModule:        "authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry)",
+			moduleName:       "authz.ModuleName",
+		}, nil
+	case SDKModuleFeegrant:
+		return sdkModule{
+			imports: `"github.com/cosmos/cosmos-sdk/x/feegrant"
+	feegrantkeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"`,
+			storeKey:       "feegrant.StoreKey",
+			keeperDeclared: "FeeGrantKeeper feegrantkeeper.Keeper",
+			keeperDefinition: `app.FeeGrantKeeper = feegrantkeeper.NewKeeper(
+		appCodec,
+		keys[feegrant.StoreKey],
+		app.AccountKeeper,
+	)`,
+			appModule:  "feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry)",
+			moduleName: "feegrant.ModuleName",
+		}, nil
+	case SDKModuleGroup:
+		return sdkModule{
+			imports: `"github.com/cosmos/cosmos-sdk/x/group"
+	groupkeeper "github.com/cosmos/cosmos-sdk/x/group/keeper"
+	groupmodule "github.com/cosmos/cosmos-sdk/x/group/module"`,
+			storeKey:       "group.StoreKey",
+			keeperDeclared: "GroupKeeper groupkeeper.Keeper",
+			keeperDefinition: `app.GroupKeeper = groupkeeper.NewKeeper(
+		keys[group.StoreKey],
+		appCodec,
+		app.MsgServiceRouter(),
+		app.AccountKeeper,
+		group.DefaultConfig(),
+	)`,
+			appModule:  "groupmodule.NewAppModule(appCodec, app.GroupKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry)",
+			moduleName: "group.ModuleName",
+		}, nil
+	case SDKModuleNFT:
+		return sdkModule{
+			imports: `"github.com/cosmos/cosmos-sdk/x/nft"
+	nftkeeper "github.com/cosmos/cosmos-sdk/x/nft/keeper"
+	nftmodule "github.com/cosmos/cosmos-sdk/x/nft/module"`,
+			storeKey:       "nftkeeper.StoreKey",
+			keeperDeclared: "NFTKeeper nftkeeper.Keeper",
+			keeperDefinition: `app.NFTKeeper = nftkeeper.NewKeeper(
+		keys[nftkeeper.StoreKey],
+		appCodec,
+		app.AccountKeeper,
+		app.BankKeeper,
+	)`,
+			appModule:  "nftmodule.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry)",
+			moduleName: "nft.ModuleName",
+			maccPerms:  "nft.ModuleName: nil,",
+		}, nil
+	}
+
+	return sdkModule{}, fmt.Errorf("unknown Cosmos SDK module %s", name)
+}
+
+// NewSDKModule returns the generator to wire a Cosmos SDK module inside an app.
+func NewSDKModule(replacer placeholder.Replacer, opts *SDKModuleOptions) (*genny.Generator, error) {
+	m, err := newSDKModule(opts.Module, opts.SDKVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	g := genny.New()
+	g.RunFn(appSDKModuleModify(replacer, opts, m))
+	return g, nil
+}
+
+// app.go modification when enabling a Cosmos SDK module
+func appSDKModuleModify(replacer placeholder.Replacer, opts *SDKModuleOptions, m sdkModule) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, module.PathAppGo)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		templateImport := `%[1]v
+	%[2]v`
+		replacementImport := fmt.Sprintf(templateImport, module.PlaceholderSgAppModuleImport, m.imports)
+		content := replacer.Replace(f.String(), module.PlaceholderSgAppModuleImport, replacementImport)
+
+		templateModuleBasic := `%[1]v
+		%[2]vmodule.AppModuleBasic{},`
+		replacementModuleBasic := fmt.Sprintf(templateModuleBasic, module.PlaceholderSgAppModuleBasic, opts.Module)
+		content = replacer.Replace(content, module.PlaceholderSgAppModuleBasic, replacementModuleBasic)
+
+		templateKeeperDeclaration := `%[1]v
+	%[2]v`
+		replacementKeeperDeclaration := fmt.Sprintf(templateKeeperDeclaration, module.PlaceholderSgAppKeeperDeclaration, m.keeperDeclared)
+		content = replacer.Replace(content, module.PlaceholderSgAppKeeperDeclaration, replacementKeeperDeclaration)
+
+		templateStoreKey := `%[1]v
+		%[2]v,`
+		replacementStoreKey := fmt.Sprintf(templateStoreKey, module.PlaceholderSgAppStoreKey, m.storeKey)
+		content = replacer.Replace(content, module.PlaceholderSgAppStoreKey, replacementStoreKey)
+
+		// The keeper is defined after the keepers of the Cosmos SDK modules it depends on
+		templateKeeperDefinition := `%[1]v
+
+	%[2]v`
+		replacementKeeperDefinition := fmt.Sprintf(templateKeeperDefinition, m.keeperDefinition, module.PlaceholderSgAppKeeperDefinition)
+		content = replacer.Replace(content, module.PlaceholderSgAppKeeperDefinition, replacementKeeperDefinition)
+
+		// The app module is registered both in the module manager and in the simulation manager
+		templateAppModule := `%[1]v
+		%[2]v,`
+		replacementAppModule := fmt.Sprintf(templateAppModule, module.PlaceholderSgAppAppModule, m.appModule)
+		content = replacer.ReplaceAll(content, module.PlaceholderSgAppAppModule, replacementAppModule)
+
+		// Every module must be part of the begin blockers, end blockers and init genesis order
+		templateModuleName := `%[1]v
+		%[2]v,`
+		for _, placeholder := range []string{
+			module.PlaceholderSgAppBeginBlockers,
+			module.PlaceholderSgAppEndBlockers,
+			module.PlaceholderSgAppInitGenesis,
+		} {
+			content = replacer.Replace(content, placeholder, fmt.Sprintf(templateModuleName, placeholder, m.moduleName))
+		}
+
+		// The keeper of some modules requires the module account to be set
+		if m.maccPerms != "" {
+			templateMaccPerms := `%[1]v
+		%[2]v`
+			replacementMaccPerms := fmt.Sprintf(templateMaccPerms, module.PlaceholderSgAppMaccPerms, m.maccPerms)
+			content = replacer.Replace(content, module.PlaceholderSgAppMaccPerms, replacementMaccPerms)
+		}
+
+		// Fee grants are only used by the ante handler when it has access to the fee grant keeper
+		if opts.Module == SDKModuleFeegrant {
+			content = setAnteFeegrantKeeper(content)
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// setAnteFeegrantKeeper sets the fee grant keeper in the ante handler options.
+func setAnteFeegrantKeeper(content string) string {
+	if feegrantAnteOption.MatchString(content) {
+		return feegrantAnteOption.ReplaceAllString(content, "${1}app.FeeGrantKeeper,")
+	}
+	if strings.Contains(content, "FeegrantKeeper:") {
+		return content
+	}
+	return signModeAnteOption.ReplaceAllString(content, "${1}SignModeHandler:${2}\n${1}FeegrantKeeper: app.FeeGrantKeeper,")
+}
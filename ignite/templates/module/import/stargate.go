@@ -2,10 +2,14 @@ package moduleimport
 
 import (
 	"fmt"
+	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/gobuffalo/genny"
 	"github.com/gobuffalo/plush/v4"
+	"github.com/goccy/go-yaml/ast"
+	"github.com/goccy/go-yaml/parser"
 
 	"github.com/ignite/cli/ignite/pkg/placeholder"
 	"github.com/ignite/cli/ignite/templates/field/plushhelpers"
@@ -17,6 +21,7 @@ func NewStargate(replacer placeholder.Replacer, opts *ImportOptions) (*genny.Gen
 	g := genny.New()
 	g.RunFn(appModifyStargate(replacer, opts))
 	g.RunFn(cmdModifyStargate(replacer, opts))
+	g.RunFn(configModify(opts))
 
 	ctx := plush.NewContext()
 	ctx.Set("AppName", opts.AppName)
@@ -35,9 +40,11 @@ func appModifyStargate(replacer placeholder.Replacer, opts *ImportOptions) genny
 		}
 
 		templateImport := `%[1]v
-		"github.com/tendermint/spm-extras/wasmcmd"
+		"strings"
+
 		"github.com/CosmWasm/wasmd/x/wasm"
-		wasmclient "github.com/CosmWasm/wasmd/x/wasm/client"`
+		wasmclient "github.com/CosmWasm/wasmd/x/wasm/client"
+		wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"`
 		replacementImport := fmt.Sprintf(templateImport, module.PlaceholderSgAppModuleImport)
 		content := replacer.Replace(f.String(), module.PlaceholderSgAppModuleImport, replacementImport)
 
@@ -50,6 +57,23 @@ func appModifyStargate(replacer placeholder.Replacer, opts *ImportOptions) genny
 			// https://github.com/CosmWasm/wasmd/blob/02a54d33ff2c064f3539ae12d75d027d9c665f05/x/wasm/internal/types/proposal.go#L28-L34
 			EnableSpecificProposals = ""
 		)
+
+		// GetEnabledProposals parses the ProposalsEnabled / EnableSpecificProposals values to
+		// produce a list of enabled proposals to pass into wasmd app.
+		func GetEnabledProposals() []wasm.ProposalType {
+			if EnableSpecificProposals == "" {
+				if ProposalsEnabled == "true" {
+					return wasm.EnableAllProposals
+				}
+				return wasm.DisableAllProposals
+			}
+			chunks := strings.Split(EnableSpecificProposals, ",")
+			proposals, err := wasm.ConvertToProposals(chunks)
+			if err != nil {
+				panic(err)
+			}
+			return proposals
+		}
 		`
 		content = replacer.Replace(content, module.PlaceholderSgWasmAppEnabledProposals, templateEnabledProposals)
 
@@ -63,9 +87,14 @@ func appModifyStargate(replacer placeholder.Replacer, opts *ImportOptions) genny
 		replacementModuleBasic := fmt.Sprintf(templateModuleBasic, module.PlaceholderSgAppModuleBasic)
 		content = replacer.Replace(content, module.PlaceholderSgAppModuleBasic, replacementModuleBasic)
 
+		templateMaccPerms := `%[1]v
+		wasm.ModuleName: {authtypes.Burner},`
+		replacementMaccPerms := fmt.Sprintf(templateMaccPerms, module.PlaceholderSgAppMaccPerms)
+		content = replacer.Replace(content, module.PlaceholderSgAppMaccPerms, replacementMaccPerms)
+
 		templateKeeperDeclaration := `%[1]v
-		wasmKeeper       wasm.Keeper
-		scopedWasmKeeper capabilitykeeper.ScopedKeeper
+		WasmKeeper       wasm.Keeper
+		ScopedWasmKeeper capabilitykeeper.ScopedKeeper
 		`
 		replacementKeeperDeclaration := fmt.Sprintf(templateKeeperDeclaration, module.PlaceholderSgAppKeeperDeclaration)
 		content = replacer.Replace(content, module.PlaceholderSgAppKeeperDeclaration, replacementKeeperDeclaration)
@@ -77,7 +106,7 @@ func appModifyStargate(replacer placeholder.Replacer, opts *ImportOptions) genny
 		content = replacer.Replace(content, module.PlaceholderSgAppScopedKeeper, replacementDeclaration)
 
 		templateDeclaration = `%[1]v
-		app.scopedWasmKeeper = scopedWasmKeeper
+		app.ScopedWasmKeeper = scopedWasmKeeper
 		`
 		replacementDeclaration = fmt.Sprintf(templateDeclaration, module.PlaceholderSgAppBeforeInitReturn)
 		content = replacer.Replace(content, module.PlaceholderSgAppBeforeInitReturn, replacementDeclaration)
@@ -87,9 +116,9 @@ func appModifyStargate(replacer placeholder.Replacer, opts *ImportOptions) genny
 		replacementStoreKey := fmt.Sprintf(templateStoreKey, module.PlaceholderSgAppStoreKey)
 		content = replacer.Replace(content, module.PlaceholderSgAppStoreKey, replacementStoreKey)
 
-		templateKeeperDefinition := `%[1]v
-		wasmDir := filepath.Join(homePath, "wasm")
-	
+		// The wasm keeper must be created before the gov router is sealed
+		// by the gov keeper, so the wasm proposal route can be registered.
+		templateKeeperDefinition := `wasmDir := filepath.Join(homePath, "wasm")
 		wasmConfig, err := wasm.ReadWasmConfig(appOpts)
 		if err != nil {
 			panic("error while reading wasm config: " + err.Error())
@@ -97,49 +126,73 @@ func appModifyStargate(replacer placeholder.Replacer, opts *ImportOptions) genny
 
 		// The last arguments can contain custom message handlers, and custom query handlers,
 		// if we want to allow any custom callbacks
-		supportedFeatures := "staking"
-		app.wasmKeeper = wasm.NewKeeper(
-				appCodec,
-				keys[wasm.StoreKey],
-				app.GetSubspace(wasm.ModuleName),
-				app.AccountKeeper,
-				app.BankKeeper,
-				app.StakingKeeper,
-				app.DistrKeeper,
-				app.IBCKeeper.ChannelKeeper,
-				&app.IBCKeeper.PortKeeper,
-				scopedWasmKeeper,
-				app.TransferKeeper,
-				app.Router(),
-				app.GRPCQueryRouter(),
-				wasmDir,
-				wasmConfig,
-				supportedFeatures,
+		availableCapabilities := "iterator,staking,stargate,cosmwasm_1_1"
+		app.WasmKeeper = wasm.NewKeeper(
+			appCodec,
+			keys[wasm.StoreKey],
+			app.GetSubspace(wasm.ModuleName),
+			app.AccountKeeper,
+			app.BankKeeper,
+			app.StakingKeeper,
+			app.DistrKeeper,
+			app.IBCKeeper.ChannelKeeper,
+			&app.IBCKeeper.PortKeeper,
+			scopedWasmKeeper,
+			app.TransferKeeper,
+			app.MsgServiceRouter(),
+			app.GRPCQueryRouter(),
+			wasmDir,
+			wasmConfig,
+			availableCapabilities,
 		)
-	
+
 		// The gov proposal types can be individually enabled
-		enabledProposals := wasmcmd.GetEnabledProposals(ProposalsEnabled, EnableSpecificProposals)
-		if len(enabledProposals) != 0 {
-			govRouter.AddRoute(wasm.RouterKey, wasm.NewWasmProposalHandler(app.wasmKeeper, enabledProposals))
-		}`
-		replacementKeeperDefinition := fmt.Sprintf(templateKeeperDefinition, module.PlaceholderSgAppKeeperDefinition)
-		content = replacer.Replace(content, module.PlaceholderSgAppKeeperDefinition, replacementKeeperDefinition)
+		if enabledProposals := GetEnabledProposals(); len(enabledProposals) != 0 {
+			govRouter.AddRoute(wasm.RouterKey, wasm.NewWasmProposalHandler(app.WasmKeeper, enabledProposals))
+		}
+		%[1]v`
+		replacementKeeperDefinition := fmt.Sprintf(templateKeeperDefinition, module.PlaceholderSgAppGovRouter)
+		content = replacer.Replace(content, module.PlaceholderSgAppGovRouter, replacementKeeperDefinition)
 
+		templateIBCRouter := `ibcRouter.AddRoute(wasm.ModuleName, wasm.NewIBCHandler(app.WasmKeeper, app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper))
+%[1]v`
+		replacementIBCRouter := fmt.Sprintf(templateIBCRouter, module.PlaceholderIBCAppRouter)
+		content = replacer.Replace(content, module.PlaceholderIBCAppRouter, replacementIBCRouter)
+
+		// The app module is registered both in the module manager and in the simulation manager
 		templateAppModule := `%[1]v
-		wasm.NewAppModule(appCodec, &app.wasmKeeper, app.StakingKeeper),`
+		wasm.NewAppModule(appCodec, &app.WasmKeeper, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),`
 		replacementAppModule := fmt.Sprintf(templateAppModule, module.PlaceholderSgAppAppModule)
-		content = replacer.Replace(content, module.PlaceholderSgAppAppModule, replacementAppModule)
+		content = replacer.ReplaceAll(content, module.PlaceholderSgAppAppModule, replacementAppModule)
 
-		templateInitGenesis := `%[1]v
+		templateModuleName := `%[1]v
 		wasm.ModuleName,`
-		replacementInitGenesis := fmt.Sprintf(templateInitGenesis, module.PlaceholderSgAppInitGenesis)
-		content = replacer.Replace(content, module.PlaceholderSgAppInitGenesis, replacementInitGenesis)
+		for _, placeholder := range []string{
+			module.PlaceholderSgAppBeginBlockers,
+			module.PlaceholderSgAppEndBlockers,
+			module.PlaceholderSgAppInitGenesis,
+		} {
+			content = replacer.Replace(content, placeholder, fmt.Sprintf(templateModuleName, placeholder))
+		}
 
 		templateParamSubspace := `%[1]v
 		paramsKeeper.Subspace(wasm.ModuleName)`
 		replacementParamSubspace := fmt.Sprintf(templateParamSubspace, module.PlaceholderSgAppParamSubspace)
 		content = replacer.Replace(content, module.PlaceholderSgAppParamSubspace, replacementParamSubspace)
 
+		// Register the wasm snapshot extension so state sync includes the contract code
+		templateSnapshot := `if manager := app.SnapshotManager(); manager != nil {
+			if err := manager.RegisterExtensions(
+				wasmkeeper.NewWasmSnapshotter(app.CommitMultiStore(), &app.WasmKeeper),
+			); err != nil {
+				panic(fmt.Errorf("failed to register snapshot extension: %%s", err))
+			}
+		}
+
+		%[1]v`
+		replacementSnapshot := fmt.Sprintf(templateSnapshot, module.PlaceholderSgAppBeforeInitReturn)
+		content = replacer.Replace(content, module.PlaceholderSgAppBeforeInitReturn, replacementSnapshot)
+
 		newFile := genny.NewFileS(path, content)
 		return r.File(newFile)
 	}
@@ -156,18 +209,12 @@ func cmdModifyStargate(replacer placeholder.Replacer, opts *ImportOptions) genny
 
 		// add wasm import
 		templateImport := `%[1]v
-		"github.com/tendermint/spm-extras/wasmcmd"`
+		"github.com/CosmWasm/wasmd/x/wasm"`
 		replacementImport := fmt.Sprintf(templateImport, module.PlaceholderSgRootModuleImport)
 		content := replacer.Replace(f.String(), module.PlaceholderSgRootModuleImport, replacementImport)
 
-		// add wasm command
-		templateCommands := `wasmcmd.GenesisWasmMsgCmd(app.DefaultNodeHome),
-		%[1]v`
-		replacementCommands := fmt.Sprintf(templateCommands, module.PlaceholderSgRootCommands)
-		content = replacer.Replace(content, module.PlaceholderSgRootCommands, replacementCommands)
-
 		// add wasm start args
-		templateArgs := `wasmcmd.AddModuleInitFlags(startCmd)
+		templateArgs := `wasm.AddModuleInitFlags(startCmd)
 		%[1]v`
 		replacementArgs := fmt.Sprintf(templateArgs, module.PlaceholderSgRootArgument)
 		content = replacer.Replace(content, module.PlaceholderSgRootArgument, replacementArgs)
@@ -176,3 +223,145 @@ func cmdModifyStargate(replacer placeholder.Replacer, opts *ImportOptions) genny
 		return r.File(newFile)
 	}
 }
+
+// config.yml modification when importing wasm
+func configModify(opts *ImportOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "config.yml")
+		f, err := r.Disk.Find(path)
+		if os.IsNotExist(err) {
+			// Apps using a custom config file must define the wasm defaults manually
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		content, err := setWasmDefaults(f.String())
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// wasmDefaults are the default wasm app.toml options of the validators.
+var wasmDefaults = []string{
+	"wasm:",
+	"  query_gas_limit: 300000",
+	"  memory_cache_size: 100",
+}
+
+// setWasmDefaults adds the default wasm app.toml options to the validators of
+// the config that don't define them. The options are inserted in the text of
+// the config, so its comments and formatting are kept.
+func setWasmDefaults(config string) (string, error) {
+	file, err := parser.ParseBytes([]byte(config), 0)
+	if err != nil {
+		return "", err
+	}
+
+	lines := strings.SplitAfter(config, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	// the options are inserted after the last line of the block starting at
+	// the line, the block ends with the first line indented less than the block.
+	inserts := make(map[int][]string)
+	insert := func(line, indent int, block []string) {
+		end := line - 1
+		for i := line; i < len(lines); i++ {
+			trimmed := strings.TrimSpace(lines[i])
+			if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+				continue
+			}
+			if len(lines[i])-len(strings.TrimLeft(lines[i], " ")) < indent {
+				break
+			}
+			end = i
+		}
+		for _, l := range block {
+			inserts[end] = append(inserts[end], strings.Repeat(" ", indent)+l+"\n")
+		}
+	}
+
+	for _, doc := range file.Docs {
+		docValues, _ := blockMappingValues(doc.Body)
+		validators, ok := findMappingValue(docValues, "validators").(*ast.SequenceNode)
+		if !ok || validators.IsFlowStyle {
+			continue
+		}
+
+		for _, v := range validators.Values {
+			validator, ok := blockMappingValues(v)
+			if !ok || len(validator) == 0 {
+				continue
+			}
+
+			app := findMappingValueNode(validator, "app")
+			if app == nil {
+				pos := validator[0].Key.GetToken().Position
+				insert(pos.Line, pos.Column-1, append([]string{"app:"}, indentLines(wasmDefaults)...))
+				continue
+			}
+
+			appValues, ok := blockMappingValues(app.Value)
+			if !ok || len(appValues) == 0 || findMappingValueNode(appValues, "wasm") != nil {
+				continue
+			}
+			pos := app.Key.GetToken().Position
+			insert(pos.Line, appValues[0].Key.GetToken().Position.Column-1, wasmDefaults)
+		}
+	}
+
+	var b strings.Builder
+	for i, line := range lines {
+		if _, ok := inserts[i]; ok && !strings.HasSuffix(line, "\n") {
+			line += "\n"
+		}
+		b.WriteString(line)
+		for _, l := range inserts[i] {
+			b.WriteString(l)
+		}
+	}
+	return b.String(), nil
+}
+
+// blockMappingValues returns the key values of a block mapping node.
+func blockMappingValues(n ast.Node) ([]*ast.MappingValueNode, bool) {
+	switch n := n.(type) {
+	case *ast.MappingNode:
+		return n.Values, !n.IsFlowStyle
+	case *ast.MappingValueNode:
+		return []*ast.MappingValueNode{n}, true
+	default:
+		return nil, false
+	}
+}
+
+func findMappingValueNode(values []*ast.MappingValueNode, key string) *ast.MappingValueNode {
+	for _, v := range values {
+		if v.Key.GetToken().Value == key {
+			return v
+		}
+	}
+	return nil
+}
+
+func findMappingValue(values []*ast.MappingValueNode, key string) ast.Node {
+	if v := findMappingValueNode(values, key); v != nil {
+		return v.Value
+	}
+	return nil
+}
+
+func indentLines(lines []string) []string {
+	indented := make([]string, len(lines))
+	for i, l := range lines {
+		indented[i] = "  " + l
+	}
+	return indented
+}
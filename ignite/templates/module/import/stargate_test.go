@@ -0,0 +1,133 @@
+package moduleimport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetWasmDefaults(t *testing.T) {
+	cases := []struct {
+		name   string
+		config string
+		want   string
+	}{
+		{
+			name: "without app config",
+			config: `version: 1
+# the accounts of the chain
+accounts:
+  - name: alice
+    coins: ["20000token", "200000000stake"]
+validators:
+  - name: alice
+    bonded: "100000000stake" # self-delegation
+
+  # the second validator
+  - name: bob
+faucet:
+  name: bob
+`,
+			want: `version: 1
+# the accounts of the chain
+accounts:
+  - name: alice
+    coins: ["20000token", "200000000stake"]
+validators:
+  - name: alice
+    bonded: "100000000stake" # self-delegation
+    app:
+      wasm:
+        query_gas_limit: 300000
+        memory_cache_size: 100
+
+  # the second validator
+  - name: bob
+    app:
+      wasm:
+        query_gas_limit: 300000
+        memory_cache_size: 100
+faucet:
+  name: bob
+`,
+		},
+		{
+			name: "with app config",
+			config: `validators:
+  - name: alice
+    app:
+      # the gas prices of the node
+      minimum-gas-prices: 0stake
+    config:
+      moniker: alice`,
+			want: `validators:
+  - name: alice
+    app:
+      # the gas prices of the node
+      minimum-gas-prices: 0stake
+      wasm:
+        query_gas_limit: 300000
+        memory_cache_size: 100
+    config:
+      moniker: alice`,
+		},
+		{
+			name: "with wasm config",
+			config: `validators:
+  - name: alice
+    app:
+      wasm:
+        query_gas_limit: 1000
+`,
+			want: `validators:
+  - name: alice
+    app:
+      wasm:
+        query_gas_limit: 1000
+`,
+		},
+		{
+			name:   "without validators",
+			config: "version: 1\n",
+			want:   "version: 1\n",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := setWasmDefaults(tt.config)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSetAnteFeegrantKeeper(t *testing.T) {
+	cases := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "without fee grant keeper",
+			content: "ante.HandlerOptions{\n\t\tSignModeHandler: handler,\n\t\tSigGasConsumer:  consumer,\n\t}",
+			want:    "ante.HandlerOptions{\n\t\tSignModeHandler: handler,\n\t\tFeegrantKeeper: app.FeeGrantKeeper,\n\t\tSigGasConsumer:  consumer,\n\t}",
+		},
+		{
+			name:    "with nil fee grant keeper",
+			content: "ante.HandlerOptions{\n\t\tSignModeHandler: handler,\n\t\tFeegrantKeeper:  nil,\n\t}",
+			want:    "ante.HandlerOptions{\n\t\tSignModeHandler: handler,\n\t\tFeegrantKeeper:  app.FeeGrantKeeper,\n\t}",
+		},
+		{
+			name:    "with fee grant keeper",
+			content: "ante.HandlerOptions{\n\t\tSignModeHandler: handler,\n\t\tFeegrantKeeper:  keeper,\n\t}",
+			want:    "ante.HandlerOptions{\n\t\tSignModeHandler: handler,\n\t\tFeegrantKeeper:  keeper,\n\t}",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, setAnteFeegrantKeeper(tt.content))
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package module
+
+import (
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xast"
+)
+
+// The app.go placeholders are injected from the syntax tree of app.go when they
+// are missing, the injection points are the places of the placeholders in the
+// app.go of the scaffolded chains.
+func init() {
+	registerGoInjection(PlaceholderSgAppModuleImport, xast.ImportBlock())
+	registerGoInjection(PlaceholderSgAppModuleBasic, xast.CallArgs("", "module.NewBasicManager", 0))
+	registerGoInjection(PlaceholderSgAppKeeperDeclaration, xast.StructFields("App"))
+	registerGoInjection(PlaceholderSgAppStoreKey, xast.CallArgs("New", "sdk.NewKVStoreKeys", 0))
+	registerGoInjection(PlaceholderSgAppMemStoreKey, xast.CallArgs("New", "sdk.NewMemoryStoreKeys", 0))
+	registerGoInjection(PlaceholderSgAppScopedKeeper, xast.AfterCall("New", "ScopeToModule"))
+	registerGoInjection(PlaceholderSgAppGovRouter, xast.BeforeCall("New", "govkeeper.NewKeeper"))
+	registerGoInjection(PlaceholderSgAppKeeperDefinition, xast.BeforeCall("New", "CapabilityKeeper.Seal"))
+	registerGoInjection(PlaceholderIBCAppMiddleware, xast.BeforeCall("New", "CapabilityKeeper.Seal"))
+	registerGoInjection(PlaceholderIBCAppRouter, xast.BeforeCall("New", "IBCKeeper.SetRouter"))
+	registerGoInjection(
+		PlaceholderSgAppAppModule,
+		xast.CallArgs("New", "module.NewManager", 0),
+		xast.CallArgs("New", "module.NewSimulationManager", 0),
+	)
+	registerGoInjection(PlaceholderSgAppBeginBlockers, xast.CallArgs("New", "SetOrderBeginBlockers", 0))
+	registerGoInjection(PlaceholderSgAppEndBlockers, xast.CallArgs("New", "SetOrderEndBlockers", 0))
+	registerGoInjection(PlaceholderSgAppInitGenesis, xast.CallArgs("New", "SetOrderInitGenesis", 0))
+	registerGoInjection(PlaceholderSgAppUpgrades, xast.BeforeCall("New", "LoadLatestVersion"))
+	registerGoInjection(PlaceholderSgAppBeforeInitReturn, xast.BeforeReturn("New"))
+	registerGoInjection(PlaceholderSgAppMaccPerms, xast.CompositeLit("maccPerms"))
+	registerGoInjection(PlaceholderSgAppParamSubspace, xast.BeforeReturn("initParamsKeeper"))
+	registerGoInjection(PlaceholderSgAppGovProposalHandlers, xast.BeforeCall("getGovProposalHandlers", "append"))
+	registerGoInjection(PlaceholderSgWasmAppEnabledProposals, xast.BeforeFunc("getGovProposalHandlers"))
+}
+
+// registerGoInjection registers the injection of a placeholder of a Go file.
+// The first point is used when the placeholder is replaced once and all the
+// points when every occurrence of the placeholder is replaced.
+func registerGoInjection(p string, points ...xast.InjectionPoint) {
+	placeholder.RegisterInjection(p, func(content, snippet string, all bool) (string, error) {
+		if !all {
+			return xast.Inject(content, snippet, points[0])
+		}
+		return xast.Inject(content, snippet, points...)
+	})
+}
@@ -0,0 +1,25 @@
+package module
+
+import (
+	"strings"
+
+	"github.com/gobuffalo/genny"
+)
+
+// modernImports replaces the import paths used by the templates with the ones
+// of the Cosmos SDK versions that support the modern app wiring.
+var modernImports = strings.NewReplacer(
+	`"github.com/tendermint/tendermint/`, `"github.com/cometbft/cometbft/`,
+	`"github.com/tendermint/tm-db"`, `"github.com/cometbft/cometbft-db"`,
+	`"github.com/gogo/protobuf/`, `"github.com/cosmos/gogoproto/`,
+	`"github.com/regen-network/cosmos-proto"`, `"github.com/cosmos/cosmos-proto"`,
+	`"github.com/cosmos/ibc-go/v5/`, `"github.com/cosmos/ibc-go/v7/`,
+)
+
+// ModernImportsTransformer updates the import paths of the generated Go files
+// for apps that use the modern app wiring, which requires Cosmos SDK v0.47 or newer.
+func ModernImportsTransformer() genny.Transformer {
+	return genny.NewTransformer(".go", func(f genny.File) (genny.File, error) {
+		return genny.NewFileS(f.Name(), modernImports.Replace(f.String())), nil
+	})
+}
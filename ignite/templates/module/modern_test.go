@@ -0,0 +1,45 @@
+package module
+
+import (
+	"testing"
+
+	"github.com/gobuffalo/genny"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModernImportsTransformer(t *testing.T) {
+	cases := []struct {
+		name string
+		file genny.File
+		want string
+	}{
+		{
+			name: "go file",
+			file: genny.NewFileS("x/foo/module.go", `import (
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+	"github.com/gogo/protobuf/proto"
+	"github.com/cosmos/ibc-go/v5/modules/core/exported"
+)`),
+			want: `import (
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)`,
+		},
+		{
+			name: "other file",
+			file: genny.NewFileS("README.md", `"github.com/tendermint/tendermint/abci/types"`),
+			want: `"github.com/tendermint/tendermint/abci/types"`,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := ModernImportsTransformer().Transform(tt.file)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, f.String())
+		})
+	}
+}
@@ -10,6 +10,7 @@ const (
 	PlaceholderSgAppModuleBasic         = "// this line is used by starport scaffolding # stargate/app/moduleBasic"
 	PlaceholderSgAppKeeperDeclaration   = "// this line is used by starport scaffolding # stargate/app/keeperDeclaration"
 	PlaceholderSgAppStoreKey            = "// this line is used by starport scaffolding # stargate/app/storeKey"
+	PlaceholderSgAppMemStoreKey         = "// this line is used by starport scaffolding # stargate/app/memStoreKey"
 	PlaceholderSgAppKeeperDefinition    = "// this line is used by starport scaffolding # stargate/app/keeperDefinition"
 	PlaceholderSgAppAppModule           = "// this line is used by starport scaffolding # stargate/app/appModule"
 	PlaceholderSgAppInitGenesis         = "// this line is used by starport scaffolding # stargate/app/initGenesis"
@@ -17,9 +18,11 @@ const (
 	PlaceholderSgAppEndBlockers         = "// this line is used by starport scaffolding # stargate/app/endBlockers"
 	PlaceholderSgAppParamSubspace       = "// this line is used by starport scaffolding # stargate/app/paramSubspace"
 	PlaceholderSgAppGovProposalHandlers = "// this line is used by starport scaffolding # stargate/app/govProposalHandlers"
+	PlaceholderSgAppGovRouter           = "// this line is used by starport scaffolding # stargate/app/govRouter"
 	PlaceholderSgAppScopedKeeper        = "// this line is used by starport scaffolding # stargate/app/scopedKeeper"
 	PlaceholderSgAppBeforeInitReturn    = "// this line is used by starport scaffolding # stargate/app/beforeInitReturn"
 	PlaceholderSgAppMaccPerms           = "// this line is used by starport scaffolding # stargate/app/maccPerms"
+	PlaceholderSgAppUpgrades            = "// this line is used by starport scaffolding # stargate/app/upgrades"
 
 	// Placeholders in Stargate app.go for wasm
 	PlaceholderSgWasmAppEnabledProposals = "// this line is used by starport scaffolding # stargate/wasm/app/enabledProposals"
@@ -36,6 +39,7 @@ const (
 	PlaceholderIBCAppScopedKeeperDefinition  = "// this line is used by starport scaffolding # ibc/app/scopedKeeper/definition"
 	PlaceholderIBCAppKeeperArgument          = "// this line is used by starport scaffolding # ibc/app/keeper/argument"
 	PlaceholderIBCAppRouter                  = "// this line is used by starport scaffolding # ibc/app/router"
+	PlaceholderIBCAppMiddleware              = "// this line is used by starport scaffolding # ibc/app/middleware"
 
 	// Genesis test
 	PlaceholderTypesGenesisTestcase   = "// this line is used by starport scaffolding # types/genesis/testcase"
@@ -0,0 +1,266 @@
+package nft
+
+import (
+	"embed"
+	"fmt"
+	"path/filepath"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush/v4"
+
+	"github.com/ignite/cli/ignite/pkg/gomodulepath"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/field/plushhelpers"
+	"github.com/ignite/cli/ignite/templates/module"
+	"github.com/ignite/cli/ignite/templates/testutil"
+	"github.com/ignite/cli/ignite/templates/typed"
+)
+
+//go:embed files/* files/**/*
+var fsNFT embed.FS
+
+// Options are the options to scaffold the NFT logic in a new module.
+type Options struct {
+	AppName    string
+	AppPath    string
+	ModuleName string
+	ModulePath string
+}
+
+// NewGenerator returns the generator to scaffold the NFT logic in a module
+// created with the nft module as a dependency: the messages to create classes
+// with the schema of the metadata of their NFTs, to mint and to transfer NFTs,
+// and the queries of the classes and the NFTs with their data.
+func NewGenerator(replacer placeholder.Replacer, opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+
+	ctx := plush.NewContext()
+	ctx.Set("moduleName", opts.ModuleName)
+	ctx.Set("modulePath", opts.ModulePath)
+	ctx.Set("appName", opts.AppName)
+	ctx.Set("protoPkgName", module.ProtoPackageName(gomodulepath.ExtractAppPath(opts.ModulePath), opts.ModuleName))
+	plushhelpers.ExtendPlushContext(ctx)
+
+	g.RunFn(protoTxModify(replacer, opts))
+	g.RunFn(protoQueryModify(replacer, opts))
+	g.RunFn(typesCodecModify(replacer, opts))
+	g.RunFn(clientCliTxModify(replacer, opts))
+	g.RunFn(clientCliQueryModify(replacer, opts))
+
+	g.Transformer(xgenny.Transformer(ctx))
+	g.Transformer(genny.Replace("{{appName}}", opts.AppName))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+
+	if err := xgenny.Box(g, xgenny.NewEmbedWalker(fsNFT, "files/", opts.AppPath)); err != nil {
+		return g, err
+	}
+
+	// Create the 'testutil' package with the test helpers
+	return g, testutil.Register(g, opts.AppPath)
+}
+
+func protoTxModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.AppName, opts.ModuleName, "tx.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		templateRPC := `  rpc CreateClass(MsgCreateClass) returns (MsgCreateClassResponse);
+  rpc Mint(MsgMint) returns (MsgMintResponse);
+  rpc Transfer(MsgTransfer) returns (MsgTransferResponse);
+%[1]v`
+		replacementRPC := fmt.Sprintf(templateRPC, typed.PlaceholderProtoTxRPC)
+		content := replacer.Replace(f.String(), typed.PlaceholderProtoTxRPC, replacementRPC)
+
+		templateMessage := `// MsgCreateClass creates a class of NFTs, the metadata of its NFTs follows the
+// schema of the class.
+message MsgCreateClass {
+  string creator = 1;
+  string id = 2;
+  string name = 3;
+  string symbol = 4;
+  string description = 5;
+  string uri = 6;
+  string schema = 7;
+}
+
+message MsgCreateClassResponse {}
+
+// MsgMint mints an NFT of a class created by the creator.
+message MsgMint {
+  string creator = 1;
+  string classId = 2;
+  string id = 3;
+  string uri = 4;
+  string metadata = 5;
+  string receiver = 6;
+}
+
+message MsgMintResponse {}
+
+// MsgTransfer transfers an NFT owned by the creator.
+message MsgTransfer {
+  string creator = 1;
+  string classId = 2;
+  string id = 3;
+  string receiver = 4;
+}
+
+message MsgTransferResponse {}
+
+%[1]v`
+		replacementMessage := fmt.Sprintf(templateMessage, typed.PlaceholderProtoTxMessage)
+		content = replacer.Replace(content, typed.PlaceholderProtoTxMessage, replacementMessage)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func protoQueryModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.AppName, opts.ModuleName, "query.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		templateImport := `import "%[2]v/%[3]v/nft.proto";
+%[1]v`
+		replacementImport := fmt.Sprintf(templateImport, typed.Placeholder, opts.AppName, opts.ModuleName)
+		content := replacer.Replace(f.String(), typed.Placeholder, replacementImport)
+
+		templateService := `// Queries a class with its schema and its supply.
+	rpc Class(QueryGetClassRequest) returns (QueryGetClassResponse) {
+		option (google.api.http).get = "/%[2]v/%[3]v/class/{id}";
+	}
+
+	// Queries an NFT with its metadata and its owner.
+	rpc NFT(QueryGetNFTRequest) returns (QueryGetNFTResponse) {
+		option (google.api.http).get = "/%[2]v/%[3]v/nft/{classId}/{id}";
+	}
+
+	// Queries the NFTs of a class owned by an address.
+	rpc NFTsOfOwner(QueryNFTsOfOwnerRequest) returns (QueryNFTsOfOwnerResponse) {
+		option (google.api.http).get = "/%[2]v/%[3]v/nfts/{classId}/{owner}";
+	}
+
+%[1]v`
+		replacementService := fmt.Sprintf(templateService,
+			typed.Placeholder2,
+			gomodulepath.ExtractAppPath(opts.ModulePath),
+			opts.ModuleName,
+		)
+		content = replacer.Replace(content, typed.Placeholder2, replacementService)
+
+		templateMessage := `message QueryGetClassRequest {
+  string id = 1;
+}
+
+message QueryGetClassResponse {
+  ClassInfo class = 1 [(gogoproto.nullable) = false];
+}
+
+message QueryGetNFTRequest {
+  string classId = 1;
+  string id = 2;
+}
+
+message QueryGetNFTResponse {
+  NFTInfo nft = 1 [(gogoproto.nullable) = false];
+}
+
+message QueryNFTsOfOwnerRequest {
+  string classId = 1;
+  string owner = 2;
+}
+
+message QueryNFTsOfOwnerResponse {
+  repeated NFTInfo nft = 1 [(gogoproto.nullable) = false];
+}
+
+%[1]v`
+		replacementMessage := fmt.Sprintf(templateMessage, typed.Placeholder3)
+		content = replacer.Replace(content, typed.Placeholder3, replacementMessage)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// typesCodecModify registers the messages of the module and the data of the
+// classes and of the NFTs, the data are packed in the classes and the NFTs
+// of the nft module.
+func typesCodecModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/codec.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		replacementImport := `sdk "github.com/cosmos/cosmos-sdk/types"`
+		content := replacer.ReplaceOnce(f.String(), typed.Placeholder, replacementImport)
+
+		templateRegisterConcrete := `cdc.RegisterConcrete(&MsgCreateClass{}, "%[2]v/CreateClass", nil)
+cdc.RegisterConcrete(&MsgMint{}, "%[2]v/Mint", nil)
+cdc.RegisterConcrete(&MsgTransfer{}, "%[2]v/Transfer", nil)
+%[1]v`
+		replacementRegisterConcrete := fmt.Sprintf(templateRegisterConcrete, typed.Placeholder2, opts.ModuleName)
+		content = replacer.Replace(content, typed.Placeholder2, replacementRegisterConcrete)
+
+		templateRegisterImplementations := `registry.RegisterImplementations((*sdk.Msg)(nil),
+	&MsgCreateClass{},
+	&MsgMint{},
+	&MsgTransfer{},
+)
+registry.RegisterImplementations((*codec.ProtoMarshaler)(nil),
+	&ClassData{},
+	&NFTData{},
+)
+%[1]v`
+		replacementRegisterImplementations := fmt.Sprintf(templateRegisterImplementations, typed.Placeholder3)
+		content = replacer.Replace(content, typed.Placeholder3, replacementRegisterImplementations)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func clientCliTxModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "client/cli/tx.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		template := `cmd.AddCommand(CmdCreateClass())
+	cmd.AddCommand(CmdMint())
+	cmd.AddCommand(CmdTransfer())
+%[1]v`
+		replacement := fmt.Sprintf(template, typed.Placeholder)
+		content := replacer.Replace(f.String(), typed.Placeholder, replacement)
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func clientCliQueryModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "client/cli/query.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		template := `cmd.AddCommand(CmdShowClass())
+	cmd.AddCommand(CmdShowNFT())
+	cmd.AddCommand(CmdListNFTOfOwner())
+%[1]v`
+		replacement := fmt.Sprintf(template, typed.Placeholder)
+		content := replacer.Replace(f.String(), typed.Placeholder, replacement)
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
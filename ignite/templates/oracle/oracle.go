@@ -0,0 +1,390 @@
+package oracle
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush/v4"
+
+	"github.com/ignite/cli/ignite/pkg/gomodulepath"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/field/plushhelpers"
+	"github.com/ignite/cli/ignite/templates/module"
+	"github.com/ignite/cli/ignite/templates/testutil"
+	"github.com/ignite/cli/ignite/templates/typed"
+)
+
+var (
+	//go:embed files/* files/**/*
+	fsOracle embed.FS
+
+	//go:embed overrides/* overrides/**/*
+	fsOverrides embed.FS
+)
+
+// endBlock is the end blocker of a module created without logic.
+const endBlock = `func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	return []abci.ValidatorUpdate{}
+}`
+
+// Options are the options to scaffold the oracle logic in a new module.
+type Options struct {
+	AppName         string
+	AppPath         string
+	ModuleName      string
+	ModulePath      string
+	ModernAppWiring bool
+}
+
+// NewGenerator returns the generator to scaffold the oracle logic in a module
+// created with the window and minSubmissions params: the feeders param, the prices
+// submitted by the feeders, their aggregation at the end of each window and
+// a reference feeder daemon.
+func NewGenerator(replacer placeholder.Replacer, opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+
+	ctx := plush.NewContext()
+	ctx.Set("moduleName", opts.ModuleName)
+	ctx.Set("modulePath", opts.ModulePath)
+	ctx.Set("appName", opts.AppName)
+	ctx.Set("apiPath", fmt.Sprintf("/%s/%s", gomodulepath.ExtractAppPath(opts.ModulePath), opts.ModuleName))
+	ctx.Set("protoPkgName", module.ProtoPackageName(gomodulepath.ExtractAppPath(opts.ModulePath), opts.ModuleName))
+	plushhelpers.ExtendPlushContext(ctx)
+
+	g.RunFn(overridesRender(ctx, opts))
+	g.RunFn(protoTxModify(replacer, opts))
+	g.RunFn(protoQueryModify(replacer, opts))
+	g.RunFn(genesisProtoModify(replacer, opts))
+	g.RunFn(genesisTypesModify(replacer, opts))
+	g.RunFn(genesisModuleModify(replacer, opts))
+	g.RunFn(typesCodecModify(replacer, opts))
+	g.RunFn(clientCliTxModify(replacer, opts))
+	g.RunFn(clientCliQueryModify(replacer, opts))
+	g.RunFn(moduleModify(opts))
+
+	g.Transformer(xgenny.Transformer(ctx))
+	g.Transformer(genny.Replace("{{appName}}", opts.AppName))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+	if opts.ModernAppWiring {
+		g.Transformer(module.ModernImportsTransformer())
+	}
+
+	if err := xgenny.Box(g, xgenny.NewEmbedWalker(fsOracle, "files/", opts.AppPath)); err != nil {
+		return g, err
+	}
+
+	// Create the 'testutil' package with the test helpers
+	return g, testutil.Register(g, opts.AppPath)
+}
+
+// overridesRender replaces the files of the module created for the oracle, like
+// the params, that are generic in a new module.
+func overridesRender(ctx *plush.Context, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		return fs.WalkDir(fsOverrides, "overrides", func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+
+			template, err := fsOverrides.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			content, err := plush.Render(string(template), ctx)
+			if err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+
+			name := strings.TrimSuffix(strings.TrimPrefix(path, "overrides/"), ".plush")
+			name = strings.NewReplacer(
+				"{{appName}}", opts.AppName,
+				"{{moduleName}}", opts.ModuleName,
+			).Replace(name)
+			return r.File(genny.NewFileS(filepath.Join(opts.AppPath, name), content))
+		})
+	}
+}
+
+func protoTxModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.AppName, opts.ModuleName, "tx.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		templateRPC := `  rpc SubmitPrice(MsgSubmitPrice) returns (MsgSubmitPriceResponse);
+%[1]v`
+		replacementRPC := fmt.Sprintf(templateRPC, typed.PlaceholderProtoTxRPC)
+		content := replacer.Replace(f.String(), typed.PlaceholderProtoTxRPC, replacementRPC)
+
+		templateMessage := `// MsgSubmitPrice submits the price of a symbol for the current aggregation window.
+message MsgSubmitPrice {
+  string creator = 1;
+  string symbol = 2;
+  string price = 3;
+}
+
+message MsgSubmitPriceResponse {}
+
+%[1]v`
+		replacementMessage := fmt.Sprintf(templateMessage, typed.PlaceholderProtoTxMessage)
+		content = replacer.Replace(content, typed.PlaceholderProtoTxMessage, replacementMessage)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func protoQueryModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.AppName, opts.ModuleName, "query.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		templateImport := `import "%[2]v/%[3]v/oracle.proto";
+%[1]v`
+		replacementImport := fmt.Sprintf(templateImport, typed.Placeholder, opts.AppName, opts.ModuleName)
+		content := replacer.Replace(f.String(), typed.Placeholder, replacementImport)
+
+		templateService := `// Queries the aggregated price of a symbol.
+	rpc Price(QueryGetPriceRequest) returns (QueryGetPriceResponse) {
+		option (google.api.http).get = "/%[2]v/%[3]v/price/{symbol}";
+	}
+
+	// Queries the list of the aggregated prices.
+	rpc PriceAll(QueryAllPriceRequest) returns (QueryAllPriceResponse) {
+		option (google.api.http).get = "/%[2]v/%[3]v/price";
+	}
+
+%[1]v`
+		replacementService := fmt.Sprintf(templateService,
+			typed.Placeholder2,
+			gomodulepath.ExtractAppPath(opts.ModulePath),
+			opts.ModuleName,
+		)
+		content = replacer.Replace(content, typed.Placeholder2, replacementService)
+
+		templateMessage := `message QueryGetPriceRequest {
+  string symbol = 1;
+}
+
+message QueryGetPriceResponse {
+  Price price = 1 [(gogoproto.nullable) = false];
+}
+
+message QueryAllPriceRequest {
+  cosmos.base.query.v1beta1.PageRequest pagination = 1;
+}
+
+message QueryAllPriceResponse {
+  repeated Price price = 1 [(gogoproto.nullable) = false];
+  cosmos.base.query.v1beta1.PageResponse pagination = 2;
+}
+
+%[1]v`
+		replacementMessage := fmt.Sprintf(templateMessage, typed.Placeholder3)
+		content = replacer.Replace(content, typed.Placeholder3, replacementMessage)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func genesisProtoModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.AppName, opts.ModuleName, "genesis.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		templateProtoImport := `import "%[2]v/%[3]v/oracle.proto";
+%[1]v`
+		replacementProtoImport := fmt.Sprintf(
+			templateProtoImport,
+			typed.PlaceholderGenesisProtoImport,
+			opts.AppName,
+			opts.ModuleName,
+		)
+		content := replacer.Replace(f.String(), typed.PlaceholderGenesisProtoImport, replacementProtoImport)
+
+		// The oracle state follows the params of the new module
+		templateProtoState := `repeated Price priceList = 2 [(gogoproto.nullable) = false];
+  repeated Submission submissionList = 3 [(gogoproto.nullable) = false];
+  %[1]v`
+		replacementProtoState := fmt.Sprintf(templateProtoState, typed.PlaceholderGenesisProtoState)
+		content = replacer.Replace(content, typed.PlaceholderGenesisProtoState, replacementProtoState)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func genesisTypesModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/genesis.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content := typed.PatchGenesisTypeImport(replacer, f.String())
+
+		templateTypesImport := `"fmt"`
+		content = replacer.ReplaceOnce(content, typed.PlaceholderGenesisTypesImport, templateTypesImport)
+
+		templateTypesDefault := `PriceList: []Price{},
+SubmissionList: []Submission{},
+%[1]v`
+		replacementTypesDefault := fmt.Sprintf(templateTypesDefault, typed.PlaceholderGenesisTypesDefault)
+		content = replacer.Replace(content, typed.PlaceholderGenesisTypesDefault, replacementTypesDefault)
+
+		templateTypesValidate := `// Check for duplicated symbol in price
+priceIndexMap := make(map[string]struct{})
+
+for _, elem := range gs.PriceList {
+	index := string(PriceKey(elem.Symbol))
+	if _, ok := priceIndexMap[index]; ok {
+		return fmt.Errorf("duplicated symbol for price")
+	}
+	priceIndexMap[index] = struct{}{}
+}
+// Check for duplicated feeder submission
+submissionIndexMap := make(map[string]struct{})
+
+for _, elem := range gs.SubmissionList {
+	index := string(SubmissionKey(elem.Symbol, elem.Feeder))
+	if _, ok := submissionIndexMap[index]; ok {
+		return fmt.Errorf("duplicated feeder submission for %%s", elem.Symbol)
+	}
+	submissionIndexMap[index] = struct{}{}
+}
+%[1]v`
+		replacementTypesValidate := fmt.Sprintf(templateTypesValidate, typed.PlaceholderGenesisTypesValidate)
+		content = replacer.Replace(content, typed.PlaceholderGenesisTypesValidate, replacementTypesValidate)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func genesisModuleModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "genesis.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		templateModuleInit := `// Set all the price
+for _, elem := range genState.PriceList {
+	k.SetPrice(ctx, elem)
+}
+// Set all the submission
+for _, elem := range genState.SubmissionList {
+	k.SetSubmission(ctx, elem)
+}
+%[1]v`
+		replacementModuleInit := fmt.Sprintf(templateModuleInit, typed.PlaceholderGenesisModuleInit)
+		content := replacer.Replace(f.String(), typed.PlaceholderGenesisModuleInit, replacementModuleInit)
+
+		templateModuleExport := `genesis.PriceList = k.GetAllPrice(ctx)
+genesis.SubmissionList = k.GetAllSubmission(ctx)
+%[1]v`
+		replacementModuleExport := fmt.Sprintf(templateModuleExport, typed.PlaceholderGenesisModuleExport)
+		content = replacer.Replace(content, typed.PlaceholderGenesisModuleExport, replacementModuleExport)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func typesCodecModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/codec.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		replacementImport := `sdk "github.com/cosmos/cosmos-sdk/types"`
+		content := replacer.ReplaceOnce(f.String(), typed.Placeholder, replacementImport)
+
+		templateRegisterConcrete := `cdc.RegisterConcrete(&MsgSubmitPrice{}, "%[2]v/SubmitPrice", nil)
+%[1]v`
+		replacementRegisterConcrete := fmt.Sprintf(templateRegisterConcrete, typed.Placeholder2, opts.ModuleName)
+		content = replacer.Replace(content, typed.Placeholder2, replacementRegisterConcrete)
+
+		templateRegisterImplementations := `registry.RegisterImplementations((*sdk.Msg)(nil),
+	&MsgSubmitPrice{},
+)
+%[1]v`
+		replacementRegisterImplementations := fmt.Sprintf(templateRegisterImplementations, typed.Placeholder3)
+		content = replacer.Replace(content, typed.Placeholder3, replacementRegisterImplementations)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func clientCliTxModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "client/cli/tx.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		template := `cmd.AddCommand(CmdSubmitPrice())
+%[1]v`
+		replacement := fmt.Sprintf(template, typed.Placeholder)
+		content := replacer.Replace(f.String(), typed.Placeholder, replacement)
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func clientCliQueryModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "client/cli/query.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		template := `cmd.AddCommand(CmdListPrice())
+	cmd.AddCommand(CmdShowPrice())
+%[1]v`
+		replacement := fmt.Sprintf(template, typed.Placeholder)
+		content := replacer.Replace(f.String(), typed.Placeholder, replacement)
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// moduleModify aggregates the prices in the end blocker of the module.
+func moduleModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "module.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		if !strings.Contains(f.String(), endBlock) {
+			return fmt.Errorf("%s: the end blocker of the module is not empty", path)
+		}
+
+		replacement := `func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.keeper.AggregatePrices(ctx)
+	return []abci.ValidatorUpdate{}
+}`
+		content := strings.Replace(f.String(), endBlock, replacement, 1)
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
@@ -16,4 +16,7 @@ type Options struct {
 	ResFields   field.Fields
 	ReqFields   field.Fields
 	Paginated   bool
+
+	// Route is the REST route of the query, DefaultRoute is used when it's empty.
+	Route string
 }
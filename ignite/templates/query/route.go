@@ -0,0 +1,70 @@
+package query
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/templates/field"
+	"github.com/ignite/cli/ignite/templates/field/datatype"
+)
+
+// routeParam matches the path parameters of a route, e.g. "{id}".
+var routeParam = regexp.MustCompile(`{([^{}]*)}`)
+
+// DefaultRoute returns the REST route of a query served by the API server.
+// The request fields that can be used as a path parameter are appended to the
+// route, the other ones like the repeated fields are passed as query parameters.
+func DefaultRoute(appModulePath, moduleName string, queryName multiformatname.Name, reqFields field.Fields) string {
+	route := path.Join("/", appModulePath, moduleName, queryName.Snake)
+	for _, f := range reqFields {
+		if isPathParam(f) {
+			route += fmt.Sprintf("/{%s}", f.ProtoFieldName())
+		}
+	}
+	return route
+}
+
+// CheckRoute checks that a custom REST route is valid for a query with the
+// request fields, its path parameters must be request fields.
+func CheckRoute(route string, reqFields field.Fields) error {
+	if !strings.HasPrefix(route, "/") {
+		return fmt.Errorf("route %s must start with /", route)
+	}
+	if strings.ContainsAny(route, "?# ") {
+		return fmt.Errorf("route %s must be a path without query or spaces", route)
+	}
+	if strings.Count(route, "{") != strings.Count(route, "}") {
+		return fmt.Errorf("route %s has unbalanced braces", route)
+	}
+
+	fields := make(map[string]field.Field, len(reqFields))
+	for _, f := range reqFields {
+		fields[f.ProtoFieldName()] = f
+	}
+	params := make(map[string]struct{})
+	for _, match := range routeParam.FindAllStringSubmatch(route, -1) {
+		name := match[1]
+		f, ok := fields[name]
+		if !ok {
+			return fmt.Errorf("route parameter {%s} is not a request field", name)
+		}
+		if !isPathParam(f) {
+			return fmt.Errorf("route parameter {%s} is of type %s and can't be a path parameter", name, f.DatatypeName)
+		}
+		if _, ok := params[name]; ok {
+			return fmt.Errorf("route parameter {%s} is used twice", name)
+		}
+		params[name] = struct{}{}
+	}
+	return nil
+}
+
+// isPathParam returns true if the field can be a path parameter of a route,
+// only the scalar fields can be, the others are passed as query parameters.
+func isPathParam(f field.Field) bool {
+	dt, ok := datatype.SupportedTypes[f.DatatypeName]
+	return ok && !dt.NonIndex
+}
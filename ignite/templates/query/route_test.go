@@ -0,0 +1,50 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/templates/field"
+)
+
+func TestDefaultRoute(t *testing.T) {
+	name, err := multiformatname.NewName("posts-by-author")
+	require.NoError(t, err)
+	fields, err := field.ParseFields([]string{"author", "tags:array.string", "min-height:uint", "fee:coin"}, func(string) error { return nil })
+	require.NoError(t, err)
+
+	require.Equal(t, "/test/mars/blog/posts_by_author/{author}/{minHeight}", DefaultRoute("test/mars", "blog", name, fields))
+	require.Equal(t, "/test/mars/blog/posts_by_author", DefaultRoute("test/mars", "blog", name, nil))
+}
+
+func TestCheckRoute(t *testing.T) {
+	fields, err := field.ParseFields([]string{"author", "id:uint", "tags:array.string"}, func(string) error { return nil })
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		route string
+		err   bool
+	}{
+		{name: "without parameters", route: "/blog/posts"},
+		{name: "with parameters", route: "/blog/{author}/posts/{id}"},
+		{name: "relative", route: "blog/posts", err: true},
+		{name: "with query", route: "/blog/posts?id={id}", err: true},
+		{name: "unbalanced braces", route: "/blog/posts/{id", err: true},
+		{name: "unknown parameter", route: "/blog/posts/{title}", err: true},
+		{name: "repeated parameter", route: "/blog/posts/{tags}", err: true},
+		{name: "parameter used twice", route: "/blog/{id}/posts/{id}", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckRoute(tt.route, fields)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
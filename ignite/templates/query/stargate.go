@@ -37,29 +37,25 @@ func protoQueryModify(replacer placeholder.Replacer, opts *Options) genny.RunFn
 			return err
 		}
 
-		// if the query has request fields, they are appended to the rpc query
-		var reqPath string
-		for _, field := range opts.ReqFields {
-			reqPath += "/"
-			reqPath = filepath.Join(reqPath, fmt.Sprintf("{%s}", field.ProtoFieldName()))
+		// the query is served by the API server on its route
+		appModulePath := gomodulepath.ExtractAppPath(opts.ModulePath)
+		route := opts.Route
+		if route == "" {
+			route = DefaultRoute(appModulePath, opts.ModuleName, opts.QueryName, opts.ReqFields)
 		}
 
 		// RPC service
 		templateRPC := `// Queries a list of %[2]v items.
 	rpc %[2]v(Query%[2]vRequest) returns (Query%[2]vResponse) {
-		option (google.api.http).get = "/%[3]v/%[4]v/%[5]v%[6]v";
+		option (google.api.http).get = "%[3]v";
 	}
 
 %[1]v`
-		appModulePath := gomodulepath.ExtractAppPath(opts.ModulePath)
 		replacementRPC := fmt.Sprintf(
 			templateRPC,
 			Placeholder2,
 			opts.QueryName.UpperCamel,
-			appModulePath,
-			opts.ModuleName,
-			opts.QueryName.Snake,
-			reqPath,
+			route,
 		)
 		content := replacer.Replace(f.String(), Placeholder2, replacementRPC)
 
@@ -69,7 +65,7 @@ func protoQueryModify(replacer placeholder.Replacer, opts *Options) genny.RunFn
 			reqFields += fmt.Sprintf("  %s;\n", field.ProtoType(i+1))
 		}
 		if opts.Paginated {
-			reqFields += fmt.Sprintf("cosmos.base.query.v1beta1.PageRequest pagination = %d;\n", len(opts.ReqFields)+1)
+			reqFields += fmt.Sprintf("  cosmos.base.query.v1beta1.PageRequest pagination = %d;\n", len(opts.ReqFields)+1)
 		}
 
 		// Fields for response
@@ -78,7 +74,7 @@ func protoQueryModify(replacer placeholder.Replacer, opts *Options) genny.RunFn
 			resFields += fmt.Sprintf("  %s;\n", field.ProtoType(i+1))
 		}
 		if opts.Paginated {
-			resFields += fmt.Sprintf("cosmos.base.query.v1beta1.PageResponse pagination = %d;\n", len(opts.ResFields)+1)
+			resFields += fmt.Sprintf("  cosmos.base.query.v1beta1.PageResponse pagination = %d;\n", len(opts.ResFields)+1)
 		}
 
 		// Ensure custom types are imported
@@ -8,11 +8,31 @@ import (
 	"github.com/ignite/cli/ignite/pkg/xgenny"
 )
 
-//go:embed stargate/* stargate/**/*
-var fsStargate embed.FS
+var (
+	//go:embed stargate/* stargate/**/*
+	fsStargate embed.FS
+
+	//go:embed legacy/* legacy/**/*
+	fsLegacy embed.FS
+
+	//go:embed modern/* modern/**/*
+	fsModern embed.FS
+)
 
 // Register testutil template using existing generator.
 // Register is meant to be used by modules that depend on this module.
 func Register(gen *genny.Generator, appPath string) error {
-	return xgenny.Box(gen, xgenny.NewEmbedWalker(fsStargate, "stargate/", appPath))
+	if err := xgenny.Box(gen, xgenny.NewEmbedWalker(fsStargate, "stargate/", appPath)); err != nil {
+		return err
+	}
+	return xgenny.Box(gen, xgenny.NewEmbedWalker(fsLegacy, "legacy/", appPath))
+}
+
+// RegisterModern registers the testutil template of the apps with the modern
+// app wiring, their test network is configured from the app config.
+func RegisterModern(gen *genny.Generator, appPath string) error {
+	if err := xgenny.Box(gen, xgenny.NewEmbedWalker(fsStargate, "stargate/", appPath)); err != nil {
+		return err
+	}
+	return xgenny.Box(gen, xgenny.NewEmbedWalker(fsModern, "modern/", appPath))
 }
@@ -0,0 +1,53 @@
+package testutil_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/gobuffalo/genny"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/templates/testutil"
+)
+
+func TestRegister(t *testing.T) {
+	tests := []struct {
+		name            string
+		register        func(*genny.Generator, string) error
+		expectedNetwork string
+	}{
+		{
+			name:            "legacy",
+			register:        testutil.Register,
+			expectedNetwork: `"github.com/cosmos/cosmos-sdk/simapp"`,
+		},
+		{
+			name:            "modern",
+			register:        testutil.RegisterModern,
+			expectedNetwork: "network.DefaultConfigWithAppConfig(app.AppConfig)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			appPath := t.TempDir()
+			g := genny.New()
+			require.NoError(t, tt.register(g, appPath))
+
+			r := genny.DryRunner(context.Background())
+			require.NoError(t, r.With(g))
+			require.NoError(t, r.Run())
+
+			files := make(map[string]string)
+			for _, f := range r.Results().Files {
+				rel, err := filepath.Rel(appPath, f.Name())
+				require.NoError(t, err)
+				files[filepath.ToSlash(rel)] = f.String()
+			}
+			require.Len(t, files, 3)
+			require.Contains(t, files, "testutil/sample/sample.go.plush")
+			require.Contains(t, files, "testutil/nullify/nullify.go.plush")
+			require.Contains(t, files["testutil/network/network.go.plush"], tt.expectedNetwork)
+		})
+	}
+}
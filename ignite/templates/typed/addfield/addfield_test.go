@@ -0,0 +1,175 @@
+package addfield
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/templates/field"
+)
+
+func parseFields(t *testing.T, fields ...string) field.Fields {
+	t.Helper()
+	parsed, err := field.ParseFields(fields, func(string) error { return nil })
+	require.NoError(t, err)
+	return parsed
+}
+
+func TestAppendProtoFields(t *testing.T) {
+	cases := []struct {
+		name    string
+		content string
+		message string
+		want    string
+		err     bool
+	}{
+		{
+			name:    "after the highest field number",
+			content: "message Post {\n  uint64 id = 1;\n  string creator = 3;\n  string title = 2;\n}\n",
+			message: "Post",
+			want:    "message Post {\n  uint64 id = 1;\n  string creator = 3;\n  string title = 2;\n  string body = 4;\n  uint64 views = 5;\n}\n",
+		},
+		{
+			name:    "with field options",
+			content: "message Post {\n  cosmos.base.v1beta1.Coin price = 7 [(gogoproto.nullable) = false];\n}\n",
+			message: "Post",
+			want:    "message Post {\n  cosmos.base.v1beta1.Coin price = 7 [(gogoproto.nullable) = false];\n  string body = 8;\n  uint64 views = 9;\n}\n",
+		},
+		{
+			name:    "in the right message",
+			content: "message PostList {\n  uint64 id = 4;\n}\n\nmessage Post {\n  uint64 id = 1;\n}\n",
+			message: "Post",
+			want:    "message PostList {\n  uint64 id = 4;\n}\n\nmessage Post {\n  uint64 id = 1;\n  string body = 2;\n  uint64 views = 3;\n}\n",
+		},
+		{
+			name:    "in a single line message",
+			content: "message Post {}\n",
+			message: "Post",
+			want:    "message Post {\n  string body = 1;\n  uint64 views = 2;\n}\n",
+		},
+		{
+			name:    "with nested message",
+			content: "message Post {\n  message Meta {\n    uint64 id = 5;\n  }\n  uint64 id = 1;\n}\n",
+			message: "Post",
+			want:    "message Post {\n  message Meta {\n    uint64 id = 5;\n  }\n  uint64 id = 1;\n  string body = 6;\n  uint64 views = 7;\n}\n",
+		},
+		{
+			name:    "without message",
+			content: "message Product {}\n",
+			message: "Post",
+			err:     true,
+		},
+	}
+
+	fields := parseFields(t, "body", "views:uint")
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := appendProtoFields(tt.content, tt.message, fields)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEnsureProtoImports(t *testing.T) {
+	content := "syntax = \"proto3\";\npackage mars.mars;\n\noption go_package = \"mars/x/mars/types\";\n"
+	content = ensureProtoImports(content, []string{"gogoproto/gogo.proto"})
+	require.Equal(t, "syntax = \"proto3\";\npackage mars.mars;\nimport \"gogoproto/gogo.proto\";\n\noption go_package = \"mars/x/mars/types\";\n", content)
+
+	content = ensureProtoImports(content, []string{"gogoproto/gogo.proto", "cosmos/base/v1beta1/coin.proto"})
+	require.Equal(t, "syntax = \"proto3\";\npackage mars.mars;\nimport \"gogoproto/gogo.proto\";\nimport \"cosmos/base/v1beta1/coin.proto\";\n\noption go_package = \"mars/x/mars/types\";\n", content)
+}
+
+func TestMsgServerAddFields(t *testing.T) {
+	content := `package keeper
+
+func (k msgServer) CreatePost(goCtx context.Context, msg *types.MsgCreatePost) (*types.MsgCreatePostResponse, error) {
+	var post = types.Post{
+		Creator: msg.Creator,
+		Title:   msg.Title,
+	}
+	id := k.AppendPost(ctx, post)
+	_ = types.EventDeletePost{Id: id}
+	_ = types.EventCreatePost{Id: id}
+	return &types.MsgCreatePostResponse{Id: id}, nil
+}
+`
+	want := `package keeper
+
+func (k msgServer) CreatePost(goCtx context.Context, msg *types.MsgCreatePost) (*types.MsgCreatePostResponse, error) {
+	var post = types.Post{
+		Creator: msg.Creator,
+		Title:   msg.Title,
+		Body:    msg.Body,
+		Views:   msg.Views,
+	}
+	id := k.AppendPost(ctx, post)
+	_ = types.EventDeletePost{Id: id}
+	_ = types.EventCreatePost{Id: id, Body: msg.Body, Views: msg.Views}
+	return &types.MsgCreatePostResponse{Id: id}, nil
+}
+`
+	got, err := msgServerAddFields(content, []string{"Post", "EventCreatePost"}, parseFields(t, "body", "views:uint"))
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	_, err = msgServerAddFields(content, []string{"Product"}, parseFields(t, "body"))
+	require.Error(t, err)
+}
+
+func TestCLITxAddFlags(t *testing.T) {
+	content := `package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdCreatePost() *cobra.Command {
+	cmd := &cobra.Command{
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			msg := types.NewMsgCreatePost(args[0])
+			return send(msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdUpdatePost() *cobra.Command {
+	cmd := &cobra.Command{
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			msg := types.NewMsgUpdatePost(args[0])
+			return send(msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+`
+	typeName, err := multiformatname.NewName("post")
+	require.NoError(t, err)
+
+	got, err := cliTxAddFlags(content, typeName, parseFields(t, "views:uint"))
+	require.NoError(t, err)
+	require.Contains(t, got, `"github.com/spf13/cast"`)
+	require.Contains(t, got, `cmd.Flags().String("views", "", "views of the post")`)
+	require.Contains(t, got, `msg := types.NewMsgUpdatePost(args[0])
+			if cmd.Flags().Changed("views") {
+				args := []string{cmd.Flag("views").Value.String()}
+				argViews, err := cast.ToUint64E(args[0])
+				if err != nil {
+					return err
+				}
+				msg.Views = argViews
+			}
+			return send(msg)`)
+
+	_, err = cliTxAddFlags("package cli\n", typeName, parseFields(t, "views:uint"))
+	require.Error(t, err)
+}
@@ -0,0 +1,220 @@
+package addfield
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/ast/astutil"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/templates/field"
+	"github.com/ignite/cli/ignite/templates/field/datatype"
+)
+
+// insertion is a text inserted at an offset of a source file.
+type insertion struct {
+	offset int
+	text   string
+}
+
+// applyInsertions inserts the texts in the content, starting from the end of the
+// content so the offsets of the other insertions remain valid.
+func applyInsertions(content string, insertions []insertion) string {
+	sort.SliceStable(insertions, func(i, j int) bool {
+		return insertions[i].offset > insertions[j].offset
+	})
+	for _, in := range insertions {
+		content = content[:in.offset] + in.text + content[in.offset:]
+	}
+	return content
+}
+
+// msgServerAddFields sets the fields from the message in the composite literals
+// of the given types of the package "types", e.g. the value of the type created
+// and updated by the msg server and the events emitted by the msg server.
+func msgServerAddFields(content string, typeNames []string, fields field.Fields) (string, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", content, parser.ParseComments)
+	if err != nil {
+		return "", err
+	}
+
+	names := make(map[string]struct{})
+	for _, name := range typeNames {
+		names[name] = struct{}{}
+	}
+
+	elts := make([]string, len(fields))
+	for i, f := range fields {
+		elts[i] = fmt.Sprintf("%[1]s: msg.%[1]s", f.Name.UpperCamel)
+	}
+
+	var insertions []insertion
+	ast.Inspect(f, func(n ast.Node) bool {
+		lit, ok := n.(*ast.CompositeLit)
+		if !ok {
+			return true
+		}
+		sel, ok := lit.Type.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if pkg, ok := sel.X.(*ast.Ident); !ok || pkg.Name != "types" {
+			return true
+		}
+		if _, ok := names[sel.Sel.Name]; !ok {
+			return true
+		}
+
+		insertions = append(insertions, compositeLitInsertion(fset, lit, elts))
+		return true
+	})
+	if len(insertions) == 0 {
+		return "", errors.New("no value of the type is built from the messages")
+	}
+
+	return formatSource(applyInsertions(content, insertions))
+}
+
+// compositeLitInsertion returns the insertion of the elements at the end of a composite literal.
+func compositeLitInsertion(fset *token.FileSet, lit *ast.CompositeLit, elts []string) insertion {
+	rbrace := fset.Position(lit.Rbrace)
+	if fset.Position(lit.Lbrace).Line == rbrace.Line {
+		text := strings.Join(elts, ", ")
+		if len(lit.Elts) > 0 {
+			text = ", " + text
+		}
+		return insertion{offset: rbrace.Offset, text: text}
+	}
+	return insertion{offset: rbrace.Offset, text: strings.Join(elts, ",\n") + ",\n"}
+}
+
+// cliTxAddFlags adds the fields to the create and update commands of the type as
+// optional flags. The fields are not added to the args of the commands to keep
+// the commands compatible with the scripts that use them.
+func cliTxAddFlags(content string, typeName multiformatname.Name, fields field.Fields) (string, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", content, parser.ParseComments)
+	if err != nil {
+		return "", err
+	}
+
+	var insertions []insertion
+	for _, action := range []string{"Create", "Update"} {
+		funcName := fmt.Sprintf("Cmd%s%s", action, typeName.UpperCamel)
+		msgConstructor := fmt.Sprintf("NewMsg%s%s", action, typeName.UpperCamel)
+
+		var fn *ast.FuncDecl
+		for _, decl := range f.Decls {
+			if d, ok := decl.(*ast.FuncDecl); ok && d.Name.Name == funcName {
+				fn = d
+			}
+		}
+		if fn == nil {
+			return "", fmt.Errorf("function %s not found", funcName)
+		}
+
+		var msgAssign, addTxFlags ast.Stmt
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			switch s := n.(type) {
+			case *ast.AssignStmt:
+				if len(s.Rhs) == 1 && isCall(s.Rhs[0], "types", msgConstructor) {
+					msgAssign = s
+				}
+			case *ast.ExprStmt:
+				if isCall(s.X, "flags", "AddTxFlagsToCmd") {
+					addTxFlags = s
+				}
+			}
+			return true
+		})
+		if msgAssign == nil {
+			return "", fmt.Errorf("message %s not created in %s", msgConstructor, funcName)
+		}
+		if addTxFlags == nil {
+			return "", fmt.Errorf("tx flags not added in %s", funcName)
+		}
+
+		var readFlags, defineFlags strings.Builder
+		for _, f := range fields {
+			fmt.Fprintf(&readFlags, `
+			if cmd.Flags().Changed("%[1]s") {
+				args := []string{cmd.Flag("%[1]s").Value.String()}
+				%[2]s
+				msg.%[3]s = arg%[3]s
+			}`, f.Name.Kebab, f.CLIArgs("arg", 0), f.Name.UpperCamel)
+			fmt.Fprintf(&defineFlags, "cmd.Flags().String(%q, \"\", %q)\n", f.Name.Kebab, flagUsage(typeName, f))
+		}
+		insertions = append(insertions,
+			insertion{offset: fset.Position(msgAssign.End()).Offset, text: readFlags.String()},
+			insertion{offset: fset.Position(addTxFlags.Pos()).Offset, text: defineFlags.String()},
+		)
+	}
+
+	content = applyInsertions(content, insertions)
+
+	// Add the imports required to parse the flags
+	fset = token.NewFileSet()
+	f, err = parser.ParseFile(fset, "", content, parser.ParseComments)
+	if err != nil {
+		return "", err
+	}
+	for _, imp := range fields.GoCLIImports() {
+		astutil.AddNamedImport(fset, f, imp.Alias, imp.Name)
+	}
+
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, f); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// isCall returns true when the expression calls the function of the package.
+func isCall(expr ast.Expr, pkg, name string) bool {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != name {
+		return false
+	}
+	x, ok := sel.X.(*ast.Ident)
+	return ok && x.Name == pkg
+}
+
+// flagUsage returns the usage of the flag of a field.
+func flagUsage(typeName multiformatname.Name, f field.Field) string {
+	usage := fmt.Sprintf("%s of the %s", f.Name.Kebab, typeName.Kebab)
+	switch f.DatatypeName {
+	case
+		datatype.StringSlice,
+		datatype.StringSliceAlias,
+		datatype.IntSlice,
+		datatype.IntSliceAlias,
+		datatype.UintSlice,
+		datatype.UintSliceAlias,
+		datatype.Coins,
+		datatype.CoinSliceAlias:
+		usage += " (comma separated values)"
+	case datatype.Custom:
+		usage += " (JSON)"
+	}
+	return usage
+}
+
+func formatSource(content string) (string, error) {
+	formatted, err := format.Source([]byte(content))
+	if err != nil {
+		return "", err
+	}
+	return string(formatted), nil
+}
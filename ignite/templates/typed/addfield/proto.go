@@ -0,0 +1,103 @@
+package addfield
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ignite/cli/ignite/templates/field"
+)
+
+var (
+	// protoFieldNumber matches the number of a field of a proto message.
+	protoFieldNumber = regexp.MustCompile(`=\s*(\d+)\s*[;\[]`)
+
+	// protoImport matches an import line of a proto file.
+	protoImport = regexp.MustCompile(`(?m)^import\s+"[^"]+";[^\n]*\n`)
+
+	// protoPackage matches the package line of a proto file.
+	protoPackage = regexp.MustCompile(`(?m)^package\s+[^;]+;[^\n]*\n`)
+)
+
+// protoMessage returns the regexp matching the declaration of a top level proto message.
+func protoMessage(name string) *regexp.Regexp {
+	return regexp.MustCompile(`(?m)^message\s+` + regexp.QuoteMeta(name) + `\s*\{`)
+}
+
+// hasProtoMessage returns true when the proto file content declares the message.
+func hasProtoMessage(content, name string) bool {
+	return protoMessage(name).MatchString(content)
+}
+
+// appendProtoFields adds the fields at the end of a proto message of the proto
+// file content. The fields are numbered after the highest field number of the
+// message so the values encoded before the change can still be decoded.
+func appendProtoFields(content, message string, fields field.Fields) (string, error) {
+	loc := protoMessage(message).FindStringIndex(content)
+	if loc == nil {
+		return "", fmt.Errorf("message %s not found", message)
+	}
+
+	// Find the closing brace of the message
+	end, depth := -1, 1
+	for i := loc[1]; i < len(content) && end < 0; i++ {
+		switch content[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				end = i
+			}
+		}
+	}
+	if end < 0 {
+		return "", errors.New("closing brace of message " + message + " not found")
+	}
+
+	var highest int
+	for _, m := range protoFieldNumber.FindAllStringSubmatch(content[loc[1]:end], -1) {
+		n, err := strconv.Atoi(m[1])
+		if err != nil {
+			return "", err
+		}
+		if n > highest {
+			highest = n
+		}
+	}
+
+	var b strings.Builder
+	for i, f := range fields {
+		fmt.Fprintf(&b, "  %s;\n", f.ProtoType(highest+i+1))
+	}
+
+	// Insert the fields before the line of the closing brace, or before the
+	// brace when the message is declared on a single line
+	lineStart := strings.LastIndex(content[:end], "\n") + 1
+	if strings.TrimSpace(content[lineStart:end]) == "" {
+		return content[:lineStart] + b.String() + content[lineStart:], nil
+	}
+	return content[:end] + "\n" + b.String() + content[end:], nil
+}
+
+// ensureProtoImports adds the imports missing from the proto file content after
+// its last import, or after its package when it has no imports.
+func ensureProtoImports(content string, imports []string) string {
+	for _, imp := range imports {
+		line := fmt.Sprintf("import %q;", imp)
+		if strings.Contains(content, line) {
+			continue
+		}
+
+		var offset int
+		if locs := protoImport.FindAllStringIndex(content, -1); locs != nil {
+			offset = locs[len(locs)-1][1]
+		} else if loc := protoPackage.FindStringIndex(content); loc != nil {
+			offset = loc[1]
+		}
+		content = content[:offset] + line + "\n" + content[offset:]
+	}
+	return content
+}
@@ -0,0 +1,157 @@
+// Package addfield adds new fields to a type scaffolded in a Stargate module.
+package addfield
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/gobuffalo/genny"
+
+	"github.com/ignite/cli/ignite/templates/typed"
+)
+
+// NewStargate returns the generator to add the fields of the options to an
+// existing type of a Stargate module. The fields are added to the proto message
+// of the type and, when the type has messages, to its create and update messages,
+// to its events, to the msg server and to the CLI tx commands as optional flags.
+func NewStargate(opts *typed.Options) (*genny.Generator, error) {
+	g := genny.New()
+
+	g.RunFn(protoTypeModify(opts))
+
+	if !opts.NoMessage {
+		g.RunFn(protoTxModify(opts))
+		g.RunFn(protoEventsModify(opts))
+		g.RunFn(msgServerModify(opts))
+		g.RunFn(clientCliTxModify(opts))
+	}
+
+	return g, nil
+}
+
+func protoTypeModify(opts *typed.Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.AppName, opts.ModuleName, opts.TypeName.Snake+".proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content, err := appendProtoFields(f.String(), opts.TypeName.UpperCamel, opts.Fields)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		content = ensureProtoImports(content, protoImports(opts))
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func protoTxModify(opts *typed.Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.AppName, opts.ModuleName, "tx.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content := f.String()
+		for _, msg := range []string{"MsgCreate", "MsgUpdate"} {
+			content, err = appendProtoFields(content, msg+opts.TypeName.UpperCamel, opts.Fields)
+			if err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+		}
+		content = ensureProtoImports(content, protoImports(opts))
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// protoEventsModify adds the fields to the typed events emitted when a value of
+// the type is created or updated, when the type emits them.
+func protoEventsModify(opts *typed.Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.AppName, opts.ModuleName, "events.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			// The events are optional
+			return nil
+		}
+
+		var (
+			content  = f.String()
+			modified bool
+		)
+		for _, event := range []string{"EventCreate", "EventUpdate"} {
+			name := event + opts.TypeName.UpperCamel
+			if !hasProtoMessage(content, name) {
+				continue
+			}
+			content, err = appendProtoFields(content, name, opts.Fields)
+			if err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+			modified = true
+		}
+		if !modified {
+			return nil
+		}
+		content = ensureProtoImports(content, protoImports(opts))
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func msgServerModify(opts *typed.Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "keeper", "msg_server_"+opts.TypeName.Snake+".go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content, err := msgServerAddFields(f.String(), []string{
+			opts.TypeName.UpperCamel,
+			"EventCreate" + opts.TypeName.UpperCamel,
+			"EventUpdate" + opts.TypeName.UpperCamel,
+		}, opts.Fields)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func clientCliTxModify(opts *typed.Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "client", "cli", "tx_"+opts.TypeName.Snake+".go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content, err := cliTxAddFlags(f.String(), opts.TypeName, opts.Fields)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// protoImports returns the proto files imported by the fields, including the
+// files of their custom types.
+func protoImports(opts *typed.Options) []string {
+	imports := opts.Fields.ProtoImports()
+	for _, f := range opts.Fields.Custom() {
+		imports = append(imports, fmt.Sprintf("%s/%s/%s.proto", opts.AppName, opts.ModuleName, f))
+	}
+	return imports
+}
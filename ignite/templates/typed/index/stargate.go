@@ -0,0 +1,112 @@
+// Package index scaffolds the secondary indexes of the list and map types.
+package index
+
+import (
+	"embed"
+	"fmt"
+	"path/filepath"
+
+	"github.com/gobuffalo/genny"
+
+	"github.com/ignite/cli/ignite/pkg/gomodulepath"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/typed"
+)
+
+//go:embed stargate/* stargate/**/*
+var fsStargate embed.FS
+
+// Stargate adds to g the generation of the secondary indexes of a list or map type,
+// with a paginated query for each index. The keeper of the type must maintain the
+// indexes with the generated set and remove methods.
+func Stargate(replacer placeholder.Replacer, opts *typed.Options, g *genny.Generator) error {
+	if len(opts.SecondaryIndexes) == 0 {
+		return nil
+	}
+
+	template := xgenny.NewEmbedWalker(fsStargate, "stargate/", opts.AppPath)
+
+	g.RunFn(protoQueryModify(replacer, opts))
+	g.RunFn(clientCliQueryModify(replacer, opts))
+
+	return typed.Box(template, opts, g)
+}
+
+func protoQueryModify(replacer placeholder.Replacer, opts *typed.Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.AppName, opts.ModuleName, "query.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		content := f.String()
+
+		appModulePath := gomodulepath.ExtractAppPath(opts.ModulePath)
+		for _, index := range opts.SecondaryIndexes {
+			// RPC service
+			templateRPC := `// Queries a list of %[2]v items by %[3]v.
+	rpc %[2]vBy%[4]v(Query%[2]vBy%[4]vRequest) returns (Query%[2]vBy%[4]vResponse) {
+		option (google.api.http).get = "/%[5]v/%[6]v/%[7]v/by_%[8]v/{%[3]v}";
+	}
+
+%[1]v`
+			replacementRPC := fmt.Sprintf(templateRPC, typed.Placeholder2,
+				opts.TypeName.UpperCamel,
+				index.ProtoFieldName(),
+				index.Name.UpperCamel,
+				appModulePath,
+				opts.ModuleName,
+				opts.TypeName.Snake,
+				index.Name.Snake,
+			)
+			content = replacer.Replace(content, typed.Placeholder2, replacementRPC)
+
+			// Messages
+			templateMessages := `message Query%[2]vBy%[3]vRequest {
+	%[4]v;
+	cosmos.base.query.v1beta1.PageRequest pagination = 2;
+}
+
+message Query%[2]vBy%[3]vResponse {
+	repeated %[2]v %[2]v = 1 [(gogoproto.nullable) = false];
+	cosmos.base.query.v1beta1.PageResponse pagination = 2;
+}
+
+%[1]v`
+			replacementMessages := fmt.Sprintf(templateMessages, typed.Placeholder3,
+				opts.TypeName.UpperCamel,
+				index.Name.UpperCamel,
+				index.ProtoType(1),
+			)
+			content = replacer.Replace(content, typed.Placeholder3, replacementMessages)
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func clientCliQueryModify(replacer placeholder.Replacer, opts *typed.Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "client/cli/query.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		content := f.String()
+
+		for _, index := range opts.SecondaryIndexes {
+			template := `cmd.AddCommand(CmdList%[2]vBy%[3]v())
+%[1]v`
+			replacement := fmt.Sprintf(template, typed.Placeholder,
+				opts.TypeName.UpperCamel,
+				index.Name.UpperCamel,
+			)
+			content = replacer.Replace(content, typed.Placeholder, replacement)
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
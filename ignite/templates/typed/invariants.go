@@ -0,0 +1,31 @@
+package typed
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/gobuffalo/genny"
+
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+)
+
+// InvariantsModify registers the invariants of the type in the module invariants.
+// The invariants of modules scaffolded without the keeper/invariants.go file
+// are generated but not registered.
+func InvariantsModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "keeper/invariants.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return nil
+		}
+
+		template := `register%[2]vInvariants(ir, k)
+	%[1]v`
+		replacement := fmt.Sprintf(template, PlaceholderKeeperInvariants, opts.TypeName.UpperCamel)
+		content := replacer.Replace(f.String(), PlaceholderKeeperInvariants, replacement)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
@@ -18,8 +18,10 @@ func moduleSimulationModify(replacer placeholder.Replacer, opts *typed.Options)
 			return err
 		}
 
-		// Create a list of two different indexes and fields to use as sample
-		msgField := fmt.Sprintf("%s: sample.AccAddress(),\n", opts.MsgSigner.UpperCamel)
+		// Create a list of two different indexes and fields to use as sample, the
+		// signers are random simulation accounts so the update and delete operations
+		// can be simulated from the genesis
+		msgField := fmt.Sprintf("%s: accs[simState.Rand.Intn(len(accs))],\n", opts.MsgSigner.UpperCamel)
 
 		// simulation genesis state
 		templateGs := `	%[2]vList: []types.%[2]v{
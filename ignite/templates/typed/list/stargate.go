@@ -10,9 +10,14 @@ import (
 	"github.com/gobuffalo/genny"
 
 	"github.com/ignite/cli/ignite/pkg/gomodulepath"
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
 	"github.com/ignite/cli/ignite/pkg/placeholder"
 	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/event"
+	"github.com/ignite/cli/ignite/templates/field"
+	"github.com/ignite/cli/ignite/templates/field/datatype"
 	"github.com/ignite/cli/ignite/templates/typed"
+	"github.com/ignite/cli/ignite/templates/typed/index"
 )
 
 var (
@@ -52,6 +57,8 @@ func NewStargate(replacer placeholder.Replacer, opts *typed.Options) (*genny.Gen
 	g.RunFn(typesKeyModify(opts))
 	g.RunFn(clientCliQueryModify(replacer, opts))
 
+	g.RunFn(typed.InvariantsModify(replacer, opts))
+
 	// Genesis modifications
 	genesisModify(replacer, opts, g)
 
@@ -61,6 +68,14 @@ func NewStargate(replacer placeholder.Replacer, opts *typed.Options) (*genny.Gen
 		g.RunFn(typesCodecModify(replacer, opts))
 		g.RunFn(clientCliTxModify(replacer, opts))
 
+		if !opts.NoEvents {
+			evts, err := events(opts)
+			if err != nil {
+				return nil, err
+			}
+			g.RunFn(event.ProtoModify(replacer, evts))
+		}
+
 		if !opts.NoSimulation {
 			g.RunFn(moduleSimulationModify(replacer, opts))
 			if err := typed.Box(simappTemplate, opts, g); err != nil {
@@ -76,9 +91,50 @@ func NewStargate(replacer placeholder.Replacer, opts *typed.Options) (*genny.Gen
 
 	g.RunFn(frontendSrcStoreAppModify(replacer, opts))
 
+	// Secondary indexes
+	if err := index.Stargate(replacer, opts, g); err != nil {
+		return nil, err
+	}
+
 	return g, typed.Box(componentTemplate, opts, g)
 }
 
+// events returns the typed events emitted when a value of the list is created, updated or deleted.
+func events(opts *typed.Options) (event.Options, error) {
+	id, err := multiformatname.NewName("id")
+	if err != nil {
+		return event.Options{}, err
+	}
+	idField := field.Field{Name: id, DatatypeName: datatype.Uint}
+
+	evts := event.Options{
+		AppName:    opts.AppName,
+		AppPath:    opts.AppPath,
+		ModuleName: opts.ModuleName,
+		ModulePath: opts.ModulePath,
+		MsgSigner:  opts.MsgSigner,
+	}
+	for _, e := range []struct {
+		action string
+		fields field.Fields
+	}{
+		{"create", append(field.Fields{idField}, opts.Fields...)},
+		{"update", append(field.Fields{idField}, opts.Fields...)},
+		{"delete", field.Fields{idField}},
+	} {
+		name, err := multiformatname.NewName(e.action + opts.TypeName.UpperCamel)
+		if err != nil {
+			return event.Options{}, err
+		}
+		evts.Events = append(evts.Events, event.Event{
+			Name:        name,
+			Description: fmt.Sprintf("a %s is %sd", opts.TypeName.LowerCamel, e.action),
+			Fields:      e.fields,
+		})
+	}
+	return evts, nil
+}
+
 func protoTxModify(replacer placeholder.Replacer, opts *typed.Options) genny.RunFn {
 	return func(r *genny.Runner) error {
 		path := filepath.Join(opts.AppPath, "proto", opts.AppName, opts.ModuleName, "tx.proto")
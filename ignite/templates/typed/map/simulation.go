@@ -18,10 +18,12 @@ func moduleSimulationModify(replacer placeholder.Replacer, opts *typed.Options)
 			return err
 		}
 
-		// Create a list of two different indexes and fields to use as sample
+		// Create a list of two different indexes and fields to use as sample, the
+		// signers are random simulation accounts so the update and delete operations
+		// can be simulated from the genesis
 		sampleIndexes := make([]string, 2)
 		for i := 0; i < 2; i++ {
-			sampleIndexes[i] = fmt.Sprintf("%s: sample.AccAddress(),\n", opts.MsgSigner.UpperCamel)
+			sampleIndexes[i] = fmt.Sprintf("%s: accs[simState.Rand.Intn(len(accs))],\n", opts.MsgSigner.UpperCamel)
 			for _, index := range opts.Indexes {
 				sampleIndexes[i] += index.GenesisArgs(i)
 			}
@@ -14,6 +14,7 @@ import (
 	"github.com/ignite/cli/ignite/templates/field/datatype"
 	"github.com/ignite/cli/ignite/templates/module"
 	"github.com/ignite/cli/ignite/templates/typed"
+	"github.com/ignite/cli/ignite/templates/typed/index"
 )
 
 var (
@@ -81,6 +82,7 @@ func NewStargate(replacer placeholder.Replacer, opts *typed.Options) (*genny.Gen
 	g.RunFn(genesisModuleModify(replacer, opts))
 	g.RunFn(genesisTestsModify(replacer, opts))
 	g.RunFn(genesisTypesTestsModify(replacer, opts))
+	g.RunFn(typed.InvariantsModify(replacer, opts))
 
 	// Modifications for new messages
 	if !opts.NoMessage {
@@ -110,6 +112,12 @@ func NewStargate(replacer placeholder.Replacer, opts *typed.Options) (*genny.Gen
 			return nil, err
 		}
 	}
+
+	// Secondary indexes
+	if err := index.Stargate(replacer, opts, g); err != nil {
+		return nil, err
+	}
+
 	return g, typed.Box(componentTemplate, opts, g)
 }
 
@@ -7,17 +7,20 @@ import (
 
 // Options ...
 type Options struct {
-	AppName      string
-	AppPath      string
-	ModuleName   string
-	ModulePath   string
-	TypeName     multiformatname.Name
-	MsgSigner    multiformatname.Name
-	Fields       field.Fields
-	Indexes      field.Fields
-	NoMessage    bool
-	NoSimulation bool
-	IsIBC        bool
+	AppName          string
+	AppPath          string
+	ModuleName       string
+	ModulePath       string
+	TypeName         multiformatname.Name
+	MsgSigner        multiformatname.Name
+	Fields           field.Fields
+	Indexes          field.Fields
+	SecondaryIndexes field.Fields
+	NoMessage        bool
+	NoSimulation     bool
+	NoEvents         bool
+	IsIBC            bool
+	WithMetrics      bool
 }
 
 // Validate that options are usable
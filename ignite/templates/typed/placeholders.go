@@ -1,5 +1,10 @@
 package typed
 
+import (
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/protoanalysis"
+)
+
 const (
 	Placeholder  = "// this line is used by starport scaffolding # 1"
 	Placeholder2 = "// this line is used by starport scaffolding # 2"
@@ -24,4 +29,20 @@ const (
 	PlaceholderSimappConst        = "// this line is used by starport scaffolding # simapp/module/const"
 	PlaceholderSimappGenesisState = "// this line is used by starport scaffolding # simapp/module/genesisState"
 	PlaceholderSimappOperation    = "// this line is used by starport scaffolding # simapp/module/operation"
+
+	PlaceholderKeeperInvariants = "// this line is used by starport scaffolding # keeper/invariants"
 )
+
+// The proto placeholders of the tx.proto file are injected from the proto
+// definitions of the file when they are missing.
+func init() {
+	placeholder.RegisterInjection(PlaceholderProtoTxImport, func(content, snippet string, _ bool) (string, error) {
+		return protoanalysis.InjectImports(content, snippet)
+	})
+	placeholder.RegisterInjection(PlaceholderProtoTxRPC, func(content, snippet string, _ bool) (string, error) {
+		return protoanalysis.InjectRPCs(content, "Msg", snippet)
+	})
+	placeholder.RegisterInjection(PlaceholderProtoTxMessage, func(content, snippet string, _ bool) (string, error) {
+		return protoanalysis.AppendMessages(content, snippet)
+	})
+}
@@ -27,7 +27,10 @@ func Box(box packd.Walker, opts *Options, g *genny.Generator) error {
 	ctx.Set("MsgSigner", opts.MsgSigner)
 	ctx.Set("Fields", opts.Fields)
 	ctx.Set("Indexes", opts.Indexes)
+	ctx.Set("SecondaryIndexes", opts.SecondaryIndexes)
 	ctx.Set("NoMessage", opts.NoMessage)
+	ctx.Set("NoEvents", opts.NoEvents)
+	ctx.Set("WithMetrics", opts.WithMetrics)
 	ctx.Set("protoPkgName", module.ProtoPackageName(appModulePath, opts.ModuleName))
 	ctx.Set("strconv", func() bool {
 		strconv := false
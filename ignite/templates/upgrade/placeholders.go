@@ -0,0 +1,10 @@
+package upgrade
+
+const (
+	// Placeholders in app/upgrades.go
+	PlaceholderUpgradesImport = "// this line is used by starport scaffolding # upgrades/import"
+	PlaceholderUpgradesList   = "// this line is used by starport scaffolding # upgrades/list"
+
+	// Placeholders in x/{{moduleName}}/keeper/migrations.go
+	PlaceholderMigrations = "// this line is used by starport scaffolding # migrations"
+)
@@ -0,0 +1,329 @@
+package upgrade
+
+import (
+	"embed"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush/v4"
+
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/field/plushhelpers"
+	"github.com/ignite/cli/ignite/templates/module"
+)
+
+const (
+	// PathUpgradesGo is the path of the file that registers the app upgrades.
+	PathUpgradesGo = "app/upgrades.go"
+
+	// PathUpgrades is the path of the directory that contains the app upgrades.
+	PathUpgrades = "app/upgrades"
+)
+
+var (
+	//go:embed base/* base/**/*
+	fsBase embed.FS
+
+	//go:embed upgrade/* upgrade/**/*
+	fsUpgrade embed.FS
+
+	//go:embed migrator/* migrator/**/*
+	fsMigrator embed.FS
+
+	// consensusVersionFunc matches the consensus version returned by an app module.
+	consensusVersionFunc = regexp.MustCompile(`(func \(\w*\s*AppModule\) ConsensusVersion\(\) uint64 \{\s*return )(\d+)(\s*\})`)
+
+	// registerServicesFunc matches the function that registers the services of an app module.
+	registerServicesFunc = regexp.MustCompile(`(?s)func \(am AppModule\) RegisterServices\(cfg module\.Configurator\) \{.*?\n\}`)
+
+	// configUpgrades matches the upgrades key of the config file.
+	configUpgrades = regexp.MustCompile(`(?m)^upgrades:[ \t]*\n`)
+
+	// configUpgradeIndent matches the indentation of the first upgrade in the config file.
+	configUpgradeIndent = regexp.MustCompile(`^([ \t]*)- `)
+)
+
+// Options are the options to scaffold an upgrade.
+type Options struct {
+	AppPath        string
+	ModulePath     string
+	UpgradeName    string
+	UpgradePackage string
+	AddedStores    []string
+	Migrations     []Migration
+
+	// ConfigPath is the path of the config file where the upgrade is added, it is optional.
+	ConfigPath string
+}
+
+// Migration is a consensus version bump of a module of the app.
+type Migration struct {
+	ModuleName string
+	From       uint64
+	To         uint64
+
+	// Changes describe the changes of the module state to migrate, they are
+	// added as comments to the migration.
+	Changes []string
+}
+
+// ConsensusVersion returns the consensus version of a module from the content of its module.go file.
+func ConsensusVersion(content string) (uint64, bool) {
+	m := consensusVersionFunc.FindStringSubmatch(content)
+	if m == nil {
+		return 0, false
+	}
+
+	var version uint64
+	_, err := fmt.Sscan(m[2], &version)
+	return version, err == nil
+}
+
+// NewBase returns the generator to scaffold the registry of the upgrades of the app.
+func NewBase(replacer placeholder.Replacer, opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+	g.RunFn(appUpgradesModify(replacer, opts))
+
+	ctx := plush.NewContext()
+	ctx.Set("ModulePath", opts.ModulePath)
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(xgenny.Transformer(ctx))
+
+	return g, xgenny.Box(g, xgenny.NewEmbedWalker(fsBase, "base/", opts.AppPath))
+}
+
+// NewUpgrade returns the generator to scaffold an upgrade of the app.
+func NewUpgrade(replacer placeholder.Replacer, opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+	g.RunFn(upgradesListModify(replacer, opts))
+	if opts.ConfigPath != "" {
+		g.RunFn(configModify(opts))
+	}
+
+	ctx := plush.NewContext()
+	ctx.Set("ModulePath", opts.ModulePath)
+	ctx.Set("UpgradeName", opts.UpgradeName)
+	ctx.Set("UpgradePackage", opts.UpgradePackage)
+	ctx.Set("AddedStores", opts.AddedStores)
+	ctx.Set("Migrations", opts.Migrations)
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(xgenny.Transformer(ctx))
+	g.Transformer(genny.Replace("{{upgradePackage}}", opts.UpgradePackage))
+
+	return g, xgenny.Box(g, xgenny.NewEmbedWalker(fsUpgrade, "upgrade/", opts.AppPath))
+}
+
+// NewMigration returns the generator to bump the consensus version of a module
+// and register the migration of the module state. The migrator of the module is
+// created when hasMigrator is false.
+func NewMigration(replacer placeholder.Replacer, appPath string, m Migration, hasMigrator bool) (*genny.Generator, error) {
+	g := genny.New()
+	g.RunFn(moduleMigrationModify(appPath, m))
+
+	if hasMigrator {
+		g.RunFn(migratorModify(replacer, appPath, m))
+		return g, nil
+	}
+
+	ctx := plush.NewContext()
+	ctx.Set("From", m.From)
+	ctx.Set("To", m.To)
+	ctx.Set("Changes", m.Changes)
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(xgenny.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", m.ModuleName))
+
+	return g, xgenny.Box(g, xgenny.NewEmbedWalker(fsMigrator, "migrator/", appPath))
+}
+
+// appUpgradesModify registers the upgrade handlers and store loaders in app.go.
+// The store loaders must be set before the latest version of the app is loaded.
+func appUpgradesModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, module.PathAppGo)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		template := `app.setupUpgradeHandlers()
+	app.setupUpgradeStoreLoaders()
+
+	%[1]v`
+		replacement := fmt.Sprintf(template, module.PlaceholderSgAppUpgrades)
+		content := replacer.Replace(f.String(), module.PlaceholderSgAppUpgrades, replacement)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// upgradesListModify adds the upgrade to the upgrades of the app.
+func upgradesListModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, PathUpgradesGo)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		templateImport := `"%[2]v/%[3]v/%[4]v"
+	%[1]v`
+		replacementImport := fmt.Sprintf(
+			templateImport,
+			PlaceholderUpgradesImport,
+			opts.ModulePath,
+			PathUpgrades,
+			opts.UpgradePackage,
+		)
+		content := replacer.Replace(f.String(), PlaceholderUpgradesImport, replacementImport)
+
+		templateList := `%[2]v.Upgrade,
+	%[1]v`
+		replacementList := fmt.Sprintf(templateList, PlaceholderUpgradesList, opts.UpgradePackage)
+		content = replacer.Replace(content, PlaceholderUpgradesList, replacementList)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// moduleMigrationModify bumps the consensus version of the module and registers its migration.
+func moduleMigrationModify(appPath string, m Migration) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(appPath, "x", m.ModuleName, "module.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content := f.String()
+		if !consensusVersionFunc.MatchString(content) {
+			return fmt.Errorf("consensus version not found in %s", path)
+		}
+		content = consensusVersionFunc.ReplaceAllString(content, fmt.Sprintf("${1}%d${3}", m.To))
+
+		loc := registerServicesFunc.FindStringIndex(content)
+		if loc == nil {
+			return fmt.Errorf("RegisterServices function not found in %s", path)
+		}
+
+		template := `
+	if err := cfg.RegisterMigration(types.ModuleName, %[1]d, keeper.NewMigrator(am.keeper).Migrate%[1]dto%[2]d); err != nil {
+		panic(err)
+	}
+}`
+		// Replace the closing brace of the function with the migration registration
+		end := loc[1] - 2
+		content = content[:end] + fmt.Sprintf(template, m.From, m.To) + content[loc[1]:]
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// migratorModify adds the migration of the module state to an existing migrator.
+func migratorModify(replacer placeholder.Replacer, appPath string, m Migration) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(appPath, "x", m.ModuleName, "keeper/migrations.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		var changes string
+		for _, change := range m.Changes {
+			changes += fmt.Sprintf("\t// %s\n", change)
+		}
+
+		template := `// Migrate%[2]dto%[3]d migrates the module state from consensus version %[2]d to %[3]d
+func (m Migrator) Migrate%[2]dto%[3]d(ctx sdk.Context) error {
+%[4]v	return nil
+}
+
+%[1]v`
+		replacement := fmt.Sprintf(template, PlaceholderMigrations, m.From, m.To, changes)
+		content := replacer.Replace(f.String(), PlaceholderMigrations, replacement)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// configModify adds the upgrade to the upgrades of the config file.
+func configModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		f, err := r.Disk.Find(opts.ConfigPath)
+		if err != nil {
+			return err
+		}
+
+		content, err := addConfigUpgrade(f.String(), opts.UpgradeName)
+		if err != nil {
+			return fmt.Errorf("%s: %w", opts.ConfigPath, err)
+		}
+
+		newFile := genny.NewFileS(opts.ConfigPath, content)
+		return r.File(newFile)
+	}
+}
+
+// addConfigUpgrade adds an upgrade at the end of the upgrades of a config file content.
+// The config file is modified as text to keep its formatting and comments.
+func addConfigUpgrade(content, name string) (string, error) {
+	entry := fmt.Sprintf("- name: %s\n", name)
+
+	loc := configUpgrades.FindStringIndex(content)
+	if loc == nil {
+		if strings.HasPrefix(content, "upgrades:") || strings.Contains(content, "\nupgrades:") {
+			return "", fmt.Errorf("upgrades must be a list to add the %s upgrade", name)
+		}
+		if content != "" && !strings.HasSuffix(content, "\n") {
+			content += "\n"
+		}
+		return content + "upgrades:\n  " + entry, nil
+	}
+
+	// The upgrades end at the first line that is not indented or a list item
+	var (
+		indent  string
+		isList  bool
+		isBlock bool
+		offset  = loc[1]
+		end     = loc[1]
+	)
+	for _, line := range strings.SplitAfter(content[loc[1]:], "\n") {
+		offset += len(line)
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		m := configUpgradeIndent.FindStringSubmatch(line)
+		if m == nil && line[0] != ' ' && line[0] != '\t' {
+			break
+		}
+		if m != nil && !isList {
+			indent, isList = m[1], true
+		}
+		isBlock = true
+		end = offset
+	}
+	switch {
+	case !isBlock:
+		indent = "  "
+	case !isList:
+		return "", fmt.Errorf("upgrades must be a list to add the %s upgrade", name)
+	}
+	entry = indent + entry
+	if !strings.HasSuffix(content[:end], "\n") {
+		entry = "\n" + entry
+	}
+
+	return content[:end] + entry + content[end:], nil
+}
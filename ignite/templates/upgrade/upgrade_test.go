@@ -0,0 +1,118 @@
+package upgrade
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gobuffalo/genny"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+)
+
+func TestAddConfigUpgrade(t *testing.T) {
+	cases := []struct {
+		name    string
+		content string
+		want    string
+		err     bool
+	}{
+		{
+			name:    "without upgrades",
+			content: "version: 1\naccounts:\n  - name: alice\n",
+			want:    "version: 1\naccounts:\n  - name: alice\nupgrades:\n  - name: v2\n",
+		},
+		{
+			name:    "without trailing new line",
+			content: "version: 1",
+			want:    "version: 1\nupgrades:\n  - name: v2\n",
+		},
+		{
+			name:    "with upgrades",
+			content: "upgrades:\n  - name: v1\n    binaries:\n      linux/amd64: https://example.com\n# comment\nversion: 1\n",
+			want:    "upgrades:\n  - name: v1\n    binaries:\n      linux/amd64: https://example.com\n  - name: v2\n# comment\nversion: 1\n",
+		},
+		{
+			name:    "with unindented upgrades",
+			content: "version: 1\nupgrades:\n- name: v1\n",
+			want:    "version: 1\nupgrades:\n- name: v1\n- name: v2\n",
+		},
+		{
+			name:    "with indented upgrades at the end",
+			content: "version: 1\nupgrades:\n    - name: v1",
+			want:    "version: 1\nupgrades:\n    - name: v1\n    - name: v2\n",
+		},
+		{
+			name:    "with empty upgrades",
+			content: "upgrades:\nversion: 1\n",
+			want:    "upgrades:\n  - name: v2\nversion: 1\n",
+		},
+		{
+			name:    "with upgrades map",
+			content: "upgrades:\n  name: v1\n",
+			err:     true,
+		},
+		{
+			name:    "with inline upgrades",
+			content: "version: 1\nupgrades: []\n",
+			err:     true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := addConfigUpgrade(tt.content, "v2")
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewMigration(t *testing.T) {
+	appPath := t.TempDir()
+	moduleGo := filepath.Join(appPath, "x/mars/module.go")
+	require.NoError(t, os.MkdirAll(filepath.Dir(moduleGo), 0o755))
+	require.NoError(t, os.WriteFile(moduleGo, []byte(`package mars
+
+// RegisterServices registers a gRPC query service to respond to the module-specific gRPC queries
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
+}
+
+// ConsensusVersion is a sequence number for state-breaking change of the module
+func (AppModule) ConsensusVersion() uint64 { return 1 }
+`), 0o644))
+
+	version, ok := ConsensusVersion(readFile(t, moduleGo))
+	require.True(t, ok)
+	require.EqualValues(t, 1, version)
+
+	g, err := NewMigration(placeholder.New(), appPath, Migration{ModuleName: "mars", From: 1, To: 2}, false)
+	require.NoError(t, err)
+
+	r := genny.WetRunner(context.Background())
+	require.NoError(t, r.With(g))
+	require.NoError(t, r.Run())
+
+	content := readFile(t, moduleGo)
+	require.Contains(t, content, "func (AppModule) ConsensusVersion() uint64 { return 2 }")
+	require.Contains(t, content, `	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
+	if err := cfg.RegisterMigration(types.ModuleName, 1, keeper.NewMigrator(am.keeper).Migrate1to2); err != nil {
+		panic(err)
+	}
+}`)
+	require.Contains(t, readFile(t, filepath.Join(appPath, "x/mars/keeper/migrations.go")), "func (m Migrator) Migrate1to2(ctx sdk.Context) error {")
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return string(content)
+}
@@ -66,27 +66,41 @@ func TestGenerateAnAppWithNoDefaultModuleAndCreateAModule(t *testing.T) {
 	))
 }
 
-func TestGenerateAnAppWithWasm(t *testing.T) {
-	t.Skip()
+func TestGenerateAnAppWithModernWiring(t *testing.T) {
+	var (
+		env = envtest.New(t)
+		app = env.Scaffold("github.com/test/blog", "--app-wiring", "modern")
+	)
+
+	env.Must(env.Exec("create a list with CLI tests using the test network",
+		step.NewSteps(step.New(
+			step.Exec(envtest.IgniteApp, "s", "list", "--yes", "post", "title", "body"),
+			step.Workdir(app.SourcePath()),
+		)),
+	))
+
+	app.EnsureSteady()
+}
 
+func TestGenerateAnAppWithWasm(t *testing.T) {
 	var (
 		env = envtest.New(t)
 		app = env.Scaffold("github.com/test/blog")
 	)
 
-	env.Must(env.Exec("add Wasm module",
+	env.Must(env.Exec("should reject Wasm for an app on Cosmos SDK v0.46",
 		step.NewSteps(step.New(
-			step.Exec(envtest.IgniteApp, "s", "wasm", "--yes"),
+			step.Exec(envtest.IgniteApp, "s", "wasm"),
 			step.Workdir(app.SourcePath()),
 		)),
+		envtest.ExecShouldError(),
 	))
 
-	env.Must(env.Exec("should not add Wasm module second time",
+	env.Must(env.Exec("should build the app left unchanged",
 		step.NewSteps(step.New(
-			step.Exec(envtest.IgniteApp, "s", "wasm", "--yes"),
+			step.Exec(envtest.IgniteApp, "c", "build"),
 			step.Workdir(app.SourcePath()),
 		)),
-		envtest.ExecShouldError(),
 	))
 
 	app.EnsureSteady()
@@ -195,5 +209,50 @@ func TestGenerateAStargateAppWithEmptyModule(t *testing.T) {
 		envtest.ExecShouldError(),
 	))
 
+	env.Must(env.Exec("create a module with hooks and dependencies",
+		step.NewSteps(step.New(
+			step.Exec(
+				envtest.IgniteApp,
+				"s",
+				"module",
+				"--yes",
+				"with_hooks",
+				"--hooks",
+				"--dep",
+				"bank,staking",
+				"--require-registration",
+			),
+			step.Workdir(app.SourcePath()),
+		)),
+	))
+
+	env.Must(env.Exec("build the app with the hooks and dependencies of the module",
+		step.NewSteps(step.New(
+			step.Exec(envtest.IgniteApp, "c", "build"),
+			step.Workdir(app.SourcePath()),
+		)),
+	))
+
+	env.Must(env.Exec("create a module with metrics",
+		step.NewSteps(step.New(
+			step.Exec(envtest.IgniteApp, "s", "module", "--yes", "with_metrics", "--metrics", "--require-registration"),
+			step.Workdir(app.SourcePath()),
+		)),
+	))
+
+	env.Must(env.Exec("create a list in a module with metrics",
+		step.NewSteps(step.New(
+			step.Exec(envtest.IgniteApp, "s", "list", "--yes", "post", "title", "--module", "with_metrics"),
+			step.Workdir(app.SourcePath()),
+		)),
+	))
+
+	env.Must(env.Exec("create a message in a module with metrics",
+		step.NewSteps(step.New(
+			step.Exec(envtest.IgniteApp, "s", "message", "--yes", "like-post", "id:uint", "--module", "with_metrics"),
+			step.Workdir(app.SourcePath()),
+		)),
+	))
+
 	app.EnsureSteady()
 }
@@ -311,3 +311,57 @@ func TestCreateIBCPacket(t *testing.T) {
 
 	app.EnsureSteady()
 }
+
+func TestCreateIBCMiddleware(t *testing.T) {
+	var (
+		env = envtest.New(t)
+		app = env.Scaffold("github.com/test/blogibc3")
+	)
+
+	env.Must(env.Exec("create a module",
+		step.NewSteps(step.New(
+			step.Exec(envtest.IgniteApp, "s", "module", "--yes", "foo", "--require-registration"),
+			step.Workdir(app.SourcePath()),
+		)),
+	))
+
+	env.Must(env.Exec("create a middleware",
+		step.NewSteps(step.New(
+			step.Exec(envtest.IgniteApp, "s", "ibc-middleware", "--yes", "rateLimit", "--module", "foo"),
+			step.Workdir(app.SourcePath()),
+		)),
+	))
+
+	env.Must(env.Exec("create a second middleware",
+		step.NewSteps(step.New(
+			step.Exec(envtest.IgniteApp, "s", "ibc-middleware", "--yes", "audit", "--module", "foo"),
+			step.Workdir(app.SourcePath()),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent creating a middleware with no module specified",
+		step.NewSteps(step.New(
+			step.Exec(envtest.IgniteApp, "s", "ibc-middleware", "--yes", "bar"),
+			step.Workdir(app.SourcePath()),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.Must(env.Exec("should prevent creating a middleware in a non existent module",
+		step.NewSteps(step.New(
+			step.Exec(envtest.IgniteApp, "s", "ibc-middleware", "--yes", "bar", "--module", "nomodule"),
+			step.Workdir(app.SourcePath()),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.Must(env.Exec("should prevent creating an existing middleware",
+		step.NewSteps(step.New(
+			step.Exec(envtest.IgniteApp, "s", "ibc-middleware", "--yes", "rateLimit", "--module", "foo"),
+			step.Workdir(app.SourcePath()),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	app.EnsureSteady()
+}
@@ -0,0 +1,116 @@
+//go:build !relayer
+
+package other_components_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/step"
+	envtest "github.com/ignite/cli/integration"
+)
+
+const importProtoFiles = `syntax = "proto3";
+package foo.bar;
+
+import "cosmos/base/v1beta1/coin.proto";
+import "cosmos/base/query/v1beta1/pagination.proto";
+
+service Msg {
+  rpc CreatePost(MsgCreatePost) returns (MsgCreatePostResponse);
+  rpc Deposit(MsgDeposit) returns (MsgDepositResponse);
+}
+
+message MsgCreatePost {
+  string creator = 1;
+  string title = 2;
+  repeated string tags = 3;
+}
+
+message MsgCreatePostResponse {
+  uint64 id = 1;
+}
+
+message MsgDeposit {
+  string authority = 1;
+  repeated cosmos.base.v1beta1.Coin amount = 2;
+}
+
+message MsgDepositResponse {}
+
+service Query {
+  rpc Posts(QueryPostsRequest) returns (QueryPostsResponse);
+}
+
+message QueryPostsRequest {
+  cosmos.base.query.v1beta1.PageRequest pagination = 1;
+  bool archived = 2;
+}
+
+message QueryPostsResponse {
+  repeated string titles = 1;
+  cosmos.base.query.v1beta1.PageResponse pagination = 2;
+}
+`
+
+const importProtoUnsupportedFiles = `syntax = "proto3";
+package foo.baz;
+
+service Msg {
+  rpc CreateComment(MsgCreateComment) returns (MsgCreateCommentResponse);
+  rpc Upload(MsgUpload) returns (MsgUploadResponse);
+}
+
+message MsgCreateComment {
+  string creator = 1;
+  string body = 2;
+}
+
+message MsgCreateCommentResponse {}
+
+message MsgUpload {
+  string creator = 1;
+  bytes data = 2;
+}
+
+message MsgUploadResponse {}
+`
+
+func TestGenerateAnAppWithImportedProto(t *testing.T) {
+	var (
+		env      = envtest.New(t)
+		app      = env.Scaffold("github.com/test/blog")
+		protoDir = env.TmpDir()
+	)
+
+	protoPath := filepath.Join(protoDir, "bar", "tx.proto")
+	require.NoError(t, os.MkdirAll(filepath.Dir(protoPath), 0o755))
+	require.NoError(t, os.WriteFile(protoPath, []byte(importProtoFiles), 0o644))
+
+	unsupportedPath := filepath.Join(protoDir, "baz", "tx.proto")
+	require.NoError(t, os.MkdirAll(filepath.Dir(unsupportedPath), 0o755))
+	require.NoError(t, os.WriteFile(unsupportedPath, []byte(importProtoUnsupportedFiles), 0o644))
+
+	env.Must(env.Exec("import the messages and queries of proto files",
+		step.NewSteps(step.New(
+			step.Exec(envtest.IgniteApp, "s", "import-proto", "--yes", filepath.Dir(protoPath)),
+			step.Workdir(app.SourcePath()),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent importing proto files with an unsupported field type",
+		step.NewSteps(step.New(
+			step.Exec(envtest.IgniteApp, "s", "import-proto", "--yes", filepath.Dir(unsupportedPath)),
+			step.Workdir(app.SourcePath()),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	_, statErr := os.Stat(filepath.Join(app.SourcePath(), "x", "blog", "keeper", "msg_server_create_comment.go"))
+	require.True(t, os.IsNotExist(statErr), "the supported RPCs of a failed import should not be scaffolded")
+
+	app.EnsureSteady()
+}
@@ -48,7 +48,17 @@ export default class Relayer {
                       ]: [Path, Chain, Chain, string, string]): Promise<Path> {
         const srcClient = await Relayer.getIBCClient(srcChain, srcKey);
         const dstClient = await Relayer.getIBCClient(dstChain, dstKey);
-        const link = await Relayer.create(srcClient, dstClient, srcChain.client_id, dstChain.client_id);
+        // the channel is created on the connection of the path when it's set,
+        // otherwise on a new connection.
+        const link = path.src.connection_id && path.dst.connection_id
+            ? await Link.createWithExistingConnections(
+                srcClient,
+                dstClient,
+                path.src.connection_id,
+                path.dst.connection_id,
+                new ConsoleLogger()
+            )
+            : await Relayer.create(srcClient, dstClient, srcChain.client_id, dstChain.client_id);
 
         const channels = await link.createChannel(
             'A',